@@ -187,6 +187,27 @@ var adminMarathonDeleteCmd = &cobra.Command{
 	},
 }
 
+// --- Inventory Admin ---
+
+var adminReconcileInventoryCmd = &cobra.Command{
+	Use:   "reconcile-inventory",
+	Short: "Replay transaction logs against inventory balances (dry run unless --fix)",
+	Run: func(cmd *cobra.Command, args []string) {
+		all, _ := cmd.Flags().GetBool("all")
+		fix, _ := cmd.Flags().GetBool("fix")
+
+		body := map[string]interface{}{
+			"fix": fix,
+		}
+		if !all {
+			body["playerId"] = playerFlag
+		}
+
+		data, err := apiPost("/admin/inventory/reconcile", body)
+		printResult(data, err)
+	},
+}
+
 func init() {
 	// set-streak flags
 	adminSetStreakCmd.Flags().Int("current", 0, "Current streak value")
@@ -225,6 +246,10 @@ func init() {
 	// marathon-delete flags
 	adminMarathonDeleteCmd.Flags().Bool("yes", false, "Confirm destructive operation")
 
+	// reconcile-inventory flags
+	adminReconcileInventoryCmd.Flags().Bool("all", false, "Reconcile every player instead of --player")
+	adminReconcileInventoryCmd.Flags().Bool("fix", false, "Overwrite drifted balances with the replayed ones")
+
 	adminCmd.AddCommand(
 		adminSetStreakCmd,
 		adminSimulateStreakCmd,
@@ -234,6 +259,7 @@ func init() {
 		adminMarathonUpdateCmd,
 		adminMarathonGamesCmd,
 		adminMarathonDeleteCmd,
+		adminReconcileInventoryCmd,
 	)
 	rootCmd.AddCommand(adminCmd)
 }
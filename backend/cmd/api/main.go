@@ -91,7 +91,7 @@ func main() {
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{corsOrigins},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "Idempotency-Key"},
 		AllowCredentials: corsOrigins != "*", // Only allow credentials if not wildcard
 	}))
 
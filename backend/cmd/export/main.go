@@ -24,14 +24,57 @@ type CompactQuiz struct {
 	L    *int              `json:"l,omitempty"`    // timeLimit (omit if 60)
 	P    *int              `json:"p,omitempty"`    // passingScore (omit if 70)
 	Q    []CompactQuestion `json:"q"`              // questions
+
+	Tr map[string]CompactQuizTranslation `json:"tr,omitempty"` // translations by locale (omit if none)
+}
+
+// CompactQuizTranslation is a quiz's title and description in one locale
+type CompactQuizTranslation struct {
+	T string `json:"t"`
+	D string `json:"d,omitempty"`
 }
 
 // CompactQuestion represents a question in compact format
 type CompactQuestion struct {
-	T string   `json:"t"`           // question text
-	A []string `json:"a"`           // answers
-	C int      `json:"c"`           // correctIndex (0-based)
-	P *int     `json:"p,omitempty"` // points (omit if 0)
+	T   string          `json:"t"`             // question text
+	A   []string        `json:"a"`             // answers
+	C   int             `json:"c"`             // correctIndex (0-based)
+	P   *int            `json:"p,omitempty"`   // points (omit if 0)
+	Df  string          `json:"df,omitempty"`  // difficulty (omit if medium)
+	X   string          `json:"x,omitempty"`   // explanation (omit if none)
+	Src string          `json:"src,omitempty"` // explanation source URL (omit if none)
+	M   *CompactMedia   `json:"m,omitempty"`   // question image (omit if none)
+	Am  []*CompactMedia `json:"am,omitempty"`  // answer images, parallel to a (omit if none)
+	Ty  string          `json:"ty,omitempty"`  // type: tf, multi, order, num (omit if single choice)
+	Cs  []int           `json:"cs,omitempty"`  // multi: correct indexes
+	N   *float64        `json:"n,omitempty"`   // num: the answer
+	Nt  float64         `json:"nt,omitempty"`  // num: tolerance (omit if exact)
+
+	Tr map[string]CompactQuestionTranslation `json:"tr,omitempty"` // translations by locale (omit if none)
+}
+
+// CompactQuestionTranslation is a question in one locale
+type CompactQuestionTranslation struct {
+	T string   `json:"t,omitempty"` // question text (omit if only the answers are translated)
+	A []string `json:"a,omitempty"` // answers, parallel to the question's a ("" = untranslated)
+	X string   `json:"x,omitempty"` // explanation
+}
+
+// CompactMedia represents an image reference in compact format
+type CompactMedia struct {
+	U   string `json:"u,omitempty"` // external URL
+	K   string `json:"k,omitempty"` // stored asset key
+	W   int    `json:"w"`
+	H   int    `json:"h"`
+	Alt string `json:"alt"`
+}
+
+// compactTypeCodes maps question types to their compact codes (single choice has none)
+var compactTypeCodes = map[quiz.QuestionType]string{
+	quiz.QuestionTypeTrueFalse:   "tf",
+	quiz.QuestionTypeMultiSelect: "multi",
+	quiz.QuestionTypeOrdering:    "order",
+	quiz.QuestionTypeNumeric:     "num",
 }
 
 // BatchExport represents a batch of quizzes
@@ -187,22 +230,48 @@ func convertToCompact(q *quiz.Quiz, categoryMap map[string]string) CompactQuiz {
 		compact.P = &ps
 	}
 
+	// Translations (omit if none)
+	if translations := q.Translations(); len(translations) > 0 {
+		compact.Tr = make(map[string]CompactQuizTranslation, len(translations))
+		for locale, tr := range translations {
+			compact.Tr[locale] = CompactQuizTranslation{T: tr.Title().String(), D: tr.Description()}
+		}
+	}
+
 	// Questions
 	questions := q.Questions()
 	compact.Q = make([]CompactQuestion, 0, len(questions))
 	for _, question := range questions {
 		cq := CompactQuestion{
-			T: question.Text().String(),
+			T:  question.Text().String(),
+			Ty: compactTypeCodes[question.Type()],
 		}
 
-		// Answers and correct index
+		// Numeric answer
+		if numeric := question.NumericAnswer(); !numeric.IsEmpty() {
+			value := numeric.Value()
+			cq.N = &value
+			cq.Nt = numeric.Tolerance()
+		}
+
+		// Answers and correct index (ordering: answers in the solution order)
 		answers := question.Answers()
 		cq.A = make([]string, 0, len(answers))
+		answerMedia := make([]*CompactMedia, 0, len(answers))
+		hasAnswerMedia := false
 		for i, a := range answers {
 			cq.A = append(cq.A, a.Text().String())
 			if a.IsCorrect() {
 				cq.C = i
+				if question.Type() == quiz.QuestionTypeMultiSelect {
+					cq.Cs = append(cq.Cs, i)
+				}
 			}
+			answerMedia = append(answerMedia, toCompactMedia(a.Media()))
+			hasAnswerMedia = hasAnswerMedia || !a.Media().IsEmpty()
+		}
+		if hasAnswerMedia {
+			cq.Am = answerMedia
 		}
 
 		// Points (omit if 0)
@@ -211,12 +280,60 @@ func convertToCompact(q *quiz.Quiz, categoryMap map[string]string) CompactQuiz {
 			cq.P = &pts
 		}
 
+		// Difficulty (omit if default)
+		if question.Difficulty() != quiz.DefaultDifficulty {
+			cq.Df = question.Difficulty().String()
+		}
+
+		// Explanation (omit if none)
+		cq.X = question.Explanation().Text()
+		cq.Src = question.Explanation().SourceURL()
+
+		// Media (omit if none)
+		cq.M = toCompactMedia(question.Media())
+
+		// Translations (omit if none)
+		cq.Tr = toCompactQuestionTranslations(question)
+
 		compact.Q = append(compact.Q, cq)
 	}
 
 	return compact
 }
 
+// toCompactQuestionTranslations collects a question's and its answers' translations by locale (nil if none)
+func toCompactQuestionTranslations(question quiz.Question) map[string]CompactQuestionTranslation {
+	result := make(map[string]CompactQuestionTranslation)
+	for locale, tr := range question.Translations() {
+		result[locale] = CompactQuestionTranslation{T: tr.Text().String(), X: tr.Explanation()}
+	}
+
+	answers := question.Answers()
+	for i, a := range answers {
+		for locale, text := range a.Translations() {
+			tr := result[locale]
+			if tr.A == nil {
+				tr.A = make([]string, len(answers))
+			}
+			tr.A[i] = text.String()
+			result[locale] = tr
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// toCompactMedia converts media to compact format (nil if none)
+func toCompactMedia(m quiz.Media) *CompactMedia {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &CompactMedia{U: m.URL(), K: m.AssetKey(), W: m.Width(), H: m.Height(), Alt: m.AltText()}
+}
+
 func exportBatch(quizzes []CompactQuiz, outDir string) {
 	batch := BatchExport{
 		Batch: BatchMeta{
@@ -28,19 +28,62 @@ type QuizImportData struct {
 	PassingScore int              `json:"passingScore"`         // percentage (0-100)
 	Questions    []QuestionImport `json:"questions"`
 	Tags         []string         `json:"tags,omitempty"` // Optional tags
+
+	// Optional translations by ISO 639-1 locale (e.g. "ru"); untranslated content falls back to the default text
+	Translations map[string]QuizTranslationImport `json:"translations,omitempty"`
+}
+
+// QuizTranslationImport is a quiz's title and description in one locale
+type QuizTranslationImport struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
 }
 
 // QuestionImport represents a question in the import file (verbose format)
 type QuestionImport struct {
-	Text    string         `json:"text"`
-	Points  int            `json:"points"`
-	Answers []AnswerImport `json:"answers"`
+	Type        string         `json:"type,omitempty"` // single_choice (default), true_false, multi_select, ordering, numeric
+	Text        string         `json:"text"`
+	Points      int            `json:"points"`
+	Difficulty  string         `json:"difficulty,omitempty"`  // easy, medium, hard (default: medium)
+	Explanation string         `json:"explanation,omitempty"` // shown after the question is answered
+	SourceURL   string         `json:"sourceUrl,omitempty"`   // reference backing the explanation
+	Media       *MediaImport   `json:"media,omitempty"`       // image shown with the question
+	Answers     []AnswerImport `json:"answers"`               // ordering: in the solution order; numeric: none
+
+	NumericAnswer *NumericAnswerImport `json:"numericAnswer,omitempty"` // solution of a numeric question
+
+	Translations map[string]QuestionTranslationImport `json:"translations,omitempty"` // by locale
+}
+
+// NumericAnswerImport is the solution of a numeric question: guesses within the
+// tolerance of the value earn partial credit
+type NumericAnswerImport struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+// QuestionTranslationImport is a question's text and explanation in one locale
+type QuestionTranslationImport struct {
+	Text        string `json:"text"`
+	Explanation string `json:"explanation,omitempty"`
 }
 
 // AnswerImport represents an answer in the import file (verbose format)
 type AnswerImport struct {
-	Text      string `json:"text"`
-	IsCorrect bool   `json:"isCorrect"`
+	Text      string       `json:"text"`
+	IsCorrect bool         `json:"isCorrect"`
+	Media     *MediaImport `json:"media,omitempty"` // image shown with the answer
+
+	Translations map[string]string `json:"translations,omitempty"` // answer text by locale
+}
+
+// MediaImport is an image reference: an external URL or a stored asset key (e.g. "flags/fr.png")
+type MediaImport struct {
+	URL      string `json:"url,omitempty"`
+	AssetKey string `json:"assetKey,omitempty"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Alt      string `json:"alt"`
 }
 
 // CompactQuiz represents the compact JSON format for LLM generation
@@ -53,14 +96,49 @@ type CompactQuiz struct {
 	L    *int              `json:"l,omitempty"`    // timeLimit seconds (omit if 60)
 	P    *int              `json:"p,omitempty"`    // passingScore % (omit if 70)
 	Q    []CompactQuestion `json:"q"`              // questions
+
+	Tr map[string]CompactQuizTranslation `json:"tr,omitempty"` // translations by locale
+}
+
+// CompactQuizTranslation is a quiz's title and description in one locale
+type CompactQuizTranslation struct {
+	T string `json:"t"`           // title
+	D string `json:"d,omitempty"` // description
 }
 
 // CompactQuestion represents a question in compact format
 type CompactQuestion struct {
-	T string   `json:"t"`          // question text
-	A []string `json:"a"`          // answers (array of strings)
-	C int      `json:"c"`          // correctIndex (0-based)
-	P *int     `json:"p,omitempty"` // points (omit if 10)
+	T   string          `json:"t"`             // question text
+	A   []string        `json:"a"`             // answers (array of strings)
+	C   int             `json:"c"`             // correctIndex (0-based)
+	P   *int            `json:"p,omitempty"`   // points (omit if 10)
+	Df  string          `json:"df,omitempty"`  // difficulty: easy, medium, hard (omit if medium)
+	X   string          `json:"x,omitempty"`   // explanation shown after answering
+	Src string          `json:"src,omitempty"` // source URL of the explanation
+	M   *CompactMedia   `json:"m,omitempty"`   // question image
+	Am  []*CompactMedia `json:"am,omitempty"`  // answer images, parallel to a (null = none)
+	Ty  string          `json:"ty,omitempty"`  // type: tf, multi, order, num (omit if single choice)
+	Cs  []int           `json:"cs,omitempty"`  // multi: correct indexes (0-based), instead of c
+	N   *float64        `json:"n,omitempty"`   // num: the answer (a is empty)
+	Nt  float64         `json:"nt,omitempty"`  // num: tolerance (omit if exact)
+
+	Tr map[string]CompactQuestionTranslation `json:"tr,omitempty"` // translations by locale
+}
+
+// CompactQuestionTranslation is a question in one locale
+type CompactQuestionTranslation struct {
+	T string   `json:"t,omitempty"` // question text (omit to translate only the answers)
+	A []string `json:"a,omitempty"` // answers, parallel to the question's a ("" = untranslated)
+	X string   `json:"x,omitempty"` // explanation
+}
+
+// CompactMedia represents an image reference in compact format
+type CompactMedia struct {
+	U   string `json:"u,omitempty"` // external URL
+	K   string `json:"k,omitempty"` // stored asset key
+	W   int    `json:"w"`           // width px
+	H   int    `json:"h"`           // height px
+	Alt string `json:"alt"`         // alt text
 }
 
 // BatchImport represents a batch of quizzes with shared metadata
@@ -162,6 +240,50 @@ func inferCategoryIDFromName(db *sql.DB, categoryName string) (*quiz.CategoryID,
 	return nil, fmt.Errorf("category not found: %s", categoryName)
 }
 
+// toImport converts compact media to the verbose form (nil stays nil)
+func (m *CompactMedia) toImport() *MediaImport {
+	if m == nil {
+		return nil
+	}
+	return &MediaImport{URL: m.U, AssetKey: m.K, Width: m.W, Height: m.H, Alt: m.Alt}
+}
+
+// toDomain validates the media reference; nil means no media
+func (m *MediaImport) toDomain() (quiz.Media, error) {
+	if m == nil {
+		return quiz.Media{}, nil
+	}
+	return quiz.NewMedia(m.URL, m.AssetKey, m.Width, m.Height, m.Alt)
+}
+
+// validateMedia checks a media reference and, when mediaDir is set, that its asset exists
+func validateMedia(m *MediaImport, mediaDir string) error {
+	media, err := m.toDomain()
+	if err != nil {
+		return err
+	}
+	if mediaDir != "" && media.AssetKey() != "" {
+		if _, err := os.Stat(filepath.Join(mediaDir, filepath.FromSlash(media.AssetKey()))); err != nil {
+			return fmt.Errorf("asset %q not found in %s", media.AssetKey(), mediaDir)
+		}
+	}
+	return nil
+}
+
+// compactQuestionTypes maps the compact type codes to question types
+var compactQuestionTypes = map[string]quiz.QuestionType{
+	"":      quiz.QuestionTypeSingleChoice,
+	"tf":    quiz.QuestionTypeTrueFalse,
+	"multi": quiz.QuestionTypeMultiSelect,
+	"order": quiz.QuestionTypeOrdering,
+	"num":   quiz.QuestionTypeNumeric,
+}
+
+// isTranslationLocale reports whether a translation key is an ISO 639-1 code other than the default locale
+func isTranslationLocale(locale string) bool {
+	return locale != quiz.DefaultLocale && quiz.NormalizeLocale(locale) == locale
+}
+
 // convertCompactToVerbose converts compact format to verbose format
 func convertCompactToVerbose(compact CompactQuiz, batchTags []string) QuizImportData {
 	// Merge tags (batch + quiz, deduplicated)
@@ -189,19 +311,69 @@ func convertCompactToVerbose(compact CompactQuiz, batchTags []string) QuizImport
 			points = *cq.P
 		}
 
+		// Unknown codes are passed through and rejected by validation
+		questionType := cq.Ty
+		if t, ok := compactQuestionTypes[cq.Ty]; ok {
+			questionType = t.String()
+		}
+
 		// Convert answers from index-based to boolean array
 		answers := make([]AnswerImport, len(cq.A))
 		for j, answerText := range cq.A {
 			answers[j] = AnswerImport{
 				Text:      answerText,
-				IsCorrect: j == cq.C, // True if this is the correct index
+				IsCorrect: isCompactCorrect(cq, j),
+			}
+			if j < len(cq.Am) {
+				answers[j].Media = cq.Am[j].toImport()
+			}
+			for locale, tr := range cq.Tr {
+				if j < len(tr.A) && tr.A[j] != "" {
+					if answers[j].Translations == nil {
+						answers[j].Translations = make(map[string]string)
+					}
+					answers[j].Translations[locale] = tr.A[j]
+				}
+			}
+		}
+
+		// An entry without text only translates the answers
+		var questionTranslations map[string]QuestionTranslationImport
+		for locale, tr := range cq.Tr {
+			if tr.T == "" {
+				continue
 			}
+			if questionTranslations == nil {
+				questionTranslations = make(map[string]QuestionTranslationImport, len(cq.Tr))
+			}
+			questionTranslations[locale] = QuestionTranslationImport{Text: tr.T, Explanation: tr.X}
+		}
+
+		var numericAnswer *NumericAnswerImport
+		if cq.N != nil {
+			numericAnswer = &NumericAnswerImport{Value: *cq.N, Tolerance: cq.Nt}
 		}
 
 		questions[i] = QuestionImport{
-			Text:    cq.T,
-			Points:  points,
-			Answers: answers,
+			Type:        questionType,
+			Text:        cq.T,
+			Points:      points,
+			Difficulty:  cq.Df,
+			Explanation: cq.X,
+			SourceURL:   cq.Src,
+			Media:       cq.M.toImport(),
+			Answers:     answers,
+
+			NumericAnswer: numericAnswer,
+			Translations:  questionTranslations,
+		}
+	}
+
+	var quizTranslations map[string]QuizTranslationImport
+	if len(compact.Tr) > 0 {
+		quizTranslations = make(map[string]QuizTranslationImport, len(compact.Tr))
+		for locale, tr := range compact.Tr {
+			quizTranslations[locale] = QuizTranslationImport{Title: tr.T, Description: tr.D}
 		}
 	}
 
@@ -214,6 +386,26 @@ func convertCompactToVerbose(compact CompactQuiz, batchTags []string) QuizImport
 		PassingScore: passingScore,
 		Questions:    questions,
 		Tags:         allTags,
+		Translations: quizTranslations,
+	}
+}
+
+// isCompactCorrect reports whether answer j of a compact question is correct:
+// listed in cs for multi-select, never for ordering (the order is the solution),
+// otherwise the c index
+func isCompactCorrect(cq CompactQuestion, j int) bool {
+	switch cq.Ty {
+	case "multi":
+		for _, c := range cq.Cs {
+			if c == j {
+				return true
+			}
+		}
+		return false
+	case "order":
+		return false
+	default:
+		return j == cq.C
 	}
 }
 
@@ -222,6 +414,7 @@ func main() {
 	filePath := flag.String("file", "", "Path to JSON file to import")
 	dirPath := flag.String("dir", "", "Path to directory with JSON files to import")
 	dryRun := flag.Bool("dry-run", false, "Validate without importing")
+	mediaDir := flag.String("media-dir", os.Getenv("MEDIA_DIR"), "Directory of stored media assets; asset keys must exist in it (skipped if empty)")
 	flag.Parse()
 
 	if *filePath == "" && *dirPath == "" {
@@ -288,7 +481,7 @@ func main() {
 	for _, file := range files {
 		log.Printf("\n--- Processing: %s ---", filepath.Base(file))
 
-		if err := importQuizFromFile(file, db, *dryRun); err != nil {
+		if err := importQuizFromFile(file, db, *dryRun, *mediaDir); err != nil {
 			log.Printf("✗ Error: %v", err)
 			errorCount++
 		} else {
@@ -309,7 +502,7 @@ func main() {
 }
 
 // importQuizFromFile reads and imports a quiz from a JSON file
-func importQuizFromFile(filePath string, db *sql.DB, dryRun bool) error {
+func importQuizFromFile(filePath string, db *sql.DB, dryRun bool, mediaDir string) error {
 	// Read file
 	data, err := os.ReadFile(filePath)
 	if err != nil {
@@ -391,7 +584,7 @@ func importQuizFromFile(filePath string, db *sql.DB, dryRun bool) error {
 		}
 
 		// Validate
-		if err := validateQuizData(&importData); err != nil {
+		if err := validateQuizData(&importData, mediaDir); err != nil {
 			return fmt.Errorf("validation failed for quiz %d: %w", i+1, err)
 		}
 
@@ -418,7 +611,7 @@ func importQuizFromFile(filePath string, db *sql.DB, dryRun bool) error {
 }
 
 // validateQuizData validates the import data structure
-func validateQuizData(data *QuizImportData) error {
+func validateQuizData(data *QuizImportData, mediaDir string) error {
 	if data.Title == "" {
 		return fmt.Errorf("title is required")
 	}
@@ -435,6 +628,15 @@ func validateQuizData(data *QuizImportData) error {
 		return fmt.Errorf("at least one question is required")
 	}
 
+	for locale, tr := range data.Translations {
+		if !isTranslationLocale(locale) {
+			return fmt.Errorf("translation %q: %w", locale, quiz.ErrInvalidLocale)
+		}
+		if _, err := quiz.NewQuizTranslation(tr.Title, tr.Description); err != nil {
+			return fmt.Errorf("translation %q: %w", locale, err)
+		}
+	}
+
 	// Validate each question
 	for i, q := range data.Questions {
 		if q.Text == "" {
@@ -445,26 +647,104 @@ func validateQuizData(data *QuizImportData) error {
 			return fmt.Errorf("question %d: points must be non-negative", i+1)
 		}
 
-		if len(q.Answers) < 2 {
-			return fmt.Errorf("question %d: at least 2 answers required", i+1)
+		if _, err := quiz.NewDifficulty(q.Difficulty); err != nil {
+			return fmt.Errorf("question %d: difficulty must be easy, medium or hard (got %q)", i+1, q.Difficulty)
+		}
+
+		if _, err := quiz.NewExplanation(q.Explanation, q.SourceURL); err != nil {
+			return fmt.Errorf("question %d: explanation: %w", i+1, err)
+		}
+
+		if err := validateMedia(q.Media, mediaDir); err != nil {
+			return fmt.Errorf("question %d: media: %w", i+1, err)
 		}
 
-		// Check that there's exactly one correct answer
-		correctCount := 0
-		for _, a := range q.Answers {
-			if a.IsCorrect {
-				correctCount++
+		for locale, tr := range q.Translations {
+			if !isTranslationLocale(locale) {
+				return fmt.Errorf("question %d: translation %q: %w", i+1, locale, quiz.ErrInvalidLocale)
+			}
+			if _, err := quiz.NewQuestionTranslation(tr.Text, tr.Explanation); err != nil {
+				return fmt.Errorf("question %d: translation %q: %w", i+1, locale, err)
 			}
 		}
 
-		if correctCount != 1 {
-			return fmt.Errorf("question %d: exactly one answer must be correct (found %d)", i+1, correctCount)
+		if err := validateQuestionAnswers(q); err != nil {
+			return fmt.Errorf("question %d: %w", i+1, err)
+		}
+
+		for j, a := range q.Answers {
+			if err := validateMedia(a.Media, mediaDir); err != nil {
+				return fmt.Errorf("question %d, answer %d: media: %w", i+1, j+1, err)
+			}
+			for locale, text := range a.Translations {
+				if !isTranslationLocale(locale) {
+					return fmt.Errorf("question %d, answer %d: translation %q: %w", i+1, j+1, locale, quiz.ErrInvalidLocale)
+				}
+				if _, err := quiz.NewAnswerText(text); err != nil {
+					return fmt.Errorf("question %d, answer %d: translation %q: %w", i+1, j+1, locale, err)
+				}
+			}
 		}
+
 	}
 
 	return nil
 }
 
+// validateQuestionAnswers checks a question's answers fit its type (mirrors
+// quiz.Question.ValidateAnswers, with messages pointing at the problem)
+func validateQuestionAnswers(q QuestionImport) error {
+	questionType, err := quiz.NewQuestionType(q.Type)
+	if err != nil {
+		return fmt.Errorf("type must be single_choice, true_false, multi_select, ordering or numeric (got %q)", q.Type)
+	}
+
+	if questionType == quiz.QuestionTypeNumeric {
+		if q.NumericAnswer == nil {
+			return fmt.Errorf("numericAnswer is required for a numeric question")
+		}
+		if _, err := quiz.NewNumericAnswer(q.NumericAnswer.Value, q.NumericAnswer.Tolerance); err != nil {
+			return fmt.Errorf("numericAnswer: %w", err)
+		}
+		if len(q.Answers) > 0 {
+			return fmt.Errorf("a numeric question has no answers")
+		}
+		return nil
+	}
+
+	if q.NumericAnswer != nil {
+		return fmt.Errorf("numericAnswer is only allowed on a numeric question")
+	}
+	if len(q.Answers) < 2 {
+		return fmt.Errorf("at least 2 answers required")
+	}
+
+	correctCount := 0
+	for _, a := range q.Answers {
+		if a.IsCorrect {
+			correctCount++
+		}
+	}
+
+	switch questionType {
+	case quiz.QuestionTypeTrueFalse:
+		if len(q.Answers) != 2 || correctCount != 1 {
+			return fmt.Errorf("a true/false question needs exactly 2 answers, one correct (found %d, %d correct)", len(q.Answers), correctCount)
+		}
+	case quiz.QuestionTypeMultiSelect:
+		if correctCount == 0 {
+			return fmt.Errorf("at least one answer must be correct")
+		}
+	case quiz.QuestionTypeOrdering:
+		// The answers' order is the solution
+	default:
+		if correctCount != 1 {
+			return fmt.Errorf("exactly one answer must be correct (found %d)", correctCount)
+		}
+	}
+	return nil
+}
+
 // findExistingQuizID checks if a quiz with the same title already exists in the database
 func findExistingQuizID(db *sql.DB, title string) (*quiz.QuizID, error) {
 	var idStr string
@@ -559,6 +839,16 @@ func saveQuizToDB(db *sql.DB, data *QuizImportData, batchID *string, generatedAt
 		return fmt.Errorf("failed to create quiz: %w", err)
 	}
 
+	for locale, tr := range data.Translations {
+		translation, err := quiz.NewQuizTranslation(tr.Title, tr.Description)
+		if err != nil {
+			return fmt.Errorf("invalid %s translation: %w", locale, err)
+		}
+		if err := quizAggregate.SetTranslation(locale, translation); err != nil {
+			return fmt.Errorf("invalid %s translation: %w", locale, err)
+		}
+	}
+
 	// Add tags to quiz
 	if len(data.Tags) > 0 {
 		tags := make([]*quiz.Tag, 0, len(data.Tags))
@@ -603,6 +893,26 @@ func saveQuizToDB(db *sql.DB, data *QuizImportData, batchID *string, generatedAt
 			return fmt.Errorf("invalid points: %w", err)
 		}
 
+		difficulty, err := quiz.NewDifficulty(qData.Difficulty)
+		if err != nil {
+			return fmt.Errorf("invalid difficulty: %w", err)
+		}
+
+		explanation, err := quiz.NewExplanation(qData.Explanation, qData.SourceURL)
+		if err != nil {
+			return fmt.Errorf("invalid explanation: %w", err)
+		}
+
+		questionType, err := quiz.NewQuestionType(qData.Type)
+		if err != nil {
+			return fmt.Errorf("invalid question type: %w", err)
+		}
+
+		media, err := qData.Media.toDomain()
+		if err != nil {
+			return fmt.Errorf("invalid media: %w", err)
+		}
+
 		// Create question with position
 		question, err := quiz.NewQuestion(
 			quiz.NewQuestionID(),
@@ -613,6 +923,27 @@ func saveQuizToDB(db *sql.DB, data *QuizImportData, batchID *string, generatedAt
 		if err != nil {
 			return fmt.Errorf("failed to create question: %w", err)
 		}
+		question.SetDifficulty(difficulty)
+		question.SetExplanation(explanation)
+		question.SetMedia(media)
+		question.SetType(questionType)
+		if qData.NumericAnswer != nil {
+			numericAnswer, err := quiz.NewNumericAnswer(qData.NumericAnswer.Value, qData.NumericAnswer.Tolerance)
+			if err != nil {
+				return fmt.Errorf("invalid numeric answer: %w", err)
+			}
+			question.SetNumericAnswer(numericAnswer)
+		}
+
+		for locale, tr := range qData.Translations {
+			translation, err := quiz.NewQuestionTranslation(tr.Text, tr.Explanation)
+			if err != nil {
+				return fmt.Errorf("invalid %s question translation: %w", locale, err)
+			}
+			if err := question.SetTranslation(locale, translation); err != nil {
+				return fmt.Errorf("invalid %s question translation: %w", locale, err)
+			}
+		}
 
 		// Convert answers and add to question
 		for answerIndex, aData := range qData.Answers {
@@ -631,12 +962,32 @@ func saveQuizToDB(db *sql.DB, data *QuizImportData, batchID *string, generatedAt
 				return fmt.Errorf("failed to create answer: %w", err)
 			}
 
+			answerMedia, err := aData.Media.toDomain()
+			if err != nil {
+				return fmt.Errorf("invalid answer media: %w", err)
+			}
+			answer.SetMedia(answerMedia)
+
+			for locale, text := range aData.Translations {
+				translatedText, err := quiz.NewAnswerText(text)
+				if err != nil {
+					return fmt.Errorf("invalid %s answer translation: %w", locale, err)
+				}
+				if err := answer.SetTranslation(locale, translatedText); err != nil {
+					return fmt.Errorf("invalid %s answer translation: %w", locale, err)
+				}
+			}
+
 			// Add answer to question
 			if err := question.AddAnswer(*answer); err != nil {
 				return fmt.Errorf("failed to add answer to question: %w", err)
 			}
 		}
 
+		if err := question.ValidateAnswers(); err != nil {
+			return fmt.Errorf("invalid answers: %w", err)
+		}
+
 		// Add question to quiz
 		if err := quizAggregate.AddQuestion(*question); err != nil {
 			return fmt.Errorf("failed to add question to quiz: %w", err)
@@ -0,0 +1,43 @@
+package ad_reward
+
+// ========================================
+// IssueAdReward Use Case
+// ========================================
+
+// IssueAdRewardInput is the input DTO for IssueAdReward use case
+type IssueAdRewardInput struct {
+	PlayerID  string `json:"playerId"`
+	Placement string `json:"placement"` // "daily_retry", "streak_recovery", "marathon_continue"
+}
+
+// IssueAdRewardOutput is the output DTO for IssueAdReward use case
+type IssueAdRewardOutput struct {
+	Nonce          string `json:"nonce"` // passed to the ad network as custom data, then to the rewarded action
+	Placement      string `json:"placement"`
+	ExpiresAt      int64  `json:"expiresAt"`      // the ad must be watched before this time
+	RemainingToday int    `json:"remainingToday"` // rewards left for the placement today, including this one
+}
+
+// ========================================
+// RedeemAdReward Use Case
+// ========================================
+
+// Callback query parameters sent by the ad network
+const (
+	CallbackParamNonce         = "nonce"
+	CallbackParamUserID        = "user_id"
+	CallbackParamTransactionID = "transaction_id"
+	CallbackParamSignature     = "signature"
+)
+
+// RedeemAdRewardInput is the ad network's server-to-server reward callback
+type RedeemAdRewardInput struct {
+	Params map[string]string // every query parameter, signature included
+}
+
+// RedeemAdRewardOutput is the output DTO for RedeemAdReward use case
+type RedeemAdRewardOutput struct {
+	Nonce     string
+	PlayerID  string
+	Duplicate bool // the callback was already processed (the network retried it)
+}
@@ -0,0 +1,55 @@
+package ad_reward
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
+
+// ========================================
+// Callback Signatures
+// ========================================
+
+// CallbackSigner signs and verifies ad network reward callbacks.
+// The signature is the hex HMAC-SHA256, keyed with the secret shared with the network,
+// of every other query parameter as "key=value" pairs sorted by key and joined with "&".
+type CallbackSigner struct {
+	secret []byte
+}
+
+// NewCallbackSigner creates a signer keyed with the ad network's callback secret
+func NewCallbackSigner(secret string) *CallbackSigner {
+	return &CallbackSigner{secret: []byte(secret)}
+}
+
+// Sign returns the signature of the callback parameters (any "signature" parameter is ignored)
+func (s *CallbackSigner) Sign(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != CallbackParamSignature {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + params[key]
+	}
+
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(strings.Join(pairs, "&")))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify reports whether the callback carries a valid signature
+func (s *CallbackSigner) Verify(params map[string]string) bool {
+	signature, err := hex.DecodeString(params[CallbackParamSignature])
+	if err != nil || len(signature) == 0 {
+		return false
+	}
+	expected, _ := hex.DecodeString(s.Sign(params))
+	return hmac.Equal(signature, expected)
+}
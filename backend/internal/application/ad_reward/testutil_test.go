@@ -0,0 +1,102 @@
+package ad_reward
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+)
+
+// ========================================
+// Constants
+// ========================================
+
+const (
+	testPlayerID = "player111"
+	testSecret   = "callback-secret"
+)
+
+// ========================================
+// Mock Repositories
+// ========================================
+
+// mockNonceRepo is an in-memory ad_reward.Repository
+type mockNonceRepo struct {
+	nonces map[string]ad_reward.RewardNonce
+}
+
+func newMockNonceRepo() *mockNonceRepo {
+	return &mockNonceRepo{nonces: make(map[string]ad_reward.RewardNonce)}
+}
+
+func (m *mockNonceRepo) Save(nonce *ad_reward.RewardNonce) error {
+	m.nonces[nonce.ID().String()] = *nonce
+	return nil
+}
+
+func (m *mockNonceRepo) FindByID(id ad_reward.NonceID) (*ad_reward.RewardNonce, error) {
+	nonce, ok := m.nonces[id.String()]
+	if !ok {
+		return nil, ad_reward.ErrNonceNotFound
+	}
+	return &nonce, nil
+}
+
+func (m *mockNonceRepo) UpdateFrom(nonce *ad_reward.RewardNonce, loadedStatus ad_reward.NonceStatus) error {
+	stored, ok := m.nonces[nonce.ID().String()]
+	if !ok || stored.Status() != loadedStatus {
+		return ad_reward.ErrNonceStateChanged
+	}
+	m.nonces[nonce.ID().String()] = *nonce
+	return nil
+}
+
+func (m *mockNonceRepo) CountConsumedSince(playerID ad_reward.UserID, placement ad_reward.Placement, since int64) (int, error) {
+	count := 0
+	for _, nonce := range m.nonces {
+		if nonce.PlayerID().Equals(playerID) && nonce.Placement() == placement &&
+			nonce.Status() == ad_reward.NonceConsumed && nonce.ConsumedAt() >= since {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// ========================================
+// Helpers
+// ========================================
+
+// issue hands testPlayerID a nonce for the placement
+func issue(t *testing.T, repo *mockNonceRepo, placement ad_reward.Placement) string {
+	t.Helper()
+	output, err := NewIssueAdRewardUseCase(repo).Execute(IssueAdRewardInput{
+		PlayerID:  testPlayerID,
+		Placement: placement.String(),
+	})
+	if err != nil {
+		t.Fatalf("IssueAdReward: %v", err)
+	}
+	return output.Nonce
+}
+
+// callback builds the ad network's signed callback for the nonce
+func callback(playerID, nonce, transactionID string) RedeemAdRewardInput {
+	params := map[string]string{
+		CallbackParamNonce:         nonce,
+		CallbackParamUserID:        playerID,
+		CallbackParamTransactionID: transactionID,
+		"reward_amount":            "1",
+	}
+	params[CallbackParamSignature] = NewCallbackSigner(testSecret).Sign(params)
+	return RedeemAdRewardInput{Params: params}
+}
+
+// watch issues a nonce and has the ad network confirm it
+func watch(t *testing.T, repo *mockNonceRepo, placement ad_reward.Placement) string {
+	t.Helper()
+	nonce := issue(t, repo, placement)
+	uc := NewRedeemAdRewardUseCase(repo, NewCallbackSigner(testSecret))
+	if _, err := uc.Execute(callback(testPlayerID, nonce, "tx-"+nonce)); err != nil {
+		t.Fatalf("RedeemAdReward: %v", err)
+	}
+	return nonce
+}
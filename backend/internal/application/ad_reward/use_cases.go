@@ -0,0 +1,129 @@
+package ad_reward
+
+import (
+	"errors"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// ========================================
+// IssueAdReward Use Case
+// ========================================
+
+// IssueAdRewardUseCase hands a player a one-time nonce before a rewarded ad is shown
+type IssueAdRewardUseCase struct {
+	nonceRepo ad_reward.Repository
+}
+
+func NewIssueAdRewardUseCase(nonceRepo ad_reward.Repository) *IssueAdRewardUseCase {
+	return &IssueAdRewardUseCase{nonceRepo: nonceRepo}
+}
+
+func (uc *IssueAdRewardUseCase) Execute(input IssueAdRewardInput) (IssueAdRewardOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return IssueAdRewardOutput{}, err
+	}
+
+	placement, err := ad_reward.NewPlacement(input.Placement)
+	if err != nil {
+		return IssueAdRewardOutput{}, err
+	}
+
+	now := time.Now().UTC().Unix()
+
+	// Refuse up front rather than let the player watch an ad that cannot pay out
+	used, err := uc.nonceRepo.CountConsumedSince(playerID, placement, ad_reward.DayStart(now))
+	if err != nil {
+		return IssueAdRewardOutput{}, err
+	}
+	if used >= placement.DailyCap() {
+		return IssueAdRewardOutput{}, ad_reward.ErrDailyCapReached
+	}
+
+	nonce, err := ad_reward.NewRewardNonce(playerID, placement, now)
+	if err != nil {
+		return IssueAdRewardOutput{}, err
+	}
+	if err := uc.nonceRepo.Save(nonce); err != nil {
+		return IssueAdRewardOutput{}, err
+	}
+
+	return IssueAdRewardOutput{
+		Nonce:          nonce.ID().String(),
+		Placement:      placement.String(),
+		ExpiresAt:      nonce.ExpiresAt(),
+		RemainingToday: placement.DailyCap() - used,
+	}, nil
+}
+
+// ========================================
+// RedeemAdReward Use Case
+// ========================================
+
+// RedeemAdRewardUseCase processes the ad network's signed reward callback,
+// marking the nonce as watched so the game can accept it once
+type RedeemAdRewardUseCase struct {
+	nonceRepo ad_reward.Repository
+	signer    *CallbackSigner
+}
+
+func NewRedeemAdRewardUseCase(nonceRepo ad_reward.Repository, signer *CallbackSigner) *RedeemAdRewardUseCase {
+	return &RedeemAdRewardUseCase{
+		nonceRepo: nonceRepo,
+		signer:    signer,
+	}
+}
+
+func (uc *RedeemAdRewardUseCase) Execute(input RedeemAdRewardInput) (RedeemAdRewardOutput, error) {
+	// 1. Only the ad network knows the secret
+	if !uc.signer.Verify(input.Params) {
+		return RedeemAdRewardOutput{}, ad_reward.ErrInvalidCallbackSignature
+	}
+
+	// 2. Validate parameters
+	nonceID, err := ad_reward.NewNonceIDFromString(input.Params[CallbackParamNonce])
+	if err != nil {
+		return RedeemAdRewardOutput{}, err
+	}
+	playerID, err := shared.NewUserID(input.Params[CallbackParamUserID])
+	if err != nil {
+		return RedeemAdRewardOutput{}, err
+	}
+	transactionID := input.Params[CallbackParamTransactionID]
+
+	// 3. Load nonce
+	nonce, err := uc.nonceRepo.FindByID(nonceID)
+	if err != nil {
+		return RedeemAdRewardOutput{}, err
+	}
+
+	output := RedeemAdRewardOutput{
+		Nonce:    nonceID.String(),
+		PlayerID: playerID.String(),
+	}
+
+	// Networks retry callbacks until acknowledged
+	if nonce.IsRedemptionOf(transactionID) {
+		output.Duplicate = true
+		return output, nil
+	}
+
+	// 4. Redeem (domain checks player, state and expiry)
+	loadedStatus := nonce.Status()
+	if err := nonce.Redeem(playerID, transactionID, time.Now().UTC().Unix()); err != nil {
+		return RedeemAdRewardOutput{}, err
+	}
+
+	// 5. Persist unless a concurrent callback got there first
+	if err := uc.nonceRepo.UpdateFrom(nonce, loadedStatus); err != nil {
+		if errors.Is(err, ad_reward.ErrNonceStateChanged) {
+			return RedeemAdRewardOutput{}, ad_reward.ErrNonceAlreadyRedeemed
+		}
+		return RedeemAdRewardOutput{}, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,106 @@
+package ad_reward
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+)
+
+func TestRedeemAdReward_RequiresValidSignature(t *testing.T) {
+	repo := newMockNonceRepo()
+	nonce := issue(t, repo, ad_reward.PlacementDailyRetry)
+	uc := NewRedeemAdRewardUseCase(repo, NewCallbackSigner(testSecret))
+
+	forged := callback(testPlayerID, nonce, "tx-1")
+	forged.Params[CallbackParamSignature] = NewCallbackSigner("guessed").Sign(forged.Params)
+	if _, err := uc.Execute(forged); !errors.Is(err, ad_reward.ErrInvalidCallbackSignature) {
+		t.Fatalf("forged callback = %v, want ErrInvalidCallbackSignature", err)
+	}
+
+	tampered := callback(testPlayerID, nonce, "tx-1")
+	tampered.Params["reward_amount"] = "100"
+	if _, err := uc.Execute(tampered); !errors.Is(err, ad_reward.ErrInvalidCallbackSignature) {
+		t.Fatalf("tampered callback = %v, want ErrInvalidCallbackSignature", err)
+	}
+
+	if _, err := uc.Execute(callback("player222", nonce, "tx-1")); !errors.Is(err, ad_reward.ErrNonceMismatch) {
+		t.Fatalf("callback for another player = %v, want ErrNonceMismatch", err)
+	}
+
+	output, err := uc.Execute(callback(testPlayerID, nonce, "tx-1"))
+	if err != nil || output.Duplicate {
+		t.Fatalf("valid callback = %+v, %v", output, err)
+	}
+
+	// Network retry of the same view is acknowledged, a different view is not
+	if output, err := uc.Execute(callback(testPlayerID, nonce, "tx-1")); err != nil || !output.Duplicate {
+		t.Errorf("retried callback = %+v, %v; want duplicate", output, err)
+	}
+	if _, err := uc.Execute(callback(testPlayerID, nonce, "tx-2")); !errors.Is(err, ad_reward.ErrNonceAlreadyRedeemed) {
+		t.Errorf("second view = %v, want ErrNonceAlreadyRedeemed", err)
+	}
+}
+
+func TestVerifier_ConsumesNonceOnce(t *testing.T) {
+	repo := newMockNonceRepo()
+	verifier := NewVerifier(repo)
+
+	unwatched := issue(t, repo, ad_reward.PlacementDailyRetry)
+	if ok, err := verifier.VerifyAdWatched(testPlayerID, "daily_retry", unwatched); ok || err != nil {
+		t.Errorf("unwatched nonce = %v, %v; want false", ok, err)
+	}
+
+	nonce := watch(t, repo, ad_reward.PlacementDailyRetry)
+
+	tests := []struct {
+		name     string
+		playerID string
+		adType   string
+		nonce    string
+		want     bool
+	}{
+		{"no nonce", testPlayerID, "daily_retry", "", false},
+		{"unknown nonce", testPlayerID, "daily_retry", "00112233445566778899aabbccddeeff", false},
+		{"other player", "player222", "daily_retry", nonce, false},
+		{"other placement", testPlayerID, "marathon_continue", nonce, false},
+		{"watched", testPlayerID, "daily_retry", nonce, true},
+		{"replayed", testPlayerID, "daily_retry", nonce, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := verifier.VerifyAdWatched(tt.playerID, tt.adType, tt.nonce)
+			if err != nil || ok != tt.want {
+				t.Errorf("VerifyAdWatched = %v, %v; want %v", ok, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdReward_DailyCap(t *testing.T) {
+	repo := newMockNonceRepo()
+	verifier := NewVerifier(repo)
+	placement := ad_reward.PlacementStreakRecovery // one per day
+
+	// Both nonces are issued before either is spent, so the cap must hold at consumption too
+	first := watch(t, repo, placement)
+	second := watch(t, repo, placement)
+
+	if ok, err := verifier.VerifyAdWatched(testPlayerID, placement.String(), first); !ok || err != nil {
+		t.Fatalf("first reward = %v, %v", ok, err)
+	}
+	if _, err := verifier.VerifyAdWatched(testPlayerID, placement.String(), second); !errors.Is(err, ad_reward.ErrDailyCapReached) {
+		t.Errorf("second reward = %v, want ErrDailyCapReached", err)
+	}
+
+	_, err := NewIssueAdRewardUseCase(repo).Execute(IssueAdRewardInput{PlayerID: testPlayerID, Placement: placement.String()})
+	if !errors.Is(err, ad_reward.ErrDailyCapReached) {
+		t.Errorf("issue after cap = %v, want ErrDailyCapReached", err)
+	}
+
+	// Other placements have their own caps
+	output, err := NewIssueAdRewardUseCase(repo).Execute(IssueAdRewardInput{PlayerID: testPlayerID, Placement: "daily_retry"})
+	if err != nil || output.RemainingToday != ad_reward.PlacementDailyRetry.DailyCap() {
+		t.Errorf("issue daily_retry = %+v, %v", output, err)
+	}
+}
@@ -0,0 +1,76 @@
+package ad_reward
+
+import (
+	"errors"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// ========================================
+// Ad Verification
+// ========================================
+
+// Verifier checks that a player watched a rewarded ad, spending the nonce.
+// It implements the AdVerificationService ports of the daily challenge and marathon.
+type Verifier struct {
+	nonceRepo ad_reward.Repository
+}
+
+func NewVerifier(nonceRepo ad_reward.Repository) *Verifier {
+	return &Verifier{nonceRepo: nonceRepo}
+}
+
+// VerifyAdWatched consumes the nonce for the placement named by adType.
+// Returns false if the nonce is unknown, unconfirmed by the ad network, already used,
+// expired, or issued to another player or placement.
+// Returns ad_reward.ErrDailyCapReached once the player used up the placement's rewards for the day.
+func (v *Verifier) VerifyAdWatched(playerID string, adType string, nonce string) (bool, error) {
+	uid, err := shared.NewUserID(playerID)
+	if err != nil {
+		return false, err
+	}
+	placement, err := ad_reward.NewPlacement(adType)
+	if err != nil {
+		return false, err
+	}
+	nonceID, err := ad_reward.NewNonceIDFromString(nonce)
+	if err != nil {
+		return false, nil
+	}
+
+	reward, err := v.nonceRepo.FindByID(nonceID)
+	if errors.Is(err, ad_reward.ErrNonceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now().UTC().Unix()
+
+	// Several nonces may be issued before any is spent, so the cap is enforced here too
+	used, err := v.nonceRepo.CountConsumedSince(uid, placement, ad_reward.DayStart(now))
+	if err != nil {
+		return false, err
+	}
+	if used >= placement.DailyCap() {
+		return false, ad_reward.ErrDailyCapReached
+	}
+
+	loadedStatus := reward.Status()
+	if err := reward.Consume(uid, placement, now); err != nil {
+		return false, nil
+	}
+
+	// A concurrent request spending the same nonce loses here
+	if err := v.nonceRepo.UpdateFrom(reward, loadedStatus); err != nil {
+		if errors.Is(err, ad_reward.ErrNonceStateChanged) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -1,14 +1,8 @@
 package daily_challenge
 
 // AdVerificationService defines the interface for verifying ad views before granting free retries.
+// The nonce is the one-time reward token the client received before showing the ad; verifying
+// spends it, so each watched ad pays out once.
 type AdVerificationService interface {
-	VerifyAdWatched(playerID string, adType string) (bool, error)
-}
-
-// NoopAdVerificationService is a stub until real ad network integration is wired.
-// It always reports the ad as watched.
-type NoopAdVerificationService struct{}
-
-func (s *NoopAdVerificationService) VerifyAdWatched(playerID string, adType string) (bool, error) {
-	return true, nil
+	VerifyAdWatched(playerID string, adType string, nonce string) (bool, error)
 }
@@ -1,5 +1,7 @@
 package daily_challenge
 
+import appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+
 // ========================================
 // Common DTOs
 // ========================================
@@ -36,7 +38,9 @@ type DailyGameDTO struct {
 // QuestionDTO represents a quiz question (reused from quiz domain)
 type QuestionDTO struct {
 	ID       string      `json:"id"`
+	Type     string      `json:"type"` // see quiz.QuestionDTO
 	Text     string      `json:"text"`
+	Media    *MediaDTO   `json:"media,omitempty"`
 	Answers  []AnswerDTO `json:"answers"`
 	Points   int         `json:"points"`
 	Position int         `json:"position"`
@@ -44,11 +48,15 @@ type QuestionDTO struct {
 
 // AnswerDTO represents an answer option (NO IsCorrect field!)
 type AnswerDTO struct {
-	ID       string `json:"id"`
-	Text     string `json:"text"`
-	Position int    `json:"position"`
+	ID       string    `json:"id"`
+	Text     string    `json:"text"`
+	Media    *MediaDTO `json:"media,omitempty"`
+	Position int       `json:"position"`
 }
 
+// MediaDTO is an image on a question or answer (same shape in every mode)
+type MediaDTO = appQuiz.MediaDTO
+
 // StreakDTO represents daily streak information
 type StreakDTO struct {
 	CurrentStreak  int    `json:"currentStreak"`
@@ -72,6 +80,7 @@ type LeaderboardEntryDTO struct {
 // AnsweredQuestionDTO shows the answer after game completion
 type AnsweredQuestionDTO struct {
 	QuestionID       string   `json:"questionId"`
+	QuestionType     string   `json:"questionType"`
 	QuestionText     string   `json:"questionText"`
 	PlayerAnswerID   string   `json:"playerAnswerId"`
 	PlayerAnswerText string   `json:"playerAnswerText"`
@@ -80,6 +89,18 @@ type AnsweredQuestionDTO struct {
 	IsCorrect        bool     `json:"isCorrect"`
 	TimeTaken        int64    `json:"timeTaken"` // Milliseconds
 	PointsEarned     int      `json:"pointsEarned"`
+	Explanation      *ExplanationDTO `json:"explanation,omitempty"`
+	// Answers of the other question types (the ID and text fields above are empty for them)
+	PlayerAnswerIDs  []string `json:"playerAnswerIds,omitempty"`  // multi-select, ordering
+	PlayerNumber     *float64 `json:"playerNumber,omitempty"`     // numeric
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`    // numeric
+}
+
+// ExplanationDTO is the rationale of a question, shown with the results
+type ExplanationDTO struct {
+	Text      string `json:"text"`
+	SourceURL string `json:"sourceUrl,omitempty"`
 }
 
 // ChestRewardDTO represents chest rewards earned from Daily Challenge
@@ -111,6 +132,7 @@ type GetOrCreateDailyQuizOutput struct {
 type StartDailyChallengeInput struct {
 	PlayerID string `json:"playerId"`
 	Date     string `json:"date,omitempty"` // Optional, defaults to today UTC
+	Locale   string `json:"-"`              // caller's content language, see quiz.DefaultLocale
 }
 
 type StartDailyChallengeOutput struct {
@@ -131,6 +153,10 @@ type SubmitDailyAnswerInput struct {
 	AnswerID   string `json:"answerId"`
 	PlayerID   string `json:"playerId"` // For authorization
 	TimeTaken  int64  `json:"timeTaken"` // Milliseconds
+	Locale     string `json:"-"`         // caller's content language, see quiz.DefaultLocale
+	// Multi-select (picked options) and ordering (every option, in order) send AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
 }
 
 type SubmitDailyAnswerOutput struct {
@@ -143,6 +169,9 @@ type SubmitDailyAnswerOutput struct {
 	NextQuestion       *QuestionDTO      `json:"nextQuestion,omitempty"` // If game continues
 	NextTimeLimit      *int              `json:"nextTimeLimit,omitempty"` // Always 15 if next question exists
 	GameResults        *GameResultsDTO   `json:"gameResults,omitempty"` // If game completed
+	// Instant feedback for the other question types (CorrectAnswerID is empty for them)
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`    // numeric
 }
 
 // GameResultsDTO contains final results after completing all 10 questions
@@ -172,6 +201,7 @@ type GameResultsDTO struct {
 type GetDailyGameStatusInput struct {
 	PlayerID string `json:"playerId"`
 	Date     string `json:"date,omitempty"` // Optional, defaults to today
+	Locale   string `json:"-"`              // caller's content language, see quiz.DefaultLocale
 }
 
 // RetryCostDTO represents the cost options for retrying a daily challenge
@@ -247,9 +277,12 @@ type OpenChestOutput struct {
 // ========================================
 
 type RetryChallengeInput struct {
-	GameID        string `json:"gameId"` // Original game ID
-	PlayerID      string `json:"playerId"` // For authorization
-	PaymentMethod string `json:"paymentMethod"` // "coins" or "ad"
+	GameID         string `json:"gameId"`        // Original game ID
+	PlayerID       string `json:"playerId"`      // For authorization
+	PaymentMethod  string `json:"paymentMethod"` // "coins" or "ad"
+	AdNonce        string `json:"adNonce"`       // reward nonce of the watched ad ("ad" only)
+	IdempotencyKey string `json:"-"`             // optional; a retried request with the same key is charged once
+	Locale         string `json:"-"`             // caller's content language, see quiz.DefaultLocale
 }
 
 type RetryChallengeOutput struct {
@@ -1,9 +1,60 @@
 package daily_challenge
 
-import "github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+)
 
 // EventBus defines the interface for publishing domain events
 // Implementation is in infrastructure layer
 type EventBus interface {
 	Publish(event daily_challenge.Event)
 }
+
+// TxManager provides database transaction support
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// EventOutbox stores domain events in the transaction that saves their aggregate.
+// An outbox relay delivers them to durable subscribers after commit (at-least-once),
+// so a crash right after the save can no longer lose e.g. a ChestEarnedEvent.
+// Implementation is in infrastructure layer
+type EventOutbox interface {
+	AppendInTx(tx *sql.Tx, events ...daily_challenge.Event) error
+}
+
+// gameWriter saves a daily game together with its pending events.
+// Without an outbox the game is saved and the events go straight to the event bus.
+type gameWriter struct {
+	dailyGameRepo daily_challenge.DailyGameRepository
+	eventBus      EventBus
+	txManager     TxManager   // optional, set with the use case's WithOutbox
+	outbox        EventOutbox // optional, set with the use case's WithOutbox
+}
+
+func (w gameWriter) save(game *daily_challenge.DailyGame) error {
+	events := game.Events()
+
+	if w.txManager != nil && w.outbox != nil {
+		err := w.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+			if err := w.dailyGameRepo.SaveInTx(tx, game); err != nil {
+				return err
+			}
+			return w.outbox.AppendInTx(tx, events...)
+		})
+		if err != nil {
+			return err
+		}
+	} else if err := w.dailyGameRepo.Save(game); err != nil {
+		return err
+	}
+
+	// In-process listeners (logging, live updates) get the events once they are stored
+	for _, event := range events {
+		w.eventBus.Publish(event)
+	}
+	return nil
+}
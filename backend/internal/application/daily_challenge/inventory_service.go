@@ -6,4 +6,23 @@ type InventoryService interface {
 	GetCoins(playerID string) (int, error)
 	Credit(playerID string, source string, details map[string]int) error
 	Debit(playerID string, source string, details map[string]int) error
+	// CreditOnce and DebitOnce apply at most once per idempotency key; a repeated call is a no-op
+	CreditOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+	DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+}
+
+// retryDebitKey scopes a client idempotency key to the retry payment ("" when the client sent none)
+func retryDebitKey(idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return "daily_retry:" + idempotencyKey
+}
+
+// streakRecoveryDebitKey scopes a client idempotency key to the streak recovery payment ("" when the client sent none)
+func streakRecoveryDebitKey(idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return "streak_recovery:" + idempotencyKey
 }
@@ -3,6 +3,7 @@ package daily_challenge
 import (
 	"fmt"
 
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/kernel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
@@ -28,8 +29,8 @@ func ToDailyQuizDTO(dailyQuiz *daily_challenge.DailyQuiz) DailyQuizDTO {
 	}
 }
 
-// ToDailyGameDTO converts domain DailyGame to DTO
-func ToDailyGameDTO(game *daily_challenge.DailyGame, now int64) DailyGameDTO {
+// ToDailyGameDTO converts domain DailyGame to DTO, with the current question in the given locale
+func ToDailyGameDTO(game *daily_challenge.DailyGame, now int64, locale string) DailyGameDTO {
 	session := game.Session()
 	streak := game.Streak()
 
@@ -39,7 +40,7 @@ func ToDailyGameDTO(game *daily_challenge.DailyGame, now int64) DailyGameDTO {
 	var questionTimeRemaining *int
 	if game.Status() == daily_challenge.GameStatusInProgress {
 		if q, err := session.GetCurrentQuestion(); err == nil {
-			questionDTO := ToQuestionDTO(q)
+			questionDTO := ToQuestionDTO(q.Localized(locale))
 			currentQuestion = &questionDTO
 		}
 		questionIndex = session.CurrentQuestionIndex()
@@ -106,17 +107,20 @@ func ToStreakDTO(streak daily_challenge.StreakSystem, today daily_challenge.Date
 // ToQuestionDTO converts domain Question to DTO (WITHOUT IsCorrect on answers)
 func ToQuestionDTO(question *quiz.Question) QuestionDTO {
 	answers := make([]AnswerDTO, len(question.Answers()))
-	for i, answer := range question.Answers() {
+	for i, answer := range question.DisplayAnswers() {
 		answers[i] = AnswerDTO{
 			ID:       answer.ID().String(),
 			Text:     answer.Text().String(),
+			Media:    appQuiz.ToMediaDTO(answer.Media()),
 			Position: answer.Position(),
 		}
 	}
 
 	return QuestionDTO{
 		ID:       question.ID().String(),
+		Type:     question.Type().String(),
 		Text:     question.Text().String(),
+		Media:    appQuiz.ToMediaDTO(question.Media()),
 		Answers:  answers,
 		Points:   question.Points().Value(),
 		Position: question.Position(),
@@ -124,16 +128,17 @@ func ToQuestionDTO(question *quiz.Question) QuestionDTO {
 }
 
 // ToAnsweredQuestionDTO converts session answer history to DTO (WITH correctness feedback)
-// quizAggregate is needed to calculate time bonus (same logic as kernel.AnswerQuestion)
+// pointsEarned comes from the session (see kernel.QuizGameplaySession.PointsEarned)
 func ToAnsweredQuestionDTO(
 	question *quiz.Question,
 	answerData kernel.AnswerData,
-	quizAggregate *quiz.Quiz,
+	pointsEarned int,
 ) AnsweredQuestionDTO {
-	// Find correct answer and player answer text
+	// Find correct answer and player answer text (single choice and true/false)
+	correctID := question.CorrectAnswerID()
 	var correctAnswerID, correctAnswerText, playerAnswerText string
 	for _, answer := range question.Answers() {
-		if answer.IsCorrect() {
+		if !correctID.IsZero() && answer.ID().Equals(correctID) {
 			correctAnswerID = answer.ID().String()
 			correctAnswerText = answer.Text().String()
 		}
@@ -142,29 +147,9 @@ func ToAnsweredQuestionDTO(
 		}
 	}
 
-	// Calculate points earned (base + time bonus, same logic as quiz_gameplay_session.go)
-	pointsEarned := 0
-	if answerData.IsCorrect() {
-		// Base points: use question-level, fallback to quiz-level
-		basePoints := question.Points().Value()
-		if basePoints == 0 {
-			basePoints = quizAggregate.BasePoints().Value()
-		}
-
-		// Time bonus: max(0, (timeLimit - timeTaken) * maxTimeBonus / timeLimit)
-		timeBonus := 0
-		timeTaken := answerData.TimeTaken()
-		timeLimitMs := int64(quizAggregate.TimeLimitPerQuestion()) * 1000
-		if timeTaken > 0 && timeTaken < timeLimitMs && quizAggregate.MaxTimeBonus().Value() > 0 {
-			remaining := timeLimitMs - timeTaken
-			timeBonus = int(float64(quizAggregate.MaxTimeBonus().Value()) * float64(remaining) / float64(timeLimitMs))
-		}
-
-		pointsEarned = basePoints + timeBonus
-	}
-
-	return AnsweredQuestionDTO{
+	dto := AnsweredQuestionDTO{
 		QuestionID:        question.ID().String(),
+		QuestionType:      question.Type().String(),
 		QuestionText:      question.Text().String(),
 		PlayerAnswerID:    answerData.AnswerID().String(),
 		PlayerAnswerText:  playerAnswerText,
@@ -173,6 +158,25 @@ func ToAnsweredQuestionDTO(
 		IsCorrect:         answerData.IsCorrect(),
 		TimeTaken:         answerData.TimeTaken(),
 		PointsEarned:      pointsEarned,
+		Explanation:       ToExplanationDTO(question),
+		PlayerAnswerIDs:   appQuiz.SubmissionAnswerIDs(answerData.Submission()),
+		CorrectAnswerIDs:  appQuiz.CorrectAnswerIDsOf(question),
+		CorrectNumber:     appQuiz.CorrectNumberOf(question),
+	}
+	if number, ok := answerData.Submission().Number(); ok {
+		dto.PlayerNumber = &number
+	}
+	return dto
+}
+
+// ToExplanationDTO returns the question's explanation, or nil when it has none
+func ToExplanationDTO(question *quiz.Question) *ExplanationDTO {
+	if question.Explanation().IsEmpty() {
+		return nil
+	}
+	return &ExplanationDTO{
+		Text:      question.Explanation().Text(),
+		SourceURL: question.Explanation().SourceURL(),
 	}
 }
 
@@ -204,22 +208,24 @@ func ToChestRewardDTO(reward daily_challenge.ChestReward) ChestRewardDTO {
 	}
 }
 
-// BuildGameResultsDTO creates game results with full answer breakdown
+// BuildGameResultsDTO creates game results with full answer breakdown in the given locale
 func BuildGameResultsDTO(
 	game *daily_challenge.DailyGame,
 	rank int,
 	totalPlayers int,
 	leaderboard []LeaderboardEntryDTO,
+	locale string,
 ) GameResultsDTO {
 	session := game.Session()
 	streak := game.Streak()
+	quizContent := session.Quiz().Localized(locale)
 
 	// Get all answered questions with correctness
 	answeredQuestions := make([]AnsweredQuestionDTO, 0)
-	for i := 0; i < session.Quiz().QuestionsCount(); i++ {
-		if question, err := session.Quiz().GetQuestionByIndex(i); err == nil {
+	for i := 0; i < quizContent.QuestionsCount(); i++ {
+		if question, err := quizContent.GetQuestionByIndex(i); err == nil {
 			if answerData, exists := session.GetAnswer(question.ID()); exists {
-				answeredQuestions = append(answeredQuestions, ToAnsweredQuestionDTO(question, answerData, session.Quiz()))
+				answeredQuestions = append(answeredQuestions, ToAnsweredQuestionDTO(question, answerData, session.PointsEarned(question.ID()).Value()))
 			}
 		}
 	}
@@ -1,6 +1,7 @@
 package daily_challenge
 
 import (
+	"errors"
 	"time"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
@@ -8,8 +9,10 @@ import (
 )
 
 type RecoverStreakInput struct {
-	PlayerID      string
-	PaymentMethod string // "coins" or "ad"
+	PlayerID       string
+	PaymentMethod  string // "coins" or "ad"
+	AdNonce        string // reward nonce of the watched ad ("ad" only)
+	IdempotencyKey string // optional; a retried request with the same key is charged once
 }
 
 type RecoverStreakOutput struct {
@@ -22,8 +25,9 @@ type RecoverStreakOutput struct {
 const streakRecoveryCostCoins = 50
 
 type RecoverStreakUseCase struct {
-	dailyGameRepo    daily_challenge.DailyGameRepository
-	inventoryService InventoryService
+	dailyGameRepo     daily_challenge.DailyGameRepository
+	inventoryService  InventoryService
+	adVerificationSvc AdVerificationService // optional, nil-guarded
 }
 
 func NewRecoverStreakUseCase(
@@ -36,6 +40,12 @@ func NewRecoverStreakUseCase(
 	}
 }
 
+// WithAdVerification sets the service that checks the rewarded ad of the "ad" payment method
+func (uc *RecoverStreakUseCase) WithAdVerification(svc AdVerificationService) *RecoverStreakUseCase {
+	uc.adVerificationSvc = svc
+	return uc
+}
+
 func (uc *RecoverStreakUseCase) Execute(input RecoverStreakInput) (RecoverStreakOutput, error) {
 	playerID, err := shared.NewUserID(input.PlayerID)
 	if err != nil {
@@ -47,13 +57,19 @@ func (uc *RecoverStreakUseCase) Execute(input RecoverStreakInput) (RecoverStreak
 	dayBeforeYesterday := yesterday.Previous()
 
 	// 1. Check player hasn't already played today
-	todayGame, _ := uc.dailyGameRepo.FindByPlayerAndDate(playerID, today)
+	todayGame, err := uc.dailyGameRepo.FindByPlayerAndDate(playerID, today)
+	if err != nil && !errors.Is(err, daily_challenge.ErrGameNotFound) {
+		return RecoverStreakOutput{}, err
+	}
 	if todayGame != nil {
 		return RecoverStreakOutput{}, daily_challenge.ErrAlreadyPlayedToday
 	}
 
 	// 2. Check player did NOT play yesterday (that's the missed day)
-	yesterdayGame, _ := uc.dailyGameRepo.FindByPlayerAndDate(playerID, yesterday)
+	yesterdayGame, err := uc.dailyGameRepo.FindByPlayerAndDate(playerID, yesterday)
+	if err != nil && !errors.Is(err, daily_challenge.ErrGameNotFound) {
+		return RecoverStreakOutput{}, err
+	}
 	if yesterdayGame != nil {
 		// Played yesterday — streak is not broken, nothing to recover
 		return RecoverStreakOutput{}, daily_challenge.ErrStreakNotRecoverable
@@ -72,18 +88,31 @@ func (uc *RecoverStreakUseCase) Execute(input RecoverStreakInput) (RecoverStreak
 		return RecoverStreakOutput{}, daily_challenge.ErrStreakNotRecoverable
 	}
 
-	// 5. Process payment
+	// 5. Process payment. Every check above runs first, and a lookup error is not read
+	// as "no game", so a recovery that cannot happen spends neither coins nor the ad nonce
 	coinsDeducted := 0
-	if input.PaymentMethod == "coins" {
+	switch input.PaymentMethod {
+	case "coins":
 		coinsDeducted = streakRecoveryCostCoins
 		if uc.inventoryService != nil {
-			err := uc.inventoryService.Debit(input.PlayerID, "streak_recovery", map[string]int{"coins": coinsDeducted})
+			err := uc.inventoryService.DebitOnce(input.PlayerID, streakRecoveryDebitKey(input.IdempotencyKey), "streak_recovery", map[string]int{"coins": coinsDeducted})
 			if err != nil {
 				return RecoverStreakOutput{}, err
 			}
 		}
+	case "ad":
+		if uc.adVerificationSvc != nil {
+			watched, err := uc.adVerificationSvc.VerifyAdWatched(input.PlayerID, "streak_recovery", input.AdNonce)
+			if err != nil {
+				return RecoverStreakOutput{}, err
+			}
+			if !watched {
+				return RecoverStreakOutput{}, daily_challenge.ErrAdNotVerified
+			}
+		}
+	default:
+		return RecoverStreakOutput{}, daily_challenge.ErrInvalidPaymentMethod
 	}
-	// "ad" payment: free (ad verification TODO)
 
 	// 6. Recover streak by updating the previous game's lastPlayedDate to yesterday.
 	// This way when StartDailyChallenge runs today, UpdateForDate(today) sees
@@ -1,6 +1,8 @@
 package daily_challenge
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -74,6 +76,10 @@ func (m *MockDailyGameRepository) Save(game *daily_challenge.DailyGame) error {
 	return nil
 }
 
+func (m *MockDailyGameRepository) SaveInTx(_ *sql.Tx, game *daily_challenge.DailyGame) error {
+	return m.Save(game)
+}
+
 func (m *MockDailyGameRepository) FindByID(id daily_challenge.GameID) (*daily_challenge.DailyGame, error) {
 	if g, ok := m.games[id.String()]; ok {
 		return g, nil
@@ -265,6 +271,10 @@ func (m *MockQuestionRepository) SaveAll(questions []*quiz.Question) error {
 	return nil
 }
 
+func (m *MockQuestionRepository) SaveInTx(_ *sql.Tx, question *quiz.Question) error {
+	return m.Save(question)
+}
+
 func (m *MockQuestionRepository) Delete(id quiz.QuestionID) error {
 	delete(m.questions, id.String())
 	return nil
@@ -305,6 +315,10 @@ func (m *MockQuizRepository) Save(q *quiz.Quiz) error {
 	return nil
 }
 
+func (m *MockQuizRepository) SaveInTx(_ *sql.Tx, q *quiz.Quiz) error {
+	return m.Save(q)
+}
+
 func (m *MockQuizRepository) Delete(id quiz.QuizID) error {
 	delete(m.quizzes, id.String())
 	return nil
@@ -369,6 +383,46 @@ func (m *MockEventBus) Publish(event daily_challenge.Event) {
 	m.Events = append(m.Events, event)
 }
 
+// MockTxManager runs the function without a real transaction
+type MockTxManager struct{}
+
+func (m *MockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	return fn(nil)
+}
+
+// MockEventOutbox collects events appended to the outbox
+type MockEventOutbox struct {
+	Events []daily_challenge.Event
+	Err    error // returned by AppendInTx when set
+}
+
+func (m *MockEventOutbox) AppendInTx(_ *sql.Tx, events ...daily_challenge.Event) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Events = append(m.Events, events...)
+	return nil
+}
+
+// MockAdVerifier accepts each of its watched nonces once
+type MockAdVerifier struct {
+	Watched map[string]bool // nonce -> not yet used
+	AdTypes []string        // adType of every verification
+	Err     error           // returned by VerifyAdWatched when set
+}
+
+func (m *MockAdVerifier) VerifyAdWatched(_ string, adType string, nonce string) (bool, error) {
+	m.AdTypes = append(m.AdTypes, adType)
+	if m.Err != nil {
+		return false, m.Err
+	}
+	if !m.Watched[nonce] {
+		return false, nil
+	}
+	m.Watched[nonce] = false
+	return true, nil
+}
+
 // ========================================
 // Test Helpers
 // ========================================
@@ -506,7 +560,7 @@ func newCompletedGame(t *testing.T, playerID string, date daily_challenge.Date,
 	quizQuestions := quizAgg.Questions()
 	for i, q := range quizQuestions {
 		correctAnswer := q.Answers()[0]
-		_, err := game.AnswerQuestion(q.ID(), correctAnswer.ID(), 2000, now+int64((i+1)*2000))
+		_, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(correctAnswer.ID()), 2000, now+int64((i+1)*2000))
 		if err != nil {
 			t.Fatalf("Failed to answer question %d: %v", i, err)
 		}
@@ -3,6 +3,7 @@ package daily_challenge
 import (
 	"time"
 
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
@@ -20,6 +21,8 @@ type StartDailyChallengeUseCase struct {
 	quizRepo          quiz.QuizRepository
 	eventBus          EventBus
 	getOrCreateQuizUC *GetOrCreateDailyQuizUseCase
+	txManager         TxManager   // optional, see WithOutbox
+	outbox            EventOutbox // optional, see WithOutbox
 }
 
 func NewStartDailyChallengeUseCase(
@@ -40,6 +43,13 @@ func NewStartDailyChallengeUseCase(
 	}
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *StartDailyChallengeUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *StartDailyChallengeUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 func (uc *StartDailyChallengeUseCase) Execute(input StartDailyChallengeInput) (StartDailyChallengeOutput, error) {
 	// 1. Determine date
 	var date daily_challenge.Date
@@ -158,20 +168,14 @@ func (uc *StartDailyChallengeUseCase) Execute(input StartDailyChallengeInput) (S
 
 	println("✅ [StartDailyChallenge] Created daily game, saving...")
 
-	// 7. Save game
-	if err := uc.dailyGameRepo.Save(game); err != nil {
+	// 7-8. Save game and publish events
+	writer := gameWriter{uc.dailyGameRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(game); err != nil {
 		println("❌ [StartDailyChallenge] Failed to save game:", err.Error())
 		return StartDailyChallengeOutput{}, err
 	}
 
-	println("✅ [StartDailyChallenge] Saved game, publishing events...")
-
-	// 8. Publish events
-	for _, event := range game.Events() {
-		uc.eventBus.Publish(event)
-	}
-
-	println("✅ [StartDailyChallenge] Published events, getting first question...")
+	println("✅ [StartDailyChallenge] Saved game and published events, getting first question...")
 
 	// 9. Get first question
 	firstQuestion, err := game.Session().GetCurrentQuestion()
@@ -186,8 +190,8 @@ func (uc *StartDailyChallengeUseCase) Execute(input StartDailyChallengeInput) (S
 	timeToExpire := dailyQuizEntity.ExpiresAt() - now
 
 	return StartDailyChallengeOutput{
-		Game:          ToDailyGameDTO(game, now),
-		FirstQuestion: ToQuestionDTO(firstQuestion),
+		Game:          ToDailyGameDTO(game, now, input.Locale),
+		FirstQuestion: ToQuestionDTO(firstQuestion.Localized(input.Locale)),
 		TimeLimit:     15, // Fixed: 15 seconds per question
 		TotalPlayers:  quizOutput.TotalPlayers,
 		TimeToExpire:  timeToExpire,
@@ -203,6 +207,8 @@ type SubmitDailyAnswerUseCase struct {
 	eventBus            EventBus
 	getLeaderboardUC    *GetDailyLeaderboardUseCase
 	chestRewardCalc     *daily_challenge.ChestRewardCalculator
+	txManager           TxManager   // optional, see WithOutbox
+	outbox              EventOutbox // optional, see WithOutbox
 }
 
 func NewSubmitDailyAnswerUseCase(
@@ -219,6 +225,13 @@ func NewSubmitDailyAnswerUseCase(
 	}
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *SubmitDailyAnswerUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *SubmitDailyAnswerUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (SubmitDailyAnswerOutput, error) {
 	now := time.Now().UTC().Unix()
 
@@ -244,9 +257,12 @@ func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (Submi
 
 	// 3. Answer question
 	questionID, _ := quiz.NewQuestionIDFromString(input.QuestionID)
-	answerID, _ := quiz.NewAnswerIDFromString(input.AnswerID)
+	submission, err := appQuiz.ParseAnswerSubmission(input.AnswerID, input.AnswerIDs, input.NumericAnswer)
+	if err != nil {
+		return SubmitDailyAnswerOutput{}, err
+	}
 
-	result, err := game.AnswerQuestion(questionID, answerID, input.TimeTaken, now)
+	result, err := game.AnswerQuestion(questionID, submission, input.TimeTaken, now)
 	if err != nil {
 		println("❌ [SubmitDailyAnswer] Failed to answer question:", err.Error())
 		return SubmitDailyAnswerOutput{}, err
@@ -260,18 +276,14 @@ func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (Submi
 
 	println("📝 [SubmitDailyAnswer] Saving game...")
 
-	// 4. Save game
-	if err := uc.dailyGameRepo.Save(game); err != nil {
+	// 4-5. Save game and publish events
+	writer := gameWriter{uc.dailyGameRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(game); err != nil {
 		println("❌ [SubmitDailyAnswer] Failed to save game:", err.Error())
 		return SubmitDailyAnswerOutput{}, err
 	}
 
-	println("✅ [SubmitDailyAnswer] Game saved, publishing events...")
-
-	// 5. Publish events
-	for _, event := range game.Events() {
-		uc.eventBus.Publish(event)
-	}
+	println("✅ [SubmitDailyAnswer] Game saved and events published")
 
 	// 6. Build output (with instant feedback)
 	output := SubmitDailyAnswerOutput{
@@ -282,11 +294,15 @@ func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (Submi
 		IsCorrect:          result.IsCorrect,
 		CorrectAnswerID:    result.CorrectAnswerID,
 	}
+	if answered, err := game.Session().Quiz().GetQuestion(questionID); err == nil {
+		output.CorrectAnswerIDs = appQuiz.CorrectAnswerIDsOf(answered)
+		output.CorrectNumber = appQuiz.CorrectNumberOf(answered)
+	}
 
 	// 7. If game continues, return next question
 	if !result.IsGameCompleted {
 		if nextQ, err := game.Session().GetCurrentQuestion(); err == nil {
-			questionDTO := ToQuestionDTO(nextQ)
+			questionDTO := ToQuestionDTO(nextQ.Localized(input.Locale))
 			timeLimit := 15
 			output.NextQuestion = &questionDTO
 			output.NextTimeLimit = &timeLimit
@@ -318,11 +334,11 @@ func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (Submi
 		game.EmitChestEarnedEvent(chestReward, now)
 
 		game.SetRank(rank)
-		uc.dailyGameRepo.Save(game) // Update with rank and chest reward
 
-		// Publish events (including ChestEarnedEvent)
-		for _, event := range game.Events() {
-			uc.eventBus.Publish(event)
+		// Update with rank and chest reward; the ChestEarnedEvent is stored with it
+		if err := writer.save(game); err != nil {
+			println("❌ [SubmitDailyAnswer] Failed to save chest reward:", err.Error())
+			return SubmitDailyAnswerOutput{}, err
 		}
 
 		// Fetch leaderboard
@@ -334,7 +350,7 @@ func (uc *SubmitDailyAnswerUseCase) Execute(input SubmitDailyAnswerInput) (Submi
 			leaderboardEntries = leaderboard.Entries
 		}
 
-		results := BuildGameResultsDTO(game, rank, totalPlayers, leaderboardEntries)
+		results := BuildGameResultsDTO(game, rank, totalPlayers, leaderboardEntries, input.Locale)
 		output.GameResults = &results
 	}
 
@@ -404,7 +420,7 @@ func (uc *GetDailyGameStatusUseCase) Execute(input GetDailyGameStatusInput) (Get
 		}, nil
 	}
 
-	gameDTO := ToDailyGameDTO(game, now)
+	gameDTO := ToDailyGameDTO(game, now, input.Locale)
 	var timeLimit *int
 	var timeRemaining *int
 	var results *GameResultsDTO
@@ -441,7 +457,7 @@ func (uc *GetDailyGameStatusUseCase) Execute(input GetDailyGameStatusInput) (Get
 			leaderboardEntries = leaderboard.Entries
 		}
 
-		gameResults := BuildGameResultsDTO(game, rank, totalPlayers, leaderboardEntries)
+		gameResults := BuildGameResultsDTO(game, rank, totalPlayers, leaderboardEntries, input.Locale)
 		results = &gameResults
 	}
 
@@ -710,7 +726,8 @@ func (uc *OpenChestUseCase) Execute(input OpenChestInput) (OpenChestOutput, erro
 			rewards[string(bonus)] += 1
 		}
 		if len(rewards) > 0 {
-			if err := uc.inventoryService.Credit(input.PlayerID, "daily_chest", rewards); err != nil {
+			// Keyed by game: opening the chest again (or retrying the request) never credits twice
+			if err := uc.inventoryService.CreditOnce(input.PlayerID, "daily_chest:"+input.GameID, "daily_chest", rewards); err != nil {
 				println("⚠️ [OpenChest] Failed to credit inventory:", err.Error())
 			}
 		}
@@ -743,6 +760,8 @@ type RetryChallengeUseCase struct {
 	inventoryService  InventoryService
 	adVerificationSvc AdVerificationService
 	premiumService    PremiumService // optional, nil-guarded
+	txManager         TxManager      // optional, see WithOutbox
+	outbox            EventOutbox    // optional, see WithOutbox
 }
 
 func NewRetryChallengeUseCase(
@@ -769,6 +788,13 @@ func (uc *RetryChallengeUseCase) WithPremiumService(ps PremiumService) *RetryCha
 	return uc
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *RetryChallengeUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *RetryChallengeUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 func (uc *RetryChallengeUseCase) Execute(input RetryChallengeInput) (RetryChallengeOutput, error) {
 	now := time.Now().UTC().Unix()
 
@@ -814,35 +840,13 @@ func (uc *RetryChallengeUseCase) Execute(input RetryChallengeInput) (RetryChalle
 		return RetryChallengeOutput{}, daily_challenge.ErrAlreadyPlayedToday // Reuse error (TODO: specific error)
 	}
 
-	// 5. Process payment
-	coinsDeducted := 0
-	if input.PaymentMethod == "coins" {
-		coinsDeducted = 100
-		if uc.inventoryService != nil {
-			err := uc.inventoryService.Debit(input.PlayerID, "daily_retry", map[string]int{"coins": coinsDeducted})
-			if err != nil {
-				return RetryChallengeOutput{}, err
-			}
-		}
-	} else if input.PaymentMethod == "ad" {
-		if uc.adVerificationSvc != nil {
-			watched, err := uc.adVerificationSvc.VerifyAdWatched(input.PlayerID, "daily_retry")
-			if err != nil || !watched {
-				return RetryChallengeOutput{}, daily_challenge.ErrInvalidGameID // ad not verified
-			}
-		}
-		coinsDeducted = 0
-	} else {
-		return RetryChallengeOutput{}, daily_challenge.ErrInvalidGameID // Invalid payment method
-	}
-
-	// 6. Load daily quiz
+	// 5. Load daily quiz
 	dailyQuiz, err := uc.dailyQuizRepo.FindByDate(originalGame.Date())
 	if err != nil {
 		return RetryChallengeOutput{}, err
 	}
 
-	// 7. Load questions and create Quiz aggregate
+	// 6. Load questions and create Quiz aggregate
 	questions, err := uc.questionRepo.FindByIDs(dailyQuiz.QuestionIDs())
 	if err != nil {
 		return RetryChallengeOutput{}, err
@@ -879,7 +883,7 @@ func (uc *RetryChallengeUseCase) Execute(input RetryChallengeInput) (RetryChalle
 		}
 	}
 
-	// 8. Create new game (IMPORTANT: streak from ORIGINAL game, not updated)
+	// 7. Create new game (IMPORTANT: streak from ORIGINAL game, not updated)
 	newGame, err := daily_challenge.NewDailyGame(
 		playerID,
 		dailyQuiz.ID(),
@@ -892,14 +896,36 @@ func (uc *RetryChallengeUseCase) Execute(input RetryChallengeInput) (RetryChalle
 		return RetryChallengeOutput{}, err
 	}
 
-	// 9. Save new game
-	if err := uc.dailyGameRepo.Save(newGame); err != nil {
-		return RetryChallengeOutput{}, err
+	// 8. Process payment once the retry game is built, so a retry that cannot start
+	// spends neither coins nor the ad nonce
+	coinsDeducted := 0
+	if input.PaymentMethod == "coins" {
+		coinsDeducted = 100
+		if uc.inventoryService != nil {
+			err := uc.inventoryService.DebitOnce(input.PlayerID, retryDebitKey(input.IdempotencyKey), "daily_retry", map[string]int{"coins": coinsDeducted})
+			if err != nil {
+				return RetryChallengeOutput{}, err
+			}
+		}
+	} else if input.PaymentMethod == "ad" {
+		if uc.adVerificationSvc != nil {
+			watched, err := uc.adVerificationSvc.VerifyAdWatched(input.PlayerID, "daily_retry", input.AdNonce)
+			if err != nil {
+				return RetryChallengeOutput{}, err
+			}
+			if !watched {
+				return RetryChallengeOutput{}, daily_challenge.ErrAdNotVerified
+			}
+		}
+		coinsDeducted = 0
+	} else {
+		return RetryChallengeOutput{}, daily_challenge.ErrInvalidPaymentMethod
 	}
 
-	// 10. Publish events
-	for _, event := range newGame.Events() {
-		uc.eventBus.Publish(event)
+	// 9-10. Save new game and publish events
+	writer := gameWriter{uc.dailyGameRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(newGame); err != nil {
+		return RetryChallengeOutput{}, err
 	}
 
 	// 11. Get first question
@@ -917,7 +943,7 @@ func (uc *RetryChallengeUseCase) Execute(input RetryChallengeInput) (RetryChalle
 
 	return RetryChallengeOutput{
 		NewGameID:      newGame.ID().String(),
-		FirstQuestion:  ToQuestionDTO(firstQuestion),
+		FirstQuestion:  ToQuestionDTO(firstQuestion.Localized(input.Locale)),
 		CoinsDeducted:  coinsDeducted,
 		RemainingCoins: remainingCoins,
 		TimeLimit:      15,
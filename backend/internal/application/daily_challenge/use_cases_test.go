@@ -1,9 +1,11 @@
 package daily_challenge
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 )
 
@@ -283,6 +285,75 @@ func TestSubmitDailyAnswer_LastQuestion_CompletesGame(t *testing.T) {
 	}
 }
 
+// playAllQuestions answers every question of a freshly started game correctly
+func playAllQuestions(t *testing.T, f *testFixture, submitUC *SubmitDailyAnswerUseCase) (SubmitDailyAnswerOutput, error) {
+	t.Helper()
+	startOutput, err := f.newStartUC().Execute(StartDailyChallengeInput{
+		PlayerID: testPlayerID,
+		Date:     f.date.String(),
+	})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	var output SubmitDailyAnswerOutput
+	currentQuestion := &startOutput.FirstQuestion
+	for i := 0; i < 10; i++ {
+		output, err = submitUC.Execute(SubmitDailyAnswerInput{
+			GameID:     startOutput.Game.GameID,
+			QuestionID: currentQuestion.ID,
+			AnswerID:   currentQuestion.Answers[0].ID,
+			PlayerID:   testPlayerID,
+			TimeTaken:  2000,
+		})
+		if err != nil || output.IsGameCompleted {
+			return output, err
+		}
+		currentQuestion = output.NextQuestion
+	}
+	return output, nil
+}
+
+func TestSubmitDailyAnswer_Outbox_StoresChestEarnedWithGame(t *testing.T) {
+	f := setupFixture(t)
+	outbox := &MockEventOutbox{}
+	submitUC := f.newSubmitAnswerUC().WithOutbox(&MockTxManager{}, outbox)
+
+	output, err := playAllQuestions(t, f, submitUC)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !output.IsGameCompleted {
+		t.Fatal("Game should be completed")
+	}
+
+	var chestEvents int
+	for _, event := range outbox.Events {
+		if _, ok := event.(daily_challenge.ChestEarnedEvent); ok {
+			chestEvents++
+		}
+	}
+	if chestEvents != 1 {
+		t.Errorf("outbox has %d ChestEarnedEvents, want 1", chestEvents)
+	}
+}
+
+func TestSubmitDailyAnswer_Outbox_AppendFailureFailsSave(t *testing.T) {
+	f := setupFixture(t)
+	outbox := &MockEventOutbox{Err: errors.New("outbox unavailable")}
+	submitUC := f.newSubmitAnswerUC().WithOutbox(&MockTxManager{}, outbox)
+
+	if _, err := playAllQuestions(t, f, submitUC); err == nil {
+		t.Fatal("expected error when the outbox append fails")
+	}
+	// Nothing is published for a save that did not happen
+	for _, event := range f.eventBus.Events {
+		if _, ok := event.(daily_challenge.DailyQuestionAnsweredEvent); ok {
+			t.Fatal("answer event published although the save failed")
+		}
+	}
+}
+
 func TestSubmitDailyAnswer_PlayerMismatch(t *testing.T) {
 	f := setupFixture(t)
 
@@ -744,6 +815,40 @@ func TestRetryChallenge_WithAd(t *testing.T) {
 	}
 }
 
+func TestRetryChallenge_WithAd_RequiresVerifiedNonce(t *testing.T) {
+	f := setupFixture(t)
+
+	streak := daily_challenge.NewStreakSystem()
+	game := newCompletedGame(t, testPlayerID, f.date, f.questions, streak)
+	f.dailyGameRepo.Save(game)
+
+	verifier := &MockAdVerifier{Watched: map[string]bool{"nonce-1": true}}
+	uc := NewRetryChallengeUseCase(f.dailyGameRepo, f.dailyQuizRepo, f.questionRepo, f.eventBus, nil, verifier)
+
+	_, err := uc.Execute(RetryChallengeInput{
+		GameID:        game.ID().String(),
+		PlayerID:      testPlayerID,
+		PaymentMethod: "ad",
+		AdNonce:       "unwatched",
+	})
+	if err != daily_challenge.ErrAdNotVerified {
+		t.Fatalf("Expected ErrAdNotVerified, got %v", err)
+	}
+
+	_, err = uc.Execute(RetryChallengeInput{
+		GameID:        game.ID().String(),
+		PlayerID:      testPlayerID,
+		PaymentMethod: "ad",
+		AdNonce:       "nonce-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(verifier.AdTypes) != 2 || verifier.AdTypes[1] != "daily_retry" {
+		t.Errorf("AdTypes = %v, want daily_retry", verifier.AdTypes)
+	}
+}
+
 func TestRetryChallenge_RetryLimitReached(t *testing.T) {
 	f := setupFixture(t)
 
@@ -783,8 +888,8 @@ func TestRetryChallenge_InvalidPaymentMethod(t *testing.T) {
 		PaymentMethod: "bitcoin",
 	})
 
-	if err == nil {
-		t.Error("Expected error for invalid payment method")
+	if err != daily_challenge.ErrInvalidPaymentMethod {
+		t.Errorf("Expected ErrInvalidPaymentMethod, got %v", err)
 	}
 }
 
@@ -965,7 +1070,7 @@ func TestBuildGameResultsDTO(t *testing.T) {
 	// We need a proper testing.T for newCompletedGame
 	game := newCompletedGame(t, testPlayerID, date, questions, streak)
 
-	results := BuildGameResultsDTO(game, 1, 5, nil)
+	results := BuildGameResultsDTO(game, 1, 5, nil, quiz.DefaultLocale)
 
 	if results.TotalQuestions != 10 {
 		t.Errorf("TotalQuestions = %d, want 10", results.TotalQuestions)
@@ -1034,3 +1139,44 @@ func TestErrorPropagation_InvalidUserID(t *testing.T) {
 		})
 	}
 }
+
+// ========================================
+// RecoverStreak Tests
+// ========================================
+
+func TestRecoverStreak_WithAd_RequiresVerifiedNonce(t *testing.T) {
+	f := setupFixture(t)
+
+	// Played the day before yesterday, missed yesterday
+	dayBeforeYesterday := daily_challenge.TodayUTC().Previous().Previous()
+	streak := daily_challenge.ReconstructStreakSystem(3, 3, dayBeforeYesterday.Previous())
+	f.dailyGameRepo.Save(newCompletedGame(t, testPlayerID, dayBeforeYesterday, f.questions, streak))
+
+	verifier := &MockAdVerifier{Watched: map[string]bool{"nonce-1": true}}
+	uc := NewRecoverStreakUseCase(f.dailyGameRepo, nil).WithAdVerification(verifier)
+
+	tests := []struct {
+		name    string
+		input   RecoverStreakInput
+		wantErr error
+	}{
+		{"unknown payment method", RecoverStreakInput{PlayerID: testPlayerID, PaymentMethod: "free"}, daily_challenge.ErrInvalidPaymentMethod},
+		{"ad not watched", RecoverStreakInput{PlayerID: testPlayerID, PaymentMethod: "ad", AdNonce: "unwatched"}, daily_challenge.ErrAdNotVerified},
+		{"ad watched", RecoverStreakInput{PlayerID: testPlayerID, PaymentMethod: "ad", AdNonce: "nonce-1"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (!output.Recovered || output.CoinsDeducted != 0) {
+				t.Errorf("output = %+v, want recovered for free", output)
+			}
+		})
+	}
+
+	if verifier.AdTypes[len(verifier.AdTypes)-1] != "streak_recovery" {
+		t.Errorf("AdTypes = %v, want streak_recovery", verifier.AdTypes)
+	}
+}
@@ -13,6 +13,8 @@ type AbandonMarathonUseCase struct {
 	marathonRepo     solo_marathon.Repository
 	personalBestRepo solo_marathon.PersonalBestRepository
 	eventBus         EventBus
+	txManager        TxManager   // optional, see WithOutbox
+	outbox           EventOutbox // optional, see WithOutbox
 }
 
 // NewAbandonMarathonUseCase creates a new AbandonMarathonUseCase
@@ -28,6 +30,13 @@ func NewAbandonMarathonUseCase(
 	}
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *AbandonMarathonUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *AbandonMarathonUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 // Execute abandons a marathon game (player quits voluntarily)
 func (uc *AbandonMarathonUseCase) Execute(input AbandonMarathonInput) (AbandonMarathonOutput, error) {
 	// 1. Validate and convert input to domain types
@@ -60,20 +69,13 @@ func (uc *AbandonMarathonUseCase) Execute(input AbandonMarathonInput) (AbandonMa
 
 	// 5. Skip personal best update for abandoned games — only completed runs count
 
-	// 6. Persist game
-	if err := uc.marathonRepo.Save(game); err != nil {
+	// 6. Persist game and publish domain events
+	writer := gameWriter{uc.marathonRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(game); err != nil {
 		return AbandonMarathonOutput{}, err
 	}
 
-	// 7. Publish domain events
-	if uc.eventBus != nil {
-		events := game.Events()
-		for _, event := range events {
-			uc.eventBus.Publish(event)
-		}
-	}
-
-	// 8. Build output
+	// 7. Build output
 	return AbandonMarathonOutput{
 		GameOverResult: BuildGameOverResultV2(game),
 	}, nil
@@ -0,0 +1,8 @@
+package marathon
+
+// AdVerificationService checks that a player watched a rewarded ad before a free continue.
+// This is a port (interface) — the implementation lives in the ad_reward application layer.
+// Verifying spends the one-time nonce, so each watched ad pays out once.
+type AdVerificationService interface {
+	VerifyAdWatched(playerID string, adType string, nonce string) (bool, error)
+}
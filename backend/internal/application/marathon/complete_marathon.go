@@ -15,6 +15,8 @@ type CompleteMarathonUseCase struct {
 	eventBus          EventBus
 	inventoryService  InventoryService
 	milestoneClaimsRepo MilestoneClaimsRepository
+	txManager           TxManager   // optional, see WithOutbox
+	outbox              EventOutbox // optional, see WithOutbox
 }
 
 // NewCompleteMarathonUseCase creates a new CompleteMarathonUseCase
@@ -38,6 +40,13 @@ func (uc *CompleteMarathonUseCase) WithMilestoneClaimsRepository(repo MilestoneC
 	return uc
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *CompleteMarathonUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *CompleteMarathonUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 // Execute completes a marathon game that is in game_over state (player declined continue)
 func (uc *CompleteMarathonUseCase) Execute(input CompleteMarathonInput) (CompleteMarathonOutput, error) {
 	// 1. Validate and convert input to domain types
@@ -74,20 +83,13 @@ func (uc *CompleteMarathonUseCase) Execute(input CompleteMarathonInput) (Complet
 	// 5b. Credit milestone rewards for the final score
 	uc.creditMilestoneRewards(input.PlayerID, game.Score())
 
-	// 6. Persist game
-	if err := uc.marathonRepo.Save(game); err != nil {
+	// 6. Persist game and publish domain events
+	writer := gameWriter{uc.marathonRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(game); err != nil {
 		return CompleteMarathonOutput{}, err
 	}
 
-	// 7. Publish domain events
-	if uc.eventBus != nil {
-		events := game.Events()
-		for _, event := range events {
-			uc.eventBus.Publish(event)
-		}
-	}
-
-	// 8. Build output
+	// 7. Build output
 	return CompleteMarathonOutput{
 		GameOverResult: BuildGameOverResultV2(game),
 	}, nil
@@ -14,6 +14,7 @@ type ContinueMarathonUseCase struct {
 	questionRepo     quiz.QuestionRepository
 	eventBus         EventBus
 	inventoryService InventoryService
+	adVerification   AdVerificationService // optional, nil-guarded
 }
 
 // NewContinueMarathonUseCase creates a new ContinueMarathonUseCase
@@ -31,6 +32,12 @@ func NewContinueMarathonUseCase(
 	}
 }
 
+// WithAdVerification sets the service that checks the rewarded ad of the "ad" payment method
+func (uc *ContinueMarathonUseCase) WithAdVerification(svc AdVerificationService) *ContinueMarathonUseCase {
+	uc.adVerification = svc
+	return uc
+}
+
 // Execute continues a marathon game after game over (player pays to resume)
 func (uc *ContinueMarathonUseCase) Execute(input ContinueMarathonInput) (ContinueMarathonOutput, error) {
 	// 1. Validate and convert input to domain types
@@ -66,31 +73,43 @@ func (uc *ContinueMarathonUseCase) Execute(input ContinueMarathonInput) (Continu
 		return ContinueMarathonOutput{}, quiz.ErrUnauthorized
 	}
 
-	// 4. Calculate continue cost
+	// 4. Continue game (domain business logic) and load the next question.
+	// Both run before payment, so an ad nonce or coins are only spent on a continue that can happen.
 	costCalc := solo_marathon.ContinueCostCalculator{}
 	costCoins := 0
 	if paymentMethod == solo_marathon.PaymentCoins {
 		costCoins = costCalc.GetCost(game.ContinueCount())
-		if uc.inventoryService != nil {
-			err := uc.inventoryService.Debit(input.PlayerID, "marathon_continue", map[string]int{"coins": costCoins})
-			if err != nil {
-				return ContinueMarathonOutput{}, err
-			}
-		}
 	}
 
-	// 5. Continue game (domain business logic)
 	now := time.Now().Unix()
 	if err := game.Continue(paymentMethod, costCoins, now); err != nil {
 		return ContinueMarathonOutput{}, err
 	}
 
-	// 6. Load next question for the resumed game
+	// 5. Load next question for the resumed game
 	questionSelector := solo_marathon.NewQuestionSelector(uc.questionRepo)
 	if err := game.LoadNextQuestion(questionSelector); err != nil {
 		return ContinueMarathonOutput{}, err
 	}
 
+	// 6. Charge the continue cost, or verify the rewarded ad
+	if paymentMethod == solo_marathon.PaymentCoins {
+		if uc.inventoryService != nil {
+			err := uc.inventoryService.DebitOnce(input.PlayerID, continueDebitKey(input.IdempotencyKey), "marathon_continue", map[string]int{"coins": costCoins})
+			if err != nil {
+				return ContinueMarathonOutput{}, err
+			}
+		}
+	} else if uc.adVerification != nil {
+		watched, err := uc.adVerification.VerifyAdWatched(input.PlayerID, "marathon_continue", input.AdNonce)
+		if err != nil {
+			return ContinueMarathonOutput{}, err
+		}
+		if !watched {
+			return ContinueMarathonOutput{}, solo_marathon.ErrAdNotVerified
+		}
+	}
+
 	// 7. Persist game
 	if err := uc.marathonRepo.Save(game); err != nil {
 		return ContinueMarathonOutput{}, err
@@ -109,7 +128,7 @@ func (uc *ContinueMarathonUseCase) Execute(input ContinueMarathonInput) (Continu
 
 	// 10. Build output
 	return ContinueMarathonOutput{
-		Game:             ToMarathonGameDTOV2(game, now),
+		Game:             ToMarathonGameDTOV2(game, now, input.Locale),
 		ContinueCount:   game.ContinueCount(),
 		CoinsDeducted:   costCoins,
 		NextContinueCost: nextContinueCost,
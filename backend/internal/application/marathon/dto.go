@@ -1,5 +1,7 @@
 package marathon
 
+import "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+
 // ========================================
 // Common DTOs
 // ========================================
@@ -49,7 +51,9 @@ type BonusInventoryDTO struct {
 // QuestionDTO represents a quiz question (from quiz aggregate)
 type QuestionDTO struct {
 	ID       string      `json:"id"`
+	Type     string      `json:"type"` // see quiz.QuestionDTO
 	Text     string      `json:"text"`
+	Media    *MediaDTO   `json:"media,omitempty"`
 	Answers  []AnswerDTO `json:"answers"`
 	Points   int         `json:"points"`
 	Position int         `json:"position"`
@@ -58,9 +62,19 @@ type QuestionDTO struct {
 // AnswerDTO represents an answer option
 // NOTE: IsCorrect is NOT included - never leak correct answers to client!
 type AnswerDTO struct {
-	ID       string `json:"id"`
-	Text     string `json:"text"`
-	Position int    `json:"position"`
+	ID       string    `json:"id"`
+	Text     string    `json:"text"`
+	Media    *MediaDTO `json:"media,omitempty"`
+	Position int       `json:"position"`
+}
+
+// MediaDTO is an image on a question or answer (same shape in every mode)
+type MediaDTO = quiz.MediaDTO
+
+// ExplanationDTO is the rationale of a question, revealed once it has been answered
+type ExplanationDTO struct {
+	Text      string `json:"text"`
+	SourceURL string `json:"sourceUrl,omitempty"`
 }
 
 // PersonalBestDTO represents a personal best record
@@ -104,6 +118,7 @@ type MilestoneDTO struct {
 type StartMarathonInput struct {
 	PlayerID   string  `json:"playerId"`
 	CategoryID *string `json:"categoryId,omitempty"` // nil or empty = "all categories"
+	Locale     string  `json:"-"`                    // player's content language, see quiz.DefaultLocale
 }
 
 // StartMarathonOutput is the output for starting a marathon game
@@ -123,6 +138,10 @@ type SubmitMarathonAnswerInput struct {
 	AnswerID   string `json:"answerId"`
 	PlayerID   string `json:"playerId"` // For authorization
 	TimeTaken  int64  `json:"timeTaken"` // Time taken in milliseconds
+	Locale     string `json:"-"`         // player's content language, see quiz.DefaultLocale
+	// Multi-select (picked options) and ordering (every option, in order) send AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
 }
 
 // SubmitMarathonAnswerOutput is the output for submitting an answer
@@ -130,6 +149,7 @@ type SubmitMarathonAnswerOutput struct {
 	IsCorrect          bool              `json:"isCorrect"`
 	CorrectAnswerID    string            `json:"correctAnswerId"`
 	CorrectAnswerText  string            `json:"correctAnswerText"` // Text of the correct answer
+	Explanation        *ExplanationDTO   `json:"explanation,omitempty"` // Why the correct answer is right
 	TimeTaken          int64             `json:"timeTaken"`
 	Score              int               `json:"score"`           // Total correct answers
 	TotalQuestions     int               `json:"totalQuestions"`
@@ -145,6 +165,9 @@ type SubmitMarathonAnswerOutput struct {
 	Milestone          *MilestoneDTO     `json:"milestone,omitempty"` // Next milestone progress
 	StreakCount        int               `json:"streakCount"`   // current streak after this answer
 	LifeRestored       bool              `json:"lifeRestored"`  // true if streak triggered life regen
+	// Answer key of the other question types (CorrectAnswerID is empty for them)
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`    // numeric
 }
 
 // GameOverResultDTO contains game over statistics
@@ -167,6 +190,7 @@ type UseMarathonBonusInput struct {
 	QuestionID string `json:"questionId"`
 	BonusType  string `json:"bonusType"` // "shield", "fifty_fifty", "skip", "freeze"
 	PlayerID   string `json:"playerId"`  // For authorization
+	Locale     string `json:"-"`         // player's content language, see quiz.DefaultLocale
 }
 
 // UseMarathonBonusOutput is the output for using a bonus
@@ -202,6 +226,9 @@ type ContinueMarathonInput struct {
 	GameID        string `json:"gameId"`
 	PlayerID      string `json:"playerId"` // For authorization
 	PaymentMethod string `json:"paymentMethod"` // "coins" or "ad"
+	AdNonce        string `json:"adNonce"`       // reward nonce of the watched ad ("ad" only)
+	IdempotencyKey string `json:"-"`             // optional; a retried request with the same key is charged once
+	Locale         string `json:"-"`             // player's content language, see quiz.DefaultLocale
 }
 
 // ContinueMarathonOutput is the output for continuing after game over
@@ -249,6 +276,7 @@ type AbandonMarathonOutput struct {
 // GetMarathonStatusInput is the input for getting marathon status
 type GetMarathonStatusInput struct {
 	PlayerID string `json:"playerId"` // Get active game for this player
+	Locale   string `json:"-"`        // player's content language, see quiz.DefaultLocale
 }
 
 // GetMarathonStatusOutput is the output for getting marathon status
@@ -1,9 +1,62 @@
 package marathon
 
-import "github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
+)
 
 // EventBus defines the interface for publishing domain events
 // Implementation is in infrastructure layer
 type EventBus interface {
 	Publish(event solo_marathon.Event)
 }
+
+// TxManager provides database transaction support
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// EventOutbox stores domain events in the transaction that saves their aggregate.
+// An outbox relay delivers them to durable subscribers after commit (at-least-once),
+// so a crash right after the save can no longer lose a MarathonGameOverEvent.
+// Implementation is in infrastructure layer
+type EventOutbox interface {
+	AppendInTx(tx *sql.Tx, events ...solo_marathon.Event) error
+}
+
+// gameWriter saves a marathon game together with its pending events.
+// Without an outbox the game is saved and the events go straight to the event bus.
+type gameWriter struct {
+	marathonRepo solo_marathon.Repository
+	eventBus     EventBus    // may be nil
+	txManager    TxManager   // optional, set with the use case's WithOutbox
+	outbox       EventOutbox // optional, set with the use case's WithOutbox
+}
+
+func (w gameWriter) save(game *solo_marathon.MarathonGameV2) error {
+	events := game.Events()
+
+	if w.txManager != nil && w.outbox != nil {
+		err := w.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+			if err := w.marathonRepo.SaveInTx(tx, game); err != nil {
+				return err
+			}
+			return w.outbox.AppendInTx(tx, events...)
+		})
+		if err != nil {
+			return err
+		}
+	} else if err := w.marathonRepo.Save(game); err != nil {
+		return err
+	}
+
+	// In-process listeners (logging) get the events once they are stored
+	if w.eventBus != nil {
+		for _, event := range events {
+			w.eventBus.Publish(event)
+		}
+	}
+	return nil
+}
@@ -61,7 +61,7 @@ func (uc *GetMarathonStatusUseCase) Execute(input GetMarathonStatusInput) (GetMa
 
 	// 4. Game found - build output
 	now := time.Now().Unix()
-	gameDTO := ToMarathonGameDTOV2(game, now)
+	gameDTO := ToMarathonGameDTOV2(game, now, input.Locale)
 	gameBonusDTO := gameDTO.BonusInventory
 
 	// CanStart = false when there is an in-progress game
@@ -5,6 +5,16 @@ package marathon
 type InventoryService interface {
 	Credit(playerID string, source string, details map[string]int) error
 	Debit(playerID string, source string, details map[string]int) error
+	// DebitOnce debits at most once per idempotency key; a repeated call is a no-op
+	DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+}
+
+// continueDebitKey scopes a client idempotency key to the continue payment ("" when the client sent none)
+func continueDebitKey(idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return "marathon_continue:" + idempotencyKey
 }
 
 // MilestoneClaimsRepository tracks which milestone rewards each player has already claimed,
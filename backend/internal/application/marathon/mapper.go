@@ -22,6 +22,7 @@ func ToMarathonGameDTO(game *solo_marathon.MarathonGame, now int64) MarathonGame
 			questionDTO := QuestionDTO{
 				ID:       dto.ID,
 				Text:     dto.Text,
+				Media:    dto.Media,
 				Answers:  toAnswerDTOs(dto.Answers),
 				Points:   dto.Points,
 				Position: dto.Position,
@@ -49,16 +50,17 @@ func ToMarathonGameDTO(game *solo_marathon.MarathonGame, now int64) MarathonGame
 	}
 }
 
-// ToMarathonGameDTOV2 converts a MarathonGameV2 aggregate to DTO
-func ToMarathonGameDTOV2(game *solo_marathon.MarathonGameV2, now int64) MarathonGameDTO {
+// ToMarathonGameDTOV2 converts a MarathonGameV2 aggregate to DTO, the current question in the given locale
+func ToMarathonGameDTOV2(game *solo_marathon.MarathonGameV2, now int64, locale string) MarathonGameDTO {
 	// Get current question if game is in progress
 	var currentQuestion *QuestionDTO
 	if game.Status() == solo_marathon.GameStatusInProgress {
 		if q, err := game.GetCurrentQuestion(); err == nil {
-			dto := quiz.ToQuestionDTO(q)
+			dto := quiz.ToQuestionDTO(q.Localized(locale))
 			questionDTO := QuestionDTO{
 				ID:       dto.ID,
 				Text:     dto.Text,
+				Media:    dto.Media,
 				Answers:  toAnswerDTOs(dto.Answers),
 				Points:   dto.Points,
 				Position: dto.Position,
@@ -150,13 +152,26 @@ func ToQuestionDTO(q *domainQuiz.Question) QuestionDTO {
 	dto := quiz.ToQuestionDTO(q)
 	return QuestionDTO{
 		ID:       dto.ID,
+		Type:     dto.Type,
 		Text:     dto.Text,
+		Media:    dto.Media,
 		Answers:  toAnswerDTOs(dto.Answers),
 		Points:   dto.Points,
 		Position: dto.Position,
 	}
 }
 
+// ToExplanationDTO returns the question's explanation, or nil when it has none
+func ToExplanationDTO(q *domainQuiz.Question) *ExplanationDTO {
+	if q == nil || q.Explanation().IsEmpty() {
+		return nil
+	}
+	return &ExplanationDTO{
+		Text:      q.Explanation().Text(),
+		SourceURL: q.Explanation().SourceURL(),
+	}
+}
+
 // toAnswerDTOs converts quiz AnswerDTOs to marathon AnswerDTOs
 func toAnswerDTOs(quizAnswers []quiz.AnswerDTO) []AnswerDTO {
 	answers := make([]AnswerDTO, 0, len(quizAnswers))
@@ -164,6 +179,7 @@ func toAnswerDTOs(quizAnswers []quiz.AnswerDTO) []AnswerDTO {
 		answers = append(answers, AnswerDTO{
 			ID:       a.ID,
 			Text:     a.Text,
+			Media:    a.Media,
 			Position: a.Position,
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
 )
 
@@ -19,3 +20,29 @@ func TestToLivesDTO_TimeToNextLifeIsAlwaysZero(t *testing.T) {
 		t.Errorf("Expected TimeToNextLife=0 (no time-gate between runs), got %d", dto.TimeToNextLife)
 	}
 }
+
+func TestToQuestionDTO_ResolvesMedia(t *testing.T) {
+	q := createTestQuestion(t, 1)
+	flag, err := quiz.NewMedia("", "flags/fr.png", 320, 213, "Flag of France")
+	if err != nil {
+		t.Fatalf("NewMedia: %v", err)
+	}
+	q.SetMedia(flag)
+
+	dto := ToQuestionDTO(q)
+
+	if dto.Media == nil {
+		t.Fatal("Expected question media")
+	}
+	if dto.Media.URL != "/api/v1/media/flags/fr.png" {
+		t.Errorf("Expected asset key resolved under the media route, got %q", dto.Media.URL)
+	}
+	if dto.Media.Width != 320 || dto.Media.Height != 213 || dto.Media.Alt != "Flag of France" {
+		t.Errorf("Unexpected media %+v", *dto.Media)
+	}
+	for _, a := range dto.Answers {
+		if a.Media != nil {
+			t.Errorf("Expected no media on text answer %s", a.ID)
+		}
+	}
+}
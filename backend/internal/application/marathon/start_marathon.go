@@ -16,6 +16,8 @@ type StartMarathonUseCase struct {
 	categoryRepo     quiz.CategoryRepository
 	eventBus         EventBus
 	bonusWalletRepo  solo_marathon.BonusWalletRepository
+	txManager        TxManager   // optional, see WithOutbox
+	outbox           EventOutbox // optional, see WithOutbox
 }
 
 // NewStartMarathonUseCase creates a new StartMarathonUseCase
@@ -37,6 +39,13 @@ func NewStartMarathonUseCase(
 	}
 }
 
+// WithOutbox saves games and their events in one transaction through the outbox
+func (uc *StartMarathonUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *StartMarathonUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 // Execute starts a new marathon game
 func (uc *StartMarathonUseCase) Execute(input StartMarathonInput) (StartMarathonOutput, error) {
 	// 1. Validate and convert input to domain types
@@ -45,6 +54,8 @@ func (uc *StartMarathonUseCase) Execute(input StartMarathonInput) (StartMarathon
 		return StartMarathonOutput{}, err
 	}
 
+	writer := gameWriter{uc.marathonRepo, uc.eventBus, uc.txManager, uc.outbox}
+
 	// 2. Check if player already has an active game
 	existingGame, err := uc.marathonRepo.FindActiveByPlayer(playerID)
 	if err == nil && existingGame != nil {
@@ -54,7 +65,7 @@ func (uc *StartMarathonUseCase) Execute(input StartMarathonInput) (StartMarathon
 			if err := existingGame.CompleteGame(now); err != nil {
 				return StartMarathonOutput{}, err
 			}
-			if err := uc.marathonRepo.Save(existingGame); err != nil {
+			if err := writer.save(existingGame); err != nil {
 				return StartMarathonOutput{}, err
 			}
 		} else {
@@ -120,22 +131,14 @@ func (uc *StartMarathonUseCase) Execute(input StartMarathonInput) (StartMarathon
 		return StartMarathonOutput{}, err
 	}
 
-	// 7. Persist game
-	if err := uc.marathonRepo.Save(game); err != nil {
+	// 7. Persist game and publish domain events
+	if err := writer.save(game); err != nil {
 		return StartMarathonOutput{}, err
 	}
 
-	// 8. Publish domain events
-	if uc.eventBus != nil {
-		events := game.Events()
-		for _, event := range events {
-			uc.eventBus.Publish(event)
-		}
-	}
-
-	// 9. Build output DTO
+	// 8. Build output DTO
 	return StartMarathonOutput{
-		Game:            ToMarathonGameDTOV2(game, now),
+		Game:            ToMarathonGameDTOV2(game, now, input.Locale),
 		HasPersonalBest: personalBest != nil,
 	}, nil
 }
@@ -3,6 +3,7 @@ package marathon
 import (
 	"time"
 
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
@@ -58,7 +59,7 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 		return SubmitMarathonAnswerOutput{}, err
 	}
 
-	answerID, err := quiz.NewAnswerIDFromString(input.AnswerID)
+	submission, err := appQuiz.ParseAnswerSubmission(input.AnswerID, input.AnswerIDs, input.NumericAnswer)
 	if err != nil {
 		return SubmitMarathonAnswerOutput{}, err
 	}
@@ -92,7 +93,7 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 
 	// 5. Submit answer (domain business logic)
 	now := time.Now().Unix()
-	result, err := game.AnswerQuestion(questionID, answerID, input.TimeTaken, now)
+	result, err := game.AnswerQuestion(questionID, submission, input.TimeTaken, now)
 	if err != nil {
 		return SubmitMarathonAnswerOutput{}, err
 	}
@@ -106,11 +107,11 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 		}
 	}
 
-	// Find correct answer text from the captured question
+	// Find correct answer text from the captured question (single choice and true/false only)
 	correctAnswerText := ""
 	if answeredQuestion != nil {
-		for _, a := range answeredQuestion.Answers() {
-			if a.IsCorrect() {
+		for _, a := range answeredQuestion.Localized(input.Locale).Answers() {
+			if a.ID().Equals(result.CorrectAnswerID) {
 				correctAnswerText = a.Text().String()
 				break
 			}
@@ -122,6 +123,7 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 		IsCorrect:          result.IsCorrect,
 		CorrectAnswerID:    result.CorrectAnswerID.String(),
 		CorrectAnswerText:  correctAnswerText,
+		Explanation:        ToExplanationDTO(answeredQuestion.Localized(input.Locale)),
 		TimeTaken:          result.TimeTaken,
 		Score:           result.Score,
 		TotalQuestions:  result.TotalQuestions,
@@ -135,6 +137,10 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 		StreakCount:     result.StreakCount,   // NEW
 		LifeRestored:    result.LifeRestored, // NEW
 	}
+	if answeredQuestion != nil {
+		output.CorrectAnswerIDs = appQuiz.CorrectAnswerIDsOf(answeredQuestion)
+		output.CorrectNumber = appQuiz.CorrectNumberOf(answeredQuestion)
+	}
 
 	// 7. Handle game over scenario (intermediate — continue offered)
 	if result.IsGameOver && result.GameOverData != nil {
@@ -150,7 +156,7 @@ func (uc *SubmitMarathonAnswerUseCase) Execute(input SubmitMarathonAnswerInput)
 		// Game continues - get next question
 		nextQuestion, err := game.GetCurrentQuestion()
 		if err == nil {
-			nextQuestionDTO := ToQuestionDTO(nextQuestion)
+			nextQuestionDTO := ToQuestionDTO(nextQuestion.Localized(input.Locale))
 			output.NextQuestion = &nextQuestionDTO
 
 			// Calculate time limit for next question
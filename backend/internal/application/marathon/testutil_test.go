@@ -1,6 +1,8 @@
 package marathon
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 
@@ -35,9 +37,15 @@ func (m *mockMarathonRepo) Save(game *solo_marathon.MarathonGameV2) error {
 	return nil
 }
 
+func (m *mockMarathonRepo) SaveInTx(_ *sql.Tx, game *solo_marathon.MarathonGameV2) error {
+	return m.Save(game)
+}
+
 func (m *mockMarathonRepo) FindByID(id solo_marathon.GameID) (*solo_marathon.MarathonGameV2, error) {
 	if g, ok := m.games[id.String()]; ok {
-		return g, nil
+		// A copy, like a reload from the database: changes only stick once saved
+		game := *g
+		return &game, nil
 	}
 	return nil, solo_marathon.ErrGameNotFound
 }
@@ -173,6 +181,10 @@ func (m *mockCategoryRepo) Save(c *quiz.Category) error {
 	return nil
 }
 
+func (m *mockCategoryRepo) SaveInTx(_ *sql.Tx, c *quiz.Category) error {
+	return m.Save(c)
+}
+
 func (m *mockCategoryRepo) Delete(id quiz.CategoryID) error {
 	delete(m.categories, id.String())
 	return nil
@@ -219,7 +231,7 @@ func (m *mockQuestionRepo) FindRandomQuestions(f quiz.QuestionFilter, limit int)
 	}
 	var result []*quiz.Question
 	for _, q := range m.questions {
-		if excluded[q.ID().String()] {
+		if excluded[q.ID().String()] || !matchesDifficulty(f, q) {
 			continue
 		}
 		result = append(result, q)
@@ -248,8 +260,22 @@ func (m *mockQuestionRepo) FindQuestionsByQuizSeed(n int, _ int64, _ *quiz.Categ
 	return result, nil
 }
 
-func (m *mockQuestionRepo) CountByFilter(_ quiz.QuestionFilter) (int, error) {
-	return len(m.questions), nil
+func (m *mockQuestionRepo) CountByFilter(f quiz.QuestionFilter) (int, error) {
+	excluded := make(map[string]bool, len(f.ExcludeIDs))
+	for _, id := range f.ExcludeIDs {
+		excluded[id.String()] = true
+	}
+	count := 0
+	for _, q := range m.questions {
+		if !excluded[q.ID().String()] && matchesDifficulty(f, q) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func matchesDifficulty(f quiz.QuestionFilter, q *quiz.Question) bool {
+	return !f.HasDifficultyFilter() || *f.Difficulty == q.Difficulty().String()
 }
 
 func (m *mockQuestionRepo) Save(q *quiz.Question) error {
@@ -264,6 +290,10 @@ func (m *mockQuestionRepo) SaveAll(qs []*quiz.Question) error {
 	return nil
 }
 
+func (m *mockQuestionRepo) SaveInTx(_ *sql.Tx, q *quiz.Question) error {
+	return m.Save(q)
+}
+
 func (m *mockQuestionRepo) Delete(id quiz.QuestionID) error {
 	delete(m.questions, id.String())
 	return nil
@@ -312,6 +342,38 @@ func (m *mockEventBus) Publish(event solo_marathon.Event) {
 	m.events = append(m.events, event)
 }
 
+// mockTxManager executes the function directly without a real transaction
+type mockTxManager struct{}
+
+func (m *mockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	return fn(nil)
+}
+
+// mockEventOutbox collects events appended to the outbox
+type mockEventOutbox struct {
+	events []solo_marathon.Event
+}
+
+func (m *mockEventOutbox) AppendInTx(_ *sql.Tx, events ...solo_marathon.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockAdVerifier accepts each of its watched nonces once
+type mockAdVerifier struct {
+	watched map[string]bool // nonce -> not yet used
+	adTypes []string        // adType of every verification
+}
+
+func (m *mockAdVerifier) VerifyAdWatched(_ string, adType string, nonce string) (bool, error) {
+	m.adTypes = append(m.adTypes, adType)
+	if !m.watched[nonce] {
+		return false, nil
+	}
+	m.watched[nonce] = false
+	return true, nil
+}
+
 // ========================================
 // Test Helpers
 // ========================================
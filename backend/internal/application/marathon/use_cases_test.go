@@ -3,6 +3,7 @@ package marathon
 import (
 	"testing"
 
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
 )
 
@@ -148,6 +149,32 @@ func TestStartMarathon_AfterGameOver_AllowsNewRun(t *testing.T) {
 	}
 }
 
+func TestStartMarathon_AfterGameOver_StoresGameOverInOutbox(t *testing.T) {
+	f := setupFixture(t)
+	outbox := &mockEventOutbox{}
+
+	startOutput := f.startGameForPlayer(t, testPlayerID)
+	for i := 0; i < 5; i++ {
+		f.answerCurrentQuestion(t, startOutput.Game.ID, testPlayerID, false)
+	}
+
+	// The auto-completed run's game-over event is stored with the old game
+	uc := f.newStartUC().WithOutbox(&mockTxManager{}, outbox)
+	if _, err := uc.Execute(StartMarathonInput{PlayerID: testPlayerID}); err != nil {
+		t.Fatalf("Expected new game to start after game_over, got error: %v", err)
+	}
+
+	var gameOver []solo_marathon.MarathonGameOverEvent
+	for _, event := range outbox.events {
+		if e, ok := event.(solo_marathon.MarathonGameOverEvent); ok {
+			gameOver = append(gameOver, e)
+		}
+	}
+	if len(gameOver) != 1 || gameOver[0].GameID().String() != startOutput.Game.ID {
+		t.Errorf("outbox has %d MarathonGameOverEvents, want 1 for the old game", len(gameOver))
+	}
+}
+
 func TestStartMarathon_InvalidPlayerID(t *testing.T) {
 	f := setupFixture(t)
 	uc := f.newStartUC()
@@ -229,6 +256,27 @@ func TestSubmitAnswer_Wrong_LosesLife(t *testing.T) {
 	}
 }
 
+func TestSubmitAnswer_ReturnsExplanation(t *testing.T) {
+	f := setupFixture(t)
+	explanation, err := quiz.NewExplanation("The first answer is always right here.", "https://example.com/why")
+	if err != nil {
+		t.Fatalf("NewExplanation: %v", err)
+	}
+	for _, q := range f.questions {
+		q.SetExplanation(explanation)
+	}
+	startOutput := f.startGameForPlayer(t, testPlayerID)
+
+	output := f.answerCurrentQuestion(t, startOutput.Game.ID, testPlayerID, false)
+
+	if output.Explanation == nil {
+		t.Fatal("Expected an explanation after a wrong answer")
+	}
+	if output.Explanation.Text != explanation.Text() || output.Explanation.SourceURL != explanation.SourceURL() {
+		t.Errorf("Explanation = %+v, want %q / %q", *output.Explanation, explanation.Text(), explanation.SourceURL())
+	}
+}
+
 func TestSubmitAnswer_FiveWrong_GameOver(t *testing.T) {
 	f := setupFixture(t)
 	startOutput := f.startGameForPlayer(t, testPlayerID)
@@ -654,6 +702,42 @@ func TestContinue_WithAd(t *testing.T) {
 	}
 }
 
+func TestContinue_WithAd_RequiresVerifiedNonce(t *testing.T) {
+	f := setupFixture(t)
+	startOutput := f.startGameForPlayer(t, testPlayerID)
+	gameID := startOutput.Game.ID
+
+	// Lose all lives (5 wrong answers with MaxLives=5)
+	for i := 0; i < 5; i++ {
+		f.answerCurrentQuestion(t, gameID, testPlayerID, false)
+	}
+
+	verifier := &mockAdVerifier{watched: map[string]bool{"nonce-1": true}}
+	uc := f.newContinueUC().WithAdVerification(verifier)
+
+	_, err := uc.Execute(ContinueMarathonInput{
+		GameID:        gameID,
+		PlayerID:      testPlayerID,
+		PaymentMethod: "ad",
+	})
+	if err != solo_marathon.ErrAdNotVerified {
+		t.Fatalf("Expected ErrAdNotVerified without a nonce, got %v", err)
+	}
+
+	_, err = uc.Execute(ContinueMarathonInput{
+		GameID:        gameID,
+		PlayerID:      testPlayerID,
+		PaymentMethod: "ad",
+		AdNonce:       "nonce-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(verifier.adTypes) != 2 || verifier.adTypes[1] != "marathon_continue" {
+		t.Errorf("adTypes = %v, want marathon_continue", verifier.adTypes)
+	}
+}
+
 func TestContinue_InvalidPaymentMethod(t *testing.T) {
 	f := setupFixture(t)
 	startOutput := f.startGameForPlayer(t, testPlayerID)
@@ -695,6 +779,27 @@ func TestContinue_GameNotInGameOver(t *testing.T) {
 	}
 }
 
+func TestContinue_GameNotInGameOver_KeepsAdNonce(t *testing.T) {
+	f := setupFixture(t)
+	startOutput := f.startGameForPlayer(t, testPlayerID)
+
+	verifier := &mockAdVerifier{watched: map[string]bool{"nonce-1": true}}
+	uc := f.newContinueUC().WithAdVerification(verifier)
+
+	_, err := uc.Execute(ContinueMarathonInput{
+		GameID:        startOutput.Game.ID,
+		PlayerID:      testPlayerID,
+		PaymentMethod: "ad",
+		AdNonce:       "nonce-1",
+	})
+	if err != solo_marathon.ErrContinueNotAvailable {
+		t.Fatalf("Expected ErrContinueNotAvailable, got %v", err)
+	}
+	if len(verifier.adTypes) != 0 || !verifier.watched["nonce-1"] {
+		t.Errorf("Ad nonce was spent on a continue that cannot happen (adTypes = %v)", verifier.adTypes)
+	}
+}
+
 func TestContinue_CostIncreases(t *testing.T) {
 	f := setupFixture(t)
 	startOutput := f.startGameForPlayer(t, testPlayerID)
@@ -114,7 +114,7 @@ func (uc *UseMarathonBonusUseCase) Execute(input UseMarathonBonusInput) (UseMara
 
 		nextQuestion, err := game.GetCurrentQuestion()
 		if err == nil {
-			nextQuestionDTO := ToQuestionDTO(nextQuestion)
+			nextQuestionDTO := ToQuestionDTO(nextQuestion.Localized(input.Locale))
 			bonusResult.NextQuestion = &nextQuestionDTO
 
 			nextTimeLimit := GetTimeLimit(game.Difficulty(), game.QuestionNumber())
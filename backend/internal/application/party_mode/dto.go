@@ -0,0 +1,213 @@
+package party_mode
+
+import appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+
+// ========================================
+// Common DTOs
+// ========================================
+
+// RoomSettingsDTO represents party room configuration
+type RoomSettingsDTO struct {
+	MaxPlayers        int      `json:"maxPlayers"`
+	QuestionsCount    int      `json:"questionsCount"`
+	TimePerQuestion   int      `json:"timePerQuestion"`
+	Categories        []string `json:"categories"`
+	Difficulty        string   `json:"difficulty"`
+	ShowCorrectAnswer bool     `json:"showCorrectAnswer"`
+	ShowPlayerAnswers bool     `json:"showPlayerAnswers"`
+	ShowCurrentScore  bool     `json:"showCurrentScore"`
+}
+
+// RoomPlayerDTO represents a player in the room lobby
+type RoomPlayerDTO struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	IsHost    bool   `json:"isHost"`
+	IsReady   bool   `json:"isReady"`
+	Connected bool   `json:"connected"`
+	JoinedAt  int64  `json:"joinedAt"`
+}
+
+// PartyRoomDTO represents a party room
+type PartyRoomDTO struct {
+	ID        string          `json:"id"`
+	Code      string          `json:"code"`
+	Name      string          `json:"name"`
+	HostID    string          `json:"hostId"`
+	Status    string          `json:"status"`
+	Settings  RoomSettingsDTO `json:"settings"`
+	Players   []RoomPlayerDTO `json:"players"`
+	CreatedAt int64           `json:"createdAt"`
+	ExpiresAt int64           `json:"expiresAt"`
+}
+
+// PartyPlayerDTO represents a player in an active party game
+type PartyPlayerDTO struct {
+	ID           string `json:"id"`
+	Username     string `json:"username"`
+	Score        int    `json:"score"`
+	Connected    bool   `json:"connected"`
+	AnswersCount int    `json:"answersCount"`
+}
+
+// PartyGameDTO represents an active or finished party game
+type PartyGameDTO struct {
+	ID              string           `json:"id"`
+	RoomID          string           `json:"roomId"`
+	Status          string           `json:"status"`
+	CurrentQuestion int              `json:"currentQuestion"` // 1-based
+	TotalQuestions  int              `json:"totalQuestions"`
+	Players         []PartyPlayerDTO `json:"players"`
+	StartedAt       int64            `json:"startedAt"`
+	FinishedAt      int64            `json:"finishedAt,omitempty"`
+}
+
+// PartyQuestionDTO represents a question in a party game (no IsCorrect field!)
+type PartyQuestionDTO struct {
+	ID             string           `json:"id"`
+	QuestionNumber int              `json:"questionNumber"`
+	TotalQuestions int              `json:"totalQuestions"`
+	Text           string           `json:"text"`
+	Media          *MediaDTO        `json:"media,omitempty"`
+	Answers        []PartyAnswerDTO `json:"answers"`
+	TimeLimit      int              `json:"timeLimit"`
+}
+
+// PartyAnswerDTO represents an answer option
+type PartyAnswerDTO struct {
+	ID    string    `json:"id"`
+	Text  string    `json:"text"`
+	Media *MediaDTO `json:"media,omitempty"`
+}
+
+// MediaDTO is an image on a question or answer (same shape in every mode)
+type MediaDTO = appQuiz.MediaDTO
+
+// ========================================
+// CreateRoom Use Case
+// ========================================
+
+type CreateRoomInput struct {
+	PlayerID        string   `json:"playerId"`
+	Username        string   `json:"username"`
+	Name            string   `json:"name"`
+	MaxPlayers      int      `json:"maxPlayers,omitempty"`
+	QuestionsCount  int      `json:"questionsCount,omitempty"`
+	TimePerQuestion int      `json:"timePerQuestion,omitempty"`
+	Difficulty      string   `json:"difficulty,omitempty"`
+	CategoryIDs     []string `json:"categoryIds,omitempty"`
+}
+
+type CreateRoomOutput struct {
+	Room PartyRoomDTO `json:"room"`
+}
+
+// ========================================
+// JoinRoom Use Case
+// ========================================
+
+type JoinRoomInput struct {
+	PlayerID string `json:"playerId"`
+	Username string `json:"username"`
+	RoomCode string `json:"roomCode"`
+}
+
+type JoinRoomOutput struct {
+	Room PartyRoomDTO `json:"room"`
+}
+
+// ========================================
+// SetReady Use Case
+// ========================================
+
+type SetReadyInput struct {
+	PlayerID string `json:"playerId"`
+	RoomID   string `json:"roomId"`
+	Ready    bool   `json:"ready"`
+}
+
+type SetReadyOutput struct {
+	Room PartyRoomDTO `json:"room"`
+}
+
+// ========================================
+// StartPartyGame Use Case
+// ========================================
+
+type StartPartyGameInput struct {
+	PlayerID string `json:"playerId"`
+	RoomID   string `json:"roomId"`
+}
+
+type StartPartyGameOutput struct {
+	GameID          string `json:"gameId"`
+	RoomID          string `json:"roomId"`
+	TotalQuestions  int    `json:"totalQuestions"`
+	TimePerQuestion int    `json:"timePerQuestion"`
+	StartsIn        int    `json:"startsIn"` // seconds until the first question
+}
+
+// ========================================
+// SubmitPartyAnswer Use Case
+// ========================================
+
+type SubmitPartyAnswerInput struct {
+	PlayerID   string `json:"playerId"`
+	GameID     string `json:"gameId"`
+	QuestionID string `json:"questionId"`
+	AnswerID   string `json:"answerId"`
+	TimeTaken  int64  `json:"timeTaken"` // milliseconds
+}
+
+type SubmitPartyAnswerOutput struct {
+	IsCorrect       bool    `json:"isCorrect"`
+	CorrectAnswerID string  `json:"correctAnswerId,omitempty"` // omitted when room hides correct answers
+	PointsEarned    int     `json:"pointsEarned"`
+	Position        int     `json:"position"`
+	PlayerScore     int     `json:"playerScore"`
+	QuestionNumber  int     `json:"questionNumber"`
+	AllAnswered     bool    `json:"allAnswered"`
+	GameFinished    bool    `json:"gameFinished"`
+	WinnerID        *string `json:"winnerId,omitempty"`
+}
+
+// ========================================
+// LeaveParty Use Case
+// ========================================
+
+type LeavePartyInput struct {
+	PlayerID string `json:"playerId"`
+	RoomID   string `json:"roomId"`
+}
+
+type LeavePartyOutput struct {
+	Success    bool   `json:"success"`
+	RoomStatus string `json:"roomStatus"`
+}
+
+// ========================================
+// GetRoom Use Case
+// ========================================
+
+type GetRoomInput struct {
+	PlayerID string `json:"playerId"`
+	RoomID   string `json:"roomId"`
+}
+
+type GetRoomOutput struct {
+	Room PartyRoomDTO  `json:"room"`
+	Game *PartyGameDTO `json:"game,omitempty"`
+}
+
+// ========================================
+// GetPartyQuestion Use Case
+// ========================================
+
+type GetPartyQuestionInput struct {
+	GameID string `json:"gameId"`
+}
+
+type GetPartyQuestionOutput struct {
+	RoomID   string           `json:"roomId"`
+	Question PartyQuestionDTO `json:"question"`
+}
@@ -0,0 +1,21 @@
+package party_mode
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+
+// EventBus defines the interface for publishing domain events
+// Implementation is in infrastructure layer
+type EventBus interface {
+	Publish(event party_mode.Event)
+}
+
+// NoOpEventBus is a no-operation event bus for testing
+type NoOpEventBus struct{}
+
+func (n *NoOpEventBus) Publish(event party_mode.Event) {
+	// No-op
+}
+
+// NewNoOpEventBus creates a new no-operation event bus
+func NewNoOpEventBus() *NoOpEventBus {
+	return &NoOpEventBus{}
+}
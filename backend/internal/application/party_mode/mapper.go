@@ -0,0 +1,104 @@
+package party_mode
+
+import (
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ToRoomSettingsDTO converts domain RoomSettings to DTO
+func ToRoomSettingsDTO(settings party_mode.RoomSettings) RoomSettingsDTO {
+	categories := make([]string, 0, len(settings.Categories()))
+	for _, c := range settings.Categories() {
+		categories = append(categories, c.String())
+	}
+
+	return RoomSettingsDTO{
+		MaxPlayers:        settings.MaxPlayers(),
+		QuestionsCount:    settings.QuestionsCount(),
+		TimePerQuestion:   settings.TimePerQuestion(),
+		Categories:        categories,
+		Difficulty:        settings.Difficulty(),
+		ShowCorrectAnswer: settings.ShowCorrectAnswer(),
+		ShowPlayerAnswers: settings.ShowPlayerAnswers(),
+		ShowCurrentScore:  settings.ShowCurrentScore(),
+	}
+}
+
+// ToPartyRoomDTO converts domain PartyRoom to DTO
+func ToPartyRoomDTO(room *party_mode.PartyRoom) PartyRoomDTO {
+	players := make([]RoomPlayerDTO, 0, room.PlayerCount())
+	for _, p := range room.Players() {
+		players = append(players, RoomPlayerDTO{
+			ID:        p.UserID().String(),
+			Username:  p.Username(),
+			IsHost:    p.IsHost(),
+			IsReady:   p.IsReady(),
+			Connected: p.Connected(),
+			JoinedAt:  p.JoinedAt(),
+		})
+	}
+
+	return PartyRoomDTO{
+		ID:        room.ID().String(),
+		Code:      room.Code().String(),
+		Name:      room.Name(),
+		HostID:    room.HostID().String(),
+		Status:    string(room.Status()),
+		Settings:  ToRoomSettingsDTO(room.Settings()),
+		Players:   players,
+		CreatedAt: room.CreatedAt(),
+		ExpiresAt: room.ExpiresAt(),
+	}
+}
+
+// ToPartyPlayerDTOs converts game players to DTOs
+func ToPartyPlayerDTOs(players []party_mode.PartyPlayer) []PartyPlayerDTO {
+	result := make([]PartyPlayerDTO, 0, len(players))
+	for _, p := range players {
+		result = append(result, PartyPlayerDTO{
+			ID:           p.UserID().String(),
+			Username:     p.Username(),
+			Score:        p.Score(),
+			Connected:    p.Connected(),
+			AnswersCount: p.AnswersCount(),
+		})
+	}
+	return result
+}
+
+// ToPartyGameDTO converts domain PartyGame to DTO
+func ToPartyGameDTO(game *party_mode.PartyGame) PartyGameDTO {
+	return PartyGameDTO{
+		ID:              game.ID().String(),
+		RoomID:          game.RoomID().String(),
+		Status:          string(game.Status()),
+		CurrentQuestion: game.CurrentQuestion() + 1,
+		TotalQuestions:  len(game.QuestionIDs()),
+		Players:         ToPartyPlayerDTOs(game.Players()),
+		StartedAt:       game.StartedAt(),
+		FinishedAt:      game.FinishedAt(),
+	}
+}
+
+// ToPartyQuestionDTO converts a quiz question to a party question DTO (hides correctness)
+func ToPartyQuestionDTO(question *quiz.Question, questionNumber, totalQuestions, timeLimit int) PartyQuestionDTO {
+	answers := make([]PartyAnswerDTO, 0, len(question.Answers()))
+	for _, a := range question.Answers() {
+		answers = append(answers, PartyAnswerDTO{
+			ID:    a.ID().String(),
+			Text:  a.Text().String(),
+			Media: appQuiz.ToMediaDTO(a.Media()),
+		})
+	}
+
+	return PartyQuestionDTO{
+		ID:             question.ID().String(),
+		QuestionNumber: questionNumber,
+		TotalQuestions: totalQuestions,
+		Text:           question.Text().String(),
+		Media:          appQuiz.ToMediaDTO(question.Media()),
+		Answers:        answers,
+		TimeLimit:      timeLimit,
+	}
+}
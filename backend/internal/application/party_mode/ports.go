@@ -0,0 +1,37 @@
+package party_mode
+
+import (
+	"context"
+	"database/sql"
+)
+
+// TxManager runs a function inside a database transaction.
+// Party players act concurrently (ready, answer, leave) and the aggregates are
+// loaded and saved as a whole, so every change to a room or to the game running
+// in it loads the room with RoomRepository.FindByIDForUpdate and saves in the
+// same transaction: the row lock serializes writers across server instances.
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// PartyEvent is a real-time room notification sent to every player via WebSocket.
+type PartyEvent struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// PartyHub manages WebSocket connections for party rooms.
+// Implementations must be safe for concurrent use.
+type PartyHub interface {
+	// Broadcast sends an event to every connected player in the room.
+	Broadcast(roomID string, event PartyEvent)
+	// QuestionStarted tells the hub that a new question is open for answers.
+	// The hub loads the question payload, sends it to the room and arms the timer.
+	QuestionStarted(roomID, gameID string, questionNumber int)
+}
+
+// NoOpPartyHub discards all notifications. Used when WS is not available.
+type NoOpPartyHub struct{}
+
+func (n *NoOpPartyHub) Broadcast(roomID string, event PartyEvent)                 {}
+func (n *NoOpPartyHub) QuestionStarted(roomID, gameID string, questionNumber int) {}
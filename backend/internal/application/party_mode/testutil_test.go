@@ -0,0 +1,394 @@
+package party_mode
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ========================================
+// Mock Repositories
+// ========================================
+
+// MockRoomRepository is an in-memory RoomRepository
+type MockRoomRepository struct {
+	rooms map[string]*party_mode.PartyRoom // keyed by room ID
+
+	// codeCollisions makes the next N saves of a new room fail as if its code were taken
+	codeCollisions int
+}
+
+func NewMockRoomRepository() *MockRoomRepository {
+	return &MockRoomRepository{
+		rooms: make(map[string]*party_mode.PartyRoom),
+	}
+}
+
+func (m *MockRoomRepository) Save(room *party_mode.PartyRoom) error {
+	if _, exists := m.rooms[room.ID().String()]; !exists {
+		if m.codeCollisions > 0 {
+			m.codeCollisions--
+			return party_mode.ErrRoomCodeTaken
+		}
+		if _, err := m.FindByCode(room.Code()); err == nil && room.Status() != party_mode.RoomStatusClosed {
+			return party_mode.ErrRoomCodeTaken
+		}
+	}
+	m.rooms[room.ID().String()] = room
+	return nil
+}
+
+func (m *MockRoomRepository) SaveInTx(_ *sql.Tx, room *party_mode.PartyRoom) error {
+	return m.Save(room)
+}
+
+func (m *MockRoomRepository) FindByID(id party_mode.RoomID) (*party_mode.PartyRoom, error) {
+	if r, ok := m.rooms[id.String()]; ok {
+		return r, nil
+	}
+	return nil, party_mode.ErrRoomNotFound
+}
+
+func (m *MockRoomRepository) FindByIDForUpdate(_ *sql.Tx, id party_mode.RoomID) (*party_mode.PartyRoom, error) {
+	return m.FindByID(id)
+}
+
+func (m *MockRoomRepository) FindByCode(code party_mode.RoomCode) (*party_mode.PartyRoom, error) {
+	for _, r := range m.rooms {
+		if r.Code().Equals(code) && r.Status() != party_mode.RoomStatusClosed {
+			return r, nil
+		}
+	}
+	return nil, party_mode.ErrRoomNotFound
+}
+
+func (m *MockRoomRepository) FindActiveRooms() ([]*party_mode.PartyRoom, error) {
+	var result []*party_mode.PartyRoom
+	for _, r := range m.rooms {
+		if r.Status() == party_mode.RoomStatusLobby {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRoomRepository) Delete(id party_mode.RoomID) error {
+	delete(m.rooms, id.String())
+	return nil
+}
+
+// MockGameRepository is an in-memory GameRepository
+type MockGameRepository struct {
+	games map[string]*party_mode.PartyGame // keyed by game ID
+}
+
+func NewMockGameRepository() *MockGameRepository {
+	return &MockGameRepository{
+		games: make(map[string]*party_mode.PartyGame),
+	}
+}
+
+func (m *MockGameRepository) Save(game *party_mode.PartyGame) error {
+	m.games[game.ID().String()] = game
+	return nil
+}
+
+func (m *MockGameRepository) SaveInTx(_ *sql.Tx, game *party_mode.PartyGame) error {
+	return m.Save(game)
+}
+
+func (m *MockGameRepository) FindByID(id party_mode.GameID) (*party_mode.PartyGame, error) {
+	if g, ok := m.games[id.String()]; ok {
+		return g, nil
+	}
+	return nil, party_mode.ErrGameNotFound
+}
+
+func (m *MockGameRepository) FindByRoomID(roomID party_mode.RoomID) (*party_mode.PartyGame, error) {
+	for _, g := range m.games {
+		if g.RoomID().Equals(roomID) {
+			return g, nil
+		}
+	}
+	return nil, party_mode.ErrGameNotFound
+}
+
+func (m *MockGameRepository) Delete(id party_mode.GameID) error {
+	delete(m.games, id.String())
+	return nil
+}
+
+// MockQuestionRepository is an in-memory QuestionRepository
+type MockQuestionRepository struct {
+	questions map[string]*quiz.Question // keyed by question ID
+}
+
+func NewMockQuestionRepository() *MockQuestionRepository {
+	return &MockQuestionRepository{
+		questions: make(map[string]*quiz.Question),
+	}
+}
+
+func (m *MockQuestionRepository) AddQuestion(q *quiz.Question) {
+	m.questions[q.ID().String()] = q
+}
+
+func (m *MockQuestionRepository) FindByID(id quiz.QuestionID) (*quiz.Question, error) {
+	if q, ok := m.questions[id.String()]; ok {
+		return q, nil
+	}
+	return nil, quiz.ErrQuestionNotFound
+}
+
+func (m *MockQuestionRepository) FindByIDs(ids []quiz.QuestionID) ([]*quiz.Question, error) {
+	result := make([]*quiz.Question, 0, len(ids))
+	for _, id := range ids {
+		if q, ok := m.questions[id.String()]; ok {
+			result = append(result, q)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockQuestionRepository) FindByFilter(_ quiz.QuestionFilter) ([]*quiz.Question, error) {
+	var result []*quiz.Question
+	for _, q := range m.questions {
+		result = append(result, q)
+	}
+	return result, nil
+}
+
+func (m *MockQuestionRepository) FindRandomQuestions(_ quiz.QuestionFilter, limit int) ([]*quiz.Question, error) {
+	var result []*quiz.Question
+	for _, q := range m.questions {
+		result = append(result, q)
+		if len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m *MockQuestionRepository) FindQuestionsBySeed(filter quiz.QuestionFilter, limit int, _ int64) ([]*quiz.Question, error) {
+	return m.FindRandomQuestions(filter, limit)
+}
+
+func (m *MockQuestionRepository) FindQuestionsByQuizSeed(questionsPerQuiz int, _ int64, _ *quiz.CategoryID) ([]*quiz.Question, error) {
+	return m.FindRandomQuestions(quiz.NewQuestionFilter(), questionsPerQuiz)
+}
+
+func (m *MockQuestionRepository) CountByFilter(_ quiz.QuestionFilter) (int, error) {
+	return len(m.questions), nil
+}
+
+func (m *MockQuestionRepository) Save(question *quiz.Question) error {
+	m.questions[question.ID().String()] = question
+	return nil
+}
+
+func (m *MockQuestionRepository) SaveAll(questions []*quiz.Question) error {
+	for _, q := range questions {
+		m.questions[q.ID().String()] = q
+	}
+	return nil
+}
+
+func (m *MockQuestionRepository) SaveInTx(_ *sql.Tx, question *quiz.Question) error {
+	return m.Save(question)
+}
+
+func (m *MockQuestionRepository) Delete(id quiz.QuestionID) error {
+	delete(m.questions, id.String())
+	return nil
+}
+
+// mockTxManager runs the function directly, without a transaction
+type mockTxManager struct{}
+
+func (m *mockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	return fn(nil)
+}
+
+// MockEventBus collects published events
+type MockEventBus struct {
+	Events []party_mode.Event
+}
+
+func NewMockEventBus() *MockEventBus {
+	return &MockEventBus{
+		Events: make([]party_mode.Event, 0),
+	}
+}
+
+func (m *MockEventBus) Publish(event party_mode.Event) {
+	m.Events = append(m.Events, event)
+}
+
+// HasEvent reports whether an event of the given type was published
+func (m *MockEventBus) HasEvent(eventType string) bool {
+	for _, e := range m.Events {
+		if e.EventType() == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ========================================
+// Test Helpers
+// ========================================
+
+const testHostID = "host123"
+const testGuestID = "guest456"
+
+// newTestQuestion creates a test question with 4 answers (first is correct)
+func newTestQuestion(t *testing.T, position int) *quiz.Question {
+	t.Helper()
+
+	questionText, _ := quiz.NewQuestionText(fmt.Sprintf("Test Question %d", position))
+	points, _ := quiz.NewPoints(100)
+
+	q, err := quiz.NewQuestion(
+		quiz.NewQuestionID(),
+		questionText,
+		points,
+		position,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create test question: %v", err)
+	}
+
+	correctText, _ := quiz.NewAnswerText("Correct Answer")
+	correct, _ := quiz.NewAnswer(quiz.NewAnswerID(), correctText, true, 1)
+	q.AddAnswer(*correct)
+
+	for i := 2; i <= 4; i++ {
+		wrongText, _ := quiz.NewAnswerText(fmt.Sprintf("Wrong Answer %d", i))
+		wrong, _ := quiz.NewAnswer(quiz.NewAnswerID(), wrongText, false, i)
+		q.AddAnswer(*wrong)
+	}
+
+	return q
+}
+
+// correctAnswerID returns the ID of the question's correct answer
+func correctAnswerID(q *quiz.Question) string {
+	for _, a := range q.Answers() {
+		if a.IsCorrect() {
+			return a.ID().String()
+		}
+	}
+	return ""
+}
+
+// partyFixture wires all party use cases against in-memory repositories
+type partyFixture struct {
+	roomRepo     *MockRoomRepository
+	gameRepo     *MockGameRepository
+	questionRepo *MockQuestionRepository
+	eventBus     *MockEventBus
+
+	createRoom  *CreateRoomUseCase
+	joinRoom    *JoinRoomUseCase
+	setReady    *SetReadyUseCase
+	startGame   *StartPartyGameUseCase
+	submit      *SubmitPartyAnswerUseCase
+	leave       *LeavePartyUseCase
+	getRoom     *GetRoomUseCase
+	getQuestion *GetPartyQuestionUseCase
+}
+
+// newPartyFixture creates a fixture with questionCount questions in the pool
+func newPartyFixture(t *testing.T, questionCount int) *partyFixture {
+	t.Helper()
+
+	f := &partyFixture{
+		roomRepo:     NewMockRoomRepository(),
+		gameRepo:     NewMockGameRepository(),
+		questionRepo: NewMockQuestionRepository(),
+		eventBus:     NewMockEventBus(),
+	}
+
+	for i := 1; i <= questionCount; i++ {
+		f.questionRepo.AddQuestion(newTestQuestion(t, i))
+	}
+
+	f.createRoom = NewCreateRoomUseCase(f.roomRepo, f.eventBus)
+	txManager := &mockTxManager{}
+	f.joinRoom = NewJoinRoomUseCase(f.roomRepo, txManager, f.eventBus)
+	f.setReady = NewSetReadyUseCase(f.roomRepo, txManager, f.eventBus)
+	f.startGame = NewStartPartyGameUseCase(f.roomRepo, f.gameRepo, f.questionRepo, txManager, f.eventBus)
+	f.submit = NewSubmitPartyAnswerUseCase(f.roomRepo, f.gameRepo, f.questionRepo, txManager, f.eventBus)
+	f.leave = NewLeavePartyUseCase(f.roomRepo, f.gameRepo, txManager, f.eventBus)
+	f.getRoom = NewGetRoomUseCase(f.roomRepo, f.gameRepo)
+	f.getQuestion = NewGetPartyQuestionUseCase(f.roomRepo, f.gameRepo, f.questionRepo)
+
+	return f
+}
+
+// readyRoom creates a room with host + guest, guest ready; returns room ID
+func (f *partyFixture) readyRoom(t *testing.T) string {
+	t.Helper()
+
+	created, err := f.createRoom.Execute(CreateRoomInput{
+		PlayerID:       testHostID,
+		Username:       "Host",
+		QuestionsCount: 10,
+	})
+	if err != nil {
+		t.Fatalf("CreateRoom: %v", err)
+	}
+
+	if _, err := f.joinRoom.Execute(JoinRoomInput{
+		PlayerID: testGuestID,
+		Username: "Guest",
+		RoomCode: created.Room.Code,
+	}); err != nil {
+		t.Fatalf("JoinRoom: %v", err)
+	}
+
+	if _, err := f.setReady.Execute(SetReadyInput{
+		PlayerID: testGuestID,
+		RoomID:   created.Room.ID,
+		Ready:    true,
+	}); err != nil {
+		t.Fatalf("SetReady: %v", err)
+	}
+
+	return created.Room.ID
+}
+
+// startedGame creates a ready room and starts the game; returns room and game IDs
+func (f *partyFixture) startedGame(t *testing.T) (string, string) {
+	t.Helper()
+
+	roomID := f.readyRoom(t)
+	started, err := f.startGame.Execute(StartPartyGameInput{
+		PlayerID: testHostID,
+		RoomID:   roomID,
+	})
+	if err != nil {
+		t.Fatalf("StartPartyGame: %v", err)
+	}
+
+	return roomID, started.GameID
+}
+
+// currentQuestion returns the question currently open in the game
+func (f *partyFixture) currentQuestion(t *testing.T, gameID string) *quiz.Question {
+	t.Helper()
+
+	game, err := f.gameRepo.FindByID(party_mode.NewGameIDFromString(gameID))
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	q, err := f.questionRepo.FindByID(game.CurrentQuestionID())
+	if err != nil {
+		t.Fatalf("question FindByID: %v", err)
+	}
+	return q
+}
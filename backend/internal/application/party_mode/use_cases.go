@@ -0,0 +1,805 @@
+package party_mode
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// QuestionStartDelaySec is the pause before each question is shown,
+// giving players time to see the previous result (and the countdown before the first one).
+const QuestionStartDelaySec = 3
+
+// ========================================
+// CreateRoom Use Case
+// ========================================
+
+// maxRoomCodeAttempts bounds how many room codes CreateRoomUseCase draws before giving up
+const maxRoomCodeAttempts = 5
+
+type CreateRoomUseCase struct {
+	roomRepo party_mode.RoomRepository
+	eventBus EventBus
+}
+
+func NewCreateRoomUseCase(
+	roomRepo party_mode.RoomRepository,
+	eventBus EventBus,
+) *CreateRoomUseCase {
+	return &CreateRoomUseCase{
+		roomRepo: roomRepo,
+		eventBus: eventBus,
+	}
+}
+
+func (uc *CreateRoomUseCase) Execute(input CreateRoomInput) (CreateRoomOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	hostID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return CreateRoomOutput{}, err
+	}
+
+	settings, err := buildRoomSettings(input)
+	if err != nil {
+		return CreateRoomOutput{}, err
+	}
+
+	name := input.Name
+	if name == "" {
+		name = input.Username + "'s party"
+	}
+
+	// A fresh code is drawn when the generated one is held by another open room
+	var room *party_mode.PartyRoom
+	for attempt := 1; ; attempt++ {
+		room, err = party_mode.NewPartyRoom(hostID, input.Username, name, settings, now)
+		if err != nil {
+			return CreateRoomOutput{}, err
+		}
+
+		err = uc.roomRepo.Save(room)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, party_mode.ErrRoomCodeTaken) || attempt == maxRoomCodeAttempts {
+			return CreateRoomOutput{}, fmt.Errorf("create room: save: %w", err)
+		}
+	}
+
+	for _, event := range room.Events() {
+		uc.eventBus.Publish(event)
+	}
+
+	return CreateRoomOutput{Room: ToPartyRoomDTO(room)}, nil
+}
+
+// buildRoomSettings merges client overrides into the default room settings
+func buildRoomSettings(input CreateRoomInput) (party_mode.RoomSettings, error) {
+	defaults := party_mode.NewRoomSettings()
+
+	maxPlayers := defaults.MaxPlayers()
+	if input.MaxPlayers != 0 {
+		maxPlayers = input.MaxPlayers
+	}
+	questionsCount := defaults.QuestionsCount()
+	if input.QuestionsCount != 0 {
+		questionsCount = input.QuestionsCount
+	}
+	timePerQuestion := defaults.TimePerQuestion()
+	if input.TimePerQuestion != 0 {
+		timePerQuestion = input.TimePerQuestion
+	}
+
+	difficulty := defaults.Difficulty()
+	switch input.Difficulty {
+	case "":
+	case "easy", "mix", "hard":
+		difficulty = input.Difficulty
+	default:
+		return party_mode.RoomSettings{}, party_mode.ErrInvalidRoomSettings
+	}
+
+	categories := make([]party_mode.CategoryID, 0, len(input.CategoryIDs))
+	for _, idStr := range input.CategoryIDs {
+		categoryID, err := quiz.NewCategoryIDFromString(idStr)
+		if err != nil {
+			return party_mode.RoomSettings{}, party_mode.ErrInvalidRoomSettings
+		}
+		categories = append(categories, categoryID)
+	}
+
+	return party_mode.ReconstructRoomSettings(
+		maxPlayers,
+		questionsCount,
+		timePerQuestion,
+		categories,
+		difficulty,
+		defaults.ShowCorrectAnswer(),
+		defaults.ShowPlayerAnswers(),
+		defaults.ShowCurrentScore(),
+	), nil
+}
+
+// ========================================
+// JoinRoom Use Case
+// ========================================
+
+type JoinRoomUseCase struct {
+	roomRepo  party_mode.RoomRepository
+	txManager TxManager
+	eventBus  EventBus
+}
+
+func NewJoinRoomUseCase(
+	roomRepo party_mode.RoomRepository,
+	txManager TxManager,
+	eventBus EventBus,
+) *JoinRoomUseCase {
+	return &JoinRoomUseCase{
+		roomRepo:  roomRepo,
+		txManager: txManager,
+		eventBus:  eventBus,
+	}
+}
+
+func (uc *JoinRoomUseCase) Execute(input JoinRoomInput) (JoinRoomOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return JoinRoomOutput{}, err
+	}
+
+	code := party_mode.NewRoomCodeFromString(input.RoomCode)
+	if code.IsZero() {
+		return JoinRoomOutput{}, party_mode.ErrInvalidRoomCode
+	}
+
+	found, err := uc.roomRepo.FindByCode(code)
+	if err != nil {
+		return JoinRoomOutput{}, err
+	}
+
+	// Reload under the row lock so concurrent joins see each other
+	var room *party_mode.PartyRoom
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		locked, err := uc.roomRepo.FindByIDForUpdate(tx, found.ID())
+		if err != nil {
+			return err
+		}
+		room = locked
+
+		if room.Status() == party_mode.RoomStatusClosed || room.IsExpired(now) {
+			return party_mode.ErrRoomClosed
+		}
+
+		// Re-joining a room you are already in is a no-op (e.g. app reload)
+		if room.HasPlayer(playerID) {
+			return nil
+		}
+
+		if err := room.JoinPlayer(playerID, input.Username, now); err != nil {
+			return err
+		}
+
+		if err := uc.roomRepo.SaveInTx(tx, room); err != nil {
+			return fmt.Errorf("join room: save: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return JoinRoomOutput{}, err
+	}
+
+	for _, event := range room.Events() {
+		uc.eventBus.Publish(event)
+	}
+
+	return JoinRoomOutput{Room: ToPartyRoomDTO(room)}, nil
+}
+
+// ========================================
+// SetReady Use Case
+// ========================================
+
+type SetReadyUseCase struct {
+	roomRepo  party_mode.RoomRepository
+	txManager TxManager
+	eventBus  EventBus
+}
+
+func NewSetReadyUseCase(
+	roomRepo party_mode.RoomRepository,
+	txManager TxManager,
+	eventBus EventBus,
+) *SetReadyUseCase {
+	return &SetReadyUseCase{
+		roomRepo:  roomRepo,
+		txManager: txManager,
+		eventBus:  eventBus,
+	}
+}
+
+func (uc *SetReadyUseCase) Execute(input SetReadyInput) (SetReadyOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return SetReadyOutput{}, err
+	}
+
+	var room *party_mode.PartyRoom
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		locked, err := uc.roomRepo.FindByIDForUpdate(tx, party_mode.NewRoomIDFromString(input.RoomID))
+		if err != nil {
+			return err
+		}
+		room = locked
+
+		if err := room.SetPlayerReady(playerID, input.Ready, now); err != nil {
+			return err
+		}
+
+		if err := uc.roomRepo.SaveInTx(tx, room); err != nil {
+			return fmt.Errorf("set ready: save: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return SetReadyOutput{}, err
+	}
+
+	for _, event := range room.Events() {
+		uc.eventBus.Publish(event)
+	}
+
+	return SetReadyOutput{Room: ToPartyRoomDTO(room)}, nil
+}
+
+// ========================================
+// StartPartyGame Use Case
+// ========================================
+
+type StartPartyGameUseCase struct {
+	roomRepo     party_mode.RoomRepository
+	gameRepo     party_mode.GameRepository
+	questionRepo quiz.QuestionRepository
+	txManager    TxManager
+	eventBus     EventBus
+}
+
+func NewStartPartyGameUseCase(
+	roomRepo party_mode.RoomRepository,
+	gameRepo party_mode.GameRepository,
+	questionRepo quiz.QuestionRepository,
+	txManager TxManager,
+	eventBus EventBus,
+) *StartPartyGameUseCase {
+	return &StartPartyGameUseCase{
+		roomRepo:     roomRepo,
+		gameRepo:     gameRepo,
+		questionRepo: questionRepo,
+		txManager:    txManager,
+		eventBus:     eventBus,
+	}
+}
+
+func (uc *StartPartyGameUseCase) Execute(input StartPartyGameInput) (StartPartyGameOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return StartPartyGameOutput{}, err
+	}
+
+	var (
+		room *party_mode.PartyRoom
+		game *party_mode.PartyGame
+	)
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		locked, err := uc.roomRepo.FindByIDForUpdate(tx, party_mode.NewRoomIDFromString(input.RoomID))
+		if err != nil {
+			return err
+		}
+		room = locked
+
+		if room.Status() != party_mode.RoomStatusLobby {
+			return party_mode.ErrRoomAlreadyStarted
+		}
+
+		if err := room.CanStartGame(playerID); err != nil {
+			return err
+		}
+
+		questionIDs, err := uc.selectQuestions(room.Settings())
+		if err != nil {
+			return err
+		}
+
+		game, err = party_mode.NewPartyGame(room.ID(), questionIDs, room.Players(), now)
+		if err != nil {
+			return err
+		}
+
+		if err := room.StartGame(); err != nil {
+			return err
+		}
+
+		if err := uc.gameRepo.SaveInTx(tx, game); err != nil {
+			return fmt.Errorf("start party game: save game: %w", err)
+		}
+		if err := uc.roomRepo.SaveInTx(tx, room); err != nil {
+			return fmt.Errorf("start party game: save room: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return StartPartyGameOutput{}, err
+	}
+
+	for _, event := range room.Events() {
+		uc.eventBus.Publish(event)
+	}
+	for _, event := range game.Events() {
+		uc.eventBus.Publish(event)
+	}
+
+	return StartPartyGameOutput{
+		GameID:          game.ID().String(),
+		RoomID:          room.ID().String(),
+		TotalQuestions:  len(game.QuestionIDs()),
+		TimePerQuestion: room.Settings().TimePerQuestion(),
+		StartsIn:        QuestionStartDelaySec,
+	}, nil
+}
+
+// selectQuestions picks random questions honouring the room's categories and difficulty.
+// With several categories the questions are spread evenly across them.
+func (uc *StartPartyGameUseCase) selectQuestions(settings party_mode.RoomSettings) ([]party_mode.QuestionID, error) {
+	count := settings.QuestionsCount()
+
+	// Party rounds are answered with a single option
+	baseFilter := quiz.NewQuestionFilter().WithTypes(quiz.QuestionTypeSingleChoice, quiz.QuestionTypeTrueFalse)
+	if settings.Difficulty() != "mix" {
+		baseFilter = baseFilter.WithDifficulty(settings.Difficulty())
+	}
+
+	var questions []*quiz.Question
+	categories := settings.Categories()
+	if len(categories) == 0 {
+		found, err := uc.questionRepo.FindRandomQuestions(baseFilter, count)
+		if err != nil {
+			return nil, err
+		}
+		questions = found
+	} else {
+		perCategory := (count + len(categories) - 1) / len(categories)
+		for _, categoryID := range categories {
+			filter := baseFilter.WithCategory(categoryID).WithExcludeIDs(questionIDsOf(questions))
+			found, err := uc.questionRepo.FindRandomQuestions(filter, perCategory)
+			if err != nil {
+				return nil, err
+			}
+			questions = append(questions, found...)
+		}
+		rand.Shuffle(len(questions), func(i, j int) {
+			questions[i], questions[j] = questions[j], questions[i]
+		})
+	}
+
+	if len(questions) < count {
+		return nil, party_mode.ErrNotEnoughQuestions
+	}
+
+	return questionIDsOf(questions[:count]), nil
+}
+
+func questionIDsOf(questions []*quiz.Question) []party_mode.QuestionID {
+	ids := make([]party_mode.QuestionID, 0, len(questions))
+	for _, q := range questions {
+		ids = append(ids, q.ID())
+	}
+	return ids
+}
+
+// ========================================
+// SubmitPartyAnswer Use Case
+// ========================================
+
+type SubmitPartyAnswerUseCase struct {
+	roomRepo     party_mode.RoomRepository
+	gameRepo     party_mode.GameRepository
+	questionRepo quiz.QuestionRepository
+	txManager    TxManager
+	eventBus     EventBus
+}
+
+func NewSubmitPartyAnswerUseCase(
+	roomRepo party_mode.RoomRepository,
+	gameRepo party_mode.GameRepository,
+	questionRepo quiz.QuestionRepository,
+	txManager TxManager,
+	eventBus EventBus,
+) *SubmitPartyAnswerUseCase {
+	return &SubmitPartyAnswerUseCase{
+		roomRepo:     roomRepo,
+		gameRepo:     gameRepo,
+		questionRepo: questionRepo,
+		txManager:    txManager,
+		eventBus:     eventBus,
+	}
+}
+
+func (uc *SubmitPartyAnswerUseCase) Execute(input SubmitPartyAnswerInput) (*SubmitPartyAnswerOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	answerID, err := quiz.NewAnswerIDFromString(input.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	gameID := party_mode.NewGameIDFromString(input.GameID)
+	found, err := uc.gameRepo.FindByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		room     *party_mode.PartyRoom
+		game     *party_mode.PartyGame
+		question *quiz.Question
+		result   *party_mode.SubmitAnswerResult
+	)
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		locked, err := uc.roomRepo.FindByIDForUpdate(tx, found.RoomID())
+		if err != nil {
+			return err
+		}
+		room = locked
+
+		// Reload under the room lock: another answer may have landed meanwhile
+		game, err = uc.gameRepo.FindByID(gameID)
+		if err != nil {
+			return err
+		}
+
+		if game.Status() != party_mode.GameStatusInProgress {
+			return party_mode.ErrGameNotActive
+		}
+		if !game.HasPlayer(playerID) {
+			return party_mode.ErrPlayerNotFound
+		}
+
+		// Stale question: the question timed out while the answer was in flight
+		currentQuestionID := game.CurrentQuestionID()
+		if input.QuestionID != "" && input.QuestionID != currentQuestionID.String() {
+			return party_mode.ErrPlayerAlreadyAnswered
+		}
+
+		question, err = uc.questionRepo.FindByID(currentQuestionID)
+		if err != nil {
+			return fmt.Errorf("submit party answer: load question: %w", err)
+		}
+
+		timeTaken := input.TimeTaken
+		maxTime := int64(room.Settings().TimePerQuestion()) * 1000
+		if timeTaken < 0 {
+			timeTaken = 0
+		}
+		if timeTaken > maxTime {
+			timeTaken = maxTime
+		}
+
+		result, err = game.SubmitAnswer(playerID, answerID, timeTaken, question, now)
+		if err != nil {
+			return err
+		}
+
+		return uc.saveInTx(tx, room, game)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.publish(game)
+
+	output := &SubmitPartyAnswerOutput{
+		IsCorrect:      result.IsCorrect,
+		PointsEarned:   result.PointsEarned,
+		Position:       result.Position,
+		PlayerScore:    result.PlayerScore,
+		QuestionNumber: result.QuestionNumber,
+		AllAnswered:    result.AllAnswered,
+		GameFinished:   result.IsGameFinished,
+	}
+	if result.WinnerID != nil {
+		winnerID := result.WinnerID.String()
+		output.WinnerID = &winnerID
+	}
+	if room.Settings().ShowCorrectAnswer() {
+		for _, a := range question.Answers() {
+			if a.IsCorrect() {
+				output.CorrectAnswerID = a.ID().String()
+				break
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// TimeoutQuestion completes the given question (1-based) when its timer fires.
+// Returns false (no error) when the game already moved past that question.
+func (uc *SubmitPartyAnswerUseCase) TimeoutQuestion(gameIDStr string, questionNumber int) (bool, error) {
+	now := time.Now().UTC().Unix()
+
+	gameID := party_mode.NewGameIDFromString(gameIDStr)
+	found, err := uc.gameRepo.FindByID(gameID)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		game     *party_mode.PartyGame
+		advanced bool
+	)
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		room, err := uc.roomRepo.FindByIDForUpdate(tx, found.RoomID())
+		if err != nil {
+			return err
+		}
+
+		game, err = uc.gameRepo.FindByID(gameID)
+		if err != nil {
+			return err
+		}
+
+		advanced, err = game.TimeoutQuestion(questionNumber, now)
+		if err != nil || !advanced {
+			return err
+		}
+
+		return uc.saveInTx(tx, room, game)
+	})
+	if err != nil || !advanced {
+		return false, err
+	}
+	uc.publish(game)
+
+	return true, nil
+}
+
+// saveInTx persists the game and closes the room when the game is over
+func (uc *SubmitPartyAnswerUseCase) saveInTx(tx *sql.Tx, room *party_mode.PartyRoom, game *party_mode.PartyGame) error {
+	if err := uc.gameRepo.SaveInTx(tx, game); err != nil {
+		return fmt.Errorf("party game: save: %w", err)
+	}
+
+	if game.IsFinished() && room.Status() != party_mode.RoomStatusClosed {
+		if err := room.Close(); err == nil {
+			if err := uc.roomRepo.SaveInTx(tx, room); err != nil {
+				return fmt.Errorf("party game: close room: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// publish sends the game's events once they are committed
+func (uc *SubmitPartyAnswerUseCase) publish(game *party_mode.PartyGame) {
+	for _, event := range game.Events() {
+		uc.eventBus.Publish(event)
+	}
+}
+
+// ========================================
+// LeaveParty Use Case
+// ========================================
+
+type LeavePartyUseCase struct {
+	roomRepo  party_mode.RoomRepository
+	gameRepo  party_mode.GameRepository
+	txManager TxManager
+	eventBus  EventBus
+}
+
+func NewLeavePartyUseCase(
+	roomRepo party_mode.RoomRepository,
+	gameRepo party_mode.GameRepository,
+	txManager TxManager,
+	eventBus EventBus,
+) *LeavePartyUseCase {
+	return &LeavePartyUseCase{
+		roomRepo:  roomRepo,
+		gameRepo:  gameRepo,
+		txManager: txManager,
+		eventBus:  eventBus,
+	}
+}
+
+func (uc *LeavePartyUseCase) Execute(input LeavePartyInput) (LeavePartyOutput, error) {
+	now := time.Now().UTC().Unix()
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return LeavePartyOutput{}, err
+	}
+
+	var (
+		room *party_mode.PartyRoom
+		game *party_mode.PartyGame
+	)
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		locked, err := uc.roomRepo.FindByIDForUpdate(tx, party_mode.NewRoomIDFromString(input.RoomID))
+		if err != nil {
+			return err
+		}
+		room = locked
+
+		// Mid-game: stop waiting for the player's answers before leaving the room
+		if room.Status() == party_mode.RoomStatusPlaying {
+			game, err = uc.gameRepo.FindByRoomID(room.ID())
+			if err != nil && !errors.Is(err, party_mode.ErrGameNotFound) {
+				return err
+			}
+			if game != nil && game.Status() == party_mode.GameStatusInProgress {
+				if err := game.LeavePlayer(playerID, now); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := room.RemovePlayer(playerID, now); err != nil {
+			return err
+		}
+
+		if game != nil && game.IsFinished() && room.Status() != party_mode.RoomStatusClosed {
+			_ = room.Close()
+		}
+
+		if game != nil {
+			if err := uc.gameRepo.SaveInTx(tx, game); err != nil {
+				return fmt.Errorf("leave party: save game: %w", err)
+			}
+		}
+		if err := uc.roomRepo.SaveInTx(tx, room); err != nil {
+			return fmt.Errorf("leave party: save room: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return LeavePartyOutput{}, err
+	}
+
+	for _, event := range room.Events() {
+		uc.eventBus.Publish(event)
+	}
+	if game != nil {
+		for _, event := range game.Events() {
+			uc.eventBus.Publish(event)
+		}
+	}
+
+	return LeavePartyOutput{
+		Success:    true,
+		RoomStatus: string(room.Status()),
+	}, nil
+}
+
+// ========================================
+// GetRoom Use Case
+// ========================================
+
+type GetRoomUseCase struct {
+	roomRepo party_mode.RoomRepository
+	gameRepo party_mode.GameRepository
+}
+
+func NewGetRoomUseCase(
+	roomRepo party_mode.RoomRepository,
+	gameRepo party_mode.GameRepository,
+) *GetRoomUseCase {
+	return &GetRoomUseCase{
+		roomRepo: roomRepo,
+		gameRepo: gameRepo,
+	}
+}
+
+// Execute returns the room (and its game, if started). Only room members may read it.
+func (uc *GetRoomUseCase) Execute(input GetRoomInput) (GetRoomOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetRoomOutput{}, err
+	}
+
+	room, err := uc.roomRepo.FindByID(party_mode.NewRoomIDFromString(input.RoomID))
+	if err != nil {
+		return GetRoomOutput{}, err
+	}
+
+	game, err := uc.gameRepo.FindByRoomID(room.ID())
+	if err != nil && !errors.Is(err, party_mode.ErrGameNotFound) {
+		return GetRoomOutput{}, err
+	}
+
+	// Players who left mid-game are still on the scoreboard and may watch the results
+	isMember := room.HasPlayer(playerID) || (game != nil && game.HasPlayer(playerID))
+	if !isMember {
+		return GetRoomOutput{}, party_mode.ErrPlayerNotFound
+	}
+
+	output := GetRoomOutput{Room: ToPartyRoomDTO(room)}
+	if game != nil {
+		gameDTO := ToPartyGameDTO(game)
+		output.Game = &gameDTO
+	}
+
+	return output, nil
+}
+
+// ========================================
+// GetPartyQuestion Use Case
+// ========================================
+
+type GetPartyQuestionUseCase struct {
+	roomRepo     party_mode.RoomRepository
+	gameRepo     party_mode.GameRepository
+	questionRepo quiz.QuestionRepository
+}
+
+func NewGetPartyQuestionUseCase(
+	roomRepo party_mode.RoomRepository,
+	gameRepo party_mode.GameRepository,
+	questionRepo quiz.QuestionRepository,
+) *GetPartyQuestionUseCase {
+	return &GetPartyQuestionUseCase{
+		roomRepo:     roomRepo,
+		gameRepo:     gameRepo,
+		questionRepo: questionRepo,
+	}
+}
+
+// Execute returns the question currently open for answers in an in-progress game.
+// Used by the WebSocket hub to broadcast questions and resend them on reconnect.
+func (uc *GetPartyQuestionUseCase) Execute(input GetPartyQuestionInput) (GetPartyQuestionOutput, error) {
+	game, err := uc.gameRepo.FindByID(party_mode.NewGameIDFromString(input.GameID))
+	if err != nil {
+		return GetPartyQuestionOutput{}, err
+	}
+
+	if game.Status() != party_mode.GameStatusInProgress {
+		return GetPartyQuestionOutput{}, party_mode.ErrGameNotActive
+	}
+
+	room, err := uc.roomRepo.FindByID(game.RoomID())
+	if err != nil {
+		return GetPartyQuestionOutput{}, err
+	}
+
+	question, err := uc.questionRepo.FindByID(game.CurrentQuestionID())
+	if err != nil {
+		return GetPartyQuestionOutput{}, fmt.Errorf("get party question: load question: %w", err)
+	}
+
+	return GetPartyQuestionOutput{
+		RoomID: room.ID().String(),
+		Question: ToPartyQuestionDTO(
+			question,
+			game.CurrentQuestion()+1,
+			len(game.QuestionIDs()),
+			room.Settings().TimePerQuestion(),
+		),
+	}, nil
+}
@@ -0,0 +1,311 @@
+package party_mode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+)
+
+// ========================================
+// CreateRoom / JoinRoom Use Case Tests
+// ========================================
+
+func TestCreateRoom_AppliesOverrides(t *testing.T) {
+	f := newPartyFixture(t, 10)
+
+	output, err := f.createRoom.Execute(CreateRoomInput{
+		PlayerID:        testHostID,
+		Username:        "Host",
+		MaxPlayers:      4,
+		QuestionsCount:  15,
+		TimePerQuestion: 20,
+		Difficulty:      "hard",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if output.Room.Code == "" {
+		t.Error("Expected room code to be set")
+	}
+	if output.Room.HostID != testHostID {
+		t.Errorf("HostID = %s, want %s", output.Room.HostID, testHostID)
+	}
+	if output.Room.Settings.MaxPlayers != 4 || output.Room.Settings.QuestionsCount != 15 || output.Room.Settings.TimePerQuestion != 20 {
+		t.Errorf("Settings not applied: %+v", output.Room.Settings)
+	}
+	if output.Room.Settings.Difficulty != "hard" {
+		t.Errorf("Difficulty = %s, want hard", output.Room.Settings.Difficulty)
+	}
+	if output.Room.Name != "Host's party" {
+		t.Errorf("Name = %q, want default name", output.Room.Name)
+	}
+}
+
+func TestCreateRoom_InvalidSettings(t *testing.T) {
+	f := newPartyFixture(t, 10)
+
+	_, err := f.createRoom.Execute(CreateRoomInput{
+		PlayerID:   testHostID,
+		Username:   "Host",
+		MaxPlayers: 20,
+	})
+	if err != party_mode.ErrInvalidRoomSettings {
+		t.Errorf("Expected ErrInvalidRoomSettings, got %v", err)
+	}
+
+	_, err = f.createRoom.Execute(CreateRoomInput{
+		PlayerID:   testHostID,
+		Username:   "Host",
+		Difficulty: "insane",
+	})
+	if err != party_mode.ErrInvalidRoomSettings {
+		t.Errorf("Expected ErrInvalidRoomSettings for difficulty, got %v", err)
+	}
+}
+
+func TestCreateRoom_RegeneratesTakenCode(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	f.roomRepo.codeCollisions = 2
+
+	output, err := f.createRoom.Execute(CreateRoomInput{PlayerID: testHostID, Username: "Host"})
+	if err != nil {
+		t.Fatalf("CreateRoom failed: %v", err)
+	}
+	if _, err := f.roomRepo.FindByCode(party_mode.NewRoomCodeFromString(output.Room.Code)); err != nil {
+		t.Errorf("Room with code %s not saved: %v", output.Room.Code, err)
+	}
+	if len(f.roomRepo.rooms) != 1 {
+		t.Errorf("Saved rooms = %d, want 1", len(f.roomRepo.rooms))
+	}
+}
+
+func TestCreateRoom_GivesUpAfterRepeatedCodeCollisions(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	f.roomRepo.codeCollisions = maxRoomCodeAttempts
+
+	_, err := f.createRoom.Execute(CreateRoomInput{PlayerID: testHostID, Username: "Host"})
+	if !errors.Is(err, party_mode.ErrRoomCodeTaken) {
+		t.Errorf("Expected ErrRoomCodeTaken, got %v", err)
+	}
+}
+
+func TestJoinRoom_IsIdempotent(t *testing.T) {
+	f := newPartyFixture(t, 10)
+
+	created, _ := f.createRoom.Execute(CreateRoomInput{PlayerID: testHostID, Username: "Host"})
+	input := JoinRoomInput{PlayerID: testGuestID, Username: "Guest", RoomCode: created.Room.Code}
+
+	if _, err := f.joinRoom.Execute(input); err != nil {
+		t.Fatalf("First join failed: %v", err)
+	}
+	output, err := f.joinRoom.Execute(input)
+	if err != nil {
+		t.Fatalf("Second join failed: %v", err)
+	}
+	if len(output.Room.Players) != 2 {
+		t.Errorf("Players = %d, want 2", len(output.Room.Players))
+	}
+}
+
+func TestJoinRoom_UnknownCode(t *testing.T) {
+	f := newPartyFixture(t, 10)
+
+	_, err := f.joinRoom.Execute(JoinRoomInput{PlayerID: testGuestID, Username: "Guest", RoomCode: "ZZZZZZ"})
+	if err != party_mode.ErrRoomNotFound {
+		t.Errorf("Expected ErrRoomNotFound, got %v", err)
+	}
+}
+
+// ========================================
+// StartPartyGame Use Case Tests
+// ========================================
+
+func TestStartPartyGame_Success(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	roomID := f.readyRoom(t)
+
+	output, err := f.startGame.Execute(StartPartyGameInput{PlayerID: testHostID, RoomID: roomID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if output.TotalQuestions != 10 {
+		t.Errorf("TotalQuestions = %d, want 10", output.TotalQuestions)
+	}
+	if output.StartsIn != QuestionStartDelaySec {
+		t.Errorf("StartsIn = %d, want %d", output.StartsIn, QuestionStartDelaySec)
+	}
+
+	room, _ := f.roomRepo.FindByID(party_mode.NewRoomIDFromString(roomID))
+	if room.Status() != party_mode.RoomStatusPlaying {
+		t.Errorf("Room status = %s, want playing", room.Status())
+	}
+	if !f.eventBus.HasEvent("game_started") || !f.eventBus.HasEvent("question_started") {
+		t.Error("Expected game_started and question_started events")
+	}
+}
+
+func TestStartPartyGame_OnlyHost(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	roomID := f.readyRoom(t)
+
+	_, err := f.startGame.Execute(StartPartyGameInput{PlayerID: testGuestID, RoomID: roomID})
+	if err != party_mode.ErrOnlyHostCanStart {
+		t.Errorf("Expected ErrOnlyHostCanStart, got %v", err)
+	}
+}
+
+func TestStartPartyGame_NotEnoughQuestions(t *testing.T) {
+	f := newPartyFixture(t, 5)
+	roomID := f.readyRoom(t)
+
+	_, err := f.startGame.Execute(StartPartyGameInput{PlayerID: testHostID, RoomID: roomID})
+	if err != party_mode.ErrNotEnoughQuestions {
+		t.Errorf("Expected ErrNotEnoughQuestions, got %v", err)
+	}
+
+	room, _ := f.roomRepo.FindByID(party_mode.NewRoomIDFromString(roomID))
+	if room.Status() != party_mode.RoomStatusLobby {
+		t.Errorf("Room status = %s, want lobby", room.Status())
+	}
+}
+
+// ========================================
+// SubmitPartyAnswer Use Case Tests
+// ========================================
+
+func TestSubmitPartyAnswer_AdvancesWhenAllAnswered(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	_, gameID := f.startedGame(t)
+
+	question := f.currentQuestion(t, gameID)
+	answerID := correctAnswerID(question)
+
+	first, err := f.submit.Execute(SubmitPartyAnswerInput{
+		PlayerID: testHostID, GameID: gameID, QuestionID: question.ID().String(), AnswerID: answerID, TimeTaken: 1000,
+	})
+	if err != nil {
+		t.Fatalf("Host answer failed: %v", err)
+	}
+	if !first.IsCorrect || first.Position != 1 {
+		t.Errorf("Host result = %+v, want correct at position 1", first)
+	}
+	if first.AllAnswered {
+		t.Error("Expected AllAnswered=false after first answer")
+	}
+	if first.CorrectAnswerID != answerID {
+		t.Errorf("CorrectAnswerID = %s, want %s", first.CorrectAnswerID, answerID)
+	}
+
+	second, err := f.submit.Execute(SubmitPartyAnswerInput{
+		PlayerID: testGuestID, GameID: gameID, QuestionID: question.ID().String(), AnswerID: answerID, TimeTaken: 2000,
+	})
+	if err != nil {
+		t.Fatalf("Guest answer failed: %v", err)
+	}
+	if !second.AllAnswered || second.Position != 2 {
+		t.Errorf("Guest result = %+v, want all answered at position 2", second)
+	}
+
+	next := f.currentQuestion(t, gameID)
+	if next.ID().Equals(question.ID()) {
+		t.Error("Expected game to advance to next question")
+	}
+}
+
+func TestSubmitPartyAnswer_StaleQuestion(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	_, gameID := f.startedGame(t)
+
+	question := f.currentQuestion(t, gameID)
+	if _, err := f.submit.TimeoutQuestion(gameID, 1); err != nil {
+		t.Fatalf("TimeoutQuestion: %v", err)
+	}
+
+	_, err := f.submit.Execute(SubmitPartyAnswerInput{
+		PlayerID: testHostID, GameID: gameID, QuestionID: question.ID().String(), AnswerID: correctAnswerID(question),
+	})
+	if err != party_mode.ErrPlayerAlreadyAnswered {
+		t.Errorf("Expected ErrPlayerAlreadyAnswered, got %v", err)
+	}
+}
+
+func TestTimeoutQuestion_IgnoresStaleTimer(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	_, gameID := f.startedGame(t)
+
+	advanced, err := f.submit.TimeoutQuestion(gameID, 1)
+	if err != nil || !advanced {
+		t.Fatalf("First timeout: advanced=%v err=%v", advanced, err)
+	}
+
+	advanced, err = f.submit.TimeoutQuestion(gameID, 1)
+	if err != nil || advanced {
+		t.Errorf("Stale timeout: advanced=%v err=%v, want false, nil", advanced, err)
+	}
+}
+
+// ========================================
+// LeaveParty / GetRoom Use Case Tests
+// ========================================
+
+func TestLeaveParty_MidGameFinishesAndClosesRoom(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	roomID, gameID := f.startedGame(t)
+
+	output, err := f.leave.Execute(LeavePartyInput{PlayerID: testGuestID, RoomID: roomID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if output.RoomStatus != string(party_mode.RoomStatusClosed) {
+		t.Errorf("RoomStatus = %s, want closed", output.RoomStatus)
+	}
+
+	game, _ := f.gameRepo.FindByID(party_mode.NewGameIDFromString(gameID))
+	if !game.IsFinished() {
+		t.Error("Expected game to finish when only one player remains")
+	}
+	if !f.eventBus.HasEvent("game_finished") {
+		t.Error("Expected game_finished event")
+	}
+
+	// The player who left can still see the final board
+	room, err := f.getRoom.Execute(GetRoomInput{PlayerID: testGuestID, RoomID: roomID})
+	if err != nil {
+		t.Fatalf("GetRoom after leave: %v", err)
+	}
+	if room.Game == nil || room.Game.Status != string(party_mode.GameStatusFinished) {
+		t.Errorf("Expected finished game in room output, got %+v", room.Game)
+	}
+}
+
+func TestGetRoom_NonMember(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	roomID := f.readyRoom(t)
+
+	_, err := f.getRoom.Execute(GetRoomInput{PlayerID: "stranger", RoomID: roomID})
+	if err != party_mode.ErrPlayerNotFound {
+		t.Errorf("Expected ErrPlayerNotFound, got %v", err)
+	}
+}
+
+func TestGetPartyQuestion_HidesCorrectness(t *testing.T) {
+	f := newPartyFixture(t, 10)
+	roomID, gameID := f.startedGame(t)
+
+	output, err := f.getQuestion.Execute(GetPartyQuestionInput{GameID: gameID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if output.RoomID != roomID {
+		t.Errorf("RoomID = %s, want %s", output.RoomID, roomID)
+	}
+	if output.Question.QuestionNumber != 1 || output.Question.TotalQuestions != 10 {
+		t.Errorf("Question = %d/%d, want 1/10", output.Question.QuestionNumber, output.Question.TotalQuestions)
+	}
+	if len(output.Question.Answers) != 4 {
+		t.Errorf("Answers = %d, want 4", len(output.Question.Answers))
+	}
+}
@@ -48,15 +48,15 @@ func NewBotFallbackUseCase(
 }
 
 // Execute scans the queue for players waiting more than QueueTimeoutSec seconds
-// and creates a bot game for each of them.
-func (uc *BotFallbackUseCase) Execute() {
+// and creates a bot game for each of them. It returns how many it paired.
+func (uc *BotFallbackUseCase) Execute() (paired int) {
 	now := time.Now().UTC().Unix()
 	cutoff := now - QueueTimeoutSec
 
 	stale, err := uc.matchmakingQueue.GetStaleQueueEntries(cutoff)
 	if err != nil {
 		log.Printf("[BotFallback] Failed to get stale queue entries: %v", err)
-		return
+		return 0
 	}
 
 	for _, playerID := range stale {
@@ -68,7 +68,9 @@ func (uc *BotFallbackUseCase) Execute() {
 		if err := uc.matchmakingQueue.RemoveFromQueue(playerID); err != nil {
 			log.Printf("[BotFallback] Failed to remove player %s from queue: %v", playerID.String(), err)
 		}
+		paired++
 	}
+	return paired
 }
 
 func (uc *BotFallbackUseCase) spawnBotGame(playerID quick_duel.UserID, now int64) error {
@@ -81,7 +83,7 @@ func (uc *BotFallbackUseCase) spawnBotGame(playerID quick_duel.UserID, now int64
 		return err
 	}
 
-	// Bot player: use player's own MMR so the ELO change is symmetric
+	// Bot player: use player's own MMR so the rating change is symmetric
 	botID, err := shared.NewUserID(BotUserID)
 	if err != nil {
 		return err
@@ -90,12 +92,12 @@ func (uc *BotFallbackUseCase) spawnBotGame(playerID quick_duel.UserID, now int64
 	player := quick_duel.NewDuelPlayer(
 		playerID,
 		"", // username looked up in GetGameResult; empty is fine here
-		quick_duel.ReconstructEloRating(rating.MMR(), 0),
+		rating.MMR(),
 	)
 	bot := quick_duel.NewDuelPlayer(
 		botID,
 		BotUsername,
-		quick_duel.ReconstructEloRating(rating.MMR(), 0), // match player's MMR for fair ELO
+		rating.MMR(), // match player's MMR for a fair rating change
 	)
 
 	// Get questions
@@ -0,0 +1,101 @@
+package quick_duel
+
+import (
+	"sync"
+
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+)
+
+// BotPlayerUseCase plays the bot's side of the games created by BotFallbackUseCase.
+// For each round it picks the bot's answer and how long the bot takes to give it,
+// calibrated to the human opponent's MMR, so a bot match plays like an even match.
+// The answer is then submitted through SubmitDuelAnswerUseCase like a human's.
+type BotPlayerUseCase struct {
+	duelGameRepo quick_duel.DuelGameRepository
+	questionRepo QuestionRepository
+
+	mu  sync.Mutex // the bot's random source is not safe for concurrent use
+	bot *quick_duel.BotOpponent
+}
+
+func NewBotPlayerUseCase(
+	duelGameRepo quick_duel.DuelGameRepository,
+	questionRepo QuestionRepository,
+	bot *quick_duel.BotOpponent,
+) *BotPlayerUseCase {
+	if bot == nil {
+		bot = quick_duel.NewBotOpponent(nil)
+	}
+	return &BotPlayerUseCase{
+		duelGameRepo: duelGameRepo,
+		questionRepo: questionRepo,
+		bot:          bot,
+	}
+}
+
+// IsBotGame reports whether the bot is one of the game's players
+func (uc *BotPlayerUseCase) IsBotGame(gameIDStr string) (bool, error) {
+	game, err := uc.duelGameRepo.FindByID(quick_duel.NewGameIDFromString(gameIDStr))
+	if err != nil {
+		return false, err
+	}
+	_, isBotGame := botOpponentOf(game)
+	return isBotGame, nil
+}
+
+// PlanAnswer decides the bot's answer to a round.
+// Returns nil (no error) when the game has no bot or the round is not being played.
+func (uc *BotPlayerUseCase) PlanAnswer(gameIDStr string, roundNum int) (*BotAnswerPlan, error) {
+	game, err := uc.duelGameRepo.FindByID(quick_duel.NewGameIDFromString(gameIDStr))
+	if err != nil {
+		return nil, err
+	}
+
+	human, isBotGame := botOpponentOf(game)
+	if !isBotGame || game.Status() != quick_duel.GameStatusInProgress || game.CurrentRound() != roundNum {
+		return nil, nil
+	}
+
+	questionID := game.QuestionIDs()[roundNum-1]
+	question, err := uc.questionRepo.FindByID(questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The game keeps the human's MMR at the time it was created
+	skill := quick_duel.BotSkillForMMR(human.MMR())
+
+	uc.mu.Lock()
+	answer, err := uc.bot.PlanAnswer(question, skill)
+	uc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &BotAnswerPlan{
+		GameID:     gameIDStr,
+		PlayerID:   BotUserID,
+		QuestionID: questionID.String(),
+		TimeTaken:  int(answer.TimeTakenMs),
+	}
+	if number, ok := answer.Submission.Number(); ok {
+		plan.NumericAnswer = &number
+	} else if ids := appQuiz.SubmissionAnswerIDs(answer.Submission); ids != nil {
+		plan.AnswerIDs = ids
+	} else {
+		plan.AnswerID = answer.AnswerID.String()
+	}
+	return plan, nil
+}
+
+// botOpponentOf returns the human player of a bot game; false if neither player is the bot
+func botOpponentOf(game *quick_duel.DuelGame) (quick_duel.DuelPlayer, bool) {
+	switch BotUserID {
+	case game.Player2().UserID().String():
+		return game.Player1(), true
+	case game.Player1().UserID().String():
+		return game.Player2(), true
+	}
+	return quick_duel.DuelPlayer{}, false
+}
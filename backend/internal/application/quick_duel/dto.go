@@ -1,5 +1,10 @@
 package quick_duel
 
+import (
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
 // ========================================
 // Common DTOs
 // ========================================
@@ -28,6 +33,7 @@ type DuelGameDTO struct {
 	StartedAt    int64           `json:"startedAt"`
 	FinishedAt   int64           `json:"finishedAt,omitempty"`
 	WinnerID     *string         `json:"winnerId,omitempty"`
+	WinReason    string          `json:"winReason,omitempty"` // "score", "time", "surrender", "timeout", "disconnect"
 	IsFriendGame bool            `json:"isFriendGame"`
 }
 
@@ -35,7 +41,9 @@ type DuelGameDTO struct {
 type DuelQuestionDTO struct {
 	ID           string            `json:"id"`
 	QuestionNum  int               `json:"questionNumber"`
+	Type         string            `json:"type"` // see quiz.QuestionDTO
 	Text         string            `json:"text"`
+	Media        *MediaDTO         `json:"media,omitempty"`
 	Answers      []DuelAnswerDTO   `json:"answers"`
 	TimeLimit    int               `json:"timeLimit"`
 	ServerTime   int64             `json:"serverTime"`
@@ -43,26 +51,39 @@ type DuelQuestionDTO struct {
 
 // DuelAnswerDTO represents an answer option
 type DuelAnswerDTO struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
+	ID    string    `json:"id"`
+	Text  string    `json:"text"`
+	Media *MediaDTO `json:"media,omitempty"`
+}
+
+// MediaDTO is an image on a question or answer (same shape in every mode)
+type MediaDTO = appQuiz.MediaDTO
+
+// ExplanationDTO is the rationale of a round's question, sent once the round is over
+type ExplanationDTO struct {
+	Text      string `json:"text"`
+	SourceURL string `json:"sourceUrl,omitempty"`
 }
 
 // PlayerRatingDTO represents a player's competitive ranking
 type PlayerRatingDTO struct {
-	PlayerID     string  `json:"playerId"`
-	MMR          int     `json:"mmr"`
-	League       string  `json:"league"`
-	Division     int     `json:"division"`
-	LeagueLabel  string  `json:"leagueLabel"`
-	LeagueIcon   string  `json:"leagueIcon"`
-	PeakMMR      int     `json:"peakMmr"`
-	PeakLeague   string  `json:"peakLeague"`
-	SeasonWins   int     `json:"seasonWins"`
-	SeasonLosses int     `json:"seasonLosses"`
-	SeasonDraws  int     `json:"seasonDraws"`
-	WinRate      float64 `json:"winRate"`
-	GamesAtRank  int     `json:"gamesAtRank"`
-	CanDemote    bool    `json:"canDemote"`
+	PlayerID           string  `json:"playerId"`
+	MMR                int     `json:"mmr"`
+	League             string  `json:"league"`
+	Division           int     `json:"division"`
+	LeagueLabel        string  `json:"leagueLabel"`
+	LeagueIcon         string  `json:"leagueIcon"`
+	PeakMMR            int     `json:"peakMmr"`
+	PeakLeague         string  `json:"peakLeague"`
+	SeasonWins         int     `json:"seasonWins"`
+	SeasonLosses       int     `json:"seasonLosses"`
+	SeasonDraws        int     `json:"seasonDraws"`
+	WinRate            float64 `json:"winRate"`
+	GamesAtRank        int     `json:"gamesAtRank"`
+	CanDemote          bool    `json:"canDemote"`
+	RD                 int     `json:"rd"`          // rating deviation: how uncertain the MMR still is
+	InPlacement        bool    `json:"inPlacement"` // league hidden until the placement matches are played
+	PlacementGamesLeft int     `json:"placementGamesLeft"`
 }
 
 // LeaderboardEntryDTO represents a leaderboard entry
@@ -101,6 +122,7 @@ type GameHistoryEntryDTO struct {
 	Opponent      string `json:"opponent"`
 	OpponentMMR   int    `json:"opponentMmr"`
 	Result        string `json:"result"`
+	WinReason     string `json:"winReason,omitempty"`
 	PlayerScore   int    `json:"playerScore"`
 	OpponentScore int    `json:"opponentScore"`
 	MMRChange     int    `json:"mmrChange"`
@@ -177,7 +199,8 @@ type GetDuelStatusOutput struct {
 // ========================================
 
 type JoinQueueInput struct {
-	PlayerID string `json:"playerId"`
+	PlayerID       string `json:"playerId"`
+	IdempotencyKey string `json:"-"` // optional; a retried request with the same key is charged once
 }
 
 type JoinQueueOutput struct {
@@ -207,8 +230,9 @@ type LeaveQueueOutput struct {
 // ========================================
 
 type SendChallengeInput struct {
-	PlayerID string `json:"playerId"`
-	FriendID string `json:"friendId"`
+	PlayerID       string `json:"playerId"`
+	FriendID       string `json:"friendId"`
+	IdempotencyKey string `json:"-"` // optional; a retried request with the same key is charged once
 }
 
 type SendChallengeOutput struct {
@@ -289,6 +313,7 @@ type GameQuestionResultDTO struct {
 type GetGameResultOutput struct {
 	GameID           string                  `json:"gameId"`
 	Result           string                  `json:"result"` // "win", "loss", "draw"
+	WinReason        string                  `json:"winReason,omitempty"`
 	PlayerScore      int                     `json:"playerScore"`
 	OpponentScore    int                     `json:"opponentScore"`
 	MMRChange        int                     `json:"mmrChange"`
@@ -421,9 +446,12 @@ type StartGameOutput struct {
 
 // RoundQuestionOutput represents a question for a round
 type RoundQuestionOutput struct {
-	QuestionID   string              `json:"questionId"`
-	QuestionText string              `json:"questionText"`
-	Answers      []map[string]string `json:"answers"`
+	QuestionID    string          `json:"questionId"`
+	QuestionType  string          `json:"questionType"`
+	QuestionText  string          `json:"questionText"`
+	QuestionMedia *MediaDTO       `json:"questionMedia,omitempty"`
+	Answers       []DuelAnswerDTO `json:"answers"`
+	SentAt        int64           `json:"sentAt"` // Unix ms the question was first sent (0 = not yet)
 }
 
 // ========================================
@@ -435,22 +463,38 @@ type SubmitDuelAnswerInput struct {
 	GameID     string `json:"gameId"`
 	QuestionID string `json:"questionId"`
 	AnswerID   string `json:"answerId"`
-	TimeTaken  int    `json:"timeTaken"` // milliseconds
+	TimeTaken  int    `json:"timeTaken"` // milliseconds, as reported by the client
+
+	// Multi-select (picked options) and ordering (every option, in order) send AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
+
+	// Server-side timing, stamped by the WebSocket hub (never taken from the client)
+	ReceivedAtMs int64 `json:"-"` // Unix ms the answer reached the server; 0 = now
+	LatencyMs    int64 `json:"-"` // measured round-trip time of the player's connection
 }
 
 type SubmitDuelAnswerOutput struct {
 	IsCorrect        bool   `json:"isCorrect"`
 	CorrectAnswerID  string `json:"correctAnswerId"`
 	PointsEarned     int    `json:"pointsEarned"`
+	TimeTaken        int64  `json:"timeTaken"` // milliseconds credited, as measured by the server
 	Player1Score     int    `json:"player1Score"`
 	Player2Score     int    `json:"player2Score"`
 	RoundComplete    bool   `json:"roundComplete"`
 	GameComplete     bool   `json:"gameComplete"`
+	Explanation      *ExplanationDTO `json:"explanation,omitempty"` // set once the round is complete
 	WinnerID         string `json:"winnerId,omitempty"`
+	WinReason        string `json:"winReason,omitempty"`
 	Player1MMRChange int    `json:"player1MmrChange,omitempty"`
 	Player2MMRChange int    `json:"player2MmrChange,omitempty"`
 	Player1NewMMR    int    `json:"player1NewMmr,omitempty"`
 	Player2NewMMR    int    `json:"player2NewMmr,omitempty"`
+	// Answer key of the other question types (CorrectAnswerID is empty for them)
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`    // numeric
+
+	question *quiz.Question // the round's question, set with Explanation
 }
 
 // ========================================
@@ -472,6 +516,7 @@ type GetRivalsOutput struct {
 type PrepareShareInput struct {
 	PlayerID      string `json:"playerId"`
 	ChallengeLink string `json:"challengeLink"`
+	Locale        string `json:"-"` // sharer's language, see quiz.DefaultLocale
 }
 
 type PrepareShareOutput struct {
@@ -494,3 +539,133 @@ type SurrenderGameOutput struct {
 	MMRChange int    `json:"mmrChange"`
 	NewMMR    int    `json:"newMmr"`
 }
+
+// ========================================
+// BotPlayer Use Case
+// ========================================
+
+// BotAnswerPlan is the bot's answer to a round, to submit TimeTaken ms after the question
+type BotAnswerPlan struct {
+	GameID     string `json:"gameId"`
+	PlayerID   string `json:"playerId"`
+	QuestionID string `json:"questionId"`
+	AnswerID   string `json:"answerId"`
+	TimeTaken  int    `json:"timeTaken"` // milliseconds
+	// The other question types: see SubmitDuelAnswerInput
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"`
+}
+
+// ========================================
+// GetRatingHistory Use Case
+// ========================================
+
+type GetRatingHistoryInput struct {
+	PlayerID string `json:"playerId"`
+	SeasonID string `json:"seasonId,omitempty"` // empty for the current season
+}
+
+// RatingHistoryPointDTO is the player's rating after one finished duel
+type RatingHistoryPointDTO struct {
+	GameID    string `json:"gameId"`
+	MMR       int    `json:"mmr"`
+	MMRChange int    `json:"mmrChange"`
+	League    string `json:"league"`
+	Division  int    `json:"division"`
+	RankEvent string `json:"rankEvent,omitempty"` // "promoted", "demoted", "demotion_protected", "placed"
+	PlayedAt  int64  `json:"playedAt"`
+}
+
+// RankEventDTO is a promotion, demotion, demotion protection or placement
+type RankEventDTO struct {
+	Type         string `json:"type"`
+	GameID       string `json:"gameId"`
+	FromLeague   string `json:"fromLeague"`
+	FromDivision int    `json:"fromDivision"`
+	ToLeague     string `json:"toLeague"`
+	ToDivision   int    `json:"toDivision"`
+	MMR          int    `json:"mmr"`
+	OccurredAt   int64  `json:"occurredAt"`
+}
+
+type GetRatingHistoryOutput struct {
+	SeasonID string                  `json:"seasonId"`
+	StartMMR int                     `json:"startMmr"` // MMR before the season's first game
+	Points   []RatingHistoryPointDTO `json:"points"`   // oldest first
+	Events   []RankEventDTO          `json:"events"`   // oldest first
+}
+
+// ========================================
+// GetGameReplay Use Case
+// ========================================
+
+type GetGameReplayInput struct {
+	// PlayerID must be one of the duel players.
+	// Empty for support staff reviewing a dispute (admin route).
+	PlayerID string `json:"playerId"`
+	GameID   string `json:"gameId"`
+	Locale   string `json:"-"` // caller's content language, see quiz.DefaultLocale
+}
+
+type GetGameReplayOutput struct {
+	GameID     string           `json:"gameId"`
+	Status     string           `json:"status"`
+	WinnerID   *string          `json:"winnerId,omitempty"`
+	WinReason  string           `json:"winReason,omitempty"`
+	Player1    ReplayPlayerDTO  `json:"player1"`
+	Player2    ReplayPlayerDTO  `json:"player2"`
+	Rounds     []ReplayRoundDTO `json:"rounds"`
+	StartedAt  int64            `json:"startedAt"`
+	FinishedAt int64            `json:"finishedAt"`
+}
+
+// ReplayPlayerDTO is a duel participant with final totals
+type ReplayPlayerDTO struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	FinalScore  int    `json:"finalScore"`
+	TotalTimeMs int64  `json:"totalTimeMs"`
+}
+
+// ReplayRoundDTO is one round of the timeline.
+// Player1/Player2 are nil when the game ended before that player answered the round.
+type ReplayRoundDTO struct {
+	RoundNumber     int                   `json:"roundNumber"`
+	Question        ReplayQuestionDTO     `json:"question"`
+	CorrectAnswerID string                `json:"correctAnswerId"` // single choice, true/false
+	// Answer key of the other question types (CorrectAnswerID is empty for them)
+	CorrectAnswerIDs []string             `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64             `json:"correctNumber,omitempty"`    // numeric
+	Player1         *ReplayRoundAnswerDTO `json:"player1,omitempty"`
+	Player2         *ReplayRoundAnswerDTO `json:"player2,omitempty"`
+}
+
+// ReplayQuestionDTO is a round question with all its answer options
+type ReplayQuestionDTO struct {
+	ID      string            `json:"id"`
+	Text    string            `json:"text"`
+	Media   *MediaDTO         `json:"media,omitempty"`
+	Answers []ReplayAnswerDTO `json:"answers"`
+}
+
+type ReplayAnswerDTO struct {
+	ID    string    `json:"id"`
+	Text  string    `json:"text"`
+	Media *MediaDTO `json:"media,omitempty"`
+}
+
+// ReplayRoundAnswerDTO is what one player did in a round
+type ReplayRoundAnswerDTO struct {
+	AnswerID     string `json:"answerId,omitempty"` // empty when timed out
+	// Multi-select (picked options) and ordering (every option, in order) report AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
+	TimedOut     bool   `json:"timedOut"`
+	IsCorrect    bool   `json:"isCorrect"`
+	TimeTakenMs  int64  `json:"timeTakenMs"`
+	Points       int    `json:"points"`
+	SpeedBonus   int    `json:"speedBonus"`
+	RunningScore int    `json:"runningScore"`
+	// True when the client-reported time disagreed with the server's measurement
+	TimingFlagged bool `json:"timingFlagged,omitempty"`
+}
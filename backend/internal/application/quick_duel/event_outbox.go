@@ -0,0 +1,109 @@
+package quick_duel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+)
+
+// EventOutbox stores domain events in the transaction that saves their aggregate.
+// An outbox relay delivers them to durable subscribers after commit (at-least-once),
+// so a crash right after the save can no longer lose a DuelGameFinishedEvent.
+// Implementation is in infrastructure layer
+type EventOutbox interface {
+	AppendInTx(tx *sql.Tx, events ...quick_duel.Event) error
+}
+
+// gameWriter saves a duel game together with its pending events.
+// Without an outbox the game is saved and the events go straight to the event bus.
+type gameWriter struct {
+	duelGameRepo quick_duel.DuelGameRepository
+	eventBus     EventBus
+	txManager    TxManager   // optional, set with the use case's WithOutbox
+	outbox       EventOutbox // optional, set with the use case's WithOutbox
+}
+
+func (w gameWriter) save(game *quick_duel.DuelGame) error {
+	events := game.Events()
+
+	if w.txManager != nil && w.outbox != nil {
+		err := w.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+			if err := w.duelGameRepo.SaveInTx(tx, game); err != nil {
+				return err
+			}
+			return w.outbox.AppendInTx(tx, events...)
+		})
+		if err != nil {
+			return err
+		}
+	} else if err := w.duelGameRepo.Save(game); err != nil {
+		return err
+	}
+
+	// In-process listeners (WebSocket pushes, logging) get the events once they are stored
+	for _, event := range events {
+		w.eventBus.Publish(event)
+	}
+	return nil
+}
+
+// errGameUnchanged is returned by an update's change to leave the game unsaved
+var errGameUnchanged = errors.New("duel game unchanged")
+
+// update loads a game, applies change and saves the game together with its pending events.
+// With a transaction manager the game row stays locked (SELECT FOR UPDATE) from load to save,
+// so API instances take turns on a game and a finish is decided, rated and stored once;
+// change may write within tx too (ratings). Without one, callers rely on gameLocks alone.
+func (w gameWriter) update(gameID quick_duel.GameID, change func(tx *sql.Tx, game *quick_duel.DuelGame) error) error {
+	var events []quick_duel.Event
+
+	if w.txManager != nil {
+		err := w.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+			game, err := w.duelGameRepo.FindByIDForUpdate(tx, gameID)
+			if err != nil {
+				return err
+			}
+			if err := change(tx, game); err != nil {
+				return err
+			}
+
+			events = game.Events()
+			if err := w.duelGameRepo.SaveInTx(tx, game); err != nil {
+				return err
+			}
+			if w.outbox == nil {
+				return nil
+			}
+			return w.outbox.AppendInTx(tx, events...)
+		})
+		if err != nil {
+			if errors.Is(err, errGameUnchanged) {
+				return nil
+			}
+			return err
+		}
+	} else {
+		game, err := w.duelGameRepo.FindByID(gameID)
+		if err != nil {
+			return err
+		}
+		if err := change(nil, game); err != nil {
+			if errors.Is(err, errGameUnchanged) {
+				return nil
+			}
+			return err
+		}
+
+		events = game.Events()
+		if err := w.duelGameRepo.Save(game); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range events {
+		w.eventBus.Publish(event)
+	}
+	return nil
+}
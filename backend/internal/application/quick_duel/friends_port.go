@@ -0,0 +1,8 @@
+package quick_duel
+
+// FriendsProvider defines the interface for looking up a player's friends.
+// Implementation is in application/social layer.
+type FriendsProvider interface {
+	// FriendIDs returns the IDs of the player's accepted friends
+	FriendIDs(playerID string) ([]string, error)
+}
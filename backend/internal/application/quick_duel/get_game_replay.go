@@ -0,0 +1,162 @@
+package quick_duel
+
+import (
+	"fmt"
+	"math"
+
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// GetGameReplay Use Case
+// ========================================
+
+type GetGameReplayUseCase struct {
+	duelGameRepo quick_duel.DuelGameRepository
+	questionRepo QuestionRepository
+	userRepo     domainUser.UserRepository
+}
+
+func NewGetGameReplayUseCase(
+	duelGameRepo quick_duel.DuelGameRepository,
+	questionRepo QuestionRepository,
+	userRepo domainUser.UserRepository,
+) *GetGameReplayUseCase {
+	return &GetGameReplayUseCase{
+		duelGameRepo: duelGameRepo,
+		questionRepo: questionRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (uc *GetGameReplayUseCase) Execute(input GetGameReplayInput) (*GetGameReplayOutput, error) {
+	game, err := uc.duelGameRepo.FindByID(quick_duel.NewGameIDFromString(input.GameID))
+	if err != nil {
+		return nil, err
+	}
+
+	if input.PlayerID != "" &&
+		game.Player1().UserID().String() != input.PlayerID &&
+		game.Player2().UserID().String() != input.PlayerID {
+		return nil, quick_duel.ErrGameNotFound
+	}
+
+	// An in-progress replay would reveal the opponent's answers
+	if !game.IsFinished() {
+		return nil, quick_duel.ErrGameNotFinished
+	}
+
+	roundAnswers := game.RoundAnswers()
+	questionIDs := game.QuestionIDs()
+	player1ID := game.Player1().UserID()
+	player2ID := game.Player2().UserID()
+
+	rounds := make([]ReplayRoundDTO, 0, len(questionIDs))
+	var player1Score, player2Score int
+	for round := 1; round <= game.CurrentRound() && round <= len(questionIDs); round++ {
+		question, err := uc.questionRepo.FindByID(questionIDs[round-1])
+		if err != nil {
+			return nil, fmt.Errorf("get game replay: load question for round %d: %w", round, err)
+		}
+
+		replayRound := ReplayRoundDTO{
+			RoundNumber:      round,
+			Question:         toReplayQuestionDTO(question.Localized(input.Locale)),
+			CorrectAnswerID:  appQuiz.FindCorrectAnswerID(question),
+			CorrectAnswerIDs: appQuiz.CorrectAnswerIDsOf(question),
+			CorrectNumber:    appQuiz.CorrectNumberOf(question),
+		}
+
+		for _, answer := range roundAnswers[round] {
+			switch {
+			case answer.PlayerID().Equals(player1ID):
+				player1Score += answer.Points()
+				replayRound.Player1 = toReplayRoundAnswerDTO(answer, player1Score)
+			case answer.PlayerID().Equals(player2ID):
+				player2Score += answer.Points()
+				replayRound.Player2 = toReplayRoundAnswerDTO(answer, player2Score)
+			}
+		}
+
+		rounds = append(rounds, replayRound)
+	}
+
+	var winnerID *string
+	if winner := game.WinnerID(); winner != nil {
+		id := winner.String()
+		winnerID = &id
+	}
+
+	return &GetGameReplayOutput{
+		GameID:     game.ID().String(),
+		Status:     string(game.Status()),
+		WinnerID:   winnerID,
+		WinReason:  string(game.WinReason()),
+		Player1:    uc.toReplayPlayerDTO(game.Player1()),
+		Player2:    uc.toReplayPlayerDTO(game.Player2()),
+		Rounds:     rounds,
+		StartedAt:  game.StartedAt(),
+		FinishedAt: game.FinishedAt(),
+	}, nil
+}
+
+func (uc *GetGameReplayUseCase) toReplayPlayerDTO(player quick_duel.DuelPlayer) ReplayPlayerDTO {
+	username := player.Username()
+	if user, err := uc.userRepo.FindByID(player.UserID()); err == nil && user != nil {
+		username = user.Username().String()
+	}
+
+	return ReplayPlayerDTO{
+		ID:          player.UserID().String(),
+		Username:    username,
+		FinalScore:  player.Score(),
+		TotalTimeMs: player.TotalTimeMs(),
+	}
+}
+
+func toReplayQuestionDTO(question *quiz.Question) ReplayQuestionDTO {
+	answers := make([]ReplayAnswerDTO, 0, len(question.Answers()))
+	for _, a := range question.Answers() {
+		answers = append(answers, ReplayAnswerDTO{
+			ID:    a.ID().String(),
+			Text:  a.Text().String(),
+			Media: appQuiz.ToMediaDTO(a.Media()),
+		})
+	}
+
+	return ReplayQuestionDTO{
+		ID:      question.ID().String(),
+		Text:    question.Text().String(),
+		Media:   appQuiz.ToMediaDTO(question.Media()),
+		Answers: answers,
+	}
+}
+
+func toReplayRoundAnswerDTO(answer quick_duel.RoundAnswer, runningScore int) *ReplayRoundAnswerDTO {
+	dto := &ReplayRoundAnswerDTO{
+		TimedOut:      answer.IsTimeout(),
+		IsCorrect:     answer.IsCorrect(),
+		TimeTakenMs:   answer.TimeTaken(),
+		Points:        answer.Points(),
+		RunningScore:  runningScore,
+		TimingFlagged: answer.TimingFlagged(),
+	}
+	submission := answer.Submission()
+	if number, ok := submission.Number(); ok {
+		dto.NumericAnswer = &number
+	} else if ids := appQuiz.SubmissionAnswerIDs(submission); ids != nil {
+		dto.AnswerIDs = ids
+	} else if !answer.IsTimeout() {
+		dto.AnswerID = answer.AnswerID().String()
+	}
+
+	// Partial credit scales base points and speed bonus alike, so the bonus is its share of the points
+	if answer.Points() > 0 {
+		speedBonus := quick_duel.CalculateSpeedBonus(answer.TimeTaken())
+		dto.SpeedBonus = int(math.Round(float64(answer.Points()*speedBonus) / float64(quick_duel.BasePointsCorrect+speedBonus)))
+	}
+	return dto
+}
@@ -1,9 +1,27 @@
 package quick_duel
 
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+
 // InventoryService defines the interface for crediting/debiting player resources.
 // Implementation is in application/user layer.
 type InventoryService interface {
 	GetPvpTickets(playerID string) (int, error)
 	Credit(playerID string, source string, details map[string]int) error
 	Debit(playerID string, source string, details map[string]int) error
+	// CreditOnce and DebitOnce apply at most once per idempotency key; a repeated call is a no-op
+	CreditOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+	DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+}
+
+// gameRewardKey scopes a game's win or draw reward to the game, so a player is paid once per game
+func gameRewardKey(source string, gameID quick_duel.GameID) string {
+	return source + ":" + gameID.String()
+}
+
+// ticketDebitKey scopes a client idempotency key to the PvP entry debit ("" when the client sent none)
+func ticketDebitKey(idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return "pvp_entry:" + idempotencyKey
 }
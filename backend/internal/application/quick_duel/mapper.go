@@ -1,6 +1,9 @@
 package quick_duel
 
 import (
+	"math"
+
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
@@ -9,20 +12,23 @@ import (
 func ToPlayerRatingDTO(rating *quick_duel.PlayerRating) PlayerRatingDTO {
 	leagueInfo := quick_duel.GetLeagueFromMMR(rating.MMR())
 	return PlayerRatingDTO{
-		PlayerID:     rating.PlayerID().String(),
-		MMR:          rating.MMR(),
-		League:       rating.League().String(),
-		Division:     rating.Division().Value(),
-		LeagueLabel:  leagueInfo.Label(),
-		LeagueIcon:   rating.League().Icon(),
-		PeakMMR:      rating.PeakMMR(),
-		PeakLeague:   rating.PeakLeague().String(),
-		SeasonWins:   rating.SeasonWins(),
-		SeasonLosses: rating.SeasonLosses(),
-		SeasonDraws:  rating.SeasonDraws(),
-		WinRate:      rating.WinRate(),
-		GamesAtRank:  rating.GamesAtRank(),
-		CanDemote:    rating.CanDemote(),
+		PlayerID:           rating.PlayerID().String(),
+		MMR:                rating.MMR(),
+		League:             rating.League().String(),
+		Division:           rating.Division().Value(),
+		LeagueLabel:        leagueInfo.Label(),
+		LeagueIcon:         rating.League().Icon(),
+		PeakMMR:            rating.PeakMMR(),
+		PeakLeague:         rating.PeakLeague().String(),
+		SeasonWins:         rating.SeasonWins(),
+		SeasonLosses:       rating.SeasonLosses(),
+		SeasonDraws:        rating.SeasonDraws(),
+		WinRate:            rating.WinRate(),
+		GamesAtRank:        rating.GamesAtRank(),
+		CanDemote:          rating.CanDemote(),
+		RD:                 int(math.Round(rating.RatingDeviation())),
+		InPlacement:        rating.IsPlacement(),
+		PlacementGamesLeft: rating.PlacementGamesLeft(),
 	}
 }
 
@@ -39,7 +45,7 @@ func ToDuelPlayerDTO(player quick_duel.DuelPlayer, rating *quick_duel.PlayerRati
 		ID:         player.UserID().String(),
 		Username:   player.Username(),
 		Avatar:     "", // TODO: get from user profile
-		MMR:        player.Elo().Rating(),
+		MMR:        player.MMR(),
 		League:     league.String(),
 		Division:   division.Value(),
 		LeagueIcon: league.Icon(),
@@ -51,14 +57,9 @@ func ToDuelPlayerDTO(player quick_duel.DuelPlayer, rating *quick_duel.PlayerRati
 // ToDuelGameDTO converts domain DuelGame to DTO
 func ToDuelGameDTO(game *quick_duel.DuelGame, player1Rating, player2Rating *quick_duel.PlayerRating) DuelGameDTO {
 	var winnerID *string
-	if game.Status() == quick_duel.GameStatusFinished {
-		if game.Player1().Score() > game.Player2().Score() {
-			id := game.Player1().UserID().String()
-			winnerID = &id
-		} else if game.Player2().Score() > game.Player1().Score() {
-			id := game.Player2().UserID().String()
-			winnerID = &id
-		}
+	if winner := game.WinnerID(); winner != nil {
+		id := winner.String()
+		winnerID = &id
 	}
 
 	return DuelGameDTO{
@@ -71,30 +72,53 @@ func ToDuelGameDTO(game *quick_duel.DuelGame, player1Rating, player2Rating *quic
 		StartedAt:    game.StartedAt(),
 		FinishedAt:   game.FinishedAt(),
 		WinnerID:     winnerID,
-		IsFriendGame: false, // TODO: check if friend game
+		WinReason:    string(game.WinReason()),
+		IsFriendGame: game.IsFriendMatch(),
 	}
 }
 
 // ToDuelQuestionDTO converts domain Question to DTO (without isCorrect)
 func ToDuelQuestionDTO(question *quiz.Question, questionNum int, serverTime int64) DuelQuestionDTO {
 	answers := make([]DuelAnswerDTO, 0, len(question.Answers()))
-	for _, ans := range question.Answers() {
+	for _, ans := range question.DisplayAnswers() {
 		answers = append(answers, DuelAnswerDTO{
-			ID:   ans.ID().String(),
-			Text: ans.Text().String(),
+			ID:    ans.ID().String(),
+			Text:  ans.Text().String(),
+			Media: appQuiz.ToMediaDTO(ans.Media()),
 		})
 	}
 
 	return DuelQuestionDTO{
 		ID:          question.ID().String(),
 		QuestionNum: questionNum,
+		Type:        question.Type().String(),
 		Text:        question.Text().String(),
+		Media:       appQuiz.ToMediaDTO(question.Media()),
 		Answers:     answers,
 		TimeLimit:   quick_duel.TimePerQuestionSec,
 		ServerTime:  serverTime,
 	}
 }
 
+// ToExplanationDTO returns the question's explanation, or nil when it has none
+func ToExplanationDTO(question *quiz.Question) *ExplanationDTO {
+	if question.Explanation().IsEmpty() {
+		return nil
+	}
+	return &ExplanationDTO{
+		Text:      question.Explanation().Text(),
+		SourceURL: question.Explanation().SourceURL(),
+	}
+}
+
+// ExplanationIn returns the round's explanation in the given locale, nil when there is none
+func (o *SubmitDuelAnswerOutput) ExplanationIn(locale string) *ExplanationDTO {
+	if o.question == nil {
+		return nil
+	}
+	return ToExplanationDTO(o.question.Localized(locale))
+}
+
 // ToChallengeDTO converts domain DuelChallenge to DTO
 func ToChallengeDTO(challenge *quick_duel.DuelChallenge, now int64, challengerUsername string) ChallengeDTO {
 	var challengedID *string
@@ -132,49 +156,50 @@ func ToChallengeDTO(challenge *quick_duel.DuelChallenge, now int64, challengerUs
 }
 
 // ToGameHistoryEntryDTO converts domain DuelGame to history entry DTO
-func ToGameHistoryEntryDTO(game *quick_duel.DuelGame, playerID string, opponentUsername string) GameHistoryEntryDTO {
+// mmrChange is the player's recorded rating change for the game (0 if unknown)
+func ToGameHistoryEntryDTO(game *quick_duel.DuelGame, playerID string, opponentUsername string, mmrChange int) GameHistoryEntryDTO {
 	isPlayer1 := game.Player1().UserID().String() == playerID
 
-	var result string
 	var playerScore, opponentScore int
 	var opponentMMR int
-	var mmrChange int
 
 	if isPlayer1 {
 		playerScore = game.Player1().Score()
 		opponentScore = game.Player2().Score()
-		opponentMMR = game.Player2().Elo().Rating()
-		// TODO: mmrChange requires eloBefore stored in DuelPlayer (Phase 5 migration).
-		// Currently Elo().Rating() is post-game rating, no pre-game value available.
-		mmrChange = 0
+		opponentMMR = game.Player2().MMR()
 	} else {
 		playerScore = game.Player2().Score()
 		opponentScore = game.Player1().Score()
-		opponentMMR = game.Player1().Elo().Rating()
-		mmrChange = 0
-	}
-
-	if playerScore > opponentScore {
-		result = "win"
-	} else if playerScore < opponentScore {
-		result = "loss"
-	} else {
-		result = "draw"
+		opponentMMR = game.Player1().MMR()
 	}
 
 	return GameHistoryEntryDTO{
 		GameID:        game.ID().String(),
 		Opponent:      opponentUsername,
 		OpponentMMR:   opponentMMR,
-		Result:        result,
+		Result:        GameResultFor(game, playerID),
+		WinReason:     string(game.WinReason()),
 		PlayerScore:   playerScore,
 		OpponentScore: opponentScore,
 		MMRChange:     mmrChange,
-		IsFriendGame:  false, // TODO
+		IsFriendGame:  game.IsFriendMatch(),
 		CompletedAt:   game.FinishedAt(),
 	}
 }
 
+// GameResultFor returns "win", "loss" or "draw" from the player's point of view
+func GameResultFor(game *quick_duel.DuelGame, playerID string) string {
+	winnerID := game.WinnerID()
+	switch {
+	case winnerID == nil:
+		return "draw"
+	case winnerID.String() == playerID:
+		return "win"
+	default:
+		return "loss"
+	}
+}
+
 // ToLeaderboardEntryDTO converts domain PlayerRating to leaderboard entry DTO
 func ToLeaderboardEntryDTO(rating *quick_duel.PlayerRating, rank int, username string) LeaderboardEntryDTO {
 	return LeaderboardEntryDTO{
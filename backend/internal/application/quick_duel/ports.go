@@ -3,6 +3,7 @@ package quick_duel
 import (
 	"context"
 	"database/sql"
+	"sync"
 )
 
 // TxManager provides database transaction support
@@ -34,15 +35,46 @@ func (n *NoOpLobbyHub) IsConnected(playerID string) bool           { return fals
 func (n *NoOpLobbyHub) Notify(playerID string, event LobbyEvent)   {}
 func (n *NoOpLobbyHub) NotifyBoth(p1, p2 string, event LobbyEvent) {}
 
-// DuelRoundCache stores per-round player answers for an ongoing duel game.
-// Implementations must be safe for concurrent use and survive process restarts.
-type DuelRoundCache interface {
-	// AddAnswer records a player's answer for a specific round.
-	AddAnswer(gameID string, round int, answer PlayerAnswer) error
+// keyedMutex serializes read-modify-write cycles on a single duel game.
+// Both players answer concurrently and the aggregate (round answers included)
+// is loaded and saved as a whole, so two unsynchronized writers would lose an answer.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
 
-	// GetAnswers returns all answers stored for a specific round.
-	GetAnswers(gameID string, round int) ([]PlayerAnswer, error)
+type keyedLock struct {
+	mu   sync.Mutex
+	refs int
+}
 
-	// DeleteGame removes all cached answers for a finished game.
-	DeleteGame(gameID string) error
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*keyedLock)}
 }
+
+// Lock acquires the lock for key and returns its release function.
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.mu.Lock()
+
+	return func() {
+		l.mu.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// gameLocks is shared by the use cases that mutate an in-progress duel, keyed by game ID.
+var gameLocks = newKeyedMutex()
@@ -0,0 +1,109 @@
+package quick_duel
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// recordRatingChange stores a player's rating change for a finished game.
+// The bot's rating is not worth a history; a nil repository records nothing.
+func recordRatingChange(
+	repo quick_duel.RatingHistoryRepository,
+	game *quick_duel.DuelGame,
+	rating *quick_duel.PlayerRating,
+	change quick_duel.RatingChange,
+	playedAt int64,
+) {
+	if repo == nil || rating.PlayerID().String() == BotUserID {
+		return
+	}
+	entry, err := quick_duel.NewRatingHistoryEntry(rating.PlayerID(), game.ID(), rating.SeasonID(), change, playedAt)
+	if err != nil {
+		return
+	}
+	_ = repo.Save(entry)
+}
+
+// ========================================
+// GetRatingHistory Use Case
+// ========================================
+
+// GetRatingHistoryUseCase returns a player's MMR after every duel of a season, for the rating graph
+type GetRatingHistoryUseCase struct {
+	ratingHistory    quick_duel.RatingHistoryRepository
+	playerRatingRepo quick_duel.PlayerRatingRepository
+	seasonRepo       quick_duel.SeasonRepository
+}
+
+func NewGetRatingHistoryUseCase(
+	ratingHistory quick_duel.RatingHistoryRepository,
+	playerRatingRepo quick_duel.PlayerRatingRepository,
+	seasonRepo quick_duel.SeasonRepository,
+) *GetRatingHistoryUseCase {
+	return &GetRatingHistoryUseCase{
+		ratingHistory:    ratingHistory,
+		playerRatingRepo: playerRatingRepo,
+		seasonRepo:       seasonRepo,
+	}
+}
+
+func (uc *GetRatingHistoryUseCase) Execute(input GetRatingHistoryInput) (GetRatingHistoryOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetRatingHistoryOutput{}, err
+	}
+
+	seasonID := input.SeasonID
+	if seasonID == "" {
+		if seasonID, err = uc.seasonRepo.GetCurrentSeason(); err != nil {
+			return GetRatingHistoryOutput{}, err
+		}
+	}
+
+	entries, err := uc.ratingHistory.FindBySeason(playerID, seasonID)
+	if err != nil {
+		return GetRatingHistoryOutput{}, err
+	}
+
+	output := GetRatingHistoryOutput{
+		SeasonID: seasonID,
+		StartMMR: quick_duel.InitialMMR,
+		Points:   make([]RatingHistoryPointDTO, 0, len(entries)),
+		Events:   []RankEventDTO{},
+	}
+
+	if len(entries) > 0 {
+		output.StartMMR = entries[0].Change().MMRBefore
+	} else if rating, err := uc.playerRatingRepo.FindByPlayerID(playerID); err == nil && rating.SeasonID() == seasonID {
+		// No game yet this season: the graph starts (and ends) at the reset MMR
+		output.StartMMR = rating.MMR()
+	}
+
+	for _, entry := range entries {
+		change := entry.Change()
+		output.Points = append(output.Points, RatingHistoryPointDTO{
+			GameID:    entry.GameID().String(),
+			MMR:       change.MMRAfter,
+			MMRChange: change.MMRDelta(),
+			League:    change.LeagueAfter.String(),
+			Division:  change.DivisionAfter.Value(),
+			RankEvent: string(change.RankEvent),
+			PlayedAt:  entry.PlayedAt(),
+		})
+
+		if change.RankEvent != quick_duel.RankEventNone {
+			output.Events = append(output.Events, RankEventDTO{
+				Type:         string(change.RankEvent),
+				GameID:       entry.GameID().String(),
+				FromLeague:   change.LeagueBefore.String(),
+				FromDivision: change.DivisionBefore.Value(),
+				ToLeague:     change.LeagueAfter.String(),
+				ToDivision:   change.DivisionAfter.Value(),
+				MMR:          change.MMRAfter,
+				OccurredAt:   entry.PlayedAt(),
+			})
+		}
+	}
+
+	return output, nil
+}
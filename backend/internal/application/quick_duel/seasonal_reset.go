@@ -37,7 +37,8 @@ func NewSeasonalResetUseCase(
 }
 
 // Execute performs the soft MMR reset for all players and registers the new season.
-// Formula: newMMR = 1000 + (currentMMR - 1000) * 0.5, minimum 500.
+// Each rating goes through PlayerRating.SeasonReset: MMR moves halfway back to 1000
+// (minimum 500) and RD grows, so the first games of the season move it faster.
 func (uc *SeasonalResetUseCase) Execute(input SeasonalResetInput) (SeasonalResetOutput, error) {
 	oldSeasonID, err := uc.seasonRepo.GetCurrentSeason()
 	if err != nil {
@@ -55,20 +56,16 @@ func (uc *SeasonalResetUseCase) Execute(input SeasonalResetInput) (SeasonalReset
 		return SeasonalResetOutput{}, fmt.Errorf("seasonal reset: count players: %w", err)
 	}
 
-	resetFn := func(currentMMR int) int {
-		newMMR := 1000 + int(float64(currentMMR-1000)*0.5)
-		if newMMR < 500 {
-			newMMR = 500
-		}
-		return newMMR
+	now := time.Now().UTC()
+	resetFn := func(rating *quick_duel.PlayerRating) {
+		rating.SeasonReset(newSeasonID, now.Unix())
 	}
 
-	if err := uc.playerRatingRepo.ResetAllRatingsForSeason(newSeasonID, resetFn); err != nil {
+	if err := uc.playerRatingRepo.ResetAllRatingsForSeason(resetFn); err != nil {
 		return SeasonalResetOutput{}, fmt.Errorf("seasonal reset: reset ratings: %w", err)
 	}
 
 	// Register the new season record
-	now := time.Now().UTC()
 	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
 	monthEnd := monthStart.AddDate(0, 1, 0).Add(-time.Second)
 	_ = uc.seasonRepo.CreateSeason(newSeasonID, monthStart.Unix(), monthEnd.Unix())
@@ -9,7 +9,8 @@ import (
 
 // mockInventoryService is a simple in-memory inventory service for tests
 type mockInventoryService struct {
-	credits []inventoryCredit
+	credits    []inventoryCredit
+	creditKeys map[string]bool // idempotency keys of the CreditOnce calls applied so far
 }
 
 type inventoryCredit struct {
@@ -27,10 +28,25 @@ func (m *mockInventoryService) Credit(playerID string, source string, details ma
 	return nil
 }
 
+func (m *mockInventoryService) CreditOnce(playerID string, idempotencyKey string, source string, details map[string]int) error {
+	if m.creditKeys == nil {
+		m.creditKeys = make(map[string]bool)
+	}
+	if m.creditKeys[playerID+":"+idempotencyKey] {
+		return nil
+	}
+	m.creditKeys[playerID+":"+idempotencyKey] = true
+	return m.Credit(playerID, source, details)
+}
+
 func (m *mockInventoryService) Debit(playerID string, source string, details map[string]int) error {
 	return nil
 }
 
+func (m *mockInventoryService) DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error {
+	return nil
+}
+
 func TestSeasonalResetUseCase_ResetsAllPlayers(t *testing.T) {
 	f := setupFixture(t)
 
@@ -43,10 +59,10 @@ func TestSeasonalResetUseCase_ResetsAllPlayers(t *testing.T) {
 	// Seed player2 with high MMR
 	p2Rating := quick_duel.ReconstructPlayerRating(
 		mustUserID(testPlayer2ID),
-		2000, // Platinum
+		2000, 60, quick_duel.InitialVolatility, // Platinum
 		quick_duel.LeaguePlatinum, quick_duel.DivisionIV,
 		2000, quick_duel.LeaguePlatinum, quick_duel.DivisionIV,
-		5, "2026-02", 10, 2, 1, now,
+		5, 13, "2026-02", 10, 2, 1, now, now,
 	)
 	if err := f.playerRatingRepo.Save(p2Rating); err != nil {
 		t.Fatalf("save p2 rating: %v", err)
@@ -77,6 +93,9 @@ func TestSeasonalResetUseCase_ResetsAllPlayers(t *testing.T) {
 	if resetP2.MMR() != 1500 {
 		t.Errorf("p2 MMR after reset = %d, want 1500", resetP2.MMR())
 	}
+	if resetP2.RatingDeviation() < quick_duel.SeasonResetMinRD {
+		t.Errorf("p2 RD after reset = %.0f, want at least %.0f", resetP2.RatingDeviation(), quick_duel.SeasonResetMinRD)
+	}
 	if resetP2.SeasonID() != "2026-03" {
 		t.Errorf("p2 SeasonID after reset = %s, want 2026-03", resetP2.SeasonID())
 	}
@@ -89,9 +108,9 @@ func TestSeasonalResetUseCase_MinMMR500(t *testing.T) {
 	// Player with very low MMR (100): 1000 + (100-1000)*0.5 = 550, above 500 → 550
 	lowRating := quick_duel.ReconstructPlayerRating(
 		mustUserID(testPlayer1ID),
+		100, 60, quick_duel.InitialVolatility, quick_duel.LeagueBronze, quick_duel.DivisionIV,
 		100, quick_duel.LeagueBronze, quick_duel.DivisionIV,
-		100, quick_duel.LeagueBronze, quick_duel.DivisionIV,
-		0, "2026-02", 0, 5, 0, now,
+		0, 5, "2026-02", 0, 5, 0, now, now,
 	)
 	if err := f.playerRatingRepo.Save(lowRating); err != nil {
 		t.Fatalf("save: %v", err)
@@ -121,9 +140,9 @@ func TestDistributeSeasonalRewardsUseCase_CreditsAndResets(t *testing.T) {
 	// Seed a Gold player
 	goldRating := quick_duel.ReconstructPlayerRating(
 		mustUserID(testPlayer1ID),
+		1700, 60, quick_duel.InitialVolatility, quick_duel.LeagueGold, quick_duel.DivisionII,
 		1700, quick_duel.LeagueGold, quick_duel.DivisionII,
-		1700, quick_duel.LeagueGold, quick_duel.DivisionII,
-		3, "2026-02", 5, 2, 0, now,
+		3, 7, "2026-02", 5, 2, 0, now, now,
 	)
 	if err := f.playerRatingRepo.Save(goldRating); err != nil {
 		t.Fatalf("save gold rating: %v", err)
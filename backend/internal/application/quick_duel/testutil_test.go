@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
@@ -38,6 +39,10 @@ func (m *mockDuelGameRepo) Save(game *quick_duel.DuelGame) error {
 	return nil
 }
 
+func (m *mockDuelGameRepo) SaveInTx(_ *sql.Tx, game *quick_duel.DuelGame) error {
+	return m.Save(game)
+}
+
 func (m *mockDuelGameRepo) FindByID(id quick_duel.GameID) (*quick_duel.DuelGame, error) {
 	if g, ok := m.games[id.String()]; ok {
 		return g, nil
@@ -45,6 +50,10 @@ func (m *mockDuelGameRepo) FindByID(id quick_duel.GameID) (*quick_duel.DuelGame,
 	return nil, quick_duel.ErrGameNotFound
 }
 
+func (m *mockDuelGameRepo) FindByIDForUpdate(_ *sql.Tx, id quick_duel.GameID) (*quick_duel.DuelGame, error) {
+	return m.FindByID(id)
+}
+
 func (m *mockDuelGameRepo) FindActiveByPlayer(playerID quick_duel.UserID) (*quick_duel.DuelGame, error) {
 	for _, g := range m.games {
 		if g.Status() == quick_duel.GameStatusInProgress || g.Status() == quick_duel.GameStatusWaitingStart {
@@ -278,6 +287,10 @@ func (m *mockPlayerRatingRepo) Save(rating *quick_duel.PlayerRating) error {
 	return nil
 }
 
+func (m *mockPlayerRatingRepo) SaveInTx(_ *sql.Tx, rating *quick_duel.PlayerRating) error {
+	return m.Save(rating)
+}
+
 func (m *mockPlayerRatingRepo) FindByPlayerID(playerID quick_duel.UserID) (*quick_duel.PlayerRating, error) {
 	for _, r := range m.ratings {
 		if r.PlayerID().Equals(playerID) {
@@ -318,8 +331,18 @@ func (m *mockPlayerRatingRepo) GetLeaderboard(seasonID string, limit int, _ int)
 	return result, nil
 }
 
-func (m *mockPlayerRatingRepo) GetFriendsLeaderboard(_ quick_duel.UserID, _ []quick_duel.UserID, _ int) ([]*quick_duel.PlayerRating, error) {
-	return nil, nil
+func (m *mockPlayerRatingRepo) GetFriendsLeaderboard(playerID quick_duel.UserID, friendIDs []quick_duel.UserID, limit int) ([]*quick_duel.PlayerRating, error) {
+	var result []*quick_duel.PlayerRating
+	for _, id := range append([]quick_duel.UserID{playerID}, friendIDs...) {
+		if r, err := m.FindByPlayerID(id); err == nil {
+			result = append(result, r)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].MMR() > result[j].MMR() })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
 }
 
 func (m *mockPlayerRatingRepo) GetPlayerRank(playerID quick_duel.UserID, seasonID string) (int, error) {
@@ -365,30 +388,16 @@ func (m *mockPlayerRatingRepo) FindAllBySeasonID(seasonID string) ([]*quick_duel
 	return result, nil
 }
 
-func (m *mockPlayerRatingRepo) ResetAllRatingsForSeason(newSeasonID string, resetFn func(currentMMR int) int) error {
+func (m *mockPlayerRatingRepo) ResetAllRatingsForSeason(reset func(rating *quick_duel.PlayerRating)) error {
 	// collect all ratings first to avoid modifying while iterating
 	var all []*quick_duel.PlayerRating
 	for _, r := range m.ratings {
 		all = append(all, r)
 	}
 	for _, r := range all {
-		newMMR := resetFn(r.MMR())
-		leagueInfo := quick_duel.GetLeagueFromMMR(newMMR)
-		updated := quick_duel.ReconstructPlayerRating(
-			r.PlayerID(),
-			newMMR,
-			leagueInfo.League(),
-			leagueInfo.Division(),
-			r.PeakMMR(),
-			r.PeakLeague(),
-			r.PeakDivision(),
-			0,
-			newSeasonID,
-			0, 0, 0,
-			r.UpdatedAt(),
-		)
 		delete(m.ratings, m.key(r.PlayerID(), r.SeasonID()))
-		m.ratings[m.key(updated.PlayerID(), updated.SeasonID())] = updated
+		reset(r)
+		m.ratings[m.key(r.PlayerID(), r.SeasonID())] = r
 	}
 	return nil
 }
@@ -423,7 +432,7 @@ func (m *mockMatchmakingQueue) RemoveFromQueue(playerID quick_duel.UserID) error
 	return nil
 }
 
-func (m *mockMatchmakingQueue) FindMatch(_ quick_duel.UserID, _ int, _ int) (*quick_duel.UserID, *int, error) {
+func (m *mockMatchmakingQueue) FindMatch(_ quick_duel.UserID, _ quick_duel.Glicko2Rating, _ int) (*quick_duel.UserID, *int, error) {
 	return nil, nil, nil
 }
 
@@ -601,6 +610,53 @@ func (m *mockReferralRepo) GetPlayerReferralRank(inviterID quick_duel.UserID) (i
 	return 1, nil
 }
 
+// mockRatingHistoryRepo is an in-memory rating history repository
+type mockRatingHistoryRepo struct {
+	entries []*quick_duel.RatingHistoryEntry
+}
+
+func (m *mockRatingHistoryRepo) Save(entry *quick_duel.RatingHistoryEntry) error {
+	for _, e := range m.entries {
+		if e.PlayerID().Equals(entry.PlayerID()) && e.GameID().Equals(entry.GameID()) {
+			return nil
+		}
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockRatingHistoryRepo) FindByGame(gameID quick_duel.GameID) ([]*quick_duel.RatingHistoryEntry, error) {
+	var result []*quick_duel.RatingHistoryEntry
+	for _, e := range m.entries {
+		if e.GameID().Equals(gameID) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRatingHistoryRepo) FindByGames(playerID quick_duel.UserID, gameIDs []quick_duel.GameID) ([]*quick_duel.RatingHistoryEntry, error) {
+	var result []*quick_duel.RatingHistoryEntry
+	for _, e := range m.entries {
+		for _, id := range gameIDs {
+			if e.PlayerID().Equals(playerID) && e.GameID().Equals(id) {
+				result = append(result, e)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRatingHistoryRepo) FindBySeason(playerID quick_duel.UserID, seasonID string) ([]*quick_duel.RatingHistoryEntry, error) {
+	var result []*quick_duel.RatingHistoryEntry
+	for _, e := range m.entries {
+		if e.PlayerID().Equals(playerID) && e.SeasonID() == seasonID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
 // mockTxManager executes the function directly without a real transaction
 type mockTxManager struct{}
 
@@ -659,9 +715,20 @@ func (m *mockOnlineTracker) GetGameID(playerID string) (string, error) {
 	return m.inGame[playerID], nil
 }
 
+// mockFriendsProvider serves friend lists from a map
+type mockFriendsProvider struct {
+	friends map[string][]string // playerID -> friend IDs
+}
+
+func (m *mockFriendsProvider) FriendIDs(playerID string) ([]string, error) {
+	return m.friends[playerID], nil
+}
+
 // mockQuestionRepo is an in-memory question repository for duels
 type mockQuestionRepo struct {
-	questions []QuestionData
+	questions    []QuestionData
+	explanations map[string]quiz.Explanation  // question ID -> explanation
+	types        map[string]quiz.QuestionType // question ID -> type, single choice when unset
 }
 
 func newMockQuestionRepo() *mockQuestionRepo {
@@ -685,7 +752,11 @@ func newMockQuestionRepo() *mockQuestionRepo {
 			},
 		}
 	}
-	return &mockQuestionRepo{questions: qs}
+	return &mockQuestionRepo{
+		questions:    qs,
+		explanations: make(map[string]quiz.Explanation),
+		types:        make(map[string]quiz.QuestionType),
+	}
 }
 
 func (m *mockQuestionRepo) FindRandomByDifficulty(count int, _ string) ([]QuestionData, error) {
@@ -718,6 +789,10 @@ func (m *mockQuestionRepo) FindByID(questionID quiz.QuestionID) (*quiz.Question,
 					return nil, err
 				}
 			}
+			q.SetExplanation(m.explanations[qd.ID])
+			if questionType, ok := m.types[qd.ID]; ok {
+				q.SetType(questionType)
+			}
 			return q, nil
 		}
 	}
@@ -767,6 +842,16 @@ func (m *mockEventBus) Publish(event quick_duel.Event) {
 	m.events = append(m.events, event)
 }
 
+// mockEventOutbox collects events appended to the outbox
+type mockEventOutbox struct {
+	events []quick_duel.Event
+}
+
+func (m *mockEventOutbox) AppendInTx(_ *sql.Tx, events ...quick_duel.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
 // ========================================
 // Test Helpers
 // ========================================
@@ -798,10 +883,12 @@ type duelFixture struct {
 	duelGameRepo     *mockDuelGameRepo
 	challengeRepo    *mockChallengeRepo
 	playerRatingRepo *mockPlayerRatingRepo
+	ratingHistory    *mockRatingHistoryRepo
 	matchmakingQueue *mockMatchmakingQueue
 	seasonRepo       *mockSeasonRepo
 	referralRepo     *mockReferralRepo
 	onlineTracker    *mockOnlineTracker
+	friends          *mockFriendsProvider
 	questionRepo     *mockQuestionRepo
 	userRepo         *mockUserRepo
 	eventBus         *mockEventBus
@@ -819,10 +906,12 @@ func setupFixture(t *testing.T) *duelFixture {
 		duelGameRepo:     newMockDuelGameRepo(),
 		challengeRepo:    newMockChallengeRepo(),
 		playerRatingRepo: newMockPlayerRatingRepo(),
+		ratingHistory:    &mockRatingHistoryRepo{},
 		matchmakingQueue: newMockMatchmakingQueue(),
 		seasonRepo:       newMockSeasonRepo(),
 		referralRepo:     newMockReferralRepo(),
 		onlineTracker:    newMockOnlineTracker(),
+		friends:          &mockFriendsProvider{friends: make(map[string][]string)},
 		questionRepo:     newMockQuestionRepo(),
 		userRepo:         userRepo,
 		eventBus:         &mockEventBus{events: make([]quick_duel.Event, 0)},
@@ -870,16 +959,16 @@ func (f *duelFixture) newAcceptByLinkCodeUC() *AcceptByLinkCodeUseCase {
 // noOpNotifier satisfies TelegramNotifier in tests
 type noOpNotifier struct{}
 
-func (n *noOpNotifier) NotifyChallengeAccepted(_ context.Context, _ int64, _ string, _ string) error {
+func (n *noOpNotifier) NotifyChallengeAccepted(_ context.Context, _ int64, _ string, _ string, _ string) error {
 	return nil
 }
-func (n *noOpNotifier) NotifyInviterWaiting(_ context.Context, _ int64, _ string, _ string) error {
+func (n *noOpNotifier) NotifyInviterWaiting(_ context.Context, _ int64, _ string, _ string, _ string) error {
 	return nil
 }
-func (n *noOpNotifier) NotifyChallengeReceived(_ context.Context, _ int64, _ string, _ string) (int64, error) {
+func (n *noOpNotifier) NotifyChallengeReceived(_ context.Context, _ int64, _ string, _ string, _ string) (int64, error) {
 	return 0, nil
 }
-func (n *noOpNotifier) EditChallengeMessage(_ context.Context, _ int64, _ int64, _ string) error {
+func (n *noOpNotifier) EditChallengeMessage(_ context.Context, _ int64, _ int64, _ string, _ ChallengeMessageStatus) error {
 	return nil
 }
 func (n *noOpNotifier) SavePreparedInlineMessage(_ context.Context, _ int64, _ string, _ string) (string, int64, error) {
@@ -899,13 +988,17 @@ func (f *duelFixture) newCreateChallengeLinkUC() *CreateChallengeLinkUseCase {
 }
 
 func (f *duelFixture) newGetGameHistoryUC() *GetGameHistoryUseCase {
-	return NewGetGameHistoryUseCase(f.duelGameRepo, f.userRepo)
+	return NewGetGameHistoryUseCase(f.duelGameRepo, f.userRepo).WithRatingHistory(f.ratingHistory)
+}
+
+func (f *duelFixture) newGetRatingHistoryUC() *GetRatingHistoryUseCase {
+	return NewGetRatingHistoryUseCase(f.ratingHistory, f.playerRatingRepo, f.seasonRepo)
 }
 
 func (f *duelFixture) newGetLeaderboardUC() *GetLeaderboardUseCase {
 	return NewGetLeaderboardUseCase(
 		f.playerRatingRepo, f.referralRepo, f.seasonRepo, f.userRepo,
-	)
+	).WithFriends(f.friends)
 }
 
 func (f *duelFixture) newStartGameUC() *StartGameUseCase {
@@ -919,9 +1012,16 @@ func (f *duelFixture) newSubmitDuelAnswerUC() *SubmitDuelAnswerUseCase {
 	return NewSubmitDuelAnswerUseCase(
 		f.duelGameRepo, f.playerRatingRepo, f.questionRepo,
 		f.seasonRepo, f.eventBus,
-		NewMemoryRoundCache(),
 		nil,
-	)
+	).WithRatingHistory(f.ratingHistory)
+}
+
+func (f *duelFixture) newBotPlayerUC() *BotPlayerUseCase {
+	return NewBotPlayerUseCase(f.duelGameRepo, f.questionRepo, nil)
+}
+
+func (f *duelFixture) newGetGameReplayUC() *GetGameReplayUseCase {
+	return NewGetGameReplayUseCase(f.duelGameRepo, f.questionRepo, f.userRepo)
 }
 
 func (f *duelFixture) newRequestRematchUC() *RequestRematchUseCase {
@@ -929,7 +1029,7 @@ func (f *duelFixture) newRequestRematchUC() *RequestRematchUseCase {
 }
 
 func (f *duelFixture) newGetOnlineFriendsUC() *GetOnlineFriendsUseCase {
-	return NewGetOnlineFriendsUseCase(f.onlineTracker, f.userRepo)
+	return NewGetOnlineFriendsUseCase(f.onlineTracker, f.userRepo, f.friends)
 }
 
 func (f *duelFixture) newGetRivalsUC() *GetRivalsUseCase {
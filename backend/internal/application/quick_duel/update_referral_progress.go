@@ -0,0 +1,85 @@
+package quick_duel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// ========================================
+// UpdateReferralProgress Use Case
+// ========================================
+
+type UpdateReferralProgressInput struct {
+	PlayerID string `json:"playerId"`
+}
+
+// UpdateReferralProgressUseCase advances the referral milestones of an invited player.
+// Runs after each finished duel; players nobody invited (and the bot) are skipped.
+type UpdateReferralProgressUseCase struct {
+	referralRepo     quick_duel.ReferralRepository
+	duelGameRepo     quick_duel.DuelGameRepository
+	playerRatingRepo quick_duel.PlayerRatingRepository
+	eventBus         EventBus
+}
+
+func NewUpdateReferralProgressUseCase(
+	referralRepo quick_duel.ReferralRepository,
+	duelGameRepo quick_duel.DuelGameRepository,
+	playerRatingRepo quick_duel.PlayerRatingRepository,
+	eventBus EventBus,
+) *UpdateReferralProgressUseCase {
+	return &UpdateReferralProgressUseCase{
+		referralRepo:     referralRepo,
+		duelGameRepo:     duelGameRepo,
+		playerRatingRepo: playerRatingRepo,
+		eventBus:         eventBus,
+	}
+}
+
+func (uc *UpdateReferralProgressUseCase) Execute(input UpdateReferralProgressInput) error {
+	if input.PlayerID == BotUserID {
+		return nil
+	}
+
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	referral, err := uc.referralRepo.FindByInvitee(playerID)
+	if errors.Is(err, quick_duel.ErrReferralNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update referral progress: find referral: %w", err)
+	}
+
+	_, duelsPlayed, err := uc.duelGameRepo.FindByPlayerPaginated(playerID, 1, 0, "")
+	if err != nil {
+		return fmt.Errorf("update referral progress: count duels: %w", err)
+	}
+
+	// League milestones count once reached, even if the player dropped since
+	league := quick_duel.LeagueBronze
+	if rating, err := uc.playerRatingRepo.FindByPlayerID(playerID); err == nil && rating != nil {
+		league = rating.PeakLeague()
+	}
+
+	referral.UpdateProgress(duelsPlayed, league, time.Now().UTC().Unix())
+
+	events := referral.Events()
+	if len(events) == 0 {
+		return nil
+	}
+	if err := uc.referralRepo.Save(referral); err != nil {
+		return fmt.Errorf("update referral progress: save referral: %w", err)
+	}
+	for _, event := range events {
+		uc.eventBus.Publish(event)
+	}
+	return nil
+}
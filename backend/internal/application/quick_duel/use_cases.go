@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
@@ -26,6 +28,7 @@ type GetDuelStatusUseCase struct {
 	userRepo         domainUser.UserRepository
 	onlineTracker    OnlineTracker // optional, nil if Redis unavailable
 	inventoryService InventoryService
+	friends          FriendsProvider // optional, see WithFriends
 }
 
 func NewGetDuelStatusUseCase(
@@ -48,6 +51,12 @@ func NewGetDuelStatusUseCase(
 	}
 }
 
+// WithFriends lists the player's online friends in the status
+func (uc *GetDuelStatusUseCase) WithFriends(friends FriendsProvider) *GetDuelStatusUseCase {
+	uc.friends = friends
+	return uc
+}
+
 func (uc *GetDuelStatusUseCase) Execute(input GetDuelStatusInput) (GetDuelStatusOutput, error) {
 	now := time.Now().UTC().Unix()
 
@@ -174,12 +183,19 @@ func (uc *GetDuelStatusUseCase) Execute(input GetDuelStatusInput) (GetDuelStatus
 		}
 	}
 
+	friendsOnline := []FriendDTO{}
+	if uc.friends != nil && uc.onlineTracker != nil {
+		if online, err := listOnlineFriends(uc.friends, uc.onlineTracker, uc.userRepo, input.PlayerID); err == nil {
+			friendsOnline = online
+		}
+	}
+
 	return GetDuelStatusOutput{
 		HasActiveDuel:      activeGameID != nil,
 		ActiveGameID:       activeGameID,
 		Player:             ToPlayerRatingDTO(rating),
 		Tickets:            tickets,
-		FriendsOnline:      []FriendDTO{}, // TODO: implement friends service
+		FriendsOnline:      friendsOnline,
 		PendingChallenges:  challengeDTOs,
 		OutgoingChallenges: outgoingDTOs,
 		AcceptedChallenges: acceptedDTOs,
@@ -249,7 +265,7 @@ func (uc *JoinQueueUseCase) Execute(input JoinQueueInput) (JoinQueueOutput, erro
 
 	// Consume PvP ticket
 	if uc.inventoryService != nil {
-		err := uc.inventoryService.Debit(input.PlayerID, "pvp_entry", map[string]int{"pvp_tickets": 1})
+		err := uc.inventoryService.DebitOnce(input.PlayerID, ticketDebitKey(input.IdempotencyKey), "pvp_entry", map[string]int{"pvp_tickets": 1})
 		if err != nil {
 			return JoinQueueOutput{}, quick_duel.ErrInsufficientTickets
 		}
@@ -263,12 +279,13 @@ func (uc *JoinQueueUseCase) Execute(input JoinQueueInput) (JoinQueueOutput, erro
 
 	// Get queue info
 	queueLength, _ := uc.matchmakingQueue.GetQueueLength()
+	_, maxMMR := rating.CurrentRating(now).MatchmakingRange(0)
 
 	return JoinQueueOutput{
 		QueueID:       playerID.String(),
 		Status:        "searching",
 		EstimatedWait: 10, // Estimate based on queue length
-		MMRRange:      "±50",
+		MMRRange:      fmt.Sprintf("±%d", maxMMR-rating.MMR()),
 		Position:      queueLength,
 	}, nil
 }
@@ -413,7 +430,7 @@ func (uc *SendChallengeUseCase) Execute(input SendChallengeInput) (SendChallenge
 
 	// Consume PvP ticket for sending a challenge
 	if uc.inventoryService != nil {
-		err := uc.inventoryService.Debit(input.PlayerID, "pvp_entry", map[string]int{"pvp_tickets": 1})
+		err := uc.inventoryService.DebitOnce(input.PlayerID, ticketDebitKey(input.IdempotencyKey), "pvp_entry", map[string]int{"pvp_tickets": 1})
 		if err != nil {
 			return SendChallengeOutput{}, quick_duel.ErrInsufficientTickets
 		}
@@ -447,7 +464,7 @@ func (uc *SendChallengeUseCase) Execute(input SendChallengeInput) (SendChallenge
 			}
 		}
 		deepLink := "https://t.me/" + uc.botUsername + "?startapp=challenge_" + challenge.ID().String()
-		if msgID, err := uc.notifier.NotifyChallengeReceived(context.Background(), inviteeTgID, inviterName, deepLink); err == nil && msgID > 0 {
+		if msgID, err := uc.notifier.NotifyChallengeReceived(context.Background(), inviteeTgID, playerLanguage(uc.userRepo, friendID), inviterName, deepLink); err == nil && msgID > 0 {
 			challenge.SetTelegramMessageID(msgID)
 			_ = uc.challengeRepo.Save(challenge) // save messageID (best-effort)
 		}
@@ -537,7 +554,7 @@ func (uc *RespondChallengeUseCase) Execute(input RespondChallengeInput) (Respond
 		// Edit Telegram notification in invitee's chat (best-effort)
 		if challenge.TelegramMessageID() > 0 {
 			if tgID, err := strconv.ParseInt(playerID.String(), 10, 64); err == nil {
-				_ = uc.notifier.EditChallengeMessage(context.Background(), tgID, challenge.TelegramMessageID(), "❌ Вызов отклонён")
+				_ = uc.notifier.EditChallengeMessage(context.Background(), tgID, challenge.TelegramMessageID(), playerLanguage(uc.userRepo, playerID), ChallengeMessageDeclined)
 			}
 		}
 
@@ -599,13 +616,14 @@ func (uc *RespondChallengeUseCase) Execute(input RespondChallengeInput) (Respond
 		questionIDs = append(questionIDs, qid)
 	}
 
-	player1 := quick_duel.NewDuelPlayer(challengerID, challengerName, quick_duel.ReconstructEloRating(rating1.MMR(), 0))
-	player2 := quick_duel.NewDuelPlayer(accepterID, accepterName, quick_duel.ReconstructEloRating(rating2.MMR(), 0))
+	player1 := quick_duel.NewDuelPlayer(challengerID, challengerName, rating1.MMR())
+	player2 := quick_duel.NewDuelPlayer(accepterID, accepterName, rating2.MMR())
 
 	game, err := quick_duel.NewDuelGame(player1, player2, questionIDs, now)
 	if err != nil {
 		return RespondChallengeOutput{}, err
 	}
+	game.MarkFriendMatch()
 	if err := game.Start(now); err != nil {
 		return RespondChallengeOutput{}, err
 	}
@@ -648,14 +666,14 @@ func (uc *RespondChallengeUseCase) Execute(input RespondChallengeInput) (Respond
 	// Edit Telegram notification in invitee's chat (best-effort)
 	if telegramMsgID > 0 {
 		if tgID, err := strconv.ParseInt(playerID.String(), 10, 64); err == nil {
-			_ = uc.notifier.EditChallengeMessage(context.Background(), tgID, telegramMsgID, "✅ Вызов принят — удачи!")
+			_ = uc.notifier.EditChallengeMessage(context.Background(), tgID, telegramMsgID, playerLanguage(uc.userRepo, playerID), ChallengeMessageAccepted)
 		}
 	}
 
 	// Notify challenger via Telegram in case they are offline (best-effort)
 	if challengerTgID, err := strconv.ParseInt(challengerID.String(), 10, 64); err == nil && challengerTgID > 0 {
 		lobbyURL := "https://t.me/" + uc.botUsername + "?startapp=lobby"
-		_ = uc.notifier.NotifyChallengeAccepted(context.Background(), challengerTgID, accepterName, lobbyURL)
+		_ = uc.notifier.NotifyChallengeAccepted(context.Background(), challengerTgID, playerLanguage(uc.userRepo, challengerID), accepterName, lobbyURL)
 	}
 
 	gameID := game.ID().String()
@@ -676,13 +694,34 @@ func (uc *RespondChallengeUseCase) Execute(input RespondChallengeInput) (Respond
 // TelegramNotifier port (implemented in infrastructure/telegram)
 // ========================================
 
-// TelegramNotifier sends Telegram notifications to users.
+// TelegramNotifier sends Telegram notifications to users. Each method takes the
+// recipient's language code; the notifier picks the text for it, falling back
+// to quiz.DefaultLocale.
 type TelegramNotifier interface {
-	NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, inviteeName string, lobbyURL string) error
-	NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, inviterName string, lobbyURL string) error
-	NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, inviterName string, deepLink string) (int64, error)
-	EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, text string) error
-	SavePreparedInlineMessage(ctx context.Context, userID int64, challengeLink string, challengeText string) (string, int64, error)
+	NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, language string, inviteeName string, lobbyURL string) error
+	NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, lobbyURL string) error
+	NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, deepLink string) (int64, error)
+	EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, language string, status ChallengeMessageStatus) error
+	SavePreparedInlineMessage(ctx context.Context, userID int64, language string, challengeLink string) (string, int64, error)
+}
+
+// ChallengeMessageStatus is what a challenge notification is edited to say once
+// the challenge is settled
+type ChallengeMessageStatus string
+
+const (
+	ChallengeMessageAccepted ChallengeMessageStatus = "accepted"
+	ChallengeMessageDeclined ChallengeMessageStatus = "declined"
+	ChallengeMessageExpired  ChallengeMessageStatus = "expired"
+)
+
+// playerLanguage returns a player's language code for notifications, or "" if unknown
+func playerLanguage(userRepo domainUser.UserRepository, id shared.UserID) string {
+	u, err := userRepo.FindByID(id)
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.LanguageCode().String()
 }
 
 // ========================================
@@ -815,7 +854,7 @@ func (uc *AcceptByLinkCodeUseCase) Execute(input AcceptByLinkCodeInput) (AcceptB
 	challengerID := challenge.ChallengerID()
 	if tgID, err := strconv.ParseInt(challengerID.String(), 10, 64); err == nil && tgID > 0 {
 		lobbyURL := "https://t.me/" + uc.botUsername + "?startapp=lobby"
-		_ = uc.notifier.NotifyChallengeAccepted(context.Background(), tgID, inviteeName, lobbyURL)
+		_ = uc.notifier.NotifyChallengeAccepted(context.Background(), tgID, playerLanguage(uc.userRepo, challengerID), inviteeName, lobbyURL)
 	}
 
 	// Resolve inviter's display name
@@ -891,8 +930,9 @@ func (uc *CreateChallengeLinkUseCase) Execute(input CreateChallengeLinkInput) (C
 // ========================================
 
 type GetGameHistoryUseCase struct {
-	duelGameRepo quick_duel.DuelGameRepository
-	userRepo     domainUser.UserRepository
+	duelGameRepo  quick_duel.DuelGameRepository
+	userRepo      domainUser.UserRepository
+	ratingHistory quick_duel.RatingHistoryRepository // optional, see WithRatingHistory
 }
 
 func NewGetGameHistoryUseCase(
@@ -905,6 +945,12 @@ func NewGetGameHistoryUseCase(
 	}
 }
 
+// WithRatingHistory fills in each game's rating change; without it mmrChange is 0
+func (uc *GetGameHistoryUseCase) WithRatingHistory(ratingHistory quick_duel.RatingHistoryRepository) *GetGameHistoryUseCase {
+	uc.ratingHistory = ratingHistory
+	return uc
+}
+
 func (uc *GetGameHistoryUseCase) Execute(input GetGameHistoryInput) (GetGameHistoryOutput, error) {
 	playerID, err := shared.NewUserID(input.PlayerID)
 	if err != nil {
@@ -924,6 +970,19 @@ func (uc *GetGameHistoryUseCase) Execute(input GetGameHistoryInput) (GetGameHist
 		return GetGameHistoryOutput{}, err
 	}
 
+	mmrChanges := make(map[string]int, len(games))
+	if uc.ratingHistory != nil && len(games) > 0 {
+		gameIDs := make([]quick_duel.GameID, 0, len(games))
+		for _, game := range games {
+			gameIDs = append(gameIDs, game.ID())
+		}
+		if recorded, err := uc.ratingHistory.FindByGames(playerID, gameIDs); err == nil {
+			for _, entry := range recorded {
+				mmrChanges[entry.GameID().String()] = entry.Change().MMRDelta()
+			}
+		}
+	}
+
 	entries := make([]GameHistoryEntryDTO, 0, len(games))
 	for _, game := range games {
 		// Determine opponent
@@ -937,7 +996,7 @@ func (uc *GetGameHistoryUseCase) Execute(input GetGameHistoryInput) (GetGameHist
 			opponentUsername = user.Username().String()
 		}
 
-		entries = append(entries, ToGameHistoryEntryDTO(game, input.PlayerID, opponentUsername))
+		entries = append(entries, ToGameHistoryEntryDTO(game, input.PlayerID, opponentUsername, mmrChanges[game.ID().String()]))
 	}
 
 	return GetGameHistoryOutput{
@@ -956,6 +1015,7 @@ type GetLeaderboardUseCase struct {
 	referralRepo     quick_duel.ReferralRepository
 	seasonRepo       quick_duel.SeasonRepository
 	userRepo         domainUser.UserRepository
+	friends          FriendsProvider // optional, see WithFriends
 }
 
 func NewGetLeaderboardUseCase(
@@ -972,6 +1032,12 @@ func NewGetLeaderboardUseCase(
 	}
 }
 
+// WithFriends enables the "friends" leaderboard; without it the board is empty
+func (uc *GetLeaderboardUseCase) WithFriends(friends FriendsProvider) *GetLeaderboardUseCase {
+	uc.friends = friends
+	return uc
+}
+
 func (uc *GetLeaderboardUseCase) Execute(input GetLeaderboardInput) (GetLeaderboardOutput, error) {
 	playerID, err := shared.NewUserID(input.PlayerID)
 	if err != nil {
@@ -1029,9 +1095,37 @@ func (uc *GetLeaderboardUseCase) Execute(input GetLeaderboardInput) (GetLeaderbo
 		playerRank, _ = uc.referralRepo.GetPlayerReferralRank(playerID)
 
 	default: // "friends"
-		// TODO: implement friends leaderboard
 		entries = []LeaderboardEntryDTO{}
-		playerRank = 0
+		if uc.friends == nil {
+			break
+		}
+
+		friendIDs, err := uc.friends.FriendIDs(input.PlayerID)
+		if err != nil {
+			return GetLeaderboardOutput{}, err
+		}
+		ids := make([]quick_duel.UserID, 0, len(friendIDs))
+		for _, id := range friendIDs {
+			if uid, err := shared.NewUserID(id); err == nil {
+				ids = append(ids, uid)
+			}
+		}
+
+		ratings, err := uc.playerRatingRepo.GetFriendsLeaderboard(playerID, ids, limit)
+		if err != nil {
+			return GetLeaderboardOutput{}, err
+		}
+
+		for i, rating := range ratings {
+			username := "Player"
+			if user, err := uc.userRepo.FindByID(rating.PlayerID()); err == nil && user != nil {
+				username = user.Username().String()
+			}
+			entries = append(entries, ToLeaderboardEntryDTO(rating, i+1, username))
+			if rating.PlayerID().Equals(playerID) {
+				playerRank = i + 1
+			}
+		}
 	}
 
 	return GetLeaderboardOutput{
@@ -1334,13 +1428,13 @@ func (uc *StartGameUseCase) Execute(input StartGameInput) (StartGameOutput, erro
 	player1 := quick_duel.NewDuelPlayer(
 		player1ID,
 		input.Player1Username,
-		quick_duel.ReconstructEloRating(rating1.MMR(), 0),
+		rating1.MMR(),
 	)
 
 	player2 := quick_duel.NewDuelPlayer(
 		player2ID,
 		input.Player2Username,
-		quick_duel.ReconstructEloRating(rating2.MMR(), 0),
+		rating2.MMR(),
 	)
 
 	// Convert question IDs
@@ -1376,8 +1470,8 @@ func (uc *StartGameUseCase) Execute(input StartGameInput) (StartGameOutput, erro
 	}, nil
 }
 
-// GetRoundQuestion returns the question for a specific round
-func (uc *StartGameUseCase) GetRoundQuestion(gameIDStr string, roundNum int) (*RoundQuestionOutput, error) {
+// GetRoundQuestion returns the question for a specific round in the given locale
+func (uc *StartGameUseCase) GetRoundQuestion(gameIDStr string, roundNum int, locale string) (*RoundQuestionOutput, error) {
 	gameID := quick_duel.NewGameIDFromString(gameIDStr)
 	game, err := uc.duelGameRepo.FindByID(gameID)
 	if err != nil {
@@ -1394,19 +1488,24 @@ func (uc *StartGameUseCase) GetRoundQuestion(gameIDStr string, roundNum int) (*R
 	if err != nil {
 		return nil, fmt.Errorf("get round question: load question %s: %w", questionID, err)
 	}
+	question = question.Localized(locale)
 
-	answers := make([]map[string]string, 0, len(question.Answers()))
-	for _, a := range question.Answers() {
-		answers = append(answers, map[string]string{
-			"id":   a.ID().String(),
-			"text": a.Text().String(),
+	answers := make([]DuelAnswerDTO, 0, len(question.Answers()))
+	for _, a := range question.DisplayAnswers() {
+		answers = append(answers, DuelAnswerDTO{
+			ID:    a.ID().String(),
+			Text:  a.Text().String(),
+			Media: appQuiz.ToMediaDTO(a.Media()),
 		})
 	}
 
 	return &RoundQuestionOutput{
-		QuestionID:   questionID.String(),
-		QuestionText: question.Text().String(),
-		Answers:      answers,
+		QuestionID:    questionID.String(),
+		QuestionType:  question.Type().String(),
+		QuestionText:  question.Text().String(),
+		QuestionMedia: appQuiz.ToMediaDTO(question.Media()),
+		Answers:       answers,
+		SentAt:        game.QuestionSentAt(roundNum),
 	}, nil
 }
 
@@ -1433,18 +1532,10 @@ type SubmitDuelAnswerUseCase struct {
 	questionRepo     QuestionRepository
 	seasonRepo       quick_duel.SeasonRepository
 	eventBus         EventBus
-	roundCache       DuelRoundCache
 	inventoryService InventoryService
-}
-
-// PlayerAnswer holds a single player's answer for one duel round.
-// Exported so it can be stored and retrieved by DuelRoundCache implementations.
-type PlayerAnswer struct {
-	PlayerID  string `json:"player_id"`
-	AnswerID  string `json:"answer_id"`
-	IsCorrect bool   `json:"is_correct"`
-	TimeTaken int    `json:"time_taken"`
-	Points    int    `json:"points"`
+	ratingHistory    quick_duel.RatingHistoryRepository // optional, see WithRatingHistory
+	txManager        TxManager                          // optional, see WithOutbox
+	outbox           EventOutbox                        // optional, see WithOutbox
 }
 
 func NewSubmitDuelAnswerUseCase(
@@ -1453,7 +1544,6 @@ func NewSubmitDuelAnswerUseCase(
 	questionRepo QuestionRepository,
 	seasonRepo quick_duel.SeasonRepository,
 	eventBus EventBus,
-	roundCache DuelRoundCache,
 	inventoryService InventoryService,
 ) *SubmitDuelAnswerUseCase {
 	return &SubmitDuelAnswerUseCase{
@@ -1462,256 +1552,284 @@ func NewSubmitDuelAnswerUseCase(
 		questionRepo:     questionRepo,
 		seasonRepo:       seasonRepo,
 		eventBus:         eventBus,
-		roundCache:       roundCache,
 		inventoryService: inventoryService,
 	}
 }
 
+// WithRatingHistory records each player's rating change when a game finishes
+func (uc *SubmitDuelAnswerUseCase) WithRatingHistory(ratingHistory quick_duel.RatingHistoryRepository) *SubmitDuelAnswerUseCase {
+	uc.ratingHistory = ratingHistory
+	return uc
+}
+
+// WithOutbox saves finished games and their events in one transaction through the outbox
+func (uc *SubmitDuelAnswerUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *SubmitDuelAnswerUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 func (uc *SubmitDuelAnswerUseCase) Execute(input SubmitDuelAnswerInput) (*SubmitDuelAnswerOutput, error) {
 	if uc.questionRepo == nil {
 		return nil, fmt.Errorf("submit duel answer: question repository not configured")
 	}
-	if uc.roundCache == nil {
-		return nil, fmt.Errorf("submit duel answer: round cache not configured")
-	}
-
 	now := time.Now().UTC().Unix()
 
-	gameID := quick_duel.NewGameIDFromString(input.GameID)
-	game, err := uc.duelGameRepo.FindByID(gameID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check if game is in progress
-	if game.Status() != quick_duel.GameStatusInProgress {
-		return nil, quick_duel.ErrGameNotFound
-	}
-
-	// Validate player is in the game
-	isPlayer1 := game.Player1().UserID().String() == input.PlayerID
-	isPlayer2 := game.Player2().UserID().String() == input.PlayerID
-	if !isPlayer1 && !isPlayer2 {
-		return nil, quick_duel.ErrGameNotFound
-	}
-
-	// Determine the current round's question ID and look it up
-	currentRound := game.CurrentRound()
-	questionIDs := game.QuestionIDs()
-	if currentRound < 1 || currentRound > len(questionIDs) {
-		return nil, fmt.Errorf("submit duel answer: invalid round %d", currentRound)
-	}
-	currentQuestionID := questionIDs[currentRound-1]
-
-	// Detect stale round: if the client sent a questionID and it doesn't match
-	// the server's current round question, the round already advanced (timeout race).
-	// Treat as late/stale answer — return ErrPlayerAlreadyAnswered instead of confusing ErrAnswerNotFound.
-	if input.QuestionID != "" && input.QuestionID != currentQuestionID.String() {
-		return nil, quick_duel.ErrPlayerAlreadyAnswered
-	}
-
-	question, err := uc.questionRepo.FindByID(currentQuestionID)
-	if err != nil {
-		return nil, fmt.Errorf("submit duel answer: load question: %w", err)
-	}
+	// Round answers live on the aggregate: serialize with the opponent's answer
+	// and the round timer so neither write is lost (across instances, see gameWriter.update)
+	unlock := gameLocks.Lock(input.GameID)
+	defer unlock()
 
-	// Parse player and answer IDs
-	playerID, err := shared.NewUserID(input.PlayerID)
-	if err != nil {
-		return nil, err
-	}
-	answerID, err := quiz.NewAnswerIDFromString(input.AnswerID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Pre-populate roundAnswers from Redis cache (roundAnswers are not persisted to DB).
-	// This ensures the domain aggregate knows about the opponent's already-submitted answer
-	// so that bothAnswered/completeRound logic works correctly.
-	if cachedAnswers, cacheErr := uc.roundCache.GetAnswers(input.GameID, currentRound); cacheErr == nil {
-		for _, ca := range cachedAnswers {
-			caPlayerID, _ := shared.NewUserID(ca.PlayerID)
-			caAnswerID, _ := quiz.NewAnswerIDFromString(ca.AnswerID)
-			game.ReplayRoundAnswer(currentRound, caPlayerID, caAnswerID, int64(ca.TimeTaken), ca.IsCorrect, ca.Points)
+	var output *SubmitDuelAnswerOutput
+	var finished *finishedGame
+	err := uc.writer().update(quick_duel.NewGameIDFromString(input.GameID), func(tx *sql.Tx, game *quick_duel.DuelGame) error {
+		// Check if game is in progress
+		if game.Status() != quick_duel.GameStatusInProgress {
+			return quick_duel.ErrGameNotFound
 		}
-	}
-
-	// Delegate correctness check and scoring to the domain aggregate
-	result, err := game.SubmitAnswer(playerID, answerID, int64(input.TimeTaken), question, now)
-	if err != nil {
-		return nil, err
-	}
 
-	isCorrect := result.IsCorrect
-	points := result.PointsEarned
+		// Validate player is in the game
+		isPlayer1 := game.Player1().UserID().String() == input.PlayerID
+		isPlayer2 := game.Player2().UserID().String() == input.PlayerID
+		if !isPlayer1 && !isPlayer2 {
+			return quick_duel.ErrGameNotFound
+		}
 
-	// Determine the correct answer ID to return to the client
-	var correctAnswerID string
-	for _, a := range question.Answers() {
-		if a.IsCorrect() {
-			correctAnswerID = a.ID().String()
-			break
+		// Determine the current round's question ID and look it up
+		currentRound := game.CurrentRound()
+		questionIDs := game.QuestionIDs()
+		if currentRound < 1 || currentRound > len(questionIDs) {
+			return fmt.Errorf("submit duel answer: invalid round %d", currentRound)
 		}
-	}
+		currentQuestionID := questionIDs[currentRound-1]
 
-	// Track this answer in the distributed cache
-	if err := uc.roundCache.AddAnswer(input.GameID, currentRound, PlayerAnswer{
-		PlayerID:  input.PlayerID,
-		AnswerID:  input.AnswerID,
-		IsCorrect: isCorrect,
-		TimeTaken: input.TimeTaken,
-		Points:    points,
-	}); err != nil {
-		return nil, fmt.Errorf("submit duel answer: cache answer: %w", err)
-	}
+		// Detect stale round: if the client sent a questionID and it doesn't match
+		// the server's current round question, the round already advanced (timeout race).
+		// Treat as late/stale answer — return ErrPlayerAlreadyAnswered instead of confusing ErrAnswerNotFound.
+		if input.QuestionID != "" && input.QuestionID != currentQuestionID.String() {
+			return quick_duel.ErrPlayerAlreadyAnswered
+		}
 
-	// Check if round is complete (both players answered)
-	roundAnswers, err := uc.roundCache.GetAnswers(input.GameID, currentRound)
-	if err != nil {
-		return nil, fmt.Errorf("submit duel answer: get round answers: %w", err)
-	}
-	roundComplete := len(roundAnswers) >= 2
+		question, err := uc.questionRepo.FindByID(currentQuestionID)
+		if err != nil {
+			return fmt.Errorf("submit duel answer: load question: %w", err)
+		}
 
-	// roundAnswers are not persisted to the DB (see TODO in reconstructGame), so the
-	// domain's bothAnswered check is always false after reconstruction. When the cache
-	// confirms both players answered, force completeRound() via RecordTimeoutAnswer for
-	// the opponent — this advances currentRound in the DB so the next answer hits the
-	// correct question.
-	if roundComplete && !result.BothAnswered {
-		var opponentID quick_duel.UserID
-		if game.Player1().UserID().String() == input.PlayerID {
-			opponentID = game.Player2().UserID()
-		} else {
-			opponentID = game.Player1().UserID()
+		// Parse player and answer IDs
+		playerID, err := shared.NewUserID(input.PlayerID)
+		if err != nil {
+			return err
 		}
-		if timeoutResult, _ := game.RecordTimeoutAnswer(opponentID, now); timeoutResult != nil && timeoutResult.IsGameFinished {
-			result.IsGameFinished = true
+		submission, err := appQuiz.ParseAnswerSubmission(input.AnswerID, input.AnswerIDs, input.NumericAnswer)
+		if err != nil {
+			return err
 		}
-	}
-
-	// Scores are already maintained by the domain aggregate
-	player1Score := game.Player1().Score()
-	player2Score := game.Player2().Score()
 
-	// Game is complete when the domain aggregate reports so (last round, both answered)
-	gameComplete := result.IsGameFinished
-
-	output := &SubmitDuelAnswerOutput{
-		IsCorrect:       isCorrect,
-		CorrectAnswerID: correctAnswerID,
-		PointsEarned:    points,
-		Player1Score:    player1Score,
-		Player2Score:    player2Score,
-		RoundComplete:   roundComplete,
-		GameComplete:    gameComplete,
-	}
+		receipt := quick_duel.AnswerReceipt{
+			ClientTimeMs: int64(input.TimeTaken),
+			ReceivedAtMs: input.ReceivedAtMs,
+			LatencyMs:    input.LatencyMs,
+		}
+		if receipt.ReceivedAtMs == 0 {
+			receipt.ReceivedAtMs = time.Now().UnixMilli()
+		}
 
-	// Save updated game state (covers both mid-game and final-round persistence).
-	// finalizeGame will save again after applying MMR changes.
-	if !gameComplete {
-		if err := uc.duelGameRepo.Save(game); err != nil {
-			return nil, fmt.Errorf("submit duel answer: save game: %w", err)
+		// Delegate timing, correctness check and scoring to the domain aggregate
+		result, err := game.SubmitAnswer(playerID, submission, receipt, question, now)
+		if err != nil {
+			return err
+		}
+		if result.TimingFlagged {
+			log.Printf("[SubmitDuelAnswer] Game %s round %d: timing of %s flagged (client %dms, latency %dms)",
+				input.GameID, result.RoundNumber, input.PlayerID, input.TimeTaken, input.LatencyMs)
+		}
+
+		// The aggregate knows the opponent's answer (round answers are persisted),
+		// so it completes the round itself once both players answered
+		roundComplete := result.BothAnswered
+
+		output = &SubmitDuelAnswerOutput{
+			IsCorrect:       result.IsCorrect,
+			CorrectAnswerID: appQuiz.FindCorrectAnswerID(question),
+			PointsEarned:    result.PointsEarned,
+			TimeTaken:       result.TimeTaken,
+			// Scores are already maintained by the domain aggregate
+			Player1Score:  game.Player1().Score(),
+			Player2Score:  game.Player2().Score(),
+			RoundComplete: roundComplete,
+			// Game is complete when the domain aggregate reports so (last round, both answered)
+			GameComplete: result.IsGameFinished,
+		}
+		output.CorrectAnswerIDs = appQuiz.CorrectAnswerIDsOf(question)
+		output.CorrectNumber = appQuiz.CorrectNumberOf(question)
+		// The explanation would give the answer away while the opponent is still answering
+		if roundComplete {
+			output.Explanation = ToExplanationDTO(question)
+			output.question = question
+		}
+
+		// If game is complete, apply MMR changes before the finished game is saved
+		if result.IsGameFinished {
+			finished, err = uc.finalizeGame(tx, game, now, output)
+			return err
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// If game is complete, apply MMR changes and save the finalised game
-	if gameComplete {
-		uc.finalizeGame(game, player1Score, player2Score, now, output)
-	}
+	uc.creditRewards(finished)
 
 	return output, nil
 }
 
+// writer saves games through the outbox when WithOutbox is set
+func (uc *SubmitDuelAnswerUseCase) writer() gameWriter {
+	return gameWriter{uc.duelGameRepo, uc.eventBus, uc.txManager, uc.outbox}
+}
+
+// finishedGame is what a finished game's rewards are paid from, once the game is stored
+type finishedGame struct {
+	game    *quick_duel.DuelGame
+	league1 quick_duel.League
+	league2 quick_duel.League
+}
+
+// finalizeGame applies the rating changes of a finished game within tx (see gameWriter.update):
+// they commit together with the finished game, so a finish is rated once.
 func (uc *SubmitDuelAnswerUseCase) finalizeGame(
+	tx *sql.Tx,
 	game *quick_duel.DuelGame,
-	player1Score, player2Score int,
 	now int64,
 	output *SubmitDuelAnswerOutput,
-) {
-	// Determine winner
+) (*finishedGame, error) {
+	// The aggregate decided the outcome (score, time tiebreak or forfeit)
 	var winnerID string
-	var player1Won, player2Won bool
-
-	if player1Score > player2Score {
-		winnerID = game.Player1().UserID().String()
-		player1Won = true
-		player2Won = false
-	} else if player2Score > player1Score {
-		winnerID = game.Player2().UserID().String()
-		player1Won = false
-		player2Won = true
-	} else {
-		// Tie - treat as draw (neither won)
-		player1Won = false
-		player2Won = false
+	if winner := game.WinnerID(); winner != nil {
+		winnerID = winner.String()
 	}
+	isDraw := winnerID == ""
+	player1Won := !isDraw && winnerID == game.Player1().UserID().String()
+	player2Won := !isDraw && !player1Won
 
 	output.WinnerID = winnerID
+	output.WinReason = string(game.WinReason())
 
 	// Get current season
 	seasonID, _ := uc.seasonRepo.GetCurrentSeason()
 
+	// Load both ratings first: each player is rated against the other's pre-game rating
+	rating1, err1 := uc.playerRatingRepo.FindOrCreate(game.Player1().UserID(), seasonID, now)
+	rating2, err2 := uc.playerRatingRepo.FindOrCreate(game.Player2().UserID(), seasonID, now)
+	glicko1 := quick_duel.NewGlicko2Rating()
+	if err1 == nil {
+		glicko1 = rating1.CurrentRating(now)
+	}
+	glicko2 := quick_duel.NewGlicko2Rating()
+	if err2 == nil {
+		glicko2 = rating2.CurrentRating(now)
+	}
+	// The bot plays at the human's strength, so the rating change stays symmetric
+	switch BotUserID {
+	case game.Player2().UserID().String():
+		glicko2 = glicko1
+	case game.Player1().UserID().String():
+		glicko1 = glicko2
+	}
+
+	finished := &finishedGame{game: game}
+
 	// Update player 1 rating
-	rating1, err := uc.playerRatingRepo.FindOrCreate(game.Player1().UserID(), seasonID, now)
-	if err == nil {
-		oldMMR1 := rating1.MMR()
-		rating1.ApplyGameResult(quick_duel.GameResult{
-			Won:         player1Won,
-			OpponentMMR: game.Player2().Elo().Rating(),
-			GameTime:    now,
+	if err1 == nil {
+		change1 := rating1.ApplyGameResult(quick_duel.GameResult{
+			Won:      player1Won,
+			Draw:     isDraw,
+			Opponent: glicko2,
+			GameTime: now,
 		})
-		uc.playerRatingRepo.Save(rating1)
-		output.Player1MMRChange = rating1.MMR() - oldMMR1
+		if err := saveRating(uc.playerRatingRepo, tx, rating1); err != nil {
+			return nil, err
+		}
+		recordRatingChange(uc.ratingHistory, game, rating1, change1, now)
+		output.Player1MMRChange = change1.MMRDelta()
 		output.Player1NewMMR = rating1.MMR()
+		finished.league1 = rating1.League()
 	}
 
 	// Update player 2 rating
-	rating2, err := uc.playerRatingRepo.FindOrCreate(game.Player2().UserID(), seasonID, now)
-	if err == nil {
-		oldMMR2 := rating2.MMR()
-		rating2.ApplyGameResult(quick_duel.GameResult{
-			Won:         player2Won,
-			OpponentMMR: game.Player1().Elo().Rating(),
-			GameTime:    now,
+	if err2 == nil {
+		change2 := rating2.ApplyGameResult(quick_duel.GameResult{
+			Won:      player2Won,
+			Draw:     isDraw,
+			Opponent: glicko1,
+			GameTime: now,
 		})
-		uc.playerRatingRepo.Save(rating2)
-		output.Player2MMRChange = rating2.MMR() - oldMMR2
+		if err := saveRating(uc.playerRatingRepo, tx, rating2); err != nil {
+			return nil, err
+		}
+		recordRatingChange(uc.ratingHistory, game, rating2, change2, now)
+		output.Player2MMRChange = change2.MMRDelta()
 		output.Player2NewMMR = rating2.MMR()
+		finished.league2 = rating2.League()
 	}
 
-	// Credit win rewards via inventory
-	if uc.inventoryService != nil {
-		if winnerID != "" {
-			// Winner gets coins based on their league
-			var winnerLeague quick_duel.League
-			if game.Player1().UserID().String() == winnerID && rating1 != nil {
-				winnerLeague = rating1.League()
-			} else if rating2 != nil {
-				winnerLeague = rating2.League()
-			}
-			reward := winnerLeague.GetWinReward()
-			if reward > 0 {
-				_ = uc.inventoryService.Credit(winnerID, "pvp_win", map[string]int{"coins": reward})
-			}
-		} else {
-			// Draw — both get draw reward
-			var league1, league2 quick_duel.League
-			if rating1 != nil {
-				league1 = rating1.League()
-			}
-			if rating2 != nil {
-				league2 = rating2.League()
-			}
-			_ = uc.inventoryService.Credit(game.Player1().UserID().String(), "pvp_draw", map[string]int{"coins": league1.GetDrawReward()})
-			_ = uc.inventoryService.Credit(game.Player2().UserID().String(), "pvp_draw", map[string]int{"coins": league2.GetDrawReward()})
+	return finished, nil
+}
+
+// creditRewards pays the win reward (or both draw rewards) of a stored finished game.
+// The credits are keyed by game, so a finish that gets retried never pays twice.
+func (uc *SubmitDuelAnswerUseCase) creditRewards(finished *finishedGame) {
+	if finished == nil || uc.inventoryService == nil {
+		return
+	}
+	game := finished.game
+
+	winner := game.WinnerID()
+	if winner != nil {
+		// Winner gets coins based on their league
+		winnerLeague := finished.league2
+		if winner.Equals(game.Player1().UserID()) {
+			winnerLeague = finished.league1
+		}
+		reward := winnerLeague.GetWinReward()
+		if reward > 0 {
+			_ = uc.inventoryService.CreditOnce(winner.String(), gameRewardKey("pvp_win", game.ID()), "pvp_win", map[string]int{"coins": reward})
 		}
+		return
 	}
 
-	// Save game (domain already updated status internally)
-	uc.duelGameRepo.Save(game)
+	// Draw — both get draw reward
+	key := gameRewardKey("pvp_draw", game.ID())
+	_ = uc.inventoryService.CreditOnce(game.Player1().UserID().String(), key, "pvp_draw", map[string]int{"coins": finished.league1.GetDrawReward()})
+	_ = uc.inventoryService.CreditOnce(game.Player2().UserID().String(), key, "pvp_draw", map[string]int{"coins": finished.league2.GetDrawReward()})
+}
 
-	// Clean up cached round answers — best-effort, ignore errors
-	_ = uc.roundCache.DeleteGame(game.ID().String())
+// saveRating saves a rating within tx, or on its own when the game is not updated in a transaction
+func saveRating(repo quick_duel.PlayerRatingRepository, tx *sql.Tx, rating *quick_duel.PlayerRating) error {
+	if tx == nil {
+		return repo.Save(rating)
+	}
+	return repo.SaveInTx(tx, rating)
+}
+
+// MarkQuestionSent records when the round's question went out to the players: answers
+// are timed from then. Returns the recorded time, which stays the first one on a resend.
+func (uc *SubmitDuelAnswerUseCase) MarkQuestionSent(gameIDStr string, roundNum int, sentAtMs int64) (int64, error) {
+	unlock := gameLocks.Lock(gameIDStr)
+	defer unlock()
+
+	var sentAt int64
+	err := uc.writer().update(quick_duel.NewGameIDFromString(gameIDStr), func(_ *sql.Tx, game *quick_duel.DuelGame) error {
+		var err error
+		sentAt, err = game.MarkQuestionSent(roundNum, sentAtMs)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sentAt, nil
 }
 
 // TimeoutRound submits timeout answers for any players who have not yet answered the given round.
@@ -1720,62 +1838,105 @@ func (uc *SubmitDuelAnswerUseCase) finalizeGame(
 func (uc *SubmitDuelAnswerUseCase) TimeoutRound(gameIDStr string, roundNum int) (*SubmitDuelAnswerOutput, error) {
 	now := time.Now().UTC().Unix()
 
-	gameID := quick_duel.NewGameIDFromString(gameIDStr)
-	game, err := uc.duelGameRepo.FindByID(gameID)
-	if err != nil {
-		return nil, err
-	}
+	unlock := gameLocks.Lock(gameIDStr)
+	defer unlock()
 
-	if game.Status() != quick_duel.GameStatusInProgress {
-		return nil, nil // Game already finished — nothing to do
-	}
-	if game.CurrentRound() != roundNum {
-		return nil, nil // Round already advanced — nothing to do
-	}
+	var output *SubmitDuelAnswerOutput
+	var finished *finishedGame
+	err := uc.writer().update(quick_duel.NewGameIDFromString(gameIDStr), func(tx *sql.Tx, game *quick_duel.DuelGame) error {
+		if game.Status() != quick_duel.GameStatusInProgress {
+			return errGameUnchanged // Game already finished — nothing to do
+		}
+		if game.CurrentRound() != roundNum {
+			return errGameUnchanged // Round already advanced — nothing to do
+		}
 
-	// Record timeout for each player who hasn't answered yet
-	var lastResult *quick_duel.SubmitAnswerResult
-	for _, playerID := range []quick_duel.UserID{game.Player1().UserID(), game.Player2().UserID()} {
-		result, err := game.RecordTimeoutAnswer(playerID, now)
-		if err != nil {
-			if isErr(err, quick_duel.ErrPlayerAlreadyAnswered) {
-				continue
+		// Record timeout for each player who hasn't answered yet
+		var lastResult *quick_duel.SubmitAnswerResult
+		for _, playerID := range []quick_duel.UserID{game.Player1().UserID(), game.Player2().UserID()} {
+			result, err := game.RecordTimeoutAnswer(playerID, now)
+			if err != nil {
+				if isErr(err, quick_duel.ErrPlayerAlreadyAnswered) {
+					continue
+				}
+				return fmt.Errorf("timeout round %d player %s: %w", roundNum, playerID, err)
+			}
+			lastResult = result
+
+			// The round is complete: stop before recording into the next round
+			if result.BothAnswered {
+				break
 			}
-			return nil, fmt.Errorf("timeout round %d player %s: %w", roundNum, playerID, err)
 		}
 
-		_ = uc.roundCache.AddAnswer(gameIDStr, roundNum, PlayerAnswer{
-			PlayerID:  playerID.String(),
-			AnswerID:  "",
-			IsCorrect: false,
-			TimeTaken: quick_duel.TimePerQuestionSec * 1000,
-			Points:    0,
-		})
+		if lastResult == nil {
+			return errGameUnchanged // Both players already answered before timeout fired
+		}
 
-		lastResult = result
-	}
+		output = &SubmitDuelAnswerOutput{
+			IsCorrect:     false,
+			Player1Score:  game.Player1().Score(),
+			Player2Score:  game.Player2().Score(),
+			RoundComplete: lastResult.BothAnswered,
+			GameComplete:  lastResult.IsGameFinished,
+		}
 
-	if lastResult == nil {
-		return nil, nil // Both players already answered before timeout fired
+		if lastResult.IsGameFinished {
+			var err error
+			finished, err = uc.finalizeGame(tx, game, now, output)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	player1Score := game.Player1().Score()
-	player2Score := game.Player2().Score()
+	uc.creditRewards(finished)
+
+	return output, nil
+}
+
+// ForfeitDisconnected ends the game in favour of the opponent of a player who did
+// not come back within the reconnect grace period, then applies MMR changes.
+// Returns nil output (no error) when the game is no longer in progress.
+func (uc *SubmitDuelAnswerUseCase) ForfeitDisconnected(gameIDStr, playerIDStr string) (*SubmitDuelAnswerOutput, error) {
+	now := time.Now().UTC().Unix()
 
-	output := &SubmitDuelAnswerOutput{
-		IsCorrect:     false,
-		Player1Score:  player1Score,
-		Player2Score:  player2Score,
-		RoundComplete: lastResult.BothAnswered,
-		GameComplete:  lastResult.IsGameFinished,
+	playerID, err := shared.NewUserID(playerIDStr)
+	if err != nil {
+		return nil, err
 	}
 
-	if lastResult.IsGameFinished {
-		uc.finalizeGame(game, player1Score, player2Score, now, output)
-	} else if err := uc.duelGameRepo.Save(game); err != nil {
-		return nil, fmt.Errorf("timeout round: save game: %w", err)
+	unlock := gameLocks.Lock(gameIDStr)
+	defer unlock()
+
+	var output *SubmitDuelAnswerOutput
+	var finished *finishedGame
+	err = uc.writer().update(quick_duel.NewGameIDFromString(gameIDStr), func(tx *sql.Tx, game *quick_duel.DuelGame) error {
+		if game.Status() != quick_duel.GameStatusInProgress {
+			return errGameUnchanged // Finished while the player was away — nothing to do
+		}
+
+		if _, err := game.ForfeitDisconnected(playerID, now); err != nil {
+			return fmt.Errorf("forfeit disconnected player %s: %w", playerIDStr, err)
+		}
+
+		output = &SubmitDuelAnswerOutput{
+			Player1Score: game.Player1().Score(),
+			Player2Score: game.Player2().Score(),
+			GameComplete: true,
+		}
+		var err error
+		finished, err = uc.finalizeGame(tx, game, now, output)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	uc.creditRewards(finished)
+
 	return output, nil
 }
 
@@ -1788,6 +1949,7 @@ type GetGameResultUseCase struct {
 	playerRatingRepo quick_duel.PlayerRatingRepository
 	seasonRepo       quick_duel.SeasonRepository
 	userRepo         domainUser.UserRepository
+	ratingHistory    quick_duel.RatingHistoryRepository // optional, see WithRatingHistory
 }
 
 func NewGetGameResultUseCase(
@@ -1804,6 +1966,13 @@ func NewGetGameResultUseCase(
 	}
 }
 
+// WithRatingHistory reports the rating change recorded for the game
+// instead of comparing the current MMR with the pre-game one
+func (uc *GetGameResultUseCase) WithRatingHistory(ratingHistory quick_duel.RatingHistoryRepository) *GetGameResultUseCase {
+	uc.ratingHistory = ratingHistory
+	return uc
+}
+
 func (uc *GetGameResultUseCase) Execute(input GetGameResultInput) (*GetGameResultOutput, error) {
 	now := time.Now().UTC().Unix()
 
@@ -1829,24 +1998,16 @@ func (uc *GetGameResultUseCase) Execute(input GetGameResultInput) (*GetGameResul
 	if isPlayer1 {
 		playerScore = game.Player1().Score()
 		opponentScore = game.Player2().Score()
-		mmrBefore = game.Player1().Elo().Rating()
+		mmrBefore = game.Player1().MMR()
 		opponentPlayer = game.Player2()
 	} else {
 		playerScore = game.Player2().Score()
 		opponentScore = game.Player1().Score()
-		mmrBefore = game.Player2().Elo().Rating()
+		mmrBefore = game.Player2().MMR()
 		opponentPlayer = game.Player1()
 	}
 
-	var result string
-	switch {
-	case playerScore > opponentScore:
-		result = "win"
-	case playerScore < opponentScore:
-		result = "loss"
-	default:
-		result = "draw"
-	}
+	result := GameResultFor(game, input.PlayerID)
 
 	seasonID, _ := uc.seasonRepo.GetCurrentSeason()
 	rating, err := uc.playerRatingRepo.FindOrCreate(playerID, seasonID, now)
@@ -1855,6 +2016,20 @@ func (uc *GetGameResultUseCase) Execute(input GetGameResultInput) (*GetGameResul
 	}
 
 	mmrChange := rating.MMR() - mmrBefore
+	newMMR := rating.MMR()
+	newLeague := rating.League()
+	newDivision := rating.Division()
+	var rankChange *string
+	if change, ok := uc.recordedChange(gameID, playerID); ok {
+		mmrChange = change.MMRDelta()
+		newMMR = change.MMRAfter
+		newLeague = change.LeagueAfter
+		newDivision = change.DivisionAfter
+		if change.RankEvent == quick_duel.RankEventPromoted || change.RankEvent == quick_duel.RankEventDemoted {
+			event := string(change.RankEvent)
+			rankChange = &event
+		}
+	}
 
 	// Get opponent info
 	opponentUsername := opponentPlayer.Username()
@@ -1869,12 +2044,14 @@ func (uc *GetGameResultUseCase) Execute(input GetGameResultInput) (*GetGameResul
 	return &GetGameResultOutput{
 		GameID:           input.GameID,
 		Result:           result,
+		WinReason:        string(game.WinReason()),
 		PlayerScore:      playerScore,
 		OpponentScore:    opponentScore,
 		MMRChange:        mmrChange,
-		NewMMR:           rating.MMR(),
-		NewLeague:        rating.League().String(),
-		NewDivision:      rating.Division().Value(),
+		NewMMR:           newMMR,
+		RankChange:       rankChange,
+		NewLeague:        newLeague.String(),
+		NewDivision:      newDivision.Value(),
 		Opponent:         opponentDTO,
 		Questions:        []GameQuestionResultDTO{},
 		CanRematch:       true,
@@ -1882,6 +2059,23 @@ func (uc *GetGameResultUseCase) Execute(input GetGameResultInput) (*GetGameResul
 	}, nil
 }
 
+// recordedChange returns the player's rating change stored for the game, if any
+func (uc *GetGameResultUseCase) recordedChange(gameID quick_duel.GameID, playerID quick_duel.UserID) (quick_duel.RatingChange, bool) {
+	if uc.ratingHistory == nil {
+		return quick_duel.RatingChange{}, false
+	}
+	entries, err := uc.ratingHistory.FindByGame(gameID)
+	if err != nil {
+		return quick_duel.RatingChange{}, false
+	}
+	for _, entry := range entries {
+		if entry.PlayerID().Equals(playerID) {
+			return entry.Change(), true
+		}
+	}
+	return quick_duel.RatingChange{}, false
+}
+
 // ========================================
 // SurrenderGame Use Case
 // ========================================
@@ -1891,6 +2085,9 @@ type SurrenderGameUseCase struct {
 	playerRatingRepo quick_duel.PlayerRatingRepository
 	seasonRepo       quick_duel.SeasonRepository
 	eventBus         EventBus
+	ratingHistory    quick_duel.RatingHistoryRepository // optional, see WithRatingHistory
+	txManager        TxManager                          // optional, see WithOutbox
+	outbox           EventOutbox                        // optional, see WithOutbox
 }
 
 func NewSurrenderGameUseCase(
@@ -1907,6 +2104,19 @@ func NewSurrenderGameUseCase(
 	}
 }
 
+// WithRatingHistory records each player's rating change when a game is surrendered
+func (uc *SurrenderGameUseCase) WithRatingHistory(ratingHistory quick_duel.RatingHistoryRepository) *SurrenderGameUseCase {
+	uc.ratingHistory = ratingHistory
+	return uc
+}
+
+// WithOutbox saves surrendered games and their events in one transaction through the outbox
+func (uc *SurrenderGameUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *SurrenderGameUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 func (uc *SurrenderGameUseCase) Execute(input SurrenderGameInput) (*SurrenderGameOutput, error) {
 	now := time.Now().UTC().Unix()
 
@@ -1915,74 +2125,73 @@ func (uc *SurrenderGameUseCase) Execute(input SurrenderGameInput) (*SurrenderGam
 		return nil, err
 	}
 
-	gameID := quick_duel.NewGameIDFromString(input.GameID)
-	game, err := uc.duelGameRepo.FindByID(gameID)
-	if err != nil {
-		return nil, err
-	}
+	unlock := gameLocks.Lock(input.GameID)
+	defer unlock()
 
-	result, err := game.Surrender(playerID, now)
-	if err != nil {
-		return nil, err
-	}
+	// The game stays locked until the finished game and both ratings are stored,
+	// so a surrender racing a last answer or a timeout on another instance is rated once
+	var output *SurrenderGameOutput
+	writer := gameWriter{uc.duelGameRepo, uc.eventBus, uc.txManager, uc.outbox}
+	err = writer.update(quick_duel.NewGameIDFromString(input.GameID), func(tx *sql.Tx, game *quick_duel.DuelGame) error {
+		result, err := game.Surrender(playerID, now)
+		if err != nil {
+			return err
+		}
 
-	// Publish domain events
-	for _, event := range game.Events() {
-		uc.eventBus.Publish(event)
-	}
+		// Update ratings
+		seasonID, _ := uc.seasonRepo.GetCurrentSeason()
+		opponentID := result.WinnerID
 
-	// Save finished game
-	if err := uc.duelGameRepo.Save(game); err != nil {
-		return nil, err
-	}
+		loserRating, err := uc.playerRatingRepo.FindOrCreate(playerID, seasonID, now)
+		if err != nil {
+			return err
+		}
+		loserGlicko := loserRating.CurrentRating(now)
+		opponentRating, opponentErr := uc.playerRatingRepo.FindOrCreate(opponentID, seasonID, now)
+		opponentGlicko := quick_duel.NewGlicko2Rating()
+		if opponentErr == nil {
+			opponentGlicko = opponentRating.CurrentRating(now)
+		}
 
-	// Update ELO ratings
-	seasonID, _ := uc.seasonRepo.GetCurrentSeason()
-	opponentID := result.WinnerID
+		// Surrendering player: loss
+		loserChange := loserRating.ApplyGameResult(quick_duel.GameResult{
+			Won:      false,
+			Opponent: opponentGlicko,
+			GameTime: now,
+		})
+		if err := saveRating(uc.playerRatingRepo, tx, loserRating); err != nil {
+			return err
+		}
+		recordRatingChange(uc.ratingHistory, game, loserRating, loserChange, now)
+
+		// Winning player (opponent): win
+		if opponentErr == nil {
+			opponentChange := opponentRating.ApplyGameResult(quick_duel.GameResult{
+				Won:      true,
+				Opponent: loserGlicko,
+				GameTime: now,
+			})
+			if err := saveRating(uc.playerRatingRepo, tx, opponentRating); err != nil {
+				return err
+			}
+			recordRatingChange(uc.ratingHistory, game, opponentRating, opponentChange, now)
+		}
 
-	// Surrendering player: loss
-	loserRating, err := uc.playerRatingRepo.FindOrCreate(playerID, seasonID, now)
+		// The finished game is saved and its events published once ratings are saved:
+		// subscribers (referral progress) read them
+		output = &SurrenderGameOutput{
+			GameID:    input.GameID,
+			WinnerID:  opponentID.String(),
+			MMRChange: loserChange.MMRDelta(),
+			NewMMR:    loserRating.MMR(),
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	oldMMR := loserRating.MMR()
-	var opponentEloForLoser int
-	if game.Player1().UserID().Equals(playerID) {
-		opponentEloForLoser = game.Player2().Elo().Rating()
-	} else {
-		opponentEloForLoser = game.Player1().Elo().Rating()
-	}
-	loserRating.ApplyGameResult(quick_duel.GameResult{
-		Won:         false,
-		OpponentMMR: opponentEloForLoser,
-		GameTime:    now,
-	})
-	_ = uc.playerRatingRepo.Save(loserRating)
-	mmrChange := loserRating.MMR() - oldMMR
 
-	// Winning player (opponent): win
-	opponentRating, err := uc.playerRatingRepo.FindOrCreate(opponentID, seasonID, now)
-	if err == nil {
-		var opponentElo int
-		if game.Player1().UserID().Equals(opponentID) {
-			opponentElo = game.Player2().Elo().Rating()
-		} else {
-			opponentElo = game.Player1().Elo().Rating()
-		}
-		opponentRating.ApplyGameResult(quick_duel.GameResult{
-			Won:         true,
-			OpponentMMR: opponentElo,
-			GameTime:    now,
-		})
-		_ = uc.playerRatingRepo.Save(opponentRating)
-	}
-
-	return &SurrenderGameOutput{
-		GameID:    input.GameID,
-		WinnerID:  opponentID.String(),
-		MMRChange: mmrChange,
-		NewMMR:    loserRating.MMR(),
-	}, nil
+	return output, nil
 }
 
 // isErr reports whether err or any of its unwrapped causes equals target.
@@ -2166,13 +2375,14 @@ func (uc *RequestRematchUseCase) createRematchGame(player1ID, player2ID quick_du
 		questionIDs = append(questionIDs, qid)
 	}
 
-	p1 := quick_duel.NewDuelPlayer(player1ID, name1, quick_duel.ReconstructEloRating(rating1.MMR(), 0))
-	p2 := quick_duel.NewDuelPlayer(player2ID, name2, quick_duel.ReconstructEloRating(rating2.MMR(), 0))
+	p1 := quick_duel.NewDuelPlayer(player1ID, name1, rating1.MMR())
+	p2 := quick_duel.NewDuelPlayer(player2ID, name2, rating2.MMR())
 
 	game, err := quick_duel.NewDuelGame(p1, p2, questionIDs, now)
 	if err != nil {
 		return "", err
 	}
+	game.MarkFriendMatch()
 	if err := game.Start(now); err != nil {
 		return "", err
 	}
@@ -2190,6 +2400,7 @@ func (uc *RequestRematchUseCase) createRematchGame(player1ID, player2ID quick_du
 type GetOnlineFriendsUseCase struct {
 	onlineTracker OnlineTracker
 	userRepo      domainUser.UserRepository
+	friends       FriendsProvider
 }
 
 // OnlineTracker interface for tracking online status
@@ -2206,16 +2417,17 @@ type OnlineTracker interface {
 func NewGetOnlineFriendsUseCase(
 	onlineTracker OnlineTracker,
 	userRepo domainUser.UserRepository,
+	friends FriendsProvider,
 ) *GetOnlineFriendsUseCase {
 	return &GetOnlineFriendsUseCase{
 		onlineTracker: onlineTracker,
 		userRepo:      userRepo,
+		friends:       friends,
 	}
 }
 
 type GetOnlineFriendsInput struct {
-	PlayerID  string   `json:"playerId"`
-	FriendIDs []string `json:"friendIds"`
+	PlayerID string `json:"playerId"`
 }
 
 type GetOnlineFriendsOutput struct {
@@ -2223,12 +2435,38 @@ type GetOnlineFriendsOutput struct {
 }
 
 func (uc *GetOnlineFriendsUseCase) Execute(input GetOnlineFriendsInput) (GetOnlineFriendsOutput, error) {
-	// Get online status for all friends
-	onlineIDs, err := uc.onlineTracker.GetOnlineFriends(input.PlayerID, input.FriendIDs)
+	if _, err := shared.NewUserID(input.PlayerID); err != nil {
+		return GetOnlineFriendsOutput{}, err
+	}
+
+	friends, err := listOnlineFriends(uc.friends, uc.onlineTracker, uc.userRepo, input.PlayerID)
 	if err != nil {
 		return GetOnlineFriendsOutput{}, err
 	}
 
+	return GetOnlineFriendsOutput{
+		OnlineFriends: friends,
+	}, nil
+}
+
+// listOnlineFriends returns the player's friends who are online, flagging those in a game
+func listOnlineFriends(
+	friendsProvider FriendsProvider,
+	onlineTracker OnlineTracker,
+	userRepo domainUser.UserRepository,
+	playerID string,
+) ([]FriendDTO, error) {
+	friendIDs, err := friendsProvider.FriendIDs(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get online status for all friends
+	onlineIDs, err := onlineTracker.GetOnlineFriends(playerID, friendIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	friends := make([]FriendDTO, 0, len(onlineIDs))
 	for _, friendID := range onlineIDs {
 		uid, err := shared.NewUserID(friendID)
@@ -2236,13 +2474,13 @@ func (uc *GetOnlineFriendsUseCase) Execute(input GetOnlineFriendsInput) (GetOnli
 			continue
 		}
 
-		user, err := uc.userRepo.FindByID(uid)
+		user, err := userRepo.FindByID(uid)
 		if err != nil {
 			continue
 		}
 
 		// Check if in game
-		gameID, _ := uc.onlineTracker.GetGameID(friendID)
+		gameID, _ := onlineTracker.GetGameID(friendID)
 		inGame := gameID != ""
 
 		friends = append(friends, FriendDTO{
@@ -2253,9 +2491,7 @@ func (uc *GetOnlineFriendsUseCase) Execute(input GetOnlineFriendsInput) (GetOnli
 		})
 	}
 
-	return GetOnlineFriendsOutput{
-		OnlineFriends: friends,
-	}, nil
+	return friends, nil
 }
 
 // ========================================
@@ -2378,13 +2614,14 @@ func (uc *StartChallengeUseCase) Execute(input StartChallengeInput) (StartChalle
 		questionIDs = append(questionIDs, qid)
 	}
 
-	player1 := quick_duel.NewDuelPlayer(inviterID, inviterName, quick_duel.ReconstructEloRating(rating1.MMR(), 0))
-	player2 := quick_duel.NewDuelPlayer(accepterID, accepterName, quick_duel.ReconstructEloRating(rating2.MMR(), 0))
+	player1 := quick_duel.NewDuelPlayer(inviterID, inviterName, rating1.MMR())
+	player2 := quick_duel.NewDuelPlayer(accepterID, accepterName, rating2.MMR())
 
 	game, err := quick_duel.NewDuelGame(player1, player2, questionIDs, now)
 	if err != nil {
 		return StartChallengeOutput{}, err
 	}
+	game.MarkFriendMatch()
 	if err := game.Start(now); err != nil {
 		return StartChallengeOutput{}, err
 	}
@@ -2409,7 +2646,7 @@ func (uc *StartChallengeUseCase) Execute(input StartChallengeInput) (StartChalle
 	if inviteeID != nil {
 		if inviteeTgID, err := strconv.ParseInt(inviteeID.String(), 10, 64); err == nil && inviteeTgID > 0 {
 			lobbyURL := "https://t.me/" + uc.botUsername + "?startapp=lobby"
-			_ = uc.notifier.NotifyInviterWaiting(context.Background(), inviteeTgID, inviterName, lobbyURL)
+			_ = uc.notifier.NotifyInviterWaiting(context.Background(), inviteeTgID, playerLanguage(uc.userRepo, *inviteeID), inviterName, lobbyURL)
 		}
 	}
 
@@ -2520,8 +2757,7 @@ func (uc *PrepareShareUseCase) Execute(ctx context.Context, input PrepareShareIn
 		return PrepareShareOutput{}, fmt.Errorf("invalid player ID: %s", input.PlayerID)
 	}
 
-	shareText := "⚔️ Вызываю тебя на дуэль в Quiz Sprint!"
-	preparedID, expiresAt, err := uc.notifier.SavePreparedInlineMessage(ctx, telegramUserID, input.ChallengeLink, shareText)
+	preparedID, expiresAt, err := uc.notifier.SavePreparedInlineMessage(ctx, telegramUserID, input.Locale, input.ChallengeLink)
 	if err != nil {
 		return PrepareShareOutput{}, err
 	}
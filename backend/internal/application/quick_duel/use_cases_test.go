@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
 
 // ========================================
@@ -114,6 +115,21 @@ func TestGetDuelStatus_IncludesOutgoingChallenges(t *testing.T) {
 	}
 }
 
+func TestGetDuelStatus_ListsOnlineFriends(t *testing.T) {
+	f := setupFixture(t)
+	f.friends.friends[testPlayer1ID] = []string{testPlayer2ID, testPlayer3ID}
+	f.onlineTracker.SetOnline(testPlayer3ID, 300)
+
+	output, err := f.newGetDuelStatusUC().WithFriends(f.friends).Execute(GetDuelStatusInput{PlayerID: testPlayer1ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.FriendsOnline) != 1 || output.FriendsOnline[0].ID != testPlayer3ID {
+		t.Errorf("FriendsOnline = %+v, want only %s", output.FriendsOnline, testPlayer3ID)
+	}
+}
+
 func TestGetDuelStatus_InvalidPlayer(t *testing.T) {
 	f := setupFixture(t)
 	uc := f.newGetDuelStatusUC()
@@ -167,6 +183,10 @@ func TestJoinQueue_Success(t *testing.T) {
 	if output.Position != 1 {
 		t.Errorf("Position = %d, want 1", output.Position)
 	}
+	// A new player's rating is uncertain: the 50 MMR base range widens by RD
+	if output.MMRRange != "±350" {
+		t.Errorf("MMRRange = %s, want ±350", output.MMRRange)
+	}
 
 	// Verify player is in queue
 	inQueue, _ := f.matchmakingQueue.IsPlayerInQueue(mustUserID(testPlayer1ID))
@@ -762,13 +782,15 @@ func TestSubmitDuelAnswer_Success(t *testing.T) {
 
 func TestSubmitDuelAnswer_RoundComplete(t *testing.T) {
 	f := setupFixture(t)
+	explanation, _ := quiz.NewExplanation("Only the first option is correct.", "https://example.com/q1")
+	f.questionRepo.explanations[f.questionRepo.questions[0].ID] = explanation
 
 	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
 
 	uc := f.newSubmitDuelAnswerUC()
 
 	// Player1 answers correctly
-	_, err := uc.Execute(SubmitDuelAnswerInput{
+	first, err := uc.Execute(SubmitDuelAnswerInput{
 		PlayerID:  testPlayer1ID,
 		GameID:    gameOutput.GameID,
 		AnswerID:  f.correctAnswerID(0),
@@ -789,9 +811,15 @@ func TestSubmitDuelAnswer_RoundComplete(t *testing.T) {
 		t.Fatalf("player2 answer error: %v", err)
 	}
 
+	if first.Explanation != nil {
+		t.Error("Explanation must not be revealed before the opponent answered")
+	}
 	if !output.RoundComplete {
 		t.Error("RoundComplete should be true after both players answered")
 	}
+	if output.Explanation == nil || output.Explanation.Text != explanation.Text() || output.Explanation.SourceURL != explanation.SourceURL() {
+		t.Errorf("Explanation = %+v, want %q / %q", output.Explanation, explanation.Text(), explanation.SourceURL())
+	}
 }
 
 func TestSubmitDuelAnswer_GameNotFound(t *testing.T) {
@@ -871,6 +899,160 @@ func TestSubmitDuelAnswer_BothPlayersAnswer(t *testing.T) {
 	}
 }
 
+func TestSubmitDuelAnswer_TimeoutRoundKeepsSubmittedAnswer(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+
+	uc := f.newSubmitDuelAnswerUC()
+	if _, err := uc.Execute(SubmitDuelAnswerInput{
+		PlayerID:  testPlayer1ID,
+		GameID:    gameOutput.GameID,
+		AnswerID:  f.correctAnswerID(0),
+		TimeTaken: 2000,
+	}); err != nil {
+		t.Fatalf("player1 error: %v", err)
+	}
+
+	// Round timer fires: only player2 is missing an answer
+	output, err := uc.TimeoutRound(gameOutput.GameID, 1)
+	if err != nil {
+		t.Fatalf("TimeoutRound error: %v", err)
+	}
+	if output == nil || !output.RoundComplete {
+		t.Fatal("round 1 should be complete after the timeout")
+	}
+
+	game, _ := f.duelGameRepo.FindByID(quick_duel.NewGameIDFromString(gameOutput.GameID))
+	if game.CurrentRound() != 2 {
+		t.Fatalf("CurrentRound = %d, want 2", game.CurrentRound())
+	}
+	round1 := game.RoundAnswers()[1]
+	if len(round1) != 2 {
+		t.Fatalf("round 1 answers = %d, want 2", len(round1))
+	}
+	if len(game.RoundAnswers()[2]) != 0 {
+		t.Error("timeout must not leak into round 2")
+	}
+	if game.Player1().Score() == 0 {
+		t.Error("player1's correct answer should be kept")
+	}
+}
+
+func TestSubmitDuelAnswer_TimesAnswerFromQuestionSent(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	uc := f.newSubmitDuelAnswerUC()
+
+	sentAt, err := uc.MarkQuestionSent(gameOutput.GameID, 1, 1_700_000_000_000)
+	if err != nil {
+		t.Fatalf("MarkQuestionSent error: %v", err)
+	}
+
+	output, err := uc.Execute(SubmitDuelAnswerInput{
+		PlayerID:     testPlayer1ID,
+		GameID:       gameOutput.GameID,
+		AnswerID:     f.correctAnswerID(0),
+		TimeTaken:    quick_duel.MinAnswerTimeMs, // claims an instant answer
+		ReceivedAtMs: sentAt + 6000,
+		LatencyMs:    200,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TimeTaken != 5800 {
+		t.Errorf("TimeTaken = %d, want 5800 (server time less the latency)", output.TimeTaken)
+	}
+
+	// The question's sent time survives in the stored game
+	question, err := f.newStartGameUC().GetRoundQuestion(gameOutput.GameID, 1, quiz.DefaultLocale)
+	if err != nil {
+		t.Fatalf("GetRoundQuestion error: %v", err)
+	}
+	if question.SentAt != sentAt {
+		t.Errorf("SentAt = %d, want %d", question.SentAt, sentAt)
+	}
+}
+
+func TestSubmitDuelAnswer_ForfeitDisconnected(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+
+	uc := f.newSubmitDuelAnswerUC()
+	output, err := uc.ForfeitDisconnected(gameOutput.GameID, testPlayer2ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output == nil || !output.GameComplete {
+		t.Fatal("game should be complete after the forfeit")
+	}
+	if output.WinnerID != testPlayer1ID {
+		t.Errorf("WinnerID = %s, want %s", output.WinnerID, testPlayer1ID)
+	}
+	if output.WinReason != string(quick_duel.WinReasonDisconnect) {
+		t.Errorf("WinReason = %s, want %s", output.WinReason, quick_duel.WinReasonDisconnect)
+	}
+	if output.Player1MMRChange <= 0 || output.Player2MMRChange > 0 {
+		t.Errorf("MMR changes = %+d / %+d, want winner up and loser not up", output.Player1MMRChange, output.Player2MMRChange)
+	}
+
+	// A second grace-period timer finds the game already finished
+	again, err := uc.ForfeitDisconnected(gameOutput.GameID, testPlayer2ID)
+	if err != nil || again != nil {
+		t.Errorf("second forfeit = %v, %v; want nil, nil", again, err)
+	}
+}
+
+func TestSubmitDuelAnswer_ForfeitDisconnected_CreditsWinOncePerGame(t *testing.T) {
+	f := setupFixture(t)
+	inv := &mockInventoryService{}
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+
+	uc := NewSubmitDuelAnswerUseCase(
+		f.duelGameRepo, f.playerRatingRepo, f.questionRepo,
+		f.seasonRepo, f.eventBus, inv,
+	).WithOutbox(&mockTxManager{}, &mockEventOutbox{})
+	if _, err := uc.ForfeitDisconnected(gameOutput.GameID, testPlayer2ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inv.credits) != 1 || inv.credits[0].playerID != testPlayer1ID || inv.credits[0].source != "pvp_win" {
+		t.Fatalf("credits = %+v, want one pvp_win for %s", inv.credits, testPlayer1ID)
+	}
+
+	// Paying the same finished game again (a retried finish) credits nothing more
+	game, _ := f.duelGameRepo.FindByID(quick_duel.NewGameIDFromString(gameOutput.GameID))
+	rating, _ := f.playerRatingRepo.FindByPlayerID(mustUserID(testPlayer1ID))
+	uc.creditRewards(&finishedGame{game: game, league1: rating.League()})
+	if len(inv.credits) != 1 {
+		t.Errorf("credits = %d after paying the game again, want 1", len(inv.credits))
+	}
+}
+
+func TestSubmitDuelAnswer_Outbox_StoresGameFinishedWithGame(t *testing.T) {
+	f := setupFixture(t)
+	outbox := &mockEventOutbox{}
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+
+	uc := f.newSubmitDuelAnswerUC().WithOutbox(&mockTxManager{}, outbox)
+	if _, err := uc.ForfeitDisconnected(gameOutput.GameID, testPlayer2ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var finished int
+	for _, event := range outbox.events {
+		if _, ok := event.(quick_duel.DuelGameFinishedEvent); ok {
+			finished++
+		}
+	}
+	if finished != 1 {
+		t.Errorf("outbox has %d DuelGameFinishedEvents, want 1", finished)
+	}
+}
+
 // ========================================
 func TestSubmitDuelAnswer_WrongAnswer(t *testing.T) {
 	f := setupFixture(t)
@@ -965,8 +1147,8 @@ func TestRequestRematch_Success(t *testing.T) {
 	f := setupFixture(t)
 
 	// Create a finished game
-	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.NewEloRating())
-	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.NewEloRating())
+	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.InitialMMR)
+	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.InitialMMR)
 	now := time.Now().UTC().Unix()
 
 	qIDs := f.questionIDs()[:quick_duel.QuestionsPerDuel]
@@ -974,7 +1156,8 @@ func TestRequestRematch_Success(t *testing.T) {
 	game := quick_duel.ReconstructDuelGame(
 		quick_duel.NewGameID(), p1, p2, qIDs,
 		quick_duel.QuestionsPerDuel, quick_duel.GameStatusFinished,
-		nil, now-60, now-10,
+		nil, nil, now-60, now-10,
+		nil, "", false,
 	)
 	f.duelGameRepo.Save(game)
 
@@ -1018,8 +1201,8 @@ func TestRequestRematch_PlayerNotInGame(t *testing.T) {
 	f := setupFixture(t)
 
 	// Create a finished game between player1 and player2
-	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.NewEloRating())
-	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.NewEloRating())
+	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.InitialMMR)
+	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.InitialMMR)
 	now := time.Now().UTC().Unix()
 
 	qIDs := f.questionIDs()[:quick_duel.QuestionsPerDuel]
@@ -1027,7 +1210,8 @@ func TestRequestRematch_PlayerNotInGame(t *testing.T) {
 	game := quick_duel.ReconstructDuelGame(
 		quick_duel.NewGameID(), p1, p2, qIDs,
 		quick_duel.QuestionsPerDuel, quick_duel.GameStatusFinished,
-		nil, now-60, now-10,
+		nil, nil, now-60, now-10,
+		nil, "", false,
 	)
 	f.duelGameRepo.Save(game)
 
@@ -1042,6 +1226,162 @@ func TestRequestRematch_PlayerNotInGame(t *testing.T) {
 	}
 }
 
+// ========================================
+// GetGameReplay Tests
+// ========================================
+
+func TestGetGameReplay_Timeline(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	submitUC := f.newSubmitDuelAnswerUC()
+
+	// Player1 always right and fast, player2 always wrong
+	for round := 0; round < quick_duel.QuestionsPerDuel; round++ {
+		if _, err := submitUC.Execute(SubmitDuelAnswerInput{
+			PlayerID:  testPlayer1ID,
+			GameID:    gameOutput.GameID,
+			AnswerID:  f.correctAnswerID(round),
+			TimeTaken: 2000,
+		}); err != nil {
+			t.Fatalf("round %d player1 error: %v", round+1, err)
+		}
+		if _, err := submitUC.Execute(SubmitDuelAnswerInput{
+			PlayerID:  testPlayer2ID,
+			GameID:    gameOutput.GameID,
+			AnswerID:  f.wrongAnswerID(round),
+			TimeTaken: 4000,
+		}); err != nil {
+			t.Fatalf("round %d player2 error: %v", round+1, err)
+		}
+	}
+
+	output, err := f.newGetGameReplayUC().Execute(GetGameReplayInput{
+		PlayerID: testPlayer2ID,
+		GameID:   gameOutput.GameID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.Rounds) != quick_duel.QuestionsPerDuel {
+		t.Fatalf("rounds = %d, want %d", len(output.Rounds), quick_duel.QuestionsPerDuel)
+	}
+	if output.WinnerID == nil || *output.WinnerID != testPlayer1ID {
+		t.Errorf("WinnerID = %v, want %s", output.WinnerID, testPlayer1ID)
+	}
+	if output.WinReason != string(quick_duel.WinReasonScore) {
+		t.Errorf("WinReason = %s, want %s", output.WinReason, quick_duel.WinReasonScore)
+	}
+
+	first := output.Rounds[0]
+	if first.Player1 == nil || first.Player2 == nil {
+		t.Fatal("round 1 should have both players' answers")
+	}
+	if first.CorrectAnswerID != f.correctAnswerID(0) || first.Player1.AnswerID != first.CorrectAnswerID {
+		t.Errorf("round 1 correct answer = %s, player1 chose %s", first.CorrectAnswerID, first.Player1.AnswerID)
+	}
+	if first.Player1.SpeedBonus != quick_duel.CalculateSpeedBonus(2000) {
+		t.Errorf("SpeedBonus = %d, want %d", first.Player1.SpeedBonus, quick_duel.CalculateSpeedBonus(2000))
+	}
+	if first.Player2.IsCorrect || first.Player2.SpeedBonus != 0 {
+		t.Errorf("player2 round 1 = %+v, want wrong without bonus", *first.Player2)
+	}
+
+	last := output.Rounds[len(output.Rounds)-1]
+	if last.Player1.RunningScore != output.Player1.FinalScore {
+		t.Errorf("running score %d != final score %d", last.Player1.RunningScore, output.Player1.FinalScore)
+	}
+}
+
+func TestGetGameReplay_MultiSelectRound(t *testing.T) {
+	f := setupFixture(t)
+
+	// Round 1 is multi-select with two correct options
+	first := &f.questionRepo.questions[0]
+	first.Answers[1].IsCorrect = true
+	f.questionRepo.types[first.ID] = quiz.QuestionTypeMultiSelect
+	both := []string{first.Answers[0].ID, first.Answers[1].ID}
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	submitUC := f.newSubmitDuelAnswerUC()
+	for _, input := range []SubmitDuelAnswerInput{
+		{PlayerID: testPlayer1ID, AnswerIDs: both, TimeTaken: 2000},
+		{PlayerID: testPlayer2ID, AnswerID: both[0], TimeTaken: 2000},
+	} {
+		input.GameID = gameOutput.GameID
+		if _, err := submitUC.Execute(input); err != nil {
+			t.Fatalf("%s answer error: %v", input.PlayerID, err)
+		}
+	}
+	if _, err := submitUC.ForfeitDisconnected(gameOutput.GameID, testPlayer2ID); err != nil {
+		t.Fatalf("forfeit error: %v", err)
+	}
+
+	output, err := f.newGetGameReplayUC().Execute(GetGameReplayInput{GameID: gameOutput.GameID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	round := output.Rounds[0]
+	if round.CorrectAnswerID != "" || len(round.CorrectAnswerIDs) != 2 {
+		t.Errorf("answer key = %q / %v, want both correct options", round.CorrectAnswerID, round.CorrectAnswerIDs)
+	}
+	if len(round.Player1.AnswerIDs) != 2 || round.Player1.SpeedBonus != quick_duel.CalculateSpeedBonus(2000) {
+		t.Errorf("player1 = %+v, want both options with the full speed bonus", *round.Player1)
+	}
+
+	// Half the options: half the points, speed bonus included
+	partial := round.Player2
+	if partial.AnswerID != both[0] || partial.Points == 0 || partial.IsCorrect {
+		t.Fatalf("player2 = %+v, want partial credit for one option", *partial)
+	}
+	if partial.SpeedBonus <= 0 || partial.SpeedBonus >= round.Player1.SpeedBonus {
+		t.Errorf("partial SpeedBonus = %d, want a share of %d", partial.SpeedBonus, round.Player1.SpeedBonus)
+	}
+}
+
+func TestGetGameReplay_NotFinished(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+
+	_, err := f.newGetGameReplayUC().Execute(GetGameReplayInput{
+		PlayerID: testPlayer1ID,
+		GameID:   gameOutput.GameID,
+	})
+	if err != quick_duel.ErrGameNotFinished {
+		t.Errorf("expected ErrGameNotFinished, got %v", err)
+	}
+}
+
+func TestGetGameReplay_PlayerNotInGame(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	if _, err := f.newSubmitDuelAnswerUC().ForfeitDisconnected(gameOutput.GameID, testPlayer2ID); err != nil {
+		t.Fatalf("forfeit error: %v", err)
+	}
+
+	uc := f.newGetGameReplayUC()
+	_, err := uc.Execute(GetGameReplayInput{PlayerID: testPlayer3ID, GameID: gameOutput.GameID})
+	if err != quick_duel.ErrGameNotFound {
+		t.Errorf("expected ErrGameNotFound, got %v", err)
+	}
+
+	// Support staff (no player ID) can review any finished game
+	output, err := uc.Execute(GetGameReplayInput{GameID: gameOutput.GameID})
+	if err != nil {
+		t.Fatalf("support replay error: %v", err)
+	}
+	if output.WinReason != string(quick_duel.WinReasonDisconnect) {
+		t.Errorf("WinReason = %s, want %s", output.WinReason, quick_duel.WinReasonDisconnect)
+	}
+	if len(output.Rounds) != 1 || output.Rounds[0].Player1 != nil {
+		t.Errorf("expected a single unanswered round, got %+v", output.Rounds)
+	}
+}
+
 // ========================================
 // GetGameHistory Tests
 // ========================================
@@ -1073,8 +1413,8 @@ func TestGetGameHistory_WithGames(t *testing.T) {
 	f := setupFixture(t)
 
 	// Create a finished game
-	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.NewEloRating())
-	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.NewEloRating())
+	p1 := quick_duel.NewDuelPlayer(mustUserID(testPlayer1ID), "Player1", quick_duel.InitialMMR)
+	p2 := quick_duel.NewDuelPlayer(mustUserID(testPlayer2ID), "Player2", quick_duel.InitialMMR)
 	now := time.Now().UTC().Unix()
 
 	qIDs := f.questionIDs()[:quick_duel.QuestionsPerDuel]
@@ -1082,7 +1422,8 @@ func TestGetGameHistory_WithGames(t *testing.T) {
 	game := quick_duel.ReconstructDuelGame(
 		quick_duel.NewGameID(), p1, p2, qIDs,
 		quick_duel.QuestionsPerDuel, quick_duel.GameStatusFinished,
-		nil, now-60, now-10,
+		nil, nil, now-60, now-10,
+		nil, "", false,
 	)
 	f.duelGameRepo.Save(game)
 
@@ -1141,6 +1482,61 @@ func TestGetGameHistory_InvalidPlayer(t *testing.T) {
 	}
 }
 
+// ========================================
+// GetRatingHistory Tests
+// ========================================
+
+func TestGetRatingHistory_RecordsFinishedGame(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	finished, err := f.newSubmitDuelAnswerUC().ForfeitDisconnected(gameOutput.GameID, testPlayer2ID)
+	if err != nil || finished == nil {
+		t.Fatalf("ForfeitDisconnected = %v, %v", finished, err)
+	}
+	if len(f.ratingHistory.entries) != 2 {
+		t.Fatalf("recorded %d history entries, want one per player", len(f.ratingHistory.entries))
+	}
+
+	output, err := f.newGetRatingHistoryUC().Execute(GetRatingHistoryInput{PlayerID: testPlayer1ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SeasonID != f.seasonRepo.currentSeason {
+		t.Errorf("SeasonID = %s, want %s", output.SeasonID, f.seasonRepo.currentSeason)
+	}
+	if output.StartMMR != quick_duel.InitialMMR {
+		t.Errorf("StartMMR = %d, want %d", output.StartMMR, quick_duel.InitialMMR)
+	}
+	if len(output.Points) != 1 {
+		t.Fatalf("expected 1 point, got %d", len(output.Points))
+	}
+	point := output.Points[0]
+	if point.GameID != gameOutput.GameID || point.MMRChange != finished.Player1MMRChange || point.MMR != finished.Player1NewMMR {
+		t.Errorf("point = %+v, want game %s with %+d to %d", point, gameOutput.GameID, finished.Player1MMRChange, finished.Player1NewMMR)
+	}
+
+	history, err := f.newGetGameHistoryUC().Execute(GetGameHistoryInput{PlayerID: testPlayer2ID, Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history.Games) != 1 || history.Games[0].MMRChange != finished.Player2MMRChange {
+		t.Errorf("history = %+v, want mmrChange %+d", history.Games, finished.Player2MMRChange)
+	}
+}
+
+func TestGetRatingHistory_NoGames(t *testing.T) {
+	f := setupFixture(t)
+
+	output, err := f.newGetRatingHistoryUC().Execute(GetRatingHistoryInput{PlayerID: testPlayer1ID, SeasonID: "2025-12"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SeasonID != "2025-12" || len(output.Points) != 0 || len(output.Events) != 0 {
+		t.Errorf("output = %+v, want an empty 2025-12 history", output)
+	}
+}
+
 // ========================================
 // GetLeaderboard Tests
 // ========================================
@@ -1237,6 +1633,41 @@ func TestGetLeaderboard_Friends_Empty(t *testing.T) {
 	}
 }
 
+func TestGetLeaderboard_Friends_RanksPlayerAmongFriends(t *testing.T) {
+	f := setupFixture(t)
+
+	now := time.Now().UTC().Unix()
+	f.playerRatingRepo.FindOrCreate(mustUserID(testPlayer1ID), "2026-02", now)
+	f.playerRatingRepo.Save(quick_duel.ReconstructPlayerRating(
+		mustUserID(testPlayer2ID),
+		2000, 60, quick_duel.InitialVolatility, // Platinum
+		quick_duel.LeaguePlatinum, quick_duel.DivisionIV,
+		2000, quick_duel.LeaguePlatinum, quick_duel.DivisionIV,
+		5, 13, "2026-02", 10, 2, 1, now, now,
+	))
+	f.playerRatingRepo.FindOrCreate(mustUserID(testPlayer3ID), "2026-02", now) // not a friend
+	f.friends.friends[testPlayer1ID] = []string{testPlayer2ID}
+
+	output, err := f.newGetLeaderboardUC().Execute(GetLeaderboardInput{
+		PlayerID: testPlayer1ID,
+		Type:     "friends",
+		Limit:    10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.Entries) != 2 {
+		t.Fatalf("expected the player and 1 friend, got %d entries", len(output.Entries))
+	}
+	if output.Entries[0].PlayerID != testPlayer2ID {
+		t.Errorf("first entry = %s, want the higher-rated friend", output.Entries[0].PlayerID)
+	}
+	if output.PlayerRank != 2 {
+		t.Errorf("PlayerRank = %d, want 2", output.PlayerRank)
+	}
+}
+
 func TestGetLeaderboard_Referrals(t *testing.T) {
 	f := setupFixture(t)
 	uc := f.newGetLeaderboardUC()
@@ -1278,8 +1709,7 @@ func TestGetOnlineFriends_NoFriends(t *testing.T) {
 	uc := f.newGetOnlineFriendsUC()
 
 	output, err := uc.Execute(GetOnlineFriendsInput{
-		PlayerID:  testPlayer1ID,
-		FriendIDs: []string{},
+		PlayerID: testPlayer1ID,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -1294,12 +1724,12 @@ func TestGetOnlineFriends_SomeOnline(t *testing.T) {
 	f := setupFixture(t)
 
 	// Set player2 online, player3 offline
+	f.friends.friends[testPlayer1ID] = []string{testPlayer2ID, testPlayer3ID}
 	f.onlineTracker.SetOnline(testPlayer2ID, 300)
 
 	uc := f.newGetOnlineFriendsUC()
 	output, err := uc.Execute(GetOnlineFriendsInput{
-		PlayerID:  testPlayer1ID,
-		FriendIDs: []string{testPlayer2ID, testPlayer3ID},
+		PlayerID: testPlayer1ID,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -1320,13 +1750,13 @@ func TestGetOnlineFriends_InGame(t *testing.T) {
 	f := setupFixture(t)
 
 	// Player2 is online and in a game
+	f.friends.friends[testPlayer1ID] = []string{testPlayer2ID}
 	f.onlineTracker.SetOnline(testPlayer2ID, 300)
 	f.onlineTracker.SetInGame(testPlayer2ID, "some-game-id")
 
 	uc := f.newGetOnlineFriendsUC()
 	output, err := uc.Execute(GetOnlineFriendsInput{
-		PlayerID:  testPlayer1ID,
-		FriendIDs: []string{testPlayer2ID},
+		PlayerID: testPlayer1ID,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -1649,3 +2079,154 @@ func TestStartChallenge_FailsIfInviteeInGame(t *testing.T) {
 		t.Errorf("expected ErrAlreadyInGame, got %v", err)
 	}
 }
+
+func TestSubmitDuelAnswer_ForfeitDisconnected_PublishesGameFinished(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	f.eventBus.events = nil
+
+	if _, err := f.newSubmitDuelAnswerUC().ForfeitDisconnected(gameOutput.GameID, testPlayer2ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, event := range f.eventBus.events {
+		if _, ok := event.(quick_duel.DuelGameFinishedEvent); ok {
+			return
+		}
+	}
+	t.Error("expected DuelGameFinishedEvent to be published")
+}
+
+// ========================================
+// UpdateReferralProgress Tests
+// ========================================
+
+// playFinishedDuels plays n duels of the player against testPlayer3ID, each ended by a forfeit
+func (f *duelFixture) playFinishedDuels(t *testing.T, playerID string, n int) {
+	t.Helper()
+	uc := f.newSubmitDuelAnswerUC()
+	for i := 0; i < n; i++ {
+		game := f.startGame(t, playerID, testPlayer3ID)
+		if _, err := uc.ForfeitDisconnected(game.GameID, testPlayer3ID); err != nil {
+			t.Fatalf("forfeit duel %d: %v", i+1, err)
+		}
+	}
+}
+
+func TestUpdateReferralProgress_PlayedFiveDuels(t *testing.T) {
+	f := setupFixture(t)
+
+	referral := quick_duel.ReconstructReferral(
+		quick_duel.NewReferralID(), mustUserID(testPlayer1ID), mustUserID(testPlayer2ID),
+		true, false, false, false, false, map[string]bool{}, map[string]bool{}, 1000000,
+	)
+	f.referralRepo.Save(referral)
+
+	uc := NewUpdateReferralProgressUseCase(f.referralRepo, f.duelGameRepo, f.playerRatingRepo, f.eventBus)
+
+	f.playFinishedDuels(t, testPlayer2ID, 4)
+	if err := uc.Execute(UpdateReferralProgressInput{PlayerID: testPlayer2ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if referral.MilestonePlayedFive() {
+		t.Fatal("milestone reached after 4 duels")
+	}
+
+	f.playFinishedDuels(t, testPlayer2ID, 1)
+	f.eventBus.events = nil
+	if err := uc.Execute(UpdateReferralProgressInput{PlayerID: testPlayer2ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved, _ := f.referralRepo.FindByInvitee(mustUserID(testPlayer2ID))
+	if !saved.MilestonePlayedFive() {
+		t.Error("expected played-5 milestone after 5 duels")
+	}
+	if len(f.eventBus.events) != 1 {
+		t.Fatalf("published %d events, want 1", len(f.eventBus.events))
+	}
+	if _, ok := f.eventBus.events[0].(quick_duel.ReferralMilestoneEvent); !ok {
+		t.Errorf("published %T, want ReferralMilestoneEvent", f.eventBus.events[0])
+	}
+}
+
+func TestUpdateReferralProgress_NotInvited(t *testing.T) {
+	f := setupFixture(t)
+
+	uc := NewUpdateReferralProgressUseCase(f.referralRepo, f.duelGameRepo, f.playerRatingRepo, f.eventBus)
+	f.playFinishedDuels(t, testPlayer2ID, 1)
+	f.eventBus.events = nil
+
+	if err := uc.Execute(UpdateReferralProgressInput{PlayerID: testPlayer2ID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.Execute(UpdateReferralProgressInput{PlayerID: BotUserID}); err != nil {
+		t.Fatalf("bot: unexpected error: %v", err)
+	}
+	if len(f.eventBus.events) != 0 {
+		t.Errorf("published %d events, want 0", len(f.eventBus.events))
+	}
+}
+
+// ========================================
+// BotPlayer Tests
+// ========================================
+
+func TestBotPlayer_PlansAnswerForCurrentRound(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, BotUserID)
+	uc := f.newBotPlayerUC()
+
+	isBotGame, err := uc.IsBotGame(gameOutput.GameID)
+	if err != nil || !isBotGame {
+		t.Fatalf("IsBotGame = %v, %v; want true", isBotGame, err)
+	}
+
+	plan, err := uc.PlanAnswer(gameOutput.GameID, 1)
+	if err != nil {
+		t.Fatalf("PlanAnswer: %v", err)
+	}
+	if plan == nil {
+		t.Fatal("expected a plan for round 1")
+	}
+	if plan.PlayerID != BotUserID || plan.QuestionID != f.questionRepo.questions[0].ID {
+		t.Errorf("plan = %+v", plan)
+	}
+	if plan.TimeTaken < quick_duel.MinAnswerTimeMs || plan.TimeTaken >= quick_duel.TimePerQuestionSec*1000 {
+		t.Errorf("TimeTaken = %d, want within the answer window", plan.TimeTaken)
+	}
+
+	// The plan is a valid submission
+	if _, err := f.newSubmitDuelAnswerUC().Execute(SubmitDuelAnswerInput{
+		PlayerID:   plan.PlayerID,
+		GameID:     plan.GameID,
+		QuestionID: plan.QuestionID,
+		AnswerID:   plan.AnswerID,
+		TimeTaken:  plan.TimeTaken,
+	}); err != nil {
+		t.Errorf("submitting the bot's answer: %v", err)
+	}
+
+	// Only the round being played gets a plan
+	plan, err = uc.PlanAnswer(gameOutput.GameID, 2)
+	if err != nil || plan != nil {
+		t.Errorf("PlanAnswer(round 2) = %+v, %v; want nil", plan, err)
+	}
+}
+
+func TestBotPlayer_IgnoresGamesWithoutBot(t *testing.T) {
+	f := setupFixture(t)
+
+	gameOutput := f.startGame(t, testPlayer1ID, testPlayer2ID)
+	uc := f.newBotPlayerUC()
+
+	if isBotGame, _ := uc.IsBotGame(gameOutput.GameID); isBotGame {
+		t.Error("IsBotGame = true for a game between two players")
+	}
+	plan, err := uc.PlanAnswer(gameOutput.GameID, 1)
+	if err != nil || plan != nil {
+		t.Errorf("PlanAnswer = %+v, %v; want nil", plan, err)
+	}
+}
@@ -0,0 +1,143 @@
+package quiz
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// AdminCreateCategoryUseCase creates a category
+type AdminCreateCategoryUseCase struct {
+	categoryRepo quiz.CategoryRepository
+	auditor      contentAuditor
+}
+
+// NewAdminCreateCategoryUseCase creates a new AdminCreateCategoryUseCase
+func NewAdminCreateCategoryUseCase(categoryRepo quiz.CategoryRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminCreateCategoryUseCase {
+	return &AdminCreateCategoryUseCase{categoryRepo: categoryRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute validates and stores a new category
+func (uc *AdminCreateCategoryUseCase) Execute(input AdminCreateCategoryInput) (AdminCategoryOutput, error) {
+	category, err := buildCategory(quiz.NewCategoryID(), input.AdminCategoryInput)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	after := ToAdminCategoryDTO(category)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.categoryRepo.SaveInTx(tx, category)
+	}, quiz.ContentEntityCategory, after.ID, quiz.ContentActionCreate, input.Actor, nil, after)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	return AdminCategoryOutput{Category: after}, nil
+}
+
+// AdminUpdateCategoryUseCase replaces a category's name and translations
+type AdminUpdateCategoryUseCase struct {
+	categoryRepo quiz.CategoryRepository
+	auditor      contentAuditor
+}
+
+// NewAdminUpdateCategoryUseCase creates a new AdminUpdateCategoryUseCase
+func NewAdminUpdateCategoryUseCase(categoryRepo quiz.CategoryRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminUpdateCategoryUseCase {
+	return &AdminUpdateCategoryUseCase{categoryRepo: categoryRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute validates and stores the edit of a live category
+func (uc *AdminUpdateCategoryUseCase) Execute(input AdminUpdateCategoryInput) (AdminCategoryOutput, error) {
+	existing, err := findCategory(uc.categoryRepo, input.CategoryID)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+	if existing.IsDeleted() {
+		return AdminCategoryOutput{}, quiz.ErrCategoryDeleted
+	}
+	before := ToAdminCategoryDTO(existing)
+
+	category, err := buildCategory(existing.ID(), input.AdminCategoryInput)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	after := ToAdminCategoryDTO(category)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.categoryRepo.SaveInTx(tx, category)
+	}, quiz.ContentEntityCategory, after.ID, quiz.ContentActionUpdate, input.Actor, before, after)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	return AdminCategoryOutput{Category: after}, nil
+}
+
+// AdminDeleteCategoryUseCase soft-deletes a category. Its quizzes stay playable
+// but drop out of the category listing.
+type AdminDeleteCategoryUseCase struct {
+	categoryRepo quiz.CategoryRepository
+	auditor      contentAuditor
+}
+
+// NewAdminDeleteCategoryUseCase creates a new AdminDeleteCategoryUseCase
+func NewAdminDeleteCategoryUseCase(categoryRepo quiz.CategoryRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminDeleteCategoryUseCase {
+	return &AdminDeleteCategoryUseCase{categoryRepo: categoryRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute soft-deletes a live category
+func (uc *AdminDeleteCategoryUseCase) Execute(input AdminCategoryRefInput) (AdminCategoryOutput, error) {
+	return setCategoryDeleted(uc.categoryRepo, uc.auditor, input, quiz.ContentActionDelete)
+}
+
+// AdminRestoreCategoryUseCase restores a soft-deleted category
+type AdminRestoreCategoryUseCase struct {
+	categoryRepo quiz.CategoryRepository
+	auditor      contentAuditor
+}
+
+// NewAdminRestoreCategoryUseCase creates a new AdminRestoreCategoryUseCase
+func NewAdminRestoreCategoryUseCase(categoryRepo quiz.CategoryRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminRestoreCategoryUseCase {
+	return &AdminRestoreCategoryUseCase{categoryRepo: categoryRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute restores a deleted category
+func (uc *AdminRestoreCategoryUseCase) Execute(input AdminCategoryRefInput) (AdminCategoryOutput, error) {
+	return setCategoryDeleted(uc.categoryRepo, uc.auditor, input, quiz.ContentActionRestore)
+}
+
+// setCategoryDeleted deletes (ContentActionDelete) or restores a category and audits it
+func setCategoryDeleted(categoryRepo quiz.CategoryRepository, auditor contentAuditor, input AdminCategoryRefInput, action quiz.ContentAction) (AdminCategoryOutput, error) {
+	category, err := findCategory(categoryRepo, input.CategoryID)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	if action == quiz.ContentActionDelete {
+		err = category.Delete(time.Now().Unix())
+	} else {
+		err = category.Restore()
+	}
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	err = auditor.recordDeletion(func(tx *sql.Tx) error {
+		return categoryRepo.SaveInTx(tx, category)
+	}, quiz.ContentEntityCategory, category.ID().String(), action, input.Actor)
+	if err != nil {
+		return AdminCategoryOutput{}, err
+	}
+
+	return AdminCategoryOutput{Category: ToAdminCategoryDTO(category)}, nil
+}
+
+// findCategory parses a category ID and loads the category
+func findCategory(categoryRepo quiz.CategoryRepository, categoryID string) (*quiz.Category, error) {
+	id, err := quiz.NewCategoryIDFromString(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return categoryRepo.FindByID(id)
+}
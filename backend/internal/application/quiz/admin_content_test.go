@@ -0,0 +1,189 @@
+package quiz
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+func TestAdminUpdateQuestion_RecordsBeforeAfterDiff(t *testing.T) {
+	f := newContentFixture()
+	q := f.addQuiz(t)
+	question := f.addQuestion(t, q.ID())
+
+	input := questionInput(question)
+	input.Text = "What is Golang?"
+	_, err := NewAdminUpdateQuestionUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(AdminUpdateQuestionInput{
+		AdminQuestionInput: input,
+		QuestionID:         question.ID,
+		Actor:              "bob",
+	})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	entries := f.auditEntries(quiz.ContentEntityQuestion, question.ID)
+	if len(entries) != 2 {
+		t.Fatalf("audit entries = %d, want create + update", len(entries))
+	}
+	update := entries[1]
+	if update.Action() != quiz.ContentActionUpdate || update.Actor() != "bob" {
+		t.Errorf("entry = %s by %s, want update by bob", update.Action(), update.Actor())
+	}
+	want := map[string]quiz.FieldChange{"text": {Before: "What is Go?", After: "What is Golang?"}}
+	if len(update.Changes()) != len(want) || update.Changes()["text"] != want["text"] {
+		t.Errorf("changes = %v, want %v", update.Changes(), want)
+	}
+}
+
+func TestAdminUpdateQuestion_NoOpWritesNoAuditEntry(t *testing.T) {
+	f := newContentFixture()
+	q := f.addQuiz(t)
+	question := f.addQuestion(t, q.ID())
+
+	_, err := NewAdminUpdateQuestionUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(AdminUpdateQuestionInput{
+		AdminQuestionInput: questionInput(question),
+		QuestionID:         question.ID,
+	})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if entries := f.auditEntries(quiz.ContentEntityQuestion, question.ID); len(entries) != 1 {
+		t.Errorf("audit entries = %d, want only the create", len(entries))
+	}
+}
+
+func TestAdminUpdateQuestion_AuditFailureRollsBackEdit(t *testing.T) {
+	f := newContentFixture()
+	q := f.addQuiz(t)
+	question := f.addQuestion(t, q.ID())
+	f.auditRepo.fail = true
+
+	input := questionInput(question)
+	input.Text = "What is Golang?"
+	_, err := NewAdminUpdateQuestionUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(AdminUpdateQuestionInput{
+		AdminQuestionInput: input,
+		QuestionID:         question.ID,
+	})
+	if err != errAuditUnavailable {
+		t.Fatalf("err = %v, want %v", err, errAuditUnavailable)
+	}
+
+	stored, _ := findQuestion(f.questionRepo, question.ID)
+	if stored.Text().String() != "What is Go?" {
+		t.Errorf("text = %q, an edit without its audit entry must not be stored", stored.Text().String())
+	}
+}
+
+func TestAdminDeleteAndRestoreQuestion(t *testing.T) {
+	f := newContentFixture()
+	q := f.addQuiz(t)
+	question := f.addQuestion(t, q.ID())
+	ref := AdminQuestionRefInput{QuestionID: question.ID, Actor: "alice"}
+
+	if _, err := NewAdminDeleteQuestionUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(ref); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if live := f.questionRepo.FindByQuizID(q.ID()); len(live) != 0 {
+		t.Errorf("deleted question still among the quiz's %d questions", len(live))
+	}
+
+	if _, err := NewAdminRestoreQuestionUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(ref); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if live := f.questionRepo.FindByQuizID(q.ID()); len(live) != 1 || live[0].ID().String() != question.ID {
+		t.Errorf("restored question missing from the quiz: %d questions", len(live))
+	}
+
+	entries := f.auditEntries(quiz.ContentEntityQuestion, question.ID)
+	if len(entries) != 3 {
+		t.Fatalf("audit entries = %d, want create, delete and restore", len(entries))
+	}
+	for i, want := range []quiz.ContentAction{quiz.ContentActionDelete, quiz.ContentActionRestore} {
+		entry := entries[i+1]
+		deleted := want == quiz.ContentActionDelete
+		if entry.Action() != want || entry.Changes()["deleted"] != (quiz.FieldChange{Before: !deleted, After: deleted}) {
+			t.Errorf("entry %d = %s %v, want %s", i+1, entry.Action(), entry.Changes(), want)
+		}
+	}
+}
+
+func TestAdminDeleteAndRestoreAnswer(t *testing.T) {
+	f := newContentFixture()
+	q := f.addQuiz(t)
+	question := f.addQuestion(t, q.ID())
+	answerID := question.Answers[2].ID
+	ref := AdminAnswerRefInput{QuestionID: question.ID, AnswerID: answerID, Actor: "alice"}
+
+	hasAnswer := func() bool {
+		for _, live := range f.questionRepo.FindByQuizID(q.ID()) {
+			for _, answer := range live.Answers() {
+				if answer.ID().String() == answerID {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if _, err := NewAdminDeleteAnswerUseCase(f.questionRepo, f.auditRepo, f.txManager).Execute(ref); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if hasAnswer() {
+		t.Error("deleted answer still on the question")
+	}
+
+	restoreUC := NewAdminRestoreAnswerUseCase(f.questionRepo, f.questionRepo, f.auditRepo, f.txManager)
+	if _, err := restoreUC.Execute(ref); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if !hasAnswer() {
+		t.Error("restored answer missing from the question")
+	}
+
+	entries := f.auditEntries(quiz.ContentEntityAnswer, answerID)
+	if len(entries) != 2 || entries[0].Action() != quiz.ContentActionDelete || entries[1].Action() != quiz.ContentActionRestore {
+		t.Errorf("answer audit entries = %d, want delete then restore", len(entries))
+	}
+}
+
+func TestAdminUpdateCategory_RecordsDiffOnlyWhenChanged(t *testing.T) {
+	f := newContentFixture()
+	created, err := NewAdminCreateCategoryUseCase(f.categoryRepo, f.auditRepo, f.txManager).Execute(AdminCreateCategoryInput{
+		AdminCategoryInput: AdminCategoryInput{Name: "Programming"},
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	categoryID := created.Category.ID
+	updateUC := NewAdminUpdateCategoryUseCase(f.categoryRepo, f.auditRepo, f.txManager)
+
+	update := func(name string) {
+		t.Helper()
+		_, err := updateUC.Execute(AdminUpdateCategoryInput{
+			AdminCategoryInput: AdminCategoryInput{Name: name},
+			CategoryID:         categoryID,
+		})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+
+	update("Programming")
+	if entries := f.auditEntries(quiz.ContentEntityCategory, categoryID); len(entries) != 1 {
+		t.Fatalf("audit entries after a no-op update = %d, want only the create", len(entries))
+	}
+
+	update("Coding")
+	entries := f.auditEntries(quiz.ContentEntityCategory, categoryID)
+	if len(entries) != 2 {
+		t.Fatalf("audit entries = %d, want create + update", len(entries))
+	}
+	if entries[1].Actor() != DefaultContentActor {
+		t.Errorf("actor = %q, want %q", entries[1].Actor(), DefaultContentActor)
+	}
+	if change := entries[1].Changes()["name"]; change != (quiz.FieldChange{Before: "Programming", After: "Coding"}) {
+		t.Errorf("name change = %v", change)
+	}
+}
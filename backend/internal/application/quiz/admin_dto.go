@@ -0,0 +1,291 @@
+package quiz
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+
+// ========================================
+// Admin content DTOs
+// ========================================
+// Unlike the player DTOs these carry the answer key, raw media and every
+// translation. They double as the snapshots audit diffs are computed from.
+
+// AdminQuizDTO is the full editable state of a quiz
+type AdminQuizDTO struct {
+	ID           string                             `json:"id"`
+	Title        string                             `json:"title"`
+	Description  string                             `json:"description"`
+	CategoryID   string                             `json:"categoryId"`
+	TimeLimit    int                                `json:"timeLimit"`
+	PassingScore int                                `json:"passingScore"`
+	Tags         []string                           `json:"tags"`
+	Translations map[string]AdminQuizTranslationDTO `json:"translations"`
+	Questions    []AdminQuestionDTO                 `json:"questions,omitempty"` // live questions, by position
+	CreatedAt    int64                              `json:"createdAt"`
+	UpdatedAt    int64                              `json:"updatedAt"`
+	Deleted      bool                               `json:"deleted"`
+	DeletedAt    int64                              `json:"deletedAt,omitempty"`
+}
+
+// AdminQuizTranslationDTO is a quiz's title and description in one locale
+type AdminQuizTranslationDTO struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// AdminQuestionDTO is the full editable state of a question
+type AdminQuestionDTO struct {
+	ID            string                                 `json:"id"`
+	QuizID        string                                 `json:"quizId"`
+	Type          string                                 `json:"type"`
+	Text          string                                 `json:"text"`
+	Points        int                                    `json:"points"`
+	Position      int                                    `json:"position"`
+	Difficulty    string                                 `json:"difficulty"`
+	Explanation   string                                 `json:"explanation"`
+	SourceURL     string                                 `json:"sourceUrl"`
+	Media         *AdminMediaDTO                         `json:"media"`
+	NumericAnswer *AdminNumericAnswerDTO                 `json:"numericAnswer"`
+	Answers       []AdminAnswerDTO                       `json:"answers"` // ordering: in solution order
+	Translations  map[string]AdminQuestionTranslationDTO `json:"translations"`
+	Deleted       bool                                   `json:"deleted"`
+	DeletedAt     int64                                  `json:"deletedAt,omitempty"`
+}
+
+// AdminQuestionTranslationDTO is a question's text and explanation in one locale
+type AdminQuestionTranslationDTO struct {
+	Text        string `json:"text"`
+	Explanation string `json:"explanation"`
+}
+
+// AdminAnswerDTO is an answer option with its correctness
+type AdminAnswerDTO struct {
+	ID           string            `json:"id"`
+	Text         string            `json:"text"`
+	IsCorrect    bool              `json:"isCorrect"`
+	Position     int               `json:"position"`
+	Media        *AdminMediaDTO    `json:"media"`
+	Translations map[string]string `json:"translations"`
+}
+
+// AdminMediaDTO is media as stored: an external URL or an asset key
+type AdminMediaDTO struct {
+	URL      string `json:"url,omitempty"`
+	AssetKey string `json:"assetKey,omitempty"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Alt      string `json:"alt"`
+}
+
+// AdminNumericAnswerDTO is the answer key of a numeric question
+type AdminNumericAnswerDTO struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+// AdminCategoryDTO is the full editable state of a category
+type AdminCategoryDTO struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Translations map[string]string `json:"translations"`
+	Deleted      bool              `json:"deleted"`
+	DeletedAt    int64             `json:"deletedAt,omitempty"`
+}
+
+// AdminTagDTO is the full editable state of a tag
+type AdminTagDTO struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Deleted   bool   `json:"deleted"`
+	DeletedAt int64  `json:"deletedAt,omitempty"`
+}
+
+// ContentAuditEntryDTO is one admin edit of catalog content
+type ContentAuditEntryDTO struct {
+	ID         string                      `json:"id"`
+	EntityType string                      `json:"entityType"`
+	EntityID   string                      `json:"entityId"`
+	Action     string                      `json:"action"`
+	Actor      string                      `json:"actor"`
+	Changes    map[string]quiz.FieldChange `json:"changes"`
+	CreatedAt  int64                       `json:"createdAt"`
+}
+
+// ========================================
+// Admin Quiz Use Cases
+// ========================================
+
+// AdminQuizInput is the editable part of a quiz. An update replaces all of it,
+// except that an empty categoryId keeps the quiz's category.
+type AdminQuizInput struct {
+	Title        string                             `json:"title"`
+	Description  string                             `json:"description"`
+	CategoryID   string                             `json:"categoryId"`
+	TimeLimit    int                                `json:"timeLimit"`
+	PassingScore int                                `json:"passingScore"`
+	Tags         []string                           `json:"tags"`
+	Translations map[string]AdminQuizTranslationDTO `json:"translations"`
+}
+
+// AdminCreateQuizInput is the input DTO for AdminCreateQuiz use case
+type AdminCreateQuizInput struct {
+	AdminQuizInput
+	Actor string `json:"-"`
+}
+
+// AdminUpdateQuizInput is the input DTO for AdminUpdateQuiz use case
+type AdminUpdateQuizInput struct {
+	AdminQuizInput
+	QuizID string `json:"-"`
+	Actor  string `json:"-"`
+}
+
+// AdminQuizRefInput identifies a quiz for the get, delete and restore use cases
+type AdminQuizRefInput struct {
+	QuizID string `json:"-"`
+	Actor  string `json:"-"`
+}
+
+// AdminQuizOutput is the output DTO of the admin quiz use cases
+type AdminQuizOutput struct {
+	Quiz AdminQuizDTO `json:"quiz"`
+}
+
+// ========================================
+// Admin Question Use Cases
+// ========================================
+
+// AdminQuestionInput is the editable part of a question. Answers are the full
+// list: an answer with an existing ID is kept (history stays linked to it), one
+// without an ID is created, and existing answers left out are soft-deleted.
+type AdminQuestionInput struct {
+	Type          string                                 `json:"type"`
+	Text          string                                 `json:"text"`
+	Points        int                                    `json:"points"`
+	Position      *int                                   `json:"position,omitempty"` // nil: append (create) or keep (update)
+	Difficulty    string                                 `json:"difficulty"`
+	Explanation   string                                 `json:"explanation"`
+	SourceURL     string                                 `json:"sourceUrl"`
+	Media         *AdminMediaDTO                         `json:"media"`
+	NumericAnswer *AdminNumericAnswerDTO                 `json:"numericAnswer"`
+	Answers       []AdminAnswerInput                     `json:"answers"`
+	Translations  map[string]AdminQuestionTranslationDTO `json:"translations"`
+}
+
+// AdminAnswerInput is an answer option of AdminQuestionInput
+type AdminAnswerInput struct {
+	ID           string            `json:"id,omitempty"`
+	Text         string            `json:"text"`
+	IsCorrect    bool              `json:"isCorrect"`
+	Media        *AdminMediaDTO    `json:"media"`
+	Translations map[string]string `json:"translations"`
+}
+
+// AdminCreateQuestionInput is the input DTO for AdminCreateQuestion use case
+type AdminCreateQuestionInput struct {
+	AdminQuestionInput
+	QuizID string `json:"-"`
+	Actor  string `json:"-"`
+}
+
+// AdminUpdateQuestionInput is the input DTO for AdminUpdateQuestion use case
+type AdminUpdateQuestionInput struct {
+	AdminQuestionInput
+	QuestionID string `json:"-"`
+	Actor      string `json:"-"`
+}
+
+// AdminQuestionRefInput identifies a question for the get, delete and restore use cases
+type AdminQuestionRefInput struct {
+	QuestionID string `json:"-"`
+	Actor      string `json:"-"`
+}
+
+// AdminAnswerRefInput identifies an answer for the delete and restore use cases
+type AdminAnswerRefInput struct {
+	QuestionID string `json:"-"`
+	AnswerID   string `json:"-"`
+	Actor      string `json:"-"`
+}
+
+// AdminQuestionOutput is the output DTO of the admin question and answer use cases
+type AdminQuestionOutput struct {
+	Question AdminQuestionDTO `json:"question"`
+}
+
+// ========================================
+// Admin Category Use Cases
+// ========================================
+
+// AdminCategoryInput is the editable part of a category
+type AdminCategoryInput struct {
+	Name         string            `json:"name"`
+	Translations map[string]string `json:"translations"`
+}
+
+// AdminCreateCategoryInput is the input DTO for AdminCreateCategory use case
+type AdminCreateCategoryInput struct {
+	AdminCategoryInput
+	Actor string `json:"-"`
+}
+
+// AdminUpdateCategoryInput is the input DTO for AdminUpdateCategory use case
+type AdminUpdateCategoryInput struct {
+	AdminCategoryInput
+	CategoryID string `json:"-"`
+	Actor      string `json:"-"`
+}
+
+// AdminCategoryRefInput identifies a category for the delete and restore use cases
+type AdminCategoryRefInput struct {
+	CategoryID string `json:"-"`
+	Actor      string `json:"-"`
+}
+
+// AdminCategoryOutput is the output DTO of the admin category use cases
+type AdminCategoryOutput struct {
+	Category AdminCategoryDTO `json:"category"`
+}
+
+// ========================================
+// Admin Tag Use Cases
+// ========================================
+
+// AdminCreateTagInput is the input DTO for AdminCreateTag use case
+type AdminCreateTagInput struct {
+	Name  string `json:"name"`
+	Actor string `json:"-"`
+}
+
+// AdminRenameTagInput is the input DTO for AdminRenameTag use case
+type AdminRenameTagInput struct {
+	TagID string `json:"-"`
+	Name  string `json:"name"`
+	Actor string `json:"-"`
+}
+
+// AdminTagRefInput identifies a tag for the delete and restore use cases
+type AdminTagRefInput struct {
+	TagID string `json:"-"`
+	Actor string `json:"-"`
+}
+
+// AdminTagOutput is the output DTO of the admin tag use cases
+type AdminTagOutput struct {
+	Tag AdminTagDTO `json:"tag"`
+}
+
+// ========================================
+// ListContentAudit Use Case
+// ========================================
+
+// ListContentAuditInput is the input DTO for ListContentAudit use case
+// EntityType and EntityID narrow it to one entity (both or neither)
+type ListContentAuditInput struct {
+	EntityType string `json:"entityType,omitempty"`
+	EntityID   string `json:"entityId,omitempty"`
+	Limit      int    `json:"limit"`
+}
+
+// ListContentAuditOutput is the output DTO for ListContentAudit use case
+type ListContentAuditOutput struct {
+	Entries []ContentAuditEntryDTO `json:"entries"`
+}
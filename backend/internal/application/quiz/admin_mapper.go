@@ -0,0 +1,334 @@
+package quiz
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ========================================
+// Domain → Admin DTO Mappers
+// ========================================
+
+// ToAdminQuizDTO converts a Quiz aggregate to AdminQuizDTO, with its live questions
+func ToAdminQuizDTO(q *quiz.Quiz) AdminQuizDTO {
+	dto := toAdminQuizSnapshot(q)
+	for _, question := range q.Questions() {
+		dto.Questions = append(dto.Questions, ToAdminQuestionDTO(&question))
+	}
+	return dto
+}
+
+// toAdminQuizSnapshot is AdminQuizDTO without questions (they are audited on their own)
+func toAdminQuizSnapshot(q *quiz.Quiz) AdminQuizDTO {
+	var categoryID string
+	if !q.CategoryID().IsZero() {
+		categoryID = q.CategoryID().String()
+	}
+
+	translations := make(map[string]AdminQuizTranslationDTO, len(q.Translations()))
+	for locale, translation := range q.Translations() {
+		translations[locale] = AdminQuizTranslationDTO{
+			Title:       translation.Title().String(),
+			Description: translation.Description(),
+		}
+	}
+
+	return AdminQuizDTO{
+		ID:           q.ID().String(),
+		Title:        q.Title().String(),
+		Description:  q.Description(),
+		CategoryID:   categoryID,
+		TimeLimit:    q.TimeLimit().Seconds(),
+		PassingScore: q.PassingScore().Percentage(),
+		Tags:         q.TagNames(),
+		Translations: translations,
+		CreatedAt:    q.CreatedAt(),
+		UpdatedAt:    q.UpdatedAt(),
+		Deleted:      q.IsDeleted(),
+		DeletedAt:    q.DeletedAt(),
+	}
+}
+
+// ToAdminQuestionDTO converts a Question entity to AdminQuestionDTO (with the answer key)
+func ToAdminQuestionDTO(q *quiz.Question) AdminQuestionDTO {
+	answers := make([]AdminAnswerDTO, 0, len(q.Answers()))
+	for _, answer := range q.Answers() {
+		answers = append(answers, ToAdminAnswerDTO(&answer))
+	}
+
+	translations := make(map[string]AdminQuestionTranslationDTO, len(q.Translations()))
+	for locale, translation := range q.Translations() {
+		translations[locale] = AdminQuestionTranslationDTO{
+			Text:        translation.Text().String(),
+			Explanation: translation.Explanation(),
+		}
+	}
+
+	var numericAnswer *AdminNumericAnswerDTO
+	if !q.NumericAnswer().IsEmpty() {
+		numericAnswer = &AdminNumericAnswerDTO{
+			Value:     q.NumericAnswer().Value(),
+			Tolerance: q.NumericAnswer().Tolerance(),
+		}
+	}
+
+	var quizID string
+	if !q.QuizID().IsZero() {
+		quizID = q.QuizID().String()
+	}
+
+	return AdminQuestionDTO{
+		ID:            q.ID().String(),
+		QuizID:        quizID,
+		Type:          q.Type().String(),
+		Text:          q.Text().String(),
+		Points:        q.Points().Value(),
+		Position:      q.Position(),
+		Difficulty:    q.Difficulty().String(),
+		Explanation:   q.Explanation().Text(),
+		SourceURL:     q.Explanation().SourceURL(),
+		Media:         ToAdminMediaDTO(q.Media()),
+		NumericAnswer: numericAnswer,
+		Answers:       answers,
+		Translations:  translations,
+		Deleted:       q.IsDeleted(),
+		DeletedAt:     q.DeletedAt(),
+	}
+}
+
+// ToAdminAnswerDTO converts an Answer entity to AdminAnswerDTO (with its correctness)
+func ToAdminAnswerDTO(a *quiz.Answer) AdminAnswerDTO {
+	translations := make(map[string]string, len(a.Translations()))
+	for locale, text := range a.Translations() {
+		translations[locale] = text.String()
+	}
+
+	return AdminAnswerDTO{
+		ID:           a.ID().String(),
+		Text:         a.Text().String(),
+		IsCorrect:    a.IsCorrect(),
+		Position:     a.Position(),
+		Media:        ToAdminMediaDTO(a.Media()),
+		Translations: translations,
+	}
+}
+
+// ToAdminMediaDTO converts Media to AdminMediaDTO as stored (nil = no media)
+func ToAdminMediaDTO(m quiz.Media) *AdminMediaDTO {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &AdminMediaDTO{
+		URL:      m.URL(),
+		AssetKey: m.AssetKey(),
+		Width:    m.Width(),
+		Height:   m.Height(),
+		Alt:      m.AltText(),
+	}
+}
+
+// ToAdminCategoryDTO converts a Category aggregate to AdminCategoryDTO
+func ToAdminCategoryDTO(c *quiz.Category) AdminCategoryDTO {
+	translations := make(map[string]string, len(c.Translations()))
+	for locale, name := range c.Translations() {
+		translations[locale] = name.String()
+	}
+
+	return AdminCategoryDTO{
+		ID:           c.ID().String(),
+		Name:         c.Name().String(),
+		Translations: translations,
+		Deleted:      c.IsDeleted(),
+		DeletedAt:    c.DeletedAt(),
+	}
+}
+
+// ToAdminTagDTO converts a Tag to AdminTagDTO
+func ToAdminTagDTO(t *quiz.Tag) AdminTagDTO {
+	return AdminTagDTO{
+		ID:        t.ID().String(),
+		Name:      t.Name().String(),
+		Deleted:   t.IsDeleted(),
+		DeletedAt: t.DeletedAt(),
+	}
+}
+
+// ToContentAuditEntryDTO converts a ContentAuditEntry to ContentAuditEntryDTO
+func ToContentAuditEntryDTO(e *quiz.ContentAuditEntry) ContentAuditEntryDTO {
+	return ContentAuditEntryDTO{
+		ID:         e.ID().String(),
+		EntityType: e.EntityType().String(),
+		EntityID:   e.EntityID(),
+		Action:     e.Action().String(),
+		Actor:      e.Actor(),
+		Changes:    e.Changes(),
+		CreatedAt:  e.CreatedAt(),
+	}
+}
+
+// ========================================
+// Admin Input → Domain
+// ========================================
+
+// buildQuizTranslations validates the quiz translations of an admin input
+func buildQuizTranslations(input map[string]AdminQuizTranslationDTO) (map[string]quiz.QuizTranslation, error) {
+	translations := make(map[string]quiz.QuizTranslation, len(input))
+	for locale, t := range input {
+		translation, err := quiz.NewQuizTranslation(t.Title, t.Description)
+		if err != nil {
+			return nil, err
+		}
+		translations[locale] = translation
+	}
+	return translations, nil
+}
+
+// buildMedia validates admin media input (nil = no media)
+func buildMedia(input *AdminMediaDTO) (quiz.Media, error) {
+	if input == nil {
+		return quiz.Media{}, nil
+	}
+	return quiz.NewMedia(input.URL, input.AssetKey, input.Width, input.Height, input.Alt)
+}
+
+// buildQuestion builds a question from admin input through the domain constructors.
+// existing is the question being edited (nil on create): answer IDs in the input
+// must be among its answers.
+func buildQuestion(id quiz.QuestionID, quizID quiz.QuizID, position int, input AdminQuestionInput, existing *quiz.Question) (*quiz.Question, error) {
+	text, err := quiz.NewQuestionText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+	points, err := quiz.NewPoints(input.Points)
+	if err != nil {
+		return nil, err
+	}
+	question, err := quiz.NewQuestion(id, text, points, position)
+	if err != nil {
+		return nil, err
+	}
+	question.AssignToQuiz(quizID)
+
+	questionType, err := quiz.NewQuestionType(input.Type)
+	if err != nil {
+		return nil, err
+	}
+	question.SetType(questionType)
+
+	difficulty, err := quiz.NewDifficulty(input.Difficulty)
+	if err != nil {
+		return nil, err
+	}
+	question.SetDifficulty(difficulty)
+
+	explanation, err := quiz.NewExplanation(input.Explanation, input.SourceURL)
+	if err != nil {
+		return nil, err
+	}
+	question.SetExplanation(explanation)
+
+	media, err := buildMedia(input.Media)
+	if err != nil {
+		return nil, err
+	}
+	question.SetMedia(media)
+
+	if input.NumericAnswer != nil {
+		numericAnswer, err := quiz.NewNumericAnswer(input.NumericAnswer.Value, input.NumericAnswer.Tolerance)
+		if err != nil {
+			return nil, err
+		}
+		question.SetNumericAnswer(numericAnswer)
+	}
+
+	for locale, t := range input.Translations {
+		translation, err := quiz.NewQuestionTranslation(t.Text, t.Explanation)
+		if err != nil {
+			return nil, err
+		}
+		if err := question.SetTranslation(locale, translation); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, a := range input.Answers {
+		answer, err := buildAnswer(a, i, existing)
+		if err != nil {
+			return nil, err
+		}
+		if err := question.AddAnswer(*answer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := question.ValidateAnswers(); err != nil {
+		return nil, err
+	}
+
+	return question, nil
+}
+
+// buildAnswer builds an answer option at the given position (see buildQuestion)
+func buildAnswer(input AdminAnswerInput, position int, existing *quiz.Question) (*quiz.Answer, error) {
+	answerID := quiz.NewAnswerID()
+	if input.ID != "" {
+		id, err := quiz.NewAnswerIDFromString(input.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil || !existing.IsValidAnswer(id) {
+			return nil, quiz.ErrAnswerNotFound
+		}
+		answerID = id
+	}
+
+	text, err := quiz.NewAnswerText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+	answer, err := quiz.NewAnswer(answerID, text, input.IsCorrect, position)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := buildMedia(input.Media)
+	if err != nil {
+		return nil, err
+	}
+	answer.SetMedia(media)
+
+	for locale, value := range input.Translations {
+		translation, err := quiz.NewAnswerText(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := answer.SetTranslation(locale, translation); err != nil {
+			return nil, err
+		}
+	}
+
+	return answer, nil
+}
+
+// buildCategory builds a category from admin input through the domain constructors
+func buildCategory(id quiz.CategoryID, input AdminCategoryInput) (*quiz.Category, error) {
+	name, err := quiz.NewCategoryName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	category, err := quiz.NewCategory(id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for locale, value := range input.Translations {
+		translation, err := quiz.NewCategoryName(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := category.SetTranslation(locale, translation); err != nil {
+			return nil, err
+		}
+	}
+
+	return category, nil
+}
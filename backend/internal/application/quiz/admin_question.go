@@ -0,0 +1,321 @@
+package quiz
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// DeletedAnswerFinder finds a question's soft-deleted answers, to restore them
+type DeletedAnswerFinder interface {
+	FindDeletedAnswers(questionID quiz.QuestionID) ([]quiz.Answer, error)
+}
+
+// AdminGetQuestionUseCase loads a question for editing, including a deleted one
+type AdminGetQuestionUseCase struct {
+	questionRepo quiz.QuestionRepository
+}
+
+// NewAdminGetQuestionUseCase creates a new AdminGetQuestionUseCase
+func NewAdminGetQuestionUseCase(questionRepo quiz.QuestionRepository) *AdminGetQuestionUseCase {
+	return &AdminGetQuestionUseCase{questionRepo: questionRepo}
+}
+
+// Execute retrieves a question with its answer key
+func (uc *AdminGetQuestionUseCase) Execute(input AdminQuestionRefInput) (AdminQuestionOutput, error) {
+	question, err := findQuestion(uc.questionRepo, input.QuestionID)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: ToAdminQuestionDTO(question)}, nil
+}
+
+// AdminCreateQuestionUseCase adds a question to a live quiz
+type AdminCreateQuestionUseCase struct {
+	quizRepo     quiz.QuizRepository
+	questionRepo quiz.QuestionRepository
+	auditor      contentAuditor
+}
+
+// NewAdminCreateQuestionUseCase creates a new AdminCreateQuestionUseCase
+func NewAdminCreateQuestionUseCase(
+	quizRepo quiz.QuizRepository,
+	questionRepo quiz.QuestionRepository,
+	auditRepo quiz.ContentAuditRepository,
+	txManager TxManager,
+) *AdminCreateQuestionUseCase {
+	return &AdminCreateQuestionUseCase{
+		quizRepo:     quizRepo,
+		questionRepo: questionRepo,
+		auditor:      contentAuditor{auditRepo: auditRepo, txManager: txManager},
+	}
+}
+
+// Execute validates and stores a new question, after the quiz's last one by default
+func (uc *AdminCreateQuestionUseCase) Execute(input AdminCreateQuestionInput) (AdminQuestionOutput, error) {
+	quizID, err := quiz.NewQuizIDFromString(input.QuizID)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	quizAggregate, err := uc.quizRepo.FindByID(quizID)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+	if quizAggregate.IsDeleted() {
+		return AdminQuestionOutput{}, quiz.ErrQuizDeleted
+	}
+	if quizAggregate.QuestionsCount() >= 50 {
+		return AdminQuestionOutput{}, quiz.ErrTooManyQuestions
+	}
+
+	position := 0
+	for _, existing := range quizAggregate.Questions() {
+		if existing.Position() >= position {
+			position = existing.Position() + 1
+		}
+	}
+	if input.Position != nil {
+		position = *input.Position
+	}
+
+	question, err := buildQuestion(quiz.NewQuestionID(), quizID, position, input.AdminQuestionInput, nil)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	after := ToAdminQuestionDTO(question)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.questionRepo.SaveInTx(tx, question)
+	}, quiz.ContentEntityQuestion, after.ID, quiz.ContentActionCreate, input.Actor, nil, after)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: after}, nil
+}
+
+// AdminUpdateQuestionUseCase replaces a question's content and answers
+type AdminUpdateQuestionUseCase struct {
+	questionRepo quiz.QuestionRepository
+	auditor      contentAuditor
+}
+
+// NewAdminUpdateQuestionUseCase creates a new AdminUpdateQuestionUseCase
+func NewAdminUpdateQuestionUseCase(questionRepo quiz.QuestionRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminUpdateQuestionUseCase {
+	return &AdminUpdateQuestionUseCase{questionRepo: questionRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute validates and stores the edit of a live question. Its ID is kept, so
+// answers players gave stay linked to it.
+func (uc *AdminUpdateQuestionUseCase) Execute(input AdminUpdateQuestionInput) (AdminQuestionOutput, error) {
+	existing, err := findQuestion(uc.questionRepo, input.QuestionID)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+	if existing.IsDeleted() {
+		return AdminQuestionOutput{}, quiz.ErrQuestionDeleted
+	}
+	before := ToAdminQuestionDTO(existing)
+
+	position := existing.Position()
+	if input.Position != nil {
+		position = *input.Position
+	}
+
+	question, err := buildQuestion(existing.ID(), existing.QuizID(), position, input.AdminQuestionInput, existing)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	after := ToAdminQuestionDTO(question)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.questionRepo.SaveInTx(tx, question)
+	}, quiz.ContentEntityQuestion, after.ID, quiz.ContentActionUpdate, input.Actor, before, after)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: after}, nil
+}
+
+// AdminDeleteQuestionUseCase soft-deletes a question: new games no longer pick it
+type AdminDeleteQuestionUseCase struct {
+	questionRepo quiz.QuestionRepository
+	auditor      contentAuditor
+}
+
+// NewAdminDeleteQuestionUseCase creates a new AdminDeleteQuestionUseCase
+func NewAdminDeleteQuestionUseCase(questionRepo quiz.QuestionRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminDeleteQuestionUseCase {
+	return &AdminDeleteQuestionUseCase{questionRepo: questionRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute soft-deletes a live question
+func (uc *AdminDeleteQuestionUseCase) Execute(input AdminQuestionRefInput) (AdminQuestionOutput, error) {
+	return setQuestionDeleted(uc.questionRepo, uc.auditor, input, quiz.ContentActionDelete)
+}
+
+// AdminRestoreQuestionUseCase restores a soft-deleted question
+type AdminRestoreQuestionUseCase struct {
+	questionRepo quiz.QuestionRepository
+	auditor      contentAuditor
+}
+
+// NewAdminRestoreQuestionUseCase creates a new AdminRestoreQuestionUseCase
+func NewAdminRestoreQuestionUseCase(questionRepo quiz.QuestionRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminRestoreQuestionUseCase {
+	return &AdminRestoreQuestionUseCase{questionRepo: questionRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute restores a deleted question
+func (uc *AdminRestoreQuestionUseCase) Execute(input AdminQuestionRefInput) (AdminQuestionOutput, error) {
+	return setQuestionDeleted(uc.questionRepo, uc.auditor, input, quiz.ContentActionRestore)
+}
+
+// setQuestionDeleted deletes (ContentActionDelete) or restores a question and audits it
+func setQuestionDeleted(questionRepo quiz.QuestionRepository, auditor contentAuditor, input AdminQuestionRefInput, action quiz.ContentAction) (AdminQuestionOutput, error) {
+	question, err := findQuestion(questionRepo, input.QuestionID)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	if action == quiz.ContentActionDelete {
+		err = question.Delete(time.Now().Unix())
+	} else {
+		err = question.Restore()
+	}
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	err = auditor.recordDeletion(func(tx *sql.Tx) error {
+		return questionRepo.SaveInTx(tx, question)
+	}, quiz.ContentEntityQuestion, question.ID().String(), action, input.Actor)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: ToAdminQuestionDTO(question)}, nil
+}
+
+// AdminDeleteAnswerUseCase soft-deletes one answer option of a question
+type AdminDeleteAnswerUseCase struct {
+	questionRepo quiz.QuestionRepository
+	auditor      contentAuditor
+}
+
+// NewAdminDeleteAnswerUseCase creates a new AdminDeleteAnswerUseCase
+func NewAdminDeleteAnswerUseCase(questionRepo quiz.QuestionRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminDeleteAnswerUseCase {
+	return &AdminDeleteAnswerUseCase{questionRepo: questionRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute removes an answer; the remaining answers must still fit the question type
+func (uc *AdminDeleteAnswerUseCase) Execute(input AdminAnswerRefInput) (AdminQuestionOutput, error) {
+	question, answerID, err := findAnswerQuestion(uc.questionRepo, input)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	if err := question.RemoveAnswer(answerID); err != nil {
+		return AdminQuestionOutput{}, err
+	}
+	if err := question.ValidateAnswers(); err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	err = uc.auditor.recordDeletion(func(tx *sql.Tx) error {
+		return uc.questionRepo.SaveInTx(tx, question)
+	}, quiz.ContentEntityAnswer, answerID.String(), quiz.ContentActionDelete, input.Actor)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: ToAdminQuestionDTO(question)}, nil
+}
+
+// AdminRestoreAnswerUseCase restores a soft-deleted answer option
+type AdminRestoreAnswerUseCase struct {
+	questionRepo   quiz.QuestionRepository
+	deletedAnswers DeletedAnswerFinder
+	auditor        contentAuditor
+}
+
+// NewAdminRestoreAnswerUseCase creates a new AdminRestoreAnswerUseCase
+func NewAdminRestoreAnswerUseCase(
+	questionRepo quiz.QuestionRepository,
+	deletedAnswers DeletedAnswerFinder,
+	auditRepo quiz.ContentAuditRepository,
+	txManager TxManager,
+) *AdminRestoreAnswerUseCase {
+	return &AdminRestoreAnswerUseCase{
+		questionRepo:   questionRepo,
+		deletedAnswers: deletedAnswers,
+		auditor:        contentAuditor{auditRepo: auditRepo, txManager: txManager},
+	}
+}
+
+// Execute puts a deleted answer back; the answers must still fit the question type
+func (uc *AdminRestoreAnswerUseCase) Execute(input AdminAnswerRefInput) (AdminQuestionOutput, error) {
+	question, answerID, err := findAnswerQuestion(uc.questionRepo, input)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	deleted, err := uc.deletedAnswers.FindDeletedAnswers(question.ID())
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+	restored := false
+	for _, answer := range deleted {
+		if answer.ID().Equals(answerID) {
+			if err := question.AddAnswer(answer); err != nil {
+				return AdminQuestionOutput{}, err
+			}
+			restored = true
+			break
+		}
+	}
+	if !restored {
+		return AdminQuestionOutput{}, quiz.ErrAnswerNotFound
+	}
+	if err := question.ValidateAnswers(); err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	err = uc.auditor.recordDeletion(func(tx *sql.Tx) error {
+		return uc.questionRepo.SaveInTx(tx, question)
+	}, quiz.ContentEntityAnswer, answerID.String(), quiz.ContentActionRestore, input.Actor)
+	if err != nil {
+		return AdminQuestionOutput{}, err
+	}
+
+	return AdminQuestionOutput{Question: ToAdminQuestionDTO(question)}, nil
+}
+
+// findQuestion parses a question ID and loads the question
+func findQuestion(questionRepo quiz.QuestionRepository, questionID string) (*quiz.Question, error) {
+	id, err := quiz.NewQuestionIDFromString(questionID)
+	if err != nil {
+		return nil, err
+	}
+	return questionRepo.FindByID(id)
+}
+
+// findAnswerQuestion loads the live question an answer edit is about
+func findAnswerQuestion(questionRepo quiz.QuestionRepository, input AdminAnswerRefInput) (*quiz.Question, quiz.AnswerID, error) {
+	answerID, err := quiz.NewAnswerIDFromString(input.AnswerID)
+	if err != nil {
+		return nil, quiz.AnswerID{}, err
+	}
+
+	question, err := findQuestion(questionRepo, input.QuestionID)
+	if err != nil {
+		return nil, quiz.AnswerID{}, err
+	}
+	if question.IsDeleted() {
+		return nil, quiz.AnswerID{}, quiz.ErrQuestionDeleted
+	}
+
+	return question, answerID, nil
+}
@@ -0,0 +1,305 @@
+package quiz
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// adminQuizDeps are what the admin quiz use cases share
+type adminQuizDeps struct {
+	quizRepo     quiz.QuizRepository
+	categoryRepo quiz.CategoryRepository
+	tagRepo      quiz.TagRepository
+	auditor      contentAuditor
+}
+
+// AdminGetQuizUseCase loads a quiz for editing, including a deleted one
+type AdminGetQuizUseCase struct {
+	quizRepo quiz.QuizRepository
+}
+
+// NewAdminGetQuizUseCase creates a new AdminGetQuizUseCase
+func NewAdminGetQuizUseCase(quizRepo quiz.QuizRepository) *AdminGetQuizUseCase {
+	return &AdminGetQuizUseCase{quizRepo: quizRepo}
+}
+
+// Execute retrieves a quiz with its live questions and their answer keys
+func (uc *AdminGetQuizUseCase) Execute(input AdminQuizRefInput) (AdminQuizOutput, error) {
+	quizID, err := quiz.NewQuizIDFromString(input.QuizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	quizAggregate, err := uc.quizRepo.FindByID(quizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	return AdminQuizOutput{Quiz: ToAdminQuizDTO(quizAggregate)}, nil
+}
+
+// AdminCreateQuizUseCase creates a quiz (questions are added separately)
+type AdminCreateQuizUseCase struct {
+	adminQuizDeps
+}
+
+// NewAdminCreateQuizUseCase creates a new AdminCreateQuizUseCase
+func NewAdminCreateQuizUseCase(
+	quizRepo quiz.QuizRepository,
+	categoryRepo quiz.CategoryRepository,
+	tagRepo quiz.TagRepository,
+	auditRepo quiz.ContentAuditRepository,
+	txManager TxManager,
+) *AdminCreateQuizUseCase {
+	return &AdminCreateQuizUseCase{adminQuizDeps{
+		quizRepo:     quizRepo,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		auditor:      contentAuditor{auditRepo: auditRepo, txManager: txManager},
+	}}
+}
+
+// Execute validates and stores a new quiz
+func (uc *AdminCreateQuizUseCase) Execute(input AdminCreateQuizInput) (AdminQuizOutput, error) {
+	details, err := uc.buildDetails(input.AdminQuizInput, quiz.CategoryID{})
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	quizAggregate, err := quiz.NewQuiz(
+		quiz.NewQuizID(),
+		details.title,
+		input.Description,
+		details.categoryID,
+		details.timeLimit,
+		details.passingScore,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+	if err := details.applyTo(quizAggregate); err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	after := toAdminQuizSnapshot(quizAggregate)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.quizRepo.SaveInTx(tx, quizAggregate)
+	}, quiz.ContentEntityQuiz, after.ID, quiz.ContentActionCreate, input.Actor, nil, after)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	return AdminQuizOutput{Quiz: ToAdminQuizDTO(quizAggregate)}, nil
+}
+
+// AdminUpdateQuizUseCase replaces a quiz's details, tags and translations
+type AdminUpdateQuizUseCase struct {
+	adminQuizDeps
+}
+
+// NewAdminUpdateQuizUseCase creates a new AdminUpdateQuizUseCase
+func NewAdminUpdateQuizUseCase(
+	quizRepo quiz.QuizRepository,
+	categoryRepo quiz.CategoryRepository,
+	tagRepo quiz.TagRepository,
+	auditRepo quiz.ContentAuditRepository,
+	txManager TxManager,
+) *AdminUpdateQuizUseCase {
+	return &AdminUpdateQuizUseCase{adminQuizDeps{
+		quizRepo:     quizRepo,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		auditor:      contentAuditor{auditRepo: auditRepo, txManager: txManager},
+	}}
+}
+
+// Execute validates and stores the edit of a live quiz
+func (uc *AdminUpdateQuizUseCase) Execute(input AdminUpdateQuizInput) (AdminQuizOutput, error) {
+	quizID, err := quiz.NewQuizIDFromString(input.QuizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	quizAggregate, err := uc.quizRepo.FindByID(quizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+	if quizAggregate.IsDeleted() {
+		return AdminQuizOutput{}, quiz.ErrQuizDeleted
+	}
+	before := toAdminQuizSnapshot(quizAggregate)
+
+	details, err := uc.buildDetails(input.AdminQuizInput, quizAggregate.CategoryID())
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+	err = quizAggregate.UpdateDetails(
+		details.title,
+		input.Description,
+		details.categoryID,
+		details.timeLimit,
+		details.passingScore,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+	if err := details.applyTo(quizAggregate); err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	after := toAdminQuizSnapshot(quizAggregate)
+	// updatedAt always changes; it isn't an edit of its own
+	after.UpdatedAt = before.UpdatedAt
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.quizRepo.SaveInTx(tx, quizAggregate)
+	}, quiz.ContentEntityQuiz, after.ID, quiz.ContentActionUpdate, input.Actor, before, after)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	return AdminQuizOutput{Quiz: ToAdminQuizDTO(quizAggregate)}, nil
+}
+
+// AdminDeleteQuizUseCase soft-deletes a quiz: players no longer see or start it,
+// while sessions and games that played it keep their history
+type AdminDeleteQuizUseCase struct {
+	quizRepo quiz.QuizRepository
+	auditor  contentAuditor
+}
+
+// NewAdminDeleteQuizUseCase creates a new AdminDeleteQuizUseCase
+func NewAdminDeleteQuizUseCase(quizRepo quiz.QuizRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminDeleteQuizUseCase {
+	return &AdminDeleteQuizUseCase{quizRepo: quizRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute soft-deletes a live quiz
+func (uc *AdminDeleteQuizUseCase) Execute(input AdminQuizRefInput) (AdminQuizOutput, error) {
+	return setQuizDeleted(uc.quizRepo, uc.auditor, input, quiz.ContentActionDelete)
+}
+
+// AdminRestoreQuizUseCase restores a soft-deleted quiz
+type AdminRestoreQuizUseCase struct {
+	quizRepo quiz.QuizRepository
+	auditor  contentAuditor
+}
+
+// NewAdminRestoreQuizUseCase creates a new AdminRestoreQuizUseCase
+func NewAdminRestoreQuizUseCase(quizRepo quiz.QuizRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminRestoreQuizUseCase {
+	return &AdminRestoreQuizUseCase{quizRepo: quizRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute restores a deleted quiz
+func (uc *AdminRestoreQuizUseCase) Execute(input AdminQuizRefInput) (AdminQuizOutput, error) {
+	return setQuizDeleted(uc.quizRepo, uc.auditor, input, quiz.ContentActionRestore)
+}
+
+// setQuizDeleted deletes (ContentActionDelete) or restores a quiz and audits it
+func setQuizDeleted(quizRepo quiz.QuizRepository, auditor contentAuditor, input AdminQuizRefInput, action quiz.ContentAction) (AdminQuizOutput, error) {
+	quizID, err := quiz.NewQuizIDFromString(input.QuizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	quizAggregate, err := quizRepo.FindByID(quizID)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	if action == quiz.ContentActionDelete {
+		err = quizAggregate.Delete(time.Now().Unix())
+	} else {
+		err = quizAggregate.Restore()
+	}
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	err = auditor.recordDeletion(func(tx *sql.Tx) error {
+		return quizRepo.SaveInTx(tx, quizAggregate)
+	}, quiz.ContentEntityQuiz, quizID.String(), action, input.Actor)
+	if err != nil {
+		return AdminQuizOutput{}, err
+	}
+
+	return AdminQuizOutput{Quiz: ToAdminQuizDTO(quizAggregate)}, nil
+}
+
+// quizDetails is AdminQuizInput validated through the domain constructors
+type quizDetails struct {
+	title        quiz.QuizTitle
+	categoryID   quiz.CategoryID
+	timeLimit    quiz.TimeLimit
+	passingScore quiz.PassingScore
+	tags         []quiz.Tag
+	translations map[string]quiz.QuizTranslation
+}
+
+// applyTo replaces a quiz's tags and translations
+func (d quizDetails) applyTo(q *quiz.Quiz) error {
+	if err := q.ReplaceTags(d.tags); err != nil {
+		return err
+	}
+	return q.ReplaceTranslations(d.translations)
+}
+
+// buildDetails validates quiz input. An empty category keeps the current one, and
+// a new category must exist and be live. Tags are reused by name (a deleted one
+// is refused) or created.
+func (d adminQuizDeps) buildDetails(input AdminQuizInput, currentCategoryID quiz.CategoryID) (quizDetails, error) {
+	var details quizDetails
+	var err error
+
+	if details.title, err = quiz.NewQuizTitle(input.Title); err != nil {
+		return quizDetails{}, err
+	}
+	if details.timeLimit, err = quiz.NewTimeLimit(input.TimeLimit); err != nil {
+		return quizDetails{}, err
+	}
+	if details.passingScore, err = quiz.NewPassingScore(input.PassingScore); err != nil {
+		return quizDetails{}, err
+	}
+
+	details.categoryID = currentCategoryID
+	if input.CategoryID != "" {
+		if details.categoryID, err = quiz.NewCategoryIDFromString(input.CategoryID); err != nil {
+			return quizDetails{}, err
+		}
+	}
+	if !details.categoryID.IsZero() && !details.categoryID.Equals(currentCategoryID) {
+		category, err := d.categoryRepo.FindByID(details.categoryID)
+		if err != nil {
+			return quizDetails{}, err
+		}
+		if category.IsDeleted() {
+			return quizDetails{}, quiz.ErrCategoryDeleted
+		}
+	}
+
+	for _, name := range input.Tags {
+		tag, err := quiz.NewTag(name)
+		if err != nil {
+			return quizDetails{}, err
+		}
+		existing, err := d.tagRepo.FindByName(name)
+		switch {
+		case err == quiz.ErrTagNotFound:
+		case err != nil:
+			return quizDetails{}, err
+		case existing.IsDeleted():
+			return quizDetails{}, quiz.ErrTagDeleted
+		default:
+			tag = existing
+		}
+		details.tags = append(details.tags, *tag)
+	}
+
+	if details.translations, err = buildQuizTranslations(input.Translations); err != nil {
+		return quizDetails{}, err
+	}
+
+	return details, nil
+}
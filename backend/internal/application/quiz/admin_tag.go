@@ -0,0 +1,174 @@
+package quiz
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// AdminCreateTagUseCase creates a tag
+type AdminCreateTagUseCase struct {
+	tagRepo quiz.TagRepository
+	auditor contentAuditor
+}
+
+// NewAdminCreateTagUseCase creates a new AdminCreateTagUseCase
+func NewAdminCreateTagUseCase(tagRepo quiz.TagRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminCreateTagUseCase {
+	return &AdminCreateTagUseCase{tagRepo: tagRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute validates and stores a new tag. The name must be free, and so must
+// the ID derived from it (a renamed tag keeps its original ID).
+func (uc *AdminCreateTagUseCase) Execute(input AdminCreateTagInput) (AdminTagOutput, error) {
+	tag, err := quiz.NewTag(input.Name)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	if err := ensureTagNameFree(uc.tagRepo, input.Name, quiz.TagID{}); err != nil {
+		return AdminTagOutput{}, err
+	}
+	switch _, err := uc.tagRepo.FindByID(tag.ID()); err {
+	case quiz.ErrTagNotFound:
+	case nil:
+		return AdminTagOutput{}, quiz.ErrTagAlreadyExists
+	default:
+		return AdminTagOutput{}, err
+	}
+
+	after := ToAdminTagDTO(tag)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.tagRepo.SaveInTx(tx, tag)
+	}, quiz.ContentEntityTag, after.ID, quiz.ContentActionCreate, input.Actor, nil, after)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	return AdminTagOutput{Tag: after}, nil
+}
+
+// AdminRenameTagUseCase renames a tag on every quiz that has it
+type AdminRenameTagUseCase struct {
+	tagRepo quiz.TagRepository
+	auditor contentAuditor
+}
+
+// NewAdminRenameTagUseCase creates a new AdminRenameTagUseCase
+func NewAdminRenameTagUseCase(tagRepo quiz.TagRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminRenameTagUseCase {
+	return &AdminRenameTagUseCase{tagRepo: tagRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute renames a live tag to a free name
+func (uc *AdminRenameTagUseCase) Execute(input AdminRenameTagInput) (AdminTagOutput, error) {
+	tag, err := findTag(uc.tagRepo, input.TagID)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+	if tag.IsDeleted() {
+		return AdminTagOutput{}, quiz.ErrTagDeleted
+	}
+	before := ToAdminTagDTO(tag)
+
+	name, err := quiz.NewTagName(input.Name)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+	if err := ensureTagNameFree(uc.tagRepo, input.Name, tag.ID()); err != nil {
+		return AdminTagOutput{}, err
+	}
+	tag.Rename(name)
+
+	after := ToAdminTagDTO(tag)
+	err = uc.auditor.record(func(tx *sql.Tx) error {
+		return uc.tagRepo.UpdateInTx(tx, tag)
+	}, quiz.ContentEntityTag, after.ID, quiz.ContentActionUpdate, input.Actor, before, after)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	return AdminTagOutput{Tag: after}, nil
+}
+
+// AdminDeleteTagUseCase soft-deletes a tag: quizzes stop showing it
+type AdminDeleteTagUseCase struct {
+	tagRepo quiz.TagRepository
+	auditor contentAuditor
+}
+
+// NewAdminDeleteTagUseCase creates a new AdminDeleteTagUseCase
+func NewAdminDeleteTagUseCase(tagRepo quiz.TagRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminDeleteTagUseCase {
+	return &AdminDeleteTagUseCase{tagRepo: tagRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute soft-deletes a live tag
+func (uc *AdminDeleteTagUseCase) Execute(input AdminTagRefInput) (AdminTagOutput, error) {
+	return setTagDeleted(uc.tagRepo, uc.auditor, input, quiz.ContentActionDelete)
+}
+
+// AdminRestoreTagUseCase restores a soft-deleted tag, back on the quizzes that had it
+type AdminRestoreTagUseCase struct {
+	tagRepo quiz.TagRepository
+	auditor contentAuditor
+}
+
+// NewAdminRestoreTagUseCase creates a new AdminRestoreTagUseCase
+func NewAdminRestoreTagUseCase(tagRepo quiz.TagRepository, auditRepo quiz.ContentAuditRepository, txManager TxManager) *AdminRestoreTagUseCase {
+	return &AdminRestoreTagUseCase{tagRepo: tagRepo, auditor: contentAuditor{auditRepo: auditRepo, txManager: txManager}}
+}
+
+// Execute restores a deleted tag
+func (uc *AdminRestoreTagUseCase) Execute(input AdminTagRefInput) (AdminTagOutput, error) {
+	return setTagDeleted(uc.tagRepo, uc.auditor, input, quiz.ContentActionRestore)
+}
+
+// setTagDeleted deletes (ContentActionDelete) or restores a tag and audits it
+func setTagDeleted(tagRepo quiz.TagRepository, auditor contentAuditor, input AdminTagRefInput, action quiz.ContentAction) (AdminTagOutput, error) {
+	tag, err := findTag(tagRepo, input.TagID)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	if action == quiz.ContentActionDelete {
+		err = tag.Delete(time.Now().Unix())
+	} else {
+		err = tag.Restore()
+	}
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	err = auditor.recordDeletion(func(tx *sql.Tx) error {
+		return tagRepo.UpdateInTx(tx, tag)
+	}, quiz.ContentEntityTag, tag.ID().String(), action, input.Actor)
+	if err != nil {
+		return AdminTagOutput{}, err
+	}
+
+	return AdminTagOutput{Tag: ToAdminTagDTO(tag)}, nil
+}
+
+// findTag wraps a tag ID and loads the tag
+func findTag(tagRepo quiz.TagRepository, tagID string) (*quiz.Tag, error) {
+	id, err := quiz.NewTagIDFromString(tagID)
+	if err != nil {
+		return nil, err
+	}
+	return tagRepo.FindByID(id)
+}
+
+// ensureTagNameFree fails with ErrTagAlreadyExists when a tag other than self
+// (live or deleted) has the name
+func ensureTagNameFree(tagRepo quiz.TagRepository, name string, self quiz.TagID) error {
+	existing, err := tagRepo.FindByName(name)
+	switch {
+	case err == quiz.ErrTagNotFound:
+		return nil
+	case err != nil:
+		return err
+	case existing.ID() == self:
+		return nil
+	default:
+		return quiz.ErrTagAlreadyExists
+	}
+}
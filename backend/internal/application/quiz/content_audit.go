@@ -0,0 +1,164 @@
+package quiz
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// DefaultContentActor is recorded when an admin edit doesn't say who made it
+const DefaultContentActor = "admin"
+
+// TxManager provides database transaction support
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// contentAuditor writes admin content edits together with their audit trail
+type contentAuditor struct {
+	auditRepo quiz.ContentAuditRepository
+	txManager TxManager
+}
+
+// record runs save and appends an audit entry with the fields that differ
+// between two admin DTO snapshots (before is nil on create) in one transaction,
+// so neither is stored without the other. An update that changed nothing is
+// saved but not recorded.
+func (a contentAuditor) record(
+	save func(tx *sql.Tx) error,
+	entityType quiz.ContentEntityType,
+	entityID string,
+	action quiz.ContentAction,
+	actor string,
+	before, after interface{},
+) error {
+	beforeFields, err := snapshotFields(before)
+	if err != nil {
+		return err
+	}
+	afterFields, err := snapshotFields(after)
+	if err != nil {
+		return err
+	}
+
+	changes := quiz.DiffFields(beforeFields, afterFields)
+	if action == quiz.ContentActionUpdate && len(changes) == 0 {
+		return a.commit(save, nil)
+	}
+
+	entry, err := newAuditEntry(entityType, entityID, action, actor, changes)
+	if err != nil {
+		return err
+	}
+	return a.commit(save, entry)
+}
+
+// recordDeletion runs save and appends a delete or restore entry in one transaction
+func (a contentAuditor) recordDeletion(
+	save func(tx *sql.Tx) error,
+	entityType quiz.ContentEntityType,
+	entityID string,
+	action quiz.ContentAction,
+	actor string,
+) error {
+	deleted := action == quiz.ContentActionDelete
+	changes := map[string]quiz.FieldChange{
+		"deleted": {Before: !deleted, After: deleted},
+	}
+
+	entry, err := newAuditEntry(entityType, entityID, action, actor, changes)
+	if err != nil {
+		return err
+	}
+	return a.commit(save, entry)
+}
+
+// commit runs save and appends entry (nil = nothing to record) in one transaction
+func (a contentAuditor) commit(save func(tx *sql.Tx) error, entry *quiz.ContentAuditEntry) error {
+	return a.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		if err := save(tx); err != nil {
+			return err
+		}
+		if entry == nil {
+			return nil
+		}
+		return a.auditRepo.AppendInTx(tx, entry)
+	})
+}
+
+// newAuditEntry builds an audit entry stamped now, by DefaultContentActor when
+// the edit doesn't say who made it
+func newAuditEntry(
+	entityType quiz.ContentEntityType,
+	entityID string,
+	action quiz.ContentAction,
+	actor string,
+	changes map[string]quiz.FieldChange,
+) (*quiz.ContentAuditEntry, error) {
+	if strings.TrimSpace(actor) == "" {
+		actor = DefaultContentActor
+	}
+	return quiz.NewContentAuditEntry(entityType, entityID, action, actor, changes, time.Now().Unix())
+}
+
+// snapshotFields turns a DTO snapshot into its JSON fields (nil = no snapshot)
+func snapshotFields(snapshot interface{}) (map[string]interface{}, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ListContentAuditUseCase lists the audit trail of admin content edits
+type ListContentAuditUseCase struct {
+	auditRepo quiz.ContentAuditRepository
+}
+
+// NewListContentAuditUseCase creates a new ListContentAuditUseCase
+func NewListContentAuditUseCase(auditRepo quiz.ContentAuditRepository) *ListContentAuditUseCase {
+	return &ListContentAuditUseCase{auditRepo: auditRepo}
+}
+
+// Execute lists audit entries, newest first: one entity's, or the latest overall
+func (uc *ListContentAuditUseCase) Execute(input ListContentAuditInput) (ListContentAuditOutput, error) {
+	limit := input.Limit
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	var (
+		entries []*quiz.ContentAuditEntry
+		err     error
+	)
+	if input.EntityType != "" || input.EntityID != "" {
+		entityType := quiz.ContentEntityType(input.EntityType)
+		if !entityType.IsValid() || input.EntityID == "" {
+			return ListContentAuditOutput{}, quiz.ErrInvalidAuditEntry
+		}
+		entries, err = uc.auditRepo.FindByEntity(entityType, input.EntityID, limit)
+	} else {
+		entries, err = uc.auditRepo.FindRecent(limit)
+	}
+	if err != nil {
+		return ListContentAuditOutput{}, err
+	}
+
+	dtos := make([]ContentAuditEntryDTO, 0, len(entries))
+	for _, entry := range entries {
+		dtos = append(dtos, ToContentAuditEntryDTO(entry))
+	}
+
+	return ListContentAuditOutput{Entries: dtos}, nil
+}
@@ -31,8 +31,10 @@ type QuizDetailDTO struct {
 // QuestionDTO is a data transfer object for Question
 type QuestionDTO struct {
 	ID       string      `json:"id"`
+	Type     string      `json:"type"` // single_choice, true_false, multi_select, ordering, numeric
 	Text     string      `json:"text"`
-	Answers  []AnswerDTO `json:"answers"`
+	Media    *MediaDTO   `json:"media,omitempty"`
+	Answers  []AnswerDTO `json:"answers"` // none for numeric; ordering options are shuffled
 	Points   int         `json:"points"`
 	Position int         `json:"position"`
 }
@@ -40,9 +42,19 @@ type QuestionDTO struct {
 // AnswerDTO is a data transfer object for Answer
 // NOTE: IsCorrect is NOT included - never leak correct answers to client!
 type AnswerDTO struct {
-	ID       string `json:"id"`
-	Text     string `json:"text"`
-	Position int    `json:"position"`
+	ID       string    `json:"id"`
+	Text     string    `json:"text"`
+	Media    *MediaDTO `json:"media,omitempty"`
+	Position int       `json:"position"`
+}
+
+// MediaDTO is an image attached to a question or answer, shared by every game mode.
+// URL is always fetchable: stored assets are resolved to the API's media route.
+type MediaDTO struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Alt    string `json:"alt"`
 }
 
 // SessionDTO is a data transfer object for QuizSession
@@ -80,6 +92,7 @@ type CategoryDTO struct {
 type StartQuizInput struct {
 	QuizID string `json:"quizId"`
 	UserID string `json:"userId"`
+	Locale string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // StartQuizOutput is the output DTO for StartQuiz use case
@@ -102,6 +115,10 @@ type SubmitAnswerInput struct {
 	AnswerID   string `json:"answerId"`
 	UserID     string `json:"userId"`
 	TimeTaken  int64  `json:"timeTaken"` // Time taken to answer in milliseconds
+	Locale     string `json:"-"`         // caller's content language, see quiz.DefaultLocale
+	// Multi-select (picked options) and ordering (every option, in order) send AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
 }
 
 // SubmitAnswerOutput is the output DTO for SubmitAnswer use case
@@ -117,6 +134,9 @@ type SubmitAnswerOutput struct {
 	IsQuizCompleted bool            `json:"isQuizCompleted"` // Whether quiz is completed
 	NextQuestion    *QuestionDTO    `json:"nextQuestion,omitempty"`
 	FinalResult     *FinalResultDTO `json:"finalResult,omitempty"`
+	// Answer key of the other question types (CorrectAnswerID is empty for them)
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"` // multi-select, ordering (solution order)
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`    // numeric
 }
 
 // FinalResultDTO contains the final quiz result
@@ -166,6 +186,7 @@ type CreateCategoryOutput struct {
 // GetQuizInput is the input DTO for GetQuiz use case
 type GetQuizInput struct {
 	QuizID string `json:"quizId"`
+	Locale string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // GetQuizOutput is the output DTO for GetQuiz use case
@@ -180,6 +201,7 @@ type GetQuizOutput struct {
 // ListQuizzesInput is the input DTO for ListQuizzes use case
 type ListQuizzesInput struct {
 	CategoryID string `json:"categoryId,omitempty"`
+	Locale     string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // ListQuizzesOutput is the output DTO for ListQuizzes use case
@@ -194,6 +216,7 @@ type ListQuizzesOutput struct {
 // GetQuizDetailsInput is the input DTO for GetQuizDetails use case
 type GetQuizDetailsInput struct {
 	QuizID string `json:"quizId"`
+	Locale string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // GetQuizDetailsOutput is the output DTO for GetQuizDetails use case
@@ -230,6 +253,7 @@ type GlobalLeaderboardEntryDTO struct {
 // GetRandomQuizInput is the input DTO for GetRandomQuiz use case
 type GetRandomQuizInput struct {
 	CategoryID string `json:"categoryId,omitempty"` // Optional category filter
+	Locale     string `json:"-"`                    // caller's content language, see quiz.DefaultLocale
 }
 
 // SessionSummaryDTO is a data transfer object for active session summary
@@ -246,6 +270,7 @@ type SessionSummaryDTO struct {
 // GetUserActiveSessionsInput is the input DTO for GetUserActiveSessions use case
 type GetUserActiveSessionsInput struct {
 	UserID string `json:"userId"`
+	Locale string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // GetUserActiveSessionsOutput is the output DTO for GetUserActiveSessions use case
@@ -260,6 +285,7 @@ type GetUserActiveSessionsOutput struct {
 // GetDailyQuizInput is the input DTO for GetDailyQuiz use case
 type GetDailyQuizInput struct {
 	UserID string `json:"userId"`
+	Locale string `json:"-"` // caller's content language, see quiz.DefaultLocale
 }
 
 // GetDailyQuizOutput is the output DTO for GetDailyQuiz use case
@@ -0,0 +1,49 @@
+package quiz
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// EventOutbox stores session events in the transaction that saves the session.
+// An outbox relay delivers them to durable subscribers after commit (at-least-once),
+// so a crash right after the save can no longer lose a QuizCompletedEvent.
+// Implementation is in infrastructure layer
+type EventOutbox interface {
+	AppendInTx(tx *sql.Tx, events ...quiz.Event) error
+}
+
+// sessionWriter saves a quiz session together with its pending events.
+// Without an outbox the session is saved and the events go straight to the event bus.
+type sessionWriter struct {
+	sessionRepo quiz.SessionRepository
+	eventBus    quiz.EventBus // may be nil
+	txManager   TxManager     // optional, set with the use case's WithOutbox
+	outbox      EventOutbox   // optional, set with the use case's WithOutbox
+}
+
+func (w sessionWriter) save(session *quiz.QuizSession) error {
+	events := session.Events()
+
+	if w.txManager != nil && w.outbox != nil {
+		err := w.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+			if err := w.sessionRepo.SaveInTx(tx, session); err != nil {
+				return err
+			}
+			return w.outbox.AppendInTx(tx, events...)
+		})
+		if err != nil {
+			return err
+		}
+	} else if err := w.sessionRepo.Save(session); err != nil {
+		return err
+	}
+
+	// In-process listeners (logging) get the events once they are stored
+	if w.eventBus != nil {
+		w.eventBus.Publish(events...)
+	}
+	return nil
+}
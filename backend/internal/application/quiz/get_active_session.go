@@ -26,6 +26,7 @@ func NewGetActiveSessionUseCase(
 type GetActiveSessionInput struct {
 	QuizID string
 	UserID string
+	Locale string // caller's content language, see quiz.DefaultLocale
 }
 
 // GetActiveSessionOutput is the output DTO
@@ -71,7 +72,7 @@ func (uc *GetActiveSessionUseCase) Execute(input GetActiveSessionInput) (GetActi
 	// 5. Return DTO
 	return GetActiveSessionOutput{
 		Session:              ToSessionDTO(session),
-		CurrentQuestion:      ToQuestionDTO(currentQuestion),
+		CurrentQuestion:      ToQuestionDTO(currentQuestion.Localized(input.Locale)),
 		TotalQuestions:       quizAggregate.QuestionsCount(),
 		TimeLimit:            quizAggregate.TimeLimit().Seconds(),
 		TimeLimitPerQuestion: quizAggregate.TimeLimitPerQuestion(),
@@ -17,7 +17,9 @@ func NewListCategoriesUseCase(categoryRepo quiz.CategoryRepository) *ListCategor
 }
 
 // ListCategoriesInput is the input for the use case.
-type ListCategoriesInput struct{}
+type ListCategoriesInput struct {
+	Locale string // caller's content language, see quiz.DefaultLocale
+}
 
 // ListCategoriesOutput is the output for the use case.
 type ListCategoriesOutput struct {
@@ -32,6 +34,6 @@ func (uc *ListCategoriesUseCase) Execute(input ListCategoriesInput) (ListCategor
 	}
 
 	return ListCategoriesOutput{
-		Categories: ToCategoryDTOs(categories),
+		Categories: ToCategoryDTOs(categories, input.Locale),
 	}, nil
 }
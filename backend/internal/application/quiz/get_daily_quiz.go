@@ -115,7 +115,7 @@ func (uc *GetDailyQuizUseCase) Execute(input GetDailyQuizInput) (GetDailyQuizOut
 
 	// 8. Return DTO
 	return GetDailyQuizOutput{
-		Quiz:             ToQuizDetailDTO(quizAggregate),
+		Quiz:             ToQuizDetailDTO(quizAggregate.Localized(input.Locale)),
 		CompletionStatus: completionStatus,
 		UserResult:       userResult,
 		TopScores:        topScores,
@@ -29,10 +29,13 @@ func (uc *GetQuizUseCase) Execute(input GetQuizInput) (GetQuizOutput, error) {
 	if err != nil {
 		return GetQuizOutput{}, err
 	}
+	if quizAggregate.IsDeleted() {
+		return GetQuizOutput{}, quiz.ErrQuizNotFound
+	}
 
 	// 3. Return DTO
 	return GetQuizOutput{
-		Quiz: ToQuizDTO(quizAggregate),
+		Quiz: ToQuizDTO(quizAggregate.Localized(input.Locale)),
 	}, nil
 }
 
@@ -69,6 +72,6 @@ func (uc *ListQuizzesUseCase) Execute(input ListQuizzesInput) (ListQuizzesOutput
 
 	// 2. Return DTOs
 	return ListQuizzesOutput{
-		Quizzes: ToQuizListDTOFromSummaries(summaries),
+		Quizzes: ToQuizListDTOFromSummaries(summaries, input.Locale),
 	}, nil
 }
@@ -36,6 +36,9 @@ func (uc *GetQuizDetailsUseCase) Execute(input GetQuizDetailsInput) (GetQuizDeta
 	if err != nil {
 		return GetQuizDetailsOutput{}, err
 	}
+	if quizAggregate.IsDeleted() {
+		return GetQuizDetailsOutput{}, quiz.ErrQuizNotFound
+	}
 
 	// 3. Get top leaderboard entries (top 3 for preview)
 	topScores, err := uc.leaderboardRepo.GetLeaderboard(quizID, 3)
@@ -46,7 +49,7 @@ func (uc *GetQuizDetailsUseCase) Execute(input GetQuizDetailsInput) (GetQuizDeta
 
 	// 4. Convert to DTO (with questions but WITHOUT correct answers)
 	return GetQuizDetailsOutput{
-		Quiz:      ToQuizDetailDTO(quizAggregate),
+		Quiz:      ToQuizDetailDTO(quizAggregate.Localized(input.Locale)),
 		TopScores: ToLeaderboardEntriesDTO(topScores),
 	}, nil
 }
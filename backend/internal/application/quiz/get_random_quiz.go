@@ -62,7 +62,7 @@ func (uc *GetRandomQuizUseCase) Execute(input GetRandomQuizInput) (GetQuizDetail
 
 	// 5. Return DTO
 	return GetQuizDetailsOutput{
-		Quiz:      ToQuizDetailDTO(quizAggregate),
+		Quiz:      ToQuizDetailDTO(quizAggregate.Localized(input.Locale)),
 		TopScores: topScores,
 	}, nil
 }
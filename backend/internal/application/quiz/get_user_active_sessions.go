@@ -50,7 +50,7 @@ func (uc *GetUserActiveSessionsUseCase) Execute(input GetUserActiveSessionsInput
 		summary := SessionSummaryDTO{
 			SessionID:       session.ID().String(),
 			QuizID:          session.QuizID().String(),
-			QuizTitle:       quizAggregate.Title().String(),
+			QuizTitle:       quizAggregate.Localized(input.Locale).Title().String(),
 			CurrentQuestion: session.CurrentQuestion(),
 			TotalQuestions:  quizAggregate.QuestionsCount(),
 			Score:           session.Score().Value(),
@@ -55,13 +55,15 @@ func ToQuizDetailDTO(q *quiz.Quiz) QuizDetailDTO {
 // NOTE: Does NOT include IsCorrect - never leak correct answers!
 func ToQuestionDTO(q *quiz.Question) QuestionDTO {
 	answers := make([]AnswerDTO, 0, len(q.Answers()))
-	for _, answer := range q.Answers() {
+	for _, answer := range q.DisplayAnswers() {
 		answers = append(answers, ToAnswerDTO(&answer))
 	}
 
 	return QuestionDTO{
 		ID:       q.ID().String(),
+		Type:     q.Type().String(),
 		Text:     q.Text().String(),
+		Media:    ToMediaDTO(q.Media()),
 		Answers:  answers,
 		Points:   q.Points().Value(),
 		Position: q.Position(),
@@ -74,10 +76,31 @@ func ToAnswerDTO(a *quiz.Answer) AnswerDTO {
 	return AnswerDTO{
 		ID:       a.ID().String(),
 		Text:     a.Text().String(),
+		Media:    ToMediaDTO(a.Media()),
 		Position: a.Position(),
 	}
 }
 
+// MediaAssetURLPrefix is where stored media assets are served (see the /media route)
+const MediaAssetURLPrefix = "/api/v1/media/"
+
+// ToMediaDTO converts media to DTO, or nil when there is none
+func ToMediaDTO(m quiz.Media) *MediaDTO {
+	if m.IsEmpty() {
+		return nil
+	}
+	url := m.URL()
+	if m.AssetKey() != "" {
+		url = MediaAssetURLPrefix + m.AssetKey()
+	}
+	return &MediaDTO{
+		URL:    url,
+		Width:  m.Width(),
+		Height: m.Height(),
+		Alt:    m.AltText(),
+	}
+}
+
 // ToSessionDTO converts a QuizSession aggregate to SessionDTO
 func ToSessionDTO(s *quiz.QuizSession) SessionDTO {
 	return SessionDTO{
@@ -120,11 +143,11 @@ func ToCategoryDTO(c *quiz.Category) CategoryDTO {
 	}
 }
 
-// ToCategoryDTOs converts a slice of Category aggregates to DTOs.
-func ToCategoryDTOs(categories []*quiz.Category) []CategoryDTO {
+// ToCategoryDTOs converts a slice of Category aggregates to DTOs, names in the given locale.
+func ToCategoryDTOs(categories []*quiz.Category, locale string) []CategoryDTO {
 	dtos := make([]CategoryDTO, 0, len(categories))
 	for _, c := range categories {
-		dtos = append(dtos, ToCategoryDTO(c))
+		dtos = append(dtos, ToCategoryDTO(c.Localized(locale)))
 	}
 	return dtos
 }
@@ -157,11 +180,11 @@ func ToQuizDTOFromSummary(s *quiz.QuizSummary) QuizDTO {
 	}
 }
 
-// ToQuizListDTOFromSummaries converts a slice of QuizSummary objects to DTOs
-func ToQuizListDTOFromSummaries(summaries []*quiz.QuizSummary) []QuizDTO {
+// ToQuizListDTOFromSummaries converts a slice of QuizSummary objects to DTOs in the given locale
+func ToQuizListDTOFromSummaries(summaries []*quiz.QuizSummary, locale string) []QuizDTO {
 	dtos := make([]QuizDTO, 0, len(summaries))
 	for _, s := range summaries {
-		dtos = append(dtos, ToQuizDTOFromSummary(s))
+		dtos = append(dtos, ToQuizDTOFromSummary(s.Localized(locale)))
 	}
 	return dtos
 }
@@ -197,14 +220,14 @@ func BuildFinalResult(session *quiz.QuizSession, quizAggregate *quiz.Quiz) Final
 	}
 }
 
-// FindCorrectAnswerID finds the correct answer ID for a question
+// FindCorrectAnswerID finds the correct answer ID of a single-choice or true/false
+// question; empty for the other types (see CorrectAnswerIDsOf, CorrectNumberOf)
 func FindCorrectAnswerID(q *quiz.Question) string {
-	for _, answer := range q.Answers() {
-		if answer.IsCorrect() {
-			return answer.ID().String()
-		}
+	correctID := q.CorrectAnswerID()
+	if correctID.IsZero() {
+		return ""
 	}
-	return ""
+	return correctID.String()
 }
 
 // ToGlobalLeaderboardEntryDTO converts a GlobalLeaderboardEntry to DTO
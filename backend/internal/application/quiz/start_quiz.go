@@ -85,7 +85,7 @@ func (uc *StartQuizUseCase) Execute(input StartQuizInput) (StartQuizOutput, erro
 	// 9. Return DTO (not domain models!)
 	return StartQuizOutput{
 		Session:              ToSessionDTO(session),
-		FirstQuestion:        ToQuestionDTO(firstQuestion),
+		FirstQuestion:        ToQuestionDTO(firstQuestion.Localized(input.Locale)),
 		TotalQuestions:       quizAggregate.QuestionsCount(),
 		TimeLimit:            quizAggregate.TimeLimit().Seconds(),
 		TimeLimitPerQuestion: quizAggregate.TimeLimitPerQuestion(),
@@ -0,0 +1,78 @@
+package quiz
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ParseAnswerSubmission converts the answer fields of a submit request, shared by
+// every game mode: numericAnswer for a numeric question, answerIDs for multi-select
+// (the picked options) and ordering (every option, in order), otherwise answerID.
+func ParseAnswerSubmission(answerID string, answerIDs []string, numericAnswer *float64) (quiz.AnswerSubmission, error) {
+	if numericAnswer != nil {
+		if answerID != "" || len(answerIDs) > 0 {
+			return quiz.AnswerSubmission{}, quiz.ErrInvalidSubmission
+		}
+		return quiz.NewNumericSubmission(*numericAnswer), nil
+	}
+
+	if len(answerIDs) == 0 {
+		id, err := quiz.NewAnswerIDFromString(answerID)
+		if err != nil {
+			return quiz.AnswerSubmission{}, err
+		}
+		return quiz.NewChoiceSubmission(id), nil
+	}
+
+	if answerID != "" && answerID != answerIDs[0] {
+		return quiz.AnswerSubmission{}, quiz.ErrInvalidSubmission
+	}
+	ids := make([]quiz.AnswerID, 0, len(answerIDs))
+	for _, raw := range answerIDs {
+		id, err := quiz.NewAnswerIDFromString(raw)
+		if err != nil {
+			return quiz.AnswerSubmission{}, err
+		}
+		ids = append(ids, id)
+	}
+	return quiz.NewChoiceSubmission(ids...), nil
+}
+
+// CorrectAnswerIDsOf returns the answer key of a multi-select (the correct options)
+// or ordering (the solution order) question; nil for the other types, whose key is
+// CorrectAnswerID or CorrectNumberOf
+func CorrectAnswerIDsOf(q *quiz.Question) []string {
+	switch q.Type() {
+	case quiz.QuestionTypeMultiSelect, quiz.QuestionTypeOrdering:
+	default:
+		return nil
+	}
+	ids := q.CorrectAnswerIDs()
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
+
+// CorrectNumberOf returns the exact value of a numeric question; nil for the other types
+func CorrectNumberOf(q *quiz.Question) *float64 {
+	if q.Type() != quiz.QuestionTypeNumeric || q.NumericAnswer().IsEmpty() {
+		return nil
+	}
+	value := q.NumericAnswer().Value()
+	return &value
+}
+
+// SubmissionAnswerIDs returns the options of a submission as strings, nil when a
+// single option (already reported as the answer ID) or a number was submitted
+func SubmissionAnswerIDs(s quiz.AnswerSubmission) []string {
+	ids := s.AnswerIDs()
+	if len(ids) < 2 {
+		return nil
+	}
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
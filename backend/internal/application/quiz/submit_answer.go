@@ -12,6 +12,8 @@ type SubmitAnswerUseCase struct {
 	quizRepo    quiz.QuizRepository
 	sessionRepo quiz.SessionRepository
 	eventBus    quiz.EventBus
+	txManager   TxManager   // optional, see WithOutbox
+	outbox      EventOutbox // optional, see WithOutbox
 }
 
 // NewSubmitAnswerUseCase creates a new SubmitAnswerUseCase
@@ -27,6 +29,13 @@ func NewSubmitAnswerUseCase(
 	}
 }
 
+// WithOutbox saves sessions and their events in one transaction through the outbox
+func (uc *SubmitAnswerUseCase) WithOutbox(txManager TxManager, outbox EventOutbox) *SubmitAnswerUseCase {
+	uc.txManager = txManager
+	uc.outbox = outbox
+	return uc
+}
+
 // Execute submits an answer for a quiz question
 func (uc *SubmitAnswerUseCase) Execute(input SubmitAnswerInput) (SubmitAnswerOutput, error) {
 	// 1. Validate and convert input to domain types
@@ -40,7 +49,7 @@ func (uc *SubmitAnswerUseCase) Execute(input SubmitAnswerInput) (SubmitAnswerOut
 		return SubmitAnswerOutput{}, err
 	}
 
-	answerID, err := quiz.NewAnswerIDFromString(input.AnswerID)
+	submission, err := ParseAnswerSubmission(input.AnswerID, input.AnswerIDs, input.NumericAnswer)
 	if err != nil {
 		return SubmitAnswerOutput{}, err
 	}
@@ -83,7 +92,7 @@ func (uc *SubmitAnswerUseCase) Execute(input SubmitAnswerInput) (SubmitAnswerOut
 
 	// 6. Submit answer (domain business logic with new scoring system)
 	now := time.Now().Unix()
-	result, err := session.SubmitAnswer(question, answerID, now, input.TimeTaken, quizAggregate)
+	result, err := session.SubmitAnswer(question, submission, now, input.TimeTaken, quizAggregate)
 	if err != nil {
 		return SubmitAnswerOutput{}, err
 	}
@@ -96,37 +105,35 @@ func (uc *SubmitAnswerUseCase) Execute(input SubmitAnswerInput) (SubmitAnswerOut
 		}
 	}
 
-	// 8. Persist session
-	if err := uc.sessionRepo.Save(session); err != nil {
+	// 8. Persist session and publish domain events
+	writer := sessionWriter{uc.sessionRepo, uc.eventBus, uc.txManager, uc.outbox}
+	if err := writer.save(session); err != nil {
 		return SubmitAnswerOutput{}, err
 	}
 
-	// 9. Publish domain events
-	if uc.eventBus != nil {
-		uc.eventBus.Publish(session.Events()...)
-	}
-
 	// 10. Build output with detailed points breakdown
 	output := SubmitAnswerOutput{
-		IsCorrect:       result.IsCorrect,
-		CorrectAnswerID: FindCorrectAnswerID(question),
-		BasePoints:      result.BasePoints.Value(),
-		TimeBonus:       result.TimeBonus.Value(),
-		StreakBonus:     result.StreakBonus.Value(),
-		PointsEarned:    result.TotalPoints.Value(),
-		CurrentStreak:   result.CurrentStreak,
-		TotalScore:      session.Score().Value(),
-		IsQuizCompleted: isCompleted,
+		IsCorrect:        result.IsCorrect,
+		CorrectAnswerID:  FindCorrectAnswerID(question),
+		CorrectAnswerIDs: CorrectAnswerIDsOf(question),
+		CorrectNumber:    CorrectNumberOf(question),
+		BasePoints:       result.BasePoints.Value(),
+		TimeBonus:        result.TimeBonus.Value(),
+		StreakBonus:      result.StreakBonus.Value(),
+		PointsEarned:     result.TotalPoints.Value(),
+		CurrentStreak:    result.CurrentStreak,
+		TotalScore:       session.Score().Value(),
+		IsQuizCompleted:  isCompleted,
 	}
 
 	// 12. Include next question or final result
 	if isCompleted {
-		finalResult := BuildFinalResult(session, quizAggregate)
+		finalResult := BuildFinalResult(session, quizAggregate.Localized(input.Locale))
 		output.FinalResult = &finalResult
 	} else {
 		nextQuestion, err := quizAggregate.GetQuestionByIndex(session.CurrentQuestion())
 		if err == nil {
-			dto := ToQuestionDTO(nextQuestion)
+			dto := ToQuestionDTO(nextQuestion.Localized(input.Locale))
 			output.NextQuestion = &dto
 		}
 	}
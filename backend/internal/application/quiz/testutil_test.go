@@ -0,0 +1,341 @@
+package quiz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ========================================
+// Mock Repositories
+// ========================================
+
+// errAuditUnavailable makes mockAuditRepo fail its appends
+var errAuditUnavailable = errors.New("audit log unavailable")
+
+// mockStore holds the state shared by the mocks, so mockTxManager can roll it back.
+// Content is kept by value: what a use case loads is a copy, as if read from a row.
+type mockStore struct {
+	quizzes        map[string]quiz.Quiz
+	questions      map[string]quiz.Question
+	deletedAnswers map[string][]quiz.Answer // questionID -> answers removed from it
+	categories     map[string]quiz.Category
+	audit          []*quiz.ContentAuditEntry
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		quizzes:        make(map[string]quiz.Quiz),
+		questions:      make(map[string]quiz.Question),
+		deletedAnswers: make(map[string][]quiz.Answer),
+		categories:     make(map[string]quiz.Category),
+	}
+}
+
+func (s *mockStore) clone() *mockStore {
+	c := newMockStore()
+	for id, q := range s.quizzes {
+		c.quizzes[id] = q
+	}
+	for id, q := range s.questions {
+		c.questions[id] = q
+	}
+	for id, answers := range s.deletedAnswers {
+		c.deletedAnswers[id] = append([]quiz.Answer(nil), answers...)
+	}
+	for id, category := range s.categories {
+		c.categories[id] = category
+	}
+	c.audit = append(c.audit, s.audit...)
+	return c
+}
+
+// mockTxManager runs the function directly and restores the store if it fails
+type mockTxManager struct {
+	store *mockStore
+}
+
+func (m *mockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	saved := m.store.clone()
+	if err := fn(nil); err != nil {
+		*m.store = *saved
+		return err
+	}
+	return nil
+}
+
+// mockQuizRepo is an in-memory QuizRepository
+type mockQuizRepo struct {
+	store *mockStore
+}
+
+func (m *mockQuizRepo) FindByID(id quiz.QuizID) (*quiz.Quiz, error) {
+	q, ok := m.store.quizzes[id.String()]
+	if !ok {
+		return nil, quiz.ErrQuizNotFound
+	}
+	return &q, nil
+}
+
+func (m *mockQuizRepo) FindAll() ([]quiz.Quiz, error)                  { return nil, nil }
+func (m *mockQuizRepo) FindAllSummaries() ([]*quiz.QuizSummary, error) { return nil, nil }
+func (m *mockQuizRepo) FindSummariesByCategory(quiz.CategoryID) ([]*quiz.QuizSummary, error) {
+	return nil, nil
+}
+
+func (m *mockQuizRepo) Save(q *quiz.Quiz) error {
+	m.store.quizzes[q.ID().String()] = *q
+	return nil
+}
+
+func (m *mockQuizRepo) SaveInTx(_ *sql.Tx, q *quiz.Quiz) error {
+	return m.Save(q)
+}
+
+func (m *mockQuizRepo) Delete(quiz.QuizID) error { return nil }
+
+// mockQuestionRepo is an in-memory QuestionRepository. Like the postgres one,
+// it soft-deletes the answers a save leaves out.
+type mockQuestionRepo struct {
+	store *mockStore
+}
+
+func (m *mockQuestionRepo) FindByID(id quiz.QuestionID) (*quiz.Question, error) {
+	q, ok := m.store.questions[id.String()]
+	if !ok {
+		return nil, quiz.ErrQuestionNotFound
+	}
+	return &q, nil
+}
+
+// FindByQuizID returns the live questions of a quiz, as players get them
+func (m *mockQuestionRepo) FindByQuizID(quizID quiz.QuizID) []*quiz.Question {
+	var questions []*quiz.Question
+	for _, q := range m.store.questions {
+		if q.QuizID().Equals(quizID) && !q.IsDeleted() {
+			question := q
+			questions = append(questions, &question)
+		}
+	}
+	return questions
+}
+
+func (m *mockQuestionRepo) FindDeletedAnswers(questionID quiz.QuestionID) ([]quiz.Answer, error) {
+	return m.store.deletedAnswers[questionID.String()], nil
+}
+
+func (m *mockQuestionRepo) FindByIDs([]quiz.QuestionID) ([]*quiz.Question, error) { return nil, nil }
+func (m *mockQuestionRepo) FindByFilter(quiz.QuestionFilter) ([]*quiz.Question, error) {
+	return nil, nil
+}
+func (m *mockQuestionRepo) FindRandomQuestions(quiz.QuestionFilter, int) ([]*quiz.Question, error) {
+	return nil, nil
+}
+func (m *mockQuestionRepo) FindQuestionsBySeed(quiz.QuestionFilter, int, int64) ([]*quiz.Question, error) {
+	return nil, nil
+}
+func (m *mockQuestionRepo) FindQuestionsByQuizSeed(int, int64, *quiz.CategoryID) ([]*quiz.Question, error) {
+	return nil, nil
+}
+func (m *mockQuestionRepo) CountByFilter(quiz.QuestionFilter) (int, error) { return 0, nil }
+
+func (m *mockQuestionRepo) Save(question *quiz.Question) error {
+	return m.SaveInTx(nil, question)
+}
+
+func (m *mockQuestionRepo) SaveAll(questions []*quiz.Question) error {
+	for _, question := range questions {
+		if err := m.Save(question); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockQuestionRepo) SaveInTx(_ *sql.Tx, question *quiz.Question) error {
+	if question.QuizID().IsZero() {
+		return quiz.ErrQuestionWithoutQuiz
+	}
+	id := question.ID().String()
+
+	// Answers dropped by this save are soft-deleted, answers back on it restored
+	deleted := make(map[string]quiz.Answer)
+	for _, answer := range m.store.deletedAnswers[id] {
+		deleted[answer.ID().String()] = answer
+	}
+	if previous, ok := m.store.questions[id]; ok {
+		for _, answer := range previous.Answers() {
+			deleted[answer.ID().String()] = answer
+		}
+	}
+	for _, answer := range question.Answers() {
+		delete(deleted, answer.ID().String())
+	}
+	m.store.deletedAnswers[id] = nil
+	for _, answer := range deleted {
+		m.store.deletedAnswers[id] = append(m.store.deletedAnswers[id], answer)
+	}
+
+	m.store.questions[id] = *question
+	return nil
+}
+
+func (m *mockQuestionRepo) Delete(quiz.QuestionID) error { return nil }
+
+// mockCategoryRepo is an in-memory CategoryRepository
+type mockCategoryRepo struct {
+	store *mockStore
+}
+
+func (m *mockCategoryRepo) FindByID(id quiz.CategoryID) (*quiz.Category, error) {
+	category, ok := m.store.categories[id.String()]
+	if !ok {
+		return nil, quiz.ErrCategoryNotFound
+	}
+	return &category, nil
+}
+
+func (m *mockCategoryRepo) FindAll() ([]*quiz.Category, error) { return nil, nil }
+
+func (m *mockCategoryRepo) Save(category *quiz.Category) error {
+	m.store.categories[category.ID().String()] = *category
+	return nil
+}
+
+func (m *mockCategoryRepo) SaveInTx(_ *sql.Tx, category *quiz.Category) error {
+	return m.Save(category)
+}
+
+func (m *mockCategoryRepo) Delete(quiz.CategoryID) error { return nil }
+
+// mockAuditRepo is an in-memory ContentAuditRepository
+type mockAuditRepo struct {
+	store *mockStore
+	fail  bool
+}
+
+func (m *mockAuditRepo) Append(entry *quiz.ContentAuditEntry) error {
+	return m.AppendInTx(nil, entry)
+}
+
+func (m *mockAuditRepo) AppendInTx(_ *sql.Tx, entry *quiz.ContentAuditEntry) error {
+	if m.fail {
+		return errAuditUnavailable
+	}
+	m.store.audit = append(m.store.audit, entry)
+	return nil
+}
+
+func (m *mockAuditRepo) FindByEntity(entityType quiz.ContentEntityType, entityID string, _ int) ([]*quiz.ContentAuditEntry, error) {
+	var entries []*quiz.ContentAuditEntry
+	for _, entry := range m.store.audit {
+		if entry.EntityType() == entityType && entry.EntityID() == entityID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (m *mockAuditRepo) FindRecent(int) ([]*quiz.ContentAuditEntry, error) {
+	return m.store.audit, nil
+}
+
+// ========================================
+// Fixture
+// ========================================
+
+type contentFixture struct {
+	store        *mockStore
+	quizRepo     *mockQuizRepo
+	questionRepo *mockQuestionRepo
+	categoryRepo *mockCategoryRepo
+	auditRepo    *mockAuditRepo
+	txManager    *mockTxManager
+}
+
+func newContentFixture() *contentFixture {
+	store := newMockStore()
+	return &contentFixture{
+		store:        store,
+		quizRepo:     &mockQuizRepo{store: store},
+		questionRepo: &mockQuestionRepo{store: store},
+		categoryRepo: &mockCategoryRepo{store: store},
+		auditRepo:    &mockAuditRepo{store: store},
+		txManager:    &mockTxManager{store: store},
+	}
+}
+
+// addQuiz stores a live quiz to add questions to
+func (f *contentFixture) addQuiz(t *testing.T) *quiz.Quiz {
+	t.Helper()
+
+	title, _ := quiz.NewQuizTitle("Go basics")
+	timeLimit, _ := quiz.NewTimeLimit(300)
+	passingScore, _ := quiz.NewPassingScore(70)
+	q, err := quiz.NewQuiz(quiz.NewQuizID(), title, "", quiz.CategoryID{}, timeLimit, passingScore, 1000)
+	if err != nil {
+		t.Fatalf("NewQuiz: %v", err)
+	}
+	if err := f.quizRepo.Save(q); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return q
+}
+
+// addQuestion creates a single-choice question on a quiz through the admin use case
+func (f *contentFixture) addQuestion(t *testing.T, quizID quiz.QuizID) AdminQuestionDTO {
+	t.Helper()
+
+	output, err := NewAdminCreateQuestionUseCase(f.quizRepo, f.questionRepo, f.auditRepo, f.txManager).Execute(AdminCreateQuestionInput{
+		QuizID: quizID.String(),
+		Actor:  "alice",
+		AdminQuestionInput: AdminQuestionInput{
+			Text:   "What is Go?",
+			Points: 100,
+			Answers: []AdminAnswerInput{
+				{Text: "A programming language", IsCorrect: true},
+				{Text: "A board game"},
+				{Text: "A fish"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AdminCreateQuestion: %v", err)
+	}
+	return output.Question
+}
+
+// questionInput turns a question back into the input that stores it unchanged
+func questionInput(question AdminQuestionDTO) AdminQuestionInput {
+	answers := make([]AdminAnswerInput, 0, len(question.Answers))
+	for _, answer := range question.Answers {
+		answers = append(answers, AdminAnswerInput{
+			ID:           answer.ID,
+			Text:         answer.Text,
+			IsCorrect:    answer.IsCorrect,
+			Media:        answer.Media,
+			Translations: answer.Translations,
+		})
+	}
+	return AdminQuestionInput{
+		Type:          question.Type,
+		Text:          question.Text,
+		Points:        question.Points,
+		Difficulty:    question.Difficulty,
+		Explanation:   question.Explanation,
+		SourceURL:     question.SourceURL,
+		Media:         question.Media,
+		NumericAnswer: question.NumericAnswer,
+		Answers:       answers,
+		Translations:  question.Translations,
+	}
+}
+
+// auditEntries returns the audit trail of one entity, oldest first
+func (f *contentFixture) auditEntries(entityType quiz.ContentEntityType, entityID string) []*quiz.ContentAuditEntry {
+	entries, _ := f.auditRepo.FindByEntity(entityType, entityID, 0)
+	return entries
+}
@@ -0,0 +1,57 @@
+package shop
+
+// ========================================
+// Common DTOs
+// ========================================
+
+// ShopItemDTO is a catalog item as offered to a player
+type ShopItemDTO struct {
+	ID                 string         `json:"id"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Contents           map[string]int `json:"contents"` // resource -> amount
+	PriceCoins         int            `json:"priceCoins"`
+	PurchaseLimit      int            `json:"purchaseLimit"`                // 0 = unlimited
+	LimitWindow        string         `json:"limitWindow"`                  // "lifetime", "daily", "weekly"
+	RemainingPurchases *int           `json:"remainingPurchases,omitempty"` // nil when unlimited
+	IsLimitedTimeOffer bool           `json:"isLimitedTimeOffer"`
+	AvailableUntil     int64          `json:"availableUntil,omitempty"`
+	CanAfford          bool           `json:"canAfford"`
+}
+
+// PurchaseDTO is a completed purchase
+type PurchaseDTO struct {
+	ID         string         `json:"id"`
+	ItemID     string         `json:"itemId"`
+	PriceCoins int            `json:"priceCoins"`
+	Contents   map[string]int `json:"contents"`
+	CreatedAt  int64          `json:"createdAt"`
+}
+
+// ========================================
+// GetShop Use Case
+// ========================================
+
+type GetShopInput struct {
+	PlayerID string `json:"playerId"`
+}
+
+type GetShopOutput struct {
+	Coins int           `json:"coins"`
+	Items []ShopItemDTO `json:"items"`
+}
+
+// ========================================
+// PurchaseItem Use Case
+// ========================================
+
+type PurchaseItemInput struct {
+	PlayerID       string `json:"playerId"`
+	ItemID         string `json:"itemId"`
+	IdempotencyKey string `json:"-"`
+}
+
+type PurchaseItemOutput struct {
+	Purchase PurchaseDTO `json:"purchase"`
+	Coins    int         `json:"coins"` // Balance after the purchase
+}
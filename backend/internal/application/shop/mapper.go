@@ -0,0 +1,37 @@
+package shop
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+)
+
+// ToShopItemDTO converts a catalog item to a DTO for a player with the given purchase count and coins
+func ToShopItemDTO(item *shop.Item, purchasedInWindow int, coins int) ShopItemDTO {
+	dto := ShopItemDTO{
+		ID:                 item.ID().String(),
+		Title:              item.Title(),
+		Description:        item.Description(),
+		Contents:           item.Contents(),
+		PriceCoins:         item.PriceCoins(),
+		PurchaseLimit:      item.PurchaseLimit(),
+		LimitWindow:        item.LimitWindow().String(),
+		IsLimitedTimeOffer: item.IsLimitedTimeOffer(),
+		AvailableUntil:     item.AvailableUntil(),
+		CanAfford:          coins >= item.PriceCoins(),
+	}
+	if item.HasPurchaseLimit() {
+		remaining := item.RemainingPurchases(purchasedInWindow)
+		dto.RemainingPurchases = &remaining
+	}
+	return dto
+}
+
+// ToPurchaseDTO converts a purchase to a DTO
+func ToPurchaseDTO(purchase *shop.Purchase) PurchaseDTO {
+	return PurchaseDTO{
+		ID:         purchase.ID(),
+		ItemID:     purchase.ItemID().String(),
+		PriceCoins: purchase.PriceCoins(),
+		Contents:   purchase.Contents(),
+		CreatedAt:  purchase.CreatedAt(),
+	}
+}
@@ -0,0 +1,31 @@
+package shop
+
+import (
+	"context"
+	"database/sql"
+)
+
+// TxManager provides database transaction support
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// InventoryService defines the interface for paying for and delivering purchases.
+// Implementation is in application/user layer.
+type InventoryService interface {
+	GetCoins(playerID string) (int, error)
+	// ExchangeInTx debits cost and credits rewards within tx.
+	// Returns user.ErrDuplicateTransaction if the idempotency key was already used.
+	ExchangeInTx(tx *sql.Tx, playerID string, idempotencyKey string, source string, cost map[string]int, rewards map[string]int) error
+}
+
+// purchaseSource is the transaction log source of shop purchases
+const purchaseSource = "shop_purchase"
+
+// purchaseLedgerKey scopes a client idempotency key to the purchase payment ("" when the client sent none)
+func purchaseLedgerKey(idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return "shop_purchase:" + idempotencyKey
+}
@@ -0,0 +1,207 @@
+package shop
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// Constants
+// ========================================
+
+const testPlayerID = "player111"
+
+// ========================================
+// Mock Repositories
+// ========================================
+
+// mockStore holds the state shared by the mocks, so mockTxManager can roll it back
+type mockStore struct {
+	balances  map[string]map[string]int // playerID -> resource -> amount
+	keys      map[string]bool           // playerID + "/" + ledger idempotency key
+	purchases []*shop.Purchase
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		balances: make(map[string]map[string]int),
+		keys:     make(map[string]bool),
+	}
+}
+
+func (s *mockStore) clone() *mockStore {
+	c := newMockStore()
+	for playerID, balance := range s.balances {
+		c.balances[playerID] = make(map[string]int, len(balance))
+		for resource, amount := range balance {
+			c.balances[playerID][resource] = amount
+		}
+	}
+	for key := range s.keys {
+		c.keys[key] = true
+	}
+	c.purchases = append(c.purchases, s.purchases...)
+	return c
+}
+
+func (s *mockStore) balance(playerID string) map[string]int {
+	if s.balances[playerID] == nil {
+		s.balances[playerID] = make(map[string]int)
+	}
+	return s.balances[playerID]
+}
+
+// mockTxManager runs the function directly and restores the store if it fails
+type mockTxManager struct {
+	store *mockStore
+}
+
+func (m *mockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	saved := m.store.clone()
+	if err := fn(nil); err != nil {
+		*m.store = *saved
+		return err
+	}
+	return nil
+}
+
+// mockInventoryService is an in-memory InventoryService
+type mockInventoryService struct {
+	store *mockStore
+}
+
+func (m *mockInventoryService) GetCoins(playerID string) (int, error) {
+	return m.store.balance(playerID)[domainUser.ResourceCoins], nil
+}
+
+func (m *mockInventoryService) ExchangeInTx(_ *sql.Tx, playerID string, idempotencyKey string, _ string, cost map[string]int, rewards map[string]int) error {
+	key := playerID + "/" + idempotencyKey
+	if idempotencyKey != "" && m.store.keys[key] {
+		return domainUser.ErrDuplicateTransaction
+	}
+	balance := m.store.balance(playerID)
+	for resource, amount := range cost {
+		if balance[resource] < amount {
+			return fmt.Errorf("%w: %s", domainUser.ErrInsufficientBalance, resource)
+		}
+		balance[resource] -= amount
+	}
+	for resource, amount := range rewards {
+		balance[resource] += amount
+	}
+	if idempotencyKey != "" {
+		m.store.keys[key] = true
+	}
+	return nil
+}
+
+// mockCatalogRepo is an in-memory catalog
+type mockCatalogRepo struct {
+	items []*shop.Item
+}
+
+func (m *mockCatalogRepo) FindAll() ([]*shop.Item, error) {
+	return m.items, nil
+}
+
+func (m *mockCatalogRepo) FindByID(id shop.ItemID) (*shop.Item, error) {
+	for _, item := range m.items {
+		if item.ID().Equals(id) {
+			return item, nil
+		}
+	}
+	return nil, shop.ErrItemNotFound
+}
+
+// mockPurchaseRepo is an in-memory purchase repository
+type mockPurchaseRepo struct {
+	store *mockStore
+}
+
+func (m *mockPurchaseRepo) SaveInTx(_ *sql.Tx, purchase *shop.Purchase) error {
+	m.store.purchases = append(m.store.purchases, purchase)
+	return nil
+}
+
+func (m *mockPurchaseRepo) CountByPlayerSinceInTx(_ *sql.Tx, playerID shop.UserID, itemID shop.ItemID, since int64) (int, error) {
+	counts, err := m.CountByPlayerSince(playerID, map[string]int64{itemID.String(): since})
+	return counts[itemID.String()], err
+}
+
+func (m *mockPurchaseRepo) CountByPlayerSince(playerID shop.UserID, windowStarts map[string]int64) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, p := range m.store.purchases {
+		since, ok := windowStarts[p.ItemID().String()]
+		if ok && p.PlayerID().Equals(playerID) && p.CreatedAt() >= since {
+			counts[p.ItemID().String()]++
+		}
+	}
+	return counts, nil
+}
+
+func (m *mockPurchaseRepo) FindByIdempotencyKey(playerID shop.UserID, key string) (*shop.Purchase, error) {
+	for _, p := range m.store.purchases {
+		if p.PlayerID().Equals(playerID) && p.IdempotencyKey() == key {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// ========================================
+// Fixture
+// ========================================
+
+type shopFixture struct {
+	store        *mockStore
+	catalogRepo  *mockCatalogRepo
+	purchaseRepo *mockPurchaseRepo
+	inventory    *mockInventoryService
+	txManager    *mockTxManager
+}
+
+func newShopFixture(items ...*shop.Item) *shopFixture {
+	store := newMockStore()
+	return &shopFixture{
+		store:        store,
+		catalogRepo:  &mockCatalogRepo{items: items},
+		purchaseRepo: &mockPurchaseRepo{store: store},
+		inventory:    &mockInventoryService{store: store},
+		txManager:    &mockTxManager{store: store},
+	}
+}
+
+func (f *shopFixture) newGetShopUC() *GetShopUseCase {
+	return NewGetShopUseCase(f.catalogRepo, f.purchaseRepo, f.inventory)
+}
+
+func (f *shopFixture) newPurchaseItemUC() *PurchaseItemUseCase {
+	return NewPurchaseItemUseCase(f.catalogRepo, f.purchaseRepo, f.inventory, f.txManager)
+}
+
+func (f *shopFixture) setCoins(playerID string, coins int) {
+	f.store.balance(playerID)[domainUser.ResourceCoins] = coins
+}
+
+func (f *shopFixture) resource(playerID string, resource string) int {
+	return f.store.balance(playerID)[resource]
+}
+
+// newTestItem creates an item always on sale
+func newTestItem(t *testing.T, id string, price int, contents map[string]int, limit int, window shop.LimitWindow) *shop.Item {
+	t.Helper()
+	itemID, err := shop.NewItemID(id)
+	if err != nil {
+		t.Fatalf("NewItemID: %v", err)
+	}
+	item, err := shop.NewItem(itemID, id, "", contents, price, limit, window, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("NewItem: %v", err)
+	}
+	return item
+}
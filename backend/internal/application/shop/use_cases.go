@@ -0,0 +1,222 @@
+package shop
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// GetShop Use Case
+// ========================================
+
+// GetShopUseCase lists the items a player can currently buy
+type GetShopUseCase struct {
+	catalogRepo      shop.CatalogRepository
+	purchaseRepo     shop.PurchaseRepository
+	inventoryService InventoryService
+}
+
+func NewGetShopUseCase(
+	catalogRepo shop.CatalogRepository,
+	purchaseRepo shop.PurchaseRepository,
+	inventoryService InventoryService,
+) *GetShopUseCase {
+	return &GetShopUseCase{
+		catalogRepo:      catalogRepo,
+		purchaseRepo:     purchaseRepo,
+		inventoryService: inventoryService,
+	}
+}
+
+func (uc *GetShopUseCase) Execute(input GetShopInput) (GetShopOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetShopOutput{}, err
+	}
+
+	items, err := uc.catalogRepo.FindAll()
+	if err != nil {
+		return GetShopOutput{}, err
+	}
+
+	now := time.Now().UTC().Unix()
+
+	// Only limited items need their purchases counted, each within its own window
+	available := make([]*shop.Item, 0, len(items))
+	windowStarts := make(map[string]int64)
+	for _, item := range items {
+		if !item.IsAvailable(now) {
+			continue
+		}
+		available = append(available, item)
+		if item.HasPurchaseLimit() {
+			windowStarts[item.ID().String()] = item.LimitWindowStart(now)
+		}
+	}
+
+	purchased := map[string]int{}
+	if len(windowStarts) > 0 {
+		purchased, err = uc.purchaseRepo.CountByPlayerSince(playerID, windowStarts)
+		if err != nil {
+			return GetShopOutput{}, err
+		}
+	}
+
+	coins, err := uc.inventoryService.GetCoins(playerID.String())
+	if err != nil {
+		return GetShopOutput{}, err
+	}
+
+	itemDTOs := make([]ShopItemDTO, 0, len(available))
+	for _, item := range available {
+		itemDTOs = append(itemDTOs, ToShopItemDTO(item, purchased[item.ID().String()], coins))
+	}
+
+	return GetShopOutput{
+		Coins: coins,
+		Items: itemDTOs,
+	}, nil
+}
+
+// ========================================
+// PurchaseItem Use Case
+// ========================================
+
+// PurchaseItemUseCase buys a catalog item for coins. The payment, the delivered resources,
+// the purchase limit check and the purchase record commit in one transaction.
+type PurchaseItemUseCase struct {
+	catalogRepo      shop.CatalogRepository
+	purchaseRepo     shop.PurchaseRepository
+	inventoryService InventoryService
+	txManager        TxManager
+}
+
+func NewPurchaseItemUseCase(
+	catalogRepo shop.CatalogRepository,
+	purchaseRepo shop.PurchaseRepository,
+	inventoryService InventoryService,
+	txManager TxManager,
+) *PurchaseItemUseCase {
+	return &PurchaseItemUseCase{
+		catalogRepo:      catalogRepo,
+		purchaseRepo:     purchaseRepo,
+		inventoryService: inventoryService,
+		txManager:        txManager,
+	}
+}
+
+func (uc *PurchaseItemUseCase) Execute(input PurchaseItemInput) (PurchaseItemOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return PurchaseItemOutput{}, err
+	}
+
+	itemID, err := shop.NewItemID(input.ItemID)
+	if err != nil {
+		return PurchaseItemOutput{}, err
+	}
+
+	// 1. A retry of a completed purchase returns it unchanged
+	if input.IdempotencyKey != "" {
+		existing, err := uc.purchaseRepo.FindByIdempotencyKey(playerID, input.IdempotencyKey)
+		if err != nil {
+			return PurchaseItemOutput{}, err
+		}
+		if existing != nil {
+			return uc.replay(existing, itemID)
+		}
+	}
+
+	// 2. Load item and check it is on sale
+	item, err := uc.catalogRepo.FindByID(itemID)
+	if err != nil {
+		return PurchaseItemOutput{}, err
+	}
+
+	now := time.Now().UTC().Unix()
+	if !item.IsAvailable(now) {
+		return PurchaseItemOutput{}, shop.ErrItemNotAvailable
+	}
+
+	purchase, err := shop.NewPurchase(uuid.New().String(), playerID, item, input.IdempotencyKey, now)
+	if err != nil {
+		return PurchaseItemOutput{}, err
+	}
+
+	// 3. Pay, deliver, check the limit and record the purchase atomically.
+	// The payment locks the player's inventory first, so concurrent purchases of the
+	// same player serialize and the limit is checked against committed purchases only.
+	err = uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		err := uc.inventoryService.ExchangeInTx(
+			tx,
+			playerID.String(),
+			purchaseLedgerKey(input.IdempotencyKey),
+			purchaseSource,
+			map[string]int{domainUser.ResourceCoins: item.PriceCoins()},
+			item.Contents(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if item.HasPurchaseLimit() {
+			count, err := uc.purchaseRepo.CountByPlayerSinceInTx(tx, playerID, item.ID(), item.LimitWindowStart(now))
+			if err != nil {
+				return err
+			}
+			if err := item.CanBePurchased(count, now); err != nil {
+				return err
+			}
+		}
+
+		return uc.purchaseRepo.SaveInTx(tx, purchase)
+	})
+
+	switch {
+	case errors.Is(err, domainUser.ErrDuplicateTransaction):
+		// A concurrent retry completed first
+		existing, findErr := uc.purchaseRepo.FindByIdempotencyKey(playerID, input.IdempotencyKey)
+		if findErr != nil {
+			return PurchaseItemOutput{}, findErr
+		}
+		if existing == nil {
+			return PurchaseItemOutput{}, err
+		}
+		return uc.replay(existing, itemID)
+	case errors.Is(err, domainUser.ErrInsufficientBalance):
+		return PurchaseItemOutput{}, shop.ErrInsufficientCoins
+	case err != nil:
+		return PurchaseItemOutput{}, err
+	}
+
+	return uc.output(purchase)
+}
+
+// replay returns the purchase an idempotency key already completed. The key must
+// have bought the same item: reused for another item, it is a client bug, not a retry.
+func (uc *PurchaseItemUseCase) replay(existing *shop.Purchase, itemID shop.ItemID) (PurchaseItemOutput, error) {
+	if !existing.ItemID().Equals(itemID) {
+		return PurchaseItemOutput{}, shop.ErrIdempotencyKeyReused
+	}
+	return uc.output(existing)
+}
+
+func (uc *PurchaseItemUseCase) output(purchase *shop.Purchase) (PurchaseItemOutput, error) {
+	coins, err := uc.inventoryService.GetCoins(purchase.PlayerID().String())
+	if err != nil {
+		return PurchaseItemOutput{}, err
+	}
+
+	return PurchaseItemOutput{
+		Purchase: ToPurchaseDTO(purchase),
+		Coins:    coins,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package shop
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+func TestPurchaseItem_PaysAndDelivers(t *testing.T) {
+	item := newTestItem(t, "shield_pack", 100, map[string]int{domainUser.ResourceShield: 3}, 0, shop.LimitWindowLifetime)
+	f := newShopFixture(item)
+	f.setCoins(testPlayerID, 250)
+
+	output, err := f.newPurchaseItemUC().Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "shield_pack"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if output.Coins != 150 || f.resource(testPlayerID, domainUser.ResourceShield) != 3 {
+		t.Errorf("coins = %d, shields = %d, want 150 and 3", output.Coins, f.resource(testPlayerID, domainUser.ResourceShield))
+	}
+	if output.Purchase.ItemID != "shield_pack" || output.Purchase.PriceCoins != 100 {
+		t.Errorf("purchase = %+v", output.Purchase)
+	}
+}
+
+func TestPurchaseItem_InsufficientCoinsChangesNothing(t *testing.T) {
+	item := newTestItem(t, "ticket_pack", 100, map[string]int{domainUser.ResourcePvpTickets: 5}, 0, shop.LimitWindowLifetime)
+	f := newShopFixture(item)
+	f.setCoins(testPlayerID, 99)
+
+	_, err := f.newPurchaseItemUC().Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "ticket_pack"})
+	if !errors.Is(err, shop.ErrInsufficientCoins) {
+		t.Fatalf("err = %v, want ErrInsufficientCoins", err)
+	}
+	if f.resource(testPlayerID, domainUser.ResourceCoins) != 99 || len(f.store.purchases) != 0 {
+		t.Error("a failed purchase must not change anything")
+	}
+}
+
+func TestPurchaseItem_EnforcesLimitWithinTransaction(t *testing.T) {
+	item := newTestItem(t, "daily_deal", 10, map[string]int{domainUser.ResourceSkip: 1}, 2, shop.LimitWindowDaily)
+	f := newShopFixture(item)
+	f.setCoins(testPlayerID, 100)
+	uc := f.newPurchaseItemUC()
+
+	for i := 0; i < 2; i++ {
+		if _, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "daily_deal"}); err != nil {
+			t.Fatalf("purchase #%d: %v", i+1, err)
+		}
+	}
+
+	_, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "daily_deal"})
+	if !errors.Is(err, shop.ErrPurchaseLimitReached) {
+		t.Fatalf("third purchase err = %v, want ErrPurchaseLimitReached", err)
+	}
+	// The payment of the rejected purchase was rolled back
+	if coins := f.resource(testPlayerID, domainUser.ResourceCoins); coins != 80 {
+		t.Errorf("coins = %d, want 80", coins)
+	}
+	if skips := f.resource(testPlayerID, domainUser.ResourceSkip); skips != 2 {
+		t.Errorf("skips = %d, want 2", skips)
+	}
+}
+
+func TestPurchaseItem_RetryReturnsSamePurchase(t *testing.T) {
+	item := newTestItem(t, "freeze_pack", 40, map[string]int{domainUser.ResourceFreeze: 2}, 0, shop.LimitWindowLifetime)
+	f := newShopFixture(item)
+	f.setCoins(testPlayerID, 100)
+	uc := f.newPurchaseItemUC()
+
+	input := PurchaseItemInput{PlayerID: testPlayerID, ItemID: "freeze_pack", IdempotencyKey: "req-1"}
+	first, err := uc.Execute(input)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	second, err := uc.Execute(input)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+
+	if second.Purchase.ID != first.Purchase.ID || second.Coins != 60 || len(f.store.purchases) != 1 {
+		t.Errorf("retry = %+v, purchases = %d; want the first purchase, charged once", second, len(f.store.purchases))
+	}
+}
+
+func TestPurchaseItem_RejectsKeyReusedForAnotherItem(t *testing.T) {
+	freeze := newTestItem(t, "freeze_pack", 40, map[string]int{domainUser.ResourceFreeze: 2}, 0, shop.LimitWindowLifetime)
+	shield := newTestItem(t, "shield_pack", 30, map[string]int{domainUser.ResourceShield: 1}, 0, shop.LimitWindowLifetime)
+	f := newShopFixture(freeze, shield)
+	f.setCoins(testPlayerID, 100)
+	uc := f.newPurchaseItemUC()
+
+	if _, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "freeze_pack", IdempotencyKey: "req-1"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	_, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "shield_pack", IdempotencyKey: "req-1"})
+
+	if !errors.Is(err, shop.ErrIdempotencyKeyReused) {
+		t.Errorf("err = %v, want ErrIdempotencyKeyReused", err)
+	}
+	if coins := f.resource(testPlayerID, domainUser.ResourceCoins); coins != 60 || len(f.store.purchases) != 1 {
+		t.Errorf("coins = %d, purchases = %d; want 60, 1", coins, len(f.store.purchases))
+	}
+}
+
+func TestPurchaseItem_RejectsUnavailableItems(t *testing.T) {
+	now := time.Now().Unix()
+	expiredID, _ := shop.NewItemID("expired_offer")
+	expired, err := shop.NewItem(expiredID, "Expired", "", map[string]int{domainUser.ResourceShield: 1}, 10, 0, shop.LimitWindowLifetime, now-7200, now-3600, 0)
+	if err != nil {
+		t.Fatalf("NewItem: %v", err)
+	}
+	f := newShopFixture(expired)
+	f.setCoins(testPlayerID, 100)
+	uc := f.newPurchaseItemUC()
+
+	if _, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "expired_offer"}); !errors.Is(err, shop.ErrItemNotAvailable) {
+		t.Errorf("expired offer err = %v, want ErrItemNotAvailable", err)
+	}
+	if _, err := uc.Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "missing"}); !errors.Is(err, shop.ErrItemNotFound) {
+		t.Errorf("missing item err = %v, want ErrItemNotFound", err)
+	}
+}
+
+func TestGetShop_ShowsAvailableItemsWithRemainingPurchases(t *testing.T) {
+	now := time.Now().Unix()
+	limited := newTestItem(t, "weekly_bundle", 50, map[string]int{domainUser.ResourceFiftyFifty: 2}, 1, shop.LimitWindowWeekly)
+	unlimited := newTestItem(t, "shield_pack", 500, map[string]int{domainUser.ResourceShield: 3}, 0, shop.LimitWindowLifetime)
+	upcomingID, _ := shop.NewItemID("upcoming_offer")
+	upcoming, _ := shop.NewItem(upcomingID, "Upcoming", "", map[string]int{domainUser.ResourceSkip: 1}, 10, 0, shop.LimitWindowLifetime, now+3600, now+7200, 0)
+
+	f := newShopFixture(limited, unlimited, upcoming)
+	f.setCoins(testPlayerID, 100)
+	if _, err := f.newPurchaseItemUC().Execute(PurchaseItemInput{PlayerID: testPlayerID, ItemID: "weekly_bundle"}); err != nil {
+		t.Fatalf("purchase: %v", err)
+	}
+
+	output, err := f.newGetShopUC().Execute(GetShopInput{PlayerID: testPlayerID})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if output.Coins != 50 || len(output.Items) != 2 {
+		t.Fatalf("coins = %d, items = %d; want 50 and 2", output.Coins, len(output.Items))
+	}
+	weekly, shield := output.Items[0], output.Items[1]
+	if weekly.RemainingPurchases == nil || *weekly.RemainingPurchases != 0 {
+		t.Errorf("weekly remaining = %v, want 0", weekly.RemainingPurchases)
+	}
+	if shield.RemainingPurchases != nil || shield.CanAfford {
+		t.Errorf("shield pack = %+v, want unlimited and unaffordable", shield)
+	}
+}
@@ -0,0 +1,143 @@
+package social
+
+// ========================================
+// Common DTOs
+// ========================================
+
+// PlayerDTO identifies another player in friend lists
+type PlayerDTO struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+}
+
+// FriendDTO is an accepted friend
+type FriendDTO struct {
+	PlayerDTO
+	FriendshipID string `json:"friendshipId"`
+	Since        int64  `json:"since"` // when the request was accepted
+}
+
+// FriendRequestDTO is a pending friend request, sent or received
+type FriendRequestDTO struct {
+	ID        string    `json:"id"`
+	Player    PlayerDTO `json:"player"` // the other side: sender of an incoming request, addressee of an outgoing one
+	CreatedAt int64     `json:"createdAt"`
+}
+
+// FriendSuggestionDTO is a player met in duels who is not a friend yet
+type FriendSuggestionDTO struct {
+	PlayerDTO
+	GamesPlayed  int   `json:"gamesPlayed"` // finished duels against each other
+	LastPlayedAt int64 `json:"lastPlayedAt"`
+}
+
+// BlockedPlayerDTO is a player the player blocked
+type BlockedPlayerDTO struct {
+	PlayerDTO
+	BlockedAt int64 `json:"blockedAt"`
+}
+
+// ========================================
+// GetFriends Use Case
+// ========================================
+
+type GetFriendsInput struct {
+	PlayerID string `json:"playerId"`
+}
+
+type GetFriendsOutput struct {
+	Friends  []FriendDTO        `json:"friends"`
+	Incoming []FriendRequestDTO `json:"incoming"` // requests waiting for the player's answer
+	Outgoing []FriendRequestDTO `json:"outgoing"` // requests the player sent
+}
+
+// ========================================
+// SendFriendRequest Use Case
+// ========================================
+
+type SendFriendRequestInput struct {
+	PlayerID string `json:"playerId"`
+	FriendID string `json:"friendId"`
+}
+
+type SendFriendRequestOutput struct {
+	RequestID string `json:"requestId"`
+	Status    string `json:"status"` // "pending", or "accepted" when the friend had already invited the player
+}
+
+// ========================================
+// RespondFriendRequest Use Case
+// ========================================
+
+type RespondFriendRequestInput struct {
+	PlayerID  string `json:"playerId"`
+	RequestID string `json:"requestId"`
+	Action    string `json:"action"` // "accept" or "decline"
+}
+
+type RespondFriendRequestOutput struct {
+	Success bool       `json:"success"`
+	Friend  *FriendDTO `json:"friend,omitempty"` // set when accepted
+}
+
+// ========================================
+// RemoveFriend Use Case
+// ========================================
+
+// RemoveFriendInput ends a friendship, or cancels a request sent to or received from the friend
+type RemoveFriendInput struct {
+	PlayerID string `json:"playerId"`
+	FriendID string `json:"friendId"`
+}
+
+type RemoveFriendOutput struct {
+	Success bool `json:"success"`
+}
+
+// ========================================
+// BlockPlayer / UnblockPlayer Use Cases
+// ========================================
+
+type BlockPlayerInput struct {
+	PlayerID  string `json:"playerId"`
+	BlockedID string `json:"blockedId"`
+}
+
+type BlockPlayerOutput struct {
+	Success bool `json:"success"`
+}
+
+type UnblockPlayerInput struct {
+	PlayerID  string `json:"playerId"`
+	BlockedID string `json:"blockedId"`
+}
+
+type UnblockPlayerOutput struct {
+	Success bool `json:"success"`
+}
+
+// ========================================
+// GetBlockedPlayers Use Case
+// ========================================
+
+type GetBlockedPlayersInput struct {
+	PlayerID string `json:"playerId"`
+}
+
+type GetBlockedPlayersOutput struct {
+	Players []BlockedPlayerDTO `json:"players"`
+}
+
+// ========================================
+// GetFriendSuggestions Use Case
+// ========================================
+
+type GetFriendSuggestionsInput struct {
+	PlayerID string `json:"playerId"`
+	Limit    int    `json:"limit,omitempty"` // default 10, max 50
+}
+
+type GetFriendSuggestionsOutput struct {
+	Suggestions []FriendSuggestionDTO `json:"suggestions"`
+}
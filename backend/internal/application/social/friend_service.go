@@ -0,0 +1,34 @@
+package social
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+)
+
+// FriendService gives other contexts (duel leaderboards, online friends) a player's friend list
+type FriendService struct {
+	friendshipRepo social.FriendshipRepository
+}
+
+func NewFriendService(friendshipRepo social.FriendshipRepository) *FriendService {
+	return &FriendService{friendshipRepo: friendshipRepo}
+}
+
+// FriendIDs returns the IDs of the player's accepted friends
+func (s *FriendService) FriendIDs(playerID string) ([]string, error) {
+	uid, err := shared.NewUserID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	friendIDs, err := s.friendshipRepo.FindFriendIDs(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(friendIDs))
+	for _, id := range friendIDs {
+		ids = append(ids, id.String())
+	}
+	return ids, nil
+}
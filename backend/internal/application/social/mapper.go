@@ -0,0 +1,39 @@
+package social
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ToPlayerDTO converts a user to a DTO; a missing user is shown by ID
+func ToPlayerDTO(playerID social.UserID, user *domainUser.User) PlayerDTO {
+	dto := PlayerDTO{ID: playerID.String(), Username: playerID.String()}
+	if user == nil {
+		return dto
+	}
+	if !user.Username().IsAnonymous() {
+		dto.Username = user.Username().String()
+	} else if name := user.TelegramUsername().String(); name != "" {
+		dto.Username = name
+	}
+	dto.AvatarURL = user.AvatarURL().String()
+	return dto
+}
+
+// ToFriendDTO converts an accepted friendship to a DTO from the player's side
+func ToFriendDTO(friendship *social.Friendship, friend PlayerDTO) FriendDTO {
+	return FriendDTO{
+		PlayerDTO:    friend,
+		FriendshipID: friendship.ID().String(),
+		Since:        friendship.AcceptedAt(),
+	}
+}
+
+// ToFriendRequestDTO converts a pending request to a DTO from the player's side
+func ToFriendRequestDTO(friendship *social.Friendship, other PlayerDTO) FriendRequestDTO {
+	return FriendRequestDTO{
+		ID:        friendship.ID().String(),
+		Player:    other,
+		CreatedAt: friendship.CreatedAt(),
+	}
+}
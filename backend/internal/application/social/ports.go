@@ -0,0 +1,11 @@
+package social
+
+import (
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+)
+
+// DuelHistory lists the opponents a player met in finished duels.
+// Implemented by the duel game repository.
+type DuelHistory interface {
+	FindRecentOpponents(playerID quick_duel.UserID, limit int) ([]quick_duel.RecentOpponentEntry, error)
+}
@@ -0,0 +1,272 @@
+package social
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// Constants
+// ========================================
+
+const (
+	testPlayer1ID = "player111"
+	testPlayer2ID = "player222"
+	testPlayer3ID = "player333"
+)
+
+// ========================================
+// Mock Repositories
+// ========================================
+
+// mockFriendshipRepo is an in-memory FriendshipRepository
+type mockFriendshipRepo struct {
+	friendships map[string]*social.Friendship
+}
+
+func newMockFriendshipRepo() *mockFriendshipRepo {
+	return &mockFriendshipRepo{friendships: make(map[string]*social.Friendship)}
+}
+
+func (m *mockFriendshipRepo) Create(friendship *social.Friendship) error {
+	if _, err := m.FindBetween(friendship.RequesterID(), friendship.AddresseeID()); err == nil {
+		return social.ErrFriendshipExists
+	}
+	copied := *friendship
+	m.friendships[friendship.ID().String()] = &copied
+	return nil
+}
+
+func (m *mockFriendshipRepo) UpdateFrom(friendship *social.Friendship, loadedStatus social.FriendshipStatus) error {
+	stored, ok := m.friendships[friendship.ID().String()]
+	if !ok || stored.Status() != loadedStatus {
+		return social.ErrFriendshipChanged
+	}
+	copied := *friendship
+	m.friendships[friendship.ID().String()] = &copied
+	return nil
+}
+
+func (m *mockFriendshipRepo) Delete(id social.FriendshipID) error {
+	if _, ok := m.friendships[id.String()]; !ok {
+		return social.ErrFriendshipNotFound
+	}
+	delete(m.friendships, id.String())
+	return nil
+}
+
+func (m *mockFriendshipRepo) FindByID(id social.FriendshipID) (*social.Friendship, error) {
+	f, ok := m.friendships[id.String()]
+	if !ok {
+		return nil, social.ErrFriendshipNotFound
+	}
+	copied := *f
+	return &copied, nil
+}
+
+func (m *mockFriendshipRepo) FindBetween(playerID social.UserID, otherID social.UserID) (*social.Friendship, error) {
+	for _, f := range m.friendships {
+		if f.Involves(playerID) && f.Involves(otherID) {
+			copied := *f
+			return &copied, nil
+		}
+	}
+	return nil, social.ErrFriendshipNotFound
+}
+
+func (m *mockFriendshipRepo) FindByPlayer(playerID social.UserID) ([]*social.Friendship, error) {
+	var result []*social.Friendship
+	for _, f := range m.friendships {
+		if f.Involves(playerID) {
+			copied := *f
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockFriendshipRepo) FindFriendIDs(playerID social.UserID) ([]social.UserID, error) {
+	var ids []social.UserID
+	for _, f := range m.friendships {
+		if f.IsAccepted() && f.Involves(playerID) {
+			ids = append(ids, f.OtherPlayer(playerID))
+		}
+	}
+	return ids, nil
+}
+
+func (m *mockFriendshipRepo) CountFriends(playerID social.UserID) (int, error) {
+	ids, _ := m.FindFriendIDs(playerID)
+	return len(ids), nil
+}
+
+// mockBlockRepo is an in-memory BlockRepository; Save clears friendships like the real one
+type mockBlockRepo struct {
+	blocks         []*social.Block
+	friendshipRepo *mockFriendshipRepo
+}
+
+func (m *mockBlockRepo) Save(block *social.Block) error {
+	for _, b := range m.blocks {
+		if b.BlockerID().Equals(block.BlockerID()) && b.BlockedID().Equals(block.BlockedID()) {
+			return nil
+		}
+	}
+	m.blocks = append(m.blocks, block)
+	if f, err := m.friendshipRepo.FindBetween(block.BlockerID(), block.BlockedID()); err == nil {
+		_ = m.friendshipRepo.Delete(f.ID())
+	}
+	return nil
+}
+
+func (m *mockBlockRepo) Delete(blockerID social.UserID, blockedID social.UserID) error {
+	for i, b := range m.blocks {
+		if b.BlockerID().Equals(blockerID) && b.BlockedID().Equals(blockedID) {
+			m.blocks = append(m.blocks[:i], m.blocks[i+1:]...)
+			return nil
+		}
+	}
+	return social.ErrBlockNotFound
+}
+
+func (m *mockBlockRepo) IsBlocked(playerID social.UserID, otherID social.UserID) (bool, error) {
+	for _, b := range m.blocks {
+		if (b.BlockerID().Equals(playerID) && b.BlockedID().Equals(otherID)) ||
+			(b.BlockerID().Equals(otherID) && b.BlockedID().Equals(playerID)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockBlockRepo) FindByBlocker(blockerID social.UserID) ([]*social.Block, error) {
+	var result []*social.Block
+	for _, b := range m.blocks {
+		if b.BlockerID().Equals(blockerID) {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+// mockUserRepo for user lookups
+type mockUserRepo struct {
+	users map[string]*domainUser.User
+}
+
+func (m *mockUserRepo) FindByID(id domainUser.UserID) (*domainUser.User, error) {
+	if u, ok := m.users[id.String()]; ok {
+		return u, nil
+	}
+	return nil, domainUser.ErrUserNotFound
+}
+
+func (m *mockUserRepo) FindByTelegramUsername(_ domainUser.TelegramUsername) (*domainUser.User, error) {
+	return nil, domainUser.ErrUserNotFound
+}
+
+func (m *mockUserRepo) FindAll(_, _ int) ([]domainUser.User, error) { return nil, nil }
+
+func (m *mockUserRepo) Save(u *domainUser.User) error {
+	m.users[u.ID().String()] = u
+	return nil
+}
+
+func (m *mockUserRepo) Delete(_ domainUser.UserID) error { return nil }
+
+func (m *mockUserRepo) Exists(id domainUser.UserID) (bool, error) {
+	_, ok := m.users[id.String()]
+	return ok, nil
+}
+
+// mockDuelHistory returns canned recent opponents
+type mockDuelHistory struct {
+	opponents []quick_duel.RecentOpponentEntry
+}
+
+func (m *mockDuelHistory) FindRecentOpponents(_ quick_duel.UserID, limit int) ([]quick_duel.RecentOpponentEntry, error) {
+	result := append([]quick_duel.RecentOpponentEntry(nil), m.opponents...)
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// ========================================
+// Test Fixture
+// ========================================
+
+type testFixture struct {
+	friendshipRepo *mockFriendshipRepo
+	blockRepo      *mockBlockRepo
+	userRepo       *mockUserRepo
+	duelHistory    *mockDuelHistory
+}
+
+func setupFixture(t *testing.T) *testFixture {
+	t.Helper()
+	friendshipRepo := newMockFriendshipRepo()
+	f := &testFixture{
+		friendshipRepo: friendshipRepo,
+		blockRepo:      &mockBlockRepo{friendshipRepo: friendshipRepo},
+		userRepo:       &mockUserRepo{users: make(map[string]*domainUser.User)},
+		duelHistory:    &mockDuelHistory{},
+	}
+	for _, id := range []string{testPlayer1ID, testPlayer2ID, testPlayer3ID} {
+		f.addUser(t, id)
+	}
+	return f
+}
+
+func (f *testFixture) addUser(t *testing.T, id string) {
+	t.Helper()
+	userID, _ := shared.NewUserID(id)
+	username, _ := domainUser.NewUsername("user_" + id)
+	u, err := domainUser.NewUser(userID, username, 1000000)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	_ = f.userRepo.Save(u)
+}
+
+func (f *testFixture) newSendFriendRequestUC() *SendFriendRequestUseCase {
+	return NewSendFriendRequestUseCase(f.friendshipRepo, f.blockRepo, f.userRepo)
+}
+
+func (f *testFixture) newRespondFriendRequestUC() *RespondFriendRequestUseCase {
+	return NewRespondFriendRequestUseCase(f.friendshipRepo, f.userRepo)
+}
+
+func (f *testFixture) newGetFriendsUC() *GetFriendsUseCase {
+	return NewGetFriendsUseCase(f.friendshipRepo, f.userRepo)
+}
+
+func (f *testFixture) newBlockPlayerUC() *BlockPlayerUseCase {
+	return NewBlockPlayerUseCase(f.blockRepo, f.userRepo)
+}
+
+func (f *testFixture) newGetFriendSuggestionsUC() *GetFriendSuggestionsUseCase {
+	return NewGetFriendSuggestionsUseCase(f.duelHistory, f.friendshipRepo, f.blockRepo, f.userRepo)
+}
+
+// sendRequest sends a friend request and fails the test on error
+func (f *testFixture) sendRequest(t *testing.T, from, to string) SendFriendRequestOutput {
+	t.Helper()
+	output, err := f.newSendFriendRequestUC().Execute(SendFriendRequestInput{PlayerID: from, FriendID: to})
+	if err != nil {
+		t.Fatalf("SendFriendRequest(%s -> %s): %v", from, to, err)
+	}
+	return output
+}
+
+func mustUserID(s string) shared.UserID {
+	id, err := shared.NewUserID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
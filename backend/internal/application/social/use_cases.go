@@ -0,0 +1,491 @@
+package social
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// GetFriends Use Case
+// ========================================
+
+// GetFriendsUseCase lists a player's friends and pending friend requests
+type GetFriendsUseCase struct {
+	friendshipRepo social.FriendshipRepository
+	userRepo       domainUser.UserRepository
+}
+
+func NewGetFriendsUseCase(
+	friendshipRepo social.FriendshipRepository,
+	userRepo domainUser.UserRepository,
+) *GetFriendsUseCase {
+	return &GetFriendsUseCase{
+		friendshipRepo: friendshipRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (uc *GetFriendsUseCase) Execute(input GetFriendsInput) (GetFriendsOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetFriendsOutput{}, err
+	}
+
+	friendships, err := uc.friendshipRepo.FindByPlayer(playerID)
+	if err != nil {
+		return GetFriendsOutput{}, err
+	}
+
+	output := GetFriendsOutput{
+		Friends:  []FriendDTO{},
+		Incoming: []FriendRequestDTO{},
+		Outgoing: []FriendRequestDTO{},
+	}
+	for _, friendship := range friendships {
+		other := lookupPlayer(uc.userRepo, friendship.OtherPlayer(playerID))
+		switch {
+		case friendship.IsAccepted():
+			output.Friends = append(output.Friends, ToFriendDTO(friendship, other))
+		case friendship.IsIncomingFor(playerID):
+			output.Incoming = append(output.Incoming, ToFriendRequestDTO(friendship, other))
+		default:
+			output.Outgoing = append(output.Outgoing, ToFriendRequestDTO(friendship, other))
+		}
+	}
+
+	return output, nil
+}
+
+// ========================================
+// SendFriendRequest Use Case
+// ========================================
+
+// SendFriendRequestUseCase invites another player to be friends.
+// If that player already invited the sender, their request is accepted instead.
+type SendFriendRequestUseCase struct {
+	friendshipRepo social.FriendshipRepository
+	blockRepo      social.BlockRepository
+	userRepo       domainUser.UserRepository
+}
+
+func NewSendFriendRequestUseCase(
+	friendshipRepo social.FriendshipRepository,
+	blockRepo social.BlockRepository,
+	userRepo domainUser.UserRepository,
+) *SendFriendRequestUseCase {
+	return &SendFriendRequestUseCase{
+		friendshipRepo: friendshipRepo,
+		blockRepo:      blockRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (uc *SendFriendRequestUseCase) Execute(input SendFriendRequestInput) (SendFriendRequestOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+	friendID, err := shared.NewUserID(input.FriendID)
+	if err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+	if playerID.Equals(friendID) {
+		return SendFriendRequestOutput{}, social.ErrCannotFriendSelf
+	}
+
+	if _, err := uc.userRepo.FindByID(friendID); err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+
+	blocked, err := uc.blockRepo.IsBlocked(playerID, friendID)
+	if err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+	if blocked {
+		return SendFriendRequestOutput{}, social.ErrPlayerBlocked
+	}
+
+	now := time.Now().UTC().Unix()
+
+	existing, err := uc.friendshipRepo.FindBetween(playerID, friendID)
+	switch {
+	case errors.Is(err, social.ErrFriendshipNotFound):
+		// No request yet in either direction
+	case err != nil:
+		return SendFriendRequestOutput{}, err
+	case existing.IsAccepted():
+		return SendFriendRequestOutput{}, social.ErrAlreadyFriends
+	case !existing.IsIncomingFor(playerID):
+		return SendFriendRequestOutput{}, social.ErrFriendRequestExists
+	default:
+		// The friend invited the player first: inviting back accepts
+		if err := acceptFriendRequest(uc.friendshipRepo, existing, playerID, now); err != nil {
+			return SendFriendRequestOutput{}, err
+		}
+		return SendFriendRequestOutput{
+			RequestID: existing.ID().String(),
+			Status:    existing.Status().String(),
+		}, nil
+	}
+
+	if err := checkFriendLimit(uc.friendshipRepo, playerID); err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+
+	request, err := social.NewFriendRequest(playerID, friendID, now)
+	if err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+	if err := uc.friendshipRepo.Create(request); err != nil {
+		return SendFriendRequestOutput{}, err
+	}
+
+	return SendFriendRequestOutput{
+		RequestID: request.ID().String(),
+		Status:    request.Status().String(),
+	}, nil
+}
+
+// ========================================
+// RespondFriendRequest Use Case
+// ========================================
+
+// RespondFriendRequestUseCase accepts or declines a received friend request.
+// A declined request is deleted, so it can be sent again later.
+type RespondFriendRequestUseCase struct {
+	friendshipRepo social.FriendshipRepository
+	userRepo       domainUser.UserRepository
+}
+
+func NewRespondFriendRequestUseCase(
+	friendshipRepo social.FriendshipRepository,
+	userRepo domainUser.UserRepository,
+) *RespondFriendRequestUseCase {
+	return &RespondFriendRequestUseCase{
+		friendshipRepo: friendshipRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (uc *RespondFriendRequestUseCase) Execute(input RespondFriendRequestInput) (RespondFriendRequestOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return RespondFriendRequestOutput{}, err
+	}
+	requestID, err := social.NewFriendshipIDFromString(input.RequestID)
+	if err != nil {
+		return RespondFriendRequestOutput{}, err
+	}
+	if input.Action != "accept" && input.Action != "decline" {
+		return RespondFriendRequestOutput{}, social.ErrInvalidResponseAction
+	}
+
+	request, err := uc.friendshipRepo.FindByID(requestID)
+	if err != nil {
+		return RespondFriendRequestOutput{}, err
+	}
+	// Someone else's request is reported as missing rather than confirmed to exist
+	if !request.Involves(playerID) {
+		return RespondFriendRequestOutput{}, social.ErrFriendshipNotFound
+	}
+
+	if input.Action == "decline" {
+		if err := request.CheckDecline(playerID); err != nil {
+			return RespondFriendRequestOutput{}, err
+		}
+		if err := uc.friendshipRepo.Delete(request.ID()); err != nil {
+			return RespondFriendRequestOutput{}, err
+		}
+		return RespondFriendRequestOutput{Success: true}, nil
+	}
+
+	if err := acceptFriendRequest(uc.friendshipRepo, request, playerID, time.Now().UTC().Unix()); err != nil {
+		return RespondFriendRequestOutput{}, err
+	}
+
+	friend := ToFriendDTO(request, lookupPlayer(uc.userRepo, request.OtherPlayer(playerID)))
+	return RespondFriendRequestOutput{Success: true, Friend: &friend}, nil
+}
+
+// acceptFriendRequest accepts the request on behalf of playerID, if neither side is at the friend limit
+func acceptFriendRequest(repo social.FriendshipRepository, request *social.Friendship, playerID social.UserID, now int64) error {
+	for _, id := range []social.UserID{request.RequesterID(), request.AddresseeID()} {
+		if err := checkFriendLimit(repo, id); err != nil {
+			return err
+		}
+	}
+
+	loadedStatus := request.Status()
+	if err := request.Accept(playerID, now); err != nil {
+		return err
+	}
+	return repo.UpdateFrom(request, loadedStatus)
+}
+
+// checkFriendLimit returns ErrFriendLimitReached if the player cannot have another friend
+func checkFriendLimit(repo social.FriendshipRepository, playerID social.UserID) error {
+	count, err := repo.CountFriends(playerID)
+	if err != nil {
+		return err
+	}
+	if count >= social.MaxFriends {
+		return social.ErrFriendLimitReached
+	}
+	return nil
+}
+
+// ========================================
+// RemoveFriend Use Case
+// ========================================
+
+// RemoveFriendUseCase ends a friendship, or cancels a pending request in either direction
+type RemoveFriendUseCase struct {
+	friendshipRepo social.FriendshipRepository
+}
+
+func NewRemoveFriendUseCase(friendshipRepo social.FriendshipRepository) *RemoveFriendUseCase {
+	return &RemoveFriendUseCase{friendshipRepo: friendshipRepo}
+}
+
+func (uc *RemoveFriendUseCase) Execute(input RemoveFriendInput) (RemoveFriendOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return RemoveFriendOutput{}, err
+	}
+	friendID, err := shared.NewUserID(input.FriendID)
+	if err != nil {
+		return RemoveFriendOutput{}, err
+	}
+
+	friendship, err := uc.friendshipRepo.FindBetween(playerID, friendID)
+	if err != nil {
+		return RemoveFriendOutput{}, err
+	}
+	if err := uc.friendshipRepo.Delete(friendship.ID()); err != nil {
+		return RemoveFriendOutput{}, err
+	}
+
+	return RemoveFriendOutput{Success: true}, nil
+}
+
+// ========================================
+// BlockPlayer Use Case
+// ========================================
+
+// BlockPlayerUseCase blocks another player, ending any friendship or request between them
+type BlockPlayerUseCase struct {
+	blockRepo social.BlockRepository
+	userRepo  domainUser.UserRepository
+}
+
+func NewBlockPlayerUseCase(
+	blockRepo social.BlockRepository,
+	userRepo domainUser.UserRepository,
+) *BlockPlayerUseCase {
+	return &BlockPlayerUseCase{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+func (uc *BlockPlayerUseCase) Execute(input BlockPlayerInput) (BlockPlayerOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return BlockPlayerOutput{}, err
+	}
+	blockedID, err := shared.NewUserID(input.BlockedID)
+	if err != nil {
+		return BlockPlayerOutput{}, err
+	}
+
+	block, err := social.NewBlock(playerID, blockedID, time.Now().UTC().Unix())
+	if err != nil {
+		return BlockPlayerOutput{}, err
+	}
+	if _, err := uc.userRepo.FindByID(blockedID); err != nil {
+		return BlockPlayerOutput{}, err
+	}
+	if err := uc.blockRepo.Save(block); err != nil {
+		return BlockPlayerOutput{}, err
+	}
+
+	return BlockPlayerOutput{Success: true}, nil
+}
+
+// ========================================
+// UnblockPlayer Use Case
+// ========================================
+
+type UnblockPlayerUseCase struct {
+	blockRepo social.BlockRepository
+}
+
+func NewUnblockPlayerUseCase(blockRepo social.BlockRepository) *UnblockPlayerUseCase {
+	return &UnblockPlayerUseCase{blockRepo: blockRepo}
+}
+
+func (uc *UnblockPlayerUseCase) Execute(input UnblockPlayerInput) (UnblockPlayerOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return UnblockPlayerOutput{}, err
+	}
+	blockedID, err := shared.NewUserID(input.BlockedID)
+	if err != nil {
+		return UnblockPlayerOutput{}, err
+	}
+
+	if err := uc.blockRepo.Delete(playerID, blockedID); err != nil {
+		return UnblockPlayerOutput{}, err
+	}
+
+	return UnblockPlayerOutput{Success: true}, nil
+}
+
+// ========================================
+// GetBlockedPlayers Use Case
+// ========================================
+
+type GetBlockedPlayersUseCase struct {
+	blockRepo social.BlockRepository
+	userRepo  domainUser.UserRepository
+}
+
+func NewGetBlockedPlayersUseCase(
+	blockRepo social.BlockRepository,
+	userRepo domainUser.UserRepository,
+) *GetBlockedPlayersUseCase {
+	return &GetBlockedPlayersUseCase{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+func (uc *GetBlockedPlayersUseCase) Execute(input GetBlockedPlayersInput) (GetBlockedPlayersOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetBlockedPlayersOutput{}, err
+	}
+
+	blocks, err := uc.blockRepo.FindByBlocker(playerID)
+	if err != nil {
+		return GetBlockedPlayersOutput{}, err
+	}
+
+	players := make([]BlockedPlayerDTO, 0, len(blocks))
+	for _, block := range blocks {
+		players = append(players, BlockedPlayerDTO{
+			PlayerDTO: lookupPlayer(uc.userRepo, block.BlockedID()),
+			BlockedAt: block.CreatedAt(),
+		})
+	}
+
+	return GetBlockedPlayersOutput{Players: players}, nil
+}
+
+// ========================================
+// GetFriendSuggestions Use Case
+// ========================================
+
+// opponentsScanned is how many recent duel opponents are considered for suggestions
+const opponentsScanned = 50
+
+// GetFriendSuggestionsUseCase suggests duel opponents as friends, most played first.
+// Friends, pending requests and blocked players (either way) are left out.
+type GetFriendSuggestionsUseCase struct {
+	duelHistory    DuelHistory
+	friendshipRepo social.FriendshipRepository
+	blockRepo      social.BlockRepository
+	userRepo       domainUser.UserRepository
+}
+
+func NewGetFriendSuggestionsUseCase(
+	duelHistory DuelHistory,
+	friendshipRepo social.FriendshipRepository,
+	blockRepo social.BlockRepository,
+	userRepo domainUser.UserRepository,
+) *GetFriendSuggestionsUseCase {
+	return &GetFriendSuggestionsUseCase{
+		duelHistory:    duelHistory,
+		friendshipRepo: friendshipRepo,
+		blockRepo:      blockRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (uc *GetFriendSuggestionsUseCase) Execute(input GetFriendSuggestionsInput) (GetFriendSuggestionsOutput, error) {
+	playerID, err := shared.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetFriendSuggestionsOutput{}, err
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	opponents, err := uc.duelHistory.FindRecentOpponents(playerID, opponentsScanned)
+	if err != nil {
+		return GetFriendSuggestionsOutput{}, err
+	}
+
+	friendships, err := uc.friendshipRepo.FindByPlayer(playerID)
+	if err != nil {
+		return GetFriendSuggestionsOutput{}, err
+	}
+	related := make(map[string]bool, len(friendships))
+	for _, friendship := range friendships {
+		related[friendship.OtherPlayer(playerID).String()] = true
+	}
+
+	// Most played first; FindRecentOpponents orders ties by recency
+	sort.SliceStable(opponents, func(i, j int) bool {
+		return opponents[i].GamesCount > opponents[j].GamesCount
+	})
+
+	suggestions := make([]FriendSuggestionDTO, 0, limit)
+	for _, opponent := range opponents {
+		if len(suggestions) == limit {
+			break
+		}
+		if opponent.OpponentID.Equals(playerID) || related[opponent.OpponentID.String()] {
+			continue
+		}
+		blocked, err := uc.blockRepo.IsBlocked(playerID, opponent.OpponentID)
+		if err != nil {
+			return GetFriendSuggestionsOutput{}, err
+		}
+		if blocked {
+			continue
+		}
+		// The bot opponent, like deleted accounts, has no user
+		user, err := uc.userRepo.FindByID(opponent.OpponentID)
+		if err != nil {
+			continue
+		}
+
+		suggestions = append(suggestions, FriendSuggestionDTO{
+			PlayerDTO:    ToPlayerDTO(opponent.OpponentID, user),
+			GamesPlayed:  opponent.GamesCount,
+			LastPlayedAt: opponent.LastPlayedAt,
+		})
+	}
+
+	return GetFriendSuggestionsOutput{Suggestions: suggestions}, nil
+}
+
+// lookupPlayer resolves a player for display, falling back to the ID
+func lookupPlayer(userRepo domainUser.UserRepository, playerID social.UserID) PlayerDTO {
+	user, err := userRepo.FindByID(playerID)
+	if err != nil {
+		user = nil
+	}
+	return ToPlayerDTO(playerID, user)
+}
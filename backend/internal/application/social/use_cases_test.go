@@ -0,0 +1,190 @@
+package social
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// ========================================
+// Friend Request Tests
+// ========================================
+
+func TestSendFriendRequest_AcceptMakesFriends(t *testing.T) {
+	f := setupFixture(t)
+
+	sent := f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+	if sent.Status != "pending" {
+		t.Fatalf("status = %s, want pending", sent.Status)
+	}
+
+	_, err := f.newSendFriendRequestUC().Execute(SendFriendRequestInput{PlayerID: testPlayer1ID, FriendID: testPlayer2ID})
+	if !errors.Is(err, social.ErrFriendRequestExists) {
+		t.Errorf("second request: err = %v, want ErrFriendRequestExists", err)
+	}
+
+	// Only the invited player can accept
+	_, err = f.newRespondFriendRequestUC().Execute(RespondFriendRequestInput{PlayerID: testPlayer1ID, RequestID: sent.RequestID, Action: "accept"})
+	if !errors.Is(err, social.ErrNotRequestAddressee) {
+		t.Errorf("requester accepting: err = %v, want ErrNotRequestAddressee", err)
+	}
+
+	output, err := f.newRespondFriendRequestUC().Execute(RespondFriendRequestInput{PlayerID: testPlayer2ID, RequestID: sent.RequestID, Action: "accept"})
+	if err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	if output.Friend == nil || output.Friend.ID != testPlayer1ID {
+		t.Errorf("friend = %+v, want %s", output.Friend, testPlayer1ID)
+	}
+
+	friends, _ := f.newGetFriendsUC().Execute(GetFriendsInput{PlayerID: testPlayer1ID})
+	if len(friends.Friends) != 1 || friends.Friends[0].ID != testPlayer2ID || len(friends.Outgoing) != 0 {
+		t.Errorf("player1 friends = %+v", friends)
+	}
+
+	ids, _ := NewFriendService(f.friendshipRepo).FriendIDs(testPlayer2ID)
+	if len(ids) != 1 || ids[0] != testPlayer1ID {
+		t.Errorf("FriendIDs = %v, want [%s]", ids, testPlayer1ID)
+	}
+}
+
+func TestSendFriendRequest_MutualRequestAccepts(t *testing.T) {
+	f := setupFixture(t)
+
+	f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+	output := f.sendRequest(t, testPlayer2ID, testPlayer1ID)
+	if output.Status != "accepted" {
+		t.Errorf("status = %s, want accepted", output.Status)
+	}
+
+	_, err := f.newSendFriendRequestUC().Execute(SendFriendRequestInput{PlayerID: testPlayer1ID, FriendID: testPlayer2ID})
+	if !errors.Is(err, social.ErrAlreadyFriends) {
+		t.Errorf("err = %v, want ErrAlreadyFriends", err)
+	}
+}
+
+func TestRespondFriendRequest_DeclineDeletesRequest(t *testing.T) {
+	f := setupFixture(t)
+
+	sent := f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+
+	incoming, _ := f.newGetFriendsUC().Execute(GetFriendsInput{PlayerID: testPlayer2ID})
+	if len(incoming.Incoming) != 1 || incoming.Incoming[0].Player.ID != testPlayer1ID {
+		t.Fatalf("incoming = %+v", incoming.Incoming)
+	}
+
+	// A stranger cannot see or answer the request
+	_, err := f.newRespondFriendRequestUC().Execute(RespondFriendRequestInput{PlayerID: testPlayer3ID, RequestID: sent.RequestID, Action: "decline"})
+	if !errors.Is(err, social.ErrFriendshipNotFound) {
+		t.Errorf("stranger: err = %v, want ErrFriendshipNotFound", err)
+	}
+
+	if _, err := f.newRespondFriendRequestUC().Execute(RespondFriendRequestInput{PlayerID: testPlayer2ID, RequestID: sent.RequestID, Action: "decline"}); err != nil {
+		t.Fatalf("decline: %v", err)
+	}
+	if len(f.friendshipRepo.friendships) != 0 {
+		t.Error("declined request should be deleted")
+	}
+
+	// It can be sent again
+	f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+}
+
+func TestSendFriendRequest_RejectsInvalidTargets(t *testing.T) {
+	f := setupFixture(t)
+	uc := f.newSendFriendRequestUC()
+
+	tests := []struct {
+		name     string
+		friendID string
+		wantErr  error
+	}{
+		{"self", testPlayer1ID, social.ErrCannotFriendSelf},
+		{"unknown player", "player999", domainUser.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(SendFriendRequestInput{PlayerID: testPlayer1ID, FriendID: tt.friendID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// ========================================
+// Block Tests
+// ========================================
+
+func TestBlockPlayer_EndsFriendshipAndStopsRequests(t *testing.T) {
+	f := setupFixture(t)
+
+	f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+	f.sendRequest(t, testPlayer2ID, testPlayer1ID)
+
+	if _, err := f.newBlockPlayerUC().Execute(BlockPlayerInput{PlayerID: testPlayer2ID, BlockedID: testPlayer1ID}); err != nil {
+		t.Fatalf("block: %v", err)
+	}
+
+	friends, _ := f.newGetFriendsUC().Execute(GetFriendsInput{PlayerID: testPlayer1ID})
+	if len(friends.Friends) != 0 {
+		t.Errorf("friends after block = %+v", friends.Friends)
+	}
+
+	// Neither side can send a request while blocked
+	for _, pair := range [][2]string{{testPlayer1ID, testPlayer2ID}, {testPlayer2ID, testPlayer1ID}} {
+		_, err := f.newSendFriendRequestUC().Execute(SendFriendRequestInput{PlayerID: pair[0], FriendID: pair[1]})
+		if !errors.Is(err, social.ErrPlayerBlocked) {
+			t.Errorf("%s -> %s: err = %v, want ErrPlayerBlocked", pair[0], pair[1], err)
+		}
+	}
+
+	blocked, _ := NewGetBlockedPlayersUseCase(f.blockRepo, f.userRepo).Execute(GetBlockedPlayersInput{PlayerID: testPlayer2ID})
+	if len(blocked.Players) != 1 || blocked.Players[0].ID != testPlayer1ID {
+		t.Errorf("blocked = %+v", blocked.Players)
+	}
+
+	if _, err := NewUnblockPlayerUseCase(f.blockRepo).Execute(UnblockPlayerInput{PlayerID: testPlayer2ID, BlockedID: testPlayer1ID}); err != nil {
+		t.Fatalf("unblock: %v", err)
+	}
+	f.sendRequest(t, testPlayer1ID, testPlayer2ID)
+}
+
+// ========================================
+// Suggestion Tests
+// ========================================
+
+func TestGetFriendSuggestions_ExcludesFriendsBlockedAndBot(t *testing.T) {
+	f := setupFixture(t)
+	f.addUser(t, "player444")
+
+	f.duelHistory.opponents = []quick_duel.RecentOpponentEntry{
+		{OpponentID: mustUserID(testPlayer2ID), GamesCount: 5, LastPlayedAt: 100},
+		{OpponentID: mustUserID(testPlayer3ID), GamesCount: 1, LastPlayedAt: 300},
+		{OpponentID: mustUserID("player444"), GamesCount: 3, LastPlayedAt: 200},
+		{OpponentID: mustUserID("bot_opponent"), GamesCount: 9, LastPlayedAt: 400}, // no user
+	}
+	f.sendRequest(t, testPlayer1ID, testPlayer2ID) // pending request: already related
+	if _, err := f.newBlockPlayerUC().Execute(BlockPlayerInput{PlayerID: testPlayer3ID, BlockedID: testPlayer1ID}); err != nil {
+		t.Fatalf("block: %v", err)
+	}
+	f.addUser(t, "player555")
+	f.duelHistory.opponents = append(f.duelHistory.opponents, quick_duel.RecentOpponentEntry{OpponentID: mustUserID("player555"), GamesCount: 3, LastPlayedAt: 50})
+
+	output, err := f.newGetFriendSuggestionsUC().Execute(GetFriendSuggestionsInput{PlayerID: testPlayer1ID})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	var got []string
+	for _, s := range output.Suggestions {
+		got = append(got, s.ID)
+	}
+	want := []string{"player444", "player555"} // most played first, ties by recency
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("suggestions = %v, want %v", got, want)
+	}
+}
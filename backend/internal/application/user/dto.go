@@ -136,3 +136,136 @@ type InventoryDTO struct {
 	Skip       int `json:"skip"`
 	Freeze     int `json:"freeze"`
 }
+
+// ========================================
+// ReconcileInventory Use Case
+// ========================================
+
+// ReconcileInventoryInput is the input DTO for ReconcileInventory use case
+type ReconcileInventoryInput struct {
+	PlayerID string `json:"playerId,omitempty"` // empty: every player with an inventory
+	Fix      bool   `json:"fix"`                // overwrite drifted balances with the replayed ones
+}
+
+// InventoryDriftDTO is a balance that does not match the transaction log
+type InventoryDriftDTO struct {
+	PlayerID string `json:"playerId"`
+	Resource string `json:"resource"`
+	Balance  int    `json:"balance"`  // stored balance
+	Expected int    `json:"expected"` // balance the transaction log adds up to
+}
+
+// ReconcileInventoryOutput is the output DTO for ReconcileInventory use case
+type ReconcileInventoryOutput struct {
+	Checked int                 `json:"checked"` // inventories replayed
+	Drifts  []InventoryDriftDTO `json:"drifts"`
+	Fixed   int                 `json:"fixed"`   // inventories corrected (Fix only)
+	Skipped []string            `json:"skipped"` // players not corrected: changed during the run, or a negative replayed balance
+}
+
+// ========================================
+// GetTransactionHistory Use Case
+// ========================================
+
+// GetTransactionHistoryInput is the input DTO for GetTransactionHistory use case
+type GetTransactionHistoryInput struct {
+	PlayerID string `json:"playerId"`
+	Cursor   string `json:"cursor,omitempty"`   // nextCursor of the previous page; empty for the newest
+	Limit    int    `json:"limit,omitempty"`    // page size (default 20, max 100)
+	Resource string `json:"resource,omitempty"` // e.g. "coins", "pvp_tickets"; empty for all
+	Source   string `json:"source,omitempty"`   // e.g. "streak_recovery", "daily_chest"; empty for all
+	Days     int    `json:"days,omitempty"`     // days covered by the summary (default 30, max 90)
+}
+
+// TransactionDTO is a data transfer object for a transaction log entry
+type TransactionDTO struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"` // "credit" or "debit"
+	Source    string         `json:"source"`
+	Details   map[string]int `json:"details"` // resource -> amount
+	CreatedAt int64          `json:"createdAt"`
+}
+
+// ResourceTotalsDTO is how much of a resource was received and spent
+type ResourceTotalsDTO struct {
+	Credited int `json:"credited"`
+	Debited  int `json:"debited"`
+	Net      int `json:"net"`
+}
+
+// TransactionDaySummaryDTO totals a day of transactions per resource
+type TransactionDaySummaryDTO struct {
+	Date      string                       `json:"date"` // YYYY-MM-DD (UTC)
+	Resources map[string]ResourceTotalsDTO `json:"resources"`
+}
+
+// GetTransactionHistoryOutput is the output DTO for GetTransactionHistory use case
+type GetTransactionHistoryOutput struct {
+	Transactions []TransactionDTO           `json:"transactions"`
+	NextCursor   string                     `json:"nextCursor,omitempty"` // empty on the last page
+	Summary      []TransactionDaySummaryDTO `json:"summary,omitempty"`    // first page only, newest day first
+}
+
+// ========================================
+// Premium DTOs
+// ========================================
+
+// PremiumPlanDTO is a premium offer paid in Telegram Stars
+type PremiumPlanDTO struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	PriceStars   int    `json:"priceStars"`
+	Days         int    `json:"days"`
+	Subscription bool   `json:"subscription"` // renewed monthly by Telegram
+}
+
+// GetPremiumStatusInput is the input DTO for GetPremiumStatus use case
+type GetPremiumStatusInput struct {
+	PlayerID string `json:"playerId"`
+}
+
+// GetPremiumStatusOutput is the output DTO for GetPremiumStatus use case
+type GetPremiumStatusOutput struct {
+	IsPremium bool             `json:"isPremium"`
+	ExpiresAt int64            `json:"expiresAt,omitempty"` // 0 if the player never had premium
+	Plans     []PremiumPlanDTO `json:"plans"`
+}
+
+// CreatePremiumInvoiceInput is the input DTO for CreatePremiumInvoice use case
+type CreatePremiumInvoiceInput struct {
+	PlayerID string `json:"playerId"`
+	PlanID   string `json:"planId"`
+}
+
+// CreatePremiumInvoiceOutput is the output DTO for CreatePremiumInvoice use case
+type CreatePremiumInvoiceOutput struct {
+	InvoiceLink string         `json:"invoiceLink"` // opened with Telegram.WebApp.openInvoice
+	Plan        PremiumPlanDTO `json:"plan"`
+}
+
+// PreCheckoutInput is a Telegram pre_checkout_query to approve or decline
+type PreCheckoutInput struct {
+	QueryID     string
+	FromUserID  string // Telegram user ID of the payer
+	Currency    string
+	TotalAmount int
+	Payload     string
+}
+
+// SuccessfulPaymentInput is a Telegram successful_payment to fulfil
+type SuccessfulPaymentInput struct {
+	FromUserID  string // Telegram user ID of the payer
+	Currency    string
+	TotalAmount int
+	Payload     string
+	ChargeID    string // telegram_payment_charge_id
+	IsRecurring bool   // subscription renewal or first payment of a subscription
+}
+
+// SuccessfulPaymentOutput is the output DTO for HandleSuccessfulPayment use case
+type SuccessfulPaymentOutput struct {
+	PlayerID  string
+	ExpiresAt int64
+	Duplicate bool // the payment was already fulfilled
+}
@@ -1,6 +1,8 @@
 package user
 
 import (
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,25 +10,29 @@ import (
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
 )
 
-// InventoryService orchestrates inventory and transaction operations
+// InventoryService orchestrates inventory and transaction operations.
+// Every balance change is committed together with its transaction log entry.
 type InventoryService interface {
 	GetBalance(playerID string) (*InventoryDTO, error)
 	GetCoins(playerID string) (int, error)
 	GetPvpTickets(playerID string) (int, error)
 	Credit(playerID string, source string, details map[string]int) error
 	Debit(playerID string, source string, details map[string]int) error
+	CreditOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+	DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error
+	ExchangeInTx(tx *sql.Tx, playerID string, idempotencyKey string, source string, cost map[string]int, rewards map[string]int) error
 }
 
 type inventoryServiceImpl struct {
 	inventoryRepo user.InventoryRepository
-	txRepo        user.TransactionRepository
+	ledger        user.InventoryLedger
 }
 
 // NewInventoryService creates a new InventoryService
-func NewInventoryService(inventoryRepo user.InventoryRepository, txRepo user.TransactionRepository) InventoryService {
+func NewInventoryService(inventoryRepo user.InventoryRepository, ledger user.InventoryLedger) InventoryService {
 	return &inventoryServiceImpl{
 		inventoryRepo: inventoryRepo,
-		txRepo:        txRepo,
+		ledger:        ledger,
 	}
 }
 
@@ -72,60 +78,65 @@ func (s *inventoryServiceImpl) GetPvpTickets(playerID string) (int, error) {
 
 // Credit adds resources to a player's inventory and logs the transaction
 func (s *inventoryServiceImpl) Credit(playerID string, source string, details map[string]int) error {
-	uid, err := user.NewUserID(playerID)
-	if err != nil {
-		return err
-	}
+	return s.apply(playerID, user.TransactionCredit, "", source, details)
+}
 
-	inventory, err := s.inventoryRepo.FindByPlayerID(uid)
-	if err != nil {
-		return err
-	}
+// Debit removes resources from a player's inventory and logs the transaction
+func (s *inventoryServiceImpl) Debit(playerID string, source string, details map[string]int) error {
+	return s.apply(playerID, user.TransactionDebit, "", source, details)
+}
 
-	now := time.Now().Unix()
+// CreditOnce is Credit applied at most once per idempotency key: a retry is a no-op
+func (s *inventoryServiceImpl) CreditOnce(playerID string, idempotencyKey string, source string, details map[string]int) error {
+	return s.apply(playerID, user.TransactionCredit, idempotencyKey, source, details)
+}
 
-	if err := inventory.CreditMultiple(details, now); err != nil {
-		return err
-	}
+// DebitOnce is Debit applied at most once per idempotency key: a retry is a no-op
+func (s *inventoryServiceImpl) DebitOnce(playerID string, idempotencyKey string, source string, details map[string]int) error {
+	return s.apply(playerID, user.TransactionDebit, idempotencyKey, source, details)
+}
 
-	if err := s.inventoryRepo.Save(inventory); err != nil {
+// apply changes the balance and logs the transaction in one ledger operation
+func (s *inventoryServiceImpl) apply(playerID string, txType user.TransactionType, idempotencyKey string, source string, details map[string]int) error {
+	uid, err := user.NewUserID(playerID)
+	if err != nil {
 		return err
 	}
 
-	txLog, err := user.NewTransactionLog(uuid.New().String(), uid, user.TransactionCredit, source, details, now)
+	txLog, err := user.NewTransactionLog(uuid.New().String(), uid, txType, source, details, time.Now().Unix())
 	if err != nil {
 		return err
 	}
 
-	return s.txRepo.Save(txLog)
+	err = s.ledger.Apply(txLog.WithIdempotencyKey(idempotencyKey))
+	if errors.Is(err, user.ErrDuplicateTransaction) {
+		return nil // Already applied by an earlier attempt
+	}
+	return err
 }
 
-// Debit removes resources from a player's inventory and logs the transaction
-func (s *inventoryServiceImpl) Debit(playerID string, source string, details map[string]int) error {
+// ExchangeInTx debits cost and credits rewards within the caller's transaction, so both commit
+// together with the caller's own writes. Unlike the *Once methods it returns
+// user.ErrDuplicateTransaction on a retry, letting the caller return the earlier result.
+func (s *inventoryServiceImpl) ExchangeInTx(tx *sql.Tx, playerID string, idempotencyKey string, source string, cost map[string]int, rewards map[string]int) error {
 	uid, err := user.NewUserID(playerID)
 	if err != nil {
 		return err
 	}
 
-	inventory, err := s.inventoryRepo.FindByPlayerID(uid)
-	if err != nil {
-		return err
-	}
-
 	now := time.Now().Unix()
-
-	if err := inventory.DebitMultiple(details, now); err != nil {
+	debit, err := user.NewTransactionLog(uuid.New().String(), uid, user.TransactionDebit, source, cost, now)
+	if err != nil {
 		return err
 	}
-
-	if err := s.inventoryRepo.Save(inventory); err != nil {
+	credit, err := user.NewTransactionLog(uuid.New().String(), uid, user.TransactionCredit, source, rewards, now)
+	if err != nil {
 		return err
 	}
 
-	txLog, err := user.NewTransactionLog(uuid.New().String(), uid, user.TransactionDebit, source, details, now)
-	if err != nil {
+	// The key goes on the debit: it is applied first, under the inventory lock
+	if err := s.ledger.ApplyInTx(tx, debit.WithIdempotencyKey(idempotencyKey)); err != nil {
 		return err
 	}
-
-	return s.txRepo.Save(txLog)
+	return s.ledger.ApplyInTx(tx, credit)
 }
@@ -0,0 +1,188 @@
+package user
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// mockInventoryLedger is an in-memory InventoryLedger
+type mockInventoryLedger struct {
+	inventories map[string]*user.Inventory
+	history     map[string][]user.TransactionLog
+	keys        map[string]bool // playerID + "/" + idempotency key
+}
+
+func newMockInventoryLedger() *mockInventoryLedger {
+	return &mockInventoryLedger{
+		inventories: make(map[string]*user.Inventory),
+		history:     make(map[string][]user.TransactionLog),
+		keys:        make(map[string]bool),
+	}
+}
+
+func (m *mockInventoryLedger) inventory(playerID user.UserID) *user.Inventory {
+	inv, ok := m.inventories[playerID.String()]
+	if !ok {
+		inv, _ = user.NewInventory(playerID, 0)
+		m.inventories[playerID.String()] = inv
+	}
+	return inv
+}
+
+func (m *mockInventoryLedger) Apply(tx *user.TransactionLog) error {
+	key := tx.PlayerID().String() + "/" + tx.IdempotencyKey()
+	if tx.IdempotencyKey() != "" && m.keys[key] {
+		return user.ErrDuplicateTransaction
+	}
+	if err := m.inventory(tx.PlayerID()).Apply(tx); err != nil {
+		return err
+	}
+	if tx.IdempotencyKey() != "" {
+		m.keys[key] = true
+	}
+	m.history[tx.PlayerID().String()] = append(m.history[tx.PlayerID().String()], *tx)
+	return nil
+}
+
+func (m *mockInventoryLedger) ApplyInTx(_ *sql.Tx, tx *user.TransactionLog) error {
+	return m.Apply(tx)
+}
+
+func (m *mockInventoryLedger) Snapshot(playerID user.UserID) (*user.Inventory, []user.TransactionLog, error) {
+	inv := m.inventory(playerID)
+	copied := *inv
+	return &copied, m.history[playerID.String()], nil
+}
+
+func (m *mockInventoryLedger) Correct(read *user.Inventory, corrected *user.Inventory) error {
+	current := m.inventory(read.PlayerID())
+	if current.Coins() != read.Coins() || current.PvpTickets() != read.PvpTickets() {
+		return user.ErrInventoryChanged
+	}
+	copied := *corrected
+	m.inventories[read.PlayerID().String()] = &copied
+	return nil
+}
+
+func (m *mockInventoryLedger) FindPlayerIDs(afterID string, limit int) ([]user.UserID, error) {
+	var ids []string
+	for id := range m.inventories {
+		if id > afterID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	playerIDs := make([]user.UserID, 0, len(ids))
+	for _, id := range ids {
+		uid, _ := user.NewUserID(id)
+		playerIDs = append(playerIDs, uid)
+	}
+	return playerIDs, nil
+}
+
+// setBalance bypasses the ledger, the way a direct UPDATE would
+func (m *mockInventoryLedger) setBalance(playerID string, coins, pvpTickets int) {
+	uid, _ := user.NewUserID(playerID)
+	m.inventories[playerID] = user.ReconstructInventory(uid, coins, pvpTickets, 0, 0, 0, 0, 0)
+}
+
+func TestInventoryService_CreditOnceIgnoresRetries(t *testing.T) {
+	ledger := newMockInventoryLedger()
+	svc := NewInventoryService(nil, ledger)
+
+	for i := 0; i < 2; i++ {
+		if err := svc.CreditOnce("12345", "daily_chest:game-1", "daily_chest", map[string]int{user.ResourceCoins: 100}); err != nil {
+			t.Fatalf("CreditOnce #%d: %v", i+1, err)
+		}
+	}
+	if err := svc.CreditOnce("12345", "daily_chest:game-2", "daily_chest", map[string]int{user.ResourceCoins: 50}); err != nil {
+		t.Fatalf("CreditOnce(game-2): %v", err)
+	}
+
+	uid, _ := user.NewUserID("12345")
+	if coins := ledger.inventory(uid).Coins(); coins != 150 {
+		t.Errorf("coins = %d, want 150", coins)
+	}
+	if n := len(ledger.history["12345"]); n != 2 {
+		t.Errorf("logged %d transactions, want 2", n)
+	}
+}
+
+func TestInventoryService_DebitChecksBalance(t *testing.T) {
+	ledger := newMockInventoryLedger()
+	svc := NewInventoryService(nil, ledger)
+
+	err := svc.DebitOnce("12345", "pvp_entry:req-1", "pvp_entry", map[string]int{user.ResourcePvpTickets: 4})
+	if !errors.Is(err, user.ErrInsufficientBalance) {
+		t.Fatalf("DebitOnce = %v, want ErrInsufficientBalance", err)
+	}
+	if len(ledger.history["12345"]) != 0 {
+		t.Error("a rejected debit must not be logged")
+	}
+
+	// The key of a rejected debit stays usable
+	if err := svc.DebitOnce("12345", "pvp_entry:req-1", "pvp_entry", map[string]int{user.ResourcePvpTickets: 1}); err != nil {
+		t.Fatalf("DebitOnce: %v", err)
+	}
+	uid, _ := user.NewUserID("12345")
+	if tickets := ledger.inventory(uid).PvpTickets(); tickets != 2 {
+		t.Errorf("tickets = %d, want 2", tickets)
+	}
+}
+
+func TestReconcileInventory_ReportsAndFixesDrift(t *testing.T) {
+	ledger := newMockInventoryLedger()
+	svc := NewInventoryService(nil, ledger)
+	_ = svc.Credit("111", "welcome_bonus", map[string]int{user.ResourceCoins: 100})
+	_ = svc.Credit("222", "welcome_bonus", map[string]int{user.ResourceCoins: 100})
+	ledger.setBalance("222", 400, 3) // lost update / manual edit
+
+	uc := NewReconcileInventoryUseCase(ledger)
+
+	report, err := uc.Execute(ReconcileInventoryInput{})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if report.Checked != 2 || len(report.Drifts) != 1 || report.Fixed != 0 {
+		t.Fatalf("dry run report = %+v", report)
+	}
+	if d := report.Drifts[0]; d.PlayerID != "222" || d.Resource != user.ResourceCoins || d.Balance != 400 || d.Expected != 100 {
+		t.Errorf("drift = %+v", d)
+	}
+
+	report, err = uc.Execute(ReconcileInventoryInput{PlayerID: "222", Fix: true})
+	if err != nil {
+		t.Fatalf("Execute(fix): %v", err)
+	}
+	if report.Checked != 1 || report.Fixed != 1 {
+		t.Errorf("fix report = %+v", report)
+	}
+	uid, _ := user.NewUserID("222")
+	if coins := ledger.inventory(uid).Coins(); coins != 100 {
+		t.Errorf("coins after fix = %d, want 100", coins)
+	}
+}
+
+func TestReconcileInventory_NeverWritesNegativeBalance(t *testing.T) {
+	ledger := newMockInventoryLedger()
+	ledger.setBalance("111", 0, 0)
+	uid, _ := user.NewUserID("111")
+	debit, _ := user.NewTransactionLog("tx-1", uid, user.TransactionDebit, "pvp_entry", map[string]int{user.ResourcePvpTickets: 5}, 0)
+	ledger.history["111"] = append(ledger.history["111"], *debit) // a debit logged without its balance check
+
+	report, err := NewReconcileInventoryUseCase(ledger).Execute(ReconcileInventoryInput{Fix: true})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if report.Fixed != 0 || len(report.Skipped) != 1 || report.Skipped[0] != "111" {
+		t.Errorf("report = %+v", report)
+	}
+}
@@ -41,3 +41,24 @@ func ToUserListDTO(users []user.User) []UserDTO {
 	}
 	return dtos
 }
+
+// ToPremiumPlanDTO converts a premium plan to DTO
+func ToPremiumPlanDTO(plan user.PremiumPlan) PremiumPlanDTO {
+	return PremiumPlanDTO{
+		ID:           plan.ID,
+		Title:        plan.Title,
+		Description:  plan.Description,
+		PriceStars:   plan.PriceStars,
+		Days:         plan.Days,
+		Subscription: plan.Subscription,
+	}
+}
+
+// ToPremiumPlanDTOs converts premium plans to DTOs
+func ToPremiumPlanDTOs(plans []user.PremiumPlan) []PremiumPlanDTO {
+	dtos := make([]PremiumPlanDTO, 0, len(plans))
+	for _, plan := range plans {
+		dtos = append(dtos, ToPremiumPlanDTO(plan))
+	}
+	return dtos
+}
@@ -0,0 +1,216 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// TxManager provides database transaction support
+type TxManager interface {
+	RunInTx(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+// StarsInvoice is an invoice payable in Telegram Stars
+type StarsInvoice struct {
+	Title              string
+	Description        string
+	Payload            string
+	PriceStars         int
+	SubscriptionPeriod int64 // seconds; 0 for a one-time payment
+}
+
+// StarsPaymentGateway talks to the payments part of the Telegram Bot API.
+// Implementation is in infrastructure/telegram.
+type StarsPaymentGateway interface {
+	// CreateInvoiceLink creates a link the Mini App opens with Telegram.WebApp.openInvoice
+	CreateInvoiceLink(ctx context.Context, invoice StarsInvoice) (string, error)
+
+	// AnswerPreCheckoutQuery approves (ok) or declines a payment; Telegram needs an answer within 10 seconds
+	AnswerPreCheckoutQuery(ctx context.Context, queryID string, ok bool, errorMessage string) error
+}
+
+// starsSubscriptionPeriod is the only subscription period Telegram Stars supports (30 days)
+const starsSubscriptionPeriod = 30 * 24 * 60 * 60
+
+// ========================================
+// GetPremiumStatus Use Case
+// ========================================
+
+// GetPremiumStatusUseCase returns the player's premium status and the plans on sale
+type GetPremiumStatusUseCase struct {
+	premiumRepo user.PremiumRepository
+}
+
+func NewGetPremiumStatusUseCase(premiumRepo user.PremiumRepository) *GetPremiumStatusUseCase {
+	return &GetPremiumStatusUseCase{premiumRepo: premiumRepo}
+}
+
+func (uc *GetPremiumStatusUseCase) Execute(input GetPremiumStatusInput) (GetPremiumStatusOutput, error) {
+	playerID, err := user.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetPremiumStatusOutput{}, err
+	}
+
+	status, err := uc.premiumRepo.FindStatus(playerID)
+	if err != nil {
+		return GetPremiumStatusOutput{}, err
+	}
+
+	return GetPremiumStatusOutput{
+		IsPremium: status.IsActiveAt(time.Now().Unix()),
+		ExpiresAt: status.ExpiresAt(),
+		Plans:     ToPremiumPlanDTOs(user.PremiumPlans()),
+	}, nil
+}
+
+// ========================================
+// CreatePremiumInvoice Use Case
+// ========================================
+
+// CreatePremiumInvoiceUseCase creates a Telegram Stars invoice for a premium plan
+type CreatePremiumInvoiceUseCase struct {
+	gateway StarsPaymentGateway
+}
+
+func NewCreatePremiumInvoiceUseCase(gateway StarsPaymentGateway) *CreatePremiumInvoiceUseCase {
+	return &CreatePremiumInvoiceUseCase{gateway: gateway}
+}
+
+func (uc *CreatePremiumInvoiceUseCase) Execute(input CreatePremiumInvoiceInput) (CreatePremiumInvoiceOutput, error) {
+	playerID, err := user.NewUserID(input.PlayerID)
+	if err != nil {
+		return CreatePremiumInvoiceOutput{}, err
+	}
+
+	plan, err := user.FindPremiumPlan(input.PlanID)
+	if err != nil {
+		return CreatePremiumInvoiceOutput{}, err
+	}
+
+	invoice := StarsInvoice{
+		Title:       plan.Title,
+		Description: plan.Description,
+		Payload:     user.PremiumInvoicePayload(plan.ID, playerID),
+		PriceStars:  plan.PriceStars,
+	}
+	if plan.Subscription {
+		invoice.SubscriptionPeriod = starsSubscriptionPeriod
+	}
+
+	link, err := uc.gateway.CreateInvoiceLink(context.Background(), invoice)
+	if err != nil {
+		return CreatePremiumInvoiceOutput{}, fmt.Errorf("%w: %v", user.ErrPaymentUnavailable, err)
+	}
+
+	return CreatePremiumInvoiceOutput{
+		InvoiceLink: link,
+		Plan:        ToPremiumPlanDTO(plan),
+	}, nil
+}
+
+// ========================================
+// HandlePreCheckout Use Case
+// ========================================
+
+// HandlePreCheckoutUseCase approves a payment only if it pays exactly for a plan on sale,
+// on behalf of the player the invoice was created for
+type HandlePreCheckoutUseCase struct {
+	gateway StarsPaymentGateway
+}
+
+func NewHandlePreCheckoutUseCase(gateway StarsPaymentGateway) *HandlePreCheckoutUseCase {
+	return &HandlePreCheckoutUseCase{gateway: gateway}
+}
+
+// Execute answers the query; it only fails if the answer could not be sent
+func (uc *HandlePreCheckoutUseCase) Execute(input PreCheckoutInput) error {
+	ok, errorMessage := true, ""
+	if err := checkPremiumPayment(input.Payload, input.FromUserID, input.Currency, input.TotalAmount); err != nil {
+		log.Printf("[Premium] Declining pre-checkout %s from %s: %v", input.QueryID, input.FromUserID, err)
+		ok, errorMessage = false, "This offer is no longer available. Please reopen the shop and try again."
+	}
+
+	return uc.gateway.AnswerPreCheckoutQuery(context.Background(), input.QueryID, ok, errorMessage)
+}
+
+// ========================================
+// HandleSuccessfulPayment Use Case
+// ========================================
+
+// HandleSuccessfulPaymentUseCase extends the payer's premium. The payment record and the
+// new status commit together, and a redelivered update (same charge ID) changes nothing.
+type HandleSuccessfulPaymentUseCase struct {
+	premiumRepo user.PremiumRepository
+	txManager   TxManager
+}
+
+func NewHandleSuccessfulPaymentUseCase(premiumRepo user.PremiumRepository, txManager TxManager) *HandleSuccessfulPaymentUseCase {
+	return &HandleSuccessfulPaymentUseCase{
+		premiumRepo: premiumRepo,
+		txManager:   txManager,
+	}
+}
+
+func (uc *HandleSuccessfulPaymentUseCase) Execute(input SuccessfulPaymentInput) (SuccessfulPaymentOutput, error) {
+	// Telegram already charged the player: a mismatch here is logged loudly, not ignored
+	if err := checkPremiumPayment(input.Payload, input.FromUserID, input.Currency, input.TotalAmount); err != nil {
+		log.Printf("❌ [Premium] Successful payment %s does not match its invoice: %v", input.ChargeID, err)
+		return SuccessfulPaymentOutput{}, err
+	}
+	plan, playerID, _ := user.ParsePremiumInvoicePayload(input.Payload)
+
+	now := time.Now().Unix()
+	var status user.PremiumStatus
+
+	err := uc.txManager.RunInTx(context.Background(), func(tx *sql.Tx) error {
+		current, err := uc.premiumRepo.FindStatusForUpdate(tx, playerID)
+		if err != nil {
+			return err
+		}
+		status = current.Extend(now, plan.Duration())
+
+		payment, err := user.NewPremiumPayment(input.ChargeID, playerID, plan, input.IsRecurring, status.ExpiresAt(), now)
+		if err != nil {
+			return err
+		}
+		if err := uc.premiumRepo.SavePaymentInTx(tx, payment); err != nil {
+			return err
+		}
+
+		return uc.premiumRepo.SaveStatusInTx(tx, playerID, status)
+	})
+
+	if errors.Is(err, user.ErrDuplicatePremiumPayment) {
+		current, err := uc.premiumRepo.FindStatus(playerID)
+		if err != nil {
+			return SuccessfulPaymentOutput{}, err
+		}
+		return SuccessfulPaymentOutput{PlayerID: playerID.String(), ExpiresAt: current.ExpiresAt(), Duplicate: true}, nil
+	}
+	if err != nil {
+		return SuccessfulPaymentOutput{}, err
+	}
+
+	log.Printf("✅ [Premium] Player %s paid %d XTR for %s (recurring: %v), premium until %d",
+		playerID, plan.PriceStars, plan.ID, input.IsRecurring, status.ExpiresAt())
+
+	return SuccessfulPaymentOutput{PlayerID: playerID.String(), ExpiresAt: status.ExpiresAt()}, nil
+}
+
+// checkPremiumPayment verifies a payment against the plan and payer encoded in its invoice payload
+func checkPremiumPayment(payload string, fromUserID string, currency string, totalAmount int) error {
+	plan, playerID, err := user.ParsePremiumInvoicePayload(payload)
+	if err != nil {
+		return err
+	}
+	if playerID.String() != fromUserID {
+		return fmt.Errorf("%w: invoice of player %s paid by %s", user.ErrPaymentMismatch, playerID, fromUserID)
+	}
+	return plan.CheckPayment(currency, totalAmount)
+}
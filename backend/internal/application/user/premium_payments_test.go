@@ -0,0 +1,201 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// mockPremiumRepo is an in-memory PremiumRepository
+type mockPremiumRepo struct {
+	statuses map[string]user.PremiumStatus
+	payments map[string]*user.PremiumPayment // charge ID -> payment
+}
+
+func newMockPremiumRepo() *mockPremiumRepo {
+	return &mockPremiumRepo{
+		statuses: make(map[string]user.PremiumStatus),
+		payments: make(map[string]*user.PremiumPayment),
+	}
+}
+
+func (m *mockPremiumRepo) FindStatus(playerID user.UserID) (user.PremiumStatus, error) {
+	return m.statuses[playerID.String()], nil
+}
+
+func (m *mockPremiumRepo) FindStatusForUpdate(_ *sql.Tx, playerID user.UserID) (user.PremiumStatus, error) {
+	return m.FindStatus(playerID)
+}
+
+func (m *mockPremiumRepo) SaveStatusInTx(_ *sql.Tx, playerID user.UserID, status user.PremiumStatus) error {
+	m.statuses[playerID.String()] = status
+	return nil
+}
+
+func (m *mockPremiumRepo) SavePaymentInTx(_ *sql.Tx, payment *user.PremiumPayment) error {
+	if _, ok := m.payments[payment.ChargeID()]; ok {
+		return user.ErrDuplicatePremiumPayment
+	}
+	m.payments[payment.ChargeID()] = payment
+	return nil
+}
+
+// mockTxManager executes the function directly without a real transaction
+type mockTxManager struct{}
+
+func (m *mockTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	return fn(nil)
+}
+
+// mockStarsGateway records the Bot API calls it receives
+type mockStarsGateway struct {
+	invoices []StarsInvoice
+	answers  map[string]bool // pre-checkout query ID -> ok
+}
+
+func (m *mockStarsGateway) CreateInvoiceLink(_ context.Context, invoice StarsInvoice) (string, error) {
+	m.invoices = append(m.invoices, invoice)
+	return "https://t.me/$invoice", nil
+}
+
+func (m *mockStarsGateway) AnswerPreCheckoutQuery(_ context.Context, queryID string, ok bool, _ string) error {
+	if m.answers == nil {
+		m.answers = make(map[string]bool)
+	}
+	m.answers[queryID] = ok
+	return nil
+}
+
+func TestCreatePremiumInvoice_SubscriptionPlan(t *testing.T) {
+	gateway := &mockStarsGateway{}
+
+	output, err := NewCreatePremiumInvoiceUseCase(gateway).Execute(CreatePremiumInvoiceInput{PlayerID: "12345", PlanID: "premium_month"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if output.InvoiceLink == "" || len(gateway.invoices) != 1 {
+		t.Fatalf("output = %+v, invoices = %d", output, len(gateway.invoices))
+	}
+	invoice := gateway.invoices[0]
+	if invoice.Payload != "premium:premium_month:12345" || invoice.PriceStars != 250 || invoice.SubscriptionPeriod != starsSubscriptionPeriod {
+		t.Errorf("invoice = %+v", invoice)
+	}
+
+	_, err = NewCreatePremiumInvoiceUseCase(gateway).Execute(CreatePremiumInvoiceInput{PlayerID: "12345", PlanID: "premium_forever"})
+	if !errors.Is(err, user.ErrUnknownPremiumPlan) {
+		t.Errorf("unknown plan err = %v, want ErrUnknownPremiumPlan", err)
+	}
+}
+
+func TestHandlePreCheckout_ApprovesOnlyMatchingPayments(t *testing.T) {
+	gateway := &mockStarsGateway{}
+	uc := NewHandlePreCheckoutUseCase(gateway)
+	payload := user.PremiumInvoicePayload("premium_week", mustUserID(t, "12345"))
+
+	tests := []struct {
+		queryID string
+		input   PreCheckoutInput
+		wantOK  bool
+	}{
+		{"ok", PreCheckoutInput{FromUserID: "12345", Currency: "XTR", TotalAmount: 75, Payload: payload}, true},
+		{"underpaid", PreCheckoutInput{FromUserID: "12345", Currency: "XTR", TotalAmount: 1, Payload: payload}, false},
+		{"other payer", PreCheckoutInput{FromUserID: "99999", Currency: "XTR", TotalAmount: 75, Payload: payload}, false},
+		{"foreign payload", PreCheckoutInput{FromUserID: "12345", Currency: "XTR", TotalAmount: 75, Payload: "shop:x"}, false},
+	}
+	for _, tt := range tests {
+		tt.input.QueryID = tt.queryID
+		if err := uc.Execute(tt.input); err != nil {
+			t.Fatalf("%s: Execute: %v", tt.queryID, err)
+		}
+		if ok, answered := gateway.answers[tt.queryID]; !answered || ok != tt.wantOK {
+			t.Errorf("%s: answered %v, ok %v; want ok %v", tt.queryID, answered, ok, tt.wantOK)
+		}
+	}
+}
+
+func TestHandleSuccessfulPayment_ExtendsPremiumOncePerCharge(t *testing.T) {
+	repo := newMockPremiumRepo()
+	uc := NewHandleSuccessfulPaymentUseCase(repo, &mockTxManager{})
+	premium := NewPremiumService(repo)
+	payment := SuccessfulPaymentInput{
+		FromUserID:  "12345",
+		Currency:    "XTR",
+		TotalAmount: 250,
+		Payload:     user.PremiumInvoicePayload("premium_month", mustUserID(t, "12345")),
+		ChargeID:    "charge-1",
+		IsRecurring: true,
+	}
+
+	if isPremium, _ := premium.IsPremium("12345"); isPremium {
+		t.Fatal("player is premium before paying")
+	}
+
+	first, err := uc.Execute(payment)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if isPremium, _ := premium.IsPremium("12345"); !isPremium {
+		t.Error("player is not premium after paying")
+	}
+	if days := (first.ExpiresAt - time.Now().Unix()) / 86400; days < 29 || days > 30 {
+		t.Errorf("premium lasts %d days, want 30", days)
+	}
+
+	// Telegram redelivers the update
+	again, err := uc.Execute(payment)
+	if err != nil || !again.Duplicate || again.ExpiresAt != first.ExpiresAt {
+		t.Fatalf("redelivery = %+v, err %v; want a duplicate with the same expiry", again, err)
+	}
+
+	// Monthly renewal adds to the remaining time
+	payment.ChargeID = "charge-2"
+	renewed, err := uc.Execute(payment)
+	if err != nil {
+		t.Fatalf("renewal: %v", err)
+	}
+	if renewed.ExpiresAt != first.ExpiresAt+30*86400 {
+		t.Errorf("renewal expires at %d, want %d", renewed.ExpiresAt, first.ExpiresAt+30*86400)
+	}
+}
+
+func TestHandleSuccessfulPayment_RejectsMismatchedPayment(t *testing.T) {
+	repo := newMockPremiumRepo()
+	uc := NewHandleSuccessfulPaymentUseCase(repo, &mockTxManager{})
+
+	_, err := uc.Execute(SuccessfulPaymentInput{
+		FromUserID:  "12345",
+		Currency:    "XTR",
+		TotalAmount: 1,
+		Payload:     user.PremiumInvoicePayload("premium_month", mustUserID(t, "12345")),
+		ChargeID:    "charge-1",
+	})
+	if !errors.Is(err, user.ErrPaymentMismatch) {
+		t.Fatalf("err = %v, want ErrPaymentMismatch", err)
+	}
+	if len(repo.payments) != 0 || len(repo.statuses) != 0 {
+		t.Error("a mismatched payment must not grant premium")
+	}
+}
+
+func TestPremiumService_ExpiredPremium(t *testing.T) {
+	repo := newMockPremiumRepo()
+	repo.statuses["12345"] = user.NewPremiumStatus(true, time.Now().Add(-time.Minute).Unix())
+
+	if isPremium, _ := NewPremiumService(repo).IsPremium("12345"); isPremium {
+		t.Error("expired premium must not count")
+	}
+}
+
+func mustUserID(t *testing.T, id string) user.UserID {
+	t.Helper()
+	uid, err := user.NewUserID(id)
+	if err != nil {
+		t.Fatalf("NewUserID(%q): %v", id, err)
+	}
+	return uid
+}
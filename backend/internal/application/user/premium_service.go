@@ -1,14 +1,44 @@
 package user
 
+import (
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
 // PremiumService checks whether a player has an active premium subscription.
 type PremiumService interface {
 	IsPremium(playerID string) (bool, error)
 }
 
 // NoopPremiumService always reports the player as a free user.
-// Used until a real billing integration is wired in.
+// Used when no database is available.
 type NoopPremiumService struct{}
 
 func (s *NoopPremiumService) IsPremium(_ string) (bool, error) {
 	return false, nil
 }
+
+type premiumServiceImpl struct {
+	premiumRepo user.PremiumRepository
+}
+
+// NewPremiumService creates a PremiumService backed by persisted premium statuses
+func NewPremiumService(premiumRepo user.PremiumRepository) PremiumService {
+	return &premiumServiceImpl{premiumRepo: premiumRepo}
+}
+
+// IsPremium reports whether the player's premium is active and not expired
+func (s *premiumServiceImpl) IsPremium(playerID string) (bool, error) {
+	uid, err := user.NewUserID(playerID)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := s.premiumRepo.FindStatus(uid)
+	if err != nil {
+		return false, err
+	}
+
+	return status.IsActiveAt(time.Now().Unix()), nil
+}
@@ -0,0 +1,117 @@
+package user
+
+import (
+	"errors"
+	"log"
+	"sort"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// reconcileBatchSize is how many players are loaded per page when reconciling everyone
+const reconcileBatchSize = 500
+
+// ========================================
+// ReconcileInventory Use Case
+// ========================================
+
+// ReconcileInventoryUseCase replays transaction logs against inventory balances
+// and reports (or, with Fix, corrects) the balances that drifted from their log
+type ReconcileInventoryUseCase struct {
+	ledger user.InventoryLedger
+}
+
+func NewReconcileInventoryUseCase(ledger user.InventoryLedger) *ReconcileInventoryUseCase {
+	return &ReconcileInventoryUseCase{ledger: ledger}
+}
+
+func (uc *ReconcileInventoryUseCase) Execute(input ReconcileInventoryInput) (ReconcileInventoryOutput, error) {
+	output := ReconcileInventoryOutput{
+		Drifts:  []InventoryDriftDTO{},
+		Skipped: []string{},
+	}
+
+	if input.PlayerID != "" {
+		playerID, err := user.NewUserID(input.PlayerID)
+		if err != nil {
+			return ReconcileInventoryOutput{}, err
+		}
+		if err := uc.reconcilePlayer(playerID, input.Fix, &output); err != nil {
+			return ReconcileInventoryOutput{}, err
+		}
+		return output, nil
+	}
+
+	afterID := ""
+	for {
+		playerIDs, err := uc.ledger.FindPlayerIDs(afterID, reconcileBatchSize)
+		if err != nil {
+			return ReconcileInventoryOutput{}, err
+		}
+		for _, playerID := range playerIDs {
+			if err := uc.reconcilePlayer(playerID, input.Fix, &output); err != nil {
+				return ReconcileInventoryOutput{}, err
+			}
+		}
+		if len(playerIDs) < reconcileBatchSize {
+			return output, nil
+		}
+		afterID = playerIDs[len(playerIDs)-1].String()
+	}
+}
+
+func (uc *ReconcileInventoryUseCase) reconcilePlayer(playerID user.UserID, fix bool, output *ReconcileInventoryOutput) error {
+	inventory, history, err := uc.ledger.Snapshot(playerID)
+	if err != nil {
+		return err
+	}
+	output.Checked++
+
+	expected := user.ReplayTransactions(playerID, history)
+	balances, expectedBalances := inventory.Balances(), expected.Balances()
+
+	resources := make([]string, 0, len(balances))
+	for resource := range balances {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	drifted, negative := false, false
+	for _, resource := range resources {
+		if expectedBalances[resource] < 0 {
+			negative = true
+		}
+		if balances[resource] == expectedBalances[resource] {
+			continue
+		}
+		drifted = true
+		output.Drifts = append(output.Drifts, InventoryDriftDTO{
+			PlayerID: playerID.String(),
+			Resource: resource,
+			Balance:  balances[resource],
+			Expected: expectedBalances[resource],
+		})
+	}
+
+	if !drifted || !fix {
+		return nil
+	}
+	if negative {
+		// A balance cannot go below zero: this one needs a look, not an overwrite
+		output.Skipped = append(output.Skipped, playerID.String())
+		return nil
+	}
+
+	err = uc.ledger.Correct(inventory, expected)
+	if errors.Is(err, user.ErrInventoryChanged) {
+		output.Skipped = append(output.Skipped, playerID.String())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[Inventory] Reconciled player %s to the transaction log", playerID.String())
+	output.Fixed++
+	return nil
+}
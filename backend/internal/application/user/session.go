@@ -0,0 +1,284 @@
+package user
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+const (
+	// AccessTokenTTL is how long an access token authenticates requests
+	AccessTokenTTL = 15 * time.Minute
+	// RefreshTokenTTL is how long a refresh token can mint new access tokens
+	RefreshTokenTTL = 30 * 24 * time.Hour
+
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+// ========================================
+// Session Tokens
+// ========================================
+
+// SessionTokenSigner signs and verifies session tokens.
+// A token is "<base64url claims>.<base64url HMAC-SHA256 of the claims>".
+// Tokens are stateless: revoking a user's sessions is done with User.Block,
+// which every verification checks.
+type SessionTokenSigner struct {
+	secret []byte
+}
+
+// NewSessionTokenSigner creates a signer keyed with the server secret
+func NewSessionTokenSigner(secret string) *SessionTokenSigner {
+	return &SessionTokenSigner{secret: []byte(secret)}
+}
+
+// sessionClaims is the signed token payload
+type sessionClaims struct {
+	Subject   string `json:"sub"`
+	Username  string `json:"name,omitempty"`
+	Type      string `json:"typ"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func (s *SessionTokenSigner) sign(claims sessionClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// verify checks the signature, type and expiry of a token
+func (s *SessionTokenSigner) verify(token, tokenType string, now int64) (sessionClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return sessionClaims{}, user.ErrInvalidSessionToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.mac(encoded)) {
+		return sessionClaims{}, user.ErrInvalidSessionToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return sessionClaims{}, user.ErrInvalidSessionToken
+	}
+
+	var claims sessionClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return sessionClaims{}, user.ErrInvalidSessionToken
+	}
+	if claims.Type != tokenType || claims.Subject == "" {
+		return sessionClaims{}, user.ErrInvalidSessionToken
+	}
+	if now >= claims.ExpiresAt {
+		return sessionClaims{}, user.ErrSessionExpired
+	}
+
+	return claims, nil
+}
+
+func (s *SessionTokenSigner) mac(encoded string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
+
+// issue signs a fresh access + refresh token pair
+func (s *SessionTokenSigner) issue(userID, username string, now time.Time) (SessionTokensDTO, error) {
+	accessExpiresAt := now.Add(AccessTokenTTL).Unix()
+	refreshExpiresAt := now.Add(RefreshTokenTTL).Unix()
+
+	accessToken, err := s.sign(sessionClaims{
+		Subject:   userID,
+		Username:  username,
+		Type:      tokenTypeAccess,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: accessExpiresAt,
+	})
+	if err != nil {
+		return SessionTokensDTO{}, err
+	}
+
+	refreshToken, err := s.sign(sessionClaims{
+		Subject:   userID,
+		Username:  username,
+		Type:      tokenTypeRefresh,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: refreshExpiresAt,
+	})
+	if err != nil {
+		return SessionTokensDTO{}, err
+	}
+
+	return SessionTokensDTO{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+		TokenType:        "Bearer",
+	}, nil
+}
+
+// ensureNotBlocked rejects blocked users.
+// Users that have not registered yet may still play, as with init data auth.
+func ensureNotBlocked(userRepo user.UserRepository, userID string) error {
+	_, err := findNotBlocked(userRepo, userID)
+	return err
+}
+
+// findNotBlocked loads a user that is not blocked; nil when they have not registered yet
+func findNotBlocked(userRepo user.UserRepository, userID string) (*user.User, error) {
+	id, err := user.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := userRepo.FindByID(id)
+	if err == user.ErrUserNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.IsBlocked() {
+		return nil, user.ErrUserBlocked
+	}
+	return u, nil
+}
+
+// SessionTokensDTO is an issued access + refresh token pair
+type SessionTokensDTO struct {
+	AccessToken      string `json:"accessToken"`
+	AccessExpiresAt  int64  `json:"accessExpiresAt"`
+	RefreshToken     string `json:"refreshToken"`
+	RefreshExpiresAt int64  `json:"refreshExpiresAt"`
+	TokenType        string `json:"tokenType"`
+}
+
+// ========================================
+// CreateSession Use Case
+// ========================================
+
+// CreateSessionInput is the identity taken from validated Telegram init data
+type CreateSessionInput struct {
+	UserID   string
+	Username string
+}
+
+// CreateSessionUseCase exchanges validated Telegram init data for session tokens
+type CreateSessionUseCase struct {
+	userRepo user.UserRepository
+	signer   *SessionTokenSigner
+}
+
+// NewCreateSessionUseCase creates a new CreateSessionUseCase
+func NewCreateSessionUseCase(userRepo user.UserRepository, signer *SessionTokenSigner) *CreateSessionUseCase {
+	return &CreateSessionUseCase{
+		userRepo: userRepo,
+		signer:   signer,
+	}
+}
+
+// Execute issues a token pair for a user that is not blocked
+func (uc *CreateSessionUseCase) Execute(input CreateSessionInput) (SessionTokensDTO, error) {
+	if err := ensureNotBlocked(uc.userRepo, input.UserID); err != nil {
+		return SessionTokensDTO{}, err
+	}
+
+	return uc.signer.issue(input.UserID, input.Username, time.Now())
+}
+
+// ========================================
+// RefreshSession Use Case
+// ========================================
+
+// RefreshSessionInput is the input DTO for RefreshSession use case
+type RefreshSessionInput struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshSessionUseCase trades a refresh token for a new token pair
+type RefreshSessionUseCase struct {
+	userRepo user.UserRepository
+	signer   *SessionTokenSigner
+}
+
+// NewRefreshSessionUseCase creates a new RefreshSessionUseCase
+func NewRefreshSessionUseCase(userRepo user.UserRepository, signer *SessionTokenSigner) *RefreshSessionUseCase {
+	return &RefreshSessionUseCase{
+		userRepo: userRepo,
+		signer:   signer,
+	}
+}
+
+// Execute verifies the refresh token and issues a new pair
+func (uc *RefreshSessionUseCase) Execute(input RefreshSessionInput) (SessionTokensDTO, error) {
+	now := time.Now()
+	claims, err := uc.signer.verify(input.RefreshToken, tokenTypeRefresh, now.Unix())
+	if err != nil {
+		return SessionTokensDTO{}, err
+	}
+
+	if err := ensureNotBlocked(uc.userRepo, claims.Subject); err != nil {
+		return SessionTokensDTO{}, err
+	}
+
+	return uc.signer.issue(claims.Subject, claims.Username, now)
+}
+
+// ========================================
+// VerifySession Use Case
+// ========================================
+
+// VerifySessionOutput is the principal behind a valid access token
+type VerifySessionOutput struct {
+	UserID       string
+	Username     string
+	LanguageCode string // the user's language preference; empty before registration
+}
+
+// VerifySessionUseCase authenticates a request by its access token
+type VerifySessionUseCase struct {
+	userRepo user.UserRepository
+	signer   *SessionTokenSigner
+}
+
+// NewVerifySessionUseCase creates a new VerifySessionUseCase
+func NewVerifySessionUseCase(userRepo user.UserRepository, signer *SessionTokenSigner) *VerifySessionUseCase {
+	return &VerifySessionUseCase{
+		userRepo: userRepo,
+		signer:   signer,
+	}
+}
+
+// Execute verifies the access token and that its user has not been blocked since it was issued
+func (uc *VerifySessionUseCase) Execute(accessToken string) (VerifySessionOutput, error) {
+	claims, err := uc.signer.verify(accessToken, tokenTypeAccess, time.Now().Unix())
+	if err != nil {
+		return VerifySessionOutput{}, err
+	}
+
+	u, err := findNotBlocked(uc.userRepo, claims.Subject)
+	if err != nil {
+		return VerifySessionOutput{}, err
+	}
+
+	output := VerifySessionOutput{
+		UserID:   claims.Subject,
+		Username: claims.Username,
+	}
+	if u != nil {
+		output.LanguageCode = u.LanguageCode().String()
+	}
+	return output, nil
+}
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// mockUserRepo is an in-memory UserRepository for session tests
+type mockUserRepo struct {
+	users map[string]*user.User
+}
+
+func newMockUserRepo() *mockUserRepo {
+	return &mockUserRepo{users: make(map[string]*user.User)}
+}
+
+func (m *mockUserRepo) FindByID(id user.UserID) (*user.User, error) {
+	u, ok := m.users[id.String()]
+	if !ok {
+		return nil, user.ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (m *mockUserRepo) FindByTelegramUsername(_ user.TelegramUsername) (*user.User, error) {
+	return nil, user.ErrUserNotFound
+}
+func (m *mockUserRepo) FindAll(_, _ int) ([]user.User, error) { return nil, nil }
+func (m *mockUserRepo) Save(u *user.User) error               { m.users[u.ID().String()] = u; return nil }
+func (m *mockUserRepo) Delete(_ user.UserID) error            { return nil }
+func (m *mockUserRepo) Exists(id user.UserID) (bool, error) {
+	_, ok := m.users[id.String()]
+	return ok, nil
+}
+
+func newSessionFixture(t *testing.T) (*mockUserRepo, *CreateSessionUseCase, *RefreshSessionUseCase, *VerifySessionUseCase) {
+	t.Helper()
+	repo := newMockUserRepo()
+	id, _ := user.NewUserID("12345")
+	name, _ := user.NewUsername("Alice")
+	u, err := user.NewUser(id, name, time.Now().Unix())
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	_ = repo.Save(u)
+
+	signer := NewSessionTokenSigner("test-secret")
+	return repo,
+		NewCreateSessionUseCase(repo, signer),
+		NewRefreshSessionUseCase(repo, signer),
+		NewVerifySessionUseCase(repo, signer)
+}
+
+func TestSession_CreateAndVerify(t *testing.T) {
+	_, createUC, _, verifyUC := newSessionFixture(t)
+
+	tokens, err := createUC.Execute(CreateSessionInput{UserID: "12345", Username: "alice"})
+	if err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if tokens.TokenType != "Bearer" {
+		t.Errorf("TokenType = %q, want Bearer", tokens.TokenType)
+	}
+	if tokens.RefreshExpiresAt <= tokens.AccessExpiresAt {
+		t.Error("refresh token should outlive the access token")
+	}
+
+	principal, err := verifyUC.Execute(tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("VerifySession: %v", err)
+	}
+	if principal.UserID != "12345" || principal.Username != "alice" {
+		t.Errorf("principal = %+v, want 12345/alice", principal)
+	}
+
+	// A refresh token is not an access token
+	if _, err := verifyUC.Execute(tokens.RefreshToken); err != user.ErrInvalidSessionToken {
+		t.Errorf("refresh token as access: got %v, want ErrInvalidSessionToken", err)
+	}
+}
+
+func TestSession_RejectsTamperedAndForeignTokens(t *testing.T) {
+	_, createUC, _, verifyUC := newSessionFixture(t)
+	tokens, _ := createUC.Execute(CreateSessionInput{UserID: "12345"})
+
+	payload, signature, _ := strings.Cut(tokens.AccessToken, ".")
+	forged, _ := NewSessionTokenSigner("other-secret").sign(sessionClaims{
+		Subject:   "12345",
+		Type:      tokenTypeAccess,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+
+	for name, token := range map[string]string{
+		"tampered payload":  payload + "x." + signature,
+		"missing signature": payload,
+		"other secret":      forged,
+		"garbage":           "not-a-token",
+	} {
+		if _, err := verifyUC.Execute(token); err != user.ErrInvalidSessionToken {
+			t.Errorf("%s: got %v, want ErrInvalidSessionToken", name, err)
+		}
+	}
+}
+
+func TestSession_Expired(t *testing.T) {
+	signer := NewSessionTokenSigner("test-secret")
+	issuedAt := time.Now().Add(-AccessTokenTTL - time.Minute)
+	tokens, err := signer.issue("12345", "", issuedAt)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+
+	if _, err := signer.verify(tokens.AccessToken, tokenTypeAccess, time.Now().Unix()); err != user.ErrSessionExpired {
+		t.Errorf("expired access token: got %v, want ErrSessionExpired", err)
+	}
+	if _, err := signer.verify(tokens.RefreshToken, tokenTypeRefresh, time.Now().Unix()); err != nil {
+		t.Errorf("refresh token should still be valid: %v", err)
+	}
+}
+
+func TestSession_BlockRevokes(t *testing.T) {
+	repo, createUC, refreshUC, verifyUC := newSessionFixture(t)
+	tokens, _ := createUC.Execute(CreateSessionInput{UserID: "12345"})
+
+	refreshed, err := refreshUC.Execute(RefreshSessionInput{RefreshToken: tokens.RefreshToken})
+	if err != nil {
+		t.Fatalf("RefreshSession: %v", err)
+	}
+	if _, err := verifyUC.Execute(refreshed.AccessToken); err != nil {
+		t.Fatalf("refreshed access token should verify: %v", err)
+	}
+
+	id, _ := user.NewUserID("12345")
+	u, _ := repo.FindByID(id)
+	_ = u.Block(time.Now().Unix())
+
+	if _, err := verifyUC.Execute(tokens.AccessToken); err != user.ErrUserBlocked {
+		t.Errorf("access after block: got %v, want ErrUserBlocked", err)
+	}
+	if _, err := refreshUC.Execute(RefreshSessionInput{RefreshToken: tokens.RefreshToken}); err != user.ErrUserBlocked {
+		t.Errorf("refresh after block: got %v, want ErrUserBlocked", err)
+	}
+	if _, err := createUC.Execute(CreateSessionInput{UserID: "12345"}); err != user.ErrUserBlocked {
+		t.Errorf("create after block: got %v, want ErrUserBlocked", err)
+	}
+}
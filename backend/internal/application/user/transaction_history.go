@@ -0,0 +1,158 @@
+package user
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+const (
+	defaultTransactionPageSize = 20
+	maxTransactionPageSize     = 100
+	defaultSummaryDays         = 30
+	maxSummaryDays             = 90
+	maxTransactionSourceLength = 64
+)
+
+// ========================================
+// GetTransactionHistory Use Case
+// ========================================
+
+// GetTransactionHistoryUseCase pages through a player's transaction log and
+// totals it per day, so players (and support) can see where resources went
+type GetTransactionHistoryUseCase struct {
+	txRepo user.TransactionRepository
+}
+
+func NewGetTransactionHistoryUseCase(txRepo user.TransactionRepository) *GetTransactionHistoryUseCase {
+	return &GetTransactionHistoryUseCase{txRepo: txRepo}
+}
+
+func (uc *GetTransactionHistoryUseCase) Execute(input GetTransactionHistoryInput) (GetTransactionHistoryOutput, error) {
+	// 1. Validate input
+	playerID, err := user.NewUserID(input.PlayerID)
+	if err != nil {
+		return GetTransactionHistoryOutput{}, err
+	}
+	if input.Resource != "" && !user.IsValidResource(input.Resource) {
+		return GetTransactionHistoryOutput{}, user.ErrInvalidResource
+	}
+	if len(input.Source) > maxTransactionSourceLength {
+		return GetTransactionHistoryOutput{}, user.ErrInvalidTransactionSource
+	}
+
+	var after *user.TransactionCursor
+	if input.Cursor != "" {
+		if after, err = decodeTransactionCursor(input.Cursor); err != nil {
+			return GetTransactionHistoryOutput{}, err
+		}
+	}
+
+	limit := input.Limit
+	if limit <= 0 || limit > maxTransactionPageSize {
+		limit = defaultTransactionPageSize
+	}
+
+	filter := user.TransactionFilter{
+		PlayerID: playerID,
+		Resource: input.Resource,
+		Source:   input.Source,
+	}
+
+	// 2. Load one extra transaction to know whether another page follows
+	transactions, err := uc.txRepo.FindPage(filter, after, limit+1)
+	if err != nil {
+		return GetTransactionHistoryOutput{}, err
+	}
+
+	output := GetTransactionHistoryOutput{
+		Transactions: make([]TransactionDTO, 0, limit),
+	}
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+		last := transactions[limit-1]
+		output.NextCursor = encodeTransactionCursor(user.TransactionCursor{CreatedAt: last.CreatedAt(), ID: last.ID()})
+	}
+	for _, tx := range transactions {
+		output.Transactions = append(output.Transactions, toTransactionDTO(tx))
+	}
+
+	// 3. Daily summary, with the first page only
+	if after == nil {
+		days := input.Days
+		if days <= 0 || days > maxSummaryDays {
+			days = defaultSummaryDays
+		}
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		since := today.AddDate(0, 0, -(days - 1)).Unix()
+
+		totals, err := uc.txRepo.SummarizeByDay(filter, since)
+		if err != nil {
+			return GetTransactionHistoryOutput{}, err
+		}
+		output.Summary = toTransactionDaySummaries(totals)
+	}
+
+	return output, nil
+}
+
+func toTransactionDTO(tx user.TransactionLog) TransactionDTO {
+	return TransactionDTO{
+		ID:        tx.ID(),
+		Type:      string(tx.Type()),
+		Source:    tx.Source(),
+		Details:   tx.Details(),
+		CreatedAt: tx.CreatedAt(),
+	}
+}
+
+// toTransactionDaySummaries groups per-resource totals (newest day first) by day
+func toTransactionDaySummaries(totals []user.DailyResourceTotal) []TransactionDaySummaryDTO {
+	summaries := []TransactionDaySummaryDTO{}
+	for _, total := range totals {
+		if len(summaries) == 0 || summaries[len(summaries)-1].Date != total.Day {
+			summaries = append(summaries, TransactionDaySummaryDTO{
+				Date:      total.Day,
+				Resources: make(map[string]ResourceTotalsDTO),
+			})
+		}
+		summaries[len(summaries)-1].Resources[total.Resource] = ResourceTotalsDTO{
+			Credited: total.Credited,
+			Debited:  total.Debited,
+			Net:      total.Credited - total.Debited,
+		}
+	}
+	return summaries
+}
+
+// encodeTransactionCursor makes an opaque cursor from a log position
+func encodeTransactionCursor(cursor user.TransactionCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt, 10) + ":" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeTransactionCursor(encoded string) (*user.TransactionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, user.ErrInvalidTransactionCursor
+	}
+
+	createdAtStr, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, user.ErrInvalidTransactionCursor
+	}
+	createdAt, err := strconv.ParseInt(createdAtStr, 10, 64)
+	if err != nil {
+		return nil, user.ErrInvalidTransactionCursor
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, user.ErrInvalidTransactionCursor
+	}
+
+	return &user.TransactionCursor{CreatedAt: createdAt, ID: id}, nil
+}
@@ -0,0 +1,194 @@
+package user
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// mockTransactionRepo is an in-memory TransactionRepository
+type mockTransactionRepo struct {
+	txs []user.TransactionLog
+}
+
+func (m *mockTransactionRepo) Save(tx *user.TransactionLog) error {
+	m.txs = append(m.txs, *tx)
+	return nil
+}
+
+func (m *mockTransactionRepo) FindByPlayer(playerID user.UserID, limit int) ([]user.TransactionLog, error) {
+	return m.FindPage(user.TransactionFilter{PlayerID: playerID}, nil, limit)
+}
+
+func (m *mockTransactionRepo) matching(filter user.TransactionFilter) []user.TransactionLog {
+	var result []user.TransactionLog
+	for _, tx := range m.txs {
+		if tx.PlayerID() != filter.PlayerID {
+			continue
+		}
+		if filter.Source != "" && tx.Source() != filter.Source {
+			continue
+		}
+		if _, ok := tx.Details()[filter.Resource]; filter.Resource != "" && !ok {
+			continue
+		}
+		result = append(result, tx)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CreatedAt() != result[j].CreatedAt() {
+			return result[i].CreatedAt() > result[j].CreatedAt()
+		}
+		return result[i].ID() > result[j].ID()
+	})
+	return result
+}
+
+func (m *mockTransactionRepo) FindPage(filter user.TransactionFilter, after *user.TransactionCursor, limit int) ([]user.TransactionLog, error) {
+	var page []user.TransactionLog
+	for _, tx := range m.matching(filter) {
+		if after != nil && (tx.CreatedAt() > after.CreatedAt || (tx.CreatedAt() == after.CreatedAt && tx.ID() >= after.ID)) {
+			continue
+		}
+		if len(page) == limit {
+			break
+		}
+		page = append(page, tx)
+	}
+	return page, nil
+}
+
+func (m *mockTransactionRepo) SummarizeByDay(filter user.TransactionFilter, since int64) ([]user.DailyResourceTotal, error) {
+	var totals []user.DailyResourceTotal
+	index := map[string]int{}
+	for _, tx := range m.matching(filter) {
+		if tx.CreatedAt() < since {
+			continue
+		}
+		day := time.Unix(tx.CreatedAt(), 0).UTC().Format("2006-01-02")
+		for resource, amount := range tx.Details() {
+			if filter.Resource != "" && resource != filter.Resource {
+				continue
+			}
+			key := day + "/" + resource
+			i, ok := index[key]
+			if !ok {
+				i = len(totals)
+				index[key] = i
+				totals = append(totals, user.DailyResourceTotal{Day: day, Resource: resource})
+			}
+			if tx.Type() == user.TransactionCredit {
+				totals[i].Credited += amount
+			} else {
+				totals[i].Debited += amount
+			}
+		}
+	}
+	return totals, nil
+}
+
+func seedTransaction(t *testing.T, repo *mockTransactionRepo, playerID string, txType user.TransactionType, source string, details map[string]int, createdAt time.Time) {
+	t.Helper()
+	uid, _ := user.NewUserID(playerID)
+	tx, err := user.NewTransactionLog(uuid.NewString(), uid, txType, source, details, createdAt.Unix())
+	if err != nil {
+		t.Fatalf("NewTransactionLog: %v", err)
+	}
+	_ = repo.Save(tx)
+}
+
+func TestGetTransactionHistory_PagesWithCursor(t *testing.T) {
+	repo := &mockTransactionRepo{}
+	now := time.Now().UTC()
+	for i := 0; i < 5; i++ {
+		seedTransaction(t, repo, "12345", user.TransactionCredit, "pvp_win", map[string]int{user.ResourceCoins: 10}, now.Add(-time.Duration(i)*time.Minute))
+	}
+	seedTransaction(t, repo, "99999", user.TransactionCredit, "pvp_win", map[string]int{user.ResourceCoins: 10}, now)
+
+	uc := NewGetTransactionHistoryUseCase(repo)
+
+	seen := map[string]bool{}
+	cursor := ""
+	pages := 0
+	for {
+		output, err := uc.Execute(GetTransactionHistoryInput{PlayerID: "12345", Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+		pages++
+		if (pages == 1) != (output.Summary != nil) {
+			t.Errorf("page %d: summary = %v, want it on the first page only", pages, output.Summary)
+		}
+		for _, tx := range output.Transactions {
+			if seen[tx.ID] {
+				t.Errorf("transaction %s returned twice", tx.ID)
+			}
+			seen[tx.ID] = true
+		}
+		if output.NextCursor == "" {
+			break
+		}
+		cursor = output.NextCursor
+	}
+
+	if pages != 3 || len(seen) != 5 {
+		t.Errorf("got %d transactions in %d pages, want 5 in 3", len(seen), pages)
+	}
+}
+
+func TestGetTransactionHistory_FiltersAndSummarizes(t *testing.T) {
+	repo := &mockTransactionRepo{}
+	now := time.Now().UTC()
+	yesterday := now.AddDate(0, 0, -1)
+	seedTransaction(t, repo, "12345", user.TransactionCredit, "daily_chest", map[string]int{user.ResourceCoins: 100, user.ResourcePvpTickets: 1}, now)
+	seedTransaction(t, repo, "12345", user.TransactionDebit, "streak_recovery", map[string]int{user.ResourceCoins: 30}, now)
+	seedTransaction(t, repo, "12345", user.TransactionDebit, "pvp_entry", map[string]int{user.ResourcePvpTickets: 1}, yesterday)
+
+	uc := NewGetTransactionHistoryUseCase(repo)
+
+	output, err := uc.Execute(GetTransactionHistoryInput{PlayerID: "12345", Resource: user.ResourceCoins})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(output.Transactions) != 2 {
+		t.Fatalf("coins transactions = %d, want 2", len(output.Transactions))
+	}
+	if len(output.Summary) != 1 {
+		t.Fatalf("summary days = %d, want 1", len(output.Summary))
+	}
+	if coins := output.Summary[0].Resources[user.ResourceCoins]; coins.Credited != 100 || coins.Debited != 30 || coins.Net != 70 {
+		t.Errorf("coins summary = %+v", coins)
+	}
+
+	output, _ = uc.Execute(GetTransactionHistoryInput{PlayerID: "12345", Source: "pvp_entry"})
+	if len(output.Transactions) != 1 || output.Transactions[0].Type != "debit" {
+		t.Errorf("pvp_entry transactions = %+v", output.Transactions)
+	}
+}
+
+func TestGetTransactionHistory_RejectsInvalidInput(t *testing.T) {
+	uc := NewGetTransactionHistoryUseCase(&mockTransactionRepo{})
+
+	tests := []struct {
+		name    string
+		input   GetTransactionHistoryInput
+		wantErr error
+	}{
+		{"unknown resource", GetTransactionHistoryInput{PlayerID: "12345", Resource: "gems"}, user.ErrInvalidResource},
+		{"garbage cursor", GetTransactionHistoryInput{PlayerID: "12345", Cursor: "!!"}, user.ErrInvalidTransactionCursor},
+		{"cursor without UUID", GetTransactionHistoryInput{PlayerID: "12345", Cursor: encodeTransactionCursor(user.TransactionCursor{CreatedAt: 1, ID: "x"})}, user.ErrInvalidTransactionCursor},
+		{"missing player", GetTransactionHistoryInput{}, shared.ErrInvalidUserID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Execute(tt.input); !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package ad_reward
+
+import "errors"
+
+// Domain errors for rewarded ads
+var (
+	ErrInvalidNonce             = errors.New("invalid ad reward nonce")
+	ErrInvalidPlacement         = errors.New("invalid ad placement")
+	ErrInvalidTransactionID     = errors.New("invalid ad network transaction ID")
+	ErrNonceNotFound            = errors.New("ad reward nonce not found")
+	ErrNonceExpired             = errors.New("ad reward nonce expired")
+	ErrNonceAlreadyRedeemed     = errors.New("ad reward nonce already redeemed")
+	ErrNonceAlreadyUsed         = errors.New("ad reward already used")
+	ErrNonceMismatch            = errors.New("ad reward nonce belongs to another player or placement")
+	ErrAdNotWatched             = errors.New("ad network has not confirmed the ad view")
+	ErrNonceStateChanged        = errors.New("ad reward nonce changed concurrently")
+	ErrDailyCapReached          = errors.New("daily ad reward limit reached")
+	ErrInvalidCallbackSignature = errors.New("invalid ad network callback signature")
+)
@@ -0,0 +1,18 @@
+package ad_reward
+
+// Repository defines the interface for reward nonce persistence
+type Repository interface {
+	// Save inserts a newly issued nonce
+	Save(nonce *RewardNonce) error
+
+	// FindByID retrieves a nonce
+	// Returns ErrNonceNotFound if it does not exist
+	FindByID(id NonceID) (*RewardNonce, error)
+
+	// UpdateFrom persists a state change of the nonce, provided it is still in the status
+	// it had when loaded. Returns ErrNonceStateChanged otherwise, so a nonce is consumed once.
+	UpdateFrom(nonce *RewardNonce, loadedStatus NonceStatus) error
+
+	// CountConsumedSince counts the player's rewards of a placement consumed since the given time
+	CountConsumedSince(playerID UserID, placement Placement, since int64) (int, error)
+}
@@ -0,0 +1,125 @@
+package ad_reward
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+
+const (
+	// WatchTTL is how long after issuance the ad network's callback is accepted (seconds)
+	WatchTTL = 30 * 60
+	// UseTTL is how long after issuance a confirmed reward can be spent (seconds)
+	UseTTL = 24 * 60 * 60
+)
+
+// RewardNonce is a one-time rewarded-ad token.
+// Issued to a player for a placement, redeemed by the ad network's signed server-to-server
+// callback once the ad was watched, then consumed exactly once by the game feature it pays for.
+type RewardNonce struct {
+	id            NonceID
+	playerID      UserID
+	placement     Placement
+	status        NonceStatus
+	transactionID string // ad network's ID of the rewarded view
+	issuedAt      int64
+	redeemedAt    int64
+	consumedAt    int64
+}
+
+// NewRewardNonce issues a nonce for the player and placement
+func NewRewardNonce(playerID UserID, placement Placement, now int64) (*RewardNonce, error) {
+	if playerID.IsZero() {
+		return nil, shared.ErrInvalidUserID
+	}
+	if _, err := NewPlacement(placement.String()); err != nil {
+		return nil, err
+	}
+
+	return &RewardNonce{
+		id:        NewNonceID(),
+		playerID:  playerID,
+		placement: placement,
+		status:    NonceIssued,
+		issuedAt:  now,
+	}, nil
+}
+
+func ReconstructRewardNonce(
+	id NonceID,
+	playerID UserID,
+	placement Placement,
+	status NonceStatus,
+	transactionID string,
+	issuedAt int64,
+	redeemedAt int64,
+	consumedAt int64,
+) *RewardNonce {
+	return &RewardNonce{
+		id:            id,
+		playerID:      playerID,
+		placement:     placement,
+		status:        status,
+		transactionID: transactionID,
+		issuedAt:      issuedAt,
+		redeemedAt:    redeemedAt,
+		consumedAt:    consumedAt,
+	}
+}
+
+// Redeem records the ad network's confirmation that the player watched the ad
+func (n *RewardNonce) Redeem(playerID UserID, transactionID string, now int64) error {
+	if transactionID == "" {
+		return ErrInvalidTransactionID
+	}
+	if !n.playerID.Equals(playerID) {
+		return ErrNonceMismatch
+	}
+	if n.status != NonceIssued {
+		return ErrNonceAlreadyRedeemed
+	}
+	if now > n.issuedAt+WatchTTL {
+		return ErrNonceExpired
+	}
+
+	n.status = NonceRedeemed
+	n.transactionID = transactionID
+	n.redeemedAt = now
+	return nil
+}
+
+// IsRedemptionOf reports whether the nonce was already redeemed by this ad network transaction
+// (networks retry callbacks until they get a success response)
+func (n *RewardNonce) IsRedemptionOf(transactionID string) bool {
+	return n.status != NonceIssued && n.transactionID == transactionID
+}
+
+// Consume spends the confirmed reward on its placement
+func (n *RewardNonce) Consume(playerID UserID, placement Placement, now int64) error {
+	if !n.playerID.Equals(playerID) || n.placement != placement {
+		return ErrNonceMismatch
+	}
+	switch n.status {
+	case NonceIssued:
+		return ErrAdNotWatched
+	case NonceConsumed:
+		return ErrNonceAlreadyUsed
+	}
+	if now > n.issuedAt+UseTTL {
+		return ErrNonceExpired
+	}
+
+	n.status = NonceConsumed
+	n.consumedAt = now
+	return nil
+}
+
+func (n *RewardNonce) ID() NonceID           { return n.id }
+func (n *RewardNonce) PlayerID() UserID      { return n.playerID }
+func (n *RewardNonce) Placement() Placement  { return n.placement }
+func (n *RewardNonce) Status() NonceStatus   { return n.status }
+func (n *RewardNonce) TransactionID() string { return n.transactionID }
+func (n *RewardNonce) IssuedAt() int64       { return n.issuedAt }
+func (n *RewardNonce) RedeemedAt() int64     { return n.redeemedAt }
+func (n *RewardNonce) ConsumedAt() int64     { return n.consumedAt }
+
+// ExpiresAt returns until when the ad network's callback is accepted
+func (n *RewardNonce) ExpiresAt() int64 {
+	return n.issuedAt + WatchTTL
+}
@@ -0,0 +1,92 @@
+package ad_reward
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+func newTestNonce(t *testing.T, placement Placement, now int64) *RewardNonce {
+	t.Helper()
+	playerID, _ := shared.NewUserID("12345")
+	nonce, err := NewRewardNonce(playerID, placement, now)
+	if err != nil {
+		t.Fatalf("NewRewardNonce: %v", err)
+	}
+	return nonce
+}
+
+func TestNewRewardNonce_IssuesUniqueNonces(t *testing.T) {
+	a := newTestNonce(t, PlacementDailyRetry, 1000)
+	b := newTestNonce(t, PlacementDailyRetry, 1000)
+
+	if a.ID() == b.ID() {
+		t.Error("two issued nonces share an ID")
+	}
+	if _, err := NewNonceIDFromString(a.ID().String()); err != nil {
+		t.Errorf("issued nonce %q does not parse: %v", a.ID(), err)
+	}
+	if a.Status() != NonceIssued || a.ExpiresAt() != 1000+WatchTTL {
+		t.Errorf("status = %s, expiresAt = %d", a.Status(), a.ExpiresAt())
+	}
+
+	playerID, _ := shared.NewUserID("12345")
+	if _, err := NewRewardNonce(playerID, "banner", 1000); !errors.Is(err, ErrInvalidPlacement) {
+		t.Errorf("unknown placement: err = %v, want ErrInvalidPlacement", err)
+	}
+}
+
+func TestRewardNonce_Lifecycle(t *testing.T) {
+	playerID, _ := shared.NewUserID("12345")
+	otherID, _ := shared.NewUserID("99999")
+	nonce := newTestNonce(t, PlacementDailyRetry, 1000)
+
+	if err := nonce.Consume(playerID, PlacementDailyRetry, 1010); !errors.Is(err, ErrAdNotWatched) {
+		t.Fatalf("Consume before callback = %v, want ErrAdNotWatched", err)
+	}
+	if err := nonce.Redeem(otherID, "tx-1", 1010); !errors.Is(err, ErrNonceMismatch) {
+		t.Fatalf("Redeem for another player = %v, want ErrNonceMismatch", err)
+	}
+	if err := nonce.Redeem(playerID, "tx-1", 1010); err != nil {
+		t.Fatalf("Redeem: %v", err)
+	}
+	if !nonce.IsRedemptionOf("tx-1") || nonce.IsRedemptionOf("tx-2") {
+		t.Error("IsRedemptionOf must match the redeeming transaction only")
+	}
+	if err := nonce.Redeem(playerID, "tx-2", 1020); !errors.Is(err, ErrNonceAlreadyRedeemed) {
+		t.Fatalf("second Redeem = %v, want ErrNonceAlreadyRedeemed", err)
+	}
+
+	if err := nonce.Consume(playerID, PlacementMarathonContinue, 1030); !errors.Is(err, ErrNonceMismatch) {
+		t.Fatalf("Consume for another placement = %v, want ErrNonceMismatch", err)
+	}
+	if err := nonce.Consume(playerID, PlacementDailyRetry, 1030); err != nil {
+		t.Fatalf("Consume: %v", err)
+	}
+	if err := nonce.Consume(playerID, PlacementDailyRetry, 1040); !errors.Is(err, ErrNonceAlreadyUsed) {
+		t.Fatalf("second Consume = %v, want ErrNonceAlreadyUsed", err)
+	}
+}
+
+func TestRewardNonce_Expiry(t *testing.T) {
+	playerID, _ := shared.NewUserID("12345")
+
+	late := newTestNonce(t, PlacementStreakRecovery, 1000)
+	if err := late.Redeem(playerID, "tx-1", 1000+WatchTTL+1); !errors.Is(err, ErrNonceExpired) {
+		t.Errorf("late callback = %v, want ErrNonceExpired", err)
+	}
+
+	stale := newTestNonce(t, PlacementStreakRecovery, 1000)
+	_ = stale.Redeem(playerID, "tx-2", 1010)
+	if err := stale.Consume(playerID, PlacementStreakRecovery, 1000+UseTTL+1); !errors.Is(err, ErrNonceExpired) {
+		t.Errorf("stale reward = %v, want ErrNonceExpired", err)
+	}
+}
+
+func TestDayStart(t *testing.T) {
+	// 2026-03-10 15:04:05 UTC
+	if got, want := DayStart(1773155045), int64(1773100800); got != want {
+		t.Errorf("DayStart = %d, want %d", got, want)
+	}
+}
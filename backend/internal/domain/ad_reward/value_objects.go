@@ -0,0 +1,95 @@
+package ad_reward
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// Type aliases from other domains
+type UserID = shared.UserID
+
+// NonceID is the one-time token a client passes to the ad network and back to the game
+type NonceID struct {
+	value string
+}
+
+// nonceBytes is the entropy of a nonce (hex-encoded to twice as many characters)
+const nonceBytes = 16
+
+// NewNonceID generates a random, unguessable nonce
+func NewNonceID() NonceID {
+	b := make([]byte, nonceBytes)
+	if _, err := rand.Read(b); err != nil {
+		panic("ad_reward: crypto/rand failed: " + err.Error())
+	}
+	return NonceID{value: hex.EncodeToString(b)}
+}
+
+func NewNonceIDFromString(value string) (NonceID, error) {
+	if len(value) != 2*nonceBytes {
+		return NonceID{}, ErrInvalidNonce
+	}
+	if _, err := hex.DecodeString(value); err != nil {
+		return NonceID{}, ErrInvalidNonce
+	}
+	return NonceID{value: value}, nil
+}
+
+func (id NonceID) String() string {
+	return id.value
+}
+
+func (id NonceID) IsZero() bool {
+	return id.value == ""
+}
+
+// Placement is the game feature a rewarded ad pays for
+type Placement string
+
+const (
+	PlacementDailyRetry       Placement = "daily_retry"
+	PlacementStreakRecovery   Placement = "streak_recovery"
+	PlacementMarathonContinue Placement = "marathon_continue"
+)
+
+// dailyCaps is how many rewards of each placement a player may use per UTC day
+var dailyCaps = map[Placement]int{
+	PlacementDailyRetry:       3,
+	PlacementStreakRecovery:   1,
+	PlacementMarathonContinue: 5,
+}
+
+func NewPlacement(value string) (Placement, error) {
+	p := Placement(value)
+	if _, ok := dailyCaps[p]; !ok {
+		return "", ErrInvalidPlacement
+	}
+	return p, nil
+}
+
+func (p Placement) String() string {
+	return string(p)
+}
+
+// DailyCap returns how many rewards of this placement a player may use per UTC day
+func (p Placement) DailyCap() int {
+	return dailyCaps[p]
+}
+
+// DayStart returns the start of the UTC day containing now (daily caps reset then)
+func DayStart(now int64) int64 {
+	t := time.Unix(now, 0).UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// NonceStatus is the lifecycle state of a nonce
+type NonceStatus string
+
+const (
+	NonceIssued   NonceStatus = "issued"   // handed to the client, ad not confirmed yet
+	NonceRedeemed NonceStatus = "redeemed" // the ad network confirmed the view
+	NonceConsumed NonceStatus = "consumed" // the reward was spent on its placement
+)
@@ -95,7 +95,8 @@ type AnswerQuestionResult struct {
 	RemainingQuestions int
 	IsGameCompleted    bool
 	IsCorrect          bool
-	CorrectAnswerID    string
+	CorrectAnswerID    string  // single-choice and true/false questions only
+	Credit             float64 // share of the points earned (partial credit)
 }
 
 // AnswerQuestion processes a user's answer for daily challenge
@@ -105,7 +106,7 @@ type AnswerQuestionResult struct {
 // - Complete game after 10 questions
 func (dg *DailyGame) AnswerQuestion(
 	questionID QuestionID,
-	answerID AnswerID,
+	submission quiz.AnswerSubmission,
 	timeTaken int64,
 	answeredAt int64,
 ) (*AnswerQuestionResult, error) {
@@ -117,16 +118,13 @@ func (dg *DailyGame) AnswerQuestion(
 	// 2. Find correct answer ID before answering (question is accessible now)
 	correctAnswerID := ""
 	if question, err := dg.session.Quiz().GetQuestion(questionID); err == nil {
-		for _, answer := range question.Answers() {
-			if answer.IsCorrect() {
-				correctAnswerID = answer.ID().String()
-				break
-			}
+		if id := question.CorrectAnswerID(); !id.IsZero() {
+			correctAnswerID = id.String()
 		}
 	}
 
 	// 3. Delegate to kernel session for pure gameplay logic
-	kernelResult, err := dg.session.AnswerQuestion(questionID, answerID, timeTaken, answeredAt)
+	kernelResult, err := dg.session.AnswerQuestion(questionID, submission, timeTaken, answeredAt)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +137,7 @@ func (dg *DailyGame) AnswerQuestion(
 		IsGameCompleted:    dg.session.IsFinished(),
 		IsCorrect:          kernelResult.IsCorrect,
 		CorrectAnswerID:    correctAnswerID,
+		Credit:             kernelResult.Credit,
 	}
 
 	// 4. Update questionStartedAt for next question (if not finished)
@@ -151,7 +150,7 @@ func (dg *DailyGame) AnswerQuestion(
 		dg.id,
 		dg.playerID,
 		questionID,
-		answerID,
+		submission.PrimaryAnswerID(),
 		timeTaken,
 		answeredAt,
 	))
@@ -210,7 +210,7 @@ func TestDailyGame_AnswerQuestion(t *testing.T) {
 	// Answer first question
 	result, err := game.AnswerQuestion(
 		firstQuestion.ID(),
-		correctAnswer.ID(),
+		quiz.NewChoiceSubmission(correctAnswer.ID()),
 		2000, // 2 seconds
 		now+2000,
 	)
@@ -260,7 +260,7 @@ func TestDailyGame_CompleteGame(t *testing.T) {
 
 		result, err := game.AnswerQuestion(
 			question.ID(),
-			correctAnswer.ID(),
+			quiz.NewChoiceSubmission(correctAnswer.ID()),
 			2000,
 			now+int64((i+1)*2000),
 		)
@@ -348,7 +348,7 @@ func TestDailyGame_StreakBonus(t *testing.T) {
 				correctAnswer := question.Answers()[0]
 				game.AnswerQuestion(
 					question.ID(),
-					correctAnswer.ID(),
+					quiz.NewChoiceSubmission(correctAnswer.ID()),
 					2000,
 					now+int64((i+1)*2000),
 				)
@@ -393,7 +393,7 @@ func TestDailyGame_AnswerAfterCompletion(t *testing.T) {
 		correctAnswer := question.Answers()[0]
 		game.AnswerQuestion(
 			question.ID(),
-			correctAnswer.ID(),
+			quiz.NewChoiceSubmission(correctAnswer.ID()),
 			2000,
 			now+int64((i+1)*2000),
 		)
@@ -405,7 +405,7 @@ func TestDailyGame_AnswerAfterCompletion(t *testing.T) {
 
 	result, err := game.AnswerQuestion(
 		firstQuestion.ID(),
-		correctAnswer.ID(),
+		quiz.NewChoiceSubmission(correctAnswer.ID()),
 		2000,
 		now+100000,
 	)
@@ -21,6 +21,10 @@ var (
 	// Streak errors
 	ErrStreakNotRecoverable = errors.New("streak is not recoverable (more than 1 day missed)")
 
+	// Payment errors
+	ErrInvalidPaymentMethod = errors.New("invalid payment method")
+	ErrAdNotVerified        = errors.New("rewarded ad view not verified")
+
 	// Question errors
 	ErrAllQuestionsAnswered = errors.New("all questions already answered")
 	ErrQuestionNotInQuiz    = errors.New("question not in daily quiz")
@@ -1,5 +1,7 @@
 package daily_challenge
 
+import "database/sql"
+
 // DailyQuizRepository defines the interface for daily quiz persistence
 type DailyQuizRepository interface {
 	// Save persists a daily quiz
@@ -21,6 +23,9 @@ type DailyGameRepository interface {
 	// Save persists a daily game
 	Save(game *DailyGame) error
 
+	// SaveInTx persists a daily game within an existing transaction
+	SaveInTx(tx *sql.Tx, game *DailyGame) error
+
 	// FindByID retrieves a daily game by ID
 	FindByID(id GameID) (*DailyGame, error)
 
@@ -38,7 +43,7 @@ type DailyGameRepository interface {
 	// Sorted by score descending, best attempt per player
 	FindTopByDate(date Date, limit int) ([]*DailyGame, error)
 
-	// FindTopByDateAndFriends retrieves top N results filtered to player's friends (referrals)
+	// FindTopByDateAndFriends retrieves top N results among the player and their friends
 	FindTopByDateAndFriends(date Date, playerID UserID, limit int) ([]*DailyGame, error)
 
 	// FindTopByDateAndCountry retrieves top N results filtered to players with same language_code
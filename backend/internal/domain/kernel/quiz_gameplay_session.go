@@ -25,16 +25,16 @@ type QuizGameplaySession struct {
 
 // AnswerData stores the answer and metadata for a question
 type AnswerData struct {
-	answerID   AnswerID
+	submission quiz.AnswerSubmission
 	isCorrect  bool
 	timeTaken  int64 // milliseconds
 	answeredAt int64 // Unix timestamp
 }
 
 // NewAnswerData creates a new AnswerData (used for reconstruction from persistence)
-func NewAnswerData(answerID AnswerID, isCorrect bool, timeTaken int64, answeredAt int64) AnswerData {
+func NewAnswerData(submission quiz.AnswerSubmission, isCorrect bool, timeTaken int64, answeredAt int64) AnswerData {
 	return AnswerData{
-		answerID:   answerID,
+		submission: submission,
 		isCorrect:  isCorrect,
 		timeTaken:  timeTaken,
 		answeredAt: answeredAt,
@@ -42,11 +42,14 @@ func NewAnswerData(answerID AnswerID, isCorrect bool, timeTaken int64, answeredA
 }
 
 // Getters for AnswerData
-func (a AnswerData) AnswerID() AnswerID   { return a.answerID }
+func (a AnswerData) AnswerID() AnswerID   { return a.submission.PrimaryAnswerID() }
 func (a AnswerData) IsCorrect() bool      { return a.isCorrect }
 func (a AnswerData) TimeTaken() int64     { return a.timeTaken }
 func (a AnswerData) AnsweredAt() int64    { return a.answeredAt }
 
+// Submission returns everything the player submitted (AnswerID is only its first option)
+func (a AnswerData) Submission() quiz.AnswerSubmission { return a.submission }
+
 // NewQuizGameplaySession creates a new gameplay session
 func NewQuizGameplaySession(id SessionID, quiz *quiz.Quiz, startedAt int64) (*QuizGameplaySession, error) {
 	if id.IsZero() {
@@ -75,6 +78,7 @@ func NewQuizGameplaySession(id SessionID, quiz *quiz.Quiz, startedAt int64) (*Qu
 // AnswerQuestionResult contains the result of answering a question
 type AnswerQuestionResult struct {
 	IsCorrect  bool
+	Credit     float64 // share of the points earned, see quiz.Question.Evaluate
 	BasePoints Points // Base points earned (without time bonus)
 	TimeBonus  Points // Time bonus earned (faster answer = more points)
 	TimeTaken  int64  // milliseconds
@@ -82,9 +86,10 @@ type AnswerQuestionResult struct {
 
 // AnswerQuestion records a user's answer and returns the result.
 // This is PURE gameplay logic - no streak, no multiplier, no mode-specific rules.
+// A partially right answer earns its share of the base points and time bonus.
 func (s *QuizGameplaySession) AnswerQuestion(
 	questionID QuestionID,
-	answerID AnswerID,
+	submission quiz.AnswerSubmission,
 	timeTaken int64,
 	answeredAt int64,
 ) (*AnswerQuestionResult, error) {
@@ -104,42 +109,20 @@ func (s *QuizGameplaySession) AnswerQuestion(
 		return nil, err
 	}
 
-	// 4. Validate answer belongs to question
-	answer, err := question.GetAnswer(answerID)
+	// 4-5. Validate and score the answer for the question's type
+	evaluation, err := question.Evaluate(submission)
 	if err != nil {
 		return nil, err
 	}
+	isCorrect := evaluation.IsCorrect
 
-	// 5. Check correctness
-	isCorrect := answer.IsCorrect()
-
-	// 6. Calculate points (only if correct)
-	var basePoints Points
-	var timeBonus Points
-	if isCorrect {
-		// 6a. Base points
-		basePoints = question.Points()
-		if basePoints.IsZero() {
-			basePoints = s.quiz.BasePoints()
-		}
-
-		// 6b. Time bonus: max(0, (timeLimit - timeTaken) * maxTimeBonus / timeLimit)
-		// timeTaken is in milliseconds, timeLimitPerQuestion is in seconds
-		timeLimitMs := int64(s.quiz.TimeLimitPerQuestion()) * 1000
-		if timeTaken > 0 && timeTaken < timeLimitMs && s.quiz.MaxTimeBonus().Value() > 0 {
-			remaining := timeLimitMs - timeTaken
-			bonusValue := int(float64(s.quiz.MaxTimeBonus().Value()) * float64(remaining) / float64(timeLimitMs))
-			if bonusValue > 0 {
-				timeBonus, _ = quiz.NewPoints(bonusValue)
-			}
-		}
-
-		s.baseScore = s.baseScore.Add(basePoints).Add(timeBonus)
-	}
+	// 6. Calculate points (only if the answer earned credit)
+	basePoints, timeBonus := s.answerPoints(question, evaluation, timeTaken)
+	s.baseScore = s.baseScore.Add(basePoints).Add(timeBonus)
 
 	// 7. Record answer
 	s.userAnswers[questionID] = AnswerData{
-		answerID:   answerID,
+		submission: submission,
 		isCorrect:  isCorrect,
 		timeTaken:  timeTaken,
 		answeredAt: answeredAt,
@@ -150,12 +133,63 @@ func (s *QuizGameplaySession) AnswerQuestion(
 
 	return &AnswerQuestionResult{
 		IsCorrect:  isCorrect,
+		Credit:     evaluation.Credit,
 		BasePoints: basePoints,
 		TimeBonus:  timeBonus,
 		TimeTaken:  timeTaken,
 	}, nil
 }
 
+// answerPoints calculates the base points and time bonus an answer earns:
+// the evaluation's share of each, nothing without credit
+func (s *QuizGameplaySession) answerPoints(question *quiz.Question, evaluation quiz.Evaluation, timeTaken int64) (Points, Points) {
+	var basePoints Points
+	var timeBonus Points
+	if evaluation.Credit <= 0 {
+		return basePoints, timeBonus
+	}
+
+	// Base points: question-level, fallback to quiz-level
+	basePoints = question.Points()
+	if basePoints.IsZero() {
+		basePoints = s.quiz.BasePoints()
+	}
+	basePoints = evaluation.Scale(basePoints)
+
+	// Time bonus: max(0, (timeLimit - timeTaken) * maxTimeBonus / timeLimit)
+	// timeTaken is in milliseconds, timeLimitPerQuestion is in seconds
+	timeLimitMs := int64(s.quiz.TimeLimitPerQuestion()) * 1000
+	if timeTaken > 0 && timeTaken < timeLimitMs && s.quiz.MaxTimeBonus().Value() > 0 {
+		remaining := timeLimitMs - timeTaken
+		bonusValue := int(float64(s.quiz.MaxTimeBonus().Value()) * float64(remaining) / float64(timeLimitMs))
+		if bonusValue > 0 {
+			timeBonus, _ = quiz.NewPoints(bonusValue)
+			timeBonus = evaluation.Scale(timeBonus)
+		}
+	}
+
+	return basePoints, timeBonus
+}
+
+// PointsEarned returns the points (base + time bonus) the answer to a question earned;
+// zero if the question was not answered
+func (s *QuizGameplaySession) PointsEarned(questionID QuestionID) Points {
+	answerData, exists := s.userAnswers[questionID]
+	if !exists {
+		return Points{}
+	}
+	question, err := s.quiz.GetQuestion(questionID)
+	if err != nil {
+		return Points{}
+	}
+	evaluation, err := question.Evaluate(answerData.submission)
+	if err != nil {
+		return Points{}
+	}
+	basePoints, timeBonus := s.answerPoints(question, evaluation, answerData.timeTaken)
+	return basePoints.Add(timeBonus)
+}
+
 // IsFinished checks if all questions have been answered
 func (s *QuizGameplaySession) IsFinished() bool {
 	return s.currentQuestionIndex >= s.quiz.QuestionsCount()
@@ -194,23 +228,7 @@ func (s *QuizGameplaySession) GetScoreByQuestion() []int {
 
 	questions := s.quiz.Questions()
 	for _, question := range questions {
-		if answerData, exists := s.userAnswers[question.ID()]; exists && answerData.isCorrect {
-			basePoints := question.Points()
-			if basePoints.IsZero() {
-				basePoints = s.quiz.BasePoints()
-			}
-			questionScore := basePoints.Value()
-
-			// Add time bonus
-			timeLimitMs := int64(s.quiz.TimeLimitPerQuestion()) * 1000
-			timeTaken := answerData.timeTaken
-			if timeTaken > 0 && timeTaken < timeLimitMs && s.quiz.MaxTimeBonus().Value() > 0 {
-				remaining := timeLimitMs - timeTaken
-				questionScore += int(float64(s.quiz.MaxTimeBonus().Value()) * float64(remaining) / float64(timeLimitMs))
-			}
-
-			cumulativeScore += questionScore
-		}
+		cumulativeScore += s.PointsEarned(question.ID()).Value()
 		scores = append(scores, cumulativeScore)
 	}
 
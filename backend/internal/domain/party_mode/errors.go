@@ -11,6 +11,7 @@ var (
 	ErrRoomAlreadyStarted  = errors.New("room already started")
 	ErrRoomClosed          = errors.New("room is closed")
 	ErrInvalidRoomCode     = errors.New("invalid room code")
+	ErrRoomCodeTaken       = errors.New("room code already used by an open room")
 	ErrInvalidRoomSettings = errors.New("invalid room settings")
 	ErrInvalidRoomStatus   = errors.New("invalid room status transition")
 
@@ -30,4 +31,5 @@ var (
 	ErrPlayerAlreadyAnswered = errors.New("player already answered this question")
 	ErrAllQuestionsAnswered = errors.New("all questions already answered")
 	ErrInvalidGameStatus    = errors.New("invalid game status transition")
+	ErrNotEnoughQuestions   = errors.New("not enough questions for party game")
 )
@@ -16,6 +16,26 @@ type QuestionAnswer struct {
 	position  int // 1st, 2nd, 3rd to answer correctly
 }
 
+// ReconstructQuestionAnswer reconstructs a QuestionAnswer from persistence
+func ReconstructQuestionAnswer(playerID UserID, answerID AnswerID, timeTaken int64, isCorrect bool, points int, position int) QuestionAnswer {
+	return QuestionAnswer{
+		playerID:  playerID,
+		answerID:  answerID,
+		timeTaken: timeTaken,
+		isCorrect: isCorrect,
+		points:    points,
+		position:  position,
+	}
+}
+
+// Getters
+func (qa QuestionAnswer) PlayerID() UserID  { return qa.playerID }
+func (qa QuestionAnswer) AnswerID() AnswerID { return qa.answerID }
+func (qa QuestionAnswer) TimeTaken() int64  { return qa.timeTaken }
+func (qa QuestionAnswer) IsCorrect() bool   { return qa.isCorrect }
+func (qa QuestionAnswer) Points() int       { return qa.points }
+func (qa QuestionAnswer) Position() int     { return qa.position }
+
 // PartyGame is the aggregate root for active party game
 type PartyGame struct {
 	id              GameID
@@ -182,8 +202,8 @@ func (pg *PartyGame) SubmitAnswer(
 		answeredAt,
 	))
 
-	// 7. Check if all players answered
-	allAnswered := len(pg.questionAnswers[pg.currentQuestion]) == len(pg.players)
+	// 7. Check if all connected players answered
+	allAnswered := pg.allConnectedAnswered()
 
 	result := &SubmitAnswerResult{
 		IsCorrect:      isCorrect,
@@ -212,6 +232,52 @@ func (pg *PartyGame) SubmitAnswer(
 	return result, nil
 }
 
+// LeavePlayer marks a player as disconnected for the rest of the game.
+// The player keeps their score on the final board but is no longer waited for:
+// if every remaining player already answered, the current question completes.
+// When fewer than 2 players remain connected, the game finishes immediately.
+func (pg *PartyGame) LeavePlayer(playerID UserID, leftAt int64) error {
+	if pg.status != GameStatusInProgress {
+		return ErrGameNotActive
+	}
+
+	playerIndex := pg.findPlayerIndex(playerID)
+	if playerIndex == -1 {
+		return ErrPlayerNotFound
+	}
+
+	pg.players[playerIndex] = pg.players[playerIndex].SetConnected(false)
+
+	if pg.connectedCount() < 2 {
+		return pg.finishGame(leftAt)
+	}
+
+	if pg.allConnectedAnswered() {
+		return pg.completeCurrentQuestion(leftAt)
+	}
+
+	return nil
+}
+
+// TimeoutQuestion completes the given question (1-based) when its timer expires.
+// Players who did not answer simply score nothing for it.
+// Returns false (no error) when the game already moved past that question.
+func (pg *PartyGame) TimeoutQuestion(questionNumber int, timedOutAt int64) (bool, error) {
+	if pg.status != GameStatusInProgress {
+		return false, nil
+	}
+
+	if pg.currentQuestion+1 != questionNumber {
+		return false, nil
+	}
+
+	if err := pg.completeCurrentQuestion(timedOutAt); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // completeCurrentQuestion completes current question and moves to next (or finishes)
 func (pg *PartyGame) completeCurrentQuestion(completedAt int64) error {
 	// 1. Publish QuestionCompleted event
@@ -295,6 +361,25 @@ func (pg *PartyGame) hasPlayerAnsweredCurrentQuestion(playerID UserID) bool {
 	return false
 }
 
+func (pg *PartyGame) connectedCount() int {
+	count := 0
+	for _, p := range pg.players {
+		if p.Connected() {
+			count++
+		}
+	}
+	return count
+}
+
+func (pg *PartyGame) allConnectedAnswered() bool {
+	for _, p := range pg.players {
+		if p.Connected() && !pg.hasPlayerAnsweredCurrentQuestion(p.UserID()) {
+			return false
+		}
+	}
+	return true
+}
+
 func (pg *PartyGame) countCorrectAnswersForCurrentQuestion() int {
 	answers, exists := pg.questionAnswers[pg.currentQuestion]
 	if !exists {
@@ -343,6 +428,17 @@ func (pg *PartyGame) Players() []PartyPlayer {
 	return copy
 }
 func (pg *PartyGame) CurrentQuestion() int      { return pg.currentQuestion }
+func (pg *PartyGame) CurrentQuestionID() QuestionID {
+	return pg.questionIDs[pg.currentQuestion]
+}
+func (pg *PartyGame) QuestionAnswers() map[int][]QuestionAnswer {
+	copy := make(map[int][]QuestionAnswer, len(pg.questionAnswers))
+	for idx, answers := range pg.questionAnswers {
+		copy[idx] = append([]QuestionAnswer(nil), answers...)
+	}
+	return copy
+}
+func (pg *PartyGame) HasPlayer(playerID UserID) bool { return pg.hasPlayer(playerID) }
 func (pg *PartyGame) Status() GameStatus        { return pg.status }
 func (pg *PartyGame) StartedAt() int64          { return pg.startedAt }
 func (pg *PartyGame) FinishedAt() int64         { return pg.finishedAt }
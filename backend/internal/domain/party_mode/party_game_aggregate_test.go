@@ -0,0 +1,130 @@
+package party_mode
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// newTestQuestion builds a question with one correct and one wrong answer
+func newTestQuestion(t *testing.T) (*quiz.Question, AnswerID, AnswerID) {
+	t.Helper()
+
+	text, _ := quiz.NewQuestionText("What is 2 + 2?")
+	points, _ := quiz.NewPoints(100)
+	question, err := quiz.NewQuestion(quiz.NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+
+	correctText, _ := quiz.NewAnswerText("4")
+	correct, _ := quiz.NewAnswer(quiz.NewAnswerID(), correctText, true, 0)
+	wrongText, _ := quiz.NewAnswerText("5")
+	wrong, _ := quiz.NewAnswer(quiz.NewAnswerID(), wrongText, false, 1)
+	question.AddAnswer(*correct)
+	question.AddAnswer(*wrong)
+
+	return question, correct.ID(), wrong.ID()
+}
+
+// newTestGame creates a game with the given number of players and questions
+func newTestGame(t *testing.T, playerCount int, questionIDs []QuestionID) (*PartyGame, []UserID) {
+	t.Helper()
+
+	roomPlayers := make([]RoomPlayer, 0, playerCount)
+	playerIDs := make([]UserID, 0, playerCount)
+	for i := 0; i < playerCount; i++ {
+		id, _ := shared.NewUserID(string(rune('a'+i)) + "-player")
+		roomPlayers = append(roomPlayers, NewRoomPlayer(id, "Player", i == 0, 1000))
+		playerIDs = append(playerIDs, id)
+	}
+
+	game, err := NewPartyGame(NewRoomID(), questionIDs, roomPlayers, 1000)
+	if err != nil {
+		t.Fatalf("NewPartyGame: %v", err)
+	}
+	game.Events()
+
+	return game, playerIDs
+}
+
+// TestPartyGame_LeavePlayer_CompletesQuestion tests that a leaving player is no longer waited for
+func TestPartyGame_LeavePlayer_CompletesQuestion(t *testing.T) {
+	question, correctID, _ := newTestQuestion(t)
+	game, players := newTestGame(t, 3, []QuestionID{question.ID(), quiz.NewQuestionID()})
+
+	if _, err := game.SubmitAnswer(players[0], correctID, 1500, question, 1001); err != nil {
+		t.Fatalf("SubmitAnswer: %v", err)
+	}
+	if _, err := game.SubmitAnswer(players[1], correctID, 2500, question, 1002); err != nil {
+		t.Fatalf("SubmitAnswer: %v", err)
+	}
+	if game.CurrentQuestion() != 0 {
+		t.Fatalf("CurrentQuestion = %d, want 0 (third player pending)", game.CurrentQuestion())
+	}
+
+	if err := game.LeavePlayer(players[2], 1003); err != nil {
+		t.Fatalf("LeavePlayer: %v", err)
+	}
+
+	if game.CurrentQuestion() != 1 {
+		t.Errorf("CurrentQuestion = %d, want 1", game.CurrentQuestion())
+	}
+	if game.Status() != GameStatusInProgress {
+		t.Errorf("Status = %v, want %v", game.Status(), GameStatusInProgress)
+	}
+}
+
+// TestPartyGame_LeavePlayer_FinishesWhenAlone tests that the game ends when one player remains
+func TestPartyGame_LeavePlayer_FinishesWhenAlone(t *testing.T) {
+	question, _, _ := newTestQuestion(t)
+	game, players := newTestGame(t, 2, []QuestionID{question.ID()})
+
+	if err := game.LeavePlayer(players[1], 1001); err != nil {
+		t.Fatalf("LeavePlayer: %v", err)
+	}
+
+	if game.Status() != GameStatusFinished {
+		t.Errorf("Status = %v, want %v", game.Status(), GameStatusFinished)
+	}
+
+	var finished bool
+	for _, event := range game.Events() {
+		if _, ok := event.(GameFinishedEvent); ok {
+			finished = true
+		}
+	}
+	if !finished {
+		t.Error("Expected GameFinishedEvent")
+	}
+}
+
+// TestPartyGame_TimeoutQuestion tests question timeout handling
+func TestPartyGame_TimeoutQuestion(t *testing.T) {
+	question, _, _ := newTestQuestion(t)
+	game, _ := newTestGame(t, 2, []QuestionID{question.ID(), quiz.NewQuestionID()})
+
+	// Stale question number is ignored
+	advanced, err := game.TimeoutQuestion(2, 1010)
+	if err != nil || advanced {
+		t.Fatalf("TimeoutQuestion(2) = %v, %v; want false, nil", advanced, err)
+	}
+
+	advanced, err = game.TimeoutQuestion(1, 1015)
+	if err != nil || !advanced {
+		t.Fatalf("TimeoutQuestion(1) = %v, %v; want true, nil", advanced, err)
+	}
+	if game.CurrentQuestion() != 1 {
+		t.Errorf("CurrentQuestion = %d, want 1", game.CurrentQuestion())
+	}
+
+	// Timing out the last question finishes the game
+	advanced, err = game.TimeoutQuestion(2, 1030)
+	if err != nil || !advanced {
+		t.Fatalf("TimeoutQuestion(2) = %v, %v; want true, nil", advanced, err)
+	}
+	if game.Status() != GameStatusFinished {
+		t.Errorf("Status = %v, want %v", game.Status(), GameStatusFinished)
+	}
+}
@@ -202,6 +202,26 @@ func (pr *PartyRoom) StartGame() error {
 	return nil
 }
 
+// Close closes the room once the party game is over
+func (pr *PartyRoom) Close() error {
+	if !pr.status.CanTransitionTo(RoomStatusClosed) {
+		return ErrInvalidRoomStatus
+	}
+
+	pr.status = RoomStatusClosed
+	return nil
+}
+
+// HasPlayer checks if player is in the room
+func (pr *PartyRoom) HasPlayer(playerID UserID) bool {
+	return pr.hasPlayer(playerID)
+}
+
+// IsExpired checks if the lobby outlived its 1-hour lifetime
+func (pr *PartyRoom) IsExpired(now int64) bool {
+	return now >= pr.expiresAt
+}
+
 // Helper methods
 
 func (pr *PartyRoom) hasPlayer(playerID UserID) bool {
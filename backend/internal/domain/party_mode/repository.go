@@ -1,13 +1,22 @@
 package party_mode
 
+import "database/sql"
+
 // RoomRepository defines the interface for party room persistence
 type RoomRepository interface {
 	// Save persists a party room
 	Save(room *PartyRoom) error
 
+	// SaveInTx persists a party room within a transaction
+	SaveInTx(tx *sql.Tx, room *PartyRoom) error
+
 	// FindByID retrieves a party room by ID
 	FindByID(id RoomID) (*PartyRoom, error)
 
+	// FindByIDForUpdate retrieves a party room with a row-level lock (SELECT FOR UPDATE).
+	// Every change to a room or to the game running in it holds this lock.
+	FindByIDForUpdate(tx *sql.Tx, id RoomID) (*PartyRoom, error)
+
 	// FindByCode retrieves a party room by code
 	FindByCode(code RoomCode) (*PartyRoom, error)
 
@@ -23,6 +32,9 @@ type GameRepository interface {
 	// Save persists a party game
 	Save(game *PartyGame) error
 
+	// SaveInTx persists a party game within a transaction
+	SaveInTx(tx *sql.Tx, game *PartyGame) error
+
 	// FindByID retrieves a party game by ID
 	FindByID(id GameID) (*PartyGame, error)
 
@@ -131,6 +131,33 @@ func NewRoomSettings() RoomSettings {
 	}
 }
 
+// ReconstructRoomSettings builds RoomSettings from explicit values
+// Used by persistence and by room creation with custom settings
+func ReconstructRoomSettings(
+	maxPlayers int,
+	questionsCount int,
+	timePerQuestion int,
+	categories []CategoryID,
+	difficulty string,
+	showCorrectAnswer bool,
+	showPlayerAnswers bool,
+	showCurrentScore bool,
+) RoomSettings {
+	if categories == nil {
+		categories = []CategoryID{}
+	}
+	return RoomSettings{
+		maxPlayers:        maxPlayers,
+		questionsCount:    questionsCount,
+		timePerQuestion:   timePerQuestion,
+		categories:        categories,
+		difficulty:        difficulty,
+		showCorrectAnswer: showCorrectAnswer,
+		showPlayerAnswers: showPlayerAnswers,
+		showCurrentScore:  showCurrentScore,
+	}
+}
+
 // Validation
 func (rs RoomSettings) Validate() error {
 	if rs.maxPlayers < 2 || rs.maxPlayers > 8 {
@@ -182,6 +209,18 @@ func NewRoomPlayer(userID UserID, username string, isHost bool, joinedAt int64)
 	}
 }
 
+// ReconstructRoomPlayer reconstructs a RoomPlayer from persistence
+func ReconstructRoomPlayer(userID UserID, username string, isHost bool, isReady bool, connected bool, joinedAt int64) RoomPlayer {
+	return RoomPlayer{
+		userID:    userID,
+		username:  username,
+		isHost:    isHost,
+		isReady:   isReady,
+		connected: connected,
+		joinedAt:  joinedAt,
+	}
+}
+
 // SetReady sets player ready status (immutable)
 func (rp RoomPlayer) SetReady(ready bool) RoomPlayer {
 	return RoomPlayer{
@@ -245,6 +284,17 @@ func NewPartyPlayer(userID UserID, username string) PartyPlayer {
 	}
 }
 
+// ReconstructPartyPlayer reconstructs a PartyPlayer from persistence
+func ReconstructPartyPlayer(userID UserID, username string, score int, connected bool, answersCount int) PartyPlayer {
+	return PartyPlayer{
+		userID:       userID,
+		username:     username,
+		score:        score,
+		connected:    connected,
+		answersCount: answersCount,
+	}
+}
+
 // AddScore adds points (immutable)
 func (pp PartyPlayer) AddScore(points int) PartyPlayer {
 	return PartyPlayer{
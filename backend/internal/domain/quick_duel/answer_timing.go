@@ -0,0 +1,59 @@
+package quick_duel
+
+const (
+	MaxLatencyAllowanceMs = 500  // Most network delay credited back to a player
+	TimingToleranceMs     = 1000 // Client/server disagreement before an answer is flagged
+)
+
+// AnswerReceipt is how long a player took to answer, as the client reports it
+// and as the server saw it arrive
+type AnswerReceipt struct {
+	ClientTimeMs int64 // reported by the client; trusted only within the latency allowance
+	ReceivedAtMs int64 // server time the answer arrived (0 = unknown)
+	LatencyMs    int64 // measured round-trip time of the player's connection
+}
+
+// NewClientAnswerReceipt is a receipt without server timing (the client's figure is all there is)
+func NewClientAnswerReceipt(clientTimeMs int64) AnswerReceipt {
+	return AnswerReceipt{ClientTimeMs: clientTimeMs}
+}
+
+// measureAnswerTime returns the time credited to an answer to a question sent at sentAtMs,
+// and whether the client's figure disagrees with the server's beyond TimingToleranceMs.
+//
+// The server measures question sent -> answer received. The network delay in that span
+// is credited back up to the connection's round-trip time (at most MaxLatencyAllowanceMs):
+// the client's own figure is accepted anywhere in that window, never below it.
+// Without a sent or receipt time (rounds sent before the upgrade) the client's figure stands.
+func measureAnswerTime(sentAtMs int64, receipt AnswerReceipt) (timeTaken int64, flagged bool) {
+	if sentAtMs <= 0 || receipt.ReceivedAtMs <= 0 {
+		return receipt.ClientTimeMs, false
+	}
+
+	elapsed := receipt.ReceivedAtMs - sentAtMs
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	allowance := receipt.LatencyMs
+	if allowance < 0 {
+		allowance = 0
+	}
+	if allowance > MaxLatencyAllowanceMs {
+		allowance = MaxLatencyAllowanceMs
+	}
+	fastest := elapsed - allowance
+	if fastest < 0 {
+		fastest = 0
+	}
+
+	timeTaken = receipt.ClientTimeMs
+	if timeTaken < fastest {
+		timeTaken = fastest
+	}
+	if timeTaken > elapsed {
+		timeTaken = elapsed
+	}
+
+	flagged = receipt.ClientTimeMs < fastest-TimingToleranceMs || receipt.ClientTimeMs > elapsed+TimingToleranceMs
+	return timeTaken, flagged
+}
@@ -0,0 +1,98 @@
+package quick_duel
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+func TestMeasureAnswerTime(t *testing.T) {
+	const sentAt = int64(1_000_000)
+
+	tests := []struct {
+		name        string
+		receipt     AnswerReceipt
+		wantTime    int64
+		wantFlagged bool
+	}{
+		{
+			name:     "no server stamp trusts the client",
+			receipt:  AnswerReceipt{ClientTimeMs: 1200},
+			wantTime: 1200,
+		},
+		{
+			name:     "client figure inside the latency window",
+			receipt:  AnswerReceipt{ClientTimeMs: 2900, ReceivedAtMs: sentAt + 3000, LatencyMs: 150},
+			wantTime: 2900,
+		},
+		{
+			name:     "under-reported time is raised to the window",
+			receipt:  AnswerReceipt{ClientTimeMs: 2000, ReceivedAtMs: sentAt + 3000, LatencyMs: 150},
+			wantTime: 2850,
+		},
+		{
+			name:     "latency allowance is bounded",
+			receipt:  AnswerReceipt{ClientTimeMs: 2000, ReceivedAtMs: sentAt + 3000, LatencyMs: 5000},
+			wantTime: 3000 - MaxLatencyAllowanceMs,
+		},
+		{
+			name:        "spoofed time is flagged",
+			receipt:     AnswerReceipt{ClientTimeMs: 300, ReceivedAtMs: sentAt + 6000, LatencyMs: 100},
+			wantTime:    5900,
+			wantFlagged: true,
+		},
+		{
+			name:        "over-reported time is capped at the server's",
+			receipt:     AnswerReceipt{ClientTimeMs: 9000, ReceivedAtMs: sentAt + 3000, LatencyMs: 100},
+			wantTime:    3000,
+			wantFlagged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTime, gotFlagged := measureAnswerTime(sentAt, tt.receipt)
+			if gotTime != tt.wantTime || gotFlagged != tt.wantFlagged {
+				t.Errorf("measureAnswerTime() = %d, %v; want %d, %v", gotTime, gotFlagged, tt.wantTime, tt.wantFlagged)
+			}
+		})
+	}
+}
+
+func TestDuelGame_SubmitAnswerUsesServerTiming(t *testing.T) {
+	f := newOutcomeFixture(t)
+
+	sentAt, err := f.game.MarkQuestionSent(1, 5_000_000)
+	if err != nil {
+		t.Fatalf("MarkQuestionSent: %v", err)
+	}
+	// A resend to a reconnecting player keeps the round's clock
+	if again, _ := f.game.MarkQuestionSent(1, 5_004_000); again != sentAt {
+		t.Errorf("second MarkQuestionSent = %d, want %d", again, sentAt)
+	}
+
+	receipt := AnswerReceipt{ClientTimeMs: MinAnswerTimeMs, ReceivedAtMs: sentAt + 8000, LatencyMs: 100}
+	result, err := f.game.SubmitAnswer(f.p1ID, quiz.NewChoiceSubmission(f.correct), receipt, f.question, f.now)
+	if err != nil {
+		t.Fatalf("SubmitAnswer: %v", err)
+	}
+	if result.TimeTaken != 7900 || !result.TimingFlagged {
+		t.Errorf("TimeTaken = %d, flagged %v; want 7900, true", result.TimeTaken, result.TimingFlagged)
+	}
+	if want := BasePointsCorrect + CalculateSpeedBonus(7900); result.PointsEarned != want {
+		t.Errorf("PointsEarned = %d, want %d (no bonus for the spoofed time)", result.PointsEarned, want)
+	}
+
+	answer := f.game.RoundAnswers()[1][0]
+	if answer.ClientTimeTaken() != MinAnswerTimeMs || !answer.TimingFlagged() {
+		t.Errorf("recorded answer = client %dms, flagged %v", answer.ClientTimeTaken(), answer.TimingFlagged())
+	}
+}
+
+func TestDuelGame_MarkQuestionSentRejectsOtherRound(t *testing.T) {
+	f := newOutcomeFixture(t)
+
+	if _, err := f.game.MarkQuestionSent(2, 5_000_000); err != ErrInvalidRound {
+		t.Errorf("MarkQuestionSent(2) error = %v, want ErrInvalidRound", err)
+	}
+}
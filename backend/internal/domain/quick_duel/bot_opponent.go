@@ -0,0 +1,157 @@
+package quick_duel
+
+import (
+	"math/rand"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// botAnswerMarginMs keeps the bot's slowest answer inside the round timer
+const botAnswerMarginMs = 1000
+
+// BotSkill is how well the bot plays: how often it answers correctly
+// and the range its answer times are drawn from
+type BotSkill struct {
+	accuracy  float64 // 0..1
+	minTimeMs int64
+	maxTimeMs int64
+}
+
+func (s BotSkill) Accuracy() float64 { return s.accuracy }
+func (s BotSkill) MinTimeMs() int64  { return s.minTimeMs }
+func (s BotSkill) MaxTimeMs() int64  { return s.maxTimeMs }
+
+// botLeagueSkills is the bot's skill against a Division IV player of each league.
+// Higher leagues answer more questions correctly and faster, as their players do.
+var botLeagueSkills = map[League]BotSkill{
+	LeagueBronze:   {accuracy: 0.50, minTimeMs: 4000, maxTimeMs: 9000},
+	LeagueSilver:   {accuracy: 0.58, minTimeMs: 3500, maxTimeMs: 8500},
+	LeagueGold:     {accuracy: 0.66, minTimeMs: 3000, maxTimeMs: 8000},
+	LeaguePlatinum: {accuracy: 0.74, minTimeMs: 2500, maxTimeMs: 7000},
+	LeagueDiamond:  {accuracy: 0.82, minTimeMs: 2000, maxTimeMs: 6000},
+	LeagueLegend:   {accuracy: 0.90, minTimeMs: 1500, maxTimeMs: 5000},
+}
+
+// botDivisionAccuracyStep is added to the accuracy for each division above IV
+const botDivisionAccuracyStep = 0.02
+
+// BotSkillForMMR returns the bot's skill for an even match against a player with this MMR
+func BotSkillForMMR(mmr int) BotSkill {
+	info := GetLeagueFromMMR(mmr)
+	skill := botLeagueSkills[info.League()]
+	if info.League() != LeagueLegend {
+		skill.accuracy += float64(DivisionIV-info.Division()) * botDivisionAccuracyStep
+	}
+
+	maxTimeMs := int64(TimePerQuestionSec*1000 - botAnswerMarginMs)
+	if skill.maxTimeMs > maxTimeMs {
+		skill.maxTimeMs = maxTimeMs
+	}
+	if skill.minTimeMs < MinAnswerTimeMs {
+		skill.minTimeMs = MinAnswerTimeMs
+	}
+	return skill
+}
+
+// BotAnswer is the bot's answer to a round's question
+type BotAnswer struct {
+	Submission  quiz.AnswerSubmission
+	AnswerID    AnswerID // first submitted option; zero for a numeric question
+	IsCorrect   bool
+	TimeTakenMs int64 // how long after the question the bot answers
+}
+
+// BotOpponent answers duel questions for the bot player
+type BotOpponent struct {
+	rng *rand.Rand
+}
+
+// NewBotOpponent creates a bot opponent; rng is injectable for deterministic tests
+func NewBotOpponent(rng *rand.Rand) *BotOpponent {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	return &BotOpponent{rng: rng}
+}
+
+// PlanAnswer picks the bot's answer to the question and when it is given.
+// The bot answers correctly with the skill's accuracy; a wrong answer is one of the
+// incorrect options (the reversed order, or a number out of tolerance, for those
+// question types), and takes longer (the slower half of the time range), like a guess.
+func (b *BotOpponent) PlanAnswer(question *quiz.Question, skill BotSkill) (BotAnswer, error) {
+	var correct, incorrect []AnswerID
+	for _, answer := range question.Answers() {
+		if answer.IsCorrect() {
+			correct = append(correct, answer.ID())
+		} else {
+			incorrect = append(incorrect, answer.ID())
+		}
+	}
+
+	var correctSubmission, wrongSubmission quiz.AnswerSubmission
+	canMiss := true
+	switch question.Type() {
+	case quiz.QuestionTypeNumeric:
+		numeric := question.NumericAnswer()
+		if numeric.IsEmpty() {
+			return BotAnswer{}, ErrQuestionHasNoAnswers
+		}
+		correctSubmission = question.CorrectSubmission()
+		wrongSubmission = quiz.NewNumericSubmission(numeric.Value() + 2*numeric.Tolerance() + 1)
+	case quiz.QuestionTypeOrdering:
+		order := question.CorrectAnswerIDs()
+		if len(order) == 0 {
+			return BotAnswer{}, ErrQuestionHasNoAnswers
+		}
+		reversed := make([]AnswerID, len(order))
+		for i, id := range order {
+			reversed[len(order)-1-i] = id
+		}
+		correctSubmission = question.CorrectSubmission()
+		wrongSubmission = quiz.NewChoiceSubmission(reversed...)
+		canMiss = len(order) > 1
+	case quiz.QuestionTypeMultiSelect:
+		if len(correct) == 0 {
+			return BotAnswer{}, ErrQuestionHasNoAnswers
+		}
+		correctSubmission = question.CorrectSubmission()
+		if len(incorrect) > 0 {
+			wrongSubmission = quiz.NewChoiceSubmission(incorrect[b.rng.Intn(len(incorrect))])
+		}
+		canMiss = len(incorrect) > 0
+	default:
+		if len(correct) == 0 && len(incorrect) == 0 {
+			return BotAnswer{}, ErrQuestionHasNoAnswers
+		}
+		if len(correct) > 0 {
+			correctSubmission = quiz.NewChoiceSubmission(correct[b.rng.Intn(len(correct))])
+		}
+		if len(incorrect) > 0 {
+			wrongSubmission = quiz.NewChoiceSubmission(incorrect[b.rng.Intn(len(incorrect))])
+		}
+		canMiss = len(incorrect) > 0
+	}
+
+	isCorrect := !canMiss || (!correctSubmission.IsEmpty() && b.rng.Float64() < skill.accuracy)
+
+	minTimeMs := skill.minTimeMs
+	submission := correctSubmission
+	if !isCorrect {
+		submission = wrongSubmission
+		minTimeMs = (skill.minTimeMs + skill.maxTimeMs) / 2
+	}
+
+	return BotAnswer{
+		Submission:  submission,
+		AnswerID:    submission.PrimaryAnswerID(),
+		IsCorrect:   isCorrect,
+		TimeTakenMs: b.responseTime(minTimeMs, skill.maxTimeMs),
+	}, nil
+}
+
+// responseTime draws from a triangular distribution over [min, max]:
+// answers cluster around the middle of the range, with occasional quick or slow ones
+func (b *BotOpponent) responseTime(minMs, maxMs int64) int64 {
+	spread := float64(maxMs - minMs)
+	return minMs + int64(spread*(b.rng.Float64()+b.rng.Float64())/2)
+}
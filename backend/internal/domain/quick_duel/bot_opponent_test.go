@@ -0,0 +1,98 @@
+package quick_duel
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+func newBotTestQuestion(t *testing.T) (*quiz.Question, AnswerID) {
+	t.Helper()
+	text, _ := quiz.NewQuestionText("Capital of France?")
+	points, _ := quiz.NewPoints(100)
+	question, err := quiz.NewQuestion(quiz.NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+
+	correctID := quiz.NewAnswerID()
+	for i, option := range []string{"Paris", "Lyon", "Nice", "Lille"} {
+		id := quiz.NewAnswerID()
+		if i == 0 {
+			id = correctID
+		}
+		answerText, _ := quiz.NewAnswerText(option)
+		answer, _ := quiz.NewAnswer(id, answerText, i == 0, i)
+		_ = question.AddAnswer(*answer)
+	}
+	return question, correctID
+}
+
+func TestBotSkillForMMR_ScalesWithLeague(t *testing.T) {
+	bronze := BotSkillForMMR(100)
+	gold := BotSkillForMMR(MMRGoldMin)
+	goldI := BotSkillForMMR(MMRPlatinumMin - 1)
+	legend := BotSkillForMMR(5000)
+
+	if !(bronze.Accuracy() < gold.Accuracy() && gold.Accuracy() < goldI.Accuracy() && goldI.Accuracy() < legend.Accuracy()) {
+		t.Errorf("accuracy must grow with MMR: bronze %.2f, gold IV %.2f, gold I %.2f, legend %.2f",
+			bronze.Accuracy(), gold.Accuracy(), goldI.Accuracy(), legend.Accuracy())
+	}
+	if !(legend.MaxTimeMs() < bronze.MaxTimeMs()) {
+		t.Errorf("stronger bots must answer faster: bronze max %d, legend max %d", bronze.MaxTimeMs(), legend.MaxTimeMs())
+	}
+
+	for _, mmr := range []int{-50, 0, 999, 1500, 2499, 3500} {
+		skill := BotSkillForMMR(mmr)
+		if skill.MinTimeMs() < MinAnswerTimeMs || skill.MaxTimeMs() >= TimePerQuestionSec*1000 {
+			t.Errorf("MMR %d: time range [%d, %d] outside the round", mmr, skill.MinTimeMs(), skill.MaxTimeMs())
+		}
+	}
+}
+
+func TestBotOpponent_PlanAnswer_MatchesSkill(t *testing.T) {
+	question, correctID := newBotTestQuestion(t)
+	bot := NewBotOpponent(rand.New(rand.NewSource(42)))
+	skill := BotSkillForMMR(MMRGoldMin)
+
+	const rounds = 2000
+	correct := 0
+	var correctTime, wrongTime int64
+	for i := 0; i < rounds; i++ {
+		answer, err := bot.PlanAnswer(question, skill)
+		if err != nil {
+			t.Fatalf("PlanAnswer: %v", err)
+		}
+		if answer.IsCorrect != (answer.AnswerID == correctID) {
+			t.Fatalf("IsCorrect = %v for answer %s", answer.IsCorrect, answer.AnswerID)
+		}
+		if answer.TimeTakenMs < skill.MinTimeMs() || answer.TimeTakenMs > skill.MaxTimeMs() {
+			t.Fatalf("TimeTakenMs = %d, want within [%d, %d]", answer.TimeTakenMs, skill.MinTimeMs(), skill.MaxTimeMs())
+		}
+		if answer.IsCorrect {
+			correct++
+			correctTime += answer.TimeTakenMs
+		} else {
+			wrongTime += answer.TimeTakenMs
+		}
+	}
+
+	if got := float64(correct) / rounds; math.Abs(got-skill.Accuracy()) > 0.05 {
+		t.Errorf("accuracy = %.2f, want about %.2f", got, skill.Accuracy())
+	}
+	if correctTime/int64(correct) >= wrongTime/int64(rounds-correct) {
+		t.Error("wrong answers should take longer than correct ones on average")
+	}
+}
+
+func TestBotOpponent_PlanAnswer_NoAnswers(t *testing.T) {
+	text, _ := quiz.NewQuestionText("Empty?")
+	points, _ := quiz.NewPoints(100)
+	question, _ := quiz.NewQuestion(quiz.NewQuestionID(), text, points, 0)
+
+	if _, err := NewBotOpponent(nil).PlanAnswer(question, BotSkillForMMR(1000)); err != ErrQuestionHasNoAnswers {
+		t.Errorf("err = %v, want ErrQuestionHasNoAnswers", err)
+	}
+}
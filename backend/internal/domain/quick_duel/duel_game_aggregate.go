@@ -9,28 +9,71 @@ const (
 	TimePerQuestionSec = 10 // 10 seconds per question
 	BasePointsCorrect = 100 // Base points for correct answer
 	MinAnswerTimeMs = 200 // Anti-cheat: minimum 0.2 sec
+	MaxConsecutiveTimeouts = 3 // Missed rounds in a row before a player forfeits
 )
 
 // RoundAnswer tracks a player's answer for a round
 type RoundAnswer struct {
-	playerID  UserID
-	answerID  AnswerID
-	timeTaken int64 // milliseconds
-	isCorrect bool
-	points    int
+	playerID        UserID
+	submission      quiz.AnswerSubmission // zero when timed out
+	timeTaken       int64 // milliseconds, as measured by the server
+	clientTimeTaken int64 // milliseconds, as reported by the client
+	timingFlagged   bool  // client and server timings disagreed beyond TimingToleranceMs
+	isCorrect       bool
+	points          int
 }
 
+// ReconstructRoundAnswer reconstructs a RoundAnswer from persistence
+func ReconstructRoundAnswer(
+	playerID UserID,
+	submission quiz.AnswerSubmission,
+	timeTaken int64,
+	clientTimeTaken int64,
+	timingFlagged bool,
+	isCorrect bool,
+	points int,
+) RoundAnswer {
+	return RoundAnswer{
+		playerID:        playerID,
+		submission:      submission,
+		timeTaken:       timeTaken,
+		clientTimeTaken: clientTimeTaken,
+		timingFlagged:   timingFlagged,
+		isCorrect:       isCorrect,
+		points:          points,
+	}
+}
+
+// Getters
+func (ra RoundAnswer) PlayerID() UserID       { return ra.playerID }
+func (ra RoundAnswer) AnswerID() AnswerID     { return ra.submission.PrimaryAnswerID() }
+func (ra RoundAnswer) TimeTaken() int64       { return ra.timeTaken }
+func (ra RoundAnswer) ClientTimeTaken() int64 { return ra.clientTimeTaken }
+func (ra RoundAnswer) TimingFlagged() bool    { return ra.timingFlagged }
+func (ra RoundAnswer) IsCorrect() bool        { return ra.isCorrect }
+func (ra RoundAnswer) Points() int            { return ra.points }
+
+// Submission returns everything the player submitted (AnswerID is only its first option)
+func (ra RoundAnswer) Submission() quiz.AnswerSubmission { return ra.submission }
+
+// IsTimeout reports whether the round timer expired before the player answered
+func (ra RoundAnswer) IsTimeout() bool { return ra.submission.IsEmpty() }
+
 // DuelGame is the aggregate root for Quick Duel mode (1v1 PvP)
 type DuelGame struct {
-	id            GameID
-	player1       DuelPlayer
-	player2       DuelPlayer
-	questionIDs   []QuestionID // 7 question IDs
-	currentRound  int          // Current round (1-7, 0 = not started)
-	status        GameStatus
-	roundAnswers  map[int][]RoundAnswer // Round number -> answers
-	startedAt     int64        // Unix timestamp when game started
-	finishedAt    int64        // Unix timestamp when finished (0 if not finished)
+	id             GameID
+	player1        DuelPlayer
+	player2        DuelPlayer
+	questionIDs    []QuestionID // 7 question IDs
+	currentRound   int          // Current round (1-7, 0 = not started)
+	status         GameStatus
+	roundAnswers   map[int][]RoundAnswer // Round number -> answers
+	questionSentAt map[int]int64         // Round number -> Unix ms the question was sent to the players
+	startedAt      int64                 // Unix timestamp when game started
+	finishedAt     int64                 // Unix timestamp when finished (0 if not finished)
+	winnerID       *UserID               // Set when finished (nil = draw)
+	winReason      WinReason             // How the game was decided (empty = draw or not finished)
+	isFriendMatch  bool                  // Started from a friend challenge or a rematch
 
 	// Domain events collected during operations
 	events []Event
@@ -54,16 +97,17 @@ func NewDuelGame(
 
 	// Create
 	game := &DuelGame{
-		id:           NewGameID(),
-		player1:      player1,
-		player2:      player2,
-		questionIDs:  questionIDs,
-		currentRound: 0, // Not started yet
-		status:       GameStatusWaitingStart,
-		roundAnswers: make(map[int][]RoundAnswer),
-		startedAt:    0,
-		finishedAt:   0,
-		events:       make([]Event, 0),
+		id:             NewGameID(),
+		player1:        player1,
+		player2:        player2,
+		questionIDs:    questionIDs,
+		currentRound:   0, // Not started yet
+		status:         GameStatusWaitingStart,
+		roundAnswers:   make(map[int][]RoundAnswer),
+		questionSentAt: make(map[int]int64),
+		startedAt:      0,
+		finishedAt:     0,
+		events:         make([]Event, 0),
 	}
 
 	// Publish DuelGameCreated event
@@ -115,21 +159,44 @@ func (dg *DuelGame) Start(startedAt int64) error {
 
 // SubmitAnswerResult holds result of submitting an answer
 type SubmitAnswerResult struct {
-	IsCorrect       bool
-	PointsEarned    int
-	PlayerScore     int
-	OpponentScore   int
-	RoundNumber     int
-	BothAnswered    bool // True if both players answered this round
-	IsGameFinished  bool
-	WinnerID        *UserID // Set if game finished
+	IsCorrect      bool
+	Credit         float64 // Share of the round's points earned (partial for multi-select, ordering, numeric)
+	PointsEarned   int
+	TimeTaken      int64 // Milliseconds credited to the answer
+	PlayerScore    int
+	OpponentScore  int
+	RoundNumber    int
+	BothAnswered   bool // True if both players answered this round
+	TimingFlagged  bool // Client and server answer timings disagreed beyond tolerance
+	IsGameFinished bool
+	WinnerID       *UserID // Set if game finished
 }
 
-// SubmitAnswer processes a player's answer for current round
+// MarkQuestionSent records when the current round's question went out to the players.
+// The first time is kept, so resending the question to a reconnecting player
+// does not restart the clock; it is returned either way.
+func (dg *DuelGame) MarkQuestionSent(round int, sentAtMs int64) (int64, error) {
+	if dg.status != GameStatusInProgress {
+		return 0, ErrGameNotActive
+	}
+	if round != dg.currentRound {
+		return 0, ErrInvalidRound
+	}
+
+	if sentAt, ok := dg.questionSentAt[round]; ok {
+		return sentAt, nil
+	}
+	dg.questionSentAt[round] = sentAtMs
+	return sentAtMs, nil
+}
+
+// SubmitAnswer processes a player's answer for current round.
+// The answer time is measured from when the round's question was sent (see measureAnswerTime).
+// A partially right answer (see quiz.Question.Evaluate) earns its share of the points.
 func (dg *DuelGame) SubmitAnswer(
 	playerID UserID,
-	answerID AnswerID,
-	timeTaken int64,
+	submission quiz.AnswerSubmission,
+	receipt AnswerReceipt,
 	question *quiz.Question,
 	answeredAt int64,
 ) (*SubmitAnswerResult, error) {
@@ -143,12 +210,13 @@ func (dg *DuelGame) SubmitAnswer(
 		return nil, ErrPlayerNotInGame
 	}
 
-	// 3. Anti-cheat: validate and clamp answer time
-	if timeTaken < 0 {
+	// 3. Anti-cheat: measure, validate and clamp answer time
+	if receipt.ClientTimeMs < 0 {
 		return nil, ErrInvalidAnswerTime
 	}
-	const maxTimeTakenMs = int64(TimePerQuestionSec * 1000)       // 10000ms
-	const networkToleranceMs = int64(500)                          // 500ms tolerance
+	timeTaken, timingFlagged := measureAnswerTime(dg.questionSentAt[dg.currentRound], receipt)
+	const maxTimeTakenMs = int64(TimePerQuestionSec * 1000) // 10000ms
+	const networkToleranceMs = int64(500)                   // 500ms tolerance
 	if timeTaken > maxTimeTakenMs+networkToleranceMs {
 		timeTaken = maxTimeTakenMs // clamp to max, no speed bonus
 	}
@@ -161,29 +229,32 @@ func (dg *DuelGame) SubmitAnswer(
 		return nil, ErrPlayerAlreadyAnswered
 	}
 
-	// 5. Validate answer
-	answer, err := question.GetAnswer(answerID)
+	// 5. Validate and score the answer for the question's type
+	evaluation, err := question.Evaluate(submission)
 	if err != nil {
 		return nil, err
 	}
 
-	isCorrect := answer.IsCorrect()
+	isCorrect := evaluation.IsCorrect
 
 	// 6. Calculate points
 	points := 0
-	if isCorrect {
+	if evaluation.Credit > 0 {
 		basePoints := BasePointsCorrect
 		speedBonus := CalculateSpeedBonus(timeTaken)
-		points = basePoints + speedBonus
+		fullPoints, _ := quiz.NewPoints(basePoints + speedBonus)
+		points = evaluation.Scale(fullPoints).Value()
 	}
 
 	// 7. Record answer
 	roundAnswer := RoundAnswer{
-		playerID:  playerID,
-		answerID:  answerID,
-		timeTaken: timeTaken,
-		isCorrect: isCorrect,
-		points:    points,
+		playerID:        playerID,
+		submission:      submission,
+		timeTaken:       timeTaken,
+		clientTimeTaken: receipt.ClientTimeMs,
+		timingFlagged:   timingFlagged,
+		isCorrect:       isCorrect,
+		points:          points,
 	}
 	dg.roundAnswers[dg.currentRound] = append(dg.roundAnswers[dg.currentRound], roundAnswer)
 
@@ -199,7 +270,7 @@ func (dg *DuelGame) SubmitAnswer(
 		dg.id,
 		playerID,
 		question.ID(),
-		answerID,
+		submission.PrimaryAnswerID(),
 		timeTaken,
 		isCorrect,
 		points,
@@ -211,11 +282,14 @@ func (dg *DuelGame) SubmitAnswer(
 
 	result := &SubmitAnswerResult{
 		IsCorrect:      isCorrect,
+		Credit:         evaluation.Credit,
 		PointsEarned:   points,
+		TimeTaken:      timeTaken,
 		PlayerScore:    dg.getPlayerScore(playerID),
 		OpponentScore:  dg.getOpponentScore(playerID),
 		RoundNumber:    dg.currentRound,
 		BothAnswered:   bothAnswered,
+		TimingFlagged:  timingFlagged,
 		IsGameFinished: false,
 		WinnerID:       nil,
 	}
@@ -229,7 +303,7 @@ func (dg *DuelGame) SubmitAnswer(
 		// Check if game finished
 		if dg.status == GameStatusFinished {
 			result.IsGameFinished = true
-			result.WinnerID = dg.determineWinner()
+			result.WinnerID = dg.winnerID
 		}
 	}
 
@@ -261,12 +335,18 @@ func (dg *DuelGame) completeRound(completedAt int64) error {
 		completedAt,
 	))
 
-	// 2. Check if all rounds completed
+	// 2. A player who keeps missing rounds forfeits
+	if loserID, ok := dg.timedOutPlayer(); ok {
+		winnerID := dg.opponentOf(loserID)
+		return dg.finish(&winnerID, WinReasonTimeout, completedAt)
+	}
+
+	// 3. Check if all rounds completed
 	if dg.currentRound >= QuestionsPerDuel {
 		return dg.finishGame(completedAt)
 	}
 
-	// 3. Start next round
+	// 4. Start next round
 	dg.currentRound++
 	questionID := dg.questionIDs[dg.currentRound-1]
 
@@ -280,8 +360,16 @@ func (dg *DuelGame) completeRound(completedAt int64) error {
 	return nil
 }
 
-// finishGame finishes the game and calculates ELO changes
+// finishGame finishes the game after the last round: the outcome is decided
+// by score, then by total answer time; a full tie is a draw
 func (dg *DuelGame) finishGame(finishedAt int64) error {
+	winnerID, reason := dg.resolveOutcome()
+	return dg.finish(winnerID, reason, finishedAt)
+}
+
+// finish finishes the game with the given outcome.
+// Ratings are not the game's concern: PlayerRating applies the result afterwards.
+func (dg *DuelGame) finish(winnerID *UserID, reason WinReason, finishedAt int64) error {
 	// Validate state transition
 	if !dg.status.CanTransitionTo(GameStatusFinished) {
 		return ErrInvalidGameStatus
@@ -289,44 +377,16 @@ func (dg *DuelGame) finishGame(finishedAt int64) error {
 
 	dg.status = GameStatusFinished
 	dg.finishedAt = finishedAt
-
-	// Calculate new ELO ratings
-	var player1NewElo, player2NewElo EloRating
-	if dg.player1.Score() > dg.player2.Score() {
-		player1NewElo = dg.player1.Elo().CalculateNewRating(true, dg.player2.Elo().Rating())
-		player2NewElo = dg.player2.Elo().CalculateNewRating(false, dg.player1.Elo().Rating())
-	} else if dg.player1.Score() < dg.player2.Score() {
-		player1NewElo = dg.player1.Elo().CalculateNewRating(false, dg.player2.Elo().Rating())
-		player2NewElo = dg.player2.Elo().CalculateNewRating(true, dg.player1.Elo().Rating())
-	} else {
-		// Draw - both get symmetric draw ELO adjustment
-		player1NewElo = dg.player1.Elo().CalculateDrawRating(dg.player2.Elo().Rating())
-		player2NewElo = dg.player2.Elo().CalculateDrawRating(dg.player1.Elo().Rating())
-	}
-
-	// Update players' ELO
-	dg.player1 = dg.player1.UpdateElo(player1NewElo)
-	dg.player2 = dg.player2.UpdateElo(player2NewElo)
-
-	// Determine winner ID
-	var winnerID *UserID
-	if dg.player1.Score() > dg.player2.Score() {
-		id := dg.player1.UserID()
-		winnerID = &id
-	} else if dg.player2.Score() > dg.player1.Score() {
-		id := dg.player2.UserID()
-		winnerID = &id
-	}
-	// else: draw, winnerID = nil
+	dg.winnerID = winnerID
+	dg.winReason = reason
 
 	// Publish DuelGameFinished event
 	dg.events = append(dg.events, NewDuelGameFinishedEvent(
 		dg.id,
 		winnerID,
+		reason,
 		dg.player1,
 		dg.player2,
-		player1NewElo,
-		player2NewElo,
 		finishedAt,
 	))
 
@@ -381,7 +441,7 @@ func (dg *DuelGame) RecordTimeoutAnswer(playerID UserID, timedOutAt int64) (*Sub
 		}
 		if dg.status == GameStatusFinished {
 			result.IsGameFinished = true
-			result.WinnerID = dg.determineWinner()
+			result.WinnerID = dg.winnerID
 		}
 	}
 
@@ -451,7 +511,7 @@ type SurrenderResult struct {
 }
 
 // Surrender allows a player to forfeit the game mid-match.
-// The surrendering player loses; the opponent wins with full ELO gain.
+// The surrendering player loses; the opponent wins with a full rating gain.
 // Surrender is only allowed after the player has answered at least 3 questions.
 func (dg *DuelGame) Surrender(playerID UserID, surrenderedAt int64) (*SurrenderResult, error) {
 	if dg.status != GameStatusInProgress {
@@ -487,42 +547,34 @@ func (dg *DuelGame) Surrender(playerID UserID, surrenderedAt int64) (*SurrenderR
 		surrenderedAt,
 	))
 
-	// Force scores: surrendering player gets 0, opponent gets 1 to guarantee win for ELO
-	if dg.player1.UserID().Equals(playerID) {
-		dg.player1 = dg.player1.WithScore(0)
-		dg.player2 = dg.player2.WithScore(dg.player2.Score() + 1)
-	} else {
-		dg.player2 = dg.player2.WithScore(0)
-		dg.player1 = dg.player1.WithScore(dg.player1.Score() + 1)
-	}
-
-	if err := dg.finishGame(surrenderedAt); err != nil {
+	if err := dg.finish(&opponentID, WinReasonSurrender, surrenderedAt); err != nil {
 		return nil, err
 	}
 
 	return &SurrenderResult{WinnerID: opponentID}, nil
 }
 
-// ReplayRoundAnswer restores a previously submitted answer into the in-memory roundAnswers map.
-// Used to re-hydrate state from an external cache (Redis) since roundAnswers are not persisted to DB.
-// Does NOT trigger game logic, events, or score changes — purely state restoration.
-func (dg *DuelGame) ReplayRoundAnswer(round int, playerID UserID, answerID AnswerID, timeTaken int64, isCorrect bool, points int) {
-	if dg.roundAnswers == nil {
-		dg.roundAnswers = make(map[int][]RoundAnswer)
+// ForfeitDisconnected ends the game in favour of the opponent of a player
+// who did not reconnect within the grace period.
+func (dg *DuelGame) ForfeitDisconnected(playerID UserID, forfeitedAt int64) (*UserID, error) {
+	if dg.status != GameStatusInProgress {
+		return nil, ErrGameNotActive
 	}
-	// Skip if already present
-	for _, a := range dg.roundAnswers[round] {
-		if a.playerID.Equals(playerID) {
-			return
-		}
+	if !dg.isPlayerInGame(playerID) {
+		return nil, ErrPlayerNotInGame
 	}
-	dg.roundAnswers[round] = append(dg.roundAnswers[round], RoundAnswer{
-		playerID:  playerID,
-		answerID:  answerID,
-		timeTaken: timeTaken,
-		isCorrect: isCorrect,
-		points:    points,
-	})
+
+	winnerID := dg.opponentOf(playerID)
+	if err := dg.finish(&winnerID, WinReasonDisconnect, forfeitedAt); err != nil {
+		return nil, err
+	}
+
+	return &winnerID, nil
+}
+
+// MarkFriendMatch flags the game as played between friends (challenge or rematch)
+func (dg *DuelGame) MarkFriendMatch() {
+	dg.isFriendMatch = true
 }
 
 // Helper methods
@@ -572,6 +624,60 @@ func (dg *DuelGame) getOpponentScore(playerID UserID) int {
 	return dg.player1.Score()
 }
 
+func (dg *DuelGame) opponentOf(playerID UserID) UserID {
+	if dg.player1.UserID().Equals(playerID) {
+		return dg.player2.UserID()
+	}
+	return dg.player1.UserID()
+}
+
+// resolveOutcome decides a game that went the distance: score first, then total time.
+// Unlike determineWinner it does not fall back to player IDs: a full tie is a draw.
+func (dg *DuelGame) resolveOutcome() (*UserID, WinReason) {
+	if dg.player1.Score() != dg.player2.Score() {
+		return dg.determineWinner(), WinReasonScore
+	}
+	if dg.player1.TotalTimeMs() != dg.player2.TotalTimeMs() {
+		return dg.determineWinner(), WinReasonTime
+	}
+	return nil, ""
+}
+
+// timedOutPlayer returns the player who missed the last MaxConsecutiveTimeouts rounds
+// while the opponent kept playing. If both stopped answering nobody forfeits.
+func (dg *DuelGame) timedOutPlayer() (UserID, bool) {
+	p1Out := dg.consecutiveTimeouts(dg.player1.UserID()) >= MaxConsecutiveTimeouts
+	p2Out := dg.consecutiveTimeouts(dg.player2.UserID()) >= MaxConsecutiveTimeouts
+
+	switch {
+	case p1Out && !p2Out:
+		return dg.player1.UserID(), true
+	case p2Out && !p1Out:
+		return dg.player2.UserID(), true
+	default:
+		return UserID{}, false
+	}
+}
+
+// consecutiveTimeouts counts rounds the player timed out on, going back from the current round
+func (dg *DuelGame) consecutiveTimeouts(playerID UserID) int {
+	count := 0
+	for round := dg.currentRound; round >= 1; round-- {
+		timedOut := false
+		for _, ans := range dg.roundAnswers[round] {
+			if ans.playerID.Equals(playerID) {
+				timedOut = ans.IsTimeout()
+				break
+			}
+		}
+		if !timedOut {
+			break
+		}
+		count++
+	}
+	return count
+}
+
 func (dg *DuelGame) determineWinner() *UserID {
 	if dg.player1.Score() > dg.player2.Score() {
 		id := dg.player1.UserID()
@@ -600,9 +706,9 @@ func (dg *DuelGame) determineWinner() *UserID {
 }
 
 // Getters
-func (dg *DuelGame) ID() GameID            { return dg.id }
-func (dg *DuelGame) Player1() DuelPlayer   { return dg.player1 }
-func (dg *DuelGame) Player2() DuelPlayer   { return dg.player2 }
+func (dg *DuelGame) ID() GameID          { return dg.id }
+func (dg *DuelGame) Player1() DuelPlayer { return dg.player1 }
+func (dg *DuelGame) Player2() DuelPlayer { return dg.player2 }
 func (dg *DuelGame) QuestionIDs() []QuestionID {
 	copy := make([]QuestionID, len(dg.questionIDs))
 	for i, qid := range dg.questionIDs {
@@ -610,11 +716,43 @@ func (dg *DuelGame) QuestionIDs() []QuestionID {
 	}
 	return copy
 }
-func (dg *DuelGame) CurrentRound() int     { return dg.currentRound }
-func (dg *DuelGame) Status() GameStatus    { return dg.status }
-func (dg *DuelGame) StartedAt() int64      { return dg.startedAt }
-func (dg *DuelGame) FinishedAt() int64     { return dg.finishedAt }
-func (dg *DuelGame) IsFinished() bool      { return dg.status.IsTerminal() }
+func (dg *DuelGame) CurrentRound() int    { return dg.currentRound }
+func (dg *DuelGame) Status() GameStatus   { return dg.status }
+func (dg *DuelGame) StartedAt() int64     { return dg.startedAt }
+func (dg *DuelGame) FinishedAt() int64    { return dg.finishedAt }
+func (dg *DuelGame) IsFinished() bool     { return dg.status.IsTerminal() }
+func (dg *DuelGame) WinReason() WinReason { return dg.winReason }
+func (dg *DuelGame) IsFriendMatch() bool  { return dg.isFriendMatch }
+
+// WinnerID returns the winner of a finished game (nil for a draw or an unfinished game)
+func (dg *DuelGame) WinnerID() *UserID {
+	if dg.winnerID == nil {
+		return nil
+	}
+	id := *dg.winnerID
+	return &id
+}
+
+// QuestionSentAt returns when the round's question was sent, in Unix ms (0 if unknown)
+func (dg *DuelGame) QuestionSentAt(round int) int64 { return dg.questionSentAt[round] }
+
+// QuestionSentTimes returns a copy of the question sent times, by round
+func (dg *DuelGame) QuestionSentTimes() map[int]int64 {
+	sentAt := make(map[int]int64, len(dg.questionSentAt))
+	for round, at := range dg.questionSentAt {
+		sentAt[round] = at
+	}
+	return sentAt
+}
+
+// RoundAnswers returns a copy of the answers given in each round
+func (dg *DuelGame) RoundAnswers() map[int][]RoundAnswer {
+	answers := make(map[int][]RoundAnswer, len(dg.roundAnswers))
+	for round, roundAnswers := range dg.roundAnswers {
+		answers[round] = append([]RoundAnswer(nil), roundAnswers...)
+	}
+	return answers
+}
 
 // Events returns collected domain events and clears them
 func (dg *DuelGame) Events() []Event {
@@ -632,19 +770,34 @@ func ReconstructDuelGame(
 	currentRound int,
 	status GameStatus,
 	roundAnswers map[int][]RoundAnswer,
+	questionSentAt map[int]int64,
 	startedAt int64,
 	finishedAt int64,
+	winnerID *UserID,
+	winReason WinReason,
+	isFriendMatch bool,
 ) *DuelGame {
+	if roundAnswers == nil {
+		roundAnswers = make(map[int][]RoundAnswer)
+	}
+	if questionSentAt == nil {
+		questionSentAt = make(map[int]int64)
+	}
+
 	return &DuelGame{
-		id:           id,
-		player1:      player1,
-		player2:      player2,
-		questionIDs:  questionIDs,
-		currentRound: currentRound,
-		status:       status,
-		roundAnswers: roundAnswers,
-		startedAt:    startedAt,
-		finishedAt:   finishedAt,
-		events:       make([]Event, 0), // Don't replay events from DB
+		id:             id,
+		player1:        player1,
+		player2:        player2,
+		questionIDs:    questionIDs,
+		currentRound:   currentRound,
+		status:         status,
+		roundAnswers:   roundAnswers,
+		questionSentAt: questionSentAt,
+		startedAt:      startedAt,
+		finishedAt:     finishedAt,
+		winnerID:       winnerID,
+		winReason:      winReason,
+		isFriendMatch:  isFriendMatch,
+		events:         make([]Event, 0), // Don't replay events from DB
 	}
 }
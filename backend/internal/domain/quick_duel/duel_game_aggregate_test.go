@@ -12,8 +12,8 @@ func TestNewDuelGame_Success(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -60,8 +60,8 @@ func TestNewDuelGame_InvalidInputs(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	validQuestions := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -79,7 +79,7 @@ func TestNewDuelGame_InvalidInputs(t *testing.T) {
 	}{
 		{
 			name:        "Invalid player1 ID",
-			player1:     NewDuelPlayer(UserID{}, "Invalid", NewEloRating()),
+			player1:     NewDuelPlayer(UserID{}, "Invalid", InitialMMR),
 			player2:     player2,
 			questionIDs: validQuestions,
 			expectedErr: ErrInvalidGameID,
@@ -87,7 +87,7 @@ func TestNewDuelGame_InvalidInputs(t *testing.T) {
 		{
 			name:        "Invalid player2 ID",
 			player1:     player1,
-			player2:     NewDuelPlayer(UserID{}, "Invalid", NewEloRating()),
+			player2:     NewDuelPlayer(UserID{}, "Invalid", InitialMMR),
 			questionIDs: validQuestions,
 			expectedErr: ErrInvalidGameID,
 		},
@@ -129,8 +129,8 @@ func TestDuelGame_Start(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -167,8 +167,8 @@ func TestDuelGame_Start_AlreadyStarted(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -196,8 +196,8 @@ func TestDuelGame_CurrentRound(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -226,8 +226,8 @@ func TestDuelGame_IsFinished(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating())
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating())
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -257,8 +257,8 @@ func TestDuelGame_PlayerScores(t *testing.T) {
 	player2ID, _ := shared.NewUserID("player2")
 
 	// Create players with initial scores
-	player1 := NewDuelPlayer(player1ID, "Player1", NewEloRating()).AddScore(500, 0)
-	player2 := NewDuelPlayer(player2ID, "Player2", NewEloRating()).AddScore(300, 0)
+	player1 := NewDuelPlayer(player1ID, "Player1", InitialMMR).AddScore(500, 0)
+	player2 := NewDuelPlayer(player2ID, "Player2", InitialMMR).AddScore(300, 0)
 
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
 	for i := 0; i < QuestionsPerDuel; i++ {
@@ -328,8 +328,12 @@ func buildFinishedGame(t *testing.T, p1 DuelPlayer, p2 DuelPlayer) *DuelGame {
 		QuestionsPerDuel,
 		GameStatusFinished,
 		make(map[int][]RoundAnswer),
+		nil,
 		int64(1000000),
 		int64(1001000),
+		nil,
+		"",
+		false,
 	)
 	return game
 }
@@ -338,11 +342,11 @@ func buildFinishedGame(t *testing.T, p1 DuelPlayer, p2 DuelPlayer) *DuelGame {
 func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 	p1ID, _ := shared.NewUserID("aaa-player1")
 	p2ID, _ := shared.NewUserID("bbb-player2")
-	elo := NewEloRating()
+	mmr := InitialMMR
 
 	t.Run("Player1 wins by score", func(t *testing.T) {
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(700, 20000)
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(500, 15000)
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(700, 20000)
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(500, 15000)
 		game := buildFinishedGame(t, p1, p2)
 
 		winner := game.determineWinner()
@@ -352,8 +356,8 @@ func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 	})
 
 	t.Run("Player2 wins by score", func(t *testing.T) {
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(500, 20000)
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(700, 15000)
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(500, 20000)
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(700, 15000)
 		game := buildFinishedGame(t, p1, p2)
 
 		winner := game.determineWinner()
@@ -363,8 +367,8 @@ func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 	})
 
 	t.Run("Player1 wins tiebreaker by less total time", func(t *testing.T) {
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(700, 10000) // 10s total
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(700, 20000) // 20s total
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(700, 10000) // 10s total
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(700, 20000) // 20s total
 		game := buildFinishedGame(t, p1, p2)
 
 		winner := game.determineWinner()
@@ -374,8 +378,8 @@ func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 	})
 
 	t.Run("Player2 wins tiebreaker by less total time", func(t *testing.T) {
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(700, 20000) // 20s total
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(700, 10000) // 10s total
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(700, 20000) // 20s total
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(700, 10000) // 10s total
 		game := buildFinishedGame(t, p1, p2)
 
 		winner := game.determineWinner()
@@ -386,8 +390,8 @@ func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 
 	t.Run("Player1 wins tiebreaker by smaller playerID when times equal", func(t *testing.T) {
 		// p1ID = "aaa-player1" < "bbb-player2" = p2ID
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(700, 15000)
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(700, 15000)
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(700, 15000)
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(700, 15000)
 		game := buildFinishedGame(t, p1, p2)
 
 		winner := game.determineWinner()
@@ -397,8 +401,8 @@ func TestDuelGame_DetermineWinner_Tiebreaker(t *testing.T) {
 	})
 
 	t.Run("Tiebreaker is deterministic (same result on repeated calls)", func(t *testing.T) {
-		p1 := NewDuelPlayer(p1ID, "P1", elo).AddScore(700, 15000)
-		p2 := NewDuelPlayer(p2ID, "P2", elo).AddScore(700, 15000)
+		p1 := NewDuelPlayer(p1ID, "P1", mmr).AddScore(700, 15000)
+		p2 := NewDuelPlayer(p2ID, "P2", mmr).AddScore(700, 15000)
 		game := buildFinishedGame(t, p1, p2)
 
 		winner1 := game.determineWinner()
@@ -414,8 +418,8 @@ func TestDuelGame_ReconstructDuelGame(t *testing.T) {
 	player1ID, _ := shared.NewUserID("player1")
 	player2ID, _ := shared.NewUserID("player2")
 
-	player1 := NewDuelPlayer(player1ID, "Player1", ReconstructEloRating(1200, 50))
-	player2 := NewDuelPlayer(player2ID, "Player2", ReconstructEloRating(1100, 30))
+	player1 := NewDuelPlayer(player1ID, "Player1", 1200)
+	player2 := NewDuelPlayer(player2ID, "Player2", 1100)
 
 	gameID := NewGameID()
 	questionIDs := make([]QuestionID, QuestionsPerDuel)
@@ -424,7 +428,16 @@ func TestDuelGame_ReconstructDuelGame(t *testing.T) {
 	}
 
 	now := int64(1000000)
-	roundAnswers := make(map[int][]RoundAnswer)
+	answerID := quiz.NewAnswerID()
+	roundAnswers := map[int][]RoundAnswer{
+		4: {
+			ReconstructRoundAnswer(player1ID, quiz.NewChoiceSubmission(answerID), 3000, 3000, false, true, 120),
+			ReconstructRoundAnswer(player2ID, quiz.AnswerSubmission{}, TimePerQuestionSec*1000, 0, false, false, 0),
+		},
+		5: {
+			ReconstructRoundAnswer(player2ID, quiz.NewChoiceSubmission(answerID), 4000, 4000, false, true, 110),
+		},
+	}
 
 	game := ReconstructDuelGame(
 		gameID,
@@ -434,8 +447,12 @@ func TestDuelGame_ReconstructDuelGame(t *testing.T) {
 		5, // Round 5
 		GameStatusInProgress,
 		roundAnswers,
+		nil,
 		now,
 		0, // Not finished
+		nil,
+		"",
+		true,
 	)
 
 	if game == nil {
@@ -450,6 +467,20 @@ func TestDuelGame_ReconstructDuelGame(t *testing.T) {
 	if game.Status() != GameStatusInProgress {
 		t.Errorf("Status = %v, want %v", game.Status(), GameStatusInProgress)
 	}
+	if !game.IsFriendMatch() {
+		t.Error("IsFriendMatch should be restored")
+	}
+
+	// Round answers are hydrated: player2 already answered round 5, player1 did not
+	if !game.hasPlayerAnsweredRound(player2ID, 5) {
+		t.Error("player2 answer for round 5 should be restored")
+	}
+	if game.hasPlayerAnsweredRound(player1ID, 5) {
+		t.Error("player1 has not answered round 5")
+	}
+	if answers := game.RoundAnswers()[4]; len(answers) != 2 || !answers[1].IsTimeout() {
+		t.Errorf("round 4 answers not restored: %+v", answers)
+	}
 
 	// Events should be empty after reconstruction
 	events := game.Events()
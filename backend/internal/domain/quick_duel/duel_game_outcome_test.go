@@ -0,0 +1,239 @@
+package quick_duel
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// outcomeFixture is a started duel plus a question to answer every round with
+type outcomeFixture struct {
+	game     *DuelGame
+	p1ID     UserID
+	p2ID     UserID
+	question *quiz.Question
+	correct  AnswerID
+	wrong    AnswerID
+	now      int64
+}
+
+func newOutcomeFixture(t *testing.T) *outcomeFixture {
+	t.Helper()
+	p1ID, _ := shared.NewUserID("aaa-player1")
+	p2ID, _ := shared.NewUserID("bbb-player2")
+
+	questionIDs := make([]QuestionID, QuestionsPerDuel)
+	for i := 0; i < QuestionsPerDuel; i++ {
+		questionIDs[i] = quiz.NewQuestionID()
+	}
+
+	text, _ := quiz.NewQuestionText("2 + 2?")
+	points, _ := quiz.NewPoints(100)
+	question, err := quiz.NewQuestion(questionIDs[0], text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+	correctID, wrongID := quiz.NewAnswerID(), quiz.NewAnswerID()
+	correctText, _ := quiz.NewAnswerText("4")
+	wrongText, _ := quiz.NewAnswerText("5")
+	correct, _ := quiz.NewAnswer(correctID, correctText, true, 0)
+	wrong, _ := quiz.NewAnswer(wrongID, wrongText, false, 1)
+	_ = question.AddAnswer(*correct)
+	_ = question.AddAnswer(*wrong)
+
+	now := int64(1000000)
+	game, err := NewDuelGame(
+		NewDuelPlayer(p1ID, "P1", InitialMMR),
+		NewDuelPlayer(p2ID, "P2", InitialMMR),
+		questionIDs,
+		now,
+	)
+	if err != nil {
+		t.Fatalf("NewDuelGame: %v", err)
+	}
+	if err := game.Start(now); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	game.Events()
+
+	return &outcomeFixture{
+		game:     game,
+		p1ID:     p1ID,
+		p2ID:     p2ID,
+		question: question,
+		correct:  correctID,
+		wrong:    wrongID,
+		now:      now,
+	}
+}
+
+func (f *outcomeFixture) answer(t *testing.T, playerID UserID, answerID AnswerID, timeTaken int64) {
+	t.Helper()
+	f.now += timeTaken
+	if _, err := f.game.SubmitAnswer(playerID, quiz.NewChoiceSubmission(answerID), NewClientAnswerReceipt(timeTaken), f.question, f.now); err != nil {
+		t.Fatalf("SubmitAnswer(round %d): %v", f.game.CurrentRound(), err)
+	}
+}
+
+func (f *outcomeFixture) timeout(t *testing.T, playerID UserID) {
+	t.Helper()
+	if _, err := f.game.RecordTimeoutAnswer(playerID, f.now); err != nil {
+		t.Fatalf("RecordTimeoutAnswer(round %d): %v", f.game.CurrentRound(), err)
+	}
+}
+
+func finishedEvent(t *testing.T, game *DuelGame) DuelGameFinishedEvent {
+	t.Helper()
+	for _, event := range game.Events() {
+		if finished, ok := event.(DuelGameFinishedEvent); ok {
+			return finished
+		}
+	}
+	t.Fatal("expected DuelGameFinishedEvent")
+	return DuelGameFinishedEvent{}
+}
+
+func TestDuelGame_Outcome_WinByScore(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= QuestionsPerDuel; round++ {
+		f.answer(t, f.p1ID, f.correct, 3000)
+		f.answer(t, f.p2ID, f.wrong, 1000)
+	}
+
+	if !f.game.IsFinished() {
+		t.Fatal("game should be finished after the last round")
+	}
+	if winner := f.game.WinnerID(); winner == nil || !winner.Equals(f.p1ID) {
+		t.Errorf("WinnerID = %v, want player1", winner)
+	}
+	if f.game.WinReason() != WinReasonScore {
+		t.Errorf("WinReason = %q, want %q", f.game.WinReason(), WinReasonScore)
+	}
+	if event := finishedEvent(t, f.game); event.WinReason() != WinReasonScore {
+		t.Errorf("event WinReason = %q, want %q", event.WinReason(), WinReasonScore)
+	}
+}
+
+func TestDuelGame_Outcome_TimeTiebreak(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= QuestionsPerDuel; round++ {
+		// Both players score the same: past the speed bonus window
+		f.answer(t, f.p1ID, f.correct, 9000)
+		f.answer(t, f.p2ID, f.correct, 9500)
+	}
+
+	if f.game.Player1().Score() != f.game.Player2().Score() {
+		t.Fatalf("scores should be equal, got %d vs %d", f.game.Player1().Score(), f.game.Player2().Score())
+	}
+	if winner := f.game.WinnerID(); winner == nil || !winner.Equals(f.p1ID) {
+		t.Errorf("WinnerID = %v, want faster player1", winner)
+	}
+	if f.game.WinReason() != WinReasonTime {
+		t.Errorf("WinReason = %q, want %q", f.game.WinReason(), WinReasonTime)
+	}
+}
+
+func TestDuelGame_Outcome_FullTieIsDraw(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= QuestionsPerDuel; round++ {
+		f.answer(t, f.p1ID, f.correct, 9000)
+		f.answer(t, f.p2ID, f.correct, 9000)
+	}
+
+	if winner := f.game.WinnerID(); winner != nil {
+		t.Errorf("WinnerID = %v, want draw", winner)
+	}
+	if f.game.WinReason() != "" {
+		t.Errorf("WinReason = %q, want empty for a draw", f.game.WinReason())
+	}
+	event := finishedEvent(t, f.game)
+	if event.WinnerID() != nil {
+		t.Error("finished event should report a draw")
+	}
+}
+
+func TestDuelGame_Outcome_Surrender(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= 3; round++ {
+		f.answer(t, f.p1ID, f.correct, 2000)
+		f.answer(t, f.p2ID, f.wrong, 2000)
+	}
+	scoreBefore := f.game.Player1().Score()
+
+	result, err := f.game.Surrender(f.p1ID, f.now)
+	if err != nil {
+		t.Fatalf("Surrender: %v", err)
+	}
+
+	if !result.WinnerID.Equals(f.p2ID) {
+		t.Errorf("Surrender WinnerID = %v, want player2", result.WinnerID)
+	}
+	if winner := f.game.WinnerID(); winner == nil || !winner.Equals(f.p2ID) {
+		t.Errorf("WinnerID = %v, want player2", winner)
+	}
+	if f.game.WinReason() != WinReasonSurrender {
+		t.Errorf("WinReason = %q, want %q", f.game.WinReason(), WinReasonSurrender)
+	}
+	// Scores are kept as played: the outcome no longer depends on them
+	if f.game.Player1().Score() != scoreBefore {
+		t.Errorf("surrendering player's score changed: %d -> %d", scoreBefore, f.game.Player1().Score())
+	}
+}
+
+func TestDuelGame_Outcome_ConsecutiveTimeoutsForfeit(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= MaxConsecutiveTimeouts; round++ {
+		f.answer(t, f.p1ID, f.correct, 2000)
+		f.timeout(t, f.p2ID)
+	}
+
+	if !f.game.IsFinished() {
+		t.Fatalf("game should end after %d missed rounds", MaxConsecutiveTimeouts)
+	}
+	if winner := f.game.WinnerID(); winner == nil || !winner.Equals(f.p1ID) {
+		t.Errorf("WinnerID = %v, want player1", winner)
+	}
+	if f.game.WinReason() != WinReasonTimeout {
+		t.Errorf("WinReason = %q, want %q", f.game.WinReason(), WinReasonTimeout)
+	}
+}
+
+func TestDuelGame_Outcome_BothTimingOutKeepsPlaying(t *testing.T) {
+	f := newOutcomeFixture(t)
+	for round := 1; round <= MaxConsecutiveTimeouts; round++ {
+		f.timeout(t, f.p1ID)
+		f.timeout(t, f.p2ID)
+	}
+
+	if f.game.IsFinished() {
+		t.Fatal("nobody forfeits when both players stop answering")
+	}
+	if f.game.CurrentRound() != MaxConsecutiveTimeouts+1 {
+		t.Errorf("CurrentRound = %d, want %d", f.game.CurrentRound(), MaxConsecutiveTimeouts+1)
+	}
+}
+
+func TestDuelGame_Outcome_ForfeitDisconnected(t *testing.T) {
+	f := newOutcomeFixture(t)
+	f.answer(t, f.p1ID, f.correct, 2000)
+
+	winnerID, err := f.game.ForfeitDisconnected(f.p1ID, f.now)
+	if err != nil {
+		t.Fatalf("ForfeitDisconnected: %v", err)
+	}
+
+	if !winnerID.Equals(f.p2ID) {
+		t.Errorf("winner = %v, want player2", winnerID)
+	}
+	if f.game.WinReason() != WinReasonDisconnect {
+		t.Errorf("WinReason = %q, want %q", f.game.WinReason(), WinReasonDisconnect)
+	}
+	if !f.game.WinReason().IsForfeit() {
+		t.Error("disconnect should count as a forfeit")
+	}
+
+	if _, err := f.game.ForfeitDisconnected(f.p1ID, f.now); err != ErrGameNotActive {
+		t.Errorf("second forfeit: got %v, want ErrGameNotActive", err)
+	}
+}
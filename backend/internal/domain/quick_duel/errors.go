@@ -13,6 +13,7 @@ const (
 	CodeGameAlreadyFinished ErrorCode = "GAME_ALREADY_FINISHED"
 	CodeGameNotActive       ErrorCode = "GAME_NOT_ACTIVE"
 	CodeGameNotStarted      ErrorCode = "GAME_NOT_STARTED"
+	CodeGameNotFinished     ErrorCode = "GAME_NOT_FINISHED"
 	CodeInvalidGameStatus   ErrorCode = "INVALID_GAME_STATUS"
 
 	// Player error codes
@@ -59,6 +60,7 @@ var (
 	ErrGameAlreadyFinished = errors.New("duel game already finished")
 	ErrGameNotActive       = errors.New("duel game is not active")
 	ErrGameNotStarted      = errors.New("duel game not started yet")
+	ErrGameNotFinished     = errors.New("duel game is not finished yet")
 	ErrInvalidGameStatus   = errors.New("invalid game status transition")
 
 	// Player errors
@@ -95,4 +97,7 @@ var (
 	ErrReferralAlreadyExists = errors.New("referral already exists")
 	ErrMilestoneNotReached  = errors.New("milestone not reached")
 	ErrRewardAlreadyClaimed = errors.New("reward already claimed")
+
+	// Bot errors
+	ErrQuestionHasNoAnswers = errors.New("question has no answers")
 )
@@ -182,29 +182,26 @@ func (e RoundCompletedEvent) Player2Answered() bool  { return e.player2Answered
 type DuelGameFinishedEvent struct {
 	gameID        GameID
 	winnerID      *UserID // nil if draw
+	winReason     WinReason
 	player1       DuelPlayer
 	player2       DuelPlayer
-	player1NewElo EloRating
-	player2NewElo EloRating
 	occurredAt    int64
 }
 
 func NewDuelGameFinishedEvent(
 	gameID GameID,
 	winnerID *UserID,
+	winReason WinReason,
 	player1 DuelPlayer,
 	player2 DuelPlayer,
-	player1NewElo EloRating,
-	player2NewElo EloRating,
 	occurredAt int64,
 ) DuelGameFinishedEvent {
 	return DuelGameFinishedEvent{
 		gameID:        gameID,
 		winnerID:      winnerID,
+		winReason:     winReason,
 		player1:       player1,
 		player2:       player2,
-		player1NewElo: player1NewElo,
-		player2NewElo: player2NewElo,
 		occurredAt:    occurredAt,
 	}
 }
@@ -213,10 +210,9 @@ func (e DuelGameFinishedEvent) EventType() string       { return "duel_game_fini
 func (e DuelGameFinishedEvent) OccurredAt() int64       { return e.occurredAt }
 func (e DuelGameFinishedEvent) GameID() GameID          { return e.gameID }
 func (e DuelGameFinishedEvent) WinnerID() *UserID       { return e.winnerID }
+func (e DuelGameFinishedEvent) WinReason() WinReason    { return e.winReason }
 func (e DuelGameFinishedEvent) Player1() DuelPlayer     { return e.player1 }
 func (e DuelGameFinishedEvent) Player2() DuelPlayer     { return e.player2 }
-func (e DuelGameFinishedEvent) Player1NewElo() EloRating { return e.player1NewElo }
-func (e DuelGameFinishedEvent) Player2NewElo() EloRating { return e.player2NewElo }
 
 // PlayerDisconnectedEvent fired when a player loses connection
 type PlayerDisconnectedEvent struct {
@@ -346,6 +342,70 @@ func (e PlayerDemotedEvent) ToLeague() League      { return e.toLeague }
 func (e PlayerDemotedEvent) ToDivision() Division  { return e.toDivision }
 func (e PlayerDemotedEvent) NewMMR() int           { return e.newMMR }
 
+// PlayerDemotionProtectedEvent fired when demotion protection keeps a player at their rank floor
+type PlayerDemotionProtectedEvent struct {
+	playerID   UserID
+	league     League
+	division   Division
+	mmr        int
+	occurredAt int64
+}
+
+func NewPlayerDemotionProtectedEvent(
+	playerID UserID,
+	league League,
+	division Division,
+	mmr int,
+	occurredAt int64,
+) PlayerDemotionProtectedEvent {
+	return PlayerDemotionProtectedEvent{
+		playerID:   playerID,
+		league:     league,
+		division:   division,
+		mmr:        mmr,
+		occurredAt: occurredAt,
+	}
+}
+
+func (e PlayerDemotionProtectedEvent) EventType() string  { return "player_demotion_protected" }
+func (e PlayerDemotionProtectedEvent) OccurredAt() int64  { return e.occurredAt }
+func (e PlayerDemotionProtectedEvent) PlayerID() UserID   { return e.playerID }
+func (e PlayerDemotionProtectedEvent) League() League     { return e.league }
+func (e PlayerDemotionProtectedEvent) Division() Division { return e.division }
+func (e PlayerDemotionProtectedEvent) MMR() int           { return e.mmr }
+
+// PlayerPlacedEvent fired when a player finishes placement and gets a visible league
+type PlayerPlacedEvent struct {
+	playerID   UserID
+	league     League
+	division   Division
+	mmr        int
+	occurredAt int64
+}
+
+func NewPlayerPlacedEvent(
+	playerID UserID,
+	league League,
+	division Division,
+	mmr int,
+	occurredAt int64,
+) PlayerPlacedEvent {
+	return PlayerPlacedEvent{
+		playerID:   playerID,
+		league:     league,
+		division:   division,
+		mmr:        mmr,
+		occurredAt: occurredAt,
+	}
+}
+
+func (e PlayerPlacedEvent) EventType() string  { return "player_placed" }
+func (e PlayerPlacedEvent) OccurredAt() int64  { return e.occurredAt }
+func (e PlayerPlacedEvent) PlayerID() UserID   { return e.playerID }
+func (e PlayerPlacedEvent) League() League     { return e.league }
+func (e PlayerPlacedEvent) Division() Division { return e.division }
+func (e PlayerPlacedEvent) MMR() int           { return e.mmr }
+
 // SeasonResetEvent fired when MMR is reset for new season
 type SeasonResetEvent struct {
 	playerID    UserID
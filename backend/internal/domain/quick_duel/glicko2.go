@@ -0,0 +1,198 @@
+package quick_duel
+
+import "math"
+
+// Glicko-2 rating engine constants.
+// Ratings live on the MMR scale (InitialMMR is the centre), RD and volatility
+// follow the Glicko-2 paper (http://www.glicko.net/glicko/glicko2.pdf).
+const (
+	InitialRatingDeviation = 350.0 // RD of a player who never played
+	MinRatingDeviation     = 50.0  // RD floor, so established ratings keep moving
+	MaxRatingDeviation     = 350.0
+	InitialVolatility      = 0.06
+	GlickoTau              = 0.5   // constrains how fast volatility changes
+	RatingPeriodSeconds    = 86400 // an idle day is one rating period of RD decay
+	SeasonResetMinRD       = 150.0 // a soft reset leaves every rating at least this uncertain
+	PlacementMatches       = 5     // games before a visible league is assigned
+
+	glickoScale       = 173.7178 // rating points per Glicko-2 unit
+	glickoConvergence = 0.000001
+)
+
+// Glicko2Rating is a player's strength estimate: rating, rating deviation (RD,
+// how uncertain the rating is) and volatility (how erratic results are)
+type Glicko2Rating struct {
+	rating     float64
+	rd         float64
+	volatility float64
+}
+
+// NewGlicko2Rating returns the rating of a player who never played
+func NewGlicko2Rating() Glicko2Rating {
+	return Glicko2Rating{
+		rating:     InitialMMR,
+		rd:         InitialRatingDeviation,
+		volatility: InitialVolatility,
+	}
+}
+
+// ReconstructGlicko2Rating reconstructs Glicko2Rating from persistence
+func ReconstructGlicko2Rating(rating float64, rd float64, volatility float64) Glicko2Rating {
+	return Glicko2Rating{
+		rating:     rating,
+		rd:         rd,
+		volatility: volatility,
+	}
+}
+
+// Rate returns the rating after one game against opponent.
+// score is 1 for a win, 0.5 for a draw and 0 for a loss; each game is its own rating period.
+func (g Glicko2Rating) Rate(opponent Glicko2Rating, score float64) Glicko2Rating {
+	mu, phi := g.toGlicko2()
+	opponentMu, opponentPhi := opponent.toGlicko2()
+
+	gPhi := glickoG(opponentPhi)
+	expected := 1 / (1 + math.Exp(-gPhi*(mu-opponentMu)))
+	v := 1 / (gPhi * gPhi * expected * (1 - expected))
+	delta := v * gPhi * (score - expected)
+
+	volatility := g.nextVolatility(phi, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + volatility*volatility)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*gPhi*(score-expected)
+
+	return Glicko2Rating{
+		rating:     newMu*glickoScale + InitialMMR,
+		rd:         clampRD(newPhi * glickoScale),
+		volatility: volatility,
+	}
+}
+
+// nextVolatility solves for the new volatility with the Illinois algorithm (step 5 of the paper)
+func (g Glicko2Rating) nextVolatility(phi, v, delta float64) float64 {
+	a := math.Log(g.volatility * g.volatility)
+	tau2 := GlickoTau * GlickoTau
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/tau2
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+v {
+		upper = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*GlickoTau) < 0 {
+			k++
+		}
+		upper = a - k*GlickoTau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glickoConvergence {
+		c := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fc := f(c)
+		if fc*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = c, fc
+	}
+
+	return math.Exp(lower / 2)
+}
+
+// Decay returns the rating after idle rating periods: the rating stays, RD grows
+func (g Glicko2Rating) Decay(periods int) Glicko2Rating {
+	if periods <= 0 {
+		return g
+	}
+	_, phi := g.toGlicko2()
+	phi = math.Sqrt(phi*phi + float64(periods)*g.volatility*g.volatility)
+	g.rd = clampRD(phi * glickoScale)
+	return g
+}
+
+// SoftReset returns the rating for a new season: it moves halfway back to
+// InitialMMR (never below 500) and becomes less certain
+func (g Glicko2Rating) SoftReset() Glicko2Rating {
+	rating := InitialMMR + math.Trunc((g.rating-InitialMMR)*0.5)
+	if rating < 500 {
+		rating = 500
+	}
+	return Glicko2Rating{
+		rating:     rating,
+		rd:         math.Max(g.rd, SeasonResetMinRD),
+		volatility: g.volatility,
+	}
+}
+
+// MatchmakingRange returns the MMR range to search for opponents.
+// It widens the longer the player waits and the less certain their rating is.
+func (g Glicko2Rating) MatchmakingRange(searchSeconds int) (min int, max int) {
+	var rangeDelta int
+
+	switch {
+	case searchSeconds < 10:
+		rangeDelta = 50
+	case searchSeconds < 20:
+		rangeDelta = 100
+	case searchSeconds < 30:
+		rangeDelta = 200
+	case searchSeconds < 45:
+		rangeDelta = 300
+	default:
+		rangeDelta = 500
+	}
+	rangeDelta += int(g.rd - MinRatingDeviation)
+
+	mmr := g.MMR()
+	min = mmr - rangeDelta
+	max = mmr + rangeDelta
+
+	if min < MinMMR {
+		min = MinMMR
+	}
+
+	return min, max
+}
+
+// withRating returns a copy with the rating moved to mmr (demotion protection)
+func (g Glicko2Rating) withRating(mmr int) Glicko2Rating {
+	g.rating = float64(mmr)
+	return g
+}
+
+func (g Glicko2Rating) toGlicko2() (mu float64, phi float64) {
+	return (g.rating - InitialMMR) / glickoScale, g.rd / glickoScale
+}
+
+// glickoG weighs an opponent's result by how certain their rating is
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func clampRD(rd float64) float64 {
+	return math.Min(math.Max(rd, MinRatingDeviation), MaxRatingDeviation)
+}
+
+// MMR returns the rating rounded and bounded to the MMR scale
+func (g Glicko2Rating) MMR() int {
+	mmr := int(math.Round(g.rating))
+	if mmr < MinMMR {
+		return MinMMR
+	}
+	if mmr > MaxMMR {
+		return MaxMMR
+	}
+	return mmr
+}
+
+// Getters
+func (g Glicko2Rating) Rating() float64     { return g.rating }
+func (g Glicko2Rating) RD() float64         { return g.rd }
+func (g Glicko2Rating) Volatility() float64 { return g.volatility }
@@ -0,0 +1,143 @@
+package quick_duel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGlicko2Rating_RateMovesTowardResult(t *testing.T) {
+	player := ReconstructGlicko2Rating(1500, 200, InitialVolatility)
+	opponent := ReconstructGlicko2Rating(1400, 30, InitialVolatility)
+
+	won := player.Rate(opponent, 1)
+	lost := player.Rate(opponent, 0)
+	drew := player.Rate(opponent, 0.5)
+
+	if won.Rating() <= player.Rating() {
+		t.Errorf("win: rating %.1f, want above %.1f", won.Rating(), player.Rating())
+	}
+	if lost.Rating() >= player.Rating() {
+		t.Errorf("loss: rating %.1f, want below %.1f", lost.Rating(), player.Rating())
+	}
+	// The favourite loses a little on a draw
+	if drew.Rating() >= player.Rating() || drew.Rating() <= lost.Rating() {
+		t.Errorf("draw: rating %.1f, want between %.1f and %.1f", drew.Rating(), lost.Rating(), player.Rating())
+	}
+	for _, r := range []Glicko2Rating{won, lost, drew} {
+		if r.RD() >= player.RD() {
+			t.Errorf("RD %.1f, want below %.1f after a game", r.RD(), player.RD())
+		}
+		if math.Abs(r.Volatility()-InitialVolatility) > 0.01 {
+			t.Errorf("volatility %.4f moved too far from %.4f", r.Volatility(), InitialVolatility)
+		}
+	}
+}
+
+func TestGlicko2Rating_UncertainRatingsMoveMore(t *testing.T) {
+	opponent := ReconstructGlicko2Rating(InitialMMR, 100, InitialVolatility)
+
+	newcomer := NewGlicko2Rating().Rate(opponent, 1)
+	veteran := ReconstructGlicko2Rating(InitialMMR, MinRatingDeviation, InitialVolatility).Rate(opponent, 1)
+
+	newcomerGain := newcomer.Rating() - InitialMMR
+	veteranGain := veteran.Rating() - InitialMMR
+	if newcomerGain <= 3*veteranGain {
+		t.Errorf("newcomer gained %.1f, veteran %.1f: want the newcomer to move much more", newcomerGain, veteranGain)
+	}
+	if veteran.RD() < MinRatingDeviation {
+		t.Errorf("RD %.1f below floor %.1f", veteran.RD(), MinRatingDeviation)
+	}
+}
+
+func TestGlicko2Rating_DecayGrowsRDOnly(t *testing.T) {
+	rating := ReconstructGlicko2Rating(1800, MinRatingDeviation, InitialVolatility)
+
+	if got := rating.Decay(0); got != rating {
+		t.Errorf("Decay(0) = %+v, want unchanged", got)
+	}
+
+	month := rating.Decay(30)
+	if month.Rating() != rating.Rating() {
+		t.Errorf("rating changed to %.1f", month.Rating())
+	}
+	if month.RD() <= rating.RD() {
+		t.Errorf("RD after 30 idle days = %.1f, want above %.1f", month.RD(), rating.RD())
+	}
+
+	if forever := rating.Decay(100000); forever.RD() != MaxRatingDeviation {
+		t.Errorf("RD = %.1f, want capped at %.1f", forever.RD(), MaxRatingDeviation)
+	}
+}
+
+func TestGlicko2Rating_SoftReset(t *testing.T) {
+	tests := []struct {
+		name       string
+		rating     float64
+		wantRating float64
+	}{
+		{"high rating halves toward initial", 2000, 1500},
+		{"low rating halves toward initial", 100, 550},
+		{"floor at 500", -200, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reset := ReconstructGlicko2Rating(tt.rating, MinRatingDeviation, InitialVolatility).SoftReset()
+			if reset.Rating() != tt.wantRating {
+				t.Errorf("rating = %.1f, want %.1f", reset.Rating(), tt.wantRating)
+			}
+			if reset.RD() != SeasonResetMinRD {
+				t.Errorf("RD = %.1f, want %.1f", reset.RD(), SeasonResetMinRD)
+			}
+		})
+	}
+}
+
+// TestGlicko2Rating_MatchmakingRange tests matchmaking ranges (5-tier) of an established rating
+func TestGlicko2Rating_MatchmakingRange(t *testing.T) {
+	rating := ReconstructGlicko2Rating(1000, MinRatingDeviation, InitialVolatility)
+
+	tests := []struct {
+		name          string
+		searchSeconds int
+		expectedMin   int
+		expectedMax   int
+	}{
+		{"Instant (0s)", 0, 950, 1050},
+		{"Tier1 boundary (9s)", 9, 950, 1050},
+		{"Tier2 start (10s)", 10, 900, 1100},
+		{"Tier2 boundary (19s)", 19, 900, 1100},
+		{"Tier3 start (20s)", 20, 800, 1200},
+		{"Tier3 boundary (29s)", 29, 800, 1200},
+		{"Tier4 start (30s)", 30, 700, 1300},
+		{"Tier4 boundary (44s)", 44, 700, 1300},
+		{"Tier5 start (45s)", 45, 500, 1500},
+		{"Tier5 long (120s)", 120, 500, 1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max := rating.MatchmakingRange(tt.searchSeconds)
+
+			if min != tt.expectedMin {
+				t.Errorf("Min = %d, want %d", min, tt.expectedMin)
+			}
+			if max != tt.expectedMax {
+				t.Errorf("Max = %d, want %d", max, tt.expectedMax)
+			}
+		})
+	}
+}
+
+// TestGlicko2Rating_MatchmakingRange_WidensWithRD tests that uncertain ratings search wider
+func TestGlicko2Rating_MatchmakingRange_WidensWithRD(t *testing.T) {
+	min, max := ReconstructGlicko2Rating(1000, 250, InitialVolatility).MatchmakingRange(0)
+	// Tier1 delta 50 + (250 - 50) RD
+	if min != 750 || max != 1250 {
+		t.Errorf("range = %d..%d, want 750..1250", min, max)
+	}
+
+	min, _ = ReconstructGlicko2Rating(30, MinRatingDeviation, InitialVolatility).MatchmakingRange(0)
+	if min != MinMMR {
+		t.Errorf("Min should be clamped to MinMMR=%d, got %d", MinMMR, min)
+	}
+}
@@ -1,32 +1,30 @@
 package quick_duel
 
-import "math"
-
 // MMR calculation constants
 const (
-	InitialMMR        = 1000
-	MinMMR            = 0
-	MaxMMR            = 9999
-	KFactor           = 32  // ELO K-factor
-	MinMMRChange      = 10  // Minimum change per match
-	DemotionProtection = 3  // Games protected from demotion at new rank
+	InitialMMR         = 1000
+	MinMMR             = 0
+	MaxMMR             = 9999
+	DemotionProtection = 3 // Games protected from demotion at new rank
 )
 
 // PlayerRating represents a player's competitive ranking (aggregate root)
 type PlayerRating struct {
-	playerID      UserID
-	mmr           int
-	league        League
-	division      Division
-	peakMMR       int
-	peakLeague    League
-	peakDivision  Division
-	gamesAtRank   int      // Games played at current rank (for demotion protection)
-	seasonID      string
-	seasonWins    int
-	seasonLosses  int
-	seasonDraws   int
-	updatedAt     int64
+	playerID     UserID
+	glicko       Glicko2Rating
+	league       League
+	division     Division
+	peakMMR      int
+	peakLeague   League
+	peakDivision Division
+	gamesAtRank  int // Games played at current rank (for demotion protection)
+	gamesPlayed  int // Rated games ever played (placement until PlacementMatches)
+	seasonID     string
+	seasonWins   int
+	seasonLosses int
+	seasonDraws  int
+	lastGameAt   int64 // 0 if the player never played; RD grows from here (a season reset restarts it)
+	updatedAt    int64
 
 	events []Event
 }
@@ -37,17 +35,19 @@ func NewPlayerRating(playerID UserID, seasonID string, createdAt int64) *PlayerR
 
 	return &PlayerRating{
 		playerID:     playerID,
-		mmr:          InitialMMR,
+		glicko:       NewGlicko2Rating(),
 		league:       leagueInfo.League(),
 		division:     leagueInfo.Division(),
 		peakMMR:      InitialMMR,
 		peakLeague:   leagueInfo.League(),
 		peakDivision: leagueInfo.Division(),
 		gamesAtRank:  0,
+		gamesPlayed:  0,
 		seasonID:     seasonID,
 		seasonWins:   0,
 		seasonLosses: 0,
 		seasonDraws:  0,
+		lastGameAt:   0,
 		updatedAt:    createdAt,
 		events:       make([]Event, 0),
 	}
@@ -57,31 +57,37 @@ func NewPlayerRating(playerID UserID, seasonID string, createdAt int64) *PlayerR
 func ReconstructPlayerRating(
 	playerID UserID,
 	mmr int,
+	ratingDeviation float64,
+	volatility float64,
 	league League,
 	division Division,
 	peakMMR int,
 	peakLeague League,
 	peakDivision Division,
 	gamesAtRank int,
+	gamesPlayed int,
 	seasonID string,
 	seasonWins int,
 	seasonLosses int,
 	seasonDraws int,
+	lastGameAt int64,
 	updatedAt int64,
 ) *PlayerRating {
 	return &PlayerRating{
 		playerID:     playerID,
-		mmr:          mmr,
+		glicko:       ReconstructGlicko2Rating(float64(mmr), ratingDeviation, volatility),
 		league:       league,
 		division:     division,
 		peakMMR:      peakMMR,
 		peakLeague:   peakLeague,
 		peakDivision: peakDivision,
 		gamesAtRank:  gamesAtRank,
+		gamesPlayed:  gamesPlayed,
 		seasonID:     seasonID,
 		seasonWins:   seasonWins,
 		seasonLosses: seasonLosses,
 		seasonDraws:  seasonDraws,
+		lastGameAt:   lastGameAt,
 		updatedAt:    updatedAt,
 		events:       make([]Event, 0),
 	}
@@ -89,19 +95,31 @@ func ReconstructPlayerRating(
 
 // GameResult represents the result of a duel game for rating calculation
 type GameResult struct {
-	Won         bool
-	Draw        bool
-	OpponentMMR int
-	GameTime    int64
+	Won      bool
+	Draw     bool
+	Opponent Glicko2Rating // opponent's CurrentRating before this game
+	GameTime int64
 }
 
-// ApplyGameResult updates rating based on game result
-func (pr *PlayerRating) ApplyGameResult(result GameResult) {
+// CurrentRating returns the Glicko-2 rating at the given time:
+// RD grows for every full rating period the player has been idle
+func (pr *PlayerRating) CurrentRating(now int64) Glicko2Rating {
+	if pr.lastGameAt == 0 || now <= pr.lastGameAt {
+		return pr.glicko
+	}
+	return pr.glicko.Decay(int((now - pr.lastGameAt) / RatingPeriodSeconds))
+}
+
+// ApplyGameResult updates rating based on game result and reports what changed
+func (pr *PlayerRating) ApplyGameResult(result GameResult) RatingChange {
 	oldLeague := pr.league
 	oldDivision := pr.division
-
-	// Calculate expected score (probability of winning)
-	expectedScore := 1.0 / (1.0 + math.Pow(10, float64(result.OpponentMMR-pr.mmr)/400.0))
+	wasPlacement := pr.IsPlacement()
+	change := RatingChange{
+		MMRBefore:      pr.MMR(),
+		LeagueBefore:   oldLeague,
+		DivisionBefore: oldDivision,
+	}
 
 	// Actual score
 	var actualScore float64
@@ -116,39 +134,49 @@ func (pr *PlayerRating) ApplyGameResult(result GameResult) {
 		pr.seasonLosses++
 	}
 
-	// Calculate rating change
-	delta := float64(KFactor) * (actualScore - expectedScore)
-
-	// Enforce minimum change
-	if delta > 0 && delta < float64(MinMMRChange) {
-		delta = float64(MinMMRChange)
-	} else if delta < 0 && delta > -float64(MinMMRChange) {
-		delta = -float64(MinMMRChange)
-	}
-
-	// Apply change
-	pr.mmr += int(math.Round(delta))
-
-	// Enforce bounds
-	if pr.mmr < MinMMR {
-		pr.mmr = MinMMR
-	}
-	if pr.mmr > MaxMMR {
-		pr.mmr = MaxMMR
-	}
+	pr.glicko = pr.CurrentRating(result.GameTime).Rate(result.Opponent, actualScore)
+	pr.gamesPlayed++
+	pr.lastGameAt = result.GameTime
+	pr.updatedAt = result.GameTime
 
 	// Update league/division
-	newLeagueInfo := GetLeagueFromMMR(pr.mmr)
+	newLeagueInfo := GetLeagueFromMMR(pr.MMR())
 	newLeague := newLeagueInfo.League()
 	newDivision := newLeagueInfo.Division()
 
+	// Placement: the league stays hidden, so it neither promotes, demotes nor counts as a peak
+	if wasPlacement {
+		pr.league = newLeague
+		pr.division = newDivision
+		pr.gamesAtRank = 0
+		if !pr.IsPlacement() {
+			change.RankEvent = RankEventPlaced
+			pr.events = append(pr.events, NewPlayerPlacedEvent(
+				pr.playerID,
+				newLeague, newDivision,
+				pr.MMR(),
+				result.GameTime,
+			))
+			pr.updatePeak()
+		}
+		return pr.completeChange(change)
+	}
+
 	// Check demotion protection
 	if pr.shouldPreventDemotion(oldLeague, oldDivision, newLeague, newDivision) {
 		// Keep at current rank floor
-		pr.mmr = pr.getRankFloorMMR(oldLeague, oldDivision)
-		newLeagueInfo = GetLeagueFromMMR(pr.mmr)
+		pr.glicko = pr.glicko.withRating(pr.getRankFloorMMR(oldLeague, oldDivision))
+		newLeagueInfo = GetLeagueFromMMR(pr.MMR())
 		newLeague = newLeagueInfo.League()
 		newDivision = newLeagueInfo.Division()
+
+		change.RankEvent = RankEventDemotionProtected
+		pr.events = append(pr.events, NewPlayerDemotionProtectedEvent(
+			pr.playerID,
+			oldLeague, oldDivision,
+			pr.MMR(),
+			result.GameTime,
+		))
 	}
 
 	// Check if rank changed
@@ -160,20 +188,22 @@ func (pr *PlayerRating) ApplyGameResult(result GameResult) {
 		// Publish promotion/demotion event
 		if newLeague > oldLeague || (newLeague == oldLeague && newDivision < oldDivision) {
 			// Promoted (lower division number = higher rank)
+			change.RankEvent = RankEventPromoted
 			pr.events = append(pr.events, NewPlayerPromotedEvent(
 				pr.playerID,
 				oldLeague, oldDivision,
 				newLeague, newDivision,
-				pr.mmr,
+				pr.MMR(),
 				result.GameTime,
 			))
 		} else {
 			// Demoted
+			change.RankEvent = RankEventDemoted
 			pr.events = append(pr.events, NewPlayerDemotedEvent(
 				pr.playerID,
 				oldLeague, oldDivision,
 				newLeague, newDivision,
-				pr.mmr,
+				pr.MMR(),
 				result.GameTime,
 			))
 		}
@@ -183,11 +213,22 @@ func (pr *PlayerRating) ApplyGameResult(result GameResult) {
 
 	pr.league = newLeague
 	pr.division = newDivision
-	pr.updatedAt = result.GameTime
+	pr.updatePeak()
+	return pr.completeChange(change)
+}
+
+// completeChange fills in the rank the player ended the game at
+func (pr *PlayerRating) completeChange(change RatingChange) RatingChange {
+	change.MMRAfter = pr.MMR()
+	change.LeagueAfter = pr.league
+	change.DivisionAfter = pr.division
+	return change
+}
 
-	// Update peak if new high
-	if pr.mmr > pr.peakMMR {
-		pr.peakMMR = pr.mmr
+// updatePeak records the current rank if it is a new high
+func (pr *PlayerRating) updatePeak() {
+	if pr.MMR() > pr.peakMMR {
+		pr.peakMMR = pr.MMR()
 		pr.peakLeague = pr.league
 		pr.peakDivision = pr.division
 	}
@@ -221,18 +262,15 @@ func (pr *PlayerRating) getRankFloorMMR(league League, division Division) int {
 	return baseMMR + divisionOffset
 }
 
-// SeasonReset applies seasonal MMR reset
+// SeasonReset applies the seasonal soft reset (see Glicko2Rating.SoftReset)
 func (pr *PlayerRating) SeasonReset(newSeasonID string, resetTime int64) {
-	// Soft reset formula: newMMR = 1000 + (currentMMR - 1000) * 0.5
-	// Minimum: 500 MMR
-	newMMR := 1000 + int(float64(pr.mmr-1000)*0.5)
-	if newMMR < 500 {
-		newMMR = 500
-	}
-
 	oldSeasonID := pr.seasonID
 
-	pr.mmr = newMMR
+	pr.glicko = pr.CurrentRating(resetTime).SoftReset()
+	if pr.lastGameAt != 0 {
+		// The idle time so far is in the reset RD; don't decay it again
+		pr.lastGameAt = resetTime
+	}
 	pr.seasonID = newSeasonID
 	pr.seasonWins = 0
 	pr.seasonLosses = 0
@@ -241,7 +279,7 @@ func (pr *PlayerRating) SeasonReset(newSeasonID string, resetTime int64) {
 	pr.updatedAt = resetTime
 
 	// Update league/division
-	leagueInfo := GetLeagueFromMMR(pr.mmr)
+	leagueInfo := GetLeagueFromMMR(pr.MMR())
 	pr.league = leagueInfo.League()
 	pr.division = leagueInfo.Division()
 
@@ -250,7 +288,7 @@ func (pr *PlayerRating) SeasonReset(newSeasonID string, resetTime int64) {
 		pr.playerID,
 		oldSeasonID,
 		newSeasonID,
-		pr.mmr,
+		pr.MMR(),
 		pr.league,
 		pr.division,
 		resetTime,
@@ -309,23 +347,42 @@ func (pr *PlayerRating) WinRate() float64 {
 
 // CanDemote returns true if player can be demoted (no protection)
 func (pr *PlayerRating) CanDemote() bool {
-	return pr.gamesAtRank >= DemotionProtection
+	return !pr.IsPlacement() && pr.gamesAtRank >= DemotionProtection
+}
+
+// IsPlacement returns true until the player finished PlacementMatches games;
+// until then the league is not shown
+func (pr *PlayerRating) IsPlacement() bool {
+	return pr.gamesPlayed < PlacementMatches
+}
+
+// PlacementGamesLeft returns how many games remain before the league is shown
+func (pr *PlayerRating) PlacementGamesLeft() int {
+	if !pr.IsPlacement() {
+		return 0
+	}
+	return PlacementMatches - pr.gamesPlayed
 }
 
 // Getters
-func (pr *PlayerRating) PlayerID() UserID       { return pr.playerID }
-func (pr *PlayerRating) MMR() int               { return pr.mmr }
-func (pr *PlayerRating) League() League         { return pr.league }
-func (pr *PlayerRating) Division() Division     { return pr.division }
-func (pr *PlayerRating) PeakMMR() int           { return pr.peakMMR }
-func (pr *PlayerRating) PeakLeague() League     { return pr.peakLeague }
-func (pr *PlayerRating) PeakDivision() Division { return pr.peakDivision }
-func (pr *PlayerRating) GamesAtRank() int       { return pr.gamesAtRank }
-func (pr *PlayerRating) SeasonID() string       { return pr.seasonID }
-func (pr *PlayerRating) SeasonWins() int        { return pr.seasonWins }
-func (pr *PlayerRating) SeasonLosses() int      { return pr.seasonLosses }
-func (pr *PlayerRating) SeasonDraws() int       { return pr.seasonDraws }
-func (pr *PlayerRating) UpdatedAt() int64       { return pr.updatedAt }
+func (pr *PlayerRating) PlayerID() UserID         { return pr.playerID }
+func (pr *PlayerRating) MMR() int                 { return pr.glicko.MMR() }
+func (pr *PlayerRating) Glicko() Glicko2Rating    { return pr.glicko }
+func (pr *PlayerRating) RatingDeviation() float64 { return pr.glicko.RD() }
+func (pr *PlayerRating) Volatility() float64      { return pr.glicko.Volatility() }
+func (pr *PlayerRating) League() League           { return pr.league }
+func (pr *PlayerRating) Division() Division       { return pr.division }
+func (pr *PlayerRating) PeakMMR() int             { return pr.peakMMR }
+func (pr *PlayerRating) PeakLeague() League       { return pr.peakLeague }
+func (pr *PlayerRating) PeakDivision() Division   { return pr.peakDivision }
+func (pr *PlayerRating) GamesAtRank() int         { return pr.gamesAtRank }
+func (pr *PlayerRating) GamesPlayed() int         { return pr.gamesPlayed }
+func (pr *PlayerRating) SeasonID() string         { return pr.seasonID }
+func (pr *PlayerRating) SeasonWins() int          { return pr.seasonWins }
+func (pr *PlayerRating) SeasonLosses() int        { return pr.seasonLosses }
+func (pr *PlayerRating) SeasonDraws() int         { return pr.seasonDraws }
+func (pr *PlayerRating) LastGameAt() int64        { return pr.lastGameAt }
+func (pr *PlayerRating) UpdatedAt() int64         { return pr.updatedAt }
 
 // Events returns collected domain events and clears them
 func (pr *PlayerRating) Events() []Event {
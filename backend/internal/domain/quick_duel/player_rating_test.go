@@ -0,0 +1,167 @@
+package quick_duel
+
+import (
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+func newEstablishedRating(t *testing.T, mmr int, lastGameAt int64) *PlayerRating {
+	t.Helper()
+	playerID, _ := shared.NewUserID("player1")
+	info := GetLeagueFromMMR(mmr)
+	return ReconstructPlayerRating(
+		playerID, mmr, MinRatingDeviation, InitialVolatility,
+		info.League(), info.Division(),
+		mmr, info.League(), info.Division(),
+		DemotionProtection, 40, "2026-10", 10, 10, 0, lastGameAt, lastGameAt,
+	)
+}
+
+func TestPlayerRating_PlacementHidesLeagueUntilLastMatch(t *testing.T) {
+	playerID, _ := shared.NewUserID("player1")
+	rating := NewPlayerRating(playerID, "2026-10", 1000)
+	strong := ReconstructGlicko2Rating(1400, MinRatingDeviation, InitialVolatility)
+
+	for game := 1; game <= PlacementMatches; game++ {
+		if !rating.IsPlacement() || rating.PlacementGamesLeft() != PlacementMatches-game+1 {
+			t.Fatalf("before game %d: placement=%v, left=%d", game, rating.IsPlacement(), rating.PlacementGamesLeft())
+		}
+		rating.ApplyGameResult(GameResult{Won: true, Opponent: strong, GameTime: int64(1000 + game)})
+
+		events := rating.Events()
+		if game < PlacementMatches && len(events) != 0 {
+			t.Errorf("game %d: placement game published %d events", game, len(events))
+		}
+		if game == PlacementMatches {
+			if len(events) != 1 {
+				t.Fatalf("last placement game published %d events, want 1", len(events))
+			}
+			placed, ok := events[0].(PlayerPlacedEvent)
+			if !ok || placed.League() != rating.League() || placed.MMR() != rating.MMR() {
+				t.Errorf("event = %+v, want PlayerPlacedEvent for the current league", events[0])
+			}
+		}
+	}
+
+	if rating.IsPlacement() || rating.PlacementGamesLeft() != 0 {
+		t.Error("placement should be over")
+	}
+	if rating.MMR() <= 1400 {
+		t.Errorf("MMR after beating a 1400 player five times = %d, want above 1400", rating.MMR())
+	}
+	if rating.RatingDeviation() >= InitialRatingDeviation/2 {
+		t.Errorf("RD after placement = %.1f, want well below %.1f", rating.RatingDeviation(), InitialRatingDeviation)
+	}
+	if rating.PeakMMR() != rating.MMR() {
+		t.Errorf("peak = %d, want the placed MMR %d", rating.PeakMMR(), rating.MMR())
+	}
+}
+
+func TestPlayerRating_InactivityGrowsRD(t *testing.T) {
+	const lastGame = int64(1_000_000)
+	rating := newEstablishedRating(t, 1600, lastGame)
+
+	if rd := rating.CurrentRating(lastGame + RatingPeriodSeconds - 1).RD(); rd != MinRatingDeviation {
+		t.Errorf("RD within the first day = %.1f, want %.1f", rd, MinRatingDeviation)
+	}
+	idle := rating.CurrentRating(lastGame + 60*RatingPeriodSeconds)
+	if idle.RD() <= MinRatingDeviation || idle.MMR() != 1600 {
+		t.Errorf("after 60 idle days: RD %.1f, MMR %d", idle.RD(), idle.MMR())
+	}
+
+	// The grown RD makes the comeback game count more
+	active := newEstablishedRating(t, 1600, lastGame)
+	opponent := ReconstructGlicko2Rating(1600, MinRatingDeviation, InitialVolatility)
+	active.ApplyGameResult(GameResult{Won: true, Opponent: opponent, GameTime: lastGame + 60})
+	rating.ApplyGameResult(GameResult{Won: true, Opponent: opponent, GameTime: lastGame + 60*RatingPeriodSeconds})
+	if rating.MMR()-1600 <= active.MMR()-1600 {
+		t.Errorf("idle player gained %d, active player %d: want the idle player to gain more", rating.MMR()-1600, active.MMR()-1600)
+	}
+}
+
+func TestPlayerRating_SeasonResetUsesEngine(t *testing.T) {
+	rating := newEstablishedRating(t, 2000, 1000)
+
+	rating.SeasonReset("2026-11", 2000)
+
+	if rating.MMR() != 1500 || rating.RatingDeviation() != SeasonResetMinRD {
+		t.Errorf("after reset: MMR %d, RD %.1f; want 1500, %.1f", rating.MMR(), rating.RatingDeviation(), SeasonResetMinRD)
+	}
+	if rating.League() != LeagueGold || rating.SeasonID() != "2026-11" || rating.GamesPlayed() != 40 {
+		t.Errorf("after reset: league %s, season %s, games %d", rating.League(), rating.SeasonID(), rating.GamesPlayed())
+	}
+}
+
+func TestPlayerRating_SeasonResetThenGameDecaysIdleTimeOnce(t *testing.T) {
+	const lastGame = int64(1_000_000)
+	const resetTime = lastGame + 60*RatingPeriodSeconds
+	rating := newEstablishedRating(t, 1600, lastGame)
+
+	rating.SeasonReset("2026-11", resetTime)
+	reset := rating.Glicko()
+
+	// Right after the reset the idle time is already in the RD
+	if rd := rating.CurrentRating(resetTime + 60).RD(); rd != reset.RD() {
+		t.Errorf("RD after the reset = %.1f, want %.1f", rd, reset.RD())
+	}
+
+	opponent := ReconstructGlicko2Rating(1500, MinRatingDeviation, InitialVolatility)
+	rating.ApplyGameResult(GameResult{Won: true, Opponent: opponent, GameTime: resetTime + 60})
+
+	want := reset.Rate(opponent, 1)
+	if rating.MMR() != want.MMR() || rating.RatingDeviation() != want.RD() {
+		t.Errorf("after the first game: MMR %d, RD %.1f; want %d, %.1f", rating.MMR(), rating.RatingDeviation(), want.MMR(), want.RD())
+	}
+}
+
+func TestPlayerRating_ApplyGameResultReportsDemotionProtection(t *testing.T) {
+	playerID, _ := shared.NewUserID("player1")
+	info := GetLeagueFromMMR(1600)
+	floor := (&PlayerRating{}).getRankFloorMMR(info.League(), info.Division())
+	rating := ReconstructPlayerRating(
+		playerID, floor, MinRatingDeviation, InitialVolatility,
+		info.League(), info.Division(),
+		floor, info.League(), info.Division(),
+		0, 40, "2026-10", 10, 10, 0, 1000, 1000,
+	)
+	opponent := ReconstructGlicko2Rating(float64(floor), MinRatingDeviation, InitialVolatility)
+
+	change := rating.ApplyGameResult(GameResult{Opponent: opponent, GameTime: 1060})
+
+	if change.RankEvent != RankEventDemotionProtected {
+		t.Errorf("RankEvent = %q, want %q", change.RankEvent, RankEventDemotionProtected)
+	}
+	if change.MMRBefore != floor || change.MMRAfter != floor || change.MMRDelta() != 0 {
+		t.Errorf("change = %+v, want to stay at the rank floor %d", change, floor)
+	}
+	if change.LeagueAfter != info.League() || change.DivisionAfter != info.Division() {
+		t.Errorf("rank after = %s %d, want %s %d", change.LeagueAfter, change.DivisionAfter, info.League(), info.Division())
+	}
+	events := rating.Events()
+	if len(events) != 1 {
+		t.Fatalf("published %d events, want 1", len(events))
+	}
+	if _, ok := events[0].(PlayerDemotionProtectedEvent); !ok {
+		t.Errorf("event = %T, want PlayerDemotionProtectedEvent", events[0])
+	}
+}
+
+func TestPlayerRating_ApplyGameResultReportsPromotion(t *testing.T) {
+	info := GetLeagueFromMMR(1600)
+	nextFloor := (&PlayerRating{}).getRankFloorMMR(info.League(), info.Division()) + DivisionSpan
+	rating := newEstablishedRating(t, nextFloor-1, 1000)
+	strong := ReconstructGlicko2Rating(float64(nextFloor+300), MinRatingDeviation, InitialVolatility)
+
+	change := rating.ApplyGameResult(GameResult{Won: true, Opponent: strong, GameTime: 1060})
+
+	if change.RankEvent != RankEventPromoted {
+		t.Fatalf("RankEvent = %q, want %q", change.RankEvent, RankEventPromoted)
+	}
+	if change.MMRDelta() <= 0 || change.MMRAfter != rating.MMR() {
+		t.Errorf("change = %+v, rating MMR %d", change, rating.MMR())
+	}
+	if change.LeagueBefore != info.League() || change.DivisionBefore != info.Division() {
+		t.Errorf("rank before = %s %d, want %s %d", change.LeagueBefore, change.DivisionBefore, info.League(), info.Division())
+	}
+}
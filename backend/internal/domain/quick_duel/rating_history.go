@@ -0,0 +1,87 @@
+package quick_duel
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+
+// RankEvent is what a rated game did to the player's rank
+type RankEvent string
+
+const (
+	RankEventNone              RankEvent = ""
+	RankEventPromoted          RankEvent = "promoted"
+	RankEventDemoted           RankEvent = "demoted"
+	RankEventDemotionProtected RankEvent = "demotion_protected" // would have been demoted, kept at the rank floor
+	RankEventPlaced            RankEvent = "placed"             // last placement match: league becomes visible
+)
+
+// RatingChange is the effect of one rated game on a PlayerRating
+type RatingChange struct {
+	MMRBefore      int
+	MMRAfter       int
+	LeagueBefore   League
+	DivisionBefore Division
+	LeagueAfter    League
+	DivisionAfter  Division
+	RankEvent      RankEvent
+}
+
+// MMRDelta returns the MMR gained (positive) or lost (negative)
+func (c RatingChange) MMRDelta() int {
+	return c.MMRAfter - c.MMRBefore
+}
+
+// RatingHistoryEntry records how one finished duel changed a player's rating
+type RatingHistoryEntry struct {
+	playerID UserID
+	gameID   GameID
+	seasonID string
+	change   RatingChange
+	playedAt int64
+}
+
+// NewRatingHistoryEntry creates the history entry of a rated game
+func NewRatingHistoryEntry(
+	playerID UserID,
+	gameID GameID,
+	seasonID string,
+	change RatingChange,
+	playedAt int64,
+) (*RatingHistoryEntry, error) {
+	if playerID.IsZero() {
+		return nil, shared.ErrInvalidUserID
+	}
+	if gameID.IsZero() {
+		return nil, ErrInvalidGameID
+	}
+
+	return &RatingHistoryEntry{
+		playerID: playerID,
+		gameID:   gameID,
+		seasonID: seasonID,
+		change:   change,
+		playedAt: playedAt,
+	}, nil
+}
+
+// ReconstructRatingHistoryEntry reconstructs RatingHistoryEntry from persistence
+func ReconstructRatingHistoryEntry(
+	playerID UserID,
+	gameID GameID,
+	seasonID string,
+	change RatingChange,
+	playedAt int64,
+) *RatingHistoryEntry {
+	return &RatingHistoryEntry{
+		playerID: playerID,
+		gameID:   gameID,
+		seasonID: seasonID,
+		change:   change,
+		playedAt: playedAt,
+	}
+}
+
+// Getters
+func (e *RatingHistoryEntry) PlayerID() UserID     { return e.playerID }
+func (e *RatingHistoryEntry) GameID() GameID       { return e.gameID }
+func (e *RatingHistoryEntry) SeasonID() string     { return e.seasonID }
+func (e *RatingHistoryEntry) Change() RatingChange { return e.change }
+func (e *RatingHistoryEntry) PlayedAt() int64      { return e.playedAt }
@@ -7,9 +7,16 @@ type DuelGameRepository interface {
 	// Save persists a duel game
 	Save(game *DuelGame) error
 
+	// SaveInTx persists a duel game within an existing transaction
+	SaveInTx(tx *sql.Tx, game *DuelGame) error
+
 	// FindByID retrieves a duel game by ID
 	FindByID(id GameID) (*DuelGame, error)
 
+	// FindByIDForUpdate retrieves a duel game with a row-level lock (SELECT FOR UPDATE).
+	// Every change to an in-progress game holds this lock, so API instances take turns on it.
+	FindByIDForUpdate(tx *sql.Tx, id GameID) (*DuelGame, error)
+
 	// FindActiveByPlayer retrieves the active duel game for a player
 	// Returns nil if no active game found
 	FindActiveByPlayer(playerID UserID) (*DuelGame, error)
@@ -45,9 +52,9 @@ type MatchmakingQueue interface {
 	// RemoveFromQueue removes a player from matchmaking queue
 	RemoveFromQueue(playerID UserID) error
 
-	// FindMatch finds a suitable opponent for a player
+	// FindMatch finds a suitable opponent for a player within rating.MatchmakingRange(searchSeconds)
 	// Returns opponent's UserID and MMR, or nil if no match found
-	FindMatch(playerID UserID, mmr int, searchSeconds int) (*UserID, *int, error)
+	FindMatch(playerID UserID, rating Glicko2Rating, searchSeconds int) (*UserID, *int, error)
 
 	// GetQueueLength returns number of players in queue
 	GetQueueLength() (int, error)
@@ -74,6 +81,9 @@ type PlayerRatingRepository interface {
 	// Save persists or updates a player rating
 	Save(rating *PlayerRating) error
 
+	// SaveInTx persists or updates a player rating within an existing transaction
+	SaveInTx(tx *sql.Tx, rating *PlayerRating) error
+
 	// FindByPlayerID retrieves player rating
 	FindByPlayerID(playerID UserID) (*PlayerRating, error)
 
@@ -83,7 +93,7 @@ type PlayerRatingRepository interface {
 	// GetLeaderboard retrieves top players by MMR for a season
 	GetLeaderboard(seasonID string, limit int, offset int) ([]*PlayerRating, error)
 
-	// GetFriendsLeaderboard retrieves friends sorted by MMR
+	// GetFriendsLeaderboard retrieves the player and their friends sorted by MMR
 	GetFriendsLeaderboard(playerID UserID, friendIDs []UserID, limit int) ([]*PlayerRating, error)
 
 	// GetPlayerRank retrieves player's rank position
@@ -95,9 +105,24 @@ type PlayerRatingRepository interface {
 	// FindAllBySeasonID retrieves all player ratings for a given season (used for reward distribution)
 	FindAllBySeasonID(seasonID string) ([]*PlayerRating, error)
 
-	// ResetAllRatingsForSeason applies soft reset to all players and moves them to newSeasonID.
-	// resetFn computes the new MMR from the current MMR.
-	ResetAllRatingsForSeason(newSeasonID string, resetFn func(currentMMR int) int) error
+	// ResetAllRatingsForSeason applies reset to every player rating and saves it.
+	// reset is expected to call PlayerRating.SeasonReset.
+	ResetAllRatingsForSeason(reset func(rating *PlayerRating)) error
+}
+
+// RatingHistoryRepository defines the interface for per-game rating history persistence
+type RatingHistoryRepository interface {
+	// Save persists a history entry; saving a player's game twice keeps the first entry
+	Save(entry *RatingHistoryEntry) error
+
+	// FindByGame retrieves the entries of both players of a game
+	FindByGame(gameID GameID) ([]*RatingHistoryEntry, error)
+
+	// FindByGames retrieves a player's entries for the given games
+	FindByGames(playerID UserID, gameIDs []GameID) ([]*RatingHistoryEntry, error)
+
+	// FindBySeason retrieves a player's entries in a season, oldest first
+	FindBySeason(playerID UserID, seasonID string) ([]*RatingHistoryEntry, error)
 }
 
 // ChallengeRepository defines the interface for duel challenge persistence
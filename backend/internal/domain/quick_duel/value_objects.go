@@ -4,7 +4,6 @@ import (
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 	"github.com/google/uuid"
-	"math"
 )
 
 // Type aliases from other domains
@@ -39,149 +38,22 @@ func (id GameID) Equals(other GameID) bool {
 	return id.value == other.value
 }
 
-// EloRating represents a player's ELO rating for matchmaking
-type EloRating struct {
-	rating      int
-	gamesPlayed int
-}
-
-const (
-	InitialEloRating = 1000
-	MinEloRating     = 100
-	KFactorNew       = 32 // K-factor for first 30 games
-	KFactorRegular   = 16 // K-factor after 30 games
-	NewPlayerGames   = 30 // Number of games considered "new player"
-)
-
-func NewEloRating() EloRating {
-	return EloRating{
-		rating:      InitialEloRating,
-		gamesPlayed: 0,
-	}
-}
-
-// ReconstructEloRating reconstructs EloRating from persistence
-func ReconstructEloRating(rating int, gamesPlayed int) EloRating {
-	return EloRating{
-		rating:      rating,
-		gamesPlayed: gamesPlayed,
-	}
-}
-
-// KFactor returns the K-factor based on games played
-func (e EloRating) KFactor() int {
-	if e.gamesPlayed < NewPlayerGames {
-		return KFactorNew
-	}
-	return KFactorRegular
-}
-
-// CalculateNewRating calculates new ELO rating after a game
-// won: true if player won, false if lost
-// opponentRating: opponent's ELO rating
-func (e EloRating) CalculateNewRating(won bool, opponentRating int) EloRating {
-	// Expected score (probability of winning)
-	expectedScore := 1.0 / (1.0 + math.Pow(10, float64(opponentRating-e.rating)/400.0))
-
-	// Actual score
-	var actualScore float64
-	if won {
-		actualScore = 1.0
-	} else {
-		actualScore = 0.0
-	}
-
-	// Calculate rating change
-	kFactor := float64(e.KFactor())
-	ratingChange := kFactor * (actualScore - expectedScore)
-
-	// Apply change
-	newRating := e.rating + int(math.Round(ratingChange))
-
-	// Enforce minimum rating
-	if newRating < MinEloRating {
-		newRating = MinEloRating
-	}
-
-	return EloRating{
-		rating:      newRating,
-		gamesPlayed: e.gamesPlayed + 1,
-	}
-}
-
-// CalculateDrawRating calculates new ELO rating after a draw
-// opponentRating: opponent's ELO rating
-func (e EloRating) CalculateDrawRating(opponentRating int) EloRating {
-	expectedScore := 1.0 / (1.0 + math.Pow(10, float64(opponentRating-e.rating)/400.0))
-
-	kFactor := float64(e.KFactor())
-	ratingChange := kFactor * (0.5 - expectedScore)
-
-	newRating := e.rating + int(math.Round(ratingChange))
-
-	if newRating < MinEloRating {
-		newRating = MinEloRating
-	}
-
-	return EloRating{
-		rating:      newRating,
-		gamesPlayed: e.gamesPlayed + 1,
-	}
-}
-
-// IsNewPlayer checks if player is still in "new player" bracket
-func (e EloRating) IsNewPlayer() bool {
-	return e.gamesPlayed < NewPlayerGames
-}
-
-// GetMatchmakingRange returns ELO range for matchmaking based on search duration
-// searchSeconds: how long player has been searching
-func (e EloRating) GetMatchmakingRange(searchSeconds int) (min int, max int) {
-	var rangeDelta int
-
-	switch {
-	case searchSeconds < 10:
-		rangeDelta = 50
-	case searchSeconds < 20:
-		rangeDelta = 100
-	case searchSeconds < 30:
-		rangeDelta = 200
-	case searchSeconds < 45:
-		rangeDelta = 300
-	default:
-		rangeDelta = 500
-	}
-
-	min = e.rating - rangeDelta
-	max = e.rating + rangeDelta
-
-	if min < MinEloRating {
-		min = MinEloRating
-	}
-
-	return min, max
-}
-
-// Getters
-func (e EloRating) Rating() int      { return e.rating }
-func (e EloRating) GamesPlayed() int { return e.gamesPlayed }
-
 // DuelPlayer represents a player in the duel (value object)
 type DuelPlayer struct {
 	userID       UserID
 	username     string
-	elo          EloRating
+	mmr          int   // MMR when the game started
 	score        int   // Current score in this game
 	connected    bool  // Connection status
 	answersCount int   // Number of questions answered
 	totalTimeMs  int64 // Total time spent answering (ms), used for tiebreaker
 }
 
-func NewDuelPlayer(userID UserID, username string, elo EloRating) DuelPlayer {
+func NewDuelPlayer(userID UserID, username string, mmr int) DuelPlayer {
 	return DuelPlayer{
 		userID:       userID,
 		username:     username,
-		elo:          elo,
+		mmr:          mmr,
 		score:        0,
 		connected:    true,
 		answersCount: 0,
@@ -194,7 +66,7 @@ func (dp DuelPlayer) AddScore(points int, timeTakenMs int64) DuelPlayer {
 	return DuelPlayer{
 		userID:       dp.userID,
 		username:     dp.username,
-		elo:          dp.elo,
+		mmr:          dp.mmr,
 		score:        dp.score + points,
 		connected:    dp.connected,
 		answersCount: dp.answersCount + 1,
@@ -208,7 +80,7 @@ func (dp DuelPlayer) AddTime(timeTakenMs int64) DuelPlayer {
 	return DuelPlayer{
 		userID:       dp.userID,
 		username:     dp.username,
-		elo:          dp.elo,
+		mmr:          dp.mmr,
 		score:        dp.score,
 		connected:    dp.connected,
 		answersCount: dp.answersCount,
@@ -221,7 +93,7 @@ func (dp DuelPlayer) IncrementAnswers() DuelPlayer {
 	return DuelPlayer{
 		userID:       dp.userID,
 		username:     dp.username,
-		elo:          dp.elo,
+		mmr:          dp.mmr,
 		score:        dp.score,
 		connected:    dp.connected,
 		answersCount: dp.answersCount + 1,
@@ -234,7 +106,7 @@ func (dp DuelPlayer) SetConnected(connected bool) DuelPlayer {
 	return DuelPlayer{
 		userID:       dp.userID,
 		username:     dp.username,
-		elo:          dp.elo,
+		mmr:          dp.mmr,
 		score:        dp.score,
 		connected:    connected,
 		answersCount: dp.answersCount,
@@ -242,26 +114,34 @@ func (dp DuelPlayer) SetConnected(connected bool) DuelPlayer {
 	}
 }
 
-// WithScore returns a copy with an explicit score (used for surrender/forfeit)
-func (dp DuelPlayer) WithScore(score int) DuelPlayer {
+// ReconstructDuelPlayer reconstructs a DuelPlayer from persistence
+func ReconstructDuelPlayer(
+	userID UserID,
+	username string,
+	mmr int,
+	score int,
+	connected bool,
+	answersCount int,
+	totalTimeMs int64,
+) DuelPlayer {
 	return DuelPlayer{
-		userID:       dp.userID,
-		username:     dp.username,
-		elo:          dp.elo,
+		userID:       userID,
+		username:     username,
+		mmr:          mmr,
 		score:        score,
-		connected:    dp.connected,
-		answersCount: dp.answersCount,
-		totalTimeMs:  dp.totalTimeMs,
+		connected:    connected,
+		answersCount: answersCount,
+		totalTimeMs:  totalTimeMs,
 	}
 }
 
-// UpdateElo updates player's ELO (immutable)
-func (dp DuelPlayer) UpdateElo(newElo EloRating) DuelPlayer {
+// WithScore returns a copy with an explicit score (used for surrender/forfeit)
+func (dp DuelPlayer) WithScore(score int) DuelPlayer {
 	return DuelPlayer{
 		userID:       dp.userID,
 		username:     dp.username,
-		elo:          newElo,
-		score:        dp.score,
+		mmr:          dp.mmr,
+		score:        score,
 		connected:    dp.connected,
 		answersCount: dp.answersCount,
 		totalTimeMs:  dp.totalTimeMs,
@@ -271,7 +151,7 @@ func (dp DuelPlayer) UpdateElo(newElo EloRating) DuelPlayer {
 // Getters
 func (dp DuelPlayer) UserID() UserID       { return dp.userID }
 func (dp DuelPlayer) Username() string     { return dp.username }
-func (dp DuelPlayer) Elo() EloRating       { return dp.elo }
+func (dp DuelPlayer) MMR() int             { return dp.mmr }
 func (dp DuelPlayer) Score() int           { return dp.score }
 func (dp DuelPlayer) Connected() bool      { return dp.connected }
 func (dp DuelPlayer) AnswersCount() int    { return dp.answersCount }
@@ -366,6 +246,22 @@ func CalculateSpeedBonus(timeTakenMs int64) int {
 	}
 }
 
+// WinReason records how a finished duel was decided
+type WinReason string
+
+const (
+	WinReasonScore      WinReason = "score"      // Higher total score
+	WinReasonTime       WinReason = "time"       // Equal scores, less total answer time
+	WinReasonSurrender  WinReason = "surrender"  // Opponent surrendered
+	WinReasonTimeout    WinReason = "timeout"    // Opponent missed MaxConsecutiveTimeouts rounds in a row
+	WinReasonDisconnect WinReason = "disconnect" // Opponent did not reconnect within the grace period
+)
+
+// IsForfeit reports whether the game ended before all rounds were played
+func (wr WinReason) IsForfeit() bool {
+	return wr == WinReasonSurrender || wr == WinReasonTimeout || wr == WinReasonDisconnect
+}
+
 // GameStatus represents the current status of a duel game
 type GameStatus string
 
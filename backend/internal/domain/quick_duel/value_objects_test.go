@@ -23,177 +23,10 @@ func TestGameID_Operations(t *testing.T) {
 	}
 }
 
-// TestEloRating_NewEloRating tests ELO creation
-func TestEloRating_NewEloRating(t *testing.T) {
-	elo := NewEloRating()
-
-	if elo.Rating() != InitialEloRating {
-		t.Errorf("Initial rating = %d, want %d", elo.Rating(), InitialEloRating)
-	}
-	if elo.GamesPlayed() != 0 {
-		t.Errorf("Initial games played = %d, want 0", elo.GamesPlayed())
-	}
-	if !elo.IsNewPlayer() {
-		t.Error("Should be new player")
-	}
-}
-
-// TestEloRating_KFactor tests K-factor calculation
-func TestEloRating_KFactor(t *testing.T) {
-	tests := []struct {
-		name        string
-		gamesPlayed int
-		expectedK   int
-	}{
-		{"New player (0 games)", 0, KFactorNew},
-		{"New player (29 games)", 29, KFactorNew},
-		{"Veteran (30 games)", 30, KFactorRegular},
-		{"Veteran (100 games)", 100, KFactorRegular},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			elo := ReconstructEloRating(1000, tt.gamesPlayed)
-			k := elo.KFactor()
-
-			if k != tt.expectedK {
-				t.Errorf("KFactor() = %d, want %d", k, tt.expectedK)
-			}
-		})
-	}
-}
-
-// TestEloRating_CalculateNewRating tests ELO rating updates
-func TestEloRating_CalculateNewRating(t *testing.T) {
-	tests := []struct {
-		name             string
-		initialRating    int
-		gamesPlayed      int
-		won              bool
-		opponentRating   int
-		expectedIncrease bool
-	}{
-		{
-			name:             "New player wins vs equal",
-			initialRating:    1000,
-			gamesPlayed:      5,
-			won:              true,
-			opponentRating:   1000,
-			expectedIncrease: true,
-		},
-		{
-			name:             "New player loses vs equal",
-			initialRating:    1000,
-			gamesPlayed:      5,
-			won:              false,
-			opponentRating:   1000,
-			expectedIncrease: false,
-		},
-		{
-			name:             "Veteran wins vs weaker",
-			initialRating:    1200,
-			gamesPlayed:      50,
-			won:              true,
-			opponentRating:   1000,
-			expectedIncrease: true,
-		},
-		{
-			name:             "Low rating enforces minimum",
-			initialRating:    150,
-			gamesPlayed:      10,
-			won:              false,
-			opponentRating:   1000,
-			expectedIncrease: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			elo := ReconstructEloRating(tt.initialRating, tt.gamesPlayed)
-			newElo := elo.CalculateNewRating(tt.won, tt.opponentRating)
-
-			if tt.expectedIncrease {
-				if newElo.Rating() <= tt.initialRating {
-					t.Errorf("Rating should increase, got %d (was %d)", newElo.Rating(), tt.initialRating)
-				}
-			} else {
-				if newElo.Rating() > tt.initialRating {
-					t.Errorf("Rating should not increase, got %d (was %d)", newElo.Rating(), tt.initialRating)
-				}
-			}
-
-			// Check games played incremented
-			if newElo.GamesPlayed() != tt.gamesPlayed+1 {
-				t.Errorf("Games played = %d, want %d", newElo.GamesPlayed(), tt.gamesPlayed+1)
-			}
-
-			// Check minimum rating enforced
-			if newElo.Rating() < MinEloRating {
-				t.Errorf("Rating %d below minimum %d", newElo.Rating(), MinEloRating)
-			}
-
-			// Verify immutability
-			if elo.Rating() != tt.initialRating {
-				t.Error("Original ELO should be unchanged")
-			}
-		})
-	}
-}
-
-// TestEloRating_GetMatchmakingRange tests matchmaking ranges (5-tier)
-func TestEloRating_GetMatchmakingRange(t *testing.T) {
-	elo := ReconstructEloRating(1000, 10)
-
-	tests := []struct {
-		name          string
-		searchSeconds int
-		expectedMin   int
-		expectedMax   int
-	}{
-		{"Instant (0s)", 0, 950, 1050},
-		{"Tier1 boundary (9s)", 9, 950, 1050},
-		{"Tier2 start (10s)", 10, 900, 1100},
-		{"Tier2 boundary (19s)", 19, 900, 1100},
-		{"Tier3 start (20s)", 20, 800, 1200},
-		{"Tier3 boundary (29s)", 29, 800, 1200},
-		{"Tier4 start (30s)", 30, 700, 1300},
-		{"Tier4 boundary (44s)", 44, 700, 1300},
-		{"Tier5 start (45s)", 45, 500, 1500},
-		{"Tier5 long (120s)", 120, 500, 1500},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			min, max := elo.GetMatchmakingRange(tt.searchSeconds)
-
-			if min != tt.expectedMin {
-				t.Errorf("Min = %d, want %d", min, tt.expectedMin)
-			}
-			if max != tt.expectedMax {
-				t.Errorf("Max = %d, want %d", max, tt.expectedMax)
-			}
-		})
-	}
-}
-
-// TestEloRating_GetMatchmakingRange_LowRating tests minimum rating clamping
-func TestEloRating_GetMatchmakingRange_LowRating(t *testing.T) {
-	elo := ReconstructEloRating(110, 5) // Near minimum
-
-	min, max := elo.GetMatchmakingRange(0) // Tier1: delta=50 → 60..160
-	if min != MinEloRating {
-		t.Errorf("Min should be clamped to MinEloRating=%d, got %d", MinEloRating, min)
-	}
-	if max != 160 {
-		t.Errorf("Max = %d, want 160", max)
-	}
-}
-
 // TestDuelPlayer_Operations tests DuelPlayer value object
 func TestDuelPlayer_Operations(t *testing.T) {
 	userID, _ := shared.NewUserID("user123")
-	elo := NewEloRating()
-	player := NewDuelPlayer(userID, "TestPlayer", elo)
+	player := NewDuelPlayer(userID, "TestPlayer", InitialMMR)
 
 	if player.UserID() != userID {
 		t.Errorf("UserID = %v, want %v", player.UserID(), userID)
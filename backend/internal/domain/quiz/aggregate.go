@@ -54,6 +54,13 @@ type Quiz struct {
 	streakThreshold      int    // Number of correct answers to trigger streak bonus
 	streakBonus          Points // Bonus points for achieving streak
 
+	// Title and description in other locales (questions carry their own)
+	translations map[string]QuizTranslation
+
+	// Soft delete (Unix timestamp, 0 = live): hidden from listings and can't be
+	// started, while sessions and games that played it keep their history
+	deletedAt int64
+
 	// Domain events collected during operations
 	events []Event
 }
@@ -179,12 +186,17 @@ func (q *Quiz) AddQuestion(question Question) error {
 		return ErrInvalidAnswer
 	}
 
+	question.quizID = q.id
 	q.questions = append(q.questions, question)
 	return nil
 }
 
 // CanStart checks if the quiz can be started (business rule)
 func (q *Quiz) CanStart() error {
+	if q.IsDeleted() {
+		return ErrQuizDeleted
+	}
+
 	if len(q.questions) == 0 {
 		return ErrNoQuestions
 	}
@@ -333,6 +345,112 @@ func (q *Quiz) SetImportMetadata(batchID string, generatedAt int64) {
 	q.generatedAt = &generatedAt
 }
 
+// UpdateDetails replaces the quiz's editable details
+func (q *Quiz) UpdateDetails(title QuizTitle, description string, categoryID CategoryID, timeLimit TimeLimit, passingScore PassingScore, updatedAt int64) error {
+	if title.IsEmpty() {
+		return ErrInvalidTitle
+	}
+
+	q.title = title
+	q.description = description
+	q.categoryID = categoryID
+	q.timeLimit = timeLimit
+	q.passingScore = passingScore
+	q.updatedAt = updatedAt
+	return nil
+}
+
+// ReplaceTags replaces the quiz's tags (same limits as AddTag)
+func (q *Quiz) ReplaceTags(tags []Tag) error {
+	q.tags = make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		if err := q.AddTag(tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceTranslations replaces the quiz's translations by locale
+func (q *Quiz) ReplaceTranslations(translations map[string]QuizTranslation) error {
+	q.translations = nil
+	for locale, translation := range translations {
+		if err := q.SetTranslation(locale, translation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete soft-deletes the quiz; Restore brings it back
+func (q *Quiz) Delete(deletedAt int64) error {
+	if q.IsDeleted() {
+		return ErrQuizDeleted
+	}
+	q.deletedAt = deletedAt
+	return nil
+}
+
+// Restore undoes Delete
+func (q *Quiz) Restore() error {
+	if !q.IsDeleted() {
+		return ErrQuizNotDeleted
+	}
+	q.deletedAt = 0
+	return nil
+}
+
+// IsDeleted reports whether the quiz is soft-deleted
+func (q *Quiz) IsDeleted() bool { return q.deletedAt != 0 }
+
+// DeletedAt returns when the quiz was soft-deleted (0 = live)
+func (q *Quiz) DeletedAt() int64 { return q.deletedAt }
+
+// SetTranslation sets the quiz's title and description in another locale
+func (q *Quiz) SetTranslation(locale string, translation QuizTranslation) error {
+	if err := validateTranslationLocale(locale); err != nil {
+		return err
+	}
+	if q.translations == nil {
+		q.translations = make(map[string]QuizTranslation)
+	}
+	q.translations[locale] = translation
+	return nil
+}
+
+// Translations returns a copy of the quiz's translations by locale
+func (q *Quiz) Translations() map[string]QuizTranslation {
+	copies := make(map[string]QuizTranslation, len(q.translations))
+	for locale, translation := range q.translations {
+		copies[locale] = translation
+	}
+	return copies
+}
+
+// Localized returns the quiz as read in the given locale: title, description and
+// every question translated where a translation exists, DefaultLocale otherwise.
+// The copy is for display and must not be saved.
+func (q *Quiz) Localized(locale string) *Quiz {
+	locale = NormalizeLocale(locale)
+	if locale == DefaultLocale {
+		return q
+	}
+
+	localized := *q
+	if translation, ok := q.translations[locale]; ok {
+		localized.title = translation.title
+		if translation.description != "" {
+			localized.description = translation.description
+		}
+	}
+	localized.questions = make([]Question, len(q.questions))
+	for i := range q.questions {
+		localized.questions[i] = *q.questions[i].Localized(locale)
+	}
+	localized.events = make([]Event, 0)
+	return &localized
+}
+
 // Events returns collected domain events and clears them
 func (q *Quiz) Events() []Event {
 	events := q.events
@@ -401,8 +519,10 @@ type SubmitAnswerResult struct {
 	IsCorrect     bool
 }
 
-// SubmitAnswer processes a user's answer (business logic)
-func (qs *QuizSession) SubmitAnswer(question *Question, answerID AnswerID, answeredAt int64, timeTaken int64, quiz *Quiz) (*SubmitAnswerResult, error) {
+// SubmitAnswer processes a user's answer (business logic).
+// Partially right answers (see Question.Evaluate) earn their share of the base points
+// and time bonus, but only fully right ones extend the streak.
+func (qs *QuizSession) SubmitAnswer(question *Question, submission AnswerSubmission, answeredAt int64, timeTaken int64, quiz *Quiz) (*SubmitAnswerResult, error) {
 	if qs.status != SessionStatusActive {
 		return nil, ErrSessionCompleted
 	}
@@ -414,8 +534,8 @@ func (qs *QuizSession) SubmitAnswer(question *Question, answerID AnswerID, answe
 		}
 	}
 
-	// Validate answer belongs to question
-	answer, err := question.GetAnswer(answerID)
+	// Validate and score the answer for the question's type
+	evaluation, err := question.Evaluate(submission)
 	if err != nil {
 		return nil, err
 	}
@@ -427,26 +547,28 @@ func (qs *QuizSession) SubmitAnswer(question *Question, answerID AnswerID, answe
 		StreakBonus:   Points{},
 		TotalPoints:   Points{},
 		CurrentStreak: qs.correctAnswerStreak,
-		IsCorrect:     answer.IsCorrect(),
+		IsCorrect:     evaluation.IsCorrect,
 	}
 
-	// Only calculate points if answer is correct
-	if answer.IsCorrect() {
+	// Only calculate points if the answer earned credit
+	if evaluation.Credit > 0 {
 		// 1. Base points (from question or quiz default)
 		basePoints := question.Points()
 		if basePoints.IsZero() {
 			basePoints = quiz.BasePoints()
 		}
-		result.BasePoints = basePoints
+		result.BasePoints = evaluation.Scale(basePoints)
 
 		// 2. Time bonus (linear formula)
 		timeLimitMs := int64(quiz.TimeLimitPerQuestion()) * 1000
 		if timeTaken > 0 && timeTaken <= timeLimitMs {
 			ratio := 1.0 - (float64(timeTaken) / float64(timeLimitMs))
-			timeBonusValue := int(float64(quiz.MaxTimeBonus().Value()) * ratio)
-			result.TimeBonus, _ = NewPoints(timeBonusValue)
+			timeBonusValue, _ := NewPoints(int(float64(quiz.MaxTimeBonus().Value()) * ratio))
+			result.TimeBonus = evaluation.Scale(timeBonusValue)
 		}
+	}
 
+	if evaluation.IsCorrect {
 		// 3. Update streak
 		qs.correctAnswerStreak++
 		result.CurrentStreak = qs.correctAnswerStreak
@@ -455,21 +577,21 @@ func (qs *QuizSession) SubmitAnswer(question *Question, answerID AnswerID, answe
 		if qs.correctAnswerStreak >= quiz.StreakThreshold() {
 			result.StreakBonus = quiz.StreakBonus()
 		}
-
-		// 5. Calculate total points
-		result.TotalPoints = result.BasePoints.Add(result.TimeBonus).Add(result.StreakBonus)
-		qs.score = qs.score.Add(result.TotalPoints)
 	} else {
 		// Reset streak on incorrect answer
 		qs.correctAnswerStreak = 0
 		result.CurrentStreak = 0
 	}
 
+	// 5. Calculate total points
+	result.TotalPoints = result.BasePoints.Add(result.TimeBonus).Add(result.StreakBonus)
+	qs.score = qs.score.Add(result.TotalPoints)
+
 	// Record answer with detailed breakdown
 	userAnswer := NewUserAnswerWithBreakdown(
 		question.ID(),
-		answerID,
-		answer.IsCorrect(),
+		submission,
+		evaluation.IsCorrect,
 		result.BasePoints,
 		result.TimeBonus,
 		result.StreakBonus,
@@ -483,8 +605,8 @@ func (qs *QuizSession) SubmitAnswer(question *Question, answerID AnswerID, answe
 	qs.events = append(qs.events, NewAnswerSubmittedEvent(
 		qs.id,
 		question.ID(),
-		answerID,
-		answer.IsCorrect(),
+		submission.PrimaryAnswerID(),
+		evaluation.IsCorrect,
 		result.TotalPoints,
 		answeredAt,
 	))
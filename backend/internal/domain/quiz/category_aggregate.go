@@ -4,6 +4,9 @@ package quiz
 type Category struct {
 	id   CategoryID
 	name CategoryName
+
+	translations map[string]CategoryName // locale -> name, see DefaultLocale
+	deletedAt    int64                   // soft delete (Unix timestamp, 0 = live)
 }
 
 // NewCategory creates a new Category aggregate.
@@ -32,3 +35,65 @@ func (c *Category) ID() CategoryID {
 func (c *Category) Name() CategoryName {
 	return c.name
 }
+
+// Delete soft-deletes the category: it is hidden from the category list, and its
+// quizzes keep it. Restore brings it back.
+func (c *Category) Delete(deletedAt int64) error {
+	if c.IsDeleted() {
+		return ErrCategoryDeleted
+	}
+	c.deletedAt = deletedAt
+	return nil
+}
+
+// Restore undoes Delete.
+func (c *Category) Restore() error {
+	if !c.IsDeleted() {
+		return ErrCategoryNotDeleted
+	}
+	c.deletedAt = 0
+	return nil
+}
+
+// IsDeleted reports whether the category is soft-deleted.
+func (c *Category) IsDeleted() bool {
+	return c.deletedAt != 0
+}
+
+// DeletedAt returns when the category was soft-deleted (0 = live).
+func (c *Category) DeletedAt() int64 {
+	return c.deletedAt
+}
+
+// SetTranslation sets the category's name in another locale.
+func (c *Category) SetTranslation(locale string, name CategoryName) error {
+	if err := validateTranslationLocale(locale); err != nil {
+		return err
+	}
+	if c.translations == nil {
+		c.translations = make(map[string]CategoryName)
+	}
+	c.translations[locale] = name
+	return nil
+}
+
+// Translations returns a copy of the category's translated names by locale.
+func (c *Category) Translations() map[string]CategoryName {
+	copies := make(map[string]CategoryName, len(c.translations))
+	for locale, name := range c.translations {
+		copies[locale] = name
+	}
+	return copies
+}
+
+// Localized returns the category with its name in the given locale, falling back
+// to DefaultLocale. The copy is for display and must not be persisted.
+func (c *Category) Localized(locale string) *Category {
+	name, ok := c.translations[NormalizeLocale(locale)]
+	if !ok {
+		return c
+	}
+	localized := *c
+	localized.name = name
+	return &localized
+}
@@ -0,0 +1,221 @@
+package quiz
+
+import "testing"
+
+func newTestQuiz(t *testing.T) *Quiz {
+	t.Helper()
+
+	title, _ := NewQuizTitle("Go basics")
+	timeLimit, _ := NewTimeLimit(300)
+	passingScore, _ := NewPassingScore(70)
+	q, err := NewQuiz(NewQuizID(), title, "", CategoryID{}, timeLimit, passingScore, 1000)
+	if err != nil {
+		t.Fatalf("NewQuiz: %v", err)
+	}
+	return q
+}
+
+func TestQuiz_DeleteAndRestore(t *testing.T) {
+	q := newTestQuiz(t)
+	question, _ := newTypedQuestion(t, QuestionTypeSingleChoice, true, false)
+	if err := q.AddQuestion(*question); err != nil {
+		t.Fatalf("AddQuestion: %v", err)
+	}
+
+	if err := q.Restore(); err != ErrQuizNotDeleted {
+		t.Errorf("Restore on live quiz = %v, want %v", err, ErrQuizNotDeleted)
+	}
+	if err := q.Delete(2000); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if !q.IsDeleted() || q.DeletedAt() != 2000 {
+		t.Errorf("after Delete: IsDeleted = %v, DeletedAt = %d", q.IsDeleted(), q.DeletedAt())
+	}
+	if err := q.Delete(3000); err != ErrQuizDeleted {
+		t.Errorf("second Delete = %v, want %v", err, ErrQuizDeleted)
+	}
+	if err := q.CanStart(); err != ErrQuizDeleted {
+		t.Errorf("CanStart on deleted quiz = %v, want %v", err, ErrQuizDeleted)
+	}
+
+	if err := q.Restore(); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if q.IsDeleted() || q.DeletedAt() != 0 {
+		t.Errorf("after Restore: IsDeleted = %v, DeletedAt = %d", q.IsDeleted(), q.DeletedAt())
+	}
+	if err := q.CanStart(); err != nil {
+		t.Errorf("CanStart on restored quiz = %v", err)
+	}
+}
+
+func TestQuiz_AddQuestionAssignsQuiz(t *testing.T) {
+	q := newTestQuiz(t)
+	question, _ := newTypedQuestion(t, QuestionTypeSingleChoice, true, false)
+	if err := q.AddQuestion(*question); err != nil {
+		t.Fatalf("AddQuestion: %v", err)
+	}
+
+	if got := q.Questions()[0].QuizID(); !got.Equals(q.ID()) {
+		t.Errorf("QuizID = %s, want %s", got, q.ID())
+	}
+}
+
+func TestQuestion_DeleteAndRestore(t *testing.T) {
+	question, _ := newTypedQuestion(t, QuestionTypeSingleChoice, true, false)
+
+	if err := question.Restore(); err != ErrQuestionNotDeleted {
+		t.Errorf("Restore on live question = %v, want %v", err, ErrQuestionNotDeleted)
+	}
+	if err := question.Delete(2000); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := question.Delete(3000); err != ErrQuestionDeleted {
+		t.Errorf("second Delete = %v, want %v", err, ErrQuestionDeleted)
+	}
+	if err := question.Restore(); err != nil || question.IsDeleted() {
+		t.Errorf("Restore = %v, IsDeleted = %v", err, question.IsDeleted())
+	}
+}
+
+func TestQuestion_RemoveAnswer(t *testing.T) {
+	question, ids := newTypedQuestion(t, QuestionTypeMultiSelect, true, true, false)
+
+	if err := question.RemoveAnswer(ids[1]); err != nil {
+		t.Fatalf("RemoveAnswer: %v", err)
+	}
+	if question.IsValidAnswer(ids[1]) || len(question.Answers()) != 2 {
+		t.Errorf("answer still present: %d answers", len(question.Answers()))
+	}
+	if err := question.RemoveAnswer(ids[1]); err != ErrAnswerNotFound {
+		t.Errorf("RemoveAnswer twice = %v, want %v", err, ErrAnswerNotFound)
+	}
+
+	// Removing the last correct answer leaves a question nobody can get right
+	if err := question.RemoveAnswer(ids[0]); err != nil {
+		t.Fatalf("RemoveAnswer: %v", err)
+	}
+	if err := question.ValidateAnswers(); err == nil {
+		t.Error("ValidateAnswers accepted a question without a correct answer")
+	}
+}
+
+func TestCategory_DeleteAndRestore(t *testing.T) {
+	name, _ := NewCategoryName("Programming")
+	category, err := NewCategory(NewCategoryID(), name)
+	if err != nil {
+		t.Fatalf("NewCategory: %v", err)
+	}
+
+	if err := category.Restore(); err != ErrCategoryNotDeleted {
+		t.Errorf("Restore on live category = %v, want %v", err, ErrCategoryNotDeleted)
+	}
+	if err := category.Delete(2000); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := category.Delete(3000); err != ErrCategoryDeleted {
+		t.Errorf("second Delete = %v, want %v", err, ErrCategoryDeleted)
+	}
+	if err := category.Restore(); err != nil || category.IsDeleted() {
+		t.Errorf("Restore = %v, IsDeleted = %v", err, category.IsDeleted())
+	}
+}
+
+func TestTag_RenameDeleteAndRestore(t *testing.T) {
+	tag, err := NewTag("language:go")
+	if err != nil {
+		t.Fatalf("NewTag: %v", err)
+	}
+	id := tag.ID()
+
+	name, _ := NewTagName("language:golang")
+	tag.Rename(name)
+	if tag.Name().String() != "language:golang" || tag.ID() != id {
+		t.Errorf("after Rename: name = %s, id = %s (was %s)", tag.Name(), tag.ID(), id)
+	}
+
+	if err := tag.Restore(); err != ErrTagNotDeleted {
+		t.Errorf("Restore on live tag = %v, want %v", err, ErrTagNotDeleted)
+	}
+	if err := tag.Delete(2000); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := tag.Delete(3000); err != ErrTagDeleted {
+		t.Errorf("second Delete = %v, want %v", err, ErrTagDeleted)
+	}
+	if err := tag.Restore(); err != nil || tag.IsDeleted() {
+		t.Errorf("Restore = %v, IsDeleted = %v", err, tag.IsDeleted())
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	before := map[string]interface{}{
+		"title":  "Go basics",
+		"tags":   []interface{}{"language:go"},
+		"points": float64(10),
+		"media":  "cover.png",
+	}
+	after := map[string]interface{}{
+		"title":  "Go fundamentals",
+		"tags":   []interface{}{"language:go"},
+		"points": float64(10),
+		"alt":    "Gopher",
+	}
+
+	changes := DiffFields(before, after)
+
+	want := map[string]FieldChange{
+		"title": {Before: "Go basics", After: "Go fundamentals"},
+		"media": {Before: "cover.png", After: nil},
+		"alt":   {Before: nil, After: "Gopher"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("DiffFields = %v, want %v", changes, want)
+	}
+	for field, change := range want {
+		if changes[field] != change {
+			t.Errorf("change of %s = %v, want %v", field, changes[field], change)
+		}
+	}
+
+	if created := DiffFields(nil, map[string]interface{}{"title": "Go"}); created["title"].After != "Go" {
+		t.Errorf("DiffFields on create = %v", created)
+	}
+}
+
+func TestNewContentAuditEntry(t *testing.T) {
+	changes := map[string]FieldChange{"deleted": {Before: false, After: true}}
+
+	tests := []struct {
+		name       string
+		entityType ContentEntityType
+		entityID   string
+		action     ContentAction
+		actor      string
+		wantErr    bool
+	}{
+		{name: "valid", entityType: ContentEntityQuiz, entityID: "q1", action: ContentActionDelete, actor: "alice"},
+		{name: "unknown entity type", entityType: "player", entityID: "q1", action: ContentActionDelete, actor: "alice", wantErr: true},
+		{name: "unknown action", entityType: ContentEntityQuiz, entityID: "q1", action: "publish", actor: "alice", wantErr: true},
+		{name: "no entity ID", entityType: ContentEntityQuiz, action: ContentActionDelete, actor: "alice", wantErr: true},
+		{name: "no actor", entityType: ContentEntityQuiz, entityID: "q1", action: ContentActionDelete, actor: "  ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewContentAuditEntry(tt.entityType, tt.entityID, tt.action, tt.actor, changes, 1000)
+			if tt.wantErr {
+				if err != ErrInvalidAuditEntry {
+					t.Errorf("err = %v, want %v", err, ErrInvalidAuditEntry)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewContentAuditEntry: %v", err)
+			}
+			if entry.ID().IsZero() || entry.Actor() != tt.actor || entry.Changes()["deleted"].After != true {
+				t.Errorf("entry = %+v", entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package quiz
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// ContentEntityType is the kind of catalog content an audit entry is about
+type ContentEntityType string
+
+const (
+	ContentEntityQuiz     ContentEntityType = "quiz"
+	ContentEntityQuestion ContentEntityType = "question"
+	ContentEntityAnswer   ContentEntityType = "answer"
+	ContentEntityCategory ContentEntityType = "category"
+	ContentEntityTag      ContentEntityType = "tag"
+)
+
+func (t ContentEntityType) IsValid() bool {
+	switch t {
+	case ContentEntityQuiz, ContentEntityQuestion, ContentEntityAnswer, ContentEntityCategory, ContentEntityTag:
+		return true
+	}
+	return false
+}
+
+func (t ContentEntityType) String() string { return string(t) }
+
+// ContentAction is what an admin did to catalog content
+type ContentAction string
+
+const (
+	ContentActionCreate  ContentAction = "create"
+	ContentActionUpdate  ContentAction = "update"
+	ContentActionDelete  ContentAction = "delete"
+	ContentActionRestore ContentAction = "restore"
+)
+
+func (a ContentAction) IsValid() bool {
+	switch a {
+	case ContentActionCreate, ContentActionUpdate, ContentActionDelete, ContentActionRestore:
+		return true
+	}
+	return false
+}
+
+func (a ContentAction) String() string { return string(a) }
+
+// FieldChange is one field's value before and after an edit (nil = absent)
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// ContentAuditEntry records one admin edit of catalog content, with the fields it changed
+type ContentAuditEntry struct {
+	id         shared.ID
+	entityType ContentEntityType
+	entityID   string
+	action     ContentAction
+	actor      string
+	changes    map[string]FieldChange
+	createdAt  int64
+}
+
+// NewContentAuditEntry creates an audit entry for an edit
+func NewContentAuditEntry(
+	entityType ContentEntityType,
+	entityID string,
+	action ContentAction,
+	actor string,
+	changes map[string]FieldChange,
+	createdAt int64,
+) (*ContentAuditEntry, error) {
+	if !entityType.IsValid() || !action.IsValid() || entityID == "" || createdAt <= 0 {
+		return nil, ErrInvalidAuditEntry
+	}
+	actor = strings.TrimSpace(actor)
+	if actor == "" || len(actor) > 100 {
+		return nil, ErrInvalidAuditEntry
+	}
+	if changes == nil {
+		changes = map[string]FieldChange{}
+	}
+
+	return &ContentAuditEntry{
+		id:         shared.NewID(),
+		entityType: entityType,
+		entityID:   entityID,
+		action:     action,
+		actor:      actor,
+		changes:    changes,
+		createdAt:  createdAt,
+	}, nil
+}
+
+// ReconstructContentAuditEntry reconstructs an audit entry from persistence
+func ReconstructContentAuditEntry(
+	id shared.ID,
+	entityType ContentEntityType,
+	entityID string,
+	action ContentAction,
+	actor string,
+	changes map[string]FieldChange,
+	createdAt int64,
+) *ContentAuditEntry {
+	return &ContentAuditEntry{
+		id:         id,
+		entityType: entityType,
+		entityID:   entityID,
+		action:     action,
+		actor:      actor,
+		changes:    changes,
+		createdAt:  createdAt,
+	}
+}
+
+func (e *ContentAuditEntry) ID() shared.ID                   { return e.id }
+func (e *ContentAuditEntry) EntityType() ContentEntityType   { return e.entityType }
+func (e *ContentAuditEntry) EntityID() string                { return e.entityID }
+func (e *ContentAuditEntry) Action() ContentAction           { return e.action }
+func (e *ContentAuditEntry) Actor() string                   { return e.actor }
+func (e *ContentAuditEntry) Changes() map[string]FieldChange { return e.changes }
+func (e *ContentAuditEntry) CreatedAt() int64                { return e.createdAt }
+
+// DiffFields compares two field snapshots of the same entity and returns the fields
+// whose value changed. A nil snapshot stands for "didn't exist" (create).
+func DiffFields(before, after map[string]interface{}) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+	for field, value := range after {
+		previous, ok := before[field]
+		if !ok || !reflect.DeepEqual(previous, value) {
+			changes[field] = FieldChange{Before: previous, After: value}
+		}
+	}
+	for field, previous := range before {
+		if _, ok := after[field]; !ok {
+			changes[field] = FieldChange{Before: previous}
+		}
+	}
+	return changes
+}
+
+// ContentAuditRepository stores the audit trail of catalog edits (append-only)
+type ContentAuditRepository interface {
+	// Append stores an audit entry
+	Append(entry *ContentAuditEntry) error
+
+	// AppendInTx stores an audit entry within the transaction that saves the edit
+	AppendInTx(tx *sql.Tx, entry *ContentAuditEntry) error
+
+	// FindByEntity retrieves an entity's audit entries, newest first
+	FindByEntity(entityType ContentEntityType, entityID string, limit int) ([]*ContentAuditEntry, error)
+
+	// FindRecent retrieves the latest audit entries across all content, newest first
+	FindRecent(limit int) ([]*ContentAuditEntry, error)
+}
@@ -0,0 +1,54 @@
+package quiz
+
+import "testing"
+
+func TestNewDifficulty(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Difficulty
+		wantErr bool
+	}{
+		{input: "easy", want: DifficultyEasy},
+		{input: "medium", want: DifficultyMedium},
+		{input: "hard", want: DifficultyHard},
+		{input: "", want: DefaultDifficulty},
+		{input: "extreme", wantErr: true},
+		{input: "Easy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NewDifficulty(tt.input)
+			if tt.wantErr {
+				if err != ErrInvalidDifficulty {
+					t.Fatalf("expected ErrInvalidDifficulty, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewQuestion_DefaultsToMediumDifficulty(t *testing.T) {
+	text, _ := NewQuestionText("What is 2+2?")
+	points, _ := NewPoints(10)
+
+	q, err := NewQuestion(NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+	if q.Difficulty() != DifficultyMedium {
+		t.Fatalf("expected default difficulty medium, got %s", q.Difficulty())
+	}
+
+	q.SetDifficulty(DifficultyHard)
+	if q.Difficulty() != DifficultyHard {
+		t.Fatalf("expected hard after SetDifficulty, got %s", q.Difficulty())
+	}
+}
@@ -6,6 +6,9 @@ type Answer struct {
 	text      AnswerText
 	isCorrect bool
 	position  int
+	media     Media
+
+	translations map[string]AnswerText // locale -> text, see DefaultLocale
 }
 
 // NewAnswer creates a new Answer entity
@@ -22,19 +25,57 @@ func NewAnswer(id AnswerID, text AnswerText, isCorrect bool, position int) (*Ans
 	}, nil
 }
 
-// Getters (no setters - immutable after creation)
+// SetMedia attaches an image to the answer (e.g. "which of these flags...").
+// Call before the answer is added to its question: questions keep copies.
+func (a *Answer) SetMedia(media Media) {
+	a.media = media
+}
+
+// SetTranslation sets the answer's text in another locale.
+// Like SetMedia, call before the answer is added to its question.
+func (a *Answer) SetTranslation(locale string, text AnswerText) error {
+	if err := validateTranslationLocale(locale); err != nil {
+		return err
+	}
+	if a.translations == nil {
+		a.translations = make(map[string]AnswerText)
+	}
+	a.translations[locale] = text
+	return nil
+}
+
+// Translations returns a copy of the answer's translations by locale
+func (a *Answer) Translations() map[string]AnswerText {
+	copies := make(map[string]AnswerText, len(a.translations))
+	for locale, text := range a.translations {
+		copies[locale] = text
+	}
+	return copies
+}
+
+// Getters
 func (a *Answer) ID() AnswerID     { return a.id }
 func (a *Answer) Text() AnswerText { return a.text }
 func (a *Answer) IsCorrect() bool  { return a.isCorrect }
 func (a *Answer) Position() int    { return a.position }
+func (a *Answer) Media() Media     { return a.media }
 
 // Question is an entity representing a quiz question
 type Question struct {
-	id       QuestionID
-	text     QuestionText
-	answers  []Answer
-	points   Points
-	position int
+	id            QuestionID
+	text          QuestionText
+	answers       []Answer
+	points        Points
+	position      int
+	difficulty    Difficulty
+	explanation   Explanation
+	media         Media
+	questionType  QuestionType
+	numericAnswer NumericAnswer // numeric questions only
+	quizID        QuizID        // set when added to a quiz
+	deletedAt     int64         // soft delete (Unix timestamp, 0 = live)
+
+	translations map[string]QuestionTranslation // locale -> text, see DefaultLocale
 }
 
 // NewQuestion creates a new Question entity
@@ -44,14 +85,90 @@ func NewQuestion(id QuestionID, text QuestionText, points Points, position int)
 	}
 
 	return &Question{
-		id:       id,
-		text:     text,
-		points:   points,
-		position: position,
-		answers:  make([]Answer, 0),
+		id:           id,
+		text:         text,
+		points:       points,
+		position:     position,
+		difficulty:   DefaultDifficulty,
+		questionType: DefaultQuestionType,
+		answers:      make([]Answer, 0),
 	}, nil
 }
 
+// SetDifficulty sets the difficulty level used by adaptive question selection
+func (q *Question) SetDifficulty(difficulty Difficulty) {
+	q.difficulty = difficulty
+}
+
+// SetExplanation sets the rationale shown once the question has been answered
+func (q *Question) SetExplanation(explanation Explanation) {
+	q.explanation = explanation
+}
+
+// SetMedia attaches an image to the question (e.g. "identify this landmark")
+func (q *Question) SetMedia(media Media) {
+	q.media = media
+}
+
+// SetType sets how the question is answered and scored, see ValidateAnswers
+func (q *Question) SetType(questionType QuestionType) {
+	q.questionType = questionType
+}
+
+// SetNumericAnswer sets the solution of a numeric question
+func (q *Question) SetNumericAnswer(answer NumericAnswer) {
+	q.numericAnswer = answer
+}
+
+// SetTranslation sets the question's text (and explanation) in another locale.
+// Answers carry their own translations.
+func (q *Question) SetTranslation(locale string, translation QuestionTranslation) error {
+	if err := validateTranslationLocale(locale); err != nil {
+		return err
+	}
+	if q.translations == nil {
+		q.translations = make(map[string]QuestionTranslation)
+	}
+	q.translations[locale] = translation
+	return nil
+}
+
+// Translations returns a copy of the question's translations by locale
+func (q *Question) Translations() map[string]QuestionTranslation {
+	copies := make(map[string]QuestionTranslation, len(q.translations))
+	for locale, translation := range q.translations {
+		copies[locale] = translation
+	}
+	return copies
+}
+
+// Localized returns the question as read in the given locale: each text that has
+// a translation is replaced by it, the rest stays in DefaultLocale.
+// IDs, correctness and media are unchanged, so the copy answers exactly like the
+// original; it is meant for display and must not be persisted.
+func (q *Question) Localized(locale string) *Question {
+	locale = NormalizeLocale(locale)
+	if locale == DefaultLocale {
+		return q
+	}
+
+	localized := *q
+	if translation, ok := q.translations[locale]; ok {
+		localized.text = translation.text
+		if translation.explanation != "" && !q.explanation.IsEmpty() {
+			localized.explanation = Explanation{text: translation.explanation, sourceURL: q.explanation.sourceURL}
+		}
+	}
+	localized.answers = make([]Answer, len(q.answers))
+	for i, answer := range q.answers {
+		if text, ok := answer.translations[locale]; ok {
+			answer.text = text
+		}
+		localized.answers[i] = answer
+	}
+	return &localized
+}
+
 // AddAnswer adds an answer to the question
 func (q *Question) AddAnswer(answer Answer) error {
 	if len(q.answers) >= 4 {
@@ -62,8 +179,53 @@ func (q *Question) AddAnswer(answer Answer) error {
 	return nil
 }
 
+// RemoveAnswer removes an answer from the question
+func (q *Question) RemoveAnswer(answerID AnswerID) error {
+	for i := range q.answers {
+		if q.answers[i].ID().Equals(answerID) {
+			q.answers = append(q.answers[:i:i], q.answers[i+1:]...)
+			return nil
+		}
+	}
+	return ErrAnswerNotFound
+}
+
+// AssignToQuiz sets the quiz the question belongs to (Quiz.AddQuestion does it too)
+func (q *Question) AssignToQuiz(quizID QuizID) {
+	q.quizID = quizID
+}
+
+// Delete soft-deletes the question: it is no longer picked for new games, while
+// games that already played it can still load it. Restore brings it back.
+func (q *Question) Delete(deletedAt int64) error {
+	if q.IsDeleted() {
+		return ErrQuestionDeleted
+	}
+	q.deletedAt = deletedAt
+	return nil
+}
+
+// Restore undoes Delete
+func (q *Question) Restore() error {
+	if !q.IsDeleted() {
+		return ErrQuestionNotDeleted
+	}
+	q.deletedAt = 0
+	return nil
+}
+
+// IsDeleted reports whether the question is soft-deleted
+func (q *Question) IsDeleted() bool { return q.deletedAt != 0 }
+
 // HasCorrectAnswer checks if question has at least one correct answer
+// (a numeric answer, or options to order, for those types)
 func (q *Question) HasCorrectAnswer() bool {
+	switch q.questionType {
+	case QuestionTypeNumeric:
+		return !q.numericAnswer.IsEmpty()
+	case QuestionTypeOrdering:
+		return len(q.answers) > 0
+	}
 	for _, answer := range q.answers {
 		if answer.IsCorrect() {
 			return true
@@ -93,10 +255,24 @@ func (q *Question) GetAnswer(answerID AnswerID) (*Answer, error) {
 }
 
 // Getters
-func (q *Question) ID() QuestionID     { return q.id }
-func (q *Question) Text() QuestionText { return q.text }
-func (q *Question) Points() Points     { return q.points }
-func (q *Question) Position() int      { return q.position }
+func (q *Question) ID() QuestionID               { return q.id }
+func (q *Question) Text() QuestionText           { return q.text }
+func (q *Question) Points() Points               { return q.points }
+func (q *Question) Position() int                { return q.position }
+func (q *Question) Difficulty() Difficulty       { return q.difficulty }
+func (q *Question) Explanation() Explanation     { return q.explanation }
+func (q *Question) Media() Media                 { return q.media }
+func (q *Question) NumericAnswer() NumericAnswer { return q.numericAnswer }
+func (q *Question) QuizID() QuizID               { return q.quizID }
+func (q *Question) DeletedAt() int64             { return q.deletedAt }
+
+// Type returns how the question is answered; questions stored before types existed are single choice
+func (q *Question) Type() QuestionType {
+	if q.questionType == "" {
+		return DefaultQuestionType
+	}
+	return q.questionType
+}
 
 // Answers returns a copy of answers (protect internal state)
 func (q *Question) Answers() []Answer {
@@ -108,7 +284,7 @@ func (q *Question) Answers() []Answer {
 // UserAnswer represents a user's answer to a question (entity within QuizSession aggregate)
 type UserAnswer struct {
 	questionID  QuestionID
-	answerID    AnswerID
+	submission  AnswerSubmission
 	isCorrect   bool
 	basePoints  Points // Base points for correct answer
 	timeBonus   Points // Bonus points for speed
@@ -122,7 +298,7 @@ type UserAnswer struct {
 func NewUserAnswer(questionID QuestionID, answerID AnswerID, isCorrect bool, points Points, answeredAt int64) UserAnswer {
 	return UserAnswer{
 		questionID:  questionID,
-		answerID:    answerID,
+		submission:  NewChoiceSubmission(answerID),
 		isCorrect:   isCorrect,
 		basePoints:  points, // Legacy: store total points as base
 		timeBonus:   Points{},
@@ -136,7 +312,7 @@ func NewUserAnswer(questionID QuestionID, answerID AnswerID, isCorrect bool, poi
 // NewUserAnswerWithBreakdown creates a new UserAnswer with detailed points breakdown
 func NewUserAnswerWithBreakdown(
 	questionID QuestionID,
-	answerID AnswerID,
+	submission AnswerSubmission,
 	isCorrect bool,
 	basePoints, timeBonus, streakBonus Points,
 	timeSpent int64,
@@ -145,7 +321,7 @@ func NewUserAnswerWithBreakdown(
 	totalPoints := basePoints.Add(timeBonus).Add(streakBonus)
 	return UserAnswer{
 		questionID:  questionID,
-		answerID:    answerID,
+		submission:  submission,
 		isCorrect:   isCorrect,
 		basePoints:  basePoints,
 		timeBonus:   timeBonus,
@@ -157,16 +333,17 @@ func NewUserAnswerWithBreakdown(
 }
 
 // Getters
-func (ua UserAnswer) QuestionID() QuestionID { return ua.questionID }
-func (ua UserAnswer) AnswerID() AnswerID     { return ua.answerID }
-func (ua UserAnswer) IsCorrect() bool        { return ua.isCorrect }
-func (ua UserAnswer) Points() Points         { return ua.totalPoints } // Returns total for compatibility
-func (ua UserAnswer) BasePoints() Points     { return ua.basePoints }
-func (ua UserAnswer) TimeBonus() Points      { return ua.timeBonus }
-func (ua UserAnswer) StreakBonus() Points    { return ua.streakBonus }
-func (ua UserAnswer) TotalPoints() Points    { return ua.totalPoints }
-func (ua UserAnswer) TimeSpent() int64       { return ua.timeSpent }
-func (ua UserAnswer) AnsweredAt() int64      { return ua.answeredAt }
+func (ua UserAnswer) QuestionID() QuestionID       { return ua.questionID }
+func (ua UserAnswer) AnswerID() AnswerID           { return ua.submission.PrimaryAnswerID() }
+func (ua UserAnswer) Submission() AnswerSubmission { return ua.submission }
+func (ua UserAnswer) IsCorrect() bool              { return ua.isCorrect }
+func (ua UserAnswer) Points() Points               { return ua.totalPoints } // Returns total for compatibility
+func (ua UserAnswer) BasePoints() Points           { return ua.basePoints }
+func (ua UserAnswer) TimeBonus() Points            { return ua.timeBonus }
+func (ua UserAnswer) StreakBonus() Points          { return ua.streakBonus }
+func (ua UserAnswer) TotalPoints() Points          { return ua.totalPoints }
+func (ua UserAnswer) TimeSpent() int64             { return ua.timeSpent }
+func (ua UserAnswer) AnsweredAt() int64            { return ua.answeredAt }
 
 // QuizSummary represents a lightweight view of a quiz for list displays.
 type QuizSummary struct {
@@ -178,6 +355,7 @@ type QuizSummary struct {
 	passingScore  PassingScore
 	createdAt     int64
 	questionCount int
+	translations  map[string]QuizTranslation
 }
 
 // NewQuizSummary is a constructor for QuizSummary
@@ -226,3 +404,30 @@ func (qs *QuizSummary) CreatedAt() int64 { return qs.createdAt }
 
 // QuestionCount returns the number of questions in the quiz.
 func (qs *QuizSummary) QuestionCount() int { return qs.questionCount }
+
+// SetTranslation sets the quiz's title and description in another locale.
+func (qs *QuizSummary) SetTranslation(locale string, translation QuizTranslation) error {
+	if err := validateTranslationLocale(locale); err != nil {
+		return err
+	}
+	if qs.translations == nil {
+		qs.translations = make(map[string]QuizTranslation)
+	}
+	qs.translations[locale] = translation
+	return nil
+}
+
+// Localized returns the summary with its title and description in the given locale,
+// falling back to DefaultLocale.
+func (qs *QuizSummary) Localized(locale string) *QuizSummary {
+	translation, ok := qs.translations[NormalizeLocale(locale)]
+	if !ok {
+		return qs
+	}
+	localized := *qs
+	localized.title = translation.title
+	if translation.description != "" {
+		localized.description = translation.description
+	}
+	return &localized
+}
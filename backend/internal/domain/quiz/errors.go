@@ -20,10 +20,30 @@ var (
 	ErrInvalidTimeLimit    = errors.New("invalid time limit")
 	ErrTimeLimitTooHigh    = errors.New("time limit too high")
 	ErrInvalidPassingScore = errors.New("invalid passing score")
+	ErrInvalidDifficulty   = errors.New("invalid difficulty")
+	ErrInvalidExplanation  = errors.New("invalid explanation")
+	ErrExplanationTooLong  = errors.New("explanation too long")
+	ErrInvalidSourceURL    = errors.New("invalid explanation source URL")
 	ErrInvalidCategoryName = errors.New("invalid category name")
 	ErrCategoryNameTooLong = errors.New("category name is too long")
 	ErrCategoryNotFound    = errors.New("category not found")
 
+	// Media errors
+	ErrInvalidMedia         = errors.New("media needs exactly one of url or asset key")
+	ErrInvalidMediaURL      = errors.New("invalid media URL")
+	ErrInvalidMediaAssetKey = errors.New("invalid media asset key")
+	ErrInvalidMediaSize     = errors.New("invalid media dimensions")
+	ErrInvalidMediaAltText  = errors.New("media alt text is required (max 200 chars)")
+
+	// Translation errors
+	ErrInvalidLocale = errors.New("invalid translation locale (ISO 639-1 code other than the default)")
+
+	// Question type errors
+	ErrInvalidQuestionType    = errors.New("invalid question type")
+	ErrInvalidNumericAnswer   = errors.New("invalid numeric answer (finite value, non-negative tolerance)")
+	ErrInvalidQuestionAnswers = errors.New("answers do not fit the question type")
+	ErrInvalidSubmission      = errors.New("submission does not fit the question type")
+
 	// Quiz errors
 	ErrQuizNotFound     = errors.New("quiz not found")
 	ErrQuizCannotStart  = errors.New("quiz cannot be started")
@@ -33,6 +53,20 @@ var (
 	ErrTooManyAnswers   = errors.New("too many answers per question")
 	ErrTooManyTags      = errors.New("too many tags (maximum 10 per quiz)")
 
+	// Soft delete errors
+	ErrQuizDeleted         = errors.New("quiz is deleted")
+	ErrQuizNotDeleted      = errors.New("quiz is not deleted")
+	ErrQuestionDeleted     = errors.New("question is deleted")
+	ErrQuestionNotDeleted  = errors.New("question is not deleted")
+	ErrCategoryDeleted     = errors.New("category is deleted")
+	ErrCategoryNotDeleted  = errors.New("category is not deleted")
+	ErrTagDeleted          = errors.New("tag is deleted")
+	ErrTagNotDeleted       = errors.New("tag is not deleted")
+	ErrQuestionWithoutQuiz = errors.New("question does not belong to a quiz")
+
+	// Content audit errors
+	ErrInvalidAuditEntry = errors.New("invalid content audit entry")
+
 	// Session errors
 	ErrSessionNotFound      = errors.New("quiz session not found")
 	ErrSessionAlreadyExists = errors.New("active session already exists")
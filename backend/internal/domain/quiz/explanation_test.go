@@ -0,0 +1,42 @@
+package quiz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewExplanation(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		sourceURL string
+		wantErr   error
+		wantEmpty bool
+	}{
+		{name: "none", wantEmpty: true},
+		{name: "text only", text: "Go has no while keyword; for covers it."},
+		{name: "text and source", text: "Paris is the capital.", sourceURL: "https://en.wikipedia.org/wiki/Paris"},
+		{name: "source without text", sourceURL: "https://example.com", wantErr: ErrInvalidExplanation},
+		{name: "relative source", text: "Because.", sourceURL: "/wiki/Paris", wantErr: ErrInvalidSourceURL},
+		{name: "non-http source", text: "Because.", sourceURL: "javascript:alert(1)", wantErr: ErrInvalidSourceURL},
+		{name: "too long", text: strings.Repeat("a", 1001), wantErr: ErrExplanationTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExplanation(tt.text, tt.sourceURL)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.IsEmpty() != tt.wantEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", got.IsEmpty(), tt.wantEmpty)
+			}
+			if got.Text() != tt.text || got.SourceURL() != tt.sourceURL {
+				t.Errorf("got %q / %q, want %q / %q", got.Text(), got.SourceURL(), tt.text, tt.sourceURL)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package quiz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewMedia(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		assetKey  string
+		width     int
+		height    int
+		alt       string
+		wantErr   error
+		wantEmpty bool
+	}{
+		{name: "none", wantEmpty: true},
+		{name: "external url", url: "https://cdn.example.com/eiffel.jpg", width: 800, height: 600, alt: "Eiffel Tower"},
+		{name: "asset key", assetKey: "flags/fr.png", width: 320, height: 213, alt: "Flag of France"},
+		{name: "url and asset key", url: "https://cdn.example.com/fr.png", assetKey: "flags/fr.png", width: 1, height: 1, alt: "x", wantErr: ErrInvalidMedia},
+		{name: "size without source", width: 320, height: 213, alt: "Flag", wantErr: ErrInvalidMedia},
+		{name: "non-http url", url: "ftp://cdn.example.com/fr.png", width: 1, height: 1, alt: "x", wantErr: ErrInvalidMediaURL},
+		{name: "asset key escapes root", assetKey: "../secrets/fr.png", width: 1, height: 1, alt: "x", wantErr: ErrInvalidMediaAssetKey},
+		{name: "svg asset key", assetKey: "flags/fr.svg", width: 1, height: 1, alt: "x", wantErr: ErrInvalidMediaAssetKey},
+		{name: "asset key not an image", assetKey: "flags/fr.html", width: 1, height: 1, alt: "x", wantErr: ErrInvalidMediaAssetKey},
+		{name: "missing size", assetKey: "flags/fr.png", alt: "Flag of France", wantErr: ErrInvalidMediaSize},
+		{name: "oversized", assetKey: "flags/fr.png", width: MaxMediaDimension + 1, height: 10, alt: "Flag", wantErr: ErrInvalidMediaSize},
+		{name: "missing alt text", assetKey: "flags/fr.png", width: 320, height: 213, wantErr: ErrInvalidMediaAltText},
+		{name: "alt text too long", assetKey: "flags/fr.png", width: 320, height: 213, alt: strings.Repeat("a", 201), wantErr: ErrInvalidMediaAltText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMedia(tt.url, tt.assetKey, tt.width, tt.height, tt.alt)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.IsEmpty() != tt.wantEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", got.IsEmpty(), tt.wantEmpty)
+			}
+			if got.URL() != tt.url || got.AssetKey() != tt.assetKey || got.AltText() != tt.alt {
+				t.Errorf("got %q / %q / %q, want %q / %q / %q", got.URL(), got.AssetKey(), got.AltText(), tt.url, tt.assetKey, tt.alt)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package quiz
 
+import "database/sql"
+
 // QuestionRepository defines the interface for question persistence and querying
 // This is the SINGLE SOURCE of questions for all game modes
 type QuestionRepository interface {
@@ -39,7 +41,10 @@ type QuestionRepository interface {
 	// SaveAll persists multiple questions at once
 	SaveAll(questions []*Question) error
 
-	// Delete removes a question by ID
+	// SaveInTx persists a question within an existing transaction
+	SaveInTx(tx *sql.Tx, question *Question) error
+
+	// Delete soft-deletes a question by ID
 	Delete(id QuestionID) error
 }
 
@@ -61,6 +66,10 @@ type QuestionFilter struct {
 
 	// MaxPoints filters questions with points <= this value
 	MaxPoints *int
+
+	// Types filters by question type (empty = all types)
+	// Used by modes that only play option questions
+	Types []QuestionType
 }
 
 // NewQuestionFilter creates a new empty filter
@@ -88,6 +97,12 @@ func (f QuestionFilter) WithExcludeIDs(ids []QuestionID) QuestionFilter {
 	return f
 }
 
+// WithTypes restricts the question types
+func (f QuestionFilter) WithTypes(types ...QuestionType) QuestionFilter {
+	f.Types = append([]QuestionType(nil), types...)
+	return f
+}
+
 // HasCategoryFilter checks if category filter is set
 func (f QuestionFilter) HasCategoryFilter() bool {
 	return f.CategoryID != nil
@@ -102,3 +117,8 @@ func (f QuestionFilter) HasDifficultyFilter() bool {
 func (f QuestionFilter) HasExcludeFilter() bool {
 	return len(f.ExcludeIDs) > 0
 }
+
+// HasTypeFilter checks if question type filter is set
+func (f QuestionFilter) HasTypeFilter() bool {
+	return len(f.Types) > 0
+}
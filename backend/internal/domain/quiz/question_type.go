@@ -0,0 +1,330 @@
+package quiz
+
+import (
+	"math"
+	"sort"
+)
+
+// QuestionType is a value object for how a question is answered and scored
+type QuestionType string
+
+const (
+	QuestionTypeSingleChoice QuestionType = "single_choice" // pick the one correct option
+	QuestionTypeTrueFalse    QuestionType = "true_false"    // two options, one correct
+	QuestionTypeMultiSelect  QuestionType = "multi_select"  // pick every correct option, partial credit
+	QuestionTypeOrdering     QuestionType = "ordering"      // put the options in order (their positions)
+	QuestionTypeNumeric      QuestionType = "numeric"       // estimate a number, scored by closeness
+)
+
+// DefaultQuestionType is assigned to questions imported without a type
+const DefaultQuestionType = QuestionTypeSingleChoice
+
+// NewQuestionType parses a question type. Empty value means DefaultQuestionType.
+func NewQuestionType(value string) (QuestionType, error) {
+	switch QuestionType(value) {
+	case "":
+		return DefaultQuestionType, nil
+	case QuestionTypeSingleChoice, QuestionTypeTrueFalse, QuestionTypeMultiSelect, QuestionTypeOrdering, QuestionTypeNumeric:
+		return QuestionType(value), nil
+	default:
+		return "", ErrInvalidQuestionType
+	}
+}
+
+func (t QuestionType) String() string {
+	return string(t)
+}
+
+// HasOptions reports whether the question is answered by picking among its answers
+func (t QuestionType) HasOptions() bool {
+	return t != QuestionTypeNumeric
+}
+
+// NumericAnswer is the solution of a numeric question: the exact value, and how far
+// off a guess may be and still earn credit. The zero value means no numeric answer.
+type NumericAnswer struct {
+	value     float64
+	tolerance float64
+	set       bool
+}
+
+// NewNumericAnswer creates a numeric answer. A zero tolerance accepts the exact value only.
+func NewNumericAnswer(value, tolerance float64) (NumericAnswer, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || math.IsNaN(tolerance) || math.IsInf(tolerance, 0) || tolerance < 0 {
+		return NumericAnswer{}, ErrInvalidNumericAnswer
+	}
+	return NumericAnswer{value: value, tolerance: tolerance, set: true}, nil
+}
+
+func (n NumericAnswer) Value() float64     { return n.value }
+func (n NumericAnswer) Tolerance() float64 { return n.tolerance }
+
+func (n NumericAnswer) IsEmpty() bool {
+	return !n.set
+}
+
+// credit is the share of the points a guess earns: all of it for the exact value,
+// falling linearly to none at the tolerance
+func (n NumericAnswer) credit(guess float64) float64 {
+	distance := math.Abs(guess - n.value)
+	if distance == 0 {
+		return 1
+	}
+	if distance >= n.tolerance {
+		return 0
+	}
+	return 1 - distance/n.tolerance
+}
+
+// AnswerSubmission is what a player submitted for a question: the picked options
+// (in order, for an ordering question) or a number. The zero value means no answer
+// (e.g. the player timed out).
+type AnswerSubmission struct {
+	answerIDs []AnswerID
+	number    float64
+	hasNumber bool
+}
+
+// NewChoiceSubmission submits the picked options; for an ordering question, all of them in the player's order
+func NewChoiceSubmission(answerIDs ...AnswerID) AnswerSubmission {
+	ids := make([]AnswerID, len(answerIDs))
+	copy(ids, answerIDs)
+	return AnswerSubmission{answerIDs: ids}
+}
+
+// NewNumericSubmission submits a number for a numeric question
+func NewNumericSubmission(value float64) AnswerSubmission {
+	return AnswerSubmission{number: value, hasNumber: true}
+}
+
+// AnswerIDs returns a copy of the submitted options
+func (s AnswerSubmission) AnswerIDs() []AnswerID {
+	ids := make([]AnswerID, len(s.answerIDs))
+	copy(ids, s.answerIDs)
+	return ids
+}
+
+// Number returns the submitted number, if any
+func (s AnswerSubmission) Number() (float64, bool) {
+	return s.number, s.hasNumber
+}
+
+// PrimaryAnswerID is the first submitted option (zero for a number), kept where a
+// single answer ID is recorded
+func (s AnswerSubmission) PrimaryAnswerID() AnswerID {
+	if len(s.answerIDs) == 0 {
+		return AnswerID{}
+	}
+	return s.answerIDs[0]
+}
+
+// IsSingleChoice reports whether exactly one option was submitted, the only shape
+// single-choice and true/false questions accept
+func (s AnswerSubmission) IsSingleChoice() bool {
+	return len(s.answerIDs) == 1 && !s.hasNumber
+}
+
+func (s AnswerSubmission) IsEmpty() bool {
+	return len(s.answerIDs) == 0 && !s.hasNumber
+}
+
+// Evaluation is how well a submission answers a question
+type Evaluation struct {
+	Credit    float64 // share of the question's points earned, 0 to 1
+	IsCorrect bool    // fully right; for a numeric question, within the tolerance
+}
+
+// Scale returns the share of points the evaluation earns
+func (e Evaluation) Scale(points Points) Points {
+	scaled, _ := NewPoints(int(math.Round(float64(points.Value()) * e.Credit)))
+	return scaled
+}
+
+// Evaluate checks a submission against the question's type and scores it.
+// Single choice and true/false: the one correct option. Multi-select: a share per
+// correct option picked, less one per wrong option. Ordering: a share per option
+// in its place. Numeric: closeness to the value within the tolerance.
+func (q *Question) Evaluate(submission AnswerSubmission) (Evaluation, error) {
+	switch q.questionType {
+	case QuestionTypeNumeric:
+		guess, ok := submission.Number()
+		if !ok || len(submission.answerIDs) > 0 || math.IsNaN(guess) || math.IsInf(guess, 0) {
+			return Evaluation{}, ErrInvalidSubmission
+		}
+		credit := q.numericAnswer.credit(guess)
+		return Evaluation{Credit: credit, IsCorrect: credit > 0}, nil
+
+	case QuestionTypeMultiSelect:
+		if submission.hasNumber || len(submission.answerIDs) == 0 {
+			return Evaluation{}, ErrInvalidSubmission
+		}
+		picked, err := q.pickedAnswers(submission.answerIDs)
+		if err != nil {
+			return Evaluation{}, err
+		}
+		correctCount, hits, misses := 0, 0, 0
+		for _, answer := range q.answers {
+			if answer.isCorrect {
+				correctCount++
+			}
+		}
+		for _, answer := range picked {
+			if answer.isCorrect {
+				hits++
+			} else {
+				misses++
+			}
+		}
+		credit := math.Max(0, float64(hits-misses)/float64(correctCount))
+		return Evaluation{Credit: credit, IsCorrect: hits == correctCount && misses == 0}, nil
+
+	case QuestionTypeOrdering:
+		if submission.hasNumber || len(submission.answerIDs) != len(q.answers) {
+			return Evaluation{}, ErrInvalidSubmission
+		}
+		if _, err := q.pickedAnswers(submission.answerIDs); err != nil {
+			return Evaluation{}, err
+		}
+		inPlace := 0
+		for i, answer := range q.orderedAnswers() {
+			if answer.id.Equals(submission.answerIDs[i]) {
+				inPlace++
+			}
+		}
+		credit := float64(inPlace) / float64(len(q.answers))
+		return Evaluation{Credit: credit, IsCorrect: inPlace == len(q.answers)}, nil
+
+	default: // single choice, true/false
+		if !submission.IsSingleChoice() {
+			return Evaluation{}, ErrInvalidSubmission
+		}
+		answer, err := q.GetAnswer(submission.answerIDs[0])
+		if err != nil {
+			return Evaluation{}, err
+		}
+		if !answer.isCorrect {
+			return Evaluation{}, nil
+		}
+		return Evaluation{Credit: 1, IsCorrect: true}, nil
+	}
+}
+
+// pickedAnswers resolves submitted option IDs; each must belong to the question, once
+func (q *Question) pickedAnswers(answerIDs []AnswerID) ([]*Answer, error) {
+	picked := make([]*Answer, 0, len(answerIDs))
+	seen := make(map[string]bool, len(answerIDs))
+	for _, id := range answerIDs {
+		if seen[id.String()] {
+			return nil, ErrInvalidSubmission
+		}
+		seen[id.String()] = true
+		answer, err := q.GetAnswer(id)
+		if err != nil {
+			return nil, err
+		}
+		picked = append(picked, answer)
+	}
+	return picked, nil
+}
+
+// orderedAnswers returns the answers in their solution order (by position)
+func (q *Question) orderedAnswers() []Answer {
+	ordered := q.Answers()
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].position < ordered[j].position })
+	return ordered
+}
+
+// CorrectAnswerIDs returns the answer key of an option question: the correct options,
+// or for an ordering question every option in the solution order. Nil for a numeric question.
+func (q *Question) CorrectAnswerIDs() []AnswerID {
+	var ids []AnswerID
+	switch q.questionType {
+	case QuestionTypeNumeric:
+		return nil
+	case QuestionTypeOrdering:
+		for _, answer := range q.orderedAnswers() {
+			ids = append(ids, answer.id)
+		}
+	default:
+		for _, answer := range q.answers {
+			if answer.isCorrect {
+				ids = append(ids, answer.id)
+			}
+		}
+	}
+	return ids
+}
+
+// CorrectAnswerID returns the one correct option of a single-choice or true/false
+// question; zero for the other types, see CorrectAnswerIDs
+func (q *Question) CorrectAnswerID() AnswerID {
+	switch q.questionType {
+	case QuestionTypeMultiSelect, QuestionTypeOrdering, QuestionTypeNumeric:
+		return AnswerID{}
+	}
+	for _, answer := range q.answers {
+		if answer.isCorrect {
+			return answer.id
+		}
+	}
+	return AnswerID{}
+}
+
+// CorrectSubmission returns a submission that earns full credit
+func (q *Question) CorrectSubmission() AnswerSubmission {
+	if q.questionType == QuestionTypeNumeric {
+		return NewNumericSubmission(q.numericAnswer.value)
+	}
+	return NewChoiceSubmission(q.CorrectAnswerIDs()...)
+}
+
+// DisplayAnswers returns the answers in the order players see them: as authored,
+// except for an ordering question, whose authored order is the solution, so its
+// options are shown sorted by ID (stable across requests, unrelated to the solution)
+func (q *Question) DisplayAnswers() []Answer {
+	answers := q.Answers()
+	if q.questionType == QuestionTypeOrdering {
+		sort.Slice(answers, func(i, j int) bool { return answers[i].id.String() < answers[j].id.String() })
+	}
+	return answers
+}
+
+// ValidateAnswers checks the question's answers fit its type: a numeric question
+// has a numeric answer and no options, true/false exactly two options with one
+// correct, single choice one correct option, multi-select at least one, and
+// ordering at least two options (correctness flags are not used)
+func (q *Question) ValidateAnswers() error {
+	if q.questionType == QuestionTypeNumeric {
+		if q.numericAnswer.IsEmpty() || len(q.answers) > 0 {
+			return ErrInvalidQuestionAnswers
+		}
+		return nil
+	}
+
+	if len(q.answers) < 2 {
+		return ErrInvalidQuestionAnswers
+	}
+	correctCount := 0
+	for _, answer := range q.answers {
+		if answer.isCorrect {
+			correctCount++
+		}
+	}
+
+	switch q.questionType {
+	case QuestionTypeTrueFalse:
+		if len(q.answers) != 2 || correctCount != 1 {
+			return ErrInvalidQuestionAnswers
+		}
+	case QuestionTypeMultiSelect:
+		if correctCount == 0 {
+			return ErrInvalidQuestionAnswers
+		}
+	case QuestionTypeOrdering:
+	default:
+		if correctCount != 1 {
+			return ErrInvalidQuestionAnswers
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package quiz
+
+import "testing"
+
+func TestNewQuestionType(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    QuestionType
+		wantErr bool
+	}{
+		{value: "", want: DefaultQuestionType},
+		{value: "true_false", want: QuestionTypeTrueFalse},
+		{value: "numeric", want: QuestionTypeNumeric},
+		{value: "multiple_choice", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NewQuestionType(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NewQuestionType(%q) = %q, %v", tt.value, got, err)
+		}
+	}
+}
+
+// newTypedQuestion creates a question of the given type with answers A..D
+// (correct as flagged, and in the solution order for an ordering question)
+func newTypedQuestion(t *testing.T, questionType QuestionType, correct ...bool) (*Question, []AnswerID) {
+	t.Helper()
+
+	text, _ := NewQuestionText("Which of these apply?")
+	points, _ := NewPoints(10)
+	question, err := NewQuestion(NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+	question.SetType(questionType)
+
+	ids := make([]AnswerID, 0, len(correct))
+	for i, isCorrect := range correct {
+		at, _ := NewAnswerText(string(rune('A' + i)))
+		answer, _ := NewAnswer(NewAnswerID(), at, isCorrect, i)
+		if err := question.AddAnswer(*answer); err != nil {
+			t.Fatalf("AddAnswer: %v", err)
+		}
+		ids = append(ids, answer.ID())
+	}
+	return question, ids
+}
+
+func TestQuestion_EvaluateMultiSelect(t *testing.T) {
+	question, ids := newTypedQuestion(t, QuestionTypeMultiSelect, true, true, false, false)
+
+	tests := []struct {
+		name        string
+		picked      []AnswerID
+		wantCredit  float64
+		wantCorrect bool
+	}{
+		{name: "all correct", picked: []AnswerID{ids[1], ids[0]}, wantCredit: 1, wantCorrect: true},
+		{name: "one of two", picked: []AnswerID{ids[0]}, wantCredit: 0.5},
+		{name: "wrong pick cancels a hit", picked: []AnswerID{ids[0], ids[2]}, wantCredit: 0},
+		{name: "never negative", picked: []AnswerID{ids[2], ids[3]}, wantCredit: 0},
+	}
+
+	for _, tt := range tests {
+		got, err := question.Evaluate(NewChoiceSubmission(tt.picked...))
+		if err != nil {
+			t.Fatalf("%s: Evaluate: %v", tt.name, err)
+		}
+		if got.Credit != tt.wantCredit || got.IsCorrect != tt.wantCorrect {
+			t.Errorf("%s: got %+v, want credit %v correct %v", tt.name, got, tt.wantCredit, tt.wantCorrect)
+		}
+	}
+
+	if _, err := question.Evaluate(NewChoiceSubmission(ids[0], ids[0])); err != ErrInvalidSubmission {
+		t.Errorf("duplicate pick error = %v, want ErrInvalidSubmission", err)
+	}
+}
+
+func TestQuestion_EvaluateOrdering(t *testing.T) {
+	question, ids := newTypedQuestion(t, QuestionTypeOrdering, false, false, false, false)
+
+	got, err := question.Evaluate(NewChoiceSubmission(ids...))
+	if err != nil || got.Credit != 1 || !got.IsCorrect {
+		t.Errorf("solution order: got %+v, %v", got, err)
+	}
+
+	// A and B swapped: C and D are in place
+	got, err = question.Evaluate(NewChoiceSubmission(ids[1], ids[0], ids[2], ids[3]))
+	if err != nil || got.Credit != 0.5 || got.IsCorrect {
+		t.Errorf("two swapped: got %+v, %v", got, err)
+	}
+
+	if _, err := question.Evaluate(NewChoiceSubmission(ids[0], ids[1])); err != ErrInvalidSubmission {
+		t.Errorf("partial order error = %v, want ErrInvalidSubmission", err)
+	}
+
+	// The authored order is the solution, so it must not be the displayed one
+	if key := question.CorrectAnswerIDs(); len(key) != 4 || key[0] != ids[0] || key[3] != ids[3] {
+		t.Errorf("CorrectAnswerIDs = %v, want the solution order", key)
+	}
+	if !question.CorrectAnswerID().IsZero() {
+		t.Error("CorrectAnswerID of an ordering question should be zero")
+	}
+}
+
+func TestQuestion_EvaluateNumeric(t *testing.T) {
+	question, _ := newTypedQuestion(t, QuestionTypeNumeric)
+	answer, _ := NewNumericAnswer(100, 20)
+	question.SetNumericAnswer(answer)
+
+	tests := []struct {
+		guess       float64
+		wantCredit  float64
+		wantCorrect bool
+	}{
+		{guess: 100, wantCredit: 1, wantCorrect: true},
+		{guess: 90, wantCredit: 0.5, wantCorrect: true},
+		{guess: 115, wantCredit: 0.25, wantCorrect: true},
+		{guess: 120, wantCredit: 0},
+		{guess: 50, wantCredit: 0},
+	}
+
+	for _, tt := range tests {
+		got, err := question.Evaluate(NewNumericSubmission(tt.guess))
+		if err != nil {
+			t.Fatalf("Evaluate(%v): %v", tt.guess, err)
+		}
+		if got.Credit != tt.wantCredit || got.IsCorrect != tt.wantCorrect {
+			t.Errorf("Evaluate(%v) = %+v, want credit %v correct %v", tt.guess, got, tt.wantCredit, tt.wantCorrect)
+		}
+	}
+
+	if _, err := question.Evaluate(NewChoiceSubmission(NewAnswerID())); err != ErrInvalidSubmission {
+		t.Errorf("option on a numeric question error = %v, want ErrInvalidSubmission", err)
+	}
+	if scaled := (Evaluation{Credit: 0.25}).Scale(mustPoints(t, 10)); scaled.Value() != 3 {
+		t.Errorf("Scale(10) at 0.25 = %d, want 3 (rounded)", scaled.Value())
+	}
+}
+
+func TestQuestion_EvaluateSingleChoiceRejectsSeveralOptions(t *testing.T) {
+	question, ids := newTypedQuestion(t, QuestionTypeSingleChoice, true, false)
+
+	if got, err := question.Evaluate(NewChoiceSubmission(ids[1])); err != nil || got.Credit != 0 || got.IsCorrect {
+		t.Errorf("wrong option: got %+v, %v", got, err)
+	}
+	if _, err := question.Evaluate(NewChoiceSubmission(ids...)); err != ErrInvalidSubmission {
+		t.Errorf("several options error = %v, want ErrInvalidSubmission", err)
+	}
+}
+
+func TestQuestion_ValidateAnswers(t *testing.T) {
+	tests := []struct {
+		name         string
+		questionType QuestionType
+		correct      []bool
+		wantErr      bool
+	}{
+		{name: "single choice", questionType: QuestionTypeSingleChoice, correct: []bool{true, false, false}},
+		{name: "single choice with two correct", questionType: QuestionTypeSingleChoice, correct: []bool{true, true}, wantErr: true},
+		{name: "true/false", questionType: QuestionTypeTrueFalse, correct: []bool{false, true}},
+		{name: "true/false with three options", questionType: QuestionTypeTrueFalse, correct: []bool{true, false, false}, wantErr: true},
+		{name: "multi-select", questionType: QuestionTypeMultiSelect, correct: []bool{true, true, false}},
+		{name: "multi-select with none correct", questionType: QuestionTypeMultiSelect, correct: []bool{false, false}, wantErr: true},
+		{name: "ordering", questionType: QuestionTypeOrdering, correct: []bool{false, false, false}},
+		{name: "ordering with one option", questionType: QuestionTypeOrdering, correct: []bool{false}, wantErr: true},
+		{name: "numeric without answer", questionType: QuestionTypeNumeric, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		question, _ := newTypedQuestion(t, tt.questionType, tt.correct...)
+		if err := question.ValidateAnswers(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateAnswers() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	question, _ := newTypedQuestion(t, QuestionTypeNumeric)
+	answer, _ := NewNumericAnswer(42, 0)
+	question.SetNumericAnswer(answer)
+	if err := question.ValidateAnswers(); err != nil {
+		t.Errorf("numeric: ValidateAnswers() error = %v", err)
+	}
+}
+
+func mustPoints(t *testing.T, value int) Points {
+	t.Helper()
+	points, err := NewPoints(value)
+	if err != nil {
+		t.Fatalf("NewPoints(%d): %v", value, err)
+	}
+	return points
+}
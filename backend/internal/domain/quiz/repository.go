@@ -1,6 +1,8 @@
 package quiz
 
 import (
+	"database/sql"
+
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 )
 
@@ -8,7 +10,8 @@ import (
 // NOTE: No context.Context - domain layer is pure
 // Infrastructure implementations add context internally
 type QuizRepository interface {
-	// FindByID retrieves a quiz by its ID
+	// FindByID retrieves a quiz by its ID, including a soft-deleted one
+	// (games that played it still load it); the lists below skip deleted quizzes
 	FindByID(id QuizID) (*Quiz, error)
 
 	// FindAll retrieves all quizzes
@@ -23,7 +26,10 @@ type QuizRepository interface {
 	// Save persists a quiz (create or update)
 	Save(quiz *Quiz) error
 
-	// Delete removes a quiz by ID
+	// SaveInTx persists a quiz within an existing transaction
+	SaveInTx(tx *sql.Tx, quiz *Quiz) error
+
+	// Delete soft-deletes a quiz by ID
 	Delete(id QuizID) error
 }
 
@@ -45,6 +51,9 @@ type SessionRepository interface {
 	// Save persists a session (create or update)
 	Save(session *QuizSession) error
 
+	// SaveInTx persists a session within an existing transaction
+	SaveInTx(tx *sql.Tx, session *QuizSession) error
+
 	// Delete removes a session by ID
 	Delete(id SessionID) error
 }
@@ -143,6 +152,7 @@ type CategoryRepository interface {
 	FindByID(id CategoryID) (*Category, error)
 	FindAll() ([]*Category, error)
 	Save(category *Category) error
+	SaveInTx(tx *sql.Tx, category *Category) error
 	Delete(id CategoryID) error
 }
 
@@ -151,10 +161,13 @@ type TagRepository interface {
 	// Save persists a tag (create or update)
 	Save(tag *Tag) error
 
+	// SaveInTx persists a tag within an existing transaction
+	SaveInTx(tx *sql.Tx, tag *Tag) error
+
 	// SaveAll persists multiple tags at once
 	SaveAll(tags []*Tag) error
 
-	// FindByName retrieves a tag by its name
+	// FindByName retrieves a tag by its name, including a soft-deleted one
 	FindByName(name string) (*Tag, error)
 
 	// FindByNames retrieves multiple tags by their names
@@ -174,4 +187,13 @@ type TagRepository interface {
 
 	// FindQuizzesByTag retrieves quizzes that have a specific tag
 	FindQuizzesByTag(tagName string, limit, offset int) ([]*QuizSummary, error)
+
+	// FindByID retrieves a tag by its ID, including a soft-deleted one
+	FindByID(id TagID) (*Tag, error)
+
+	// Update persists a renamed, deleted or restored tag
+	Update(tag *Tag) error
+
+	// UpdateInTx persists a renamed, deleted or restored tag within an existing transaction
+	UpdateInTx(tx *sql.Tx, tag *Tag) error
 }
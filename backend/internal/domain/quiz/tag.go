@@ -10,8 +10,9 @@ import (
 // Tags follow the format: {category}:{value}
 // Examples: language:go, difficulty:easy, topic:concurrency
 type Tag struct {
-	id   TagID
-	name TagName
+	id        TagID
+	name      TagName
+	deletedAt int64 // soft delete (Unix timestamp, 0 = live)
 }
 
 // Value Objects
@@ -55,6 +56,8 @@ var (
 	ErrTagNameHasSpaces    = errors.New("tag name cannot contain spaces (use hyphens instead)")
 	ErrTagNameHasUppercase = errors.New("tag name must be lowercase")
 	ErrTagMissingColon     = errors.New("tag name must contain a colon separator (:)")
+	ErrTagNotFound         = errors.New("tag not found")
+	ErrTagAlreadyExists    = errors.New("tag already exists")
 )
 
 // Constructors
@@ -92,6 +95,14 @@ func NewTagID(tagName string) TagID {
 	return TagID{value: id}
 }
 
+// NewTagIDFromString wraps an existing tag ID (e.g. from a URL)
+func NewTagIDFromString(value string) (TagID, error) {
+	if strings.TrimSpace(value) == "" {
+		return TagID{}, ErrTagNotFound
+	}
+	return TagID{value: value}, nil
+}
+
 // NewTagName creates a TagName with validation
 func NewTagName(value string) (TagName, error) {
 	// 1. Empty check
@@ -134,6 +145,39 @@ func NewTagName(value string) (TagName, error) {
 	return TagName{value: value}, nil
 }
 
+// Rename changes the tag's name; its ID stays, so quizzes keep the tag
+func (t *Tag) Rename(name TagName) {
+	t.name = name
+}
+
+// Delete soft-deletes the tag: quizzes no longer show it, and Restore brings it back
+func (t *Tag) Delete(deletedAt int64) error {
+	if t.IsDeleted() {
+		return ErrTagDeleted
+	}
+	t.deletedAt = deletedAt
+	return nil
+}
+
+// Restore undoes Delete
+func (t *Tag) Restore() error {
+	if !t.IsDeleted() {
+		return ErrTagNotDeleted
+	}
+	t.deletedAt = 0
+	return nil
+}
+
+// IsDeleted reports whether the tag is soft-deleted
+func (t *Tag) IsDeleted() bool {
+	return t.deletedAt != 0
+}
+
+// DeletedAt returns when the tag was soft-deleted (0 = live)
+func (t *Tag) DeletedAt() int64 {
+	return t.deletedAt
+}
+
 // Getters
 
 // ID returns the tag ID
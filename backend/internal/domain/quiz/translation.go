@@ -0,0 +1,76 @@
+package quiz
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultLocale is the language catalog content is written in. Translations into
+// other locales are optional: anything untranslated is served in the default text.
+const DefaultLocale = "en"
+
+// localePattern matches the ISO 639-1 codes users.language_code holds
+var localePattern = regexp.MustCompile(`^[a-z]{2}$`)
+
+// NormalizeLocale reduces a language tag ("ru", "pt-BR") to the ISO 639-1 code
+// translations are keyed by. Empty or unrecognized tags mean DefaultLocale.
+func NormalizeLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i > 0 {
+		tag = tag[:i]
+	}
+	if !localePattern.MatchString(tag) {
+		return DefaultLocale
+	}
+	return tag
+}
+
+// validateTranslationLocale checks a translation key: an ISO 639-1 code other than
+// DefaultLocale (the default text lives on the entity itself)
+func validateTranslationLocale(locale string) error {
+	if locale == DefaultLocale || !localePattern.MatchString(locale) {
+		return ErrInvalidLocale
+	}
+	return nil
+}
+
+// QuestionTranslation is a question's text, and optionally its explanation, in one locale.
+// The explanation's source link is shared by all locales.
+type QuestionTranslation struct {
+	text        QuestionText
+	explanation string
+}
+
+// NewQuestionTranslation validates a translated question text and explanation
+func NewQuestionTranslation(text, explanation string) (QuestionTranslation, error) {
+	questionText, err := NewQuestionText(strings.TrimSpace(text))
+	if err != nil {
+		return QuestionTranslation{}, err
+	}
+	explanation = strings.TrimSpace(explanation)
+	if len(explanation) > 1000 {
+		return QuestionTranslation{}, ErrExplanationTooLong
+	}
+	return QuestionTranslation{text: questionText, explanation: explanation}, nil
+}
+
+func (t QuestionTranslation) Text() QuestionText  { return t.text }
+func (t QuestionTranslation) Explanation() string { return t.explanation }
+
+// QuizTranslation is a quiz's title and description in one locale
+type QuizTranslation struct {
+	title       QuizTitle
+	description string
+}
+
+// NewQuizTranslation validates a translated quiz title and description
+func NewQuizTranslation(title, description string) (QuizTranslation, error) {
+	quizTitle, err := NewQuizTitle(strings.TrimSpace(title))
+	if err != nil {
+		return QuizTranslation{}, err
+	}
+	return QuizTranslation{title: quizTitle, description: strings.TrimSpace(description)}, nil
+}
+
+func (t QuizTranslation) Title() QuizTitle    { return t.title }
+func (t QuizTranslation) Description() string { return t.description }
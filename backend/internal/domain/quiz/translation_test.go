@@ -0,0 +1,103 @@
+package quiz
+
+import "testing"
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "", want: DefaultLocale},
+		{tag: "ru", want: "ru"},
+		{tag: "RU", want: "ru"},
+		{tag: "pt-BR", want: "pt"},
+		{tag: "zh_Hans", want: "zh"},
+		{tag: "english", want: DefaultLocale},
+		{tag: "*", want: DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeLocale(tt.tag); got != tt.want {
+			t.Errorf("NormalizeLocale(%q) = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func newTranslatedQuestion(t *testing.T) *Question {
+	t.Helper()
+
+	text, _ := NewQuestionText("What is the capital of France?")
+	points, _ := NewPoints(10)
+	question, err := NewQuestion(NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+	explanation, _ := NewExplanation("Paris has been the capital since 987.", "https://en.wikipedia.org/wiki/Paris")
+	question.SetExplanation(explanation)
+
+	for i, answerText := range []string{"Paris", "Lyon"} {
+		at, _ := NewAnswerText(answerText)
+		answer, _ := NewAnswer(NewAnswerID(), at, i == 0, i)
+		if i == 0 {
+			translated, _ := NewAnswerText("Париж")
+			if err := answer.SetTranslation("ru", translated); err != nil {
+				t.Fatalf("answer SetTranslation: %v", err)
+			}
+		}
+		if err := question.AddAnswer(*answer); err != nil {
+			t.Fatalf("AddAnswer: %v", err)
+		}
+	}
+
+	translation, err := NewQuestionTranslation("Какая столица у Франции?", "Париж — столица с 987 года.")
+	if err != nil {
+		t.Fatalf("NewQuestionTranslation: %v", err)
+	}
+	if err := question.SetTranslation("ru", translation); err != nil {
+		t.Fatalf("SetTranslation: %v", err)
+	}
+	return question
+}
+
+func TestQuestion_Localized(t *testing.T) {
+	question := newTranslatedQuestion(t)
+
+	ru := question.Localized("ru-RU")
+	if ru.Text().String() != "Какая столица у Франции?" {
+		t.Errorf("ru text = %q", ru.Text().String())
+	}
+	if ru.Explanation().Text() != "Париж — столица с 987 года." || ru.Explanation().SourceURL() != "https://en.wikipedia.org/wiki/Paris" {
+		t.Errorf("ru explanation = %q / %q", ru.Explanation().Text(), ru.Explanation().SourceURL())
+	}
+	answers := ru.Answers()
+	if answers[0].Text().String() != "Париж" || answers[1].Text().String() != "Lyon" {
+		t.Errorf("ru answers = %q, %q; want the translation and the default text", answers[0].Text().String(), answers[1].Text().String())
+	}
+	if answers[0].ID() != question.Answers()[0].ID() || !answers[0].IsCorrect() {
+		t.Error("localized answers must keep their IDs and correctness")
+	}
+
+	// The original is untouched
+	if question.Text().String() != "What is the capital of France?" || question.Answers()[0].Text().String() != "Paris" {
+		t.Error("Localized modified the original question")
+	}
+
+	// Untranslated locales fall back to the default text
+	if de := question.Localized("de"); de.Text().String() != "What is the capital of France?" || de.Answers()[0].Text().String() != "Paris" {
+		t.Errorf("de text = %q, want the default", de.Text().String())
+	}
+	if question.Localized("") != question {
+		t.Error("Localized(default) should return the question itself")
+	}
+}
+
+func TestQuestion_SetTranslationRejectsInvalidLocale(t *testing.T) {
+	question := newTranslatedQuestion(t)
+	translation, _ := NewQuestionTranslation("Quelle est la capitale de la France ?", "")
+
+	for _, locale := range []string{DefaultLocale, "", "FR", "fra", "fr-FR"} {
+		if err := question.SetTranslation(locale, translation); err != ErrInvalidLocale {
+			t.Errorf("SetTranslation(%q) error = %v, want ErrInvalidLocale", locale, err)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package quiz
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
+
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 )
 
@@ -216,6 +220,132 @@ func (p Points) IsZero() bool {
 	return p.value == 0
 }
 
+// Difficulty is a value object for question difficulty level
+type Difficulty string
+
+const (
+	DifficultyEasy   Difficulty = "easy"
+	DifficultyMedium Difficulty = "medium"
+	DifficultyHard   Difficulty = "hard"
+)
+
+// DefaultDifficulty is assigned to questions imported without a difficulty
+const DefaultDifficulty = DifficultyMedium
+
+// NewDifficulty parses a difficulty level. Empty value means DefaultDifficulty.
+func NewDifficulty(value string) (Difficulty, error) {
+	switch Difficulty(value) {
+	case "":
+		return DefaultDifficulty, nil
+	case DifficultyEasy, DifficultyMedium, DifficultyHard:
+		return Difficulty(value), nil
+	default:
+		return "", ErrInvalidDifficulty
+	}
+}
+
+func (d Difficulty) String() string {
+	return string(d)
+}
+
+// Explanation is a value object for the rationale shown after a question is answered.
+// The zero value means the question has no explanation.
+type Explanation struct {
+	text      string
+	sourceURL string
+}
+
+// NewExplanation creates an explanation. Empty text and source mean no explanation;
+// a source needs text to go with it and must be an absolute http(s) URL.
+func NewExplanation(text, sourceURL string) (Explanation, error) {
+	text = strings.TrimSpace(text)
+	sourceURL = strings.TrimSpace(sourceURL)
+	if text == "" && sourceURL == "" {
+		return Explanation{}, nil
+	}
+	if text == "" {
+		return Explanation{}, ErrInvalidExplanation
+	}
+	if len(text) > 1000 {
+		return Explanation{}, ErrExplanationTooLong
+	}
+	if sourceURL != "" && !isWebURL(sourceURL) {
+		return Explanation{}, ErrInvalidSourceURL
+	}
+	return Explanation{text: text, sourceURL: sourceURL}, nil
+}
+
+func (e Explanation) Text() string      { return e.text }
+func (e Explanation) SourceURL() string { return e.sourceURL }
+
+func (e Explanation) IsEmpty() bool {
+	return e.text == ""
+}
+
+// Media is a value object for an image attached to a question or an answer:
+// either an external URL or the key of an asset served by the API.
+// The zero value means no media.
+type Media struct {
+	url      string
+	assetKey string
+	width    int
+	height   int
+	altText  string
+}
+
+// MaxMediaDimension is the largest width or height accepted for media, in pixels
+const MaxMediaDimension = 8192
+
+// assetKeyPattern matches relative asset paths such as "flags/fr.png". Only raster
+// formats: media is served from the API origin, where an SVG could run script.
+var assetKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(/[A-Za-z0-9_-]+)*\.(png|jpg|jpeg|webp|gif)$`)
+
+// NewMedia creates a media reference. Exactly one of url and assetKey is set;
+// dimensions and alt text are required so clients can lay the image out before it loads.
+func NewMedia(url, assetKey string, width, height int, altText string) (Media, error) {
+	url = strings.TrimSpace(url)
+	assetKey = strings.TrimSpace(assetKey)
+	altText = strings.TrimSpace(altText)
+	if url == "" && assetKey == "" && width == 0 && height == 0 && altText == "" {
+		return Media{}, nil
+	}
+	if (url == "") == (assetKey == "") {
+		return Media{}, ErrInvalidMedia
+	}
+	if url != "" && !isWebURL(url) {
+		return Media{}, ErrInvalidMediaURL
+	}
+	if assetKey != "" && (len(assetKey) > 255 || !assetKeyPattern.MatchString(assetKey)) {
+		return Media{}, ErrInvalidMediaAssetKey
+	}
+	if width <= 0 || height <= 0 || width > MaxMediaDimension || height > MaxMediaDimension {
+		return Media{}, ErrInvalidMediaSize
+	}
+	if altText == "" || len(altText) > 200 {
+		return Media{}, ErrInvalidMediaAltText
+	}
+	return Media{url: url, assetKey: assetKey, width: width, height: height, altText: altText}, nil
+}
+
+func (m Media) URL() string      { return m.url }
+func (m Media) AssetKey() string { return m.assetKey }
+func (m Media) Width() int       { return m.width }
+func (m Media) Height() int      { return m.height }
+func (m Media) AltText() string  { return m.altText }
+
+func (m Media) IsEmpty() bool {
+	return m.url == "" && m.assetKey == ""
+}
+
+// isWebURL reports whether value is an absolute http(s) URL of reasonable length
+func isWebURL(value string) bool {
+	if len(value) > 2048 {
+		return false
+	}
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 // TimeLimit is a value object for time limit in seconds
 type TimeLimit struct {
 	seconds int
@@ -0,0 +1,25 @@
+package shop
+
+import "errors"
+
+// Domain errors for the coin shop
+var (
+	// Catalog errors
+	ErrInvalidItemID       = errors.New("invalid shop item ID")
+	ErrInvalidItemTitle    = errors.New("shop item title cannot be empty")
+	ErrInvalidItemPrice    = errors.New("shop item price must be positive")
+	ErrEmptyItemContents   = errors.New("shop item must contain at least one resource")
+	ErrInvalidItemAmount   = errors.New("shop item resource amounts must be positive")
+	ErrInvalidLimitWindow  = errors.New("invalid purchase limit window")
+	ErrInvalidLimit        = errors.New("purchase limit cannot be negative")
+	ErrInvalidAvailability = errors.New("shop item availability ends before it starts")
+	ErrItemNotFound        = errors.New("shop item not found")
+
+	// Purchase errors
+	ErrItemNotAvailable      = errors.New("shop item is not available")
+	ErrPurchaseLimitReached  = errors.New("purchase limit reached for this item")
+	ErrInsufficientCoins     = errors.New("not enough coins")
+	ErrInvalidPurchaseID     = errors.New("invalid purchase ID")
+	ErrPurchaseNotRecordable = errors.New("purchase needs a player and an item")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was already used for another item")
+)
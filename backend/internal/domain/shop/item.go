@@ -0,0 +1,171 @@
+package shop
+
+// Item is a catalog entry: a bundle of inventory resources sold for coins.
+// The catalog is data: items, prices, limits and offer windows live in storage.
+type Item struct {
+	id             ItemID
+	title          string
+	description    string
+	contents       map[string]int // inventory resource -> amount
+	priceCoins     int
+	purchaseLimit  int // per player and limit window; 0 = unlimited
+	limitWindow    LimitWindow
+	availableFrom  int64 // Unix seconds; 0 = always
+	availableUntil int64 // Unix seconds; 0 = never ends
+	active         bool
+	sortOrder      int
+}
+
+func NewItem(
+	id ItemID,
+	title string,
+	description string,
+	contents map[string]int,
+	priceCoins int,
+	purchaseLimit int,
+	limitWindow LimitWindow,
+	availableFrom int64,
+	availableUntil int64,
+	sortOrder int,
+) (*Item, error) {
+	if id.IsZero() {
+		return nil, ErrInvalidItemID
+	}
+	if title == "" {
+		return nil, ErrInvalidItemTitle
+	}
+	if priceCoins <= 0 {
+		return nil, ErrInvalidItemPrice
+	}
+	if len(contents) == 0 {
+		return nil, ErrEmptyItemContents
+	}
+	for _, amount := range contents {
+		if amount <= 0 {
+			return nil, ErrInvalidItemAmount
+		}
+	}
+	if purchaseLimit < 0 {
+		return nil, ErrInvalidLimit
+	}
+	if !limitWindow.IsValid() {
+		return nil, ErrInvalidLimitWindow
+	}
+	if availableUntil != 0 && availableUntil <= availableFrom {
+		return nil, ErrInvalidAvailability
+	}
+
+	return &Item{
+		id:             id,
+		title:          title,
+		description:    description,
+		contents:       copyContents(contents),
+		priceCoins:     priceCoins,
+		purchaseLimit:  purchaseLimit,
+		limitWindow:    limitWindow,
+		availableFrom:  availableFrom,
+		availableUntil: availableUntil,
+		active:         true,
+		sortOrder:      sortOrder,
+	}, nil
+}
+
+func ReconstructItem(
+	id ItemID,
+	title string,
+	description string,
+	contents map[string]int,
+	priceCoins int,
+	purchaseLimit int,
+	limitWindow LimitWindow,
+	availableFrom int64,
+	availableUntil int64,
+	active bool,
+	sortOrder int,
+) *Item {
+	return &Item{
+		id:             id,
+		title:          title,
+		description:    description,
+		contents:       contents,
+		priceCoins:     priceCoins,
+		purchaseLimit:  purchaseLimit,
+		limitWindow:    limitWindow,
+		availableFrom:  availableFrom,
+		availableUntil: availableUntil,
+		active:         active,
+		sortOrder:      sortOrder,
+	}
+}
+
+// IsAvailable reports whether the item can be bought at the given time
+func (i *Item) IsAvailable(now int64) bool {
+	if !i.active {
+		return false
+	}
+	if i.availableFrom != 0 && now < i.availableFrom {
+		return false
+	}
+	if i.availableUntil != 0 && now >= i.availableUntil {
+		return false
+	}
+	return true
+}
+
+// IsLimitedTimeOffer reports whether the item is only sold until a given time
+func (i *Item) IsLimitedTimeOffer() bool {
+	return i.availableUntil != 0
+}
+
+// HasPurchaseLimit reports whether a player can buy the item only a limited number of times
+func (i *Item) HasPurchaseLimit() bool {
+	return i.purchaseLimit > 0
+}
+
+// RemainingPurchases returns how many more times a player who already bought the item
+// purchasedInWindow times (in the current limit window) may buy it; -1 if unlimited
+func (i *Item) RemainingPurchases(purchasedInWindow int) int {
+	if !i.HasPurchaseLimit() {
+		return -1
+	}
+	if purchasedInWindow >= i.purchaseLimit {
+		return 0
+	}
+	return i.purchaseLimit - purchasedInWindow
+}
+
+// CanBePurchased checks availability and the purchase limit
+func (i *Item) CanBePurchased(purchasedInWindow int, now int64) error {
+	if !i.IsAvailable(now) {
+		return ErrItemNotAvailable
+	}
+	if i.RemainingPurchases(purchasedInWindow) == 0 {
+		return ErrPurchaseLimitReached
+	}
+	return nil
+}
+
+// LimitWindowStart returns when the current purchase limit window began
+func (i *Item) LimitWindowStart(now int64) int64 {
+	return i.limitWindow.Start(now)
+}
+
+func (i *Item) ID() ItemID               { return i.id }
+func (i *Item) Title() string            { return i.title }
+func (i *Item) Description() string      { return i.description }
+func (i *Item) PriceCoins() int          { return i.priceCoins }
+func (i *Item) PurchaseLimit() int       { return i.purchaseLimit }
+func (i *Item) LimitWindow() LimitWindow { return i.limitWindow }
+func (i *Item) AvailableFrom() int64     { return i.availableFrom }
+func (i *Item) AvailableUntil() int64    { return i.availableUntil }
+func (i *Item) IsActive() bool           { return i.active }
+func (i *Item) SortOrder() int           { return i.sortOrder }
+func (i *Item) Contents() map[string]int { return copyContents(i.contents) }
+
+func copyContents(contents map[string]int) map[string]int {
+	copied := make(map[string]int, len(contents))
+	for resource, amount := range contents {
+		copied[resource] = amount
+	}
+	return copied
+}
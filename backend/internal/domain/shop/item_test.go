@@ -0,0 +1,99 @@
+package shop
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestItem(t *testing.T, limit int, window LimitWindow, from, until int64) *Item {
+	t.Helper()
+	id, _ := NewItemID("test_item")
+	item, err := NewItem(id, "Test", "", map[string]int{"shield": 1}, 100, limit, window, from, until, 0)
+	if err != nil {
+		t.Fatalf("NewItem: %v", err)
+	}
+	return item
+}
+
+func TestNewItem_Validation(t *testing.T) {
+	id, _ := NewItemID("shield_pack")
+
+	tests := []struct {
+		name     string
+		contents map[string]int
+		price    int
+		limit    int
+		window   LimitWindow
+		until    int64
+		wantErr  error
+	}{
+		{"valid", map[string]int{"shield": 3}, 100, 0, LimitWindowLifetime, 0, nil},
+		{"free item", map[string]int{"shield": 3}, 0, 0, LimitWindowLifetime, 0, ErrInvalidItemPrice},
+		{"empty bundle", map[string]int{}, 100, 0, LimitWindowLifetime, 0, ErrEmptyItemContents},
+		{"zero amount", map[string]int{"shield": 0}, 100, 0, LimitWindowLifetime, 0, ErrInvalidItemAmount},
+		{"negative limit", map[string]int{"shield": 3}, 100, -1, LimitWindowLifetime, 0, ErrInvalidLimit},
+		{"unknown window", map[string]int{"shield": 3}, 100, 1, "monthly", 0, ErrInvalidLimitWindow},
+		{"ends before start", map[string]int{"shield": 3}, 100, 0, LimitWindowLifetime, 500, ErrInvalidAvailability},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewItem(id, "Shields", "", tt.contents, tt.price, tt.limit, tt.window, 1000, tt.until, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestItem_IsAvailable(t *testing.T) {
+	offer := newTestItem(t, 0, LimitWindowLifetime, 1000, 2000)
+
+	for _, tc := range []struct {
+		now  int64
+		want bool
+	}{
+		{999, false},
+		{1000, true},
+		{1999, true},
+		{2000, false},
+	} {
+		if got := offer.IsAvailable(tc.now); got != tc.want {
+			t.Errorf("IsAvailable(%d) = %v, want %v", tc.now, got, tc.want)
+		}
+	}
+
+	inactive := ReconstructItem(offer.ID(), "Test", "", offer.Contents(), 100, 0, LimitWindowLifetime, 0, 0, false, 0)
+	if inactive.IsAvailable(1500) {
+		t.Error("inactive item must not be available")
+	}
+}
+
+func TestItem_CanBePurchased(t *testing.T) {
+	limited := newTestItem(t, 2, LimitWindowDaily, 0, 0)
+
+	if err := limited.CanBePurchased(1, 0); err != nil {
+		t.Errorf("second purchase err = %v", err)
+	}
+	if err := limited.CanBePurchased(2, 0); !errors.Is(err, ErrPurchaseLimitReached) {
+		t.Errorf("third purchase err = %v, want ErrPurchaseLimitReached", err)
+	}
+	if remaining := newTestItem(t, 0, LimitWindowLifetime, 0, 0).RemainingPurchases(100); remaining != -1 {
+		t.Errorf("unlimited remaining = %d, want -1", remaining)
+	}
+}
+
+func TestLimitWindow_Start(t *testing.T) {
+	// Wednesday 2026-01-28 15:04 UTC
+	now := time.Date(2026, 1, 28, 15, 4, 0, 0, time.UTC).Unix()
+
+	if got, want := LimitWindowDaily.Start(now), time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC).Unix(); got != want {
+		t.Errorf("daily start = %d, want %d", got, want)
+	}
+	if got, want := LimitWindowWeekly.Start(now), time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC).Unix(); got != want {
+		t.Errorf("weekly start = %d, want %d (Monday)", got, want)
+	}
+	if got := LimitWindowLifetime.Start(now); got != 0 {
+		t.Errorf("lifetime start = %d, want 0", got)
+	}
+}
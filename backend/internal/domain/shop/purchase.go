@@ -0,0 +1,60 @@
+package shop
+
+// Purchase records that a player bought a catalog item
+type Purchase struct {
+	id             string
+	playerID       UserID
+	itemID         ItemID
+	priceCoins     int
+	contents       map[string]int
+	idempotencyKey string
+	createdAt      int64
+}
+
+// NewPurchase records a purchase of the item at its current price and contents
+func NewPurchase(id string, playerID UserID, item *Item, idempotencyKey string, createdAt int64) (*Purchase, error) {
+	if id == "" {
+		return nil, ErrInvalidPurchaseID
+	}
+	if playerID.IsZero() || item == nil {
+		return nil, ErrPurchaseNotRecordable
+	}
+
+	return &Purchase{
+		id:             id,
+		playerID:       playerID,
+		itemID:         item.ID(),
+		priceCoins:     item.PriceCoins(),
+		contents:       item.Contents(),
+		idempotencyKey: idempotencyKey,
+		createdAt:      createdAt,
+	}, nil
+}
+
+func ReconstructPurchase(
+	id string,
+	playerID UserID,
+	itemID ItemID,
+	priceCoins int,
+	contents map[string]int,
+	idempotencyKey string,
+	createdAt int64,
+) *Purchase {
+	return &Purchase{
+		id:             id,
+		playerID:       playerID,
+		itemID:         itemID,
+		priceCoins:     priceCoins,
+		contents:       contents,
+		idempotencyKey: idempotencyKey,
+		createdAt:      createdAt,
+	}
+}
+
+func (p *Purchase) ID() string               { return p.id }
+func (p *Purchase) PlayerID() UserID         { return p.playerID }
+func (p *Purchase) ItemID() ItemID           { return p.itemID }
+func (p *Purchase) PriceCoins() int          { return p.priceCoins }
+func (p *Purchase) Contents() map[string]int { return copyContents(p.contents) }
+func (p *Purchase) IdempotencyKey() string   { return p.idempotencyKey }
+func (p *Purchase) CreatedAt() int64         { return p.createdAt }
@@ -0,0 +1,30 @@
+package shop
+
+import "database/sql"
+
+// CatalogRepository defines the interface for the shop catalog
+type CatalogRepository interface {
+	// FindAll retrieves every catalog item, active or not, ordered for display
+	FindAll() ([]*Item, error)
+
+	// FindByID retrieves a catalog item
+	// Returns ErrItemNotFound if it does not exist
+	FindByID(id ItemID) (*Item, error)
+}
+
+// PurchaseRepository defines the interface for purchase persistence
+type PurchaseRepository interface {
+	// SaveInTx persists a purchase within an existing transaction
+	SaveInTx(tx *sql.Tx, purchase *Purchase) error
+
+	// CountByPlayerSinceInTx returns how many times the player bought each item since the given time.
+	// Runs in the purchase transaction, after the player's inventory is locked.
+	CountByPlayerSinceInTx(tx *sql.Tx, playerID UserID, itemID ItemID, since int64) (int, error)
+
+	// CountByPlayerSince returns, per item, how many times the player bought it since each item's
+	// limit window began (windowStarts maps item ID -> window start)
+	CountByPlayerSince(playerID UserID, windowStarts map[string]int64) (map[string]int, error)
+
+	// FindByIdempotencyKey retrieves the player's purchase made with the key, or nil
+	FindByIdempotencyKey(playerID UserID, key string) (*Purchase, error)
+}
@@ -0,0 +1,75 @@
+package shop
+
+import (
+	"strings"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// Type aliases from other domains
+type UserID = shared.UserID
+
+// ItemID identifies a catalog item by a stable slug (e.g. "shield_pack_3")
+type ItemID struct {
+	value string
+}
+
+const maxItemIDLength = 64
+
+func NewItemID(value string) (ItemID, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || len(value) > maxItemIDLength {
+		return ItemID{}, ErrInvalidItemID
+	}
+	return ItemID{value: value}, nil
+}
+
+func (id ItemID) String() string {
+	return id.value
+}
+
+func (id ItemID) IsZero() bool {
+	return id.value == ""
+}
+
+func (id ItemID) Equals(other ItemID) bool {
+	return id.value == other.value
+}
+
+// LimitWindow is the period a per-player purchase limit applies to
+type LimitWindow string
+
+const (
+	LimitWindowLifetime LimitWindow = "lifetime" // limit counts every purchase ever made
+	LimitWindowDaily    LimitWindow = "daily"    // resets at 00:00 UTC
+	LimitWindowWeekly   LimitWindow = "weekly"   // resets on Monday 00:00 UTC
+)
+
+func (w LimitWindow) IsValid() bool {
+	switch w {
+	case LimitWindowLifetime, LimitWindowDaily, LimitWindowWeekly:
+		return true
+	}
+	return false
+}
+
+func (w LimitWindow) String() string {
+	return string(w)
+}
+
+// Start returns when the window containing now began (Unix seconds); 0 for lifetime
+func (w LimitWindow) Start(now int64) int64 {
+	t := time.Unix(now, 0).UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch w {
+	case LimitWindowDaily:
+		return day.Unix()
+	case LimitWindowWeekly:
+		daysSinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -daysSinceMonday).Unix()
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,29 @@
+package social
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+
+// Block stops a player from interacting with another: neither can send the other a
+// friend request, and blocking ends any friendship or request between them
+type Block struct {
+	blockerID UserID
+	blockedID UserID
+	createdAt int64
+}
+
+func NewBlock(blockerID UserID, blockedID UserID, createdAt int64) (*Block, error) {
+	if blockerID.IsZero() || blockedID.IsZero() {
+		return nil, shared.ErrInvalidUserID
+	}
+	if blockerID.Equals(blockedID) {
+		return nil, ErrCannotBlockSelf
+	}
+	return &Block{blockerID: blockerID, blockedID: blockedID, createdAt: createdAt}, nil
+}
+
+func ReconstructBlock(blockerID UserID, blockedID UserID, createdAt int64) *Block {
+	return &Block{blockerID: blockerID, blockedID: blockedID, createdAt: createdAt}
+}
+
+func (b *Block) BlockerID() UserID { return b.blockerID }
+func (b *Block) BlockedID() UserID { return b.blockedID }
+func (b *Block) CreatedAt() int64  { return b.createdAt }
@@ -0,0 +1,24 @@
+package social
+
+import "errors"
+
+// Domain errors for the friend graph
+var (
+	// Friendship errors
+	ErrInvalidFriendshipID   = errors.New("invalid friendship ID")
+	ErrCannotFriendSelf      = errors.New("cannot send a friend request to yourself")
+	ErrFriendshipNotFound    = errors.New("friendship not found")
+	ErrFriendRequestExists   = errors.New("friend request already sent")
+	ErrAlreadyFriends        = errors.New("players are already friends")
+	ErrRequestNotPending     = errors.New("friend request is not pending")
+	ErrNotRequestAddressee   = errors.New("only the invited player can respond to a friend request")
+	ErrFriendshipExists      = errors.New("players already have a friendship or friend request")
+	ErrFriendshipChanged     = errors.New("friendship changed concurrently")
+	ErrFriendLimitReached    = errors.New("friend limit reached")
+	ErrInvalidResponseAction = errors.New("invalid friend request response, expected accept or decline")
+
+	// Block errors
+	ErrCannotBlockSelf = errors.New("cannot block yourself")
+	ErrPlayerBlocked   = errors.New("player is blocked")
+	ErrBlockNotFound   = errors.New("player is not blocked")
+)
@@ -0,0 +1,103 @@
+package social
+
+import "github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+
+// Friendship is a friend request between two players and, once the addressee accepts it,
+// their friendship. A declined request or a removed friendship is deleted, so the two
+// players can send each other a new request later.
+type Friendship struct {
+	id          FriendshipID
+	requesterID UserID
+	addresseeID UserID
+	status      FriendshipStatus
+	createdAt   int64
+	acceptedAt  int64 // 0 while pending
+}
+
+// NewFriendRequest creates a pending request from requester to addressee
+func NewFriendRequest(requesterID UserID, addresseeID UserID, createdAt int64) (*Friendship, error) {
+	if requesterID.IsZero() || addresseeID.IsZero() {
+		return nil, shared.ErrInvalidUserID
+	}
+	if requesterID.Equals(addresseeID) {
+		return nil, ErrCannotFriendSelf
+	}
+
+	return &Friendship{
+		id:          NewFriendshipID(),
+		requesterID: requesterID,
+		addresseeID: addresseeID,
+		status:      FriendshipStatusPending,
+		createdAt:   createdAt,
+	}, nil
+}
+
+func ReconstructFriendship(
+	id FriendshipID,
+	requesterID UserID,
+	addresseeID UserID,
+	status FriendshipStatus,
+	createdAt int64,
+	acceptedAt int64,
+) *Friendship {
+	return &Friendship{
+		id:          id,
+		requesterID: requesterID,
+		addresseeID: addresseeID,
+		status:      status,
+		createdAt:   createdAt,
+		acceptedAt:  acceptedAt,
+	}
+}
+
+// Accept makes the two players friends; only the addressee can accept
+func (f *Friendship) Accept(playerID UserID, now int64) error {
+	if err := f.checkRespondent(playerID); err != nil {
+		return err
+	}
+	f.status = FriendshipStatusAccepted
+	f.acceptedAt = now
+	return nil
+}
+
+// CheckDecline validates that the player may decline the request (the request is then deleted)
+func (f *Friendship) CheckDecline(playerID UserID) error {
+	return f.checkRespondent(playerID)
+}
+
+func (f *Friendship) checkRespondent(playerID UserID) error {
+	if !f.addresseeID.Equals(playerID) {
+		return ErrNotRequestAddressee
+	}
+	if f.status != FriendshipStatusPending {
+		return ErrRequestNotPending
+	}
+	return nil
+}
+
+// Involves reports whether the player is one of the two sides
+func (f *Friendship) Involves(playerID UserID) bool {
+	return f.requesterID.Equals(playerID) || f.addresseeID.Equals(playerID)
+}
+
+// OtherPlayer returns the side that is not playerID
+func (f *Friendship) OtherPlayer(playerID UserID) UserID {
+	if f.requesterID.Equals(playerID) {
+		return f.addresseeID
+	}
+	return f.requesterID
+}
+
+// IsIncomingFor reports whether the friendship is a request waiting for the player's answer
+func (f *Friendship) IsIncomingFor(playerID UserID) bool {
+	return f.status == FriendshipStatusPending && f.addresseeID.Equals(playerID)
+}
+
+func (f *Friendship) ID() FriendshipID         { return f.id }
+func (f *Friendship) RequesterID() UserID      { return f.requesterID }
+func (f *Friendship) AddresseeID() UserID      { return f.addresseeID }
+func (f *Friendship) Status() FriendshipStatus { return f.status }
+func (f *Friendship) CreatedAt() int64         { return f.createdAt }
+func (f *Friendship) AcceptedAt() int64        { return f.acceptedAt }
+func (f *Friendship) IsAccepted() bool         { return f.status == FriendshipStatusAccepted }
+func (f *Friendship) IsPending() bool          { return f.status == FriendshipStatusPending }
@@ -0,0 +1,59 @@
+package social
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+func mustUserID(t *testing.T, value string) UserID {
+	t.Helper()
+	id, err := shared.NewUserID(value)
+	if err != nil {
+		t.Fatalf("NewUserID(%q): %v", value, err)
+	}
+	return id
+}
+
+func TestNewFriendRequest_RejectsSelf(t *testing.T) {
+	alice := mustUserID(t, "111")
+
+	if _, err := NewFriendRequest(alice, alice, 1000); !errors.Is(err, ErrCannotFriendSelf) {
+		t.Errorf("err = %v, want ErrCannotFriendSelf", err)
+	}
+	if _, err := NewBlock(alice, alice, 1000); !errors.Is(err, ErrCannotBlockSelf) {
+		t.Errorf("NewBlock err = %v, want ErrCannotBlockSelf", err)
+	}
+}
+
+func TestFriendship_OnlyAddresseeResponds(t *testing.T) {
+	alice, bob := mustUserID(t, "111"), mustUserID(t, "222")
+	request, err := NewFriendRequest(alice, bob, 1000)
+	if err != nil {
+		t.Fatalf("NewFriendRequest: %v", err)
+	}
+
+	if !request.IsIncomingFor(bob) || request.IsIncomingFor(alice) {
+		t.Error("the request should be incoming for the addressee only")
+	}
+	if err := request.Accept(alice, 2000); !errors.Is(err, ErrNotRequestAddressee) {
+		t.Errorf("Accept by requester = %v, want ErrNotRequestAddressee", err)
+	}
+	if err := request.CheckDecline(alice); !errors.Is(err, ErrNotRequestAddressee) {
+		t.Errorf("CheckDecline by requester = %v, want ErrNotRequestAddressee", err)
+	}
+
+	if err := request.Accept(bob, 2000); err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	if !request.IsAccepted() || request.AcceptedAt() != 2000 {
+		t.Errorf("status = %s, acceptedAt = %d", request.Status(), request.AcceptedAt())
+	}
+	if err := request.Accept(bob, 3000); !errors.Is(err, ErrRequestNotPending) {
+		t.Errorf("second Accept = %v, want ErrRequestNotPending", err)
+	}
+	if !request.OtherPlayer(alice).Equals(bob) || !request.OtherPlayer(bob).Equals(alice) {
+		t.Error("OtherPlayer should return the other side")
+	}
+}
@@ -0,0 +1,50 @@
+package social
+
+// FriendshipRepository defines the interface for friend requests and friendships
+type FriendshipRepository interface {
+	// Create persists a new friend request
+	// Returns ErrFriendshipExists if the two players already have a request or friendship, in either direction
+	Create(friendship *Friendship) error
+
+	// UpdateFrom persists the friendship if its stored status is still loadedStatus
+	// Returns ErrFriendshipChanged otherwise (responded to, removed or blocked concurrently)
+	UpdateFrom(friendship *Friendship, loadedStatus FriendshipStatus) error
+
+	// Delete removes a friend request or friendship
+	// Returns ErrFriendshipNotFound if it does not exist
+	Delete(id FriendshipID) error
+
+	// FindByID retrieves a friend request or friendship
+	// Returns ErrFriendshipNotFound if it does not exist
+	FindByID(id FriendshipID) (*Friendship, error)
+
+	// FindBetween retrieves the request or friendship between two players, whoever sent it
+	// Returns ErrFriendshipNotFound if there is none
+	FindBetween(playerID UserID, otherID UserID) (*Friendship, error)
+
+	// FindByPlayer retrieves the player's friendships and pending requests, both sent and received, newest first
+	FindByPlayer(playerID UserID) ([]*Friendship, error)
+
+	// FindFriendIDs retrieves the IDs of the player's accepted friends
+	FindFriendIDs(playerID UserID) ([]UserID, error)
+
+	// CountFriends returns how many accepted friends the player has
+	CountFriends(playerID UserID) (int, error)
+}
+
+// BlockRepository defines the interface for player blocks
+type BlockRepository interface {
+	// Save persists the block and, in the same transaction, deletes any friendship
+	// or friend request between the two players. Saving an existing block is a no-op.
+	Save(block *Block) error
+
+	// Delete lifts a block
+	// Returns ErrBlockNotFound if the player did not block the other
+	Delete(blockerID UserID, blockedID UserID) error
+
+	// IsBlocked reports whether either player blocked the other
+	IsBlocked(playerID UserID, otherID UserID) (bool, error)
+
+	// FindByBlocker retrieves the players the player blocked, newest first
+	FindByBlocker(blockerID UserID) ([]*Block, error)
+}
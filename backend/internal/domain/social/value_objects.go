@@ -0,0 +1,57 @@
+package social
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// Type aliases from other domains
+type UserID = shared.UserID
+
+// MaxFriends is how many accepted friends a player can have
+const MaxFriends = 200
+
+// FriendshipID uniquely identifies a friend request and, once accepted, the friendship
+type FriendshipID struct {
+	value string
+}
+
+func NewFriendshipID() FriendshipID {
+	return FriendshipID{value: uuid.New().String()}
+}
+
+func NewFriendshipIDFromString(value string) (FriendshipID, error) {
+	if _, err := uuid.Parse(value); err != nil {
+		return FriendshipID{}, ErrInvalidFriendshipID
+	}
+	return FriendshipID{value: value}, nil
+}
+
+func (id FriendshipID) String() string {
+	return id.value
+}
+
+func (id FriendshipID) IsZero() bool {
+	return id.value == ""
+}
+
+func (id FriendshipID) Equals(other FriendshipID) bool {
+	return id.value == other.value
+}
+
+// FriendshipStatus is the state of a friendship
+type FriendshipStatus string
+
+const (
+	FriendshipStatusPending  FriendshipStatus = "pending"  // request sent, waiting for the addressee
+	FriendshipStatusAccepted FriendshipStatus = "accepted" // both players are friends
+)
+
+func (s FriendshipStatus) IsValid() bool {
+	return s == FriendshipStatusPending || s == FriendshipStatusAccepted
+}
+
+func (s FriendshipStatus) String() string {
+	return string(s)
+}
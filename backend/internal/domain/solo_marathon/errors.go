@@ -20,10 +20,12 @@ var (
 	ErrInvalidBonusType   = errors.New("invalid bonus type")
 	ErrBonusAlreadyUsed   = errors.New("bonus already used for this question")
 	ErrShieldAlreadyActive = errors.New("shield is already active")
+	ErrBonusNotApplicable  = errors.New("bonus does not apply to this question type")
 
 	// Continue errors
 	ErrContinueNotAvailable = errors.New("continue not available in current game state")
 	ErrInsufficientCoins    = errors.New("insufficient coins for continue")
+	ErrAdNotVerified        = errors.New("rewarded ad view not verified")
 
 	// Question errors
 	ErrInvalidQuestion = errors.New("invalid question")
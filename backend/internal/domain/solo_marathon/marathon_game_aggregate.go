@@ -108,7 +108,7 @@ type AnswerQuestionResult struct {
 // AnswerQuestion processes a user's answer in marathon mode (V1)
 func (mg *MarathonGame) AnswerQuestion(
 	questionID QuestionID,
-	answerID AnswerID,
+	submission quiz.AnswerSubmission,
 	timeTaken int64,
 	answeredAt int64,
 ) (*AnswerQuestionResult, error) {
@@ -120,7 +120,7 @@ func (mg *MarathonGame) AnswerQuestion(
 		return nil, ErrNoLivesRemaining
 	}
 
-	kernelResult, err := mg.session.AnswerQuestion(questionID, answerID, timeTaken, answeredAt)
+	kernelResult, err := mg.session.AnswerQuestion(questionID, submission, timeTaken, answeredAt)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +210,7 @@ func (mg *MarathonGame) AnswerQuestion(
 		mg.id,
 		mg.playerID,
 		questionID,
-		answerID,
+		submission.PrimaryAnswerID(),
 		kernelResult.IsCorrect,
 		timeTaken,
 		false, // shieldActive not supported in V1
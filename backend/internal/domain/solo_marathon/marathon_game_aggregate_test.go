@@ -206,7 +206,7 @@ func TestMarathonGame_AnswerQuestion_Correct(t *testing.T) {
 	// Answer correctly
 	result, err := game.AnswerQuestion(
 		currentQuestion.ID(),
-		correctAnswer.ID(),
+		quiz.NewChoiceSubmission(correctAnswer.ID()),
 		2000, // 2 seconds
 		now+2000,
 	)
@@ -270,7 +270,7 @@ func TestMarathonGame_AnswerQuestion_Incorrect(t *testing.T) {
 	// Answer incorrectly
 	result, err := game.AnswerQuestion(
 		currentQuestion.ID(),
-		wrongAnswer.ID(),
+		quiz.NewChoiceSubmission(wrongAnswer.ID()),
 		2000,
 		now+2000,
 	)
@@ -321,7 +321,7 @@ func TestMarathonGame_AnswerQuestion_GameOver(t *testing.T) {
 	// Answer incorrectly (lose last life)
 	result, err := game.AnswerQuestion(
 		currentQuestion.ID(),
-		wrongAnswer.ID(),
+		quiz.NewChoiceSubmission(wrongAnswer.ID()),
 		2000,
 		now+2000,
 	)
@@ -363,7 +363,7 @@ func TestMarathonGame_StreakIncrement(t *testing.T) {
 
 		result, err := game.AnswerQuestion(
 			currentQuestion.ID(),
-			correctAnswer.ID(),
+			quiz.NewChoiceSubmission(correctAnswer.ID()),
 			2000,
 			now+int64(i*2000),
 		)
@@ -156,7 +156,7 @@ func (mg *MarathonGameV2) LoadNextQuestion(questionSelector *QuestionSelector) e
 // AnswerQuestionResult holds detailed information about a submitted answer
 type AnswerQuestionResultV2 struct {
 	IsCorrect       bool
-	CorrectAnswerID AnswerID
+	CorrectAnswerID AnswerID // single-choice and true/false questions only
 	TimeTaken       int64
 	Score           int             // Total correct answers
 	TotalQuestions  int             // Total questions attempted
@@ -193,9 +193,11 @@ type ContinueOffer struct {
 // - Correct answer: increment score, update difficulty
 // - Incorrect answer: if shield active, consume shield; else lose life
 // - Game over when lives == 0 (intermediate state, continue offered)
+// - Only a fully right answer counts as correct (no partial credit in marathon);
+//   a numeric estimate within the tolerance is right
 func (mg *MarathonGameV2) AnswerQuestion(
 	questionID QuestionID,
-	answerID AnswerID,
+	submission quiz.AnswerSubmission,
 	timeTaken int64,
 	answeredAt int64,
 ) (*AnswerQuestionResultV2, error) {
@@ -217,23 +219,15 @@ func (mg *MarathonGameV2) AnswerQuestion(
 		return nil, ErrInvalidQuestion
 	}
 
-	// 3. Get the answer
-	answer, err := mg.currentQuestion.GetAnswer(answerID)
+	// 3-4. Check the answer fits the question's type, and its correctness
+	evaluation, err := mg.currentQuestion.Evaluate(submission)
 	if err != nil {
 		return nil, err
 	}
-
-	// 4. Check correctness
-	isCorrect := answer.IsCorrect()
+	isCorrect := evaluation.IsCorrect
 
 	// 5. Find the correct answer ID for response
-	var correctAnswerID AnswerID
-	for _, a := range mg.currentQuestion.Answers() {
-		if a.IsCorrect() {
-			correctAnswerID = a.ID()
-			break
-		}
-	}
+	correctAnswerID := mg.currentQuestion.CorrectAnswerID()
 
 	// 6. Track shield state for this answer
 	wasShieldActive := mg.shieldActive
@@ -373,7 +367,7 @@ func (mg *MarathonGameV2) AnswerQuestion(
 		mg.id,
 		mg.playerID,
 		questionID,
-		answerID,
+		submission.PrimaryAnswerID(),
 		isCorrect,
 		timeTaken,
 		wasShieldActive,
@@ -463,6 +457,16 @@ func (mg *MarathonGameV2) UseBonus(questionID QuestionID, bonusType BonusType, u
 		return mg.ActivateShield(questionID, usedAt)
 	}
 
+	// 50/50 hides wrong options: it would give away a true/false question,
+	// and ordering and numeric questions have no wrong options to hide
+	if bonusType == BonusFiftyFifty {
+		switch mg.currentQuestion.Type() {
+		case quiz.QuestionTypeSingleChoice, quiz.QuestionTypeMultiSelect:
+		default:
+			return ErrBonusNotApplicable
+		}
+	}
+
 	// Check if this specific bonus type already used for this question
 	// (Shield + 50/50 is allowed, but not same bonus twice)
 	if usedBonuses, exists := mg.usedBonuses[questionID]; exists {
@@ -75,7 +75,7 @@ func findWrongAnswerID(q *quiz.Question) quiz.AnswerID {
 func TestMarathonGameV2_StreakIncrementsOnCorrectAnswer(t *testing.T) {
 	game, q := buildV2GameWithQuestion(t, 0, 5)
 
-	result, err := game.AnswerQuestion(q.ID(), findCorrectAnswerID(q), 1000, 1000001)
+	result, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findCorrectAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -93,7 +93,7 @@ func TestMarathonGameV2_StreakResetsOnWrongAnswer(t *testing.T) {
 	// Start with a streak of 3
 	game, q := buildV2GameWithQuestion(t, 3, 5)
 
-	result, err := game.AnswerQuestion(q.ID(), findWrongAnswerID(q), 1000, 1000001)
+	result, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findWrongAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -111,7 +111,7 @@ func TestMarathonGameV2_LifeRestoredAfterStreak5(t *testing.T) {
 	// Start with streak=4, lives=3 (not full) — answering correctly should trigger regen
 	game, q := buildV2GameWithQuestion(t, 4, 3)
 
-	result, err := game.AnswerQuestion(q.ID(), findCorrectAnswerID(q), 1000, 1000001)
+	result, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findCorrectAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -132,7 +132,7 @@ func TestMarathonGameV2_NoLifeRestoredWhenFull(t *testing.T) {
 	// Start with streak=4, lives=5 (full max)
 	game, q := buildV2GameWithQuestion(t, 4, MaxLives)
 
-	result, err := game.AnswerQuestion(q.ID(), findCorrectAnswerID(q), 1000, 1000001)
+	result, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findCorrectAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -165,7 +165,7 @@ func TestMarathonGameV2_ShieldResetsStreak(t *testing.T) {
 		4, 4, 0, // streak=4, bestStreak=4, livesRestored=0
 	)
 
-	result, err := gameWithShield.AnswerQuestion(q.ID(), findWrongAnswerID(q), 1000, 1000001)
+	result, err := gameWithShield.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findWrongAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -188,7 +188,7 @@ func TestMarathonGameV2_StreakContinuesPast5(t *testing.T) {
 	// streak=9, lives=3 — answering correctly makes streak=10, triggers 2nd regen
 	game, q := buildV2GameWithQuestion(t, 9, 3)
 
-	result, err := game.AnswerQuestion(q.ID(), findCorrectAnswerID(q), 1000, 1000001)
+	result, err := game.AnswerQuestion(q.ID(), quiz.NewChoiceSubmission(findCorrectAnswerID(q)), 1000, 1000001)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
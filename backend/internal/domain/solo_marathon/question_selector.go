@@ -37,36 +37,40 @@ func (qs *QuestionSelector) SelectNextQuestion(
 	// 2. Select difficulty using weighted random
 	selectedDifficulty := selectWeightedDifficulty(distribution)
 
-	// 3. Build filter
-	filter := quiz.NewQuestionFilter().
-		WithDifficulty(selectedDifficulty).
-		WithExcludeIDs(recentIDs)
-
-	// Add category filter if not "all categories"
-	if !category.IsAllCategories() {
-		filter = filter.WithCategory(category.CategoryID())
+	// 3. Try filters from most to least specific:
+	//    - selected difficulty, excluding recent questions
+	//    - selected difficulty, recent questions allowed
+	//    - any difficulty, excluding recent questions (catalog has no questions of this difficulty)
+	//    - any difficulty
+	filters := []quiz.QuestionFilter{
+		quiz.NewQuestionFilter().WithDifficulty(selectedDifficulty).WithExcludeIDs(recentIDs),
+		quiz.NewQuestionFilter().WithDifficulty(selectedDifficulty),
+		quiz.NewQuestionFilter().WithExcludeIDs(recentIDs),
+		quiz.NewQuestionFilter(),
 	}
 
-	// 4. Verify we have questions available
-	count, err := qs.questionRepo.CountByFilter(filter)
-	if err != nil {
-		return nil, err
-	}
-
-	if count == 0 {
-		// No questions available with this filter
-		// Fallback: try without excluding recent questions
-		filter = quiz.NewQuestionFilter().
-			WithDifficulty(selectedDifficulty)
-
+	// 4. Pick the first filter that has questions available
+	var filter quiz.QuestionFilter
+	found := false
+	for _, candidate := range filters {
+		// Add category filter if not "all categories"
 		if !category.IsAllCategories() {
-			filter = filter.WithCategory(category.CategoryID())
+			candidate = candidate.WithCategory(category.CategoryID())
 		}
 
-		count, err = qs.questionRepo.CountByFilter(filter)
-		if err != nil || count == 0 {
-			return nil, ErrNoQuestionsAvailable
+		count, err := qs.questionRepo.CountByFilter(candidate)
+		if err != nil {
+			return nil, err
 		}
+		if count > 0 {
+			filter = candidate
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, ErrNoQuestionsAvailable
 	}
 
 	// 5. Fetch random question
@@ -1,7 +1,10 @@
 package solo_marathon
 
 import (
+	"database/sql"
 	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
 
 func TestSelectWeightedDifficulty(t *testing.T) {
@@ -125,3 +128,111 @@ func TestSelectWeightedDifficulty_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// stubQuestionRepo is a minimal quiz.QuestionRepository honouring difficulty and exclude filters
+type stubQuestionRepo struct {
+	questions []*quiz.Question
+}
+
+func (r *stubQuestionRepo) matching(f quiz.QuestionFilter) []*quiz.Question {
+	excluded := make(map[string]bool, len(f.ExcludeIDs))
+	for _, id := range f.ExcludeIDs {
+		excluded[id.String()] = true
+	}
+	var result []*quiz.Question
+	for _, q := range r.questions {
+		if excluded[q.ID().String()] {
+			continue
+		}
+		if f.HasDifficultyFilter() && *f.Difficulty != q.Difficulty().String() {
+			continue
+		}
+		result = append(result, q)
+	}
+	return result
+}
+
+func (r *stubQuestionRepo) FindByID(quiz.QuestionID) (*quiz.Question, error) {
+	return nil, quiz.ErrQuestionNotFound
+}
+func (r *stubQuestionRepo) FindByIDs([]quiz.QuestionID) ([]*quiz.Question, error) { return nil, nil }
+func (r *stubQuestionRepo) FindByFilter(f quiz.QuestionFilter) ([]*quiz.Question, error) {
+	return r.matching(f), nil
+}
+func (r *stubQuestionRepo) FindRandomQuestions(f quiz.QuestionFilter, limit int) ([]*quiz.Question, error) {
+	result := r.matching(f)
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+func (r *stubQuestionRepo) FindQuestionsBySeed(f quiz.QuestionFilter, limit int, _ int64) ([]*quiz.Question, error) {
+	return r.FindRandomQuestions(f, limit)
+}
+func (r *stubQuestionRepo) FindQuestionsByQuizSeed(int, int64, *quiz.CategoryID) ([]*quiz.Question, error) {
+	return nil, nil
+}
+func (r *stubQuestionRepo) CountByFilter(f quiz.QuestionFilter) (int, error) {
+	return len(r.matching(f)), nil
+}
+func (r *stubQuestionRepo) Save(*quiz.Question) error      { return nil }
+func (r *stubQuestionRepo) SaveAll([]*quiz.Question) error { return nil }
+func (r *stubQuestionRepo) SaveInTx(*sql.Tx, *quiz.Question) error { return nil }
+func (r *stubQuestionRepo) Delete(quiz.QuestionID) error   { return nil }
+
+func newStubQuestion(t *testing.T, difficulty quiz.Difficulty) *quiz.Question {
+	t.Helper()
+	text, _ := quiz.NewQuestionText("Stub question?")
+	points, _ := quiz.NewPoints(10)
+	q, err := quiz.NewQuestion(quiz.NewQuestionID(), text, points, 0)
+	if err != nil {
+		t.Fatalf("NewQuestion: %v", err)
+	}
+	q.SetDifficulty(difficulty)
+	return q
+}
+
+func TestQuestionSelector_MasterLevelPicksHardQuestions(t *testing.T) {
+	repo := &stubQuestionRepo{questions: []*quiz.Question{
+		newStubQuestion(t, quiz.DifficultyEasy),
+		newStubQuestion(t, quiz.DifficultyMedium),
+		newStubQuestion(t, quiz.DifficultyHard),
+	}}
+	selector := NewQuestionSelector(repo)
+	master := NewDifficultyProgression().UpdateFromQuestionIndex(60)
+
+	for i := 0; i < 20; i++ {
+		q, err := selector.SelectNextQuestion(NewMarathonCategoryAll(), master, nil)
+		if err != nil {
+			t.Fatalf("SelectNextQuestion: %v", err)
+		}
+		if q.Difficulty() != quiz.DifficultyHard {
+			t.Fatalf("expected hard question at Master level, got %s", q.Difficulty())
+		}
+	}
+}
+
+func TestQuestionSelector_FallsBackWhenDifficultyMissing(t *testing.T) {
+	repo := &stubQuestionRepo{questions: []*quiz.Question{
+		newStubQuestion(t, quiz.DifficultyMedium),
+	}}
+	selector := NewQuestionSelector(repo)
+	master := NewDifficultyProgression().UpdateFromQuestionIndex(60)
+
+	q, err := selector.SelectNextQuestion(NewMarathonCategoryAll(), master, nil)
+	if err != nil {
+		t.Fatalf("expected fallback to any difficulty, got %v", err)
+	}
+	if q.Difficulty() != quiz.DifficultyMedium {
+		t.Fatalf("expected the only (medium) question, got %s", q.Difficulty())
+	}
+}
+
+func TestQuestionSelector_NoQuestions(t *testing.T) {
+	selector := NewQuestionSelector(&stubQuestionRepo{})
+
+	_, err := selector.SelectNextQuestion(NewMarathonCategoryAll(), NewDifficultyProgression(), nil)
+	if err != ErrNoQuestionsAvailable {
+		t.Fatalf("expected ErrNoQuestionsAvailable, got %v", err)
+	}
+}
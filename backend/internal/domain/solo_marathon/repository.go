@@ -1,11 +1,16 @@
 package solo_marathon
 
+import "database/sql"
+
 // Repository defines the interface for marathon game persistence
 // NOTE: Now uses MarathonGameV2 (endless mode with dynamic questions)
 type Repository interface {
 	// Save persists a marathon game
 	Save(game *MarathonGameV2) error
 
+	// SaveInTx persists a marathon game within an existing transaction
+	SaveInTx(tx *sql.Tx, game *MarathonGameV2) error
+
 	// FindByID retrieves a marathon game by ID
 	FindByID(id GameID) (*MarathonGameV2, error)
 
@@ -18,6 +18,10 @@ var (
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrUserBlocked       = errors.New("user is blocked")
 
+	// Session errors
+	ErrInvalidSessionToken = errors.New("invalid session token")
+	ErrSessionExpired      = errors.New("session token expired")
+
 	// Inventory errors
 	ErrInsufficientBalance = errors.New("insufficient balance")
 	ErrInvalidResource     = errors.New("invalid resource type")
@@ -28,4 +32,15 @@ var (
 	ErrInvalidTransactionType   = errors.New("invalid transaction type")
 	ErrInvalidTransactionSource = errors.New("transaction source cannot be empty")
 	ErrEmptyTransactionDetails  = errors.New("transaction details cannot be empty")
+	ErrDuplicateTransaction     = errors.New("transaction with this idempotency key already applied")
+	ErrInventoryChanged         = errors.New("inventory changed since it was read")
+	ErrInvalidTransactionCursor = errors.New("invalid transaction cursor")
+
+	// Premium errors
+	ErrUnknownPremiumPlan      = errors.New("unknown premium plan")
+	ErrInvalidInvoicePayload   = errors.New("invalid premium invoice payload")
+	ErrInvalidPaymentChargeID  = errors.New("payment charge ID cannot be empty")
+	ErrPaymentMismatch         = errors.New("payment does not match the premium plan")
+	ErrDuplicatePremiumPayment = errors.New("premium payment already recorded")
+	ErrPaymentUnavailable      = errors.New("payment provider unavailable")
 )
@@ -11,6 +11,9 @@ const (
 	ResourceFreeze     = "freeze"
 )
 
+// initialPvpTickets is the ticket balance of a new inventory
+const initialPvpTickets = 3
+
 var validResources = map[string]bool{
 	ResourceCoins:      true,
 	ResourcePvpTickets: true,
@@ -38,7 +41,7 @@ func NewInventory(playerID UserID, createdAt int64) (*Inventory, error) {
 
 	return &Inventory{
 		playerID:   playerID,
-		pvpTickets: 3,
+		pvpTickets: initialPvpTickets,
 		updatedAt:  createdAt,
 	}, nil
 }
@@ -131,6 +134,49 @@ func (i *Inventory) DebitMultiple(debits map[string]int, updatedAt int64) error
 	return nil
 }
 
+// Apply credits or debits the transaction's details
+func (i *Inventory) Apply(tx *TransactionLog) error {
+	switch tx.Type() {
+	case TransactionCredit:
+		return i.CreditMultiple(tx.details, tx.createdAt)
+	case TransactionDebit:
+		return i.DebitMultiple(tx.details, tx.createdAt)
+	default:
+		return ErrInvalidTransactionType
+	}
+}
+
+// Balances returns the amount of every resource
+func (i *Inventory) Balances() map[string]int {
+	balances := make(map[string]int, len(validResources))
+	for resource := range validResources {
+		balances[resource] = i.getResource(resource)
+	}
+	return balances
+}
+
+// ReplayTransactions rebuilds the inventory a player's transaction log (oldest first) adds up to,
+// starting from a new inventory. Debits are not checked against the running balance: a negative
+// result is exactly the kind of drift reconciliation has to report.
+func ReplayTransactions(playerID UserID, history []TransactionLog) *Inventory {
+	inventory := &Inventory{playerID: playerID, pvpTickets: initialPvpTickets}
+	for _, tx := range history {
+		for resource, amount := range tx.details {
+			if tx.txType == TransactionDebit {
+				amount = -amount
+			}
+			inventory.setResource(resource, inventory.getResource(resource)+amount)
+		}
+		inventory.updatedAt = tx.createdAt
+	}
+	return inventory
+}
+
+// IsValidResource reports whether the resource can be held in an inventory
+func IsValidResource(resource string) bool {
+	return validResources[resource]
+}
+
 func (i *Inventory) getResource(resource string) int {
 	switch resource {
 	case ResourceCoins:
@@ -241,3 +241,50 @@ func TestNewTransactionLog_InvalidType(t *testing.T) {
 		})
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Ledger
+// ---------------------------------------------------------------------------
+
+func TestInventory_ApplyTransaction(t *testing.T) {
+	playerID := mustUserID("player1")
+	inv, _ := NewInventory(playerID, ts)
+
+	credit, _ := NewTransactionLog("tx-001", playerID, TransactionCredit, "daily_chest", map[string]int{ResourceCoins: 150}, ts+1)
+	if err := inv.Apply(credit); err != nil {
+		t.Fatalf("Apply(credit): %v", err)
+	}
+	debit, _ := NewTransactionLog("tx-002", playerID, TransactionDebit, "pvp_entry", map[string]int{ResourcePvpTickets: 4}, ts+2)
+	if err := inv.Apply(debit); !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Apply(debit) = %v, want ErrInsufficientBalance", err)
+	}
+
+	if inv.Coins() != 150 || inv.PvpTickets() != 3 || inv.UpdatedAt() != ts+1 {
+		t.Errorf("inventory = coins %d, tickets %d, updatedAt %d", inv.Coins(), inv.PvpTickets(), inv.UpdatedAt())
+	}
+}
+
+func TestReplayTransactions(t *testing.T) {
+	playerID := mustUserID("player1")
+	history := []TransactionLog{
+		*ReconstructTransactionLog("tx-001", playerID, TransactionCredit, "welcome_bonus", map[string]int{ResourceCoins: 100, ResourceShield: 1}, "", ts),
+		*ReconstructTransactionLog("tx-002", playerID, TransactionDebit, "pvp_entry", map[string]int{ResourcePvpTickets: 1}, "key-1", ts+10),
+		*ReconstructTransactionLog("tx-003", playerID, TransactionDebit, "daily_retry", map[string]int{ResourceCoins: 150}, "", ts+20),
+	}
+
+	inv := ReplayTransactions(playerID, history)
+
+	balances := inv.Balances()
+	want := map[string]int{
+		ResourceCoins: -50, ResourcePvpTickets: 2, ResourceShield: 1,
+		ResourceFiftyFifty: 0, ResourceSkip: 0, ResourceFreeze: 0,
+	}
+	for resource, amount := range want {
+		if balances[resource] != amount {
+			t.Errorf("%s = %d, want %d", resource, balances[resource], amount)
+		}
+	}
+	if inv.UpdatedAt() != ts+20 {
+		t.Errorf("UpdatedAt = %d, want %d", inv.UpdatedAt(), ts+20)
+	}
+}
@@ -26,3 +26,18 @@ func (ps PremiumStatus) IsActive() bool {
 func (ps PremiumStatus) ExpiresAt() int64 {
 	return ps.expiresAt
 }
+
+// IsActiveAt reports whether the subscription is active and not yet expired at the given time.
+func (ps PremiumStatus) IsActiveAt(now int64) bool {
+	return ps.active && ps.expiresAt > now
+}
+
+// Extend returns the status after paying for duration seconds of premium at now.
+// Renewing before expiry adds to the remaining time instead of restarting it.
+func (ps PremiumStatus) Extend(now int64, duration int64) PremiumStatus {
+	start := now
+	if ps.IsActiveAt(now) {
+		start = ps.expiresAt
+	}
+	return PremiumStatus{active: true, expiresAt: start + duration}
+}
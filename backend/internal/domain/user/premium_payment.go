@@ -0,0 +1,132 @@
+package user
+
+import (
+	"strings"
+)
+
+// StarsCurrency is the currency code of Telegram Stars
+const StarsCurrency = "XTR"
+
+// premiumPlanDay is the length of a premium day in seconds
+const premiumPlanDay = 24 * 60 * 60
+
+// PremiumPlan is a premium offer paid in Telegram Stars
+type PremiumPlan struct {
+	ID           string
+	Title        string
+	Description  string
+	PriceStars   int
+	Days         int
+	Subscription bool // renewed monthly by Telegram; only 30-day plans can be subscriptions
+}
+
+// Duration returns how long one payment for the plan extends premium, in seconds
+func (p PremiumPlan) Duration() int64 {
+	return int64(p.Days) * premiumPlanDay
+}
+
+// PremiumPlans returns the premium plans on sale
+func PremiumPlans() []PremiumPlan {
+	return []PremiumPlan{
+		{
+			ID:           "premium_month",
+			Title:        "Quiz Sprint Premium",
+			Description:  "Double chest rewards and free daily retries for 30 days, renewed monthly",
+			PriceStars:   250,
+			Days:         30,
+			Subscription: true,
+		},
+		{
+			ID:          "premium_week",
+			Title:       "Quiz Sprint Premium (7 days)",
+			Description: "Double chest rewards and free daily retries for 7 days",
+			PriceStars:  75,
+			Days:        7,
+		},
+	}
+}
+
+// FindPremiumPlan returns the premium plan with the given ID
+func FindPremiumPlan(id string) (PremiumPlan, error) {
+	for _, plan := range PremiumPlans() {
+		if plan.ID == id {
+			return plan, nil
+		}
+	}
+	return PremiumPlan{}, ErrUnknownPremiumPlan
+}
+
+// premiumPayloadPrefix marks invoice payloads created for premium plans
+const premiumPayloadPrefix = "premium"
+
+// PremiumInvoicePayload builds the invoice payload Telegram echoes back in payment updates
+func PremiumInvoicePayload(planID string, playerID UserID) string {
+	return premiumPayloadPrefix + ":" + planID + ":" + playerID.String()
+}
+
+// ParsePremiumInvoicePayload extracts the plan and the paying player from an invoice payload
+func ParsePremiumInvoicePayload(payload string) (PremiumPlan, UserID, error) {
+	parts := strings.Split(payload, ":")
+	if len(parts) != 3 || parts[0] != premiumPayloadPrefix {
+		return PremiumPlan{}, UserID{}, ErrInvalidInvoicePayload
+	}
+
+	plan, err := FindPremiumPlan(parts[1])
+	if err != nil {
+		return PremiumPlan{}, UserID{}, err
+	}
+
+	playerID, err := NewUserID(parts[2])
+	if err != nil {
+		return PremiumPlan{}, UserID{}, ErrInvalidInvoicePayload
+	}
+
+	return plan, playerID, nil
+}
+
+// CheckPayment verifies that a payment in the given currency and amount pays for the plan
+func (p PremiumPlan) CheckPayment(currency string, totalAmount int) error {
+	if currency != StarsCurrency || totalAmount != p.PriceStars {
+		return ErrPaymentMismatch
+	}
+	return nil
+}
+
+// PremiumPayment records a successful Telegram Stars payment for premium
+type PremiumPayment struct {
+	chargeID     string // telegram_payment_charge_id, unique per payment
+	playerID     UserID
+	planID       string
+	amountStars  int
+	isRecurring  bool
+	premiumUntil int64
+	createdAt    int64
+}
+
+// NewPremiumPayment records a payment for the plan that extended premium until premiumUntil
+func NewPremiumPayment(chargeID string, playerID UserID, plan PremiumPlan, isRecurring bool, premiumUntil int64, createdAt int64) (*PremiumPayment, error) {
+	if chargeID == "" {
+		return nil, ErrInvalidPaymentChargeID
+	}
+	if playerID.IsZero() {
+		return nil, ErrInvalidUserID
+	}
+
+	return &PremiumPayment{
+		chargeID:     chargeID,
+		playerID:     playerID,
+		planID:       plan.ID,
+		amountStars:  plan.PriceStars,
+		isRecurring:  isRecurring,
+		premiumUntil: premiumUntil,
+		createdAt:    createdAt,
+	}, nil
+}
+
+func (p *PremiumPayment) ChargeID() string    { return p.chargeID }
+func (p *PremiumPayment) PlayerID() UserID    { return p.playerID }
+func (p *PremiumPayment) PlanID() string      { return p.planID }
+func (p *PremiumPayment) AmountStars() int    { return p.amountStars }
+func (p *PremiumPayment) IsRecurring() bool   { return p.isRecurring }
+func (p *PremiumPayment) PremiumUntil() int64 { return p.premiumUntil }
+func (p *PremiumPayment) CreatedAt() int64    { return p.createdAt }
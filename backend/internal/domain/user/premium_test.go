@@ -0,0 +1,71 @@
+package user
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPremiumStatus_Extend(t *testing.T) {
+	const day = int64(24 * 60 * 60)
+	now := int64(1_000_000)
+
+	status := NoPremium().Extend(now, 30*day)
+	if !status.IsActiveAt(now) || status.ExpiresAt() != now+30*day {
+		t.Fatalf("first purchase = %+v, want active until now+30d", status)
+	}
+
+	// Renewal before expiry keeps the remaining days
+	renewed := status.Extend(now+10*day, 30*day)
+	if renewed.ExpiresAt() != now+60*day {
+		t.Errorf("early renewal expires at %d, want %d", renewed.ExpiresAt(), now+60*day)
+	}
+
+	// After expiry the new period starts at payment time
+	if status.IsActiveAt(now + 30*day) {
+		t.Error("premium must expire at ExpiresAt")
+	}
+	late := status.Extend(now+40*day, 7*day)
+	if late.ExpiresAt() != now+47*day {
+		t.Errorf("late renewal expires at %d, want %d", late.ExpiresAt(), now+47*day)
+	}
+}
+
+func TestParsePremiumInvoicePayload(t *testing.T) {
+	payload := PremiumInvoicePayload("premium_month", mustUserID("12345"))
+
+	plan, playerID, err := ParsePremiumInvoicePayload(payload)
+	if err != nil {
+		t.Fatalf("ParsePremiumInvoicePayload(%q): %v", payload, err)
+	}
+	if plan.ID != "premium_month" || playerID.String() != "12345" {
+		t.Errorf("parsed plan %q, player %q", plan.ID, playerID)
+	}
+
+	for _, tc := range []struct {
+		payload string
+		wantErr error
+	}{
+		{"premium:premium_lifetime:12345", ErrUnknownPremiumPlan},
+		{"premium:premium_month:", ErrInvalidInvoicePayload},
+		{"shop:premium_month:12345", ErrInvalidInvoicePayload},
+		{"garbage", ErrInvalidInvoicePayload},
+	} {
+		if _, _, err := ParsePremiumInvoicePayload(tc.payload); !errors.Is(err, tc.wantErr) {
+			t.Errorf("ParsePremiumInvoicePayload(%q) err = %v, want %v", tc.payload, err, tc.wantErr)
+		}
+	}
+}
+
+func TestPremiumPlan_CheckPayment(t *testing.T) {
+	plan, _ := FindPremiumPlan("premium_week")
+
+	if err := plan.CheckPayment(StarsCurrency, plan.PriceStars); err != nil {
+		t.Errorf("exact payment err = %v", err)
+	}
+	if err := plan.CheckPayment(StarsCurrency, plan.PriceStars-1); !errors.Is(err, ErrPaymentMismatch) {
+		t.Errorf("underpayment err = %v, want ErrPaymentMismatch", err)
+	}
+	if err := plan.CheckPayment("USD", plan.PriceStars); !errors.Is(err, ErrPaymentMismatch) {
+		t.Errorf("wrong currency err = %v, want ErrPaymentMismatch", err)
+	}
+}
@@ -1,5 +1,7 @@
 package user
 
+import "database/sql"
+
 // UserRepository defines the interface for user persistence
 // NOTE: No context.Context - domain layer is pure
 // Infrastructure implementations add context internally
@@ -31,4 +33,49 @@ type InventoryRepository interface {
 type TransactionRepository interface {
 	Save(tx *TransactionLog) error
 	FindByPlayer(playerID UserID, limit int) ([]TransactionLog, error)
+
+	// FindPage returns up to limit transactions matching the filter, newest first,
+	// starting after the cursor (nil for the newest)
+	FindPage(filter TransactionFilter, after *TransactionCursor, limit int) ([]TransactionLog, error)
+
+	// SummarizeByDay totals the resources of matching transactions per UTC day since the given time, newest day first
+	SummarizeByDay(filter TransactionFilter, since int64) ([]DailyResourceTotal, error)
+}
+
+// InventoryLedger changes inventories together with their transaction log
+type InventoryLedger interface {
+	// Apply locks the player's inventory, applies the transaction to it and logs it, atomically.
+	// Returns ErrDuplicateTransaction, changing nothing, when the player already has a
+	// transaction with the same idempotency key.
+	Apply(tx *TransactionLog) error
+
+	// ApplyInTx is Apply within an existing database transaction, so the balance change
+	// commits or rolls back together with the caller's own writes
+	ApplyInTx(dbTx *sql.Tx, tx *TransactionLog) error
+
+	// Snapshot returns the inventory with its whole transaction log (oldest first), read consistently
+	Snapshot(playerID UserID) (*Inventory, []TransactionLog, error)
+
+	// Correct overwrites the balances of an inventory read by Snapshot with the corrected ones.
+	// Returns ErrInventoryChanged if the balances changed since they were read.
+	Correct(read *Inventory, corrected *Inventory) error
+
+	// FindPlayerIDs lists players that have an inventory, ordered by ID, after afterID
+	FindPlayerIDs(afterID string, limit int) ([]UserID, error)
+}
+
+// PremiumRepository persists premium subscriptions and the payments that bought them
+type PremiumRepository interface {
+	// FindStatus returns the player's premium status (NoPremium if they never had premium)
+	FindStatus(playerID UserID) (PremiumStatus, error)
+
+	// FindStatusForUpdate is FindStatus locking the player's premium row until tx ends
+	FindStatusForUpdate(tx *sql.Tx, playerID UserID) (PremiumStatus, error)
+
+	// SaveStatusInTx persists the player's premium status within tx
+	SaveStatusInTx(tx *sql.Tx, playerID UserID, status PremiumStatus) error
+
+	// SavePaymentInTx records a payment within tx.
+	// Returns ErrDuplicatePremiumPayment if its charge ID was already recorded.
+	SavePaymentInTx(tx *sql.Tx, payment *PremiumPayment) error
 }
@@ -8,12 +8,13 @@ const (
 )
 
 type TransactionLog struct {
-	id        string
-	playerID  UserID
-	txType    TransactionType
-	source    string
-	details   map[string]int
-	createdAt int64
+	id             string
+	playerID       UserID
+	txType         TransactionType
+	source         string
+	details        map[string]int
+	idempotencyKey string
+	createdAt      int64
 }
 
 func NewTransactionLog(
@@ -61,18 +62,27 @@ func ReconstructTransactionLog(
 	txType TransactionType,
 	source string,
 	details map[string]int,
+	idempotencyKey string,
 	createdAt int64,
 ) *TransactionLog {
 	return &TransactionLog{
-		id:        id,
-		playerID:  playerID,
-		txType:    txType,
-		source:    source,
-		details:   details,
-		createdAt: createdAt,
+		id:             id,
+		playerID:       playerID,
+		txType:         txType,
+		source:         source,
+		details:        details,
+		idempotencyKey: idempotencyKey,
+		createdAt:      createdAt,
 	}
 }
 
+// WithIdempotencyKey makes the transaction apply at most once per player and key:
+// a retried request carrying the same key is recognized as a duplicate
+func (t *TransactionLog) WithIdempotencyKey(key string) *TransactionLog {
+	t.idempotencyKey = key
+	return t
+}
+
 func (t *TransactionLog) ID() string              { return t.id }
 func (t *TransactionLog) PlayerID() UserID         { return t.playerID }
 func (t *TransactionLog) Type() TransactionType    { return t.txType }
@@ -86,3 +96,27 @@ func (t *TransactionLog) Details() map[string]int {
 	}
 	return copy
 }
+
+// IdempotencyKey returns the caller-supplied key, or "" if the transaction may repeat
+func (t *TransactionLog) IdempotencyKey() string { return t.idempotencyKey }
+
+// TransactionFilter narrows a player's transaction log
+type TransactionFilter struct {
+	PlayerID UserID
+	Resource string // only transactions that change this resource; "" for all
+	Source   string // only transactions from this source; "" for all
+}
+
+// TransactionCursor is a position in a player's transaction log, which is read newest first
+type TransactionCursor struct {
+	CreatedAt int64
+	ID        string
+}
+
+// DailyResourceTotal is how much of a resource a player received and spent on one UTC day
+type DailyResourceTotal struct {
+	Day      string // YYYY-MM-DD
+	Resource string
+	Credited int
+	Debited  int
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+
+	appAdReward "github.com/barsukov/quiz-sprint/backend/internal/application/ad_reward"
+	domainAdReward "github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// AdRewardHandler handles rewarded ad nonces and the ad network's reward callback
+type AdRewardHandler struct {
+	issueAdRewardUC  *appAdReward.IssueAdRewardUseCase
+	redeemAdRewardUC *appAdReward.RedeemAdRewardUseCase
+}
+
+func NewAdRewardHandler(
+	issueAdRewardUC *appAdReward.IssueAdRewardUseCase,
+	redeemAdRewardUC *appAdReward.RedeemAdRewardUseCase,
+) *AdRewardHandler {
+	return &AdRewardHandler{
+		issueAdRewardUC:  issueAdRewardUC,
+		redeemAdRewardUC: redeemAdRewardUC,
+	}
+}
+
+// IssueRewardNonce handles POST /api/v1/ads/reward-nonce
+// @Summary Get a rewarded ad nonce
+// @Description Issues a one-time nonce to pass to the ad network as custom data. Once the network confirms the view, send it as adNonce with the "ad" payment method of daily retry, streak recovery or marathon continue.
+// @Tags ads
+// @Accept json
+// @Produce json
+// @Param request body IssueAdRewardRequest true "Placement the ad pays for"
+// @Success 200 {object} IssueAdRewardResponse "Nonce issued"
+// @Failure 400 {object} ErrorResponse "Invalid placement"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 429 {object} ErrorResponse "Daily ad reward limit reached"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /ads/reward-nonce [post]
+func (h *AdRewardHandler) IssueRewardNonce(c fiber.Ctx) error {
+	var req IssueAdRewardRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.issueAdRewardUC.Execute(appAdReward.IssueAdRewardInput{
+		PlayerID:  playerID,
+		Placement: req.Placement,
+	})
+	if err != nil {
+		return mapAdRewardError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RewardCallback handles GET /api/v1/ads/callback
+// @Summary Ad network reward callback
+// @Description Server-to-server callback of the ad network confirming a rewarded view. Query parameters are signed with the shared AD_CALLBACK_SECRET; retries of the same transaction are acknowledged again.
+// @Tags ads
+// @Produce json
+// @Param nonce query string true "Reward nonce"
+// @Param user_id query string true "Player the nonce was issued to"
+// @Param transaction_id query string true "Ad network's ID of the rewarded view"
+// @Param signature query string true "Hex HMAC-SHA256 of the other parameters, sorted by key"
+// @Success 200 {object} map[string]interface{} "Reward confirmed"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 403 {object} ErrorResponse "Invalid signature"
+// @Failure 404 {object} ErrorResponse "Nonce not found"
+// @Failure 409 {object} ErrorResponse "Nonce expired, already redeemed or issued to another player"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /ads/callback [get]
+func (h *AdRewardHandler) RewardCallback(c fiber.Ctx) error {
+	output, err := h.redeemAdRewardUC.Execute(appAdReward.RedeemAdRewardInput{Params: c.Queries()})
+	if err != nil {
+		return mapAdRewardError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": fiber.Map{
+		"nonce":     output.Nonce,
+		"duplicate": output.Duplicate,
+	}})
+}
+
+// mapAdRewardError maps ad reward domain errors to HTTP errors
+func mapAdRewardError(err error) error {
+	switch {
+	case errors.Is(err, domainAdReward.ErrInvalidCallbackSignature):
+		return fiber.NewError(fiber.StatusForbidden, "Invalid signature")
+	case errors.Is(err, shared.ErrInvalidUserID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	case errors.Is(err, domainAdReward.ErrInvalidPlacement):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid placement")
+	case errors.Is(err, domainAdReward.ErrInvalidNonce):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid nonce")
+	case errors.Is(err, domainAdReward.ErrInvalidTransactionID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid transaction ID")
+	case errors.Is(err, domainAdReward.ErrNonceNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Nonce not found")
+	case errors.Is(err, domainAdReward.ErrNonceExpired):
+		return fiber.NewError(fiber.StatusConflict, "Nonce expired")
+	case errors.Is(err, domainAdReward.ErrNonceAlreadyRedeemed):
+		return fiber.NewError(fiber.StatusConflict, "Nonce already redeemed")
+	case errors.Is(err, domainAdReward.ErrNonceMismatch):
+		return fiber.NewError(fiber.StatusConflict, "Nonce issued to another player")
+	case errors.Is(err, domainAdReward.ErrDailyCapReached):
+		return fiber.NewError(fiber.StatusTooManyRequests, "Daily ad reward limit reached")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+}
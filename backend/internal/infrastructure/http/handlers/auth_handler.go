@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/middleware"
+)
+
+// AuthHandler issues session tokens in exchange for Telegram init data
+type AuthHandler struct {
+	createSessionUC  *appUser.CreateSessionUseCase
+	refreshSessionUC *appUser.RefreshSessionUseCase
+}
+
+// NewAuthHandler creates a new AuthHandler
+func NewAuthHandler(
+	createSessionUC *appUser.CreateSessionUseCase,
+	refreshSessionUC *appUser.RefreshSessionUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		createSessionUC:  createSessionUC,
+		refreshSessionUC: refreshSessionUC,
+	}
+}
+
+// CreateSession handles POST /api/v1/auth/session
+// @Summary Create session
+// @Description Exchange valid Telegram init data for a short-lived access token and a refresh token. Send the access token as "Authorization: Bearer <token>" (or the "auth" query parameter on WebSockets).
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security TelegramAuth
+// @Success 201 {object} SessionTokensResponse "Session created"
+// @Failure 401 {object} ErrorResponse "Invalid or missing Telegram init data"
+// @Failure 403 {object} ErrorResponse "User is blocked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /auth/session [post]
+func (h *AuthHandler) CreateSession(c fiber.Ctx) error {
+	// Only init data may open a session: an access token must not renew itself
+	if middleware.GetTelegramInitData(c) == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "Telegram init data required")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.createSessionUC.Execute(appUser.CreateSessionInput{
+		UserID:   playerID,
+		Username: getAuthUsername(c),
+	})
+	if err != nil {
+		return mapSessionError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// RefreshSession handles POST /api/v1/auth/refresh
+// @Summary Refresh session
+// @Description Trade a refresh token for a new access + refresh token pair
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body RefreshSessionRequest true "Refresh token"
+// @Success 200 {object} SessionTokensResponse "Session refreshed"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Invalid or expired refresh token"
+// @Failure 403 {object} ErrorResponse "User is blocked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) RefreshSession(c fiber.Ctx) error {
+	var req RefreshSessionRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	if req.RefreshToken == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "refreshToken is required")
+	}
+
+	output, err := h.refreshSessionUC.Execute(appUser.RefreshSessionInput{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		return mapSessionError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapSessionError maps session errors to HTTP errors
+func mapSessionError(err error) error {
+	switch err {
+	case domainUser.ErrInvalidSessionToken:
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid session token")
+	case domainUser.ErrSessionExpired:
+		return fiber.NewError(fiber.StatusUnauthorized, "Session token expired")
+	default:
+		return mapUserError(err)
+	}
+}
@@ -61,7 +61,7 @@ func (h *CategoryHandler) CreateCategory(c fiber.Ctx) error {
 // @Failure 500 {object} handlers.ErrorResponse "Internal server error"
 // @Router /categories [get]
 func (h *CategoryHandler) GetAllCategories(c fiber.Ctx) error {
-	output, err := h.listCategoriesUC.Execute(appQuiz.ListCategoriesInput{})
+	output, err := h.listCategoriesUC.Execute(appQuiz.ListCategoriesInput{Locale: getRequestLocale(c)})
 	if err != nil {
 		return mapError(err)
 	}
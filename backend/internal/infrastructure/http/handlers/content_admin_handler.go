@@ -0,0 +1,691 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
+	domainQuiz "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// ContentAdminHandler lets admins edit the quiz catalog: quizzes, questions,
+// answers, categories and tags. Deletes are soft and every edit is audited.
+type ContentAdminHandler struct {
+	listAuditUC       *appQuiz.ListContentAuditUseCase
+	getQuizUC         *appQuiz.AdminGetQuizUseCase
+	createQuizUC      *appQuiz.AdminCreateQuizUseCase
+	updateQuizUC      *appQuiz.AdminUpdateQuizUseCase
+	deleteQuizUC      *appQuiz.AdminDeleteQuizUseCase
+	restoreQuizUC     *appQuiz.AdminRestoreQuizUseCase
+	getQuestionUC     *appQuiz.AdminGetQuestionUseCase
+	createQuestionUC  *appQuiz.AdminCreateQuestionUseCase
+	updateQuestionUC  *appQuiz.AdminUpdateQuestionUseCase
+	deleteQuestionUC  *appQuiz.AdminDeleteQuestionUseCase
+	restoreQuestionUC *appQuiz.AdminRestoreQuestionUseCase
+	deleteAnswerUC    *appQuiz.AdminDeleteAnswerUseCase
+	restoreAnswerUC   *appQuiz.AdminRestoreAnswerUseCase
+	createCategoryUC  *appQuiz.AdminCreateCategoryUseCase
+	updateCategoryUC  *appQuiz.AdminUpdateCategoryUseCase
+	deleteCategoryUC  *appQuiz.AdminDeleteCategoryUseCase
+	restoreCategoryUC *appQuiz.AdminRestoreCategoryUseCase
+	createTagUC       *appQuiz.AdminCreateTagUseCase
+	renameTagUC       *appQuiz.AdminRenameTagUseCase
+	deleteTagUC       *appQuiz.AdminDeleteTagUseCase
+	restoreTagUC      *appQuiz.AdminRestoreTagUseCase
+}
+
+// NewContentAdminHandler creates a new ContentAdminHandler
+func NewContentAdminHandler(
+	listAuditUC *appQuiz.ListContentAuditUseCase,
+	getQuizUC *appQuiz.AdminGetQuizUseCase,
+	createQuizUC *appQuiz.AdminCreateQuizUseCase,
+	updateQuizUC *appQuiz.AdminUpdateQuizUseCase,
+	deleteQuizUC *appQuiz.AdminDeleteQuizUseCase,
+	restoreQuizUC *appQuiz.AdminRestoreQuizUseCase,
+	getQuestionUC *appQuiz.AdminGetQuestionUseCase,
+	createQuestionUC *appQuiz.AdminCreateQuestionUseCase,
+	updateQuestionUC *appQuiz.AdminUpdateQuestionUseCase,
+	deleteQuestionUC *appQuiz.AdminDeleteQuestionUseCase,
+	restoreQuestionUC *appQuiz.AdminRestoreQuestionUseCase,
+	deleteAnswerUC *appQuiz.AdminDeleteAnswerUseCase,
+	restoreAnswerUC *appQuiz.AdminRestoreAnswerUseCase,
+	createCategoryUC *appQuiz.AdminCreateCategoryUseCase,
+	updateCategoryUC *appQuiz.AdminUpdateCategoryUseCase,
+	deleteCategoryUC *appQuiz.AdminDeleteCategoryUseCase,
+	restoreCategoryUC *appQuiz.AdminRestoreCategoryUseCase,
+	createTagUC *appQuiz.AdminCreateTagUseCase,
+	renameTagUC *appQuiz.AdminRenameTagUseCase,
+	deleteTagUC *appQuiz.AdminDeleteTagUseCase,
+	restoreTagUC *appQuiz.AdminRestoreTagUseCase,
+) *ContentAdminHandler {
+	return &ContentAdminHandler{
+		listAuditUC:       listAuditUC,
+		getQuizUC:         getQuizUC,
+		createQuizUC:      createQuizUC,
+		updateQuizUC:      updateQuizUC,
+		deleteQuizUC:      deleteQuizUC,
+		restoreQuizUC:     restoreQuizUC,
+		getQuestionUC:     getQuestionUC,
+		createQuestionUC:  createQuestionUC,
+		updateQuestionUC:  updateQuestionUC,
+		deleteQuestionUC:  deleteQuestionUC,
+		restoreQuestionUC: restoreQuestionUC,
+		deleteAnswerUC:    deleteAnswerUC,
+		restoreAnswerUC:   restoreAnswerUC,
+		createCategoryUC:  createCategoryUC,
+		updateCategoryUC:  updateCategoryUC,
+		deleteCategoryUC:  deleteCategoryUC,
+		restoreCategoryUC: restoreCategoryUC,
+		createTagUC:       createTagUC,
+		renameTagUC:       renameTagUC,
+		deleteTagUC:       deleteTagUC,
+		restoreTagUC:      restoreTagUC,
+	}
+}
+
+// contentActor is who made an admin edit, for the audit trail
+func contentActor(c fiber.Ctx) string {
+	return c.Get("X-Admin-Actor")
+}
+
+// ========================================
+// Audit
+// ========================================
+
+// ListAudit handles GET /api/v1/admin/content/audit
+// @Summary List content audit trail
+// @Description Admin edits of catalog content, newest first: one entity's (entityType and entityId) or the latest overall
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param entityType query string false "quiz, question, answer, category or tag"
+// @Param entityId query string false "Entity ID (with entityType)"
+// @Param limit query int false "Limit (default 50, max 200)"
+// @Success 200 {object} AdminContentAuditResponse "Audit entries"
+// @Failure 400 {object} ErrorResponse "Invalid filter"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /admin/content/audit [get]
+func (h *ContentAdminHandler) ListAudit(c fiber.Ctx) error {
+	output, err := h.listAuditUC.Execute(appQuiz.ListContentAuditInput{
+		EntityType: c.Query("entityType"),
+		EntityID:   c.Query("entityId"),
+		Limit:      fiber.Query[int](c, "limit", 50),
+	})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// ========================================
+// Quizzes
+// ========================================
+
+// GetQuiz handles GET /api/v1/admin/content/quizzes/:quizId
+// @Summary Get quiz for editing
+// @Description Quiz with its questions and answer keys; deleted quizzes are included
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param quizId path string true "Quiz ID"
+// @Success 200 {object} AdminContentQuizResponse "Quiz"
+// @Failure 404 {object} ErrorResponse "Quiz not found"
+// @Router /admin/content/quizzes/{quizId} [get]
+func (h *ContentAdminHandler) GetQuiz(c fiber.Ctx) error {
+	output, err := h.getQuizUC.Execute(appQuiz.AdminQuizRefInput{QuizID: c.Params("quizId")})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// CreateQuiz handles POST /api/v1/admin/content/quizzes
+// @Summary Create quiz
+// @Description Create a quiz; questions are added separately
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param request body AdminContentQuizRequest true "Quiz"
+// @Success 201 {object} AdminContentQuizResponse "Quiz created"
+// @Failure 400 {object} ErrorResponse "Invalid quiz"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Category or tag deleted"
+// @Router /admin/content/quizzes [post]
+func (h *ContentAdminHandler) CreateQuiz(c fiber.Ctx) error {
+	var req appQuiz.AdminCreateQuizInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.Actor = contentActor(c)
+
+	output, err := h.createQuizUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// UpdateQuiz handles PUT /api/v1/admin/content/quizzes/:quizId
+// @Summary Update quiz
+// @Description Replace a quiz's details, tags and translations. An empty categoryId keeps the category.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param quizId path string true "Quiz ID"
+// @Param request body AdminContentQuizRequest true "Quiz"
+// @Success 200 {object} AdminContentQuizResponse "Quiz updated"
+// @Failure 400 {object} ErrorResponse "Invalid quiz"
+// @Failure 404 {object} ErrorResponse "Quiz or category not found"
+// @Failure 409 {object} ErrorResponse "Quiz, category or tag deleted"
+// @Router /admin/content/quizzes/{quizId} [put]
+func (h *ContentAdminHandler) UpdateQuiz(c fiber.Ctx) error {
+	var req appQuiz.AdminUpdateQuizInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.QuizID = c.Params("quizId")
+	req.Actor = contentActor(c)
+
+	output, err := h.updateQuizUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// DeleteQuiz handles DELETE /api/v1/admin/content/quizzes/:quizId
+// @Summary Delete quiz
+// @Description Soft-delete a quiz: players no longer see or start it, history is kept
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param quizId path string true "Quiz ID"
+// @Success 200 {object} AdminContentQuizResponse "Quiz deleted"
+// @Failure 404 {object} ErrorResponse "Quiz not found"
+// @Failure 409 {object} ErrorResponse "Quiz already deleted"
+// @Router /admin/content/quizzes/{quizId} [delete]
+func (h *ContentAdminHandler) DeleteQuiz(c fiber.Ctx) error {
+	output, err := h.deleteQuizUC.Execute(appQuiz.AdminQuizRefInput{QuizID: c.Params("quizId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RestoreQuiz handles POST /api/v1/admin/content/quizzes/:quizId/restore
+// @Summary Restore quiz
+// @Description Undo a quiz's soft delete
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param quizId path string true "Quiz ID"
+// @Success 200 {object} AdminContentQuizResponse "Quiz restored"
+// @Failure 404 {object} ErrorResponse "Quiz not found"
+// @Failure 409 {object} ErrorResponse "Quiz not deleted"
+// @Router /admin/content/quizzes/{quizId}/restore [post]
+func (h *ContentAdminHandler) RestoreQuiz(c fiber.Ctx) error {
+	output, err := h.restoreQuizUC.Execute(appQuiz.AdminQuizRefInput{QuizID: c.Params("quizId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// ========================================
+// Questions and answers
+// ========================================
+
+// GetQuestion handles GET /api/v1/admin/content/questions/:questionId
+// @Summary Get question for editing
+// @Description Question with its answer key; deleted questions are included
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param questionId path string true "Question ID"
+// @Success 200 {object} AdminContentQuestionResponse "Question"
+// @Failure 404 {object} ErrorResponse "Question not found"
+// @Router /admin/content/questions/{questionId} [get]
+func (h *ContentAdminHandler) GetQuestion(c fiber.Ctx) error {
+	output, err := h.getQuestionUC.Execute(appQuiz.AdminQuestionRefInput{QuestionID: c.Params("questionId")})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// CreateQuestion handles POST /api/v1/admin/content/quizzes/:quizId/questions
+// @Summary Create question
+// @Description Add a question to a quiz, after its last question unless a position is given
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param quizId path string true "Quiz ID"
+// @Param request body AdminContentQuestionRequest true "Question"
+// @Success 201 {object} AdminContentQuestionResponse "Question created"
+// @Failure 400 {object} ErrorResponse "Invalid question"
+// @Failure 404 {object} ErrorResponse "Quiz not found"
+// @Failure 409 {object} ErrorResponse "Quiz deleted"
+// @Router /admin/content/quizzes/{quizId}/questions [post]
+func (h *ContentAdminHandler) CreateQuestion(c fiber.Ctx) error {
+	var req appQuiz.AdminCreateQuestionInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.QuizID = c.Params("quizId")
+	req.Actor = contentActor(c)
+
+	output, err := h.createQuestionUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// UpdateQuestion handles PUT /api/v1/admin/content/questions/:questionId
+// @Summary Update question
+// @Description Replace a question's content and answers. Answers sent with their ID are kept, ones without are created, and ones left out are soft-deleted.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param questionId path string true "Question ID"
+// @Param request body AdminContentQuestionRequest true "Question"
+// @Success 200 {object} AdminContentQuestionResponse "Question updated"
+// @Failure 400 {object} ErrorResponse "Invalid question"
+// @Failure 404 {object} ErrorResponse "Question or answer not found"
+// @Failure 409 {object} ErrorResponse "Question deleted"
+// @Router /admin/content/questions/{questionId} [put]
+func (h *ContentAdminHandler) UpdateQuestion(c fiber.Ctx) error {
+	var req appQuiz.AdminUpdateQuestionInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.QuestionID = c.Params("questionId")
+	req.Actor = contentActor(c)
+
+	output, err := h.updateQuestionUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// DeleteQuestion handles DELETE /api/v1/admin/content/questions/:questionId
+// @Summary Delete question
+// @Description Soft-delete a question: games no longer pick it, history is kept
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param questionId path string true "Question ID"
+// @Success 200 {object} AdminContentQuestionResponse "Question deleted"
+// @Failure 404 {object} ErrorResponse "Question not found"
+// @Failure 409 {object} ErrorResponse "Question already deleted"
+// @Router /admin/content/questions/{questionId} [delete]
+func (h *ContentAdminHandler) DeleteQuestion(c fiber.Ctx) error {
+	output, err := h.deleteQuestionUC.Execute(appQuiz.AdminQuestionRefInput{QuestionID: c.Params("questionId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RestoreQuestion handles POST /api/v1/admin/content/questions/:questionId/restore
+// @Summary Restore question
+// @Description Undo a question's soft delete
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param questionId path string true "Question ID"
+// @Success 200 {object} AdminContentQuestionResponse "Question restored"
+// @Failure 404 {object} ErrorResponse "Question not found"
+// @Failure 409 {object} ErrorResponse "Question not deleted"
+// @Router /admin/content/questions/{questionId}/restore [post]
+func (h *ContentAdminHandler) RestoreQuestion(c fiber.Ctx) error {
+	output, err := h.restoreQuestionUC.Execute(appQuiz.AdminQuestionRefInput{QuestionID: c.Params("questionId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// DeleteAnswer handles DELETE /api/v1/admin/content/questions/:questionId/answers/:answerId
+// @Summary Delete answer
+// @Description Soft-delete an answer option; the remaining answers must still fit the question type
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param questionId path string true "Question ID"
+// @Param answerId path string true "Answer ID"
+// @Success 200 {object} AdminContentQuestionResponse "Answer deleted"
+// @Failure 400 {object} ErrorResponse "Answers would no longer fit the question type"
+// @Failure 404 {object} ErrorResponse "Question or answer not found"
+// @Failure 409 {object} ErrorResponse "Question deleted"
+// @Router /admin/content/questions/{questionId}/answers/{answerId} [delete]
+func (h *ContentAdminHandler) DeleteAnswer(c fiber.Ctx) error {
+	output, err := h.deleteAnswerUC.Execute(appQuiz.AdminAnswerRefInput{
+		QuestionID: c.Params("questionId"),
+		AnswerID:   c.Params("answerId"),
+		Actor:      contentActor(c),
+	})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RestoreAnswer handles POST /api/v1/admin/content/questions/:questionId/answers/:answerId/restore
+// @Summary Restore answer
+// @Description Undo an answer option's soft delete
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param questionId path string true "Question ID"
+// @Param answerId path string true "Answer ID"
+// @Success 200 {object} AdminContentQuestionResponse "Answer restored"
+// @Failure 400 {object} ErrorResponse "Answers would no longer fit the question type"
+// @Failure 404 {object} ErrorResponse "Question or deleted answer not found"
+// @Failure 409 {object} ErrorResponse "Question deleted"
+// @Router /admin/content/questions/{questionId}/answers/{answerId}/restore [post]
+func (h *ContentAdminHandler) RestoreAnswer(c fiber.Ctx) error {
+	output, err := h.restoreAnswerUC.Execute(appQuiz.AdminAnswerRefInput{
+		QuestionID: c.Params("questionId"),
+		AnswerID:   c.Params("answerId"),
+		Actor:      contentActor(c),
+	})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// ========================================
+// Categories
+// ========================================
+
+// CreateCategory handles POST /api/v1/admin/content/categories
+// @Summary Create category
+// @Description Create a category with its name translations
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param request body AdminContentCategoryRequest true "Category"
+// @Success 201 {object} AdminContentCategoryResponse "Category created"
+// @Failure 400 {object} ErrorResponse "Invalid category"
+// @Router /admin/content/categories [post]
+func (h *ContentAdminHandler) CreateCategory(c fiber.Ctx) error {
+	var req appQuiz.AdminCreateCategoryInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.Actor = contentActor(c)
+
+	output, err := h.createCategoryUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// UpdateCategory handles PUT /api/v1/admin/content/categories/:categoryId
+// @Summary Update category
+// @Description Replace a category's name and translations
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param categoryId path string true "Category ID"
+// @Param request body AdminContentCategoryRequest true "Category"
+// @Success 200 {object} AdminContentCategoryResponse "Category updated"
+// @Failure 400 {object} ErrorResponse "Invalid category"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Category deleted"
+// @Router /admin/content/categories/{categoryId} [put]
+func (h *ContentAdminHandler) UpdateCategory(c fiber.Ctx) error {
+	var req appQuiz.AdminUpdateCategoryInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.CategoryID = c.Params("categoryId")
+	req.Actor = contentActor(c)
+
+	output, err := h.updateCategoryUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// DeleteCategory handles DELETE /api/v1/admin/content/categories/:categoryId
+// @Summary Delete category
+// @Description Soft-delete a category: it drops out of the category list, its quizzes stay playable
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param categoryId path string true "Category ID"
+// @Success 200 {object} AdminContentCategoryResponse "Category deleted"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Category already deleted"
+// @Router /admin/content/categories/{categoryId} [delete]
+func (h *ContentAdminHandler) DeleteCategory(c fiber.Ctx) error {
+	output, err := h.deleteCategoryUC.Execute(appQuiz.AdminCategoryRefInput{CategoryID: c.Params("categoryId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RestoreCategory handles POST /api/v1/admin/content/categories/:categoryId/restore
+// @Summary Restore category
+// @Description Undo a category's soft delete
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param categoryId path string true "Category ID"
+// @Success 200 {object} AdminContentCategoryResponse "Category restored"
+// @Failure 404 {object} ErrorResponse "Category not found"
+// @Failure 409 {object} ErrorResponse "Category not deleted"
+// @Router /admin/content/categories/{categoryId}/restore [post]
+func (h *ContentAdminHandler) RestoreCategory(c fiber.Ctx) error {
+	output, err := h.restoreCategoryUC.Execute(appQuiz.AdminCategoryRefInput{CategoryID: c.Params("categoryId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// ========================================
+// Tags
+// ========================================
+
+// CreateTag handles POST /api/v1/admin/content/tags
+// @Summary Create tag
+// @Description Create a tag ({category}:{value})
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param request body AdminContentTagRequest true "Tag"
+// @Success 201 {object} AdminContentTagResponse "Tag created"
+// @Failure 400 {object} ErrorResponse "Invalid tag name"
+// @Failure 409 {object} ErrorResponse "Tag already exists"
+// @Router /admin/content/tags [post]
+func (h *ContentAdminHandler) CreateTag(c fiber.Ctx) error {
+	var req appQuiz.AdminCreateTagInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.Actor = contentActor(c)
+
+	output, err := h.createTagUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// RenameTag handles PUT /api/v1/admin/content/tags/:tagId
+// @Summary Rename tag
+// @Description Rename a tag on every quiz that has it
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param tagId path string true "Tag ID"
+// @Param request body AdminContentTagRequest true "Tag"
+// @Success 200 {object} AdminContentTagResponse "Tag renamed"
+// @Failure 400 {object} ErrorResponse "Invalid tag name"
+// @Failure 404 {object} ErrorResponse "Tag not found"
+// @Failure 409 {object} ErrorResponse "Tag deleted or name taken"
+// @Router /admin/content/tags/{tagId} [put]
+func (h *ContentAdminHandler) RenameTag(c fiber.Ctx) error {
+	var req appQuiz.AdminRenameTagInput
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	req.TagID = c.Params("tagId")
+	req.Actor = contentActor(c)
+
+	output, err := h.renameTagUC.Execute(req)
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// DeleteTag handles DELETE /api/v1/admin/content/tags/:tagId
+// @Summary Delete tag
+// @Description Soft-delete a tag: quizzes stop showing it until it is restored
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param tagId path string true "Tag ID"
+// @Success 200 {object} AdminContentTagResponse "Tag deleted"
+// @Failure 404 {object} ErrorResponse "Tag not found"
+// @Failure 409 {object} ErrorResponse "Tag already deleted"
+// @Router /admin/content/tags/{tagId} [delete]
+func (h *ContentAdminHandler) DeleteTag(c fiber.Ctx) error {
+	output, err := h.deleteTagUC.Execute(appQuiz.AdminTagRefInput{TagID: c.Params("tagId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RestoreTag handles POST /api/v1/admin/content/tags/:tagId/restore
+// @Summary Restore tag
+// @Description Undo a tag's soft delete; it is back on the quizzes that had it
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Admin-Actor header string false "Who makes the edit (audit trail)"
+// @Param tagId path string true "Tag ID"
+// @Success 200 {object} AdminContentTagResponse "Tag restored"
+// @Failure 404 {object} ErrorResponse "Tag not found"
+// @Failure 409 {object} ErrorResponse "Tag not deleted"
+// @Router /admin/content/tags/{tagId}/restore [post]
+func (h *ContentAdminHandler) RestoreTag(c fiber.Ctx) error {
+	output, err := h.restoreTagUC.Execute(appQuiz.AdminTagRefInput{TagID: c.Params("tagId"), Actor: contentActor(c)})
+	if err != nil {
+		return mapContentAdminError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapContentAdminError maps catalog editing errors, then falls back to mapError
+func mapContentAdminError(err error) error {
+	switch err {
+	// Soft delete state and name conflicts
+	case domainQuiz.ErrQuizDeleted,
+		domainQuiz.ErrQuizNotDeleted,
+		domainQuiz.ErrQuestionDeleted,
+		domainQuiz.ErrQuestionNotDeleted,
+		domainQuiz.ErrCategoryDeleted,
+		domainQuiz.ErrCategoryNotDeleted,
+		domainQuiz.ErrTagDeleted,
+		domainQuiz.ErrTagNotDeleted,
+		domainQuiz.ErrTagAlreadyExists:
+		return fiber.NewError(fiber.StatusConflict, err.Error())
+
+	case domainQuiz.ErrTagNotFound:
+		return fiber.NewError(fiber.StatusNotFound, "Tag not found")
+
+	// Content validation
+	case domainQuiz.ErrInvalidCategoryID,
+		domainQuiz.ErrTitleTooLong,
+		domainQuiz.ErrTimeLimitTooHigh,
+		domainQuiz.ErrInvalidQuestionText,
+		domainQuiz.ErrQuestionTextTooLong,
+		domainQuiz.ErrInvalidAnswerText,
+		domainQuiz.ErrAnswerTextTooLong,
+		domainQuiz.ErrNegativePoints,
+		domainQuiz.ErrPointsTooHigh,
+		domainQuiz.ErrInvalidDifficulty,
+		domainQuiz.ErrInvalidExplanation,
+		domainQuiz.ErrExplanationTooLong,
+		domainQuiz.ErrInvalidSourceURL,
+		domainQuiz.ErrInvalidMedia,
+		domainQuiz.ErrInvalidMediaURL,
+		domainQuiz.ErrInvalidMediaAssetKey,
+		domainQuiz.ErrInvalidMediaSize,
+		domainQuiz.ErrInvalidMediaAltText,
+		domainQuiz.ErrInvalidLocale,
+		domainQuiz.ErrInvalidQuestionType,
+		domainQuiz.ErrInvalidNumericAnswer,
+		domainQuiz.ErrInvalidQuestionAnswers,
+		domainQuiz.ErrTooManyQuestions,
+		domainQuiz.ErrTooManyAnswers,
+		domainQuiz.ErrTooManyTags,
+		domainQuiz.ErrEmptyTagName,
+		domainQuiz.ErrTagNameTooLong,
+		domainQuiz.ErrInvalidTagFormat,
+		domainQuiz.ErrInvalidTagCategory,
+		domainQuiz.ErrTagNameHasSpaces,
+		domainQuiz.ErrTagNameHasUppercase,
+		domainQuiz.ErrTagMissingColon,
+		domainQuiz.ErrInvalidAuditEntry:
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return mapError(err)
+}
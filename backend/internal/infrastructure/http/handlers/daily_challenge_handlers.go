@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v3"
 
 	appDaily "github.com/barsukov/quiz-sprint/backend/internal/application/daily_challenge"
+	domainAdReward "github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
 	domainDaily "github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
 	domainQuiz "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
@@ -54,8 +55,11 @@ func NewDailyChallengeHandler(
 // @Param request body StartDailyChallengeRequest true "Start request"
 // @Success 201 {object} StartDailyChallengeResponse "Challenge started"
 // @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 409 {object} ErrorResponse "Already played today"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/start [post]
 func (h *DailyChallengeHandler) StartDailyChallenge(c fiber.Ctx) error {
 	var req StartDailyChallengeRequest
@@ -63,13 +67,15 @@ func (h *DailyChallengeHandler) StartDailyChallenge(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
 
 	output, err := h.startChallengeUC.Execute(appDaily.StartDailyChallengeInput{
-		PlayerID: req.PlayerID,
+		PlayerID: playerID,
 		Date:     req.Date,
+		Locale:   getRequestLocale(c),
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -88,8 +94,11 @@ func (h *DailyChallengeHandler) StartDailyChallenge(c fiber.Ctx) error {
 // @Param request body SubmitDailyAnswerRequest true "Submit request"
 // @Success 200 {object} SubmitDailyAnswerResponse "Answer submitted"
 // @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/{gameId}/answer [post]
 func (h *DailyChallengeHandler) SubmitDailyAnswer(c fiber.Ctx) error {
 	var req SubmitDailyAnswerRequest
@@ -97,7 +106,12 @@ func (h *DailyChallengeHandler) SubmitDailyAnswer(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.QuestionID == "" || req.AnswerID == "" || req.PlayerID == "" {
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	if req.QuestionID == "" || !hasAnswer(req.AnswerID, req.AnswerIDs, req.NumericAnswer) {
 		return fiber.NewError(fiber.StatusBadRequest, "Missing required fields")
 	}
 
@@ -105,8 +119,12 @@ func (h *DailyChallengeHandler) SubmitDailyAnswer(c fiber.Ctx) error {
 		GameID:     c.Params("gameId"),
 		QuestionID: req.QuestionID,
 		AnswerID:   req.AnswerID,
-		PlayerID:   req.PlayerID,
+		PlayerID:   playerID,
 		TimeTaken:  req.TimeTaken,
+		Locale:     getRequestLocale(c),
+
+		AnswerIDs:     req.AnswerIDs,
+		NumericAnswer: req.NumericAnswer,
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -121,21 +139,25 @@ func (h *DailyChallengeHandler) SubmitDailyAnswer(c fiber.Ctx) error {
 // @Tags daily-challenge
 // @Accept json
 // @Produce json
-// @Param playerId query string true "Player ID"
+// @Param playerId query string false "Player ID (optional; must match the authenticated user)"
 // @Param date query string false "Date (YYYY-MM-DD, defaults to today)"
 // @Success 200 {object} GetDailyStatusResponse "Status"
 // @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/status [get]
 func (h *DailyChallengeHandler) GetDailyStatus(c fiber.Ctx) error {
-	playerID := c.Query("playerId")
-	if playerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, c.Query("playerId"))
+	if err != nil {
+		return err
 	}
 
 	output, err := h.getStatusUC.Execute(appDaily.GetDailyGameStatusInput{
 		PlayerID: playerID,
 		Date:     c.Query("date"),
+		Locale:   getRequestLocale(c),
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -185,15 +207,18 @@ func (h *DailyChallengeHandler) GetDailyLeaderboard(c fiber.Ctx) error {
 // @Tags daily-challenge
 // @Accept json
 // @Produce json
-// @Param playerId query string true "Player ID"
+// @Param playerId query string false "Player ID (optional; must match the authenticated user)"
 // @Success 200 {object} GetPlayerStreakResponse "Streak info"
 // @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/streak [get]
 func (h *DailyChallengeHandler) GetPlayerStreak(c fiber.Ctx) error {
-	playerID := c.Query("playerId")
-	if playerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, c.Query("playerId"))
+	if err != nil {
+		return err
 	}
 
 	output, err := h.getStreakUC.Execute(appDaily.GetPlayerStreakInput{
@@ -216,8 +241,11 @@ func (h *DailyChallengeHandler) GetPlayerStreak(c fiber.Ctx) error {
 // @Param request body OpenChestRequest true "Open chest request"
 // @Success 200 {object} OpenChestResponse "Chest opened"
 // @Failure 400 {object} ErrorResponse "Game not completed"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/{gameId}/chest/open [post]
 func (h *DailyChallengeHandler) OpenChest(c fiber.Ctx) error {
 	var req OpenChestRequest
@@ -225,13 +253,14 @@ func (h *DailyChallengeHandler) OpenChest(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
 
 	output, err := h.openChestUC.Execute(appDaily.OpenChestInput{
 		GameID:   c.Params("gameId"),
-		PlayerID: req.PlayerID,
+		PlayerID: playerID,
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -248,11 +277,16 @@ func (h *DailyChallengeHandler) OpenChest(c fiber.Ctx) error {
 // @Produce json
 // @Param gameId path string true "Original Game ID"
 // @Param request body RetryChallengeRequest true "Retry request"
+// @Param Idempotency-Key header string false "Client-generated key: a retried request is charged once"
 // @Success 201 {object} RetryChallengeResponse "Retry started"
 // @Failure 400 {object} ErrorResponse "Invalid request or insufficient coins"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
 // @Failure 409 {object} ErrorResponse "Retry limit reached"
+// @Failure 429 {object} ErrorResponse "Daily ad reward limit reached"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/{gameId}/retry [post]
 func (h *DailyChallengeHandler) RetryChallenge(c fiber.Ctx) error {
 	var req RetryChallengeRequest
@@ -260,18 +294,31 @@ func (h *DailyChallengeHandler) RetryChallenge(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" || req.PaymentMethod == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "Missing required fields")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	if req.PaymentMethod == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "paymentMethod is required")
 	}
 
 	if req.PaymentMethod != "coins" && req.PaymentMethod != "ad" {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid payment method")
 	}
 
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
+
 	output, err := h.retryUC.Execute(appDaily.RetryChallengeInput{
-		GameID:        c.Params("gameId"),
-		PlayerID:      req.PlayerID,
-		PaymentMethod: req.PaymentMethod,
+		GameID:         c.Params("gameId"),
+		PlayerID:       playerID,
+		PaymentMethod:  req.PaymentMethod,
+		AdNonce:        req.AdNonce,
+		IdempotencyKey: idempotencyKey,
+		Locale:         getRequestLocale(c),
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -287,29 +334,41 @@ func (h *DailyChallengeHandler) RetryChallenge(c fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param request body RecoverStreakRequest true "Recover streak request"
+// @Param Idempotency-Key header string false "Client-generated key: a retried request is charged once"
 // @Success 200 {object} RecoverStreakResponse "Streak recovered"
 // @Failure 400 {object} ErrorResponse "Invalid request or insufficient coins"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 409 {object} ErrorResponse "Streak not recoverable"
+// @Failure 429 {object} ErrorResponse "Daily ad reward limit reached"
 // @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
 // @Router /daily-challenge/streak/recover [post]
 func (h *DailyChallengeHandler) RecoverStreak(c fiber.Ctx) error {
-	var req struct {
-		PlayerID      string `json:"playerId"`
-		PaymentMethod string `json:"paymentMethod"`
-	}
+	var req RecoverStreakRequest
 	if err := c.Bind().Body(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
-	if req.PlayerID == "" || req.PaymentMethod == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "Missing required fields")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
+	}
+	if req.PaymentMethod == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "paymentMethod is required")
 	}
 	if req.PaymentMethod != "coins" && req.PaymentMethod != "ad" {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid payment method")
 	}
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
 
 	output, err := h.recoverStreakUC.Execute(appDaily.RecoverStreakInput{
-		PlayerID:      req.PlayerID,
-		PaymentMethod: req.PaymentMethod,
+		PlayerID:       playerID,
+		PaymentMethod:  req.PaymentMethod,
+		AdNonce:        req.AdNonce,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
 		return mapDailyChallengeError(err)
@@ -336,10 +395,18 @@ func mapDailyChallengeError(err error) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Game not active")
 	case domainDaily.ErrStreakNotRecoverable:
 		return fiber.NewError(fiber.StatusConflict, "Streak is not recoverable")
+	case domainDaily.ErrInvalidPaymentMethod:
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid payment method")
+	case domainDaily.ErrAdNotVerified:
+		return fiber.NewError(fiber.StatusBadRequest, "Rewarded ad not verified")
+	case domainAdReward.ErrDailyCapReached:
+		return fiber.NewError(fiber.StatusTooManyRequests, "Daily ad reward limit reached")
 	case domainQuiz.ErrQuestionNotFound:
 		return fiber.NewError(fiber.StatusNotFound, "Question not found")
 	case domainQuiz.ErrAnswerNotFound:
 		return fiber.NewError(fiber.StatusNotFound, "Answer not found")
+	case domainQuiz.ErrInvalidSubmission:
+		return fiber.NewError(fiber.StatusBadRequest, "Answer does not fit the question type")
 	case domainQuiz.ErrAlreadyAnswered:
 		return fiber.NewError(fiber.StatusConflict, "Question already answered")
 	default:
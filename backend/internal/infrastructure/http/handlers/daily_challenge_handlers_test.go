@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,6 +19,7 @@ import (
 	domainQuiz "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/middleware"
 )
 
 // ========================================
@@ -79,6 +81,10 @@ func (m *mockDailyGameRepo) Save(game *domainDaily.DailyGame) error {
 	return nil
 }
 
+func (m *mockDailyGameRepo) SaveInTx(_ *sql.Tx, game *domainDaily.DailyGame) error {
+	return m.Save(game)
+}
+
 func (m *mockDailyGameRepo) FindByID(id domainDaily.GameID) (*domainDaily.DailyGame, error) {
 	if g, ok := m.games[id.String()]; ok {
 		return g, nil
@@ -249,7 +255,8 @@ func (m *mockQuestionRepo) SaveAll(qs []*domainQuiz.Question) error {
 	}
 	return nil
 }
-func (m *mockQuestionRepo) Delete(id domainQuiz.QuestionID) error { delete(m.questions, id.String()); return nil }
+func (m *mockQuestionRepo) SaveInTx(_ *sql.Tx, q *domainQuiz.Question) error { return m.Save(q) }
+func (m *mockQuestionRepo) Delete(id domainQuiz.QuestionID) error              { delete(m.questions, id.String()); return nil }
 
 // mockQuizRepo is an in-memory QuizRepository
 type mockQuizRepo struct{}
@@ -259,6 +266,7 @@ func (m *mockQuizRepo) FindAll() ([]domainQuiz.Quiz, error)
 func (m *mockQuizRepo) FindAllSummaries() ([]*domainQuiz.QuizSummary, error)                 { return nil, nil }
 func (m *mockQuizRepo) FindSummariesByCategory(_ domainQuiz.CategoryID) ([]*domainQuiz.QuizSummary, error) { return nil, nil }
 func (m *mockQuizRepo) Save(_ *domainQuiz.Quiz) error                                       { return nil }
+func (m *mockQuizRepo) SaveInTx(_ *sql.Tx, _ *domainQuiz.Quiz) error                        { return nil }
 func (m *mockQuizRepo) Delete(_ domainQuiz.QuizID) error                                    { return nil }
 
 // mockUserRepo for handler tests
@@ -339,6 +347,18 @@ type handlerFixture struct {
 	date          domainDaily.Date
 }
 
+// testUnauthenticatedHeader makes testAuthMiddleware skip authentication
+const testUnauthenticatedHeader = "X-Test-Unauthenticated"
+
+// testAuthMiddleware stands in for TelegramAuthMiddleware:
+// every request is authenticated as player123 unless it sets testUnauthenticatedHeader.
+func testAuthMiddleware(c fiber.Ctx) error {
+	if c.Get(testUnauthenticatedHeader) == "" {
+		middleware.SetPrincipal(c, &middleware.Principal{PlayerID: "player123", Username: "TestPlayer"})
+	}
+	return c.Next()
+}
+
 func setupHandlerFixture(t *testing.T) *handlerFixture {
 	t.Helper()
 
@@ -392,7 +412,7 @@ func setupHandlerFixture(t *testing.T) *handlerFixture {
 
 	// Create Fiber app and register routes
 	app := fiber.New()
-	daily := app.Group("/api/v1/daily-challenge")
+	daily := app.Group("/api/v1/daily-challenge", testAuthMiddleware)
 	daily.Post("/start", handler.StartDailyChallenge)
 	daily.Post("/:gameId/answer", handler.SubmitDailyAnswer)
 	daily.Post("/:gameId/chest/open", handler.OpenChest)
@@ -488,7 +508,7 @@ func TestHandler_StartDailyChallenge_201(t *testing.T) {
 	}
 }
 
-func TestHandler_StartDailyChallenge_400_MissingPlayerID(t *testing.T) {
+func TestHandler_StartDailyChallenge_201_PlayerFromPrincipal(t *testing.T) {
 	f := setupHandlerFixture(t)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/daily-challenge/start", jsonBody(t, map[string]string{
@@ -501,9 +521,59 @@ func TestHandler_StartDailyChallenge_400_MissingPlayerID(t *testing.T) {
 		t.Fatalf("Request failed: %v", err)
 	}
 
-	if resp.StatusCode != 400 {
+	if resp.StatusCode != 201 {
 		body, _ := io.ReadAll(resp.Body)
-		t.Errorf("Status = %d, want 400. Body: %s", resp.StatusCode, string(body))
+		t.Fatalf("Status = %d, want 201. Body: %s", resp.StatusCode, string(body))
+	}
+
+	result := parseJSONResponse(t, resp)
+	game := result["data"].(map[string]interface{})["game"].(map[string]interface{})
+	if game["playerId"] != "player123" {
+		t.Errorf("playerId = %v, want authenticated player123", game["playerId"])
+	}
+}
+
+func TestHandler_StartDailyChallenge_401_Unauthenticated(t *testing.T) {
+	f := setupHandlerFixture(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/daily-challenge/start", jsonBody(t, map[string]string{
+		"playerId": "player123",
+		"date":     f.date.String(),
+	}))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(testUnauthenticatedHeader, "1")
+
+	resp, err := f.app.Test(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	if resp.StatusCode != 401 {
+		body, _ := io.ReadAll(resp.Body)
+		t.Errorf("Status = %d, want 401. Body: %s", resp.StatusCode, string(body))
+	}
+}
+
+func TestHandler_StartDailyChallenge_403_PlayerMismatch(t *testing.T) {
+	f := setupHandlerFixture(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/daily-challenge/start", jsonBody(t, map[string]string{
+		"playerId": "someone-else",
+		"date":     f.date.String(),
+	}))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.app.Test(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	if resp.StatusCode != 403 {
+		body, _ := io.ReadAll(resp.Body)
+		t.Errorf("Status = %d, want 403. Body: %s", resp.StatusCode, string(body))
+	}
+	if len(f.dailyGameRepo.games) != 0 {
+		t.Errorf("no game should be started for another player, got %d", len(f.dailyGameRepo.games))
 	}
 }
 
@@ -645,16 +715,17 @@ func TestHandler_GetDailyStatus_200(t *testing.T) {
 	}
 }
 
-func TestHandler_GetDailyStatus_400_MissingPlayerID(t *testing.T) {
+func TestHandler_GetDailyStatus_401_Unauthenticated(t *testing.T) {
 	f := setupHandlerFixture(t)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/daily-challenge/status", nil)
+	req.Header.Set(testUnauthenticatedHeader, "1")
 
 	resp, _ := f.app.Test(req)
 
-	if resp.StatusCode != 400 {
+	if resp.StatusCode != 401 {
 		body, _ := io.ReadAll(resp.Body)
-		t.Errorf("Status = %d, want 400. Body: %s", resp.StatusCode, string(body))
+		t.Errorf("Status = %d, want 401. Body: %s", resp.StatusCode, string(body))
 	}
 }
 
@@ -727,15 +798,15 @@ func TestHandler_GetPlayerStreak_200(t *testing.T) {
 	}
 }
 
-func TestHandler_GetPlayerStreak_400_MissingPlayerID(t *testing.T) {
+func TestHandler_GetPlayerStreak_403_PlayerMismatch(t *testing.T) {
 	f := setupHandlerFixture(t)
 
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/daily-challenge/streak", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/daily-challenge/streak?playerId=someone-else", nil)
 	resp, _ := f.app.Test(req)
 
-	if resp.StatusCode != 400 {
+	if resp.StatusCode != 403 {
 		body, _ := io.ReadAll(resp.Body)
-		t.Errorf("Status = %d, want 400. Body: %s", resp.StatusCode, string(body))
+		t.Errorf("Status = %d, want 403. Body: %s", resp.StatusCode, string(body))
 	}
 }
 
@@ -759,7 +830,7 @@ func TestHandler_OpenChest_200(t *testing.T) {
 	quizQuestions := quizAgg.Questions()
 	for i, q := range quizQuestions {
 		correct := q.Answers()[0]
-		game.AnswerQuestion(q.ID(), correct.ID(), 2000, int64(1000000+(i+1)*2000))
+		game.AnswerQuestion(q.ID(), domainQuiz.NewChoiceSubmission(correct.ID()), 2000, int64(1000000+(i+1)*2000))
 	}
 	game.Events()
 
@@ -856,7 +927,7 @@ func TestHandler_RetryChallenge_201(t *testing.T) {
 	quizQuestions := quizAgg.Questions()
 	for i, q := range quizQuestions {
 		correct := q.Answers()[0]
-		game.AnswerQuestion(q.ID(), correct.ID(), 2000, int64(1000000+(i+1)*2000))
+		game.AnswerQuestion(q.ID(), domainQuiz.NewChoiceSubmission(correct.ID()), 2000, int64(1000000+(i+1)*2000))
 	}
 	game.Events()
 
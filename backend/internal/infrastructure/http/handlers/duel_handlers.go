@@ -8,7 +8,6 @@ import (
 
 	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
 	domainDuel "github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
-	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/middleware"
 )
 
 // DuelHandler handles HTTP requests for PvP Duel game mode
@@ -25,11 +24,14 @@ type DuelHandler struct {
 	getLeaderboardUC     *appDuel.GetLeaderboardUseCase
 	requestRematchUC     *appDuel.RequestRematchUseCase
 	getGameResultUC      *appDuel.GetGameResultUseCase
+	getGameReplayUC      *appDuel.GetGameReplayUseCase
 	getRivalsUC          *appDuel.GetRivalsUseCase
 	prepareShareUC       *appDuel.PrepareShareUseCase
 	getReferralsUC       *appDuel.GetReferralsUseCase
 	claimReferralUC      *appDuel.ClaimReferralRewardUseCase
 	surrenderGameUC      *appDuel.SurrenderGameUseCase
+	getOnlineFriendsUC   *appDuel.GetOnlineFriendsUseCase
+	getRatingHistoryUC   *appDuel.GetRatingHistoryUseCase
 }
 
 func NewDuelHandler(
@@ -45,11 +47,14 @@ func NewDuelHandler(
 	getLeaderboardUC *appDuel.GetLeaderboardUseCase,
 	requestRematchUC *appDuel.RequestRematchUseCase,
 	getGameResultUC *appDuel.GetGameResultUseCase,
+	getGameReplayUC *appDuel.GetGameReplayUseCase,
 	getRivalsUC *appDuel.GetRivalsUseCase,
 	prepareShareUC *appDuel.PrepareShareUseCase,
 	getReferralsUC *appDuel.GetReferralsUseCase,
 	claimReferralUC *appDuel.ClaimReferralRewardUseCase,
 	surrenderGameUC *appDuel.SurrenderGameUseCase,
+	getOnlineFriendsUC *appDuel.GetOnlineFriendsUseCase,
+	getRatingHistoryUC *appDuel.GetRatingHistoryUseCase,
 ) *DuelHandler {
 	return &DuelHandler{
 		getStatusUC:          getStatusUC,
@@ -64,22 +69,17 @@ func NewDuelHandler(
 		getLeaderboardUC:     getLeaderboardUC,
 		requestRematchUC:     requestRematchUC,
 		getGameResultUC:      getGameResultUC,
+		getGameReplayUC:      getGameReplayUC,
 		getRivalsUC:          getRivalsUC,
 		prepareShareUC:       prepareShareUC,
 		getReferralsUC:       getReferralsUC,
 		claimReferralUC:      claimReferralUC,
 		surrenderGameUC:      surrenderGameUC,
+		getOnlineFriendsUC:   getOnlineFriendsUC,
+		getRatingHistoryUC:   getRatingHistoryUC,
 	}
 }
 
-func getAuthPlayerID(c fiber.Ctx) (string, error) {
-	initData := middleware.GetTelegramInitData(c)
-	if initData == nil {
-		return "", fiber.NewError(fiber.StatusUnauthorized, "Authentication required")
-	}
-	return strconv.FormatInt(initData.User.ID, 10), nil
-}
-
 // GetDuelStatus handles GET /api/v1/duel/status
 // @Summary Get duel status
 // @Description Get player's duel status, MMR, pending challenges
@@ -114,6 +114,7 @@ func (h *DuelHandler) GetDuelStatus(c fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param request body JoinQueueRequest true "Join request"
+// @Param Idempotency-Key header string false "Client-generated key: a retried request is charged one ticket only"
 // @Success 200 {object} JoinQueueResponse "Joined queue"
 // @Failure 400 {object} ErrorResponse "Invalid request"
 // @Failure 409 {object} ErrorResponse "Already in queue or game"
@@ -125,8 +126,14 @@ func (h *DuelHandler) JoinQueue(c fiber.Ctx) error {
 		return err
 	}
 
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
+
 	output, err := h.joinQueueUC.Execute(appDuel.JoinQueueInput{
-		PlayerID: playerID,
+		PlayerID:       playerID,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
 		return mapDuelError(err)
@@ -169,6 +176,7 @@ func (h *DuelHandler) LeaveQueue(c fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param request body SendChallengeRequest true "Challenge request"
+// @Param Idempotency-Key header string false "Client-generated key: a retried request is charged one ticket only"
 // @Success 201 {object} SendChallengeResponse "Challenge sent"
 // @Failure 400 {object} ErrorResponse "Invalid request"
 // @Failure 409 {object} ErrorResponse "Friend busy"
@@ -184,14 +192,23 @@ func (h *DuelHandler) SendChallenge(c fiber.Ctx) error {
 	if err := c.Bind().Body(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
+	if err := checkClaimedPlayerID(playerID, req.PlayerID); err != nil {
+		return err
+	}
 
 	if req.FriendID == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "friendId is required")
 	}
 
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
+
 	output, err := h.sendChallengeUC.Execute(appDuel.SendChallengeInput{
-		PlayerID: playerID,
-		FriendID: req.FriendID,
+		PlayerID:       playerID,
+		FriendID:       req.FriendID,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
 		return mapDuelError(err)
@@ -229,6 +246,9 @@ func (h *DuelHandler) RespondChallenge(c fiber.Ctx) error {
 	if err := c.Bind().Body(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
+	if err := checkClaimedPlayerID(playerID, req.PlayerID); err != nil {
+		return err
+	}
 
 	if req.Action == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "action is required")
@@ -273,6 +293,9 @@ func (h *DuelHandler) AcceptByLinkCode(c fiber.Ctx) error {
 	if err := c.Bind().Body(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
+	if err := checkClaimedPlayerID(playerID, req.PlayerID); err != nil {
+		return err
+	}
 
 	if req.LinkCode == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "linkCode is required")
@@ -363,6 +386,38 @@ func (h *DuelHandler) GetGameHistory(c fiber.Ctx) error {
 	return c.JSON(fiber.Map{"data": output})
 }
 
+// GetRatingHistory handles GET /api/v1/duel/rating-history
+// @Summary Get rating history
+// @Description Player's MMR after every duel of a season, with promotions, demotions and demotion protection
+// @Tags duel
+// @Produce json
+// @Param seasonId query string false "Season ID (default current season)"
+// @Success 200 {object} GetRatingHistoryResponse "Rating history"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /duel/rating-history [get]
+func (h *DuelHandler) GetRatingHistory(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	if h.getRatingHistoryUC == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "Rating history not available")
+	}
+
+	output, err := h.getRatingHistoryUC.Execute(appDuel.GetRatingHistoryInput{
+		PlayerID: playerID,
+		SeasonID: c.Query("seasonId"),
+	})
+	if err != nil {
+		return mapDuelError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
 // GetDuelLeaderboard handles GET /api/v1/duel/leaderboard
 // @Summary Get duel leaderboard
 // @Description Get leaderboard by type (seasonal, friends, referrals)
@@ -402,6 +457,35 @@ func (h *DuelHandler) GetDuelLeaderboard(c fiber.Ctx) error {
 	return c.JSON(fiber.Map{"data": output})
 }
 
+// GetOnlineFriends handles GET /api/v1/duel/friends/online
+// @Summary Get online friends
+// @Description Friends who are online now, to challenge them directly
+// @Tags duel
+// @Produce json
+// @Success 200 {object} GetOnlineFriendsResponse "Online friends"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /duel/friends/online [get]
+func (h *DuelHandler) GetOnlineFriends(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	// Online status lives in Redis; without it nobody is online
+	if h.getOnlineFriendsUC == nil {
+		return c.JSON(fiber.Map{"data": appDuel.GetOnlineFriendsOutput{OnlineFriends: []appDuel.FriendDTO{}}})
+	}
+
+	output, err := h.getOnlineFriendsUC.Execute(appDuel.GetOnlineFriendsInput{PlayerID: playerID})
+	if err != nil {
+		return mapDuelError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
 // RequestRematch handles POST /api/v1/duel/game/:gameId/rematch
 // @Summary Request rematch
 // @Description Request a rematch after a completed duel
@@ -477,6 +561,66 @@ func (h *DuelHandler) GetGameResult(c fiber.Ctx) error {
 	return c.JSON(fiber.Map{"data": output})
 }
 
+// GetGameReplay handles GET /api/v1/duel/game/:gameId/replay
+// @Summary Get game replay
+// @Description Round-by-round timeline of a finished duel: question, both players' answers, time, speed bonus and running score
+// @Tags duel
+// @Accept json
+// @Produce json
+// @Param gameId path string true "Game ID"
+// @Success 200 {object} GetGameReplayResponse "Game replay"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 404 {object} ErrorResponse "Game not found"
+// @Failure 409 {object} ErrorResponse "Game not finished"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /duel/game/{gameId}/replay [get]
+func (h *DuelHandler) GetGameReplay(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+	return h.getGameReplay(c, playerID)
+}
+
+// GetGameReplayForSupport handles GET /api/v1/admin/duel/game/:gameId/replay
+// @Summary Get game replay (support)
+// @Description Same timeline as the player replay, for any finished duel, to adjudicate disputes
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param gameId path string true "Game ID"
+// @Success 200 {object} GetGameReplayResponse "Game replay"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 404 {object} ErrorResponse "Game not found"
+// @Failure 409 {object} ErrorResponse "Game not finished"
+// @Router /admin/duel/game/{gameId}/replay [get]
+func (h *DuelHandler) GetGameReplayForSupport(c fiber.Ctx) error {
+	return h.getGameReplay(c, "")
+}
+
+func (h *DuelHandler) getGameReplay(c fiber.Ctx, playerID string) error {
+	if h.getGameReplayUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "Replay not available")
+	}
+
+	gameID := c.Params("gameId")
+	if _, err := uuid.Parse(gameID); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid game ID format")
+	}
+
+	output, err := h.getGameReplayUC.Execute(appDuel.GetGameReplayInput{
+		PlayerID: playerID,
+		GameID:   gameID,
+		Locale:   getRequestLocale(c),
+	})
+	if err != nil {
+		return mapDuelError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
 // StartChallenge handles POST /api/v1/duel/challenge/:challengeId/start
 // @Summary Start the duel after invitee accepted
 // @Description Inviter confirms game start after invitee accepted via link
@@ -541,6 +685,9 @@ func (h *DuelHandler) PrepareShare(c fiber.Ctx) error {
 	if err := c.Bind().Body(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
+	if err := checkClaimedPlayerID(playerID, req.PlayerID); err != nil {
+		return err
+	}
 
 	if req.ChallengeLink == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "challengeLink is required")
@@ -549,6 +696,7 @@ func (h *DuelHandler) PrepareShare(c fiber.Ctx) error {
 	output, err := h.prepareShareUC.Execute(c.Context(), appDuel.PrepareShareInput{
 		PlayerID:      playerID,
 		ChallengeLink: req.ChallengeLink,
+		Locale:        getRequestLocale(c),
 	})
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -565,6 +713,8 @@ func mapDuelError(err error) error {
 		return NewAppError(fiber.StatusNotFound, string(domainDuel.CodeGameNotFound), "Game not found")
 	case domainDuel.ErrGameNotActive:
 		return NewAppError(fiber.StatusConflict, string(domainDuel.CodeGameNotActive), "Game is not active")
+	case domainDuel.ErrGameNotFinished:
+		return NewAppError(fiber.StatusConflict, string(domainDuel.CodeGameNotFinished), "Game is not finished yet")
 	case domainDuel.ErrGameAlreadyFinished:
 		return NewAppError(fiber.StatusConflict, string(domainDuel.CodeGameAlreadyFinished), "Game is already finished")
 	case domainDuel.ErrChallengeNotFound:
@@ -703,7 +853,7 @@ func (h *DuelHandler) GetRivals(c fiber.Ctx) error {
 
 // SurrenderGame handles POST /api/v1/duel/game/:gameId/surrender
 // @Summary Surrender a duel game
-// @Description Forfeit an active duel game. The surrendering player takes an ELO loss; the opponent wins.
+// @Description Forfeit an active duel game. The surrendering player takes a rating loss; the opponent wins.
 // @Tags duel
 // @Accept json
 // @Produce json
@@ -22,9 +22,9 @@ func NewDuelLobbyWebSocketHandler(hub *DuelLobbyHub, onlineTracker appDuel.Onlin
 // then parks until disconnect. Client-to-server messages are ignored
 // (lobby is server-push only).
 func (h *DuelLobbyWebSocketHandler) HandleLobbyWebSocket(c *websocket.Conn) {
-	playerID := c.Query("playerId")
+	playerID := getWebSocketPlayerID(c)
 	if playerID == "" {
-		_ = c.WriteJSON(map[string]string{"type": "error", "error": "playerId required"})
+		_ = c.WriteJSON(map[string]string{"type": "error", "error": "Authentication required"})
 		c.Close()
 		return
 	}
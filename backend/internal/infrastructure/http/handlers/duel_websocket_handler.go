@@ -1,44 +1,94 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/contrib/v3/websocket"
+	"github.com/google/uuid"
 
 	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/realtime"
 )
 
-// DuelWebSocketHub manages WebSocket connections for real-time duels
+const (
+	duelStartDelay     = 3 * time.Second  // game_ready -> first question
+	duelNextRoundDelay = 2 * time.Second  // round_complete / round_timeout -> next question
+	duelReconnectGrace = 30 * time.Second // disconnected player forfeits after this
+	duelPingInterval   = 5 * time.Second  // how often a connection's round-trip time is measured
+)
+
+// DuelWebSocketHub manages WebSocket connections for real-time duels.
+// The two players of a game may be connected to different API instances: the
+// coordinator shares the game state, routes game messages to every instance
+// with a local player, and runs the game's steps (answers, round timers,
+// forfeits) on a single owner instance.
 type DuelWebSocketHub struct {
-	// Game ID -> player connections
+	// Game ID -> connections of its players on this instance
 	games map[string]*DuelGame
 	mu    sync.RWMutex
 
+	coordinator realtime.Coordinator
+	stepLocks   *duelStepLocks
+
 	// Use cases
 	startGameUC    *appDuel.StartGameUseCase
 	submitAnswerUC *appDuel.SubmitDuelAnswerUseCase
+	botPlayerUC    *appDuel.BotPlayerUseCase // optional, see WithBotPlayer
 
 	// Repositories
 	userRepo domainUser.UserRepository
 }
 
-// DuelGame represents an active duel game with two players
+// DuelGame holds the connections of a duel's players on this instance
 type DuelGame struct {
-	ID              string
-	Player1Conn     *websocket.Conn
-	Player2Conn     *websocket.Conn
-	Player1ID       string
-	Player2ID       string
-	CurrentRound    int
-	Finished        bool
-	GameCompleteMsg map[string]interface{} // cached for reconnecting players
-	mu              sync.Mutex
+	ID    string
+	conns map[string]*duelConn // player ID -> connection
+	mu    sync.Mutex           // serializes writes to the connections
+}
+
+type duelConn struct {
+	id        string // unique across instances, tells a reconnection from the connection it replaced
+	conn      *websocket.Conn
+	latencyMs atomic.Int64 // last measured ping round-trip time
+}
+
+// measureLatency pings the connection every duelPingInterval until done is closed.
+// Each ping carries its send time, so the pong alone gives the round-trip time
+// (see handlePong, installed before the read loop starts).
+func (c *duelConn) measureLatency(done <-chan struct{}) {
+	ticker := time.NewTicker(duelPingInterval)
+	defer ticker.Stop()
+
+	for {
+		payload := []byte(strconv.FormatInt(time.Now().UnixMilli(), 10))
+		if err := c.conn.WriteControl(websocket.PingMessage, payload, time.Now().Add(time.Second)); err != nil {
+			return
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handlePong records the round-trip time of a measureLatency ping. It runs on
+// the reading goroutine, so it must be set before reading starts.
+func (c *duelConn) handlePong(appData string) error {
+	if sentAt, err := strconv.ParseInt(appData, 10, 64); err == nil {
+		c.latencyMs.Store(time.Now().UnixMilli() - sentAt)
+	}
+	return nil
 }
 
 // DuelMessage represents a WebSocket message
@@ -59,40 +109,73 @@ type DuelSubmitAnswerData struct {
 	GameID     string `json:"gameId"`
 	QuestionID string `json:"questionId"`
 	AnswerID   string `json:"answerId"`
-	TimeTaken  int    `json:"timeTaken"` // milliseconds
+	TimeTaken  int    `json:"timeTaken"` // milliseconds, as the client measured it
+	// Multi-select (picked options) and ordering (every option, in order) send AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
+}
+
+// duelAnswerStep is the payload of an answer step: the player's answer
+// and when it reached the server, stamped by the instance that received it
+type duelAnswerStep struct {
+	Answer       DuelSubmitAnswerData `json:"answer"`
+	ReceivedAtMs int64                `json:"receivedAtMs,omitempty"` // 0 = when the step runs (bot answers)
+	LatencyMs    int64                `json:"latencyMs,omitempty"`
 }
 
-// NewDuelWebSocketHub creates a new duel WebSocket hub
+// NewDuelWebSocketHub creates a new duel WebSocket hub.
+// coordinator may be nil: games then live in this process only.
 func NewDuelWebSocketHub(
 	startGameUC *appDuel.StartGameUseCase,
 	submitAnswerUC *appDuel.SubmitDuelAnswerUseCase,
 	userRepo domainUser.UserRepository,
+	coordinator realtime.Coordinator,
 ) *DuelWebSocketHub {
+	if coordinator == nil {
+		coordinator = realtime.NewLocalCoordinator()
+	}
 	return &DuelWebSocketHub{
 		games:          make(map[string]*DuelGame),
+		coordinator:    coordinator,
+		stepLocks:      &duelStepLocks{locks: make(map[string]*duelStepLock)},
 		startGameUC:    startGameUC,
 		submitAnswerUC: submitAnswerUC,
 		userRepo:       userRepo,
 	}
 }
 
+// WithBotPlayer makes the hub play the bot's side of bot games:
+// the bot takes the second seat when its human opponent connects, and answers each round
+func (h *DuelWebSocketHub) WithBotPlayer(botPlayerUC *appDuel.BotPlayerUseCase) *DuelWebSocketHub {
+	h.botPlayerUC = botPlayerUC
+	return h
+}
+
+// Start delivers game messages to local players and runs the steps of the
+// games this instance owns, until ctx is cancelled
+func (h *DuelWebSocketHub) Start(ctx context.Context) {
+	h.coordinator.Start(ctx, h.deliver, h.runStep)
+}
+
 // HandleDuelWebSocket handles WebSocket connections for duels
 func (h *DuelWebSocketHub) HandleDuelWebSocket(c *websocket.Conn) {
 	gameID := c.Params("gameId")
-	playerID := c.Query("playerId")
+	playerID := getWebSocketPlayerID(c)
 
 	if gameID == "" || playerID == "" {
-		log.Printf("Missing gameId or playerId")
+		log.Printf("Missing gameId or authenticated player")
 		c.WriteJSON(map[string]interface{}{
 			"type":  "error",
-			"error": "Missing gameId or playerId",
+			"error": "Missing gameId or authentication",
 		})
 		c.Close()
 		return
 	}
 
 	// Register player to game
-	if err := h.registerPlayer(gameID, playerID, c); err != nil {
+	connID := uuid.NewString()
+	dc := &duelConn{id: connID, conn: c}
+	if err := h.registerPlayer(gameID, playerID, dc); err != nil {
 		log.Printf("Failed to register player: %v", err)
 		c.WriteJSON(map[string]interface{}{
 			"type":  "error",
@@ -103,7 +186,13 @@ func (h *DuelWebSocketHub) HandleDuelWebSocket(c *websocket.Conn) {
 	}
 
 	// Clean up on disconnect
-	defer h.unregisterPlayer(gameID, playerID, c)
+	defer h.unregisterPlayer(gameID, playerID, connID)
+
+	// Keep the connection's round-trip time fresh for timing answers
+	c.SetPongHandler(dc.handlePong)
+	done := make(chan struct{})
+	defer close(done)
+	go dc.measureLatency(done)
 
 	// Listen for messages
 	for {
@@ -121,95 +210,134 @@ func (h *DuelWebSocketHub) HandleDuelWebSocket(c *websocket.Conn) {
 			continue
 		}
 
-		h.handleMessage(gameID, playerID, c, msg)
+		h.handleMessage(gameID, playerID, msg)
 	}
 }
 
-func (h *DuelWebSocketHub) registerPlayer(gameID, playerID string, conn *websocket.Conn) error {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+func (h *DuelWebSocketHub) registerPlayer(gameID, playerID string, conn *duelConn) error {
+	ctx := context.Background()
 
-	game, exists := h.games[gameID]
-	if !exists {
-		// Create new game entry
-		game = &DuelGame{
-			ID:           gameID,
-			CurrentRound: 0,
+	// Only the game's players may connect
+	if h.startGameUC != nil {
+		player1ID, player2ID, err := h.startGameUC.GetDomainPlayerOrder(gameID)
+		if err != nil {
+			return err
+		}
+		if playerID != player1ID && playerID != player2ID {
+			return quick_duel.ErrPlayerNotInGame
 		}
-		h.games[gameID] = game
 	}
 
-	game.mu.Lock()
-	defer game.mu.Unlock()
+	// Listen to the game's messages before joining, so none is missed
+	game, err := h.attach(ctx, gameID, playerID, conn)
+	if err != nil {
+		return err
+	}
 
-	// Reconnect: existing player slot reconnects
-	if game.Player1ID == playerID {
-		game.Player1Conn = conn
-		log.Printf("Player %s reconnected to game %s (slot 1)", playerID, gameID)
-		conn.WriteJSON(map[string]interface{}{
-			"type": "connected",
-			"data": map[string]interface{}{
-				"gameId":   gameID,
-				"playerId": playerID,
-			},
-		})
-		if game.Finished && game.GameCompleteMsg != nil {
-			conn.WriteJSON(game.GameCompleteMsg)
-		} else if game.CurrentRound > 0 {
-			go h.resendCurrentQuestion(game, game.CurrentRound)
+	state, rejoined, err := h.coordinator.Join(ctx, gameID, playerID, conn.id)
+	if err != nil {
+		h.detach(ctx, gameID, playerID, conn.id)
+		if errors.Is(err, realtime.ErrGameFull) {
+			return quick_duel.ErrAlreadyInGame
 		}
-		return nil
+		return err
 	}
 
-	if game.Player2ID == playerID {
-		game.Player2Conn = conn
-		log.Printf("Player %s reconnected to game %s (slot 2)", playerID, gameID)
-		conn.WriteJSON(map[string]interface{}{
-			"type": "connected",
-			"data": map[string]interface{}{
-				"gameId":   gameID,
-				"playerId": playerID,
-			},
-		})
-		if game.Finished && game.GameCompleteMsg != nil {
-			conn.WriteJSON(game.GameCompleteMsg)
-		} else if game.CurrentRound > 0 {
-			go h.resendCurrentQuestion(game, game.CurrentRound)
+	h.send(game, playerID, map[string]interface{}{
+		"type": "connected",
+		"data": map[string]interface{}{
+			"gameId":   gameID,
+			"playerId": playerID,
+		},
+	})
+
+	// Reconnect: catch up with the game
+	if rejoined {
+		log.Printf("Player %s reconnected to game %s", playerID, gameID)
+		if state.Finished && state.GameComplete != nil {
+			h.sendRaw(game, playerID, state.GameComplete)
+		} else if state.CurrentRound > 0 {
+			go h.resendCurrentQuestion(game, playerID, state.CurrentRound)
 		}
 		return nil
 	}
 
-	// New player: assign to empty slot
-	if game.Player1ID == "" {
-		game.Player1ID = playerID
-		game.Player1Conn = conn
-	} else if game.Player2ID == "" {
-		game.Player2ID = playerID
-		game.Player2Conn = conn
-	} else {
-		return quick_duel.ErrAlreadyInGame
+	log.Printf("Player %s connected to game %s", playerID, gameID)
+
+	// The bot never connects: seat it next to its opponent
+	if state.Player2ID == "" && h.isBotGame(gameID) {
+		if state, _, err = h.coordinator.Join(ctx, gameID, appDuel.BotUserID, botConnID); err != nil {
+			log.Printf("Game %s: failed to seat the bot: %v", gameID, err)
+			return nil
+		}
 	}
 
-	log.Printf("Player %s connected to game %s", playerID, gameID)
+	// The second player to join (or the bot) starts the game
+	if state.Player2ID != "" {
+		h.schedule(realtime.Step{GameID: gameID, Kind: realtime.StepReady}, 0)
+	}
 
-	// Send connection confirmation
-	conn.WriteJSON(map[string]interface{}{
-		"type": "connected",
+	return nil
+}
+
+func (h *DuelWebSocketHub) unregisterPlayer(gameID, playerID, connID string) {
+	ctx := context.Background()
+	h.detach(ctx, gameID, playerID, connID)
+
+	state, left, err := h.coordinator.Leave(ctx, gameID, playerID, connID)
+	if err != nil {
+		log.Printf("Game %s: failed to record disconnect of %s: %v", gameID, playerID, err)
+		return
+	}
+	if !left {
+		return // Replaced by a newer connection of the same player
+	}
+
+	log.Printf("Player %s disconnected from game %s", playerID, gameID)
+
+	// Notify opponent of disconnect
+	opponentID := state.Opponent(playerID)
+	if state.Finished || !state.IsConnected(opponentID) {
+		return
+	}
+	h.publish(ctx, gameID, opponentID, map[string]interface{}{
+		"type": "opponent_disconnected",
 		"data": map[string]interface{}{
-			"gameId":   gameID,
-			"playerId": playerID,
+			"playerId":    playerID,
+			"reconnectIn": int(duelReconnectGrace.Seconds()), // per spec
 		},
 	})
+	// Grace period: if player doesn't reconnect within 30s, they forfeit the game
+	h.schedule(realtime.Step{GameID: gameID, Kind: realtime.StepDisconnectGrace, PlayerID: playerID}, duelReconnectGrace)
+}
 
-	// Check if both players are connected
-	if game.Player1Conn != nil && game.Player2Conn != nil {
-		h.notifyBothPlayersReady(game)
+// attach registers a local connection, watching the game's messages if it is the first one here
+func (h *DuelWebSocketHub) attach(ctx context.Context, gameID, playerID string, conn *duelConn) (*DuelGame, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	game, exists := h.games[gameID]
+	if !exists {
+		if err := h.coordinator.Watch(ctx, gameID); err != nil {
+			return nil, err
+		}
+		game = &DuelGame{
+			ID:    gameID,
+			conns: make(map[string]*duelConn),
+		}
+		h.games[gameID] = game
 	}
 
-	return nil
+	game.mu.Lock()
+	game.conns[playerID] = conn
+	game.mu.Unlock()
+
+	return game, nil
 }
 
-func (h *DuelWebSocketHub) unregisterPlayer(gameID, playerID string, conn *websocket.Conn) {
+// detach removes a local connection, unless a newer one replaced it,
+// and stops watching the game once no local player is left
+func (h *DuelWebSocketHub) detach(ctx context.Context, gameID, playerID, connID string) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -219,88 +347,192 @@ func (h *DuelWebSocketHub) unregisterPlayer(gameID, playerID string, conn *webso
 	}
 
 	game.mu.Lock()
-	defer game.mu.Unlock()
-
-	// Notify opponent of disconnect
-	var opponentConn *websocket.Conn
-	if game.Player1ID == playerID && game.Player1Conn == conn {
-		game.Player1Conn = nil
-		opponentConn = game.Player2Conn
-	} else if game.Player2ID == playerID && game.Player2Conn == conn {
-		game.Player2Conn = nil
-		opponentConn = game.Player1Conn
-	}
-
-	if opponentConn != nil {
-		opponentConn.WriteJSON(map[string]interface{}{
-			"type": "opponent_disconnected",
-			"data": map[string]interface{}{
-				"playerId":    playerID,
-				"reconnectIn": 30, // seconds — per spec
-			},
-		})
-		// Grace period: if player doesn't reconnect within 30s, forfeit remaining rounds
-		go h.handleDisconnectGracePeriod(gameID, playerID)
+	if c, ok := game.conns[playerID]; ok && c.id == connID {
+		delete(game.conns, playerID)
 	}
+	empty := len(game.conns) == 0
+	game.mu.Unlock()
 
-	// Clean up game if both disconnected
-	if game.Player1Conn == nil && game.Player2Conn == nil {
+	if empty {
 		delete(h.games, gameID)
-		log.Printf("Game %s cleaned up - both players disconnected", gameID)
+		if err := h.coordinator.Unwatch(ctx, gameID); err != nil {
+			log.Printf("Game %s: failed to stop watching: %v", gameID, err)
+		}
 	}
-
-	log.Printf("Player %s disconnected from game %s", playerID, gameID)
 }
 
-// handleDisconnectGracePeriod waits 30 s; if the player is still gone, it submits
-// timeout answers for all remaining rounds so the opponent gets a proper result.
-func (h *DuelWebSocketHub) handleDisconnectGracePeriod(gameID, playerID string) {
-	time.Sleep(30 * time.Second)
+// ========================================
+// Message routing
+// ========================================
 
+// deliver writes a game message to its recipients connected to this instance
+func (h *DuelWebSocketHub) deliver(msg realtime.Message) {
 	h.mu.RLock()
-	game, exists := h.games[gameID]
+	game, exists := h.games[msg.GameID]
 	h.mu.RUnlock()
 
 	if !exists {
-		return // Game already cleaned up
+		return
 	}
 
 	game.mu.Lock()
-	reconnected := (game.Player1ID == playerID && game.Player1Conn != nil) ||
-		(game.Player2ID == playerID && game.Player2Conn != nil)
-	currentRound := game.CurrentRound
-	game.mu.Unlock()
+	defer game.mu.Unlock()
+
+	for playerID, c := range game.conns {
+		if msg.To != "" && msg.To != playerID {
+			continue
+		}
+		c.conn.WriteMessage(websocket.TextMessage, msg.Body)
+	}
+}
 
-	if reconnected {
+// publish sends a message to both players of a game (or only to, when set), wherever they are connected
+func (h *DuelWebSocketHub) publish(ctx context.Context, gameID, to string, msg interface{}) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Game %s: failed to encode message: %v", gameID, err)
 		return
 	}
+	if err := h.coordinator.Publish(ctx, realtime.Message{GameID: gameID, To: to, Body: body}); err != nil {
+		log.Printf("Game %s: failed to publish message: %v", gameID, err)
+	}
+}
 
-	if h.submitAnswerUC == nil {
+// send writes a message to a player connected to this instance
+func (h *DuelWebSocketHub) send(game *DuelGame, playerID string, msg interface{}) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Game %s: failed to encode message: %v", game.ID, err)
 		return
 	}
+	h.sendRaw(game, playerID, body)
+}
 
-	// Submit timeout answers for every remaining round
-	for round := currentRound; round <= quick_duel.QuestionsPerDuel; round++ {
-		output, err := h.submitAnswerUC.TimeoutRound(gameID, round)
-		if err != nil {
-			log.Printf("Game %s: grace period TimeoutRound(%d) error: %v", gameID, round, err)
-			break
+func (h *DuelWebSocketHub) sendRaw(game *DuelGame, playerID string, body []byte) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	if c, ok := game.conns[playerID]; ok {
+		c.conn.WriteMessage(websocket.TextMessage, body)
+	}
+}
+
+func (h *DuelWebSocketHub) schedule(step realtime.Step, delay time.Duration) {
+	if err := h.coordinator.Schedule(context.Background(), step, delay); err != nil {
+		log.Printf("Game %s: failed to schedule %s: %v", step.GameID, step.Kind, err)
+	}
+}
+
+func (h *DuelWebSocketHub) handleMessage(gameID, playerID string, msg DuelMessage) {
+	h.mu.RLock()
+	game, exists := h.games[gameID]
+	h.mu.RUnlock()
+
+	if !exists {
+		return
+	}
+
+	switch msg.Type {
+	case "submit_answer":
+		// Stamp the receipt time first: the answer is timed from here, not from the client
+		receivedAt := time.Now().UnixMilli()
+
+		var data DuelSubmitAnswerData
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			h.send(game, playerID, map[string]interface{}{
+				"type":  "error",
+				"error": "Invalid answer data",
+			})
+			return
 		}
-		if output == nil {
-			break // Round already past or game finished before us
+
+		var latencyMs int64
+		game.mu.Lock()
+		if c, ok := game.conns[playerID]; ok {
+			latencyMs = c.latencyMs.Load()
 		}
-		if output.GameComplete {
-			h.mu.RLock()
-			g, ok := h.games[gameID]
-			h.mu.RUnlock()
-			if ok {
-				g.mu.Lock()
-				h.broadcastGameComplete(g, output)
-				g.mu.Unlock()
-			}
-			break
+		game.mu.Unlock()
+
+		payload, err := json.Marshal(duelAnswerStep{Answer: data, ReceivedAtMs: receivedAt, LatencyMs: latencyMs})
+		if err != nil {
+			log.Printf("Game %s: failed to encode answer: %v", gameID, err)
+			return
 		}
+		// Answers are applied by the game's owner, in order with its timers
+		h.schedule(realtime.Step{
+			GameID:   gameID,
+			Kind:     realtime.StepAnswer,
+			PlayerID: playerID,
+			Payload:  payload,
+		}, 0)
+
+	case "player_ready":
+		// Player signals ready for next round
+		log.Printf("Player %s ready in game %s", playerID, gameID)
+
+	case "ping":
+		h.send(game, playerID, map[string]interface{}{"type": "pong"})
+
+	default:
+		log.Printf("Unknown message type: %s", msg.Type)
+	}
+}
+
+// ========================================
+// Game steps (run on the game's owner)
+// ========================================
+
+func (h *DuelWebSocketHub) runStep(step realtime.Step) {
+	unlock := h.stepLocks.lock(step.GameID)
+	defer unlock()
+
+	ctx := context.Background()
+	state, err := h.coordinator.State(ctx, step.GameID)
+	if err != nil {
+		log.Printf("Game %s: cannot run %s: %v", step.GameID, step.Kind, err)
+		return
+	}
+	if state.Player1ID == "" || state.Finished {
+		return // Both players left, or the game is over
+	}
+
+	switch step.Kind {
+	case realtime.StepReady:
+		h.notifyBothPlayersReady(ctx, step.GameID, state)
+	case realtime.StepStartRound:
+		h.startRound(ctx, step.GameID, step.Round, state)
+	case realtime.StepRoundTimeout:
+		h.checkRoundTimeout(ctx, step.GameID, step.Round, state)
+	case realtime.StepAnswer:
+		h.handleSubmitAnswer(ctx, step.GameID, step.PlayerID, step.Payload, state)
+	case realtime.StepDisconnectGrace:
+		h.handleDisconnectGracePeriod(ctx, step.GameID, step.PlayerID, state)
+	default:
+		log.Printf("Game %s: unknown step %s", step.GameID, step.Kind)
+	}
+}
+
+// handleDisconnectGracePeriod runs 30 s after a disconnect; if the player is still gone,
+// the game is forfeited to the opponent (win reason "disconnect").
+func (h *DuelWebSocketHub) handleDisconnectGracePeriod(ctx context.Context, gameID, playerID string, state realtime.GameState) {
+	if state.IsConnected(playerID) {
+		return // Reconnected in time
+	}
+
+	if h.submitAnswerUC == nil {
+		return
+	}
+
+	output, err := h.submitAnswerUC.ForfeitDisconnected(gameID, playerID)
+	if err != nil {
+		log.Printf("Game %s: grace period forfeit of %s error: %v", gameID, playerID, err)
+		return
+	}
+	if output == nil {
+		return // Game finished before us
 	}
+
+	h.broadcastGameComplete(ctx, gameID, output)
 }
 
 // fetchPlayerInfo returns username and avatarURL for a player, empty strings on error.
@@ -319,18 +551,50 @@ func (h *DuelWebSocketHub) fetchPlayerInfo(playerID string) (username, avatarURL
 	return u.Username().String(), u.AvatarURL().String()
 }
 
-func (h *DuelWebSocketHub) notifyBothPlayersReady(game *DuelGame) {
+// playerLocale returns the language a player reads questions in, the default on error.
+func (h *DuelWebSocketHub) playerLocale(playerID string) string {
+	if h.userRepo == nil || playerID == "" {
+		return quiz.DefaultLocale
+	}
+	uid, err := domainUser.NewUserID(playerID)
+	if err != nil {
+		return quiz.DefaultLocale
+	}
+	u, err := h.userRepo.FindByID(uid)
+	if err != nil || u == nil {
+		return quiz.DefaultLocale
+	}
+	return quiz.NormalizeLocale(u.LanguageCode().String())
+}
+
+// playerLocales returns both players' languages. The bot reads whatever its opponent does.
+func (h *DuelWebSocketHub) playerLocales(state realtime.GameState) (locale1, locale2 string) {
+	locale1, locale2 = h.playerLocale(state.Player1ID), h.playerLocale(state.Player2ID)
+	switch appDuel.BotUserID {
+	case state.Player1ID:
+		locale1 = locale2
+	case state.Player2ID:
+		locale2 = locale1
+	}
+	return locale1, locale2
+}
+
+func (h *DuelWebSocketHub) notifyBothPlayersReady(ctx context.Context, gameID string, state realtime.GameState) {
+	if state.CurrentRound > 0 {
+		return // Already under way
+	}
+
 	// Use domain player order so game_ready.player1Id matches answer_result.player1Score.
 	// Domain order: player1 = challenger (set at game creation), player2 = accepter.
 	// Hub order (WS connection order) may differ — accepter usually connects first.
-	domainPlayer1ID := game.Player1ID
-	domainPlayer2ID := game.Player2ID
+	domainPlayer1ID := state.Player1ID
+	domainPlayer2ID := state.Player2ID
 	if h.startGameUC != nil {
-		if p1, p2, err := h.startGameUC.GetDomainPlayerOrder(game.ID); err == nil {
+		if p1, p2, err := h.startGameUC.GetDomainPlayerOrder(gameID); err == nil {
 			domainPlayer1ID = p1
 			domainPlayer2ID = p2
 		} else {
-			log.Printf("[DuelWS] GetDomainPlayerOrder failed for %s: %v (falling back to hub order)", game.ID, err)
+			log.Printf("[DuelWS] GetDomainPlayerOrder failed for %s: %v (falling back to hub order)", gameID, err)
 		}
 	}
 
@@ -338,175 +602,130 @@ func (h *DuelWebSocketHub) notifyBothPlayersReady(game *DuelGame) {
 	p1Username, p1Avatar := h.fetchPlayerInfo(domainPlayer1ID)
 	p2Username, p2Avatar := h.fetchPlayerInfo(domainPlayer2ID)
 
-	readyMsg := map[string]interface{}{
+	h.publish(ctx, gameID, "", map[string]interface{}{
 		"type": "game_ready",
 		"data": map[string]interface{}{
-			"gameId":          game.ID,
+			"gameId":          gameID,
 			"player1Id":       domainPlayer1ID,
 			"player2Id":       domainPlayer2ID,
 			"player1Username": p1Username,
 			"player1Avatar":   p1Avatar,
 			"player2Username": p2Username,
 			"player2Avatar":   p2Avatar,
-			"startsIn":        3,
+			"startsIn":        int(duelStartDelay.Seconds()),
 			"totalRounds":     quick_duel.QuestionsPerDuel,
 		},
-	}
-
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(readyMsg)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(readyMsg)
-	}
-
-	go func() {
-		time.Sleep(3 * time.Second)
-		h.startRound(game, 1)
-	}()
-}
-
-func (h *DuelWebSocketHub) handleMessage(gameID, playerID string, conn *websocket.Conn, msg DuelMessage) {
-	switch msg.Type {
-	case "submit_answer":
-		var data DuelSubmitAnswerData
-		if err := json.Unmarshal(msg.Data, &data); err != nil {
-			conn.WriteJSON(map[string]interface{}{
-				"type":  "error",
-				"error": "Invalid answer data",
-			})
-			return
-		}
-		h.handleSubmitAnswer(gameID, playerID, data)
-
-	case "player_ready":
-		// Player signals ready for next round
-		log.Printf("Player %s ready in game %s", playerID, gameID)
-
-	case "ping":
-		conn.WriteJSON(map[string]interface{}{"type": "pong"})
+	})
 
-	default:
-		log.Printf("Unknown message type: %s", msg.Type)
-	}
+	h.schedule(realtime.Step{GameID: gameID, Kind: realtime.StepStartRound, Round: 1}, duelStartDelay)
 }
 
-func (h *DuelWebSocketHub) handleSubmitAnswer(gameID, playerID string, data DuelSubmitAnswerData) {
+func (h *DuelWebSocketHub) handleSubmitAnswer(ctx context.Context, gameID, playerID string, payload json.RawMessage, state realtime.GameState) {
 	if h.submitAnswerUC == nil {
 		log.Printf("SubmitDuelAnswerUseCase not initialized")
 		return
 	}
 
-	output, err := h.submitAnswerUC.Execute(appDuel.SubmitDuelAnswerInput{
-		GameID:     gameID,
-		PlayerID:   playerID,
-		QuestionID: data.QuestionID,
-		AnswerID:   data.AnswerID,
-		TimeTaken:  data.TimeTaken,
-	})
-
-	h.mu.RLock()
-	game, exists := h.games[gameID]
-	h.mu.RUnlock()
-
-	if !exists {
-		return
-	}
-
-	game.mu.Lock()
-	defer game.mu.Unlock()
-
-	// Find the connection for the player who answered (for error reporting)
-	var playerConn *websocket.Conn
-	if game.Player1ID == playerID {
-		playerConn = game.Player1Conn
-	} else {
-		playerConn = game.Player2Conn
+	var step duelAnswerStep
+	if err := json.Unmarshal(payload, &step); err != nil {
+		return // Validated when received
 	}
+	data := step.Answer
 
+	output, err := h.submitAnswerUC.Execute(appDuel.SubmitDuelAnswerInput{
+		GameID:        gameID,
+		PlayerID:      playerID,
+		QuestionID:    data.QuestionID,
+		AnswerID:      data.AnswerID,
+		AnswerIDs:     data.AnswerIDs,
+		NumericAnswer: data.NumericAnswer,
+		TimeTaken:     data.TimeTaken,
+		ReceivedAtMs:  step.ReceivedAtMs,
+		LatencyMs:     step.LatencyMs,
+	})
 	if err != nil {
 		// Late answer after timeout — expected race, not an error for the user
 		if errors.Is(err, quick_duel.ErrPlayerAlreadyAnswered) {
 			return
 		}
-		if playerConn != nil {
-			playerConn.WriteJSON(map[string]interface{}{
-				"type":  "error",
-				"error": err.Error(),
-			})
-		}
+		h.publish(ctx, gameID, playerID, map[string]interface{}{
+			"type":  "error",
+			"error": err.Error(),
+		})
 		return
 	}
 
 	// Broadcast answer_result to BOTH players.
 	// Per spec and commit d886947: no pointsEarned field.
-	answerResult := map[string]interface{}{
+	result := map[string]interface{}{
+		"playerId":      playerID,
+		"questionId":    data.QuestionID,
+		"isCorrect":     output.IsCorrect,
+		"correctAnswer": output.CorrectAnswerID,
+		"timeTaken":     output.TimeTaken,
+		"player1Score":  output.Player1Score,
+		"player2Score":  output.Player2Score,
+	}
+	// The answer key of multi-select, ordering and numeric questions
+	if output.CorrectAnswerIDs != nil {
+		result["correctAnswers"] = output.CorrectAnswerIDs
+	}
+	if output.CorrectNumber != nil {
+		result["correctNumber"] = *output.CorrectNumber
+	}
+	h.publish(ctx, gameID, "", map[string]interface{}{
 		"type": "answer_result",
-		"data": map[string]interface{}{
-			"playerId":      playerID,
-			"questionId":    data.QuestionID,
-			"isCorrect":     output.IsCorrect,
-			"correctAnswer": output.CorrectAnswerID,
-			"timeTaken":     data.TimeTaken,
-			"player1Score":  output.Player1Score,
-			"player2Score":  output.Player2Score,
-		},
-	}
-
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(answerResult)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(answerResult)
-	}
+		"data": result,
+	})
 
 	// When game is finished, send game_complete (takes priority over round_complete).
 	// round_complete is NOT sent for the final round — game_complete carries final scores.
 	if output.GameComplete {
-		h.broadcastGameComplete(game, output)
+		h.broadcastGameComplete(ctx, gameID, output)
 		return
 	}
 
 	// Round complete — both players answered but the game continues
 	if output.RoundComplete {
-		h.broadcastRoundComplete(game, output)
-		// Schedule next question after delay (outside the lock)
-		nextRound := game.CurrentRound + 1
-		go func() {
-			time.Sleep(2 * time.Second)
-			h.startRound(game, nextRound)
-		}()
+		h.broadcastRoundComplete(ctx, gameID, state, output)
+		h.schedule(realtime.Step{GameID: gameID, Kind: realtime.StepStartRound, Round: state.CurrentRound + 1}, duelNextRoundDelay)
 	}
 }
 
-// broadcastRoundComplete sends round_complete to both players.
-// Must be called with game.mu held.
-func (h *DuelWebSocketHub) broadcastRoundComplete(game *DuelGame, output *appDuel.SubmitDuelAnswerOutput) {
-	roundCompleteMsg := map[string]interface{}{
-		"type": "round_complete",
-		"data": map[string]interface{}{
-			"roundNum":     game.CurrentRound,
+// broadcastRoundComplete sends round_complete to both players, each with the explanation in their language.
+func (h *DuelWebSocketHub) broadcastRoundComplete(ctx context.Context, gameID string, state realtime.GameState, output *appDuel.SubmitDuelAnswerOutput) {
+	message := func(locale string) map[string]interface{} {
+		data := map[string]interface{}{
+			"roundNum":     state.CurrentRound,
 			"player1Score": output.Player1Score,
 			"player2Score": output.Player2Score,
-			"nextRoundIn":  2, // seconds — per spec
-		},
+			"nextRoundIn":  int(duelNextRoundDelay.Seconds()), // per spec
+		}
+		if explanation := output.ExplanationIn(locale); explanation != nil {
+			data["explanation"] = explanation
+		}
+		return map[string]interface{}{
+			"type": "round_complete",
+			"data": data,
+		}
 	}
 
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(roundCompleteMsg)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(roundCompleteMsg)
+	locale1, locale2 := h.playerLocales(state)
+	if locale1 == locale2 {
+		h.publish(ctx, gameID, "", message(locale1))
+		return
 	}
+	h.publish(ctx, gameID, state.Player1ID, message(locale1))
+	h.publish(ctx, gameID, state.Player2ID, message(locale2))
 }
 
-// broadcastGameComplete sends game_complete to both players.
-// Must be called with game.mu held.
-func (h *DuelWebSocketHub) broadcastGameComplete(game *DuelGame, output *appDuel.SubmitDuelAnswerOutput) {
+// broadcastGameComplete sends game_complete to both players and keeps it for reconnecting ones.
+func (h *DuelWebSocketHub) broadcastGameComplete(ctx context.Context, gameID string, output *appDuel.SubmitDuelAnswerOutput) {
 	gameCompleteMsg := map[string]interface{}{
 		"type": "game_complete",
 		"data": map[string]interface{}{
 			"winnerId":         output.WinnerID,
+			"winReason":        output.WinReason,
 			"player1Score":     output.Player1Score,
 			"player2Score":     output.Player2Score,
 			"player1MMRChange": output.Player1MMRChange,
@@ -516,185 +735,202 @@ func (h *DuelWebSocketHub) broadcastGameComplete(game *DuelGame, output *appDuel
 		},
 	}
 
-	// Cache for reconnecting players
-	game.Finished = true
-	game.GameCompleteMsg = gameCompleteMsg
-
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(gameCompleteMsg)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(gameCompleteMsg)
+	// Cache for reconnecting players (dropped after realtime.FinishedGameTTL)
+	if body, err := json.Marshal(gameCompleteMsg); err == nil {
+		if err := h.coordinator.Finish(ctx, gameID, body); err != nil {
+			log.Printf("Game %s: failed to record finish: %v", gameID, err)
+		}
 	}
 
-	// Schedule game clean-up — do NOT hold the lock during the sleep
-	gameID := game.ID
-	go func() {
-		time.Sleep(30 * time.Second)
-		h.mu.Lock()
-		delete(h.games, gameID)
-		h.mu.Unlock()
-	}()
+	h.publish(ctx, gameID, "", gameCompleteMsg)
 }
 
-// startRound sends new_question to both players and arms the timeout goroutine.
-// Safe to call from any goroutine — acquires game.mu internally.
-func (h *DuelWebSocketHub) startRound(game *DuelGame, roundNum int) {
+// startRound sends new_question to both players and arms the round timeout.
+func (h *DuelWebSocketHub) startRound(ctx context.Context, gameID string, roundNum int, state realtime.GameState) {
 	if h.startGameUC == nil {
 		log.Printf("StartGameUseCase not initialized")
 		return
 	}
+	if state.CurrentRound >= roundNum {
+		return // Already started
+	}
 
-	// Get question data BEFORE acquiring the lock to avoid holding it during I/O.
-	output, err := h.startGameUC.GetRoundQuestion(game.ID, roundNum)
+	// Each player gets the question in their own language
+	locale1, locale2 := h.playerLocales(state)
+	output, err := h.startGameUC.GetRoundQuestion(gameID, roundNum, locale1)
 	if err != nil {
 		log.Printf("Failed to get question for round %d: %v", roundNum, err)
 		return
 	}
+	output2 := output
+	if locale2 != locale1 {
+		if output2, err = h.startGameUC.GetRoundQuestion(gameID, roundNum, locale2); err != nil {
+			log.Printf("Failed to get question for round %d: %v", roundNum, err)
+			return
+		}
+	}
 
-	game.mu.Lock()
+	if err := h.coordinator.SetRound(ctx, gameID, roundNum); err != nil {
+		log.Printf("Game %s: failed to record round %d: %v", gameID, roundNum, err)
+		return
+	}
 
-	game.CurrentRound = roundNum
+	// Answers are timed from now; without the stamp they fall back to the client's timing
+	if h.submitAnswerUC != nil {
+		sentAt, err := h.submitAnswerUC.MarkQuestionSent(gameID, roundNum, time.Now().UnixMilli())
+		if err != nil {
+			log.Printf("Game %s: failed to record when round %d was sent: %v", gameID, roundNum, err)
+		}
+		output.SentAt = sentAt
+		output2.SentAt = sentAt
+	}
 
-	questionMsg := map[string]interface{}{
-		"type": "new_question",
-		"data": map[string]interface{}{
-			"roundNum":    roundNum,
-			"totalRounds": quick_duel.QuestionsPerDuel,
-			"question": map[string]interface{}{
-				"id":        output.QuestionID,
-				"text":      output.QuestionText,
-				"answers":   output.Answers,
-				"timeLimit": quick_duel.TimePerQuestionSec,
-			},
-			"serverTime": time.Now().UnixMilli(),
-		},
+	if output2 == output {
+		h.publish(ctx, gameID, "", newQuestionMessage(roundNum, output))
+	} else {
+		h.publish(ctx, gameID, state.Player1ID, newQuestionMessage(roundNum, output))
+		h.publish(ctx, gameID, state.Player2ID, newQuestionMessage(roundNum, output2))
 	}
 
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(questionMsg)
+	// Arm the 10-second timeout
+	h.schedule(
+		realtime.Step{GameID: gameID, Kind: realtime.StepRoundTimeout, Round: roundNum},
+		time.Duration(quick_duel.TimePerQuestionSec)*time.Second,
+	)
+
+	h.scheduleBotAnswer(gameID, roundNum, state)
+}
+
+// ========================================
+// Bot opponent
+// ========================================
+
+// botConnID is the bot's permanent connection in the shared game state
+const botConnID = "bot"
+
+func (h *DuelWebSocketHub) isBotGame(gameID string) bool {
+	if h.botPlayerUC == nil {
+		return false
 	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(questionMsg)
+	isBotGame, err := h.botPlayerUC.IsBotGame(gameID)
+	if err != nil {
+		log.Printf("Game %s: cannot tell whether it is a bot game: %v", gameID, err)
 	}
+	return isBotGame
+}
 
-	game.mu.Unlock()
+// scheduleBotAnswer has the bot answer the round after its thinking time,
+// through the same answer step as a human's answer
+func (h *DuelWebSocketHub) scheduleBotAnswer(gameID string, roundNum int, state realtime.GameState) {
+	if h.botPlayerUC == nil || state.Opponent(appDuel.BotUserID) == "" {
+		return
+	}
 
-	// Arm the 10-second timeout AFTER releasing the lock
-	go func() {
-		time.Sleep(time.Duration(quick_duel.TimePerQuestionSec) * time.Second)
-		h.checkRoundTimeout(game, roundNum)
-	}()
+	plan, err := h.botPlayerUC.PlanAnswer(gameID, roundNum)
+	if err != nil {
+		log.Printf("Game %s: bot cannot answer round %d: %v", gameID, roundNum, err)
+		return
+	}
+	if plan == nil {
+		return
+	}
+
+	payload, err := json.Marshal(duelAnswerStep{Answer: DuelSubmitAnswerData{
+		PlayerID:      plan.PlayerID,
+		GameID:        plan.GameID,
+		QuestionID:    plan.QuestionID,
+		AnswerID:      plan.AnswerID,
+		TimeTaken:     plan.TimeTaken,
+		AnswerIDs:     plan.AnswerIDs,
+		NumericAnswer: plan.NumericAnswer,
+	}})
+	if err != nil {
+		log.Printf("Game %s: failed to encode bot answer: %v", gameID, err)
+		return
+	}
+
+	h.schedule(realtime.Step{
+		GameID:   gameID,
+		Kind:     realtime.StepAnswer,
+		PlayerID: plan.PlayerID,
+		Payload:  payload,
+	}, time.Duration(plan.TimeTaken)*time.Millisecond)
 }
 
 // resendCurrentQuestion sends the current question to a reconnecting player.
-// Must be called WITHOUT game.mu held (it calls startRound which acquires the lock).
-func (h *DuelWebSocketHub) resendCurrentQuestion(game *DuelGame, roundNum int) {
+func (h *DuelWebSocketHub) resendCurrentQuestion(game *DuelGame, playerID string, roundNum int) {
 	if h.startGameUC == nil {
 		return
 	}
 
-	output, err := h.startGameUC.GetRoundQuestion(game.ID, roundNum)
+	output, err := h.startGameUC.GetRoundQuestion(game.ID, roundNum, h.playerLocale(playerID))
 	if err != nil {
 		log.Printf("Failed to resend question for round %d: %v", roundNum, err)
 		return
 	}
 
-	game.mu.Lock()
-	defer game.mu.Unlock()
+	h.send(game, playerID, newQuestionMessage(roundNum, output))
+}
 
-	// Only resend if we're still on the same round
-	if game.CurrentRound != roundNum {
-		return
+func newQuestionMessage(roundNum int, output *appDuel.RoundQuestionOutput) map[string]interface{} {
+	question := map[string]interface{}{
+		"id":        output.QuestionID,
+		"type":      output.QuestionType,
+		"text":      output.QuestionText,
+		"answers":   output.Answers, // each may carry its own media
+		"timeLimit": quick_duel.TimePerQuestionSec,
 	}
-
-	questionMsg := map[string]interface{}{
+	if output.QuestionMedia != nil {
+		question["media"] = output.QuestionMedia
+	}
+	return map[string]interface{}{
 		"type": "new_question",
 		"data": map[string]interface{}{
 			"roundNum":    roundNum,
 			"totalRounds": quick_duel.QuestionsPerDuel,
-			"question": map[string]interface{}{
-				"id":        output.QuestionID,
-				"text":      output.QuestionText,
-				"answers":   output.Answers,
-				"timeLimit": quick_duel.TimePerQuestionSec,
-			},
-			"serverTime": time.Now().UnixMilli(),
+			"question":    question,
+			"sentAt":      output.SentAt, // the round's clock started here; a resend keeps it
+			"serverTime":  time.Now().UnixMilli(),
 		},
 	}
-
-	// Reconnecting player is whichever slot just regained a non-nil conn.
-	// Broadcast to both: a player who already got it will simply ignore a duplicate.
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(questionMsg)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(questionMsg)
-	}
 }
 
-// checkRoundTimeout fires when the per-question timer expires.
-// If the round hasn't advanced yet (CurrentRound == roundNum), it records
-// a timeout for any player who hasn't answered and broadcasts round_timeout.
-func (h *DuelWebSocketHub) checkRoundTimeout(game *DuelGame, roundNum int) {
-	game.mu.Lock()
+// checkRoundTimeout runs when the per-question timer expires.
+// If the round hasn't advanced yet, it records a timeout for any player
+// who hasn't answered and broadcasts round_timeout.
+func (h *DuelWebSocketHub) checkRoundTimeout(ctx context.Context, gameID string, roundNum int, state realtime.GameState) {
+	// Guard: round already advanced
+	if state.CurrentRound != roundNum || h.submitAnswerUC == nil {
+		return
+	}
 
-	// Guard: round already advanced (both answered in time) or game finished
-	if game.CurrentRound != roundNum {
-		game.mu.Unlock()
+	// Record timeout answers for any players who haven't answered yet.
+	// This also advances game.currentRound in the domain so future answers
+	// are validated against the correct question.
+	output, err := h.submitAnswerUC.TimeoutRound(gameID, roundNum)
+	if err != nil {
+		log.Printf("Game %s: TimeoutRound(%d) error: %v", gameID, roundNum, err)
 		return
 	}
+	if output == nil {
+		return // Both players answered in time; the next round is already scheduled
+	}
 
 	// Notify players of timeout
-	timeoutMsg := map[string]interface{}{
+	h.publish(ctx, gameID, "", map[string]interface{}{
 		"type": "round_timeout",
 		"data": map[string]interface{}{
 			"roundNum": roundNum,
 		},
-	}
-
-	if game.Player1Conn != nil {
-		game.Player1Conn.WriteJSON(timeoutMsg)
-	}
-	if game.Player2Conn != nil {
-		game.Player2Conn.WriteJSON(timeoutMsg)
-	}
-
-	isLastRound := roundNum >= quick_duel.QuestionsPerDuel
-	nextRound := roundNum + 1
-	gameID := game.ID
-
-	game.mu.Unlock()
-
-	// Record timeout answers for any players who haven't answered yet.
-	// This also advances game.currentRound in the domain so future answers
-	// are validated against the correct question.
-	var timeoutOutput *appDuel.SubmitDuelAnswerOutput
-	if h.submitAnswerUC != nil {
-		out, err := h.submitAnswerUC.TimeoutRound(gameID, roundNum)
-		if err != nil {
-			log.Printf("Game %s: TimeoutRound(%d) error: %v", gameID, roundNum, err)
-		}
-		timeoutOutput = out
-	}
+	})
 
-	if timeoutOutput != nil && timeoutOutput.GameComplete {
-		h.mu.RLock()
-		g, ok := h.games[gameID]
-		h.mu.RUnlock()
-		if ok {
-			g.mu.Lock()
-			h.broadcastGameComplete(g, timeoutOutput)
-			g.mu.Unlock()
-		}
+	if output.GameComplete {
+		h.broadcastGameComplete(ctx, gameID, output)
 		return
 	}
 
-	if !isLastRound {
-		go func() {
-			time.Sleep(2 * time.Second)
-			h.startRound(game, nextRound)
-		}()
+	if roundNum < quick_duel.QuestionsPerDuel {
+		h.schedule(realtime.Step{GameID: gameID, Kind: realtime.StepStartRound, Round: roundNum + 1}, duelNextRoundDelay)
 	}
 }
 
@@ -704,14 +940,36 @@ func (h *DuelWebSocketHub) NotifyGameCreated(gameID, player1ID, player2ID string
 	log.Printf("Game %s created: %s vs %s", gameID, player1ID, player2ID)
 }
 
-// handleRoundComplete is kept for backward compatibility but is no longer called
-// directly — logic moved inline into handleSubmitAnswer.
-func (h *DuelWebSocketHub) handleRoundComplete(game *DuelGame, output *appDuel.SubmitDuelAnswerOutput) {
-	h.broadcastRoundComplete(game, output)
+// duelStepLocks runs the steps of one game one at a time
+type duelStepLocks struct {
+	mu    sync.Mutex
+	locks map[string]*duelStepLock
+}
+
+type duelStepLock struct {
+	mu   sync.Mutex
+	refs int
 }
 
-// handleGameComplete is kept for backward compatibility but is no longer called
-// directly — logic moved inline into handleSubmitAnswer.
-func (h *DuelWebSocketHub) handleGameComplete(game *DuelGame, output *appDuel.SubmitDuelAnswerOutput) {
-	h.broadcastGameComplete(game, output)
+func (l *duelStepLocks) lock(gameID string) func() {
+	l.mu.Lock()
+	lock, ok := l.locks[gameID]
+	if !ok {
+		lock = &duelStepLock{}
+		l.locks[gameID] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+		l.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(l.locks, gameID)
+		}
+		l.mu.Unlock()
+	}
 }
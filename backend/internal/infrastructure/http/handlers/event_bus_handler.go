@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/messaging"
+)
+
+// EventBusHandler exposes the domain event bus metrics to admins
+type EventBusHandler struct {
+	bus *messaging.Bus
+}
+
+func NewEventBusHandler(bus *messaging.Bus) *EventBusHandler {
+	return &EventBusHandler{bus: bus}
+}
+
+// ListEventHandlers handles GET /api/v1/admin/events/handlers
+// @Summary List event handlers
+// @Description Subscriptions of the domain event bus with their call, failure and panic counters
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {object} AdminListEventHandlersResponse "Event handlers"
+// @Router /admin/events/handlers [get]
+func (h *EventBusHandler) ListEventHandlers(c fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"data": fiber.Map{
+			"handlers": h.bus.Stats(),
+		},
+	})
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+)
+
+// idempotencyKeyHeader carries a client-generated key that makes a paid request safe to retry
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds the stored key (UUIDs and similar fit comfortably)
+const maxIdempotencyKeyLength = 128
+
+// getIdempotencyKey returns the request's idempotency key, or "" if the client sent none
+func getIdempotencyKey(c fiber.Ctx) (string, error) {
+	key := c.Get(idempotencyKeyHeader)
+	if len(key) > maxIdempotencyKeyLength {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Idempotency-Key is too long")
+	}
+	return key, nil
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// InventoryHandler exposes the inventory ledger: transaction history to players
+// and support, maintenance to admins
+type InventoryHandler struct {
+	getTransactionHistoryUC *appUser.GetTransactionHistoryUseCase
+	reconcileUC             *appUser.ReconcileInventoryUseCase
+}
+
+func NewInventoryHandler(
+	getTransactionHistoryUC *appUser.GetTransactionHistoryUseCase,
+	reconcileUC *appUser.ReconcileInventoryUseCase,
+) *InventoryHandler {
+	return &InventoryHandler{
+		getTransactionHistoryUC: getTransactionHistoryUC,
+		reconcileUC:             reconcileUC,
+	}
+}
+
+// GetTransactions handles GET /api/v1/user/:id/transactions
+// @Summary Get transaction history
+// @Description Where the player's coins, tickets and bonuses came from and went, newest first, with cursor pagination. The first page also carries a per-day summary.
+// @Tags user
+// @Produce json
+// @Param id path string true "User ID (must be the authenticated user)"
+// @Param cursor query string false "nextCursor of the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param resource query string false "Only transactions changing this resource (coins, pvp_tickets, shield, fifty_fifty, skip, freeze)"
+// @Param source query string false "Only transactions from this source (e.g. streak_recovery, daily_chest, seasonal_reward, referral_milestone)"
+// @Param days query int false "Days covered by the summary (default 30, max 90)"
+// @Success 200 {object} GetTransactionHistoryResponse "Transaction history"
+// @Failure 400 {object} ErrorResponse "Invalid filter or cursor"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Not the authenticated user"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /user/{id}/transactions [get]
+func (h *InventoryHandler) GetTransactions(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerIDMatching(c, c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return h.transactionHistory(c, playerID)
+}
+
+// GetPlayerTransactionsForSupport handles GET /api/v1/admin/user/:id/transactions
+// @Summary Get a player's transaction history (support)
+// @Description Same as /user/{id}/transactions, for any player
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param id path string true "User ID"
+// @Param cursor query string false "nextCursor of the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param resource query string false "Only transactions changing this resource"
+// @Param source query string false "Only transactions from this source"
+// @Param days query int false "Days covered by the summary (default 30, max 90)"
+// @Success 200 {object} GetTransactionHistoryResponse "Transaction history"
+// @Failure 400 {object} ErrorResponse "Invalid filter or cursor"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /admin/user/{id}/transactions [get]
+func (h *InventoryHandler) GetPlayerTransactionsForSupport(c fiber.Ctx) error {
+	return h.transactionHistory(c, c.Params("id"))
+}
+
+func (h *InventoryHandler) transactionHistory(c fiber.Ctx, playerID string) error {
+	output, err := h.getTransactionHistoryUC.Execute(appUser.GetTransactionHistoryInput{
+		PlayerID: playerID,
+		Cursor:   c.Query("cursor"),
+		Limit:    fiber.Query[int](c, "limit", 0),
+		Resource: c.Query("resource"),
+		Source:   c.Query("source"),
+		Days:     fiber.Query[int](c, "days", 0),
+	})
+	if err != nil {
+		return mapInventoryError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// ReconcileInventory handles POST /api/v1/admin/inventory/reconcile
+// @Summary Reconcile inventories
+// @Description Replay transaction logs against inventory balances and list the drifted ones. With fix, drifted balances are overwritten with the replayed ones (unless they would be negative or changed meanwhile).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param request body AdminReconcileInventoryRequest true "Reconcile request"
+// @Success 200 {object} AdminReconcileInventoryResponse "Reconciliation report"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /admin/inventory/reconcile [post]
+func (h *InventoryHandler) ReconcileInventory(c fiber.Ctx) error {
+	var req AdminReconcileInventoryRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	output, err := h.reconcileUC.Execute(appUser.ReconcileInventoryInput{
+		PlayerID: req.PlayerID,
+		Fix:      req.Fix,
+	})
+	if err != nil {
+		return mapInventoryError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapInventoryError maps inventory domain errors to HTTP errors
+func mapInventoryError(err error) error {
+	switch {
+	case errors.Is(err, shared.ErrInvalidUserID), errors.Is(err, domainUser.ErrInvalidUserID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	case errors.Is(err, domainUser.ErrInvalidResource):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid resource")
+	case errors.Is(err, domainUser.ErrInvalidTransactionSource):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid source")
+	case errors.Is(err, domainUser.ErrInvalidTransactionCursor):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid cursor")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+}
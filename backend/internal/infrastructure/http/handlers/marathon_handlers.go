@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v3"
 
 	appMarathon "github.com/barsukov/quiz-sprint/backend/internal/application/marathon"
+	domainAdReward "github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
 	domainMarathon "github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
 	domainQuiz "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
@@ -60,8 +61,11 @@ func NewMarathonHandler(
 // @Param request body StartMarathonRequest true "Start marathon request"
 // @Success 201 {object} StartMarathonResponse "Marathon game started with first question"
 // @Failure 400 {object} ErrorResponse "Invalid request or player ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 409 {object} ErrorResponse "Active game already exists"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/start [post]
 func (h *MarathonHandler) StartMarathon(c fiber.Ctx) error {
 	var req StartMarathonRequest
@@ -69,13 +73,15 @@ func (h *MarathonHandler) StartMarathon(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
 
 	output, err := h.startMarathonUC.Execute(appMarathon.StartMarathonInput{
-		PlayerID:   req.PlayerID,
+		PlayerID:   playerID,
 		CategoryID: req.CategoryID,
+		Locale:     getRequestLocale(c),
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -97,8 +103,10 @@ func (h *MarathonHandler) StartMarathon(c fiber.Ctx) error {
 // @Success 200 {object} SubmitMarathonAnswerResponse "Answer result with next question or game over details"
 // @Failure 400 {object} ErrorResponse "Invalid request, game not in progress, or wrong question"
 // @Failure 401 {object} ErrorResponse "Unauthorized - game belongs to another player"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game, question, or answer not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/{gameId}/answer [post]
 func (h *MarathonHandler) SubmitMarathonAnswer(c fiber.Ctx) error {
 	var req SubmitMarathonAnswerRequest
@@ -106,14 +114,16 @@ func (h *MarathonHandler) SubmitMarathonAnswer(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
 	if req.QuestionID == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "questionId is required")
 	}
-	if req.AnswerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "answerId is required")
-	}
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	if !hasAnswer(req.AnswerID, req.AnswerIDs, req.NumericAnswer) {
+		return fiber.NewError(fiber.StatusBadRequest, "answerId, answerIds or numericAnswer is required")
 	}
 	if req.TimeTaken < 0 {
 		return fiber.NewError(fiber.StatusBadRequest, "timeTaken must be non-negative")
@@ -123,8 +133,12 @@ func (h *MarathonHandler) SubmitMarathonAnswer(c fiber.Ctx) error {
 		GameID:     c.Params("gameId"),
 		QuestionID: req.QuestionID,
 		AnswerID:   req.AnswerID,
-		PlayerID:   req.PlayerID,
+		PlayerID:   playerID,
 		TimeTaken:  req.TimeTaken,
+		Locale:     getRequestLocale(c),
+
+		AnswerIDs:     req.AnswerIDs,
+		NumericAnswer: req.NumericAnswer,
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -146,8 +160,10 @@ func (h *MarathonHandler) SubmitMarathonAnswer(c fiber.Ctx) error {
 // @Success 200 {object} UseMarathonBonusResponse "Bonus result with remaining inventory"
 // @Failure 400 {object} ErrorResponse "Invalid request, bonus not available, or wrong question"
 // @Failure 401 {object} ErrorResponse "Unauthorized - game belongs to another player"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game or question not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/{gameId}/bonus [post]
 func (h *MarathonHandler) UseMarathonBonus(c fiber.Ctx) error {
 	var req UseMarathonBonusRequest
@@ -155,15 +171,17 @@ func (h *MarathonHandler) UseMarathonBonus(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
+	}
+
 	if req.QuestionID == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "questionId is required")
 	}
 	if req.BonusType == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "bonusType is required")
 	}
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
-	}
 
 	// Validate bonus type
 	validBonuses := map[string]bool{
@@ -180,7 +198,8 @@ func (h *MarathonHandler) UseMarathonBonus(c fiber.Ctx) error {
 		GameID:     c.Params("gameId"),
 		QuestionID: req.QuestionID,
 		BonusType:  req.BonusType,
-		PlayerID:   req.PlayerID,
+		PlayerID:   playerID,
+		Locale:     getRequestLocale(c),
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -199,11 +218,15 @@ func (h *MarathonHandler) UseMarathonBonus(c fiber.Ctx) error {
 // @Produce json
 // @Param gameId path string true "Game ID"
 // @Param request body ContinueMarathonRequest true "Continue request"
+// @Param Idempotency-Key header string false "Client-generated key: a retried request is charged once"
 // @Success 200 {object} ContinueMarathonResponse "Game resumed with 1 life"
 // @Failure 400 {object} ErrorResponse "Invalid request or game not in game_over state"
 // @Failure 401 {object} ErrorResponse "Unauthorized - game belongs to another player"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
+// @Failure 429 {object} ErrorResponse "Daily ad reward limit reached"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/{gameId}/continue [post]
 func (h *MarathonHandler) ContinueMarathon(c fiber.Ctx) error {
 	var req ContinueMarathonRequest
@@ -211,9 +234,11 @@ func (h *MarathonHandler) ContinueMarathon(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
+
 	if req.PaymentMethod == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "paymentMethod is required")
 	}
@@ -223,10 +248,18 @@ func (h *MarathonHandler) ContinueMarathon(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "paymentMethod must be 'coins' or 'ad'")
 	}
 
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
+
 	output, err := h.continueMarathonUC.Execute(appMarathon.ContinueMarathonInput{
-		GameID:        c.Params("gameId"),
-		PlayerID:      req.PlayerID,
-		PaymentMethod: req.PaymentMethod,
+		GameID:         c.Params("gameId"),
+		PlayerID:       playerID,
+		PaymentMethod:  req.PaymentMethod,
+		AdNonce:        req.AdNonce,
+		IdempotencyKey: idempotencyKey,
+		Locale:         getRequestLocale(c),
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -248,8 +281,10 @@ func (h *MarathonHandler) ContinueMarathon(c fiber.Ctx) error {
 // @Success 200 {object} AbandonMarathonResponse "Game abandoned with final statistics"
 // @Failure 400 {object} ErrorResponse "Invalid game ID or game not in progress"
 // @Failure 401 {object} ErrorResponse "Unauthorized - game belongs to another player"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/{gameId} [delete]
 func (h *MarathonHandler) AbandonMarathon(c fiber.Ctx) error {
 	var req AbandonMarathonRequest
@@ -257,13 +292,14 @@ func (h *MarathonHandler) AbandonMarathon(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
 
 	output, err := h.abandonMarathonUC.Execute(appMarathon.AbandonMarathonInput{
 		GameID:   c.Params("gameId"),
-		PlayerID: req.PlayerID,
+		PlayerID: playerID,
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -285,8 +321,10 @@ func (h *MarathonHandler) AbandonMarathon(c fiber.Ctx) error {
 // @Success 200 {object} AbandonMarathonResponse "Game completed with final statistics"
 // @Failure 400 {object} ErrorResponse "Invalid game ID or game not in game_over state"
 // @Failure 401 {object} ErrorResponse "Unauthorized - game belongs to another player"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Game not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/{gameId}/complete [post]
 func (h *MarathonHandler) CompleteMarathon(c fiber.Ctx) error {
 	var req AbandonMarathonRequest
@@ -294,13 +332,14 @@ func (h *MarathonHandler) CompleteMarathon(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if req.PlayerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId is required")
+	playerID, err := getAuthPlayerIDMatching(c, req.PlayerID)
+	if err != nil {
+		return err
 	}
 
 	output, err := h.completeMarathonUC.Execute(appMarathon.CompleteMarathonInput{
 		GameID:   c.Params("gameId"),
-		PlayerID: req.PlayerID,
+		PlayerID: playerID,
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -317,19 +356,23 @@ func (h *MarathonHandler) CompleteMarathon(c fiber.Ctx) error {
 // @Tags marathon
 // @Accept json
 // @Produce json
-// @Param playerId query string true "Player ID"
+// @Param playerId query string false "Player ID (optional; must match the authenticated user)"
 // @Success 200 {object} GetMarathonStatusResponse "Marathon game status"
 // @Failure 400 {object} ErrorResponse "Invalid player ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/status [get]
 func (h *MarathonHandler) GetMarathonStatus(c fiber.Ctx) error {
-	playerID := c.Query("playerId")
-	if playerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId query parameter is required")
+	playerID, err := getAuthPlayerIDMatching(c, c.Query("playerId"))
+	if err != nil {
+		return err
 	}
 
 	output, err := h.getStatusUC.Execute(appMarathon.GetMarathonStatusInput{
 		PlayerID: playerID,
+		Locale:   getRequestLocale(c),
 	})
 	if err != nil {
 		return mapMarathonError(err)
@@ -346,16 +389,19 @@ func (h *MarathonHandler) GetMarathonStatus(c fiber.Ctx) error {
 // @Tags marathon
 // @Accept json
 // @Produce json
-// @Param playerId query string true "Player ID"
+// @Param playerId query string false "Player ID (optional; must match the authenticated user)"
 // @Success 200 {object} GetPersonalBestsResponse "Personal best records"
 // @Failure 400 {object} ErrorResponse "Invalid player ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "playerId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "No personal bests found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /marathon/personal-bests [get]
 func (h *MarathonHandler) GetPersonalBests(c fiber.Ctx) error {
-	playerID := c.Query("playerId")
-	if playerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "playerId query parameter is required")
+	playerID, err := getAuthPlayerIDMatching(c, c.Query("playerId"))
+	if err != nil {
+		return err
 	}
 
 	output, err := h.getPersonalBestsUC.Execute(appMarathon.GetPersonalBestsInput{
@@ -443,6 +489,7 @@ func mapMarathonError(err error) error {
 
 	case domainQuiz.ErrInvalidQuestionID,
 		domainQuiz.ErrInvalidAnswerID,
+		domainQuiz.ErrInvalidSubmission,
 		domainQuiz.ErrInvalidCategoryID:
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 
@@ -465,6 +512,8 @@ func mapMarathonError(err error) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Bonus already used for this question")
 	case domainMarathon.ErrShieldAlreadyActive:
 		return fiber.NewError(fiber.StatusBadRequest, "Shield is already active")
+	case domainMarathon.ErrBonusNotApplicable:
+		return fiber.NewError(fiber.StatusBadRequest, "Bonus does not apply to this question type")
 	case domainMarathon.ErrNoLivesRemaining:
 		return fiber.NewError(fiber.StatusBadRequest, "No lives remaining")
 	case domainMarathon.ErrNoQuestionsAvailable:
@@ -475,6 +524,10 @@ func mapMarathonError(err error) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Continue not available in current game state")
 	case domainMarathon.ErrInsufficientCoins:
 		return fiber.NewError(fiber.StatusBadRequest, "Insufficient coins for continue")
+	case domainMarathon.ErrAdNotVerified:
+		return fiber.NewError(fiber.StatusBadRequest, "Rewarded ad not verified")
+	case domainAdReward.ErrDailyCapReached:
+		return fiber.NewError(fiber.StatusTooManyRequests, "Daily ad reward limit reached")
 
 	// Default: Internal Server Error
 	default:
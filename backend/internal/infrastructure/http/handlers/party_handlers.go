@@ -0,0 +1,323 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+
+	appParty "github.com/barsukov/quiz-sprint/backend/internal/application/party_mode"
+	domainParty "github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	domainQuiz "github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// PartyHandler handles HTTP requests for Party Mode (private multiplayer rooms)
+type PartyHandler struct {
+	createRoomUC   *appParty.CreateRoomUseCase
+	joinRoomUC     *appParty.JoinRoomUseCase
+	getRoomUC      *appParty.GetRoomUseCase
+	setReadyUC     *appParty.SetReadyUseCase
+	startGameUC    *appParty.StartPartyGameUseCase
+	leaveRoomUC    *appParty.LeavePartyUseCase
+	submitAnswerUC *appParty.SubmitPartyAnswerUseCase
+}
+
+func NewPartyHandler(
+	createRoomUC *appParty.CreateRoomUseCase,
+	joinRoomUC *appParty.JoinRoomUseCase,
+	getRoomUC *appParty.GetRoomUseCase,
+	setReadyUC *appParty.SetReadyUseCase,
+	startGameUC *appParty.StartPartyGameUseCase,
+	leaveRoomUC *appParty.LeavePartyUseCase,
+	submitAnswerUC *appParty.SubmitPartyAnswerUseCase,
+) *PartyHandler {
+	return &PartyHandler{
+		createRoomUC:   createRoomUC,
+		joinRoomUC:     joinRoomUC,
+		getRoomUC:      getRoomUC,
+		setReadyUC:     setReadyUC,
+		startGameUC:    startGameUC,
+		leaveRoomUC:    leaveRoomUC,
+		submitAnswerUC: submitAnswerUC,
+	}
+}
+
+// CreateRoom handles POST /api/v1/party/rooms
+// @Summary Create party room
+// @Description Create a private room; the caller becomes the host
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param request body CreatePartyRoomRequest true "Room settings (all optional)"
+// @Success 201 {object} PartyRoomResponse "Room created"
+// @Failure 400 {object} ErrorResponse "Invalid settings"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms [post]
+func (h *PartyHandler) CreateRoom(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	var req CreatePartyRoomRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	output, err := h.createRoomUC.Execute(appParty.CreateRoomInput{
+		PlayerID:        playerID,
+		Username:        getAuthUsername(c),
+		Name:            req.Name,
+		MaxPlayers:      req.MaxPlayers,
+		QuestionsCount:  req.QuestionsCount,
+		TimePerQuestion: req.TimePerQuestion,
+		Difficulty:      req.Difficulty,
+		CategoryIDs:     req.CategoryIDs,
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// JoinRoom handles POST /api/v1/party/rooms/join
+// @Summary Join party room
+// @Description Join a room in the lobby by its code
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param request body JoinPartyRoomRequest true "Join request"
+// @Success 200 {object} PartyRoomResponse "Joined room"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 404 {object} ErrorResponse "Room not found"
+// @Failure 409 {object} ErrorResponse "Room full, started or closed"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms/join [post]
+func (h *PartyHandler) JoinRoom(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	var req JoinPartyRoomRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	if req.RoomCode == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "roomCode is required")
+	}
+
+	output, err := h.joinRoomUC.Execute(appParty.JoinRoomInput{
+		PlayerID: playerID,
+		Username: getAuthUsername(c),
+		RoomCode: req.RoomCode,
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// GetRoom handles GET /api/v1/party/rooms/:roomId
+// @Summary Get party room
+// @Description Get room state and, once started, the game scoreboard
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 200 {object} GetPartyRoomResponse "Room state"
+// @Failure 403 {object} ErrorResponse "Not a member of this room"
+// @Failure 404 {object} ErrorResponse "Room not found"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms/{roomId} [get]
+func (h *PartyHandler) GetRoom(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.getRoomUC.Execute(appParty.GetRoomInput{
+		PlayerID: playerID,
+		RoomID:   c.Params("roomId"),
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// SetReady handles POST /api/v1/party/rooms/:roomId/ready
+// @Summary Toggle ready status
+// @Description Mark the caller ready (or not ready) in the lobby
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Param request body SetPartyReadyRequest true "Ready flag"
+// @Success 200 {object} PartyRoomResponse "Updated room"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 404 {object} ErrorResponse "Room not found"
+// @Failure 409 {object} ErrorResponse "Game already started"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms/{roomId}/ready [post]
+func (h *PartyHandler) SetReady(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	var req SetPartyReadyRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	output, err := h.setReadyUC.Execute(appParty.SetReadyInput{
+		PlayerID: playerID,
+		RoomID:   c.Params("roomId"),
+		Ready:    req.Ready,
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// StartGame handles POST /api/v1/party/rooms/:roomId/start
+// @Summary Start party game
+// @Description Host starts the game once every player is ready
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 200 {object} StartPartyGameResponse "Game started"
+// @Failure 403 {object} ErrorResponse "Only host can start"
+// @Failure 404 {object} ErrorResponse "Room not found"
+// @Failure 409 {object} ErrorResponse "Players not ready or not enough questions"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms/{roomId}/start [post]
+func (h *PartyHandler) StartGame(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.startGameUC.Execute(appParty.StartPartyGameInput{
+		PlayerID: playerID,
+		RoomID:   c.Params("roomId"),
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// LeaveRoom handles POST /api/v1/party/rooms/:roomId/leave
+// @Summary Leave party room
+// @Description Leave the lobby or an in-progress game (score is kept on the final board)
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param roomId path string true "Room ID"
+// @Success 200 {object} LeavePartyRoomResponse "Left room"
+// @Failure 404 {object} ErrorResponse "Room not found"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/rooms/{roomId}/leave [post]
+func (h *PartyHandler) LeaveRoom(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.leaveRoomUC.Execute(appParty.LeavePartyInput{
+		PlayerID: playerID,
+		RoomID:   c.Params("roomId"),
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// SubmitAnswer handles POST /api/v1/party/game/:gameId/answer
+// @Summary Submit party answer
+// @Description Answer the current question. Also available over the party WebSocket.
+// @Tags party
+// @Accept json
+// @Produce json
+// @Param gameId path string true "Game ID"
+// @Param request body SubmitPartyAnswerRequest true "Answer"
+// @Success 200 {object} SubmitPartyAnswerResponse "Answer result"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 404 {object} ErrorResponse "Game not found"
+// @Failure 409 {object} ErrorResponse "Already answered or game not active"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /party/game/{gameId}/answer [post]
+func (h *PartyHandler) SubmitAnswer(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	var req SubmitPartyAnswerRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	if req.AnswerID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "answerId is required")
+	}
+
+	output, err := h.submitAnswerUC.Execute(appParty.SubmitPartyAnswerInput{
+		PlayerID:   playerID,
+		GameID:     c.Params("gameId"),
+		QuestionID: req.QuestionID,
+		AnswerID:   req.AnswerID,
+		TimeTaken:  req.TimeTaken,
+	})
+	if err != nil {
+		return mapPartyError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapPartyError maps domain errors to HTTP errors
+func mapPartyError(err error) error {
+	switch err {
+	case domainParty.ErrRoomNotFound:
+		return fiber.NewError(fiber.StatusNotFound, "Room not found")
+	case domainParty.ErrGameNotFound:
+		return fiber.NewError(fiber.StatusNotFound, "Game not found")
+	case domainParty.ErrInvalidRoomCode, domainParty.ErrInvalidRoomSettings:
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case domainParty.ErrRoomFull:
+		return fiber.NewError(fiber.StatusConflict, "Room is full")
+	case domainParty.ErrRoomAlreadyStarted:
+		return fiber.NewError(fiber.StatusConflict, "Game already started")
+	case domainParty.ErrRoomClosed:
+		return fiber.NewError(fiber.StatusConflict, "Room is closed")
+	case domainParty.ErrPlayerNotFound:
+		return fiber.NewError(fiber.StatusForbidden, "Player not in this room")
+	case domainParty.ErrOnlyHostCanStart:
+		return fiber.NewError(fiber.StatusForbidden, "Only host can start the game")
+	case domainParty.ErrNotEnoughPlayers:
+		return fiber.NewError(fiber.StatusConflict, "Not enough players (minimum 2)")
+	case domainParty.ErrNotAllPlayersReady:
+		return fiber.NewError(fiber.StatusConflict, "Not all players are ready")
+	case domainParty.ErrNotEnoughQuestions:
+		return fiber.NewError(fiber.StatusConflict, "Not enough questions for the selected settings")
+	case domainParty.ErrGameNotActive:
+		return fiber.NewError(fiber.StatusConflict, "Game is not active")
+	case domainParty.ErrPlayerAlreadyAnswered:
+		return fiber.NewError(fiber.StatusConflict, "Question already answered")
+	case domainQuiz.ErrAnswerNotFound, domainQuiz.ErrInvalidAnswerID:
+		return fiber.NewError(fiber.StatusBadRequest, "Answer not found")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal server error")
+	}
+}
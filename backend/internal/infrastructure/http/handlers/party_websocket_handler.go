@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/v3/websocket"
+
+	appParty "github.com/barsukov/quiz-sprint/backend/internal/application/party_mode"
+	domainParty "github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+)
+
+// PartyWebSocketHub manages WebSocket connections for party rooms.
+// Implements appParty.PartyHub: domain events are broadcast to every player
+// in the room, and the hub drives the per-question timer.
+type PartyWebSocketHub struct {
+	mu    sync.RWMutex
+	rooms map[string]*partyRoomConns // room ID -> connections
+
+	getRoomUC      *appParty.GetRoomUseCase
+	getQuestionUC  *appParty.GetPartyQuestionUseCase
+	submitAnswerUC *appParty.SubmitPartyAnswerUseCase
+
+	// questionDelay is the pause before each question is shown
+	questionDelay time.Duration
+}
+
+// partyRoomConns holds the connections of one room.
+// mu serializes writes: a WebSocket connection allows a single writer at a time.
+type partyRoomConns struct {
+	mu    sync.Mutex
+	conns map[string]LobbyConn // player ID -> connection
+}
+
+// PartySubmitAnswerData for submit_answer message
+type PartySubmitAnswerData struct {
+	GameID     string `json:"gameId"`
+	QuestionID string `json:"questionId"`
+	AnswerID   string `json:"answerId"`
+	TimeTaken  int64  `json:"timeTaken"` // milliseconds
+}
+
+// NewPartyWebSocketHub creates a new party WebSocket hub.
+// Use cases are wired with SetUseCases: they publish through an event bus
+// that itself needs the hub, so the hub is created first.
+func NewPartyWebSocketHub() *PartyWebSocketHub {
+	return &PartyWebSocketHub{
+		rooms:         make(map[string]*partyRoomConns),
+		questionDelay: appParty.QuestionStartDelaySec * time.Second,
+	}
+}
+
+// SetUseCases wires the use cases the hub calls into
+func (h *PartyWebSocketHub) SetUseCases(
+	getRoomUC *appParty.GetRoomUseCase,
+	getQuestionUC *appParty.GetPartyQuestionUseCase,
+	submitAnswerUC *appParty.SubmitPartyAnswerUseCase,
+) {
+	h.getRoomUC = getRoomUC
+	h.getQuestionUC = getQuestionUC
+	h.submitAnswerUC = submitAnswerUC
+}
+
+// Register adds a player's connection to the room. Replaces any existing connection.
+func (h *PartyWebSocketHub) Register(roomID, playerID string, conn LobbyConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[roomID]
+	if !ok {
+		room = &partyRoomConns{conns: make(map[string]LobbyConn)}
+		h.rooms[roomID] = room
+	}
+
+	room.mu.Lock()
+	room.conns[playerID] = conn
+	room.mu.Unlock()
+}
+
+// Unregister removes a player's connection if it is still the registered one
+// (a reconnect may already have replaced it).
+func (h *PartyWebSocketHub) Unregister(roomID, playerID string, conn LobbyConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[roomID]
+	if !ok {
+		return
+	}
+
+	room.mu.Lock()
+	if room.conns[playerID] == conn {
+		delete(room.conns, playerID)
+	}
+	empty := len(room.conns) == 0
+	room.mu.Unlock()
+
+	if empty {
+		delete(h.rooms, roomID)
+	}
+}
+
+// Broadcast implements appParty.PartyHub.
+func (h *PartyWebSocketHub) Broadcast(roomID string, event appParty.PartyEvent) {
+	h.mu.RLock()
+	room, ok := h.rooms[roomID]
+	h.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+	for playerID, conn := range room.conns {
+		if err := conn.WriteJSON(event); err != nil {
+			log.Printf("[PartyWS] write to %s in room %s failed: %v", playerID, roomID, err)
+		}
+	}
+}
+
+// send writes an event to a single player's connection
+func (h *PartyWebSocketHub) send(roomID string, conn LobbyConn, event appParty.PartyEvent) {
+	h.mu.RLock()
+	room, ok := h.rooms[roomID]
+	h.mu.RUnlock()
+
+	if ok {
+		room.mu.Lock()
+		defer room.mu.Unlock()
+	}
+	_ = conn.WriteJSON(event)
+}
+
+// QuestionStarted implements appParty.PartyHub.
+// Shows the question after a short pause and completes it when its time runs out.
+func (h *PartyWebSocketHub) QuestionStarted(roomID, gameID string, questionNumber int) {
+	go h.runQuestion(roomID, gameID, questionNumber)
+}
+
+func (h *PartyWebSocketHub) runQuestion(roomID, gameID string, questionNumber int) {
+	time.Sleep(h.questionDelay)
+
+	output, err := h.getQuestionUC.Execute(appParty.GetPartyQuestionInput{GameID: gameID})
+	if err != nil {
+		log.Printf("[PartyWS] game %s: cannot load question %d: %v", gameID, questionNumber, err)
+		return
+	}
+	if output.Question.QuestionNumber != questionNumber {
+		// The game moved on while we were waiting (e.g. a player left)
+		return
+	}
+
+	h.Broadcast(roomID, appParty.PartyEvent{Type: "new_question", Data: output.Question})
+
+	time.Sleep(time.Duration(output.Question.TimeLimit) * time.Second)
+
+	if _, err := h.submitAnswerUC.TimeoutQuestion(gameID, questionNumber); err != nil {
+		log.Printf("[PartyWS] game %s: timeout of question %d failed: %v", gameID, questionNumber, err)
+	}
+}
+
+// HandlePartyWebSocket handles /ws/party/:roomId connections
+func (h *PartyWebSocketHub) HandlePartyWebSocket(c *websocket.Conn) {
+	roomID := c.Params("roomId")
+	playerID := getWebSocketPlayerID(c)
+
+	if roomID == "" || playerID == "" {
+		_ = c.WriteJSON(map[string]string{"type": "error", "error": "Missing roomId or authentication"})
+		c.Close()
+		return
+	}
+
+	// Only room members may connect
+	state, err := h.getRoomUC.Execute(appParty.GetRoomInput{PlayerID: playerID, RoomID: roomID})
+	if err != nil {
+		_ = c.WriteJSON(map[string]string{"type": "error", "error": err.Error()})
+		c.Close()
+		return
+	}
+
+	h.Register(roomID, playerID, c)
+	log.Printf("[PartyWS] %s connected to room %s", playerID, roomID)
+
+	defer func() {
+		h.Unregister(roomID, playerID, c)
+		h.Broadcast(roomID, appParty.PartyEvent{
+			Type: "player_disconnected",
+			Data: map[string]string{"playerId": playerID},
+		})
+		log.Printf("[PartyWS] %s disconnected from room %s", playerID, roomID)
+	}()
+
+	h.send(roomID, c, appParty.PartyEvent{
+		Type: "connected",
+		Data: map[string]string{"roomId": roomID, "playerId": playerID},
+	})
+	h.send(roomID, c, appParty.PartyEvent{Type: "room_state", Data: state})
+
+	// Reconnect mid-game: resend the open question
+	if state.Game != nil && state.Game.Status == string(domainParty.GameStatusInProgress) {
+		if question, err := h.getQuestionUC.Execute(appParty.GetPartyQuestionInput{GameID: state.Game.ID}); err == nil {
+			h.send(roomID, c, appParty.PartyEvent{Type: "new_question", Data: question.Question})
+		}
+	}
+
+	for {
+		_, msgBytes, err := c.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Printf("[PartyWS] error: %v", err)
+			}
+			break
+		}
+
+		var msg DuelMessage
+		if err := json.Unmarshal(msgBytes, &msg); err != nil {
+			log.Printf("[PartyWS] invalid message format: %v", err)
+			continue
+		}
+
+		h.handleMessage(roomID, playerID, c, msg)
+	}
+}
+
+func (h *PartyWebSocketHub) handleMessage(roomID, playerID string, conn LobbyConn, msg DuelMessage) {
+	switch msg.Type {
+	case "submit_answer":
+		var data PartySubmitAnswerData
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			h.send(roomID, conn, appParty.PartyEvent{Type: "error", Data: "Invalid submit_answer payload"})
+			return
+		}
+
+		result, err := h.submitAnswerUC.Execute(appParty.SubmitPartyAnswerInput{
+			PlayerID:   playerID,
+			GameID:     data.GameID,
+			QuestionID: data.QuestionID,
+			AnswerID:   data.AnswerID,
+			TimeTaken:  data.TimeTaken,
+		})
+		if err != nil {
+			h.send(roomID, conn, appParty.PartyEvent{Type: "error", Data: err.Error()})
+			return
+		}
+
+		h.send(roomID, conn, appParty.PartyEvent{Type: "answer_result", Data: result})
+
+	case "ping":
+		h.send(roomID, conn, appParty.PartyEvent{Type: "pong", Data: nil})
+
+	default:
+		log.Printf("[PartyWS] unknown message type: %s", msg.Type)
+	}
+}
@@ -0,0 +1,52 @@
+package handlers_test
+
+import (
+	"testing"
+
+	appParty "github.com/barsukov/quiz-sprint/backend/internal/application/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/handlers"
+)
+
+func TestPartyWebSocketHub_BroadcastReachesOnlyRoom(t *testing.T) {
+	hub := handlers.NewPartyWebSocketHub()
+	p1 := &mockLobbyConn{}
+	p2 := &mockLobbyConn{}
+	other := &mockLobbyConn{}
+	hub.Register("room-1", "p1", p1)
+	hub.Register("room-1", "p2", p2)
+	hub.Register("room-2", "p3", other)
+
+	hub.Broadcast("room-1", appParty.PartyEvent{Type: "player_joined"})
+
+	for name, conn := range map[string]*mockLobbyConn{"p1": p1, "p2": p2} {
+		conn.mu.Lock()
+		if len(conn.messages) != 1 {
+			t.Errorf("%s: expected 1 message, got %d", name, len(conn.messages))
+		}
+		conn.mu.Unlock()
+	}
+
+	other.mu.Lock()
+	defer other.mu.Unlock()
+	if len(other.messages) != 0 {
+		t.Fatalf("expected no messages for other room, got %d", len(other.messages))
+	}
+}
+
+func TestPartyWebSocketHub_UnregisterKeepsNewerConnection(t *testing.T) {
+	hub := handlers.NewPartyWebSocketHub()
+	oldConn := &mockLobbyConn{}
+	newConn := &mockLobbyConn{}
+
+	hub.Register("room-1", "p1", oldConn)
+	hub.Register("room-1", "p1", newConn) // reconnect
+	hub.Unregister("room-1", "p1", oldConn)
+
+	hub.Broadcast("room-1", appParty.PartyEvent{Type: "ping"})
+
+	newConn.mu.Lock()
+	defer newConn.mu.Unlock()
+	if len(newConn.messages) != 1 {
+		t.Fatalf("expected reconnected player to stay registered, got %d messages", len(newConn.messages))
+	}
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// telegramSecretTokenHeader carries the secret_token given to setWebhook
+const telegramSecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// PremiumHandler sells premium for Telegram Stars and receives the Bot API payment updates
+type PremiumHandler struct {
+	getPremiumStatusUC        *appUser.GetPremiumStatusUseCase
+	createPremiumInvoiceUC    *appUser.CreatePremiumInvoiceUseCase
+	handlePreCheckoutUC       *appUser.HandlePreCheckoutUseCase
+	handleSuccessfulPaymentUC *appUser.HandleSuccessfulPaymentUseCase
+	webhookSecret             string
+}
+
+func NewPremiumHandler(
+	getPremiumStatusUC *appUser.GetPremiumStatusUseCase,
+	createPremiumInvoiceUC *appUser.CreatePremiumInvoiceUseCase,
+	handlePreCheckoutUC *appUser.HandlePreCheckoutUseCase,
+	handleSuccessfulPaymentUC *appUser.HandleSuccessfulPaymentUseCase,
+	webhookSecret string,
+) *PremiumHandler {
+	return &PremiumHandler{
+		getPremiumStatusUC:        getPremiumStatusUC,
+		createPremiumInvoiceUC:    createPremiumInvoiceUC,
+		handlePreCheckoutUC:       handlePreCheckoutUC,
+		handleSuccessfulPaymentUC: handleSuccessfulPaymentUC,
+		webhookSecret:             webhookSecret,
+	}
+}
+
+// GetPremiumStatus handles GET /api/v1/premium
+// @Summary Get premium status
+// @Description The authenticated player's premium status and the plans on sale
+// @Tags premium
+// @Produce json
+// @Success 200 {object} GetPremiumStatusResponse "Premium status"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /premium [get]
+func (h *PremiumHandler) GetPremiumStatus(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.getPremiumStatusUC.Execute(appUser.GetPremiumStatusInput{PlayerID: playerID})
+	if err != nil {
+		return mapPremiumError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// CreateInvoice handles POST /api/v1/premium/invoice
+// @Summary Create a premium invoice
+// @Description Creates a Telegram Stars invoice link for a premium plan; open it with Telegram.WebApp.openInvoice. Premium is granted when Telegram confirms the payment.
+// @Tags premium
+// @Accept json
+// @Produce json
+// @Param request body CreatePremiumInvoiceRequest true "Plan to buy"
+// @Success 200 {object} CreatePremiumInvoiceResponse "Invoice link"
+// @Failure 400 {object} ErrorResponse "Unknown plan"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 502 {object} ErrorResponse "Telegram did not create the invoice"
+// @Security TelegramAuth
+// @Router /premium/invoice [post]
+func (h *PremiumHandler) CreateInvoice(c fiber.Ctx) error {
+	var req CreatePremiumInvoiceRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.createPremiumInvoiceUC.Execute(appUser.CreatePremiumInvoiceInput{
+		PlayerID: playerID,
+		PlanID:   req.PlanID,
+	})
+	if err != nil {
+		return mapPremiumError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// telegramUpdate is the part of a Bot API Update the payment flow reads
+type telegramUpdate struct {
+	UpdateID         int64                     `json:"update_id"`
+	PreCheckoutQuery *telegramPreCheckoutQuery `json:"pre_checkout_query"`
+	Message          *struct {
+		From              telegramUser               `json:"from"`
+		SuccessfulPayment *telegramSuccessfulPayment `json:"successful_payment"`
+	} `json:"message"`
+}
+
+type telegramUser struct {
+	ID int64 `json:"id"`
+}
+
+type telegramPreCheckoutQuery struct {
+	ID             string       `json:"id"`
+	From           telegramUser `json:"from"`
+	Currency       string       `json:"currency"`
+	TotalAmount    int          `json:"total_amount"`
+	InvoicePayload string       `json:"invoice_payload"`
+}
+
+type telegramSuccessfulPayment struct {
+	Currency                string `json:"currency"`
+	TotalAmount             int    `json:"total_amount"`
+	InvoicePayload          string `json:"invoice_payload"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	IsRecurring             bool   `json:"is_recurring"`
+}
+
+// TelegramWebhook handles POST /api/v1/telegram/webhook
+// @Summary Telegram Bot API webhook
+// @Description Receives bot updates; answers pre_checkout_query and fulfils successful_payment. Other updates are acknowledged and ignored. A 5xx makes Telegram redeliver the update.
+// @Tags telegram
+// @Accept json
+// @Produce json
+// @Param X-Telegram-Bot-Api-Secret-Token header string true "secret_token given to setWebhook"
+// @Success 200 {object} map[string]bool "Update processed"
+// @Failure 401 {object} ErrorResponse "Invalid secret token"
+// @Failure 500 {object} ErrorResponse "Update could not be processed, Telegram will retry"
+// @Router /telegram/webhook [post]
+func (h *PremiumHandler) TelegramWebhook(c fiber.Ctx) error {
+	secret := c.Get(telegramSecretTokenHeader)
+	if h.webhookSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(h.webhookSecret)) != 1 {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid secret token")
+	}
+
+	var update telegramUpdate
+	if err := c.Bind().Body(&update); err != nil {
+		// Redelivering a malformed update would not help
+		log.Printf("⚠️ [TelegramWebhook] Ignoring malformed update: %v", err)
+		return c.JSON(fiber.Map{"ok": true})
+	}
+
+	switch {
+	case update.PreCheckoutQuery != nil:
+		q := update.PreCheckoutQuery
+		err := h.handlePreCheckoutUC.Execute(appUser.PreCheckoutInput{
+			QueryID:     q.ID,
+			FromUserID:  strconv.FormatInt(q.From.ID, 10),
+			Currency:    q.Currency,
+			TotalAmount: q.TotalAmount,
+			Payload:     q.InvoicePayload,
+		})
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+	case update.Message != nil && update.Message.SuccessfulPayment != nil:
+		p := update.Message.SuccessfulPayment
+		_, err := h.handleSuccessfulPaymentUC.Execute(appUser.SuccessfulPaymentInput{
+			FromUserID:  strconv.FormatInt(update.Message.From.ID, 10),
+			Currency:    p.Currency,
+			TotalAmount: p.TotalAmount,
+			Payload:     p.InvoicePayload,
+			ChargeID:    p.TelegramPaymentChargeID,
+			IsRecurring: p.IsRecurring,
+		})
+		if isPermanentPaymentError(err) {
+			// Logged by the use case; needs a manual refund, retrying cannot fix it
+			return c.JSON(fiber.Map{"ok": true})
+		}
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.JSON(fiber.Map{"ok": true})
+}
+
+// isPermanentPaymentError reports whether a payment update is invalid rather than failed
+func isPermanentPaymentError(err error) bool {
+	return errors.Is(err, domainUser.ErrPaymentMismatch) ||
+		errors.Is(err, domainUser.ErrInvalidInvoicePayload) ||
+		errors.Is(err, domainUser.ErrUnknownPremiumPlan) ||
+		errors.Is(err, domainUser.ErrInvalidPaymentChargeID)
+}
+
+// mapPremiumError maps premium domain errors to HTTP errors
+func mapPremiumError(err error) error {
+	switch {
+	case errors.Is(err, shared.ErrInvalidUserID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	case errors.Is(err, domainUser.ErrUnknownPremiumPlan):
+		return fiber.NewError(fiber.StatusBadRequest, "Unknown premium plan")
+	case errors.Is(err, domainUser.ErrPaymentUnavailable):
+		log.Printf("❌ [Premium] %v", err)
+		return fiber.NewError(fiber.StatusBadGateway, "Payment service unavailable")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+}
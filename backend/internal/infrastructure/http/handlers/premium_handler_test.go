@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/telegram"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+// mockPremiumRepo is an in-memory PremiumRepository for handler tests
+type mockPremiumRepo struct {
+	statuses map[string]domainUser.PremiumStatus
+	payments map[string]bool
+}
+
+func (m *mockPremiumRepo) FindStatus(playerID domainUser.UserID) (domainUser.PremiumStatus, error) {
+	return m.statuses[playerID.String()], nil
+}
+
+func (m *mockPremiumRepo) FindStatusForUpdate(_ *sql.Tx, playerID domainUser.UserID) (domainUser.PremiumStatus, error) {
+	return m.FindStatus(playerID)
+}
+
+func (m *mockPremiumRepo) SaveStatusInTx(_ *sql.Tx, playerID domainUser.UserID, status domainUser.PremiumStatus) error {
+	m.statuses[playerID.String()] = status
+	return nil
+}
+
+func (m *mockPremiumRepo) SavePaymentInTx(_ *sql.Tx, payment *domainUser.PremiumPayment) error {
+	if m.payments[payment.ChargeID()] {
+		return domainUser.ErrDuplicatePremiumPayment
+	}
+	m.payments[payment.ChargeID()] = true
+	return nil
+}
+
+type directTxManager struct{}
+
+func (directTxManager) RunInTx(_ context.Context, fn func(tx *sql.Tx) error) error {
+	return fn(nil)
+}
+
+// fakeBotAPI records answerPreCheckoutQuery calls like the Telegram Bot API would receive them
+type fakeBotAPI struct {
+	mu      sync.Mutex
+	answers map[string]bool // pre_checkout_query_id -> ok
+}
+
+type premiumFixture struct {
+	app     *fiber.App
+	repo    *mockPremiumRepo
+	botAPI  *fakeBotAPI
+	premium appUser.PremiumService
+}
+
+func setupPremiumFixture(t *testing.T) *premiumFixture {
+	t.Helper()
+
+	botAPI := &fakeBotAPI{answers: make(map[string]bool)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID string `json:"pre_checkout_query_id"`
+			OK bool   `json:"ok"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		botAPI.mu.Lock()
+		botAPI.answers[req.ID] = req.OK
+		botAPI.mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	repo := &mockPremiumRepo{statuses: make(map[string]domainUser.PremiumStatus), payments: make(map[string]bool)}
+	gateway := telegram.NewPaymentsClient("TEST_TOKEN", server.URL)
+
+	handler := NewPremiumHandler(
+		appUser.NewGetPremiumStatusUseCase(repo),
+		appUser.NewCreatePremiumInvoiceUseCase(gateway),
+		appUser.NewHandlePreCheckoutUseCase(gateway),
+		appUser.NewHandleSuccessfulPaymentUseCase(repo, directTxManager{}),
+		testWebhookSecret,
+	)
+
+	app := fiber.New()
+	app.Post("/api/v1/telegram/webhook", handler.TelegramWebhook)
+
+	return &premiumFixture{app: app, repo: repo, botAPI: botAPI, premium: appUser.NewPremiumService(repo)}
+}
+
+func (f *premiumFixture) postUpdate(t *testing.T, secret string, update string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/telegram/webhook", strings.NewReader(update))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(telegramSecretTokenHeader, secret)
+	resp, err := f.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+	return resp.StatusCode
+}
+
+func TestTelegramWebhook_StarsPaymentFlow(t *testing.T) {
+	f := setupPremiumFixture(t)
+
+	preCheckout := `{"update_id":1,"pre_checkout_query":{"id":"q1","from":{"id":12345},"currency":"XTR","total_amount":250,"invoice_payload":"premium:premium_month:12345"}}`
+	if status := f.postUpdate(t, testWebhookSecret, preCheckout); status != http.StatusOK {
+		t.Fatalf("pre_checkout_query status = %d", status)
+	}
+	if ok, answered := f.botAPI.answers["q1"]; !answered || !ok {
+		t.Fatalf("pre-checkout answered %v, ok %v; want approved", answered, ok)
+	}
+
+	payment := `{"update_id":2,"message":{"from":{"id":12345},"successful_payment":{"currency":"XTR","total_amount":250,"invoice_payload":"premium:premium_month:12345","telegram_payment_charge_id":"charge-1","is_recurring":true}}}`
+	for i := 0; i < 2; i++ { // Telegram may redeliver
+		if status := f.postUpdate(t, testWebhookSecret, payment); status != http.StatusOK {
+			t.Fatalf("successful_payment #%d status = %d", i+1, status)
+		}
+	}
+
+	if isPremium, _ := f.premium.IsPremium("12345"); !isPremium {
+		t.Error("player is not premium after a successful payment")
+	}
+	if len(f.repo.payments) != 1 {
+		t.Errorf("recorded %d payments, want 1", len(f.repo.payments))
+	}
+}
+
+func TestTelegramWebhook_DeclinesForeignPayer(t *testing.T) {
+	f := setupPremiumFixture(t)
+
+	preCheckout := `{"update_id":1,"pre_checkout_query":{"id":"q1","from":{"id":99999},"currency":"XTR","total_amount":250,"invoice_payload":"premium:premium_month:12345"}}`
+	if status := f.postUpdate(t, testWebhookSecret, preCheckout); status != http.StatusOK {
+		t.Fatalf("status = %d", status)
+	}
+	if ok, answered := f.botAPI.answers["q1"]; !answered || ok {
+		t.Errorf("pre-checkout answered %v, ok %v; want declined", answered, ok)
+	}
+}
+
+func TestTelegramWebhook_RejectsWrongSecret(t *testing.T) {
+	f := setupPremiumFixture(t)
+
+	payment := `{"update_id":2,"message":{"from":{"id":12345},"successful_payment":{"currency":"XTR","total_amount":250,"invoice_payload":"premium:premium_month:12345","telegram_payment_charge_id":"forged"}}}`
+	if status := f.postUpdate(t, "guess", payment); status != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", status)
+	}
+	if isPremium, _ := f.premium.IsPremium("12345"); isPremium {
+		t.Error("a forged update granted premium")
+	}
+}
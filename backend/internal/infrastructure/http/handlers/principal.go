@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gofiber/contrib/v3/websocket"
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/middleware"
+)
+
+// getAuthPlayerID returns the player ID of the authenticated principal
+func getAuthPlayerID(c fiber.Ctx) (string, error) {
+	principal := middleware.GetPrincipal(c)
+	if principal == nil || principal.PlayerID == "" {
+		return "", fiber.NewError(fiber.StatusUnauthorized, "Authentication required")
+	}
+	return principal.PlayerID, nil
+}
+
+// getAuthPlayerIDMatching returns the authenticated player ID.
+// Clients may still send their own playerId (body or query): it is never
+// trusted, only checked, and a mismatch is rejected with 403.
+func getAuthPlayerIDMatching(c fiber.Ctx, claimedPlayerID string) (string, error) {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return "", err
+	}
+	if err := checkClaimedPlayerID(playerID, claimedPlayerID); err != nil {
+		return "", err
+	}
+	return playerID, nil
+}
+
+// checkClaimedPlayerID rejects a client-supplied player ID that is not the authenticated player
+func checkClaimedPlayerID(playerID, claimedPlayerID string) error {
+	if claimedPlayerID != "" && claimedPlayerID != playerID {
+		return fiber.NewError(fiber.StatusForbidden, "playerId does not match the authenticated user")
+	}
+	return nil
+}
+
+// getAuthUsername returns the display name of the authenticated user
+func getAuthUsername(c fiber.Ctx) string {
+	principal := middleware.GetPrincipal(c)
+	if principal == nil {
+		return ""
+	}
+	return principal.Username
+}
+
+// getRequestLocale returns the content language of the caller: the authenticated
+// user's language, else the preferred Accept-Language tag ("" = default locale)
+func getRequestLocale(c fiber.Ctx) string {
+	if principal := middleware.GetPrincipal(c); principal != nil && principal.LanguageCode != "" {
+		return principal.LanguageCode
+	}
+	tag, _, _ := strings.Cut(c.Get(fiber.HeaderAcceptLanguage), ",")
+	tag, _, _ = strings.Cut(tag, ";")
+	return tag
+}
+
+// getWebSocketPlayerID returns the player ID authenticated during the WebSocket upgrade
+func getWebSocketPlayerID(c *websocket.Conn) string {
+	principal := middleware.PrincipalFromLocals(c.Locals(middleware.PrincipalLocalsKey))
+	if principal == nil {
+		return ""
+	}
+	return principal.PlayerID
+}
@@ -84,6 +84,7 @@ func (h *QuizHandler) GetAllQuizzes(c fiber.Ctx) error {
 	// 2. Execute use case
 	input := appQuiz.ListQuizzesInput{
 		CategoryID: categoryID,
+		Locale:     getRequestLocale(c),
 	}
 	output, err := h.listQuizzesUC.Execute(input)
 	if err != nil {
@@ -116,6 +117,7 @@ func (h *QuizHandler) GetQuizByID(c fiber.Ctx) error {
 	// 2. Execute use case
 	output, err := h.getQuizDetailsUC.Execute(appQuiz.GetQuizDetailsInput{
 		QuizID: quizID,
+		Locale: getRequestLocale(c),
 	})
 	if err != nil {
 		return mapError(err)
@@ -137,9 +139,12 @@ func (h *QuizHandler) GetQuizByID(c fiber.Ctx) error {
 // @Param request body StartQuizRequest true "Start quiz request"
 // @Success 201 {object} StartQuizResponse "Quiz session started with first question"
 // @Failure 400 {object} ErrorResponse "Invalid request or quiz ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "userId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Quiz not found"
 // @Failure 409 {object} ErrorResponse "Active session already exists"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /quiz/{id}/start [post]
 func (h *QuizHandler) StartQuiz(c fiber.Ctx) error {
 	// 1. Parse request body
@@ -148,15 +153,17 @@ func (h *QuizHandler) StartQuiz(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	// 2. Validate required fields
-	if req.UserID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "userId is required")
+	// 2. Resolve the authenticated player
+	userID, err := getAuthPlayerIDMatching(c, req.UserID)
+	if err != nil {
+		return err
 	}
 
 	// 3. Execute use case
 	output, err := h.startQuizUC.Execute(appQuiz.StartQuizInput{
 		QuizID: c.Params("id"),
-		UserID: req.UserID,
+		UserID: userID,
+		Locale: getRequestLocale(c),
 	})
 	if err != nil {
 		return mapError(err)
@@ -178,9 +185,11 @@ func (h *QuizHandler) StartQuiz(c fiber.Ctx) error {
 // @Param request body SubmitAnswerRequest true "Submit answer request"
 // @Success 200 {object} SubmitAnswerResponse "Answer result with correctness, points, and next question or final result"
 // @Failure 400 {object} ErrorResponse "Invalid request, session completed, or already answered"
-// @Failure 401 {object} ErrorResponse "Unauthorized - user mismatch"
+// @Failure 401 {object} ErrorResponse "Authentication required, or the session belongs to another user"
+// @Failure 403 {object} ErrorResponse "userId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Session, question, or answer not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /quiz/session/{sessionId}/answer [post]
 func (h *QuizHandler) SubmitAnswer(c fiber.Ctx) error {
 	// 1. Parse request body
@@ -189,15 +198,16 @@ func (h *QuizHandler) SubmitAnswer(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	// 2. Validate required fields
+	// 2. Resolve the authenticated player and validate required fields
+	userID, err := getAuthPlayerIDMatching(c, req.UserID)
+	if err != nil {
+		return err
+	}
 	if req.QuestionID == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "questionId is required")
 	}
-	if req.AnswerID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "answerId is required")
-	}
-	if req.UserID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "userId is required")
+	if !hasAnswer(req.AnswerID, req.AnswerIDs, req.NumericAnswer) {
+		return fiber.NewError(fiber.StatusBadRequest, "answerId, answerIds or numericAnswer is required")
 	}
 	if req.TimeTaken < 0 {
 		return fiber.NewError(fiber.StatusBadRequest, "timeTaken must be non-negative")
@@ -208,8 +218,12 @@ func (h *QuizHandler) SubmitAnswer(c fiber.Ctx) error {
 		SessionID:  c.Params("sessionId"),
 		QuestionID: req.QuestionID,
 		AnswerID:   req.AnswerID,
-		UserID:     req.UserID,
+		UserID:     userID,
 		TimeTaken:  req.TimeTaken,
+		Locale:     getRequestLocale(c),
+
+		AnswerIDs:     req.AnswerIDs,
+		NumericAnswer: req.NumericAnswer,
 	})
 	if err != nil {
 		return mapError(err)
@@ -228,23 +242,27 @@ func (h *QuizHandler) SubmitAnswer(c fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path string true "Quiz ID"
-// @Param request body GetActiveSessionRequest true "User ID for authorization"
+// @Param userId query string false "Must match the authenticated user"
 // @Success 200 {object} GetActiveSessionResponse "Active session with current question"
-// @Failure 400 {object} ErrorResponse "Invalid quiz or user ID"
+// @Failure 400 {object} ErrorResponse "Invalid quiz ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "userId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "No active session found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /quiz/{id}/active-session [get]
 func (h *QuizHandler) GetActiveSession(c fiber.Ctx) error {
-	// 1. Parse query parameter
-	userID := c.Query("userId")
-	if userID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "userId query parameter is required")
+	// 1. Resolve the authenticated player
+	userID, err := getAuthPlayerIDMatching(c, c.Query("userId"))
+	if err != nil {
+		return err
 	}
 
 	// 2. Execute use case
 	output, err := h.getActiveSessionUC.Execute(appQuiz.GetActiveSessionInput{
 		QuizID: c.Params("id"),
 		UserID: userID,
+		Locale: getRequestLocale(c),
 	})
 	if err != nil {
 		return mapError(err)
@@ -263,12 +281,14 @@ func (h *QuizHandler) GetActiveSession(c fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param sessionId path string true "Session ID"
-// @Param request body AbandonSessionRequest true "User ID for authorization"
+// @Param request body AbandonSessionRequest true "Abandon request (userId is optional)"
 // @Success 204 "Session abandoned successfully"
 // @Failure 400 {object} ErrorResponse "Invalid session ID"
-// @Failure 401 {object} ErrorResponse "Unauthorized - session belongs to another user"
+// @Failure 401 {object} ErrorResponse "Authentication required, or the session belongs to another user"
+// @Failure 403 {object} ErrorResponse "userId does not match the authenticated user"
 // @Failure 404 {object} ErrorResponse "Session not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
+// @Security TelegramAuth
 // @Router /quiz/session/{sessionId} [delete]
 func (h *QuizHandler) AbandonSession(c fiber.Ctx) error {
 	// 1. Parse request body
@@ -277,15 +297,16 @@ func (h *QuizHandler) AbandonSession(c fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	// 2. Validate required fields
-	if req.UserID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "userId is required")
+	// 2. Resolve the authenticated player
+	userID, err := getAuthPlayerIDMatching(c, req.UserID)
+	if err != nil {
+		return err
 	}
 
 	// 3. Execute use case
-	err := h.abandonSessionUC.Execute(appQuiz.AbandonSessionInput{
+	err = h.abandonSessionUC.Execute(appQuiz.AbandonSessionInput{
 		SessionID: c.Params("sessionId"),
-		UserID:    req.UserID,
+		UserID:    userID,
 	})
 	if err != nil {
 		return mapError(err)
@@ -432,6 +453,7 @@ func (h *QuizHandler) GetDailyQuiz(c fiber.Ctx) error {
 	// 2. Execute use case
 	output, err := h.getDailyQuizUC.Execute(appQuiz.GetDailyQuizInput{
 		UserID: userID,
+		Locale: getRequestLocale(c),
 	})
 	if err != nil {
 		return mapError(err)
@@ -461,6 +483,7 @@ func (h *QuizHandler) GetRandomQuiz(c fiber.Ctx) error {
 	// 2. Execute use case
 	input := appQuiz.GetRandomQuizInput{
 		CategoryID: categoryID,
+		Locale:     getRequestLocale(c),
 	}
 	output, err := h.getRandomQuizUC.Execute(input)
 	if err != nil {
@@ -491,6 +514,7 @@ func (h *QuizHandler) GetUserActiveSessions(c fiber.Ctx) error {
 	// 2. Execute use case
 	input := appQuiz.GetUserActiveSessionsInput{
 		UserID: userID,
+		Locale: getRequestLocale(c),
 	}
 	output, err := h.getUserActiveSessionsUC.Execute(input)
 	if err != nil {
@@ -507,10 +531,17 @@ func (h *QuizHandler) GetUserActiveSessions(c fiber.Ctx) error {
 // Error Mapping (Domain → HTTP)
 // ========================================
 
+// hasAnswer reports whether a submit request carries an answer: answerId, or
+// answerIds / numericAnswer for the question types answered that way
+func hasAnswer(answerID string, answerIDs []string, numericAnswer *float64) bool {
+	return answerID != "" || len(answerIDs) > 0 || numericAnswer != nil
+}
+
 func mapError(err error) error {
 	switch err {
 	// Not Found errors
-	case domainQuiz.ErrQuizNotFound:
+	case domainQuiz.ErrQuizNotFound,
+		domainQuiz.ErrQuizDeleted:
 		return fiber.NewError(fiber.StatusNotFound, "Quiz not found")
 	case domainQuiz.ErrSessionNotFound:
 		return fiber.NewError(fiber.StatusNotFound, "Session not found")
@@ -526,6 +557,7 @@ func mapError(err error) error {
 		domainQuiz.ErrInvalidSessionID,
 		domainQuiz.ErrInvalidQuestionID,
 		domainQuiz.ErrInvalidAnswerID,
+		domainQuiz.ErrInvalidSubmission,
 		domainQuiz.ErrInvalidTitle,
 		domainQuiz.ErrInvalidTimeLimit,
 		domainQuiz.ErrInvalidPassingScore,
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// The classic quiz game routes act on the authenticated player only: a missing
+// principal is rejected before any use case runs, and so is a userId naming
+// someone else.
+func TestQuizHandler_GameRoutesRequireMatchingPlayer(t *testing.T) {
+	h := &QuizHandler{}
+	app := fiber.New()
+	app.Post("/quiz/:id/start", testAuthMiddleware, h.StartQuiz)
+	app.Get("/quiz/:id/active-session", testAuthMiddleware, h.GetActiveSession)
+	app.Post("/quiz/session/:sessionId/answer", testAuthMiddleware, h.SubmitAnswer)
+	app.Delete("/quiz/session/:sessionId", testAuthMiddleware, h.AbandonSession)
+
+	routes := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "start", method: http.MethodPost, path: "/quiz/q1/start", body: `{"userId":"%s"}`},
+		{name: "active session", method: http.MethodGet, path: "/quiz/q1/active-session?userId=%s"},
+		{name: "answer", method: http.MethodPost, path: "/quiz/session/s1/answer", body: `{"userId":"%s","questionId":"q1","answerId":"a1"}`},
+		{name: "abandon", method: http.MethodDelete, path: "/quiz/session/s1", body: `{"userId":"%s"}`},
+	}
+
+	for _, route := range routes {
+		t.Run(route.name+" unauthenticated", func(t *testing.T) {
+			resp := doQuizRequest(t, app, route.method, route.path, route.body, "player123", true)
+			if resp.StatusCode != 401 {
+				body, _ := io.ReadAll(resp.Body)
+				t.Errorf("Status = %d, want 401. Body: %s", resp.StatusCode, string(body))
+			}
+		})
+		t.Run(route.name+" another player", func(t *testing.T) {
+			resp := doQuizRequest(t, app, route.method, route.path, route.body, "someone-else", false)
+			if resp.StatusCode != 403 {
+				body, _ := io.ReadAll(resp.Body)
+				t.Errorf("Status = %d, want 403. Body: %s", resp.StatusCode, string(body))
+			}
+		})
+	}
+}
+
+// doQuizRequest sends a request whose path or body names userID in place of %s
+func doQuizRequest(t *testing.T, app *fiber.App, method, path, body, userID string, unauthenticated bool) *http.Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(strings.Replace(body, "%s", userID, 1))
+	}
+	req := httptest.NewRequest(method, strings.Replace(path, "%s", userID, 1), reader)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if unauthenticated {
+		req.Header.Set(testUnauthenticatedHeader, "1")
+	}
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	return resp
+}
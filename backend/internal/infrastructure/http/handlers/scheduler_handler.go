@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/scheduler"
+)
+
+// SchedulerHandler exposes background jobs to admins
+type SchedulerHandler struct {
+	scheduler *scheduler.Scheduler
+}
+
+func NewSchedulerHandler(s *scheduler.Scheduler) *SchedulerHandler {
+	return &SchedulerHandler{scheduler: s}
+}
+
+// ListJobs handles GET /api/v1/admin/jobs
+// @Summary List background jobs
+// @Description Registered jobs with their schedule, next run and latest run
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {object} AdminListJobsResponse "Jobs list"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /admin/jobs [get]
+func (h *SchedulerHandler) ListJobs(c fiber.Ctx) error {
+	jobs, err := h.scheduler.Jobs(c.Context())
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"data": fiber.Map{
+			"jobs": jobs,
+		},
+	})
+}
+
+// ListJobRuns handles GET /api/v1/admin/jobs/:name/runs
+// @Summary List job runs
+// @Description Run history of a job, newest first
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param name path string true "Job name"
+// @Param limit query int false "Limit (default 20)"
+// @Success 200 {object} AdminListJobRunsResponse "Run history"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Router /admin/jobs/{name}/runs [get]
+func (h *SchedulerHandler) ListJobRuns(c fiber.Ctx) error {
+	limit := fiber.Query[int](c, "limit", 20)
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	runs, err := h.scheduler.Runs(c.Context(), c.Params("name"), limit)
+	if err != nil {
+		return mapSchedulerError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": fiber.Map{
+			"runs": runs,
+		},
+	})
+}
+
+// TriggerJob handles POST /api/v1/admin/jobs/:name/trigger
+// @Summary Trigger a job
+// @Description Run a job now, outside its schedule. The run happens in the background and is skipped if the job is already running on any instance.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param name path string true "Job name"
+// @Success 202 {object} AdminTriggerJobResponse "Run started"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Router /admin/jobs/{name}/trigger [post]
+func (h *SchedulerHandler) TriggerJob(c fiber.Ctx) error {
+	name := c.Params("name")
+	if err := h.scheduler.Trigger(name); err != nil {
+		return mapSchedulerError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"data": fiber.Map{
+			"job":       name,
+			"triggered": true,
+		},
+	})
+}
+
+func mapSchedulerError(err error) error {
+	if errors.Is(err, scheduler.ErrJobNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+	return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+
+	appShop "github.com/barsukov/quiz-sprint/backend/internal/application/shop"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	domainShop "github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+)
+
+// ShopHandler handles the coin shop
+type ShopHandler struct {
+	getShopUC      *appShop.GetShopUseCase
+	purchaseItemUC *appShop.PurchaseItemUseCase
+}
+
+func NewShopHandler(
+	getShopUC *appShop.GetShopUseCase,
+	purchaseItemUC *appShop.PurchaseItemUseCase,
+) *ShopHandler {
+	return &ShopHandler{
+		getShopUC:      getShopUC,
+		purchaseItemUC: purchaseItemUC,
+	}
+}
+
+// GetShop handles GET /api/v1/shop
+// @Summary Get the shop
+// @Description Items currently on sale (limited-time offers included) with the authenticated player's remaining purchases and coin balance
+// @Tags shop
+// @Produce json
+// @Success 200 {object} GetShopResponse "Shop catalog"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /shop [get]
+func (h *ShopHandler) GetShop(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.getShopUC.Execute(appShop.GetShopInput{PlayerID: playerID})
+	if err != nil {
+		return mapShopError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// PurchaseItem handles POST /api/v1/shop/purchase
+// @Summary Buy a shop item
+// @Description Pays the item's price in coins and delivers its contents in one transaction. Retrying with the same Idempotency-Key returns the original purchase without charging again.
+// @Tags shop
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string false "Client-generated key that makes the purchase safe to retry"
+// @Param request body PurchaseShopItemRequest true "Purchase request"
+// @Success 200 {object} PurchaseShopItemResponse "Purchase completed"
+// @Failure 400 {object} ErrorResponse "Invalid request or not enough coins"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Item not found"
+// @Failure 409 {object} ErrorResponse "Item not on sale or purchase limit reached"
+// @Failure 422 {object} ErrorResponse "Idempotency-Key already used for another item"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /shop/purchase [post]
+func (h *ShopHandler) PurchaseItem(c fiber.Ctx) error {
+	var req PurchaseShopItemRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	idempotencyKey, err := getIdempotencyKey(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.purchaseItemUC.Execute(appShop.PurchaseItemInput{
+		PlayerID:       playerID,
+		ItemID:         req.ItemID,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		return mapShopError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapShopError maps shop domain errors to HTTP errors
+func mapShopError(err error) error {
+	switch {
+	case errors.Is(err, shared.ErrInvalidUserID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	case errors.Is(err, domainShop.ErrInvalidItemID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid item ID")
+	case errors.Is(err, domainShop.ErrInsufficientCoins):
+		return fiber.NewError(fiber.StatusBadRequest, "Not enough coins")
+	case errors.Is(err, domainShop.ErrItemNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Item not found")
+	case errors.Is(err, domainShop.ErrItemNotAvailable):
+		return fiber.NewError(fiber.StatusConflict, "Item is not on sale")
+	case errors.Is(err, domainShop.ErrPurchaseLimitReached):
+		return fiber.NewError(fiber.StatusConflict, "Purchase limit reached for this item")
+	case errors.Is(err, domainShop.ErrIdempotencyKeyReused):
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "Idempotency-Key was already used for another item")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+
+	appSocial "github.com/barsukov/quiz-sprint/backend/internal/application/social"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	domainSocial "github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// SocialHandler handles friends, friend requests and player blocks
+type SocialHandler struct {
+	getFriendsUC           *appSocial.GetFriendsUseCase
+	sendFriendRequestUC    *appSocial.SendFriendRequestUseCase
+	respondFriendRequestUC *appSocial.RespondFriendRequestUseCase
+	removeFriendUC         *appSocial.RemoveFriendUseCase
+	blockPlayerUC          *appSocial.BlockPlayerUseCase
+	unblockPlayerUC        *appSocial.UnblockPlayerUseCase
+	getBlockedPlayersUC    *appSocial.GetBlockedPlayersUseCase
+	getSuggestionsUC       *appSocial.GetFriendSuggestionsUseCase
+}
+
+func NewSocialHandler(
+	getFriendsUC *appSocial.GetFriendsUseCase,
+	sendFriendRequestUC *appSocial.SendFriendRequestUseCase,
+	respondFriendRequestUC *appSocial.RespondFriendRequestUseCase,
+	removeFriendUC *appSocial.RemoveFriendUseCase,
+	blockPlayerUC *appSocial.BlockPlayerUseCase,
+	unblockPlayerUC *appSocial.UnblockPlayerUseCase,
+	getBlockedPlayersUC *appSocial.GetBlockedPlayersUseCase,
+	getSuggestionsUC *appSocial.GetFriendSuggestionsUseCase,
+) *SocialHandler {
+	return &SocialHandler{
+		getFriendsUC:           getFriendsUC,
+		sendFriendRequestUC:    sendFriendRequestUC,
+		respondFriendRequestUC: respondFriendRequestUC,
+		removeFriendUC:         removeFriendUC,
+		blockPlayerUC:          blockPlayerUC,
+		unblockPlayerUC:        unblockPlayerUC,
+		getBlockedPlayersUC:    getBlockedPlayersUC,
+		getSuggestionsUC:       getSuggestionsUC,
+	}
+}
+
+// GetFriends handles GET /api/v1/friends
+// @Summary Get friends
+// @Description The authenticated player's friends and pending friend requests, sent and received
+// @Tags friends
+// @Produce json
+// @Success 200 {object} GetFriendsResponse "Friends and requests"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends [get]
+func (h *SocialHandler) GetFriends(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.getFriendsUC.Execute(appSocial.GetFriendsInput{PlayerID: playerID})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// SendFriendRequest handles POST /api/v1/friends/requests
+// @Summary Send a friend request
+// @Description Invites another player to be friends. If that player already invited the sender, the two become friends at once.
+// @Tags friends
+// @Accept json
+// @Produce json
+// @Param request body SendFriendRequestRequest true "Friend request"
+// @Success 201 {object} SendFriendRequestResponse "Request sent or accepted"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Player is blocked"
+// @Failure 404 {object} ErrorResponse "Player not found"
+// @Failure 409 {object} ErrorResponse "Already friends, request already sent or friend limit reached"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/requests [post]
+func (h *SocialHandler) SendFriendRequest(c fiber.Ctx) error {
+	var req SendFriendRequestRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.sendFriendRequestUC.Execute(appSocial.SendFriendRequestInput{
+		PlayerID: playerID,
+		FriendID: req.FriendID,
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": output})
+}
+
+// RespondFriendRequest handles POST /api/v1/friends/requests/:requestId/respond
+// @Summary Accept or decline a friend request
+// @Description Only the invited player can respond. A declined request is deleted.
+// @Tags friends
+// @Accept json
+// @Produce json
+// @Param requestId path string true "Friend request ID"
+// @Param request body RespondFriendRequestRequest true "Response"
+// @Success 200 {object} RespondFriendRequestResponse "Request answered"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Not the invited player"
+// @Failure 404 {object} ErrorResponse "Request not found"
+// @Failure 409 {object} ErrorResponse "Request already answered or friend limit reached"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/requests/{requestId}/respond [post]
+func (h *SocialHandler) RespondFriendRequest(c fiber.Ctx) error {
+	var req RespondFriendRequestRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.respondFriendRequestUC.Execute(appSocial.RespondFriendRequestInput{
+		PlayerID:  playerID,
+		RequestID: c.Params("requestId"),
+		Action:    req.Action,
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// RemoveFriend handles DELETE /api/v1/friends/:friendId
+// @Summary Remove a friend
+// @Description Ends the friendship, or cancels a pending request sent to or received from the player
+// @Tags friends
+// @Produce json
+// @Param friendId path string true "Friend's player ID"
+// @Success 200 {object} RemoveFriendResponse "Friend removed"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Not a friend"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/{friendId} [delete]
+func (h *SocialHandler) RemoveFriend(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.removeFriendUC.Execute(appSocial.RemoveFriendInput{
+		PlayerID: playerID,
+		FriendID: c.Params("friendId"),
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// GetFriendSuggestions handles GET /api/v1/friends/suggestions
+// @Summary Get friend suggestions
+// @Description Players met in duels who are not friends yet, most played first
+// @Tags friends
+// @Produce json
+// @Param limit query int false "Limit (default 10, max 50)"
+// @Success 200 {object} GetFriendSuggestionsResponse "Suggestions"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/suggestions [get]
+func (h *SocialHandler) GetFriendSuggestions(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil {
+			limit = parsed
+		}
+	}
+
+	output, err := h.getSuggestionsUC.Execute(appSocial.GetFriendSuggestionsInput{
+		PlayerID: playerID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// GetBlockedPlayers handles GET /api/v1/friends/blocked
+// @Summary Get blocked players
+// @Description Players the authenticated player blocked, newest first
+// @Tags friends
+// @Produce json
+// @Success 200 {object} GetBlockedPlayersResponse "Blocked players"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/blocked [get]
+func (h *SocialHandler) GetBlockedPlayers(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.getBlockedPlayersUC.Execute(appSocial.GetBlockedPlayersInput{PlayerID: playerID})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// BlockPlayer handles POST /api/v1/friends/blocked/:playerId
+// @Summary Block a player
+// @Description Ends any friendship or friend request with the player and stops new ones, in both directions
+// @Tags friends
+// @Produce json
+// @Param playerId path string true "Player ID to block"
+// @Success 200 {object} BlockPlayerResponse "Player blocked"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Player not found"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/blocked/{playerId} [post]
+func (h *SocialHandler) BlockPlayer(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.blockPlayerUC.Execute(appSocial.BlockPlayerInput{
+		PlayerID:  playerID,
+		BlockedID: c.Params("playerId"),
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// UnblockPlayer handles DELETE /api/v1/friends/blocked/:playerId
+// @Summary Unblock a player
+// @Tags friends
+// @Produce json
+// @Param playerId path string true "Blocked player ID"
+// @Success 200 {object} UnblockPlayerResponse "Player unblocked"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Player is not blocked"
+// @Failure 500 {object} ErrorResponse "Internal error"
+// @Security TelegramAuth
+// @Router /friends/blocked/{playerId} [delete]
+func (h *SocialHandler) UnblockPlayer(c fiber.Ctx) error {
+	playerID, err := getAuthPlayerID(c)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.unblockPlayerUC.Execute(appSocial.UnblockPlayerInput{
+		PlayerID:  playerID,
+		BlockedID: c.Params("playerId"),
+	})
+	if err != nil {
+		return mapSocialError(err)
+	}
+
+	return c.JSON(fiber.Map{"data": output})
+}
+
+// mapSocialError maps social domain errors to HTTP errors
+func mapSocialError(err error) error {
+	switch {
+	case errors.Is(err, shared.ErrInvalidUserID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid user ID")
+	case errors.Is(err, domainSocial.ErrInvalidFriendshipID):
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid friend request ID")
+	case errors.Is(err, domainSocial.ErrInvalidResponseAction):
+		return fiber.NewError(fiber.StatusBadRequest, "Action must be accept or decline")
+	case errors.Is(err, domainSocial.ErrCannotFriendSelf):
+		return fiber.NewError(fiber.StatusBadRequest, "Cannot add yourself as a friend")
+	case errors.Is(err, domainSocial.ErrCannotBlockSelf):
+		return fiber.NewError(fiber.StatusBadRequest, "Cannot block yourself")
+	case errors.Is(err, domainSocial.ErrPlayerBlocked):
+		return fiber.NewError(fiber.StatusForbidden, "Player is blocked")
+	case errors.Is(err, domainSocial.ErrNotRequestAddressee):
+		return fiber.NewError(fiber.StatusForbidden, "Only the invited player can respond")
+	case errors.Is(err, domainUser.ErrUserNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Player not found")
+	case errors.Is(err, domainSocial.ErrFriendshipNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Friend or friend request not found")
+	case errors.Is(err, domainSocial.ErrBlockNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Player is not blocked")
+	case errors.Is(err, domainSocial.ErrAlreadyFriends):
+		return fiber.NewError(fiber.StatusConflict, "Already friends")
+	case errors.Is(err, domainSocial.ErrFriendRequestExists), errors.Is(err, domainSocial.ErrFriendshipExists):
+		return fiber.NewError(fiber.StatusConflict, "Friend request already sent")
+	case errors.Is(err, domainSocial.ErrRequestNotPending), errors.Is(err, domainSocial.ErrFriendshipChanged):
+		return fiber.NewError(fiber.StatusConflict, "Friend request was already answered")
+	case errors.Is(err, domainSocial.ErrFriendLimitReached):
+		return fiber.NewError(fiber.StatusConflict, "Friend limit reached")
+	default:
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+}
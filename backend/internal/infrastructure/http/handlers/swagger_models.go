@@ -37,7 +37,9 @@ type QuizDetailDTO struct {
 // QuestionDTO represents a quiz question
 type QuestionDTO struct {
 	ID       string      `json:"id" validate:"required"`
+	Type     string      `json:"type" validate:"required"` // single_choice, true_false, multi_select, ordering, numeric
 	Text     string      `json:"text" validate:"required"`
+	Media    *MediaDTO   `json:"media,omitempty"`
 	Answers  []AnswerDTO `json:"answers" validate:"required"`
 	Points   int         `json:"points" validate:"required"`
 	Position int         `json:"position" validate:"required"`
@@ -47,13 +49,24 @@ type QuestionDTO struct {
 
 // AnswerDTO represents an answer option (without correct indicator)
 type AnswerDTO struct {
-	ID       string `json:"id" validate:"required"`
-	Text     string `json:"text" validate:"required"`
-	Position int    `json:"position" validate:"required"`
+	ID       string    `json:"id" validate:"required"`
+	Text     string    `json:"text" validate:"required"`
+	Media    *MediaDTO `json:"media,omitempty"`
+	Position int       `json:"position" validate:"required"`
 }
 
 // @name AnswerDTO
 
+// MediaDTO is an image attached to a question or answer
+type MediaDTO struct {
+	URL    string `json:"url" validate:"required"` // absolute, or /api/v1/media/<key> for stored assets
+	Width  int    `json:"width" validate:"required"`
+	Height int    `json:"height" validate:"required"`
+	Alt    string `json:"alt" validate:"required"`
+}
+
+// @name MediaDTO
+
 // SessionDTO represents a quiz session
 type SessionDTO struct {
 	ID              string `json:"id" validate:"required"`
@@ -144,6 +157,9 @@ type SubmitAnswerData struct {
 	IsQuizCompleted bool            `json:"isQuizCompleted" validate:"required"`
 	NextQuestion    *QuestionDTO    `json:"nextQuestion,omitempty"`
 	FinalResult     *FinalResultDTO `json:"finalResult,omitempty"`
+	// Answer key of multi-select and ordering (solution order), or numeric questions
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"`
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`
 }
 
 // @name SubmitAnswerData
@@ -247,21 +263,25 @@ type ErrorDetail struct {
 
 // StartQuizRequest is the HTTP request body for starting a quiz
 type StartQuizRequest struct {
-	UserID string `json:"userId" validate:"required"`
+	UserID string `json:"userId,omitempty"` // optional; must match the authenticated user
 }
 
 // SubmitAnswerRequest is the HTTP request body for submitting an answer
 type SubmitAnswerRequest struct {
 	QuestionID string `json:"questionId" validate:"required"`
-	AnswerID   string `json:"answerId" validate:"required"`
-	UserID     string `json:"userId" validate:"required"`
+	AnswerID   string `json:"answerId"`
+	UserID     string `json:"userId,omitempty"` // optional; must match the authenticated user
 	TimeTaken  int64  `json:"timeTaken" validate:"required,min=0"`
+	// Multi-select (picked options) and ordering (every option, in order) send
+	// answerIds, numeric questions numericAnswer, instead of answerId
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"`
 }
 
 // GetActiveSessionRequest is the HTTP request for getting an active session
 // UserID is passed as a query parameter
 type GetActiveSessionRequest struct {
-	UserID string `json:"userId" validate:"required"`
+	UserID string `json:"userId,omitempty"` // optional; must match the authenticated user
 }
 
 // GetActiveSessionResponse wraps the active session response
@@ -284,7 +304,7 @@ type GetActiveSessionData struct {
 
 // AbandonSessionRequest is the HTTP request body for abandoning a session
 type AbandonSessionRequest struct {
-	UserID string `json:"userId" validate:"required"`
+	UserID string `json:"userId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name AbandonSessionRequest
@@ -403,6 +423,35 @@ type GetUserProfileStatsResponse struct {
 
 // @name GetUserProfileStatsResponse
 
+// ========================================
+// Auth Session Models
+// ========================================
+
+// RefreshSessionRequest is the HTTP request body for refreshing a session
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// @name RefreshSessionRequest
+
+// SessionTokensDTO is an access + refresh token pair
+type SessionTokensDTO struct {
+	AccessToken      string `json:"accessToken" validate:"required"`
+	AccessExpiresAt  int64  `json:"accessExpiresAt" validate:"required"` // Unix seconds
+	RefreshToken     string `json:"refreshToken" validate:"required"`
+	RefreshExpiresAt int64  `json:"refreshExpiresAt" validate:"required"` // Unix seconds
+	TokenType        string `json:"tokenType" validate:"required"`        // "Bearer"
+}
+
+// @name SessionTokensDTO
+
+// SessionTokensResponse wraps the session tokens response
+type SessionTokensResponse struct {
+	Data SessionTokensDTO `json:"data" validate:"required"`
+}
+
+// @name SessionTokensResponse
+
 // ========================================
 // Session Results
 // ========================================
@@ -613,7 +662,7 @@ type MarathonBonusResultDTO struct {
 
 // StartMarathonRequest is the HTTP request body for starting a marathon
 type StartMarathonRequest struct {
-	PlayerID   string  `json:"playerId" validate:"required"`
+	PlayerID   string  `json:"playerId,omitempty"` // optional; must match the authenticated user
 	CategoryID *string `json:"categoryId,omitempty"`
 }
 
@@ -622,9 +671,13 @@ type StartMarathonRequest struct {
 // SubmitMarathonAnswerRequest is the HTTP request body for submitting an answer
 type SubmitMarathonAnswerRequest struct {
 	QuestionID string `json:"questionId" validate:"required"`
-	AnswerID   string `json:"answerId" validate:"required"`
-	PlayerID   string `json:"playerId" validate:"required"`
+	AnswerID   string `json:"answerId"`
+	PlayerID   string `json:"playerId,omitempty"` // optional; must match the authenticated user
 	TimeTaken  int64  `json:"timeTaken" validate:"required,min=0"`
+	// Multi-select (picked options) and ordering (every option, in order) send
+	// answerIds, numeric questions numericAnswer, instead of answerId
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"`
 }
 
 // @name SubmitMarathonAnswerRequest
@@ -633,22 +686,23 @@ type SubmitMarathonAnswerRequest struct {
 type UseMarathonBonusRequest struct {
 	QuestionID string `json:"questionId" validate:"required"`
 	BonusType  string `json:"bonusType" validate:"required"` // "shield", "fifty_fifty", "skip", "freeze"
-	PlayerID   string `json:"playerId" validate:"required"`
+	PlayerID   string `json:"playerId,omitempty"`            // optional; must match the authenticated user
 }
 
 // @name UseMarathonBonusRequest
 
 // ContinueMarathonRequest is the HTTP request body for continuing after game over
 type ContinueMarathonRequest struct {
-	PlayerID      string `json:"playerId" validate:"required"`
+	PlayerID      string `json:"playerId,omitempty"`                // optional; must match the authenticated user
 	PaymentMethod string `json:"paymentMethod" validate:"required"` // "coins" or "ad"
+	AdNonce       string `json:"adNonce,omitempty"`                 // reward nonce of the watched ad, required for "ad"
 }
 
 // @name ContinueMarathonRequest
 
 // AbandonMarathonRequest is the HTTP request body for abandoning a marathon
 type AbandonMarathonRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name AbandonMarathonRequest
@@ -676,6 +730,7 @@ type StartMarathonResponse struct {
 type SubmitMarathonAnswerData struct {
 	IsCorrect       bool                         `json:"isCorrect" validate:"required"`
 	CorrectAnswerID string                       `json:"correctAnswerId" validate:"required"`
+	Explanation     *ExplanationDTO              `json:"explanation,omitempty"`
 	TimeTaken       int64                        `json:"timeTaken" validate:"required"`
 	Score           int                          `json:"score" validate:"required"`
 	TotalQuestions  int                          `json:"totalQuestions" validate:"required"`
@@ -691,6 +746,9 @@ type SubmitMarathonAnswerData struct {
 	Milestone       *MarathonMilestoneDTO        `json:"milestone,omitempty"`
 	StreakCount     int                          `json:"streakCount" validate:"required"`
 	LifeRestored    bool                         `json:"lifeRestored" validate:"required"`
+	// Answer key of multi-select and ordering (solution order), or numeric questions
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"`
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`
 }
 
 // @name SubmitMarathonAnswerData
@@ -797,8 +855,8 @@ type GetMarathonLeaderboardResponse struct {
 
 // StartDailyChallengeRequest is the HTTP request for starting daily challenge
 type StartDailyChallengeRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
-	Date     string `json:"date,omitempty"` // YYYY-MM-DD, defaults to today
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
+	Date     string `json:"date,omitempty"`     // YYYY-MM-DD, defaults to today
 }
 
 // @name StartDailyChallengeRequest
@@ -806,9 +864,13 @@ type StartDailyChallengeRequest struct {
 // SubmitDailyAnswerRequest is the HTTP request for submitting answer
 type SubmitDailyAnswerRequest struct {
 	QuestionID string `json:"questionId" validate:"required"`
-	AnswerID   string `json:"answerId" validate:"required"`
-	PlayerID   string `json:"playerId" validate:"required"`
+	AnswerID   string `json:"answerId"`
+	PlayerID   string `json:"playerId,omitempty"` // optional; must match the authenticated user
 	TimeTaken  int64  `json:"timeTaken" validate:"required,min=0"`
+	// Multi-select (picked options) and ordering (every option, in order) send
+	// answerIds, numeric questions numericAnswer, instead of answerId
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"`
 }
 
 // @name SubmitDailyAnswerRequest
@@ -865,19 +927,35 @@ type ReviewAnswerDTO struct {
 
 // AnsweredQuestionDTO shows the answer after game completion
 type AnsweredQuestionDTO struct {
-	QuestionID        string `json:"questionId" validate:"required"`
-	QuestionText      string `json:"questionText" validate:"required"`
-	PlayerAnswerID    string `json:"playerAnswerId" validate:"required"`
-	PlayerAnswerText  string `json:"playerAnswerText" validate:"required"`
-	CorrectAnswerID   string `json:"correctAnswerId" validate:"required"`
-	CorrectAnswerText string `json:"correctAnswerText" validate:"required"`
-	IsCorrect         bool   `json:"isCorrect" validate:"required"`
-	TimeTaken         int64  `json:"timeTaken" validate:"required"`
-	PointsEarned      int    `json:"pointsEarned" validate:"required"`
+	QuestionID        string          `json:"questionId" validate:"required"`
+	QuestionText      string          `json:"questionText" validate:"required"`
+	PlayerAnswerID    string          `json:"playerAnswerId" validate:"required"`
+	PlayerAnswerText  string          `json:"playerAnswerText" validate:"required"`
+	CorrectAnswerID   string          `json:"correctAnswerId" validate:"required"`
+	CorrectAnswerText string          `json:"correctAnswerText" validate:"required"`
+	IsCorrect         bool            `json:"isCorrect" validate:"required"`
+	TimeTaken         int64           `json:"timeTaken" validate:"required"`
+	PointsEarned      int             `json:"pointsEarned" validate:"required"`
+	Explanation       *ExplanationDTO `json:"explanation,omitempty"`
+	QuestionType      string          `json:"questionType" validate:"required"`
+	// The player's answer to multi-select and ordering, or numeric questions
+	PlayerAnswerIDs []string `json:"playerAnswerIds,omitempty"`
+	PlayerNumber    *float64 `json:"playerNumber,omitempty"`
+	// Answer key of multi-select and ordering (solution order), or numeric questions
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"`
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`
 }
 
 // @name AnsweredQuestionDTO
 
+// ExplanationDTO is the rationale of a question, revealed once it has been answered
+type ExplanationDTO struct {
+	Text      string `json:"text" validate:"required"`
+	SourceURL string `json:"sourceUrl,omitempty"`
+}
+
+// @name ExplanationDTO
+
 // StreakDTO represents player's streak info
 type StreakDTO struct {
 	CurrentStreak  int    `json:"currentStreak" validate:"required"`
@@ -918,6 +996,9 @@ type SubmitDailyAnswerData struct {
 	NextQuestion       *QuestionDTO     `json:"nextQuestion,omitempty"`
 	NextTimeLimit      *int             `json:"nextTimeLimit,omitempty"`
 	GameResults        *GameResultsDTO  `json:"gameResults,omitempty"`
+	// Answer key of multi-select and ordering (solution order), or numeric questions
+	CorrectAnswerIDs []string `json:"correctAnswerIds,omitempty"`
+	CorrectNumber    *float64 `json:"correctNumber,omitempty"`
 }
 
 // @name SubmitDailyAnswerData
@@ -995,7 +1076,7 @@ type ChestRewardDTO struct {
 
 // OpenChestRequest is the HTTP request for opening chest
 type OpenChestRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name OpenChestRequest
@@ -1019,8 +1100,9 @@ type OpenChestResponse struct {
 
 // RetryChallengeRequest is the HTTP request for retrying challenge
 type RetryChallengeRequest struct {
-	PlayerID      string `json:"playerId" validate:"required"`
+	PlayerID      string `json:"playerId,omitempty"`                // optional; must match the authenticated user
 	PaymentMethod string `json:"paymentMethod" validate:"required"` // "coins" or "ad"
+	AdNonce       string `json:"adNonce,omitempty"`                 // reward nonce of the watched ad, required for "ad"
 }
 
 // @name RetryChallengeRequest
@@ -1045,8 +1127,9 @@ type RetryChallengeResponse struct {
 
 // RecoverStreakRequest is the HTTP request for recovering a broken streak
 type RecoverStreakRequest struct {
-	PlayerID      string `json:"playerId" validate:"required"`
+	PlayerID      string `json:"playerId,omitempty"`                // optional; must match the authenticated user
 	PaymentMethod string `json:"paymentMethod" validate:"required"` // "coins" or "ad"
+	AdNonce       string `json:"adNonce,omitempty"`                 // reward nonce of the watched ad, required for "ad"
 }
 
 // @name RecoverStreakRequest
@@ -1237,13 +1320,177 @@ type AdminListMarathonGamesResponse struct {
 
 // @name AdminListMarathonGamesResponse
 
+// ========================================
+// Background Jobs Admin Models
+// ========================================
+
+// AdminJobRun is one recorded run of a background job (times are Unix seconds)
+type AdminJobRun struct {
+	ID          int64  `json:"id"`
+	JobName     string `json:"jobName"`
+	Trigger     string `json:"trigger"` // schedule, manual
+	ScheduledAt int64  `json:"scheduledAt"`
+	StartedAt   int64  `json:"startedAt"`
+	FinishedAt  int64  `json:"finishedAt,omitempty"` // absent while running
+	Status      string `json:"status"`               // running, succeeded, failed
+	Summary     string `json:"summary,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Instance    string `json:"instance"` // host/pid of the API instance that ran it
+}
+
+// @name AdminJobRun
+
+// AdminJobInfo describes a registered background job
+type AdminJobInfo struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Schedule    string       `json:"schedule"` // cron expression or "every <interval>", UTC
+	NextRunAt   int64        `json:"nextRunAt"`
+	LastRun     *AdminJobRun `json:"lastRun,omitempty"`
+}
+
+// @name AdminJobInfo
+
+// AdminListJobsResponse wraps the background jobs list
+type AdminListJobsResponse struct {
+	Data struct {
+		Jobs []AdminJobInfo `json:"jobs"`
+	} `json:"data"`
+}
+
+// @name AdminListJobsResponse
+
+// AdminListJobRunsResponse wraps a job's run history
+type AdminListJobRunsResponse struct {
+	Data struct {
+		Runs []AdminJobRun `json:"runs"`
+	} `json:"data"`
+}
+
+// @name AdminListJobRunsResponse
+
+// AdminTriggerJobResponse wraps the manual trigger result
+type AdminTriggerJobResponse struct {
+	Data struct {
+		Job       string `json:"job"`
+		Triggered bool   `json:"triggered"`
+	} `json:"data"`
+}
+
+// @name AdminTriggerJobResponse
+
+// ========================================
+// Transaction History Models
+// ========================================
+
+// TransactionItem is an entry of a player's transaction log
+type TransactionItem struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"` // "credit" or "debit"
+	Source    string         `json:"source"`
+	Details   map[string]int `json:"details"`
+	CreatedAt int64          `json:"createdAt"`
+}
+
+// @name TransactionItem
+
+// ResourceTotals is how much of a resource was received and spent
+type ResourceTotals struct {
+	Credited int `json:"credited"`
+	Debited  int `json:"debited"`
+	Net      int `json:"net"`
+}
+
+// @name ResourceTotals
+
+// TransactionDaySummary totals a day of transactions per resource
+type TransactionDaySummary struct {
+	Date      string                    `json:"date"`
+	Resources map[string]ResourceTotals `json:"resources"`
+}
+
+// @name TransactionDaySummary
+
+// GetTransactionHistoryResponse wraps a page of transaction history
+type GetTransactionHistoryResponse struct {
+	Data struct {
+		Transactions []TransactionItem       `json:"transactions"`
+		NextCursor   string                  `json:"nextCursor,omitempty"`
+		Summary      []TransactionDaySummary `json:"summary,omitempty"`
+	} `json:"data"`
+}
+
+// @name GetTransactionHistoryResponse
+
+// ========================================
+// Inventory Admin Models
+// ========================================
+
+// AdminReconcileInventoryRequest is the request for reconciling inventories
+type AdminReconcileInventoryRequest struct {
+	PlayerID string `json:"playerId,omitempty"` // empty: every player with an inventory
+	Fix      bool   `json:"fix"`                // overwrite drifted balances with the replayed ones
+}
+
+// @name AdminReconcileInventoryRequest
+
+// AdminInventoryDrift is a balance that does not match the transaction log
+type AdminInventoryDrift struct {
+	PlayerID string `json:"playerId"`
+	Resource string `json:"resource"`
+	Balance  int    `json:"balance"`
+	Expected int    `json:"expected"`
+}
+
+// @name AdminInventoryDrift
+
+// AdminReconcileInventoryResponse wraps the reconciliation report
+type AdminReconcileInventoryResponse struct {
+	Data struct {
+		Checked int                   `json:"checked"`
+		Drifts  []AdminInventoryDrift `json:"drifts"`
+		Fixed   int                   `json:"fixed"`
+		Skipped []string              `json:"skipped"`
+	} `json:"data"`
+}
+
+// @name AdminReconcileInventoryResponse
+
+// ========================================
+// Event Bus Admin Models
+// ========================================
+
+// AdminEventHandlerStats are the counters of one event bus subscription since startup
+type AdminEventHandlerStats struct {
+	Handler         string `json:"handler"`
+	Event           string `json:"event"` // Go type the handler subscribed to
+	Mode            string `json:"mode"`  // sync, async
+	Handled         int64  `json:"handled"`
+	Failed          int64  `json:"failed"` // includes panics
+	Panics          int64  `json:"panics"`
+	TotalDurationMs int64  `json:"totalDurationMs"`
+	LastError       string `json:"lastError,omitempty"`
+	LastErrorAt     int64  `json:"lastErrorAt,omitempty"`
+}
+
+// @name AdminEventHandlerStats
+
+// AdminListEventHandlersResponse wraps the event bus subscriptions
+type AdminListEventHandlersResponse struct {
+	Data struct {
+		Handlers []AdminEventHandlerStats `json:"handlers"`
+	} `json:"data"`
+}
+
+// @name AdminListEventHandlersResponse
+
 // ========================================
 // Duel (PvP) Models
 // ========================================
 
 // JoinQueueRequest is the request for joining matchmaking queue
 type JoinQueueRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name JoinQueueRequest
@@ -1274,7 +1521,7 @@ type LeaveQueueResponse struct {
 
 // SendChallengeRequest is the request for sending a challenge
 type SendChallengeRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 	FriendID string `json:"friendId" validate:"required"`
 }
 
@@ -1294,7 +1541,7 @@ type SendChallengeResponse struct {
 
 // RespondChallengeRequest is the request for responding to a challenge
 type RespondChallengeRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"`         // optional; must match the authenticated user
 	Action   string `json:"action" validate:"required"` // "accept" or "decline"
 }
 
@@ -1314,7 +1561,7 @@ type RespondChallengeResponse struct {
 
 // AcceptByLinkCodeRequest is the request for accepting a challenge via link code
 type AcceptByLinkCodeRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"`           // optional; must match the authenticated user
 	LinkCode string `json:"linkCode" validate:"required"` // e.g., "duel_abc12345"
 }
 
@@ -1334,7 +1581,7 @@ type AcceptByLinkCodeResponse struct {
 
 // CreateChallengeLinkRequest is the request for creating a challenge link
 type CreateChallengeLinkRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name CreateChallengeLinkRequest
@@ -1371,20 +1618,23 @@ type GetDuelStatusResponse struct {
 
 // DuelPlayerRatingDTO represents player's competitive ranking
 type DuelPlayerRatingDTO struct {
-	PlayerID     string  `json:"playerId"`
-	MMR          int     `json:"mmr"`
-	League       string  `json:"league"`
-	Division     int     `json:"division"`
-	LeagueLabel  string  `json:"leagueLabel"`
-	LeagueIcon   string  `json:"leagueIcon"`
-	PeakMMR      int     `json:"peakMmr"`
-	PeakLeague   string  `json:"peakLeague"`
-	SeasonWins   int     `json:"seasonWins"`
-	SeasonLosses int     `json:"seasonLosses"`
-	SeasonDraws  int     `json:"seasonDraws"`
-	WinRate      float64 `json:"winRate"`
-	GamesAtRank  int     `json:"gamesAtRank"`
-	CanDemote    bool    `json:"canDemote"`
+	PlayerID           string  `json:"playerId"`
+	MMR                int     `json:"mmr"`
+	League             string  `json:"league"`
+	Division           int     `json:"division"`
+	LeagueLabel        string  `json:"leagueLabel"`
+	LeagueIcon         string  `json:"leagueIcon"`
+	PeakMMR            int     `json:"peakMmr"`
+	PeakLeague         string  `json:"peakLeague"`
+	SeasonWins         int     `json:"seasonWins"`
+	SeasonLosses       int     `json:"seasonLosses"`
+	SeasonDraws        int     `json:"seasonDraws"`
+	WinRate            float64 `json:"winRate"`
+	GamesAtRank        int     `json:"gamesAtRank"`
+	CanDemote          bool    `json:"canDemote"`
+	RD                 int     `json:"rd"`
+	InPlacement        bool    `json:"inPlacement"`
+	PlacementGamesLeft int     `json:"placementGamesLeft"`
 }
 
 // @name DuelPlayerRatingDTO
@@ -1436,6 +1686,7 @@ type GameHistoryEntryDTO struct {
 	Opponent      string `json:"opponent"`
 	OpponentMMR   int    `json:"opponentMmr"`
 	Result        string `json:"result"`
+	WinReason     string `json:"winReason,omitempty"` // "score", "time", "surrender", "timeout", "disconnect"
 	PlayerScore   int    `json:"playerScore"`
 	OpponentScore int    `json:"opponentScore"`
 	MMRChange     int    `json:"mmrChange"`
@@ -1445,6 +1696,45 @@ type GameHistoryEntryDTO struct {
 
 // @name GameHistoryEntryDTO
 
+// GetRatingHistoryResponse wraps a player's rating graph for a season
+type GetRatingHistoryResponse struct {
+	Data struct {
+		SeasonID string                  `json:"seasonId" validate:"required"`
+		StartMMR int                     `json:"startMmr" validate:"required"`
+		Points   []RatingHistoryPointDTO `json:"points" validate:"required"`
+		Events   []RankEventDTO          `json:"events" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetRatingHistoryResponse
+
+// RatingHistoryPointDTO is the player's rating after one finished duel
+type RatingHistoryPointDTO struct {
+	GameID    string `json:"gameId" validate:"required"`
+	MMR       int    `json:"mmr" validate:"required"`
+	MMRChange int    `json:"mmrChange" validate:"required"`
+	League    string `json:"league" validate:"required"`
+	Division  int    `json:"division" validate:"required"`
+	RankEvent string `json:"rankEvent,omitempty"` // "promoted", "demoted", "demotion_protected", "placed"
+	PlayedAt  int64  `json:"playedAt" validate:"required"`
+}
+
+// @name RatingHistoryPointDTO
+
+// RankEventDTO is a promotion, demotion, demotion protection or placement
+type RankEventDTO struct {
+	Type         string `json:"type" validate:"required"`
+	GameID       string `json:"gameId" validate:"required"`
+	FromLeague   string `json:"fromLeague" validate:"required"`
+	FromDivision int    `json:"fromDivision" validate:"required"`
+	ToLeague     string `json:"toLeague" validate:"required"`
+	ToDivision   int    `json:"toDivision" validate:"required"`
+	MMR          int    `json:"mmr" validate:"required"`
+	OccurredAt   int64  `json:"occurredAt" validate:"required"`
+}
+
+// @name RankEventDTO
+
 // GetDuelLeaderboardResponse wraps the duel leaderboard response
 type GetDuelLeaderboardResponse struct {
 	Data struct {
@@ -1477,7 +1767,7 @@ type DuelLeaderboardEntryDTO struct {
 
 // RequestRematchRequest is the request for rematch
 type RequestRematchRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name RequestRematchRequest
@@ -1508,7 +1798,7 @@ type GetGameResultPlayerDTO struct {
 
 // StartChallengeRequest - request to start a challenge
 type StartChallengeRequest struct {
-	PlayerID string `json:"playerId" validate:"required"`
+	PlayerID string `json:"playerId,omitempty"` // optional; must match the authenticated user
 }
 
 // @name StartChallengeRequest
@@ -1542,6 +1832,7 @@ type GetGameResultResponse struct {
 	Data struct {
 		GameID           string                  `json:"gameId"`
 		Result           string                  `json:"result"` // "win", "loss", "draw"
+		WinReason        string                  `json:"winReason,omitempty"`
 		PlayerScore      int                     `json:"playerScore"`
 		OpponentScore    int                     `json:"opponentScore"`
 		MMRChange        int                     `json:"mmrChange"`
@@ -1558,6 +1849,83 @@ type GetGameResultResponse struct {
 
 // @name GetGameResultResponse
 
+// ReplayPlayerDTO represents a duel participant in a replay
+type ReplayPlayerDTO struct {
+	ID          string `json:"id" validate:"required"`
+	Username    string `json:"username" validate:"required"`
+	FinalScore  int    `json:"finalScore" validate:"required"`
+	TotalTimeMs int64  `json:"totalTimeMs" validate:"required"`
+}
+
+// @name ReplayPlayerDTO
+
+// ReplayAnswerDTO represents an answer option of a replayed question
+type ReplayAnswerDTO struct {
+	ID    string    `json:"id" validate:"required"`
+	Text  string    `json:"text" validate:"required"`
+	Media *MediaDTO `json:"media,omitempty"`
+}
+
+// @name ReplayAnswerDTO
+
+// ReplayQuestionDTO represents a replayed round question
+type ReplayQuestionDTO struct {
+	ID      string            `json:"id" validate:"required"`
+	Text    string            `json:"text" validate:"required"`
+	Media   *MediaDTO         `json:"media,omitempty"`
+	Answers []ReplayAnswerDTO `json:"answers" validate:"required"`
+}
+
+// @name ReplayQuestionDTO
+
+// ReplayRoundAnswerDTO represents what one player did in a round
+type ReplayRoundAnswerDTO struct {
+	AnswerID     string `json:"answerId,omitempty"` // empty when timed out
+	// Multi-select (picked options) and ordering (every option, in order) report AnswerIDs instead
+	AnswerIDs     []string `json:"answerIds,omitempty"`
+	NumericAnswer *float64 `json:"numericAnswer,omitempty"` // numeric questions only
+	TimedOut     bool   `json:"timedOut" validate:"required"`
+	IsCorrect    bool   `json:"isCorrect" validate:"required"`
+	TimeTakenMs  int64  `json:"timeTakenMs" validate:"required"`
+	Points       int    `json:"points" validate:"required"`
+	SpeedBonus   int    `json:"speedBonus" validate:"required"`
+	RunningScore int    `json:"runningScore" validate:"required"`
+	// True when the client-reported time disagreed with the server's measurement
+	TimingFlagged bool `json:"timingFlagged,omitempty"`
+}
+
+// @name ReplayRoundAnswerDTO
+
+// ReplayRoundDTO represents one round of a duel replay
+type ReplayRoundDTO struct {
+	RoundNumber     int                   `json:"roundNumber" validate:"required"`
+	Question        ReplayQuestionDTO     `json:"question" validate:"required"`
+	CorrectAnswerID string                `json:"correctAnswerId" validate:"required"` // empty for the types below
+	CorrectAnswerIDs []string             `json:"correctAnswerIds,omitempty"`          // multi-select, ordering (solution order)
+	CorrectNumber    *float64             `json:"correctNumber,omitempty"`             // numeric
+	Player1         *ReplayRoundAnswerDTO `json:"player1,omitempty"`
+	Player2         *ReplayRoundAnswerDTO `json:"player2,omitempty"`
+}
+
+// @name ReplayRoundDTO
+
+// GetGameReplayResponse wraps the duel replay response
+type GetGameReplayResponse struct {
+	Data struct {
+		GameID     string           `json:"gameId" validate:"required"`
+		Status     string           `json:"status" validate:"required"`
+		WinnerID   *string          `json:"winnerId,omitempty"`
+		WinReason  string           `json:"winReason,omitempty"` // "score", "time", "surrender", "timeout", "disconnect"
+		Player1    ReplayPlayerDTO  `json:"player1" validate:"required"`
+		Player2    ReplayPlayerDTO  `json:"player2" validate:"required"`
+		Rounds     []ReplayRoundDTO `json:"rounds" validate:"required"`
+		StartedAt  int64            `json:"startedAt" validate:"required"`
+		FinishedAt int64            `json:"finishedAt" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetGameReplayResponse
+
 // RivalItemDTO represents a rival in Swagger docs
 type RivalItemDTO struct {
 	ID         string `json:"id" validate:"required"`
@@ -1583,7 +1951,7 @@ type GetRivalsResponse struct {
 
 // PrepareShareRequest is the request body for POST /duel/challenge/prepare-share
 type PrepareShareRequest struct {
-	PlayerID      string `json:"playerId" validate:"required"`
+	PlayerID      string `json:"playerId,omitempty"` // optional; must match the authenticated user
 	ChallengeLink string `json:"challengeLink" validate:"required"`
 }
 
@@ -1661,3 +2029,674 @@ type SurrenderGameResponse struct {
 }
 
 // @name SurrenderGameResponse
+
+// ========================================
+// Party Mode Models
+// ========================================
+
+// PartyRoomSettingsDTO represents party room configuration
+type PartyRoomSettingsDTO struct {
+	MaxPlayers        int      `json:"maxPlayers" validate:"required"`
+	QuestionsCount    int      `json:"questionsCount" validate:"required"`
+	TimePerQuestion   int      `json:"timePerQuestion" validate:"required"`
+	Categories        []string `json:"categories" validate:"required"`
+	Difficulty        string   `json:"difficulty" validate:"required"` // "easy", "mix", "hard"
+	ShowCorrectAnswer bool     `json:"showCorrectAnswer" validate:"required"`
+	ShowPlayerAnswers bool     `json:"showPlayerAnswers" validate:"required"`
+	ShowCurrentScore  bool     `json:"showCurrentScore" validate:"required"`
+}
+
+// @name PartyRoomSettingsDTO
+
+// PartyRoomPlayerDTO represents a player in the room lobby
+type PartyRoomPlayerDTO struct {
+	ID        string `json:"id" validate:"required"`
+	Username  string `json:"username" validate:"required"`
+	IsHost    bool   `json:"isHost" validate:"required"`
+	IsReady   bool   `json:"isReady" validate:"required"`
+	Connected bool   `json:"connected" validate:"required"`
+	JoinedAt  int64  `json:"joinedAt" validate:"required"`
+}
+
+// @name PartyRoomPlayerDTO
+
+// PartyRoomDTO represents a party room
+type PartyRoomDTO struct {
+	ID        string               `json:"id" validate:"required"`
+	Code      string               `json:"code" validate:"required"`
+	Name      string               `json:"name" validate:"required"`
+	HostID    string               `json:"hostId" validate:"required"`
+	Status    string               `json:"status" validate:"required"` // "lobby", "playing", "closed"
+	Settings  PartyRoomSettingsDTO `json:"settings" validate:"required"`
+	Players   []PartyRoomPlayerDTO `json:"players" validate:"required"`
+	CreatedAt int64                `json:"createdAt" validate:"required"`
+	ExpiresAt int64                `json:"expiresAt" validate:"required"`
+}
+
+// @name PartyRoomDTO
+
+// PartyPlayerDTO represents a player's standing in a party game
+type PartyPlayerDTO struct {
+	ID           string `json:"id" validate:"required"`
+	Username     string `json:"username" validate:"required"`
+	Score        int    `json:"score" validate:"required"`
+	Connected    bool   `json:"connected" validate:"required"`
+	AnswersCount int    `json:"answersCount" validate:"required"`
+}
+
+// @name PartyPlayerDTO
+
+// PartyGameDTO represents an active or finished party game
+type PartyGameDTO struct {
+	ID              string           `json:"id" validate:"required"`
+	RoomID          string           `json:"roomId" validate:"required"`
+	Status          string           `json:"status" validate:"required"` // "in_progress", "finished"
+	CurrentQuestion int              `json:"currentQuestion" validate:"required"`
+	TotalQuestions  int              `json:"totalQuestions" validate:"required"`
+	Players         []PartyPlayerDTO `json:"players" validate:"required"`
+	StartedAt       int64            `json:"startedAt" validate:"required"`
+	FinishedAt      int64            `json:"finishedAt,omitempty"`
+}
+
+// @name PartyGameDTO
+
+// CreatePartyRoomRequest is the request body for POST /party/rooms
+type CreatePartyRoomRequest struct {
+	Name            string   `json:"name,omitempty"`
+	MaxPlayers      int      `json:"maxPlayers,omitempty"`
+	QuestionsCount  int      `json:"questionsCount,omitempty"`
+	TimePerQuestion int      `json:"timePerQuestion,omitempty"`
+	Difficulty      string   `json:"difficulty,omitempty"`
+	CategoryIDs     []string `json:"categoryIds,omitempty"`
+}
+
+// @name CreatePartyRoomRequest
+
+// JoinPartyRoomRequest is the request body for POST /party/rooms/join
+type JoinPartyRoomRequest struct {
+	RoomCode string `json:"roomCode" validate:"required"`
+}
+
+// @name JoinPartyRoomRequest
+
+// SetPartyReadyRequest is the request body for POST /party/rooms/:roomId/ready
+type SetPartyReadyRequest struct {
+	Ready bool `json:"ready"`
+}
+
+// @name SetPartyReadyRequest
+
+// SubmitPartyAnswerRequest is the request body for POST /party/game/:gameId/answer
+type SubmitPartyAnswerRequest struct {
+	QuestionID string `json:"questionId" validate:"required"`
+	AnswerID   string `json:"answerId" validate:"required"`
+	TimeTaken  int64  `json:"timeTaken" validate:"required"` // milliseconds
+}
+
+// @name SubmitPartyAnswerRequest
+
+// PartyRoomResponse wraps create/join/ready responses
+type PartyRoomResponse struct {
+	Data struct {
+		Room PartyRoomDTO `json:"room" validate:"required"`
+	} `json:"data"`
+}
+
+// @name PartyRoomResponse
+
+// GetPartyRoomResponse is the Swagger response model for GET /party/rooms/:roomId
+type GetPartyRoomResponse struct {
+	Data struct {
+		Room PartyRoomDTO  `json:"room" validate:"required"`
+		Game *PartyGameDTO `json:"game,omitempty"`
+	} `json:"data"`
+}
+
+// @name GetPartyRoomResponse
+
+// StartPartyGameResponse is the Swagger response model for POST /party/rooms/:roomId/start
+type StartPartyGameResponse struct {
+	Data struct {
+		GameID          string `json:"gameId" validate:"required"`
+		RoomID          string `json:"roomId" validate:"required"`
+		TotalQuestions  int    `json:"totalQuestions" validate:"required"`
+		TimePerQuestion int    `json:"timePerQuestion" validate:"required"`
+		StartsIn        int    `json:"startsIn" validate:"required"`
+	} `json:"data"`
+}
+
+// @name StartPartyGameResponse
+
+// LeavePartyRoomResponse is the Swagger response model for POST /party/rooms/:roomId/leave
+type LeavePartyRoomResponse struct {
+	Data struct {
+		Success    bool   `json:"success" validate:"required"`
+		RoomStatus string `json:"roomStatus" validate:"required"`
+	} `json:"data"`
+}
+
+// @name LeavePartyRoomResponse
+
+// SubmitPartyAnswerResponse is the Swagger response model for POST /party/game/:gameId/answer
+type SubmitPartyAnswerResponse struct {
+	Data struct {
+		IsCorrect       bool    `json:"isCorrect" validate:"required"`
+		CorrectAnswerID string  `json:"correctAnswerId,omitempty"`
+		PointsEarned    int     `json:"pointsEarned" validate:"required"`
+		Position        int     `json:"position" validate:"required"`
+		PlayerScore     int     `json:"playerScore" validate:"required"`
+		QuestionNumber  int     `json:"questionNumber" validate:"required"`
+		AllAnswered     bool    `json:"allAnswered" validate:"required"`
+		GameFinished    bool    `json:"gameFinished" validate:"required"`
+		WinnerID        *string `json:"winnerId,omitempty"`
+	} `json:"data"`
+}
+
+// @name SubmitPartyAnswerResponse
+
+// ========================================
+// Shop Models
+// ========================================
+
+// ShopItem is a catalog item as offered to the player
+type ShopItem struct {
+	ID                 string         `json:"id" validate:"required"`
+	Title              string         `json:"title" validate:"required"`
+	Description        string         `json:"description"`
+	Contents           map[string]int `json:"contents" validate:"required"` // resource -> amount
+	PriceCoins         int            `json:"priceCoins" validate:"required"`
+	PurchaseLimit      int            `json:"purchaseLimit"`                   // 0 = unlimited
+	LimitWindow        string         `json:"limitWindow" validate:"required"` // lifetime, daily, weekly
+	RemainingPurchases *int           `json:"remainingPurchases,omitempty"`    // absent when unlimited
+	IsLimitedTimeOffer bool           `json:"isLimitedTimeOffer"`
+	AvailableUntil     int64          `json:"availableUntil,omitempty"`
+	CanAfford          bool           `json:"canAfford"`
+}
+
+// @name ShopItem
+
+// GetShopResponse wraps the catalog offered to the player
+type GetShopResponse struct {
+	Data struct {
+		Coins int        `json:"coins" validate:"required"`
+		Items []ShopItem `json:"items" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetShopResponse
+
+// PurchaseShopItemRequest is the request for buying a catalog item
+type PurchaseShopItemRequest struct {
+	ItemID string `json:"itemId" validate:"required"`
+}
+
+// @name PurchaseShopItemRequest
+
+// ShopPurchase is a completed purchase
+type ShopPurchase struct {
+	ID         string         `json:"id" validate:"required"`
+	ItemID     string         `json:"itemId" validate:"required"`
+	PriceCoins int            `json:"priceCoins" validate:"required"`
+	Contents   map[string]int `json:"contents" validate:"required"`
+	CreatedAt  int64          `json:"createdAt" validate:"required"`
+}
+
+// @name ShopPurchase
+
+// PurchaseShopItemResponse wraps a completed purchase and the coin balance after it
+type PurchaseShopItemResponse struct {
+	Data struct {
+		Purchase ShopPurchase `json:"purchase" validate:"required"`
+		Coins    int          `json:"coins" validate:"required"`
+	} `json:"data"`
+}
+
+// @name PurchaseShopItemResponse
+
+// ========================================
+// Premium Models
+// ========================================
+
+// PremiumPlan is a premium offer paid in Telegram Stars
+type PremiumPlan struct {
+	ID           string `json:"id" validate:"required"`
+	Title        string `json:"title" validate:"required"`
+	Description  string `json:"description" validate:"required"`
+	PriceStars   int    `json:"priceStars" validate:"required"`
+	Days         int    `json:"days" validate:"required"`
+	Subscription bool   `json:"subscription"` // renewed monthly by Telegram
+}
+
+// @name PremiumPlan
+
+// GetPremiumStatusResponse wraps the player's premium status and the plans on sale
+type GetPremiumStatusResponse struct {
+	Data struct {
+		IsPremium bool          `json:"isPremium" validate:"required"`
+		ExpiresAt int64         `json:"expiresAt,omitempty"`
+		Plans     []PremiumPlan `json:"plans" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetPremiumStatusResponse
+
+// CreatePremiumInvoiceRequest is the request for a premium invoice
+type CreatePremiumInvoiceRequest struct {
+	PlanID string `json:"planId" validate:"required"`
+}
+
+// @name CreatePremiumInvoiceRequest
+
+// CreatePremiumInvoiceResponse wraps the invoice link to open in the Mini App
+type CreatePremiumInvoiceResponse struct {
+	Data struct {
+		InvoiceLink string      `json:"invoiceLink" validate:"required"`
+		Plan        PremiumPlan `json:"plan" validate:"required"`
+	} `json:"data"`
+}
+
+// @name CreatePremiumInvoiceResponse
+
+// ========================================
+// Ad Reward Models
+// ========================================
+
+// IssueAdRewardRequest is the request for a rewarded ad nonce
+type IssueAdRewardRequest struct {
+	Placement string `json:"placement" validate:"required"` // "daily_retry", "streak_recovery", "marathon_continue"
+}
+
+// @name IssueAdRewardRequest
+
+// IssueAdRewardResponse wraps the one-time nonce to pass to the ad network
+type IssueAdRewardResponse struct {
+	Data struct {
+		Nonce          string `json:"nonce" validate:"required"`
+		Placement      string `json:"placement" validate:"required"`
+		ExpiresAt      int64  `json:"expiresAt" validate:"required"`
+		RemainingToday int    `json:"remainingToday" validate:"required"`
+	} `json:"data"`
+}
+
+// @name IssueAdRewardResponse
+
+// ========================================
+// Social Models
+// ========================================
+
+// FriendPlayer identifies another player in friend lists
+type FriendPlayer struct {
+	ID        string `json:"id" validate:"required"`
+	Username  string `json:"username" validate:"required"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+}
+
+// @name FriendPlayer
+
+// Friend is an accepted friend
+type Friend struct {
+	FriendPlayer
+	FriendshipID string `json:"friendshipId" validate:"required"`
+	Since        int64  `json:"since" validate:"required"`
+}
+
+// @name Friend
+
+// FriendRequest is a pending friend request, sent or received
+type FriendRequest struct {
+	ID        string       `json:"id" validate:"required"`
+	Player    FriendPlayer `json:"player" validate:"required"` // the other side of the request
+	CreatedAt int64        `json:"createdAt" validate:"required"`
+}
+
+// @name FriendRequest
+
+// GetFriendsResponse wraps the player's friends and pending requests
+type GetFriendsResponse struct {
+	Data struct {
+		Friends  []Friend        `json:"friends" validate:"required"`
+		Incoming []FriendRequest `json:"incoming" validate:"required"`
+		Outgoing []FriendRequest `json:"outgoing" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetFriendsResponse
+
+// SendFriendRequestRequest is the request for inviting a player to be friends
+type SendFriendRequestRequest struct {
+	FriendID string `json:"friendId" validate:"required"`
+}
+
+// @name SendFriendRequestRequest
+
+// SendFriendRequestResponse wraps the sent (or accepted) friend request
+type SendFriendRequestResponse struct {
+	Data struct {
+		RequestID string `json:"requestId" validate:"required"`
+		Status    string `json:"status" validate:"required"` // "pending" or "accepted"
+	} `json:"data"`
+}
+
+// @name SendFriendRequestResponse
+
+// RespondFriendRequestRequest is the answer to a received friend request
+type RespondFriendRequestRequest struct {
+	Action string `json:"action" validate:"required"` // "accept" or "decline"
+}
+
+// @name RespondFriendRequestRequest
+
+// RespondFriendRequestResponse wraps the result of answering a friend request
+type RespondFriendRequestResponse struct {
+	Data struct {
+		Success bool    `json:"success" validate:"required"`
+		Friend  *Friend `json:"friend,omitempty"` // set when accepted
+	} `json:"data"`
+}
+
+// @name RespondFriendRequestResponse
+
+// RemoveFriendResponse wraps the result of removing a friend
+type RemoveFriendResponse struct {
+	Data struct {
+		Success bool `json:"success" validate:"required"`
+	} `json:"data"`
+}
+
+// @name RemoveFriendResponse
+
+// FriendSuggestion is a duel opponent who is not a friend yet
+type FriendSuggestion struct {
+	FriendPlayer
+	GamesPlayed  int   `json:"gamesPlayed" validate:"required"`
+	LastPlayedAt int64 `json:"lastPlayedAt" validate:"required"`
+}
+
+// @name FriendSuggestion
+
+// GetFriendSuggestionsResponse wraps friend suggestions from duel history
+type GetFriendSuggestionsResponse struct {
+	Data struct {
+		Suggestions []FriendSuggestion `json:"suggestions" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetFriendSuggestionsResponse
+
+// BlockedPlayer is a player the player blocked
+type BlockedPlayer struct {
+	FriendPlayer
+	BlockedAt int64 `json:"blockedAt" validate:"required"`
+}
+
+// @name BlockedPlayer
+
+// GetBlockedPlayersResponse wraps the players the player blocked
+type GetBlockedPlayersResponse struct {
+	Data struct {
+		Players []BlockedPlayer `json:"players" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetBlockedPlayersResponse
+
+// BlockPlayerResponse wraps the result of blocking a player
+type BlockPlayerResponse struct {
+	Data struct {
+		Success bool `json:"success" validate:"required"`
+	} `json:"data"`
+}
+
+// @name BlockPlayerResponse
+
+// UnblockPlayerResponse wraps the result of unblocking a player
+type UnblockPlayerResponse struct {
+	Data struct {
+		Success bool `json:"success" validate:"required"`
+	} `json:"data"`
+}
+
+// @name UnblockPlayerResponse
+
+// GetOnlineFriendsResponse wraps the player's friends who are online
+type GetOnlineFriendsResponse struct {
+	Data struct {
+		OnlineFriends []DuelFriendDTO `json:"onlineFriends" validate:"required"`
+	} `json:"data"`
+}
+
+// @name GetOnlineFriendsResponse
+
+// ========================================
+// Content Admin Models
+// ========================================
+
+// AdminContentMedia is question or answer media as stored: an external URL or an asset key
+type AdminContentMedia struct {
+	URL      string `json:"url,omitempty"`
+	AssetKey string `json:"assetKey,omitempty"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Alt      string `json:"alt"`
+}
+
+// @name AdminContentMedia
+
+// AdminContentNumericAnswer is the answer key of a numeric question
+type AdminContentNumericAnswer struct {
+	Value     float64 `json:"value" validate:"required"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+// @name AdminContentNumericAnswer
+
+// AdminContentQuizTranslation is a quiz's title and description in one locale
+type AdminContentQuizTranslation struct {
+	Title       string `json:"title" validate:"required"`
+	Description string `json:"description"`
+}
+
+// @name AdminContentQuizTranslation
+
+// AdminContentQuestionTranslation is a question's text and explanation in one locale
+type AdminContentQuestionTranslation struct {
+	Text        string `json:"text" validate:"required"`
+	Explanation string `json:"explanation"`
+}
+
+// @name AdminContentQuestionTranslation
+
+// AdminContentAnswer is an answer option with its correctness
+type AdminContentAnswer struct {
+	ID           string             `json:"id" validate:"required"`
+	Text         string             `json:"text" validate:"required"`
+	IsCorrect    bool               `json:"isCorrect" validate:"required"`
+	Position     int                `json:"position" validate:"required"`
+	Media        *AdminContentMedia `json:"media"`
+	Translations map[string]string  `json:"translations" validate:"required"`
+}
+
+// @name AdminContentAnswer
+
+// AdminContentQuestion is the full editable state of a question
+type AdminContentQuestion struct {
+	ID            string                                     `json:"id" validate:"required"`
+	QuizID        string                                     `json:"quizId" validate:"required"`
+	Type          string                                     `json:"type" validate:"required"`
+	Text          string                                     `json:"text" validate:"required"`
+	Points        int                                        `json:"points" validate:"required"`
+	Position      int                                        `json:"position" validate:"required"`
+	Difficulty    string                                     `json:"difficulty" validate:"required"`
+	Explanation   string                                     `json:"explanation"`
+	SourceURL     string                                     `json:"sourceUrl"`
+	Media         *AdminContentMedia                         `json:"media"`
+	NumericAnswer *AdminContentNumericAnswer                 `json:"numericAnswer"`
+	Answers       []AdminContentAnswer                       `json:"answers" validate:"required"`
+	Translations  map[string]AdminContentQuestionTranslation `json:"translations" validate:"required"`
+	Deleted       bool                                       `json:"deleted" validate:"required"`
+	DeletedAt     int64                                      `json:"deletedAt,omitempty"`
+}
+
+// @name AdminContentQuestion
+
+// AdminContentQuiz is the full editable state of a quiz
+type AdminContentQuiz struct {
+	ID           string                                 `json:"id" validate:"required"`
+	Title        string                                 `json:"title" validate:"required"`
+	Description  string                                 `json:"description"`
+	CategoryID   string                                 `json:"categoryId"`
+	TimeLimit    int                                    `json:"timeLimit" validate:"required"`
+	PassingScore int                                    `json:"passingScore" validate:"required"`
+	Tags         []string                               `json:"tags" validate:"required"`
+	Translations map[string]AdminContentQuizTranslation `json:"translations" validate:"required"`
+	Questions    []AdminContentQuestion                 `json:"questions,omitempty"`
+	CreatedAt    int64                                  `json:"createdAt" validate:"required"`
+	UpdatedAt    int64                                  `json:"updatedAt" validate:"required"`
+	Deleted      bool                                   `json:"deleted" validate:"required"`
+	DeletedAt    int64                                  `json:"deletedAt,omitempty"`
+}
+
+// @name AdminContentQuiz
+
+// AdminContentCategory is the full editable state of a category
+type AdminContentCategory struct {
+	ID           string            `json:"id" validate:"required"`
+	Name         string            `json:"name" validate:"required"`
+	Translations map[string]string `json:"translations" validate:"required"`
+	Deleted      bool              `json:"deleted" validate:"required"`
+	DeletedAt    int64             `json:"deletedAt,omitempty"`
+}
+
+// @name AdminContentCategory
+
+// AdminContentTag is the full editable state of a tag
+type AdminContentTag struct {
+	ID        string `json:"id" validate:"required"`
+	Name      string `json:"name" validate:"required"`
+	Deleted   bool   `json:"deleted" validate:"required"`
+	DeletedAt int64  `json:"deletedAt,omitempty"`
+}
+
+// @name AdminContentTag
+
+// ContentAuditFieldChange is a field's value before and after an edit
+type ContentAuditFieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// @name ContentAuditFieldChange
+
+// ContentAuditEntry is one admin edit of catalog content
+type ContentAuditEntry struct {
+	ID         string                             `json:"id" validate:"required"`
+	EntityType string                             `json:"entityType" validate:"required"`
+	EntityID   string                             `json:"entityId" validate:"required"`
+	Action     string                             `json:"action" validate:"required"`
+	Actor      string                             `json:"actor" validate:"required"`
+	Changes    map[string]ContentAuditFieldChange `json:"changes" validate:"required"`
+	CreatedAt  int64                              `json:"createdAt" validate:"required"`
+}
+
+// @name ContentAuditEntry
+
+// AdminContentQuizRequest is the request body for creating or updating a quiz
+type AdminContentQuizRequest struct {
+	Title        string                                 `json:"title" validate:"required"`
+	Description  string                                 `json:"description"`
+	CategoryID   string                                 `json:"categoryId"`
+	TimeLimit    int                                    `json:"timeLimit" validate:"required"`
+	PassingScore int                                    `json:"passingScore" validate:"required"`
+	Tags         []string                               `json:"tags"`
+	Translations map[string]AdminContentQuizTranslation `json:"translations"`
+}
+
+// @name AdminContentQuizRequest
+
+// AdminContentAnswerRequest is an answer option of AdminContentQuestionRequest
+type AdminContentAnswerRequest struct {
+	ID           string             `json:"id,omitempty"` // existing answer to keep; empty creates one
+	Text         string             `json:"text" validate:"required"`
+	IsCorrect    bool               `json:"isCorrect"`
+	Media        *AdminContentMedia `json:"media"`
+	Translations map[string]string  `json:"translations"`
+}
+
+// @name AdminContentAnswerRequest
+
+// AdminContentQuestionRequest is the request body for creating or updating a question
+type AdminContentQuestionRequest struct {
+	Type          string                                     `json:"type"`
+	Text          string                                     `json:"text" validate:"required"`
+	Points        int                                        `json:"points" validate:"required"`
+	Position      *int                                       `json:"position,omitempty"`
+	Difficulty    string                                     `json:"difficulty"`
+	Explanation   string                                     `json:"explanation"`
+	SourceURL     string                                     `json:"sourceUrl"`
+	Media         *AdminContentMedia                         `json:"media"`
+	NumericAnswer *AdminContentNumericAnswer                 `json:"numericAnswer"`
+	Answers       []AdminContentAnswerRequest                `json:"answers"`
+	Translations  map[string]AdminContentQuestionTranslation `json:"translations"`
+}
+
+// @name AdminContentQuestionRequest
+
+// AdminContentCategoryRequest is the request body for creating or updating a category
+type AdminContentCategoryRequest struct {
+	Name         string            `json:"name" validate:"required"`
+	Translations map[string]string `json:"translations"`
+}
+
+// @name AdminContentCategoryRequest
+
+// AdminContentTagRequest is the request body for creating or renaming a tag
+type AdminContentTagRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// @name AdminContentTagRequest
+
+// AdminContentQuizResponse wraps an admin quiz
+type AdminContentQuizResponse struct {
+	Data struct {
+		Quiz AdminContentQuiz `json:"quiz" validate:"required"`
+	} `json:"data"`
+}
+
+// @name AdminContentQuizResponse
+
+// AdminContentQuestionResponse wraps an admin question
+type AdminContentQuestionResponse struct {
+	Data struct {
+		Question AdminContentQuestion `json:"question" validate:"required"`
+	} `json:"data"`
+}
+
+// @name AdminContentQuestionResponse
+
+// AdminContentCategoryResponse wraps an admin category
+type AdminContentCategoryResponse struct {
+	Data struct {
+		Category AdminContentCategory `json:"category" validate:"required"`
+	} `json:"data"`
+}
+
+// @name AdminContentCategoryResponse
+
+// AdminContentTagResponse wraps an admin tag
+type AdminContentTagResponse struct {
+	Data struct {
+		Tag AdminContentTag `json:"tag" validate:"required"`
+	} `json:"data"`
+}
+
+// @name AdminContentTagResponse
+
+// AdminContentAuditResponse wraps content audit entries
+type AdminContentAuditResponse struct {
+	Data struct {
+		Entries []ContentAuditEntry `json:"entries" validate:"required"`
+	} `json:"data"`
+}
+
+// @name AdminContentAuditResponse
@@ -0,0 +1,36 @@
+package middleware
+
+import "github.com/gofiber/fiber/v3"
+
+// PrincipalLocalsKey is the request-context key of the authenticated principal.
+// WebSocket handlers read it back through (*websocket.Conn).Locals.
+const PrincipalLocalsKey = "auth_principal"
+
+// Principal is the authenticated caller.
+// PlayerID is derived by the server from validated credentials and is the only
+// player identity handlers may act on.
+type Principal struct {
+	PlayerID     string
+	Username     string // Telegram username, or first name when unset
+	LanguageCode string // content language (ISO 639-1); empty = default
+}
+
+// SetPrincipal stores the authenticated principal in the request context
+func SetPrincipal(c fiber.Ctx, principal *Principal) {
+	c.Locals(PrincipalLocalsKey, principal)
+}
+
+// GetPrincipal retrieves the authenticated principal from the request context.
+// Returns nil when the request was not authenticated.
+func GetPrincipal(c fiber.Ctx) *Principal {
+	return PrincipalFromLocals(c.Locals(PrincipalLocalsKey))
+}
+
+// PrincipalFromLocals converts a raw Locals value into a principal
+func PrincipalFromLocals(value interface{}) *Principal {
+	principal, ok := value.(*Principal)
+	if !ok {
+		return nil
+	}
+	return principal
+}
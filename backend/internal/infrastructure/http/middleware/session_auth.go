@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// SessionVerifier resolves a session access token to its principal.
+// Returned *fiber.Error values are passed through; any other error is a 401.
+type SessionVerifier func(accessToken string) (*Principal, error)
+
+var (
+	sessionVerifierMu sync.RWMutex
+	sessionVerifier   SessionVerifier
+)
+
+// SetSessionVerifier enables "Authorization: Bearer <access-token>" authentication.
+// Until it is set only Telegram init data is accepted.
+func SetSessionVerifier(verifier SessionVerifier) {
+	sessionVerifierMu.Lock()
+	defer sessionVerifierMu.Unlock()
+	sessionVerifier = verifier
+}
+
+// authenticateSession verifies a session access token and stores the principal in the request context
+func authenticateSession(c fiber.Ctx, accessToken string) error {
+	sessionVerifierMu.RLock()
+	verify := sessionVerifier
+	sessionVerifierMu.RUnlock()
+
+	if verify == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "Session tokens are not enabled")
+	}
+	if accessToken == "" {
+		return fiber.NewError(fiber.StatusUnauthorized, "Empty access token")
+	}
+
+	principal, err := verify(accessToken)
+	if err != nil {
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			return fiberErr
+		}
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired access token: "+err.Error())
+	}
+
+	SetPrincipal(c, principal)
+	return nil
+}
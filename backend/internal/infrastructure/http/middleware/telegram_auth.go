@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,7 +13,8 @@ import (
 )
 
 // TelegramAuthMiddleware validates Telegram Mini App init data
-// Expects "Authorization: tma <base64-encoded-init-data-raw>" header
+// Expects "Authorization: tma <base64-encoded-init-data-raw>" header,
+// or "Authorization: Bearer <access-token>" once a session verifier is set
 func TelegramAuthMiddleware() fiber.Handler {
 	return func(c fiber.Ctx) error {
 		// 1. Extract Authorization header
@@ -21,66 +23,122 @@ func TelegramAuthMiddleware() fiber.Handler {
 			return fiber.NewError(fiber.StatusUnauthorized, "Missing Authorization header")
 		}
 
-		// 2. Parse "tma <init-data-raw>" format
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "tma" {
-			return fiber.NewError(fiber.StatusUnauthorized, "Invalid Authorization header format. Expected: 'tma <init-data-raw>'")
+		if err := authenticate(c, authHeader); err != nil {
+			return err
 		}
 
-		encodedInitData := parts[1]
-		if encodedInitData == "" {
-			return fiber.NewError(fiber.StatusUnauthorized, "Empty init data")
-		}
+		fmt.Println("✅ Stored in context, calling next handler...")
+
+		return c.Next()
+	}
+}
 
-		// 3. Decode from Base64
-		decodedInitData, err := base64.StdEncoding.DecodeString(encodedInitData)
-		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, "Invalid Base64 in init data: "+err.Error())
+// TelegramWebSocketAuthMiddleware authenticates WebSocket upgrade requests.
+// Browsers cannot set headers on a WebSocket handshake, so the Authorization
+// value ("tma <base64-encoded-init-data-raw>" or "Bearer <access-token>")
+// may also be passed as the "auth" query parameter.
+func TelegramWebSocketAuthMiddleware() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		authHeader := c.Get("Authorization")
+		if authHeader == "" {
+			authHeader = c.Query("auth")
+		}
+		if authHeader == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "Missing Authorization header or auth query parameter")
 		}
-		initDataRaw := string(decodedInitData)
 
-		// 4. Get bot token from environment
-		botToken := os.Getenv("TELEGRAM_BOT_TOKEN")
-		if botToken == "" {
-			return fiber.NewError(fiber.StatusInternalServerError, "Bot token not configured")
+		if err := authenticate(c, authHeader); err != nil {
+			return err
 		}
 
-		// 5. Validate init data signature and expiration
-		// Telegram considers init data valid for 1 hour by default
-		expiration := 1 * time.Hour
+		return c.Next()
+	}
+}
 
-		fmt.Printf("🔍 Validating init data:\n")
-		// Only log first 100 chars for brevity
-		loggableInitData := initDataRaw
-		if len(loggableInitData) > 100 {
-			loggableInitData = loggableInitData[:100] + "..."
-		}
-		fmt.Printf("  Raw Decoded: %s\n", loggableInitData)
-		fmt.Printf("  Bot token: %s...\n", botToken[:min(20, len(botToken))])
-		fmt.Printf("  Expiration: %v\n", expiration)
+// authenticate validates an Authorization value and stores the principal in the request context
+func authenticate(c fiber.Ctx, authHeader string) error {
+	// 2. Parse "tma <init-data-raw>" format
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) == 2 && parts[0] == "Bearer" {
+		return authenticateSession(c, parts[1])
+	}
+	if len(parts) != 2 || parts[0] != "tma" {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid Authorization header format. Expected: 'tma <init-data-raw>' or 'Bearer <access-token>'")
+	}
 
-		if err := initdata.Validate(initDataRaw, botToken, expiration); err != nil {
-			fmt.Printf("❌ Validation failed: %v\n", err)
-			return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired init data: "+err.Error())
-		}
+	parsedData, err := validateInitData(parts[1])
+	if err != nil {
+		return err
+	}
 
-		fmt.Println("✅ Validation successful!")
+	// 7. Store parsed init data in request context for handlers
+	c.Locals("telegram_init_data", parsedData)
+	SetPrincipal(c, principalFromInitData(parsedData))
 
-		// 6. Parse validated init data
-		parsedData, err := initdata.Parse(initDataRaw)
-		if err != nil {
-			fmt.Printf("❌ Parse failed: %v\n", err)
-			return fiber.NewError(fiber.StatusUnauthorized, "Failed to parse init data: "+err.Error())
-		}
+	return nil
+}
 
-		fmt.Printf("✅ Parsed init data: user_id=%d, username=%s\n", parsedData.User.ID, parsedData.User.Username)
+// validateInitData decodes and validates base64-encoded init data
+func validateInitData(encodedInitData string) (*initdata.InitData, error) {
+	if encodedInitData == "" {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Empty init data")
+	}
 
-		// 7. Store parsed init data in request context for handlers
-		c.Locals("telegram_init_data", &parsedData)
+	// 3. Decode from Base64
+	decodedInitData, err := base64.StdEncoding.DecodeString(encodedInitData)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid Base64 in init data: "+err.Error())
+	}
+	initDataRaw := string(decodedInitData)
 
-		fmt.Println("✅ Stored in context, calling next handler...")
+	// 4. Get bot token from environment
+	botToken := os.Getenv("TELEGRAM_BOT_TOKEN")
+	if botToken == "" {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Bot token not configured")
+	}
 
-		return c.Next()
+	// 5. Validate init data signature and expiration
+	// Telegram considers init data valid for 1 hour by default
+	expiration := 1 * time.Hour
+
+	fmt.Printf("🔍 Validating init data:\n")
+	// Only log first 100 chars for brevity
+	loggableInitData := initDataRaw
+	if len(loggableInitData) > 100 {
+		loggableInitData = loggableInitData[:100] + "..."
+	}
+	fmt.Printf("  Raw Decoded: %s\n", loggableInitData)
+	fmt.Printf("  Bot token: %s...\n", botToken[:min(20, len(botToken))])
+	fmt.Printf("  Expiration: %v\n", expiration)
+
+	if err := initdata.Validate(initDataRaw, botToken, expiration); err != nil {
+		fmt.Printf("❌ Validation failed: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired init data: "+err.Error())
+	}
+
+	fmt.Println("✅ Validation successful!")
+
+	// 6. Parse validated init data
+	parsedData, err := initdata.Parse(initDataRaw)
+	if err != nil {
+		fmt.Printf("❌ Parse failed: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Failed to parse init data: "+err.Error())
+	}
+
+	fmt.Printf("✅ Parsed init data: user_id=%d, username=%s\n", parsedData.User.ID, parsedData.User.Username)
+
+	return &parsedData, nil
+}
+
+func principalFromInitData(data *initdata.InitData) *Principal {
+	username := data.User.Username
+	if username == "" {
+		username = data.User.FirstName
+	}
+	return &Principal{
+		PlayerID:     strconv.FormatInt(data.User.ID, 10),
+		Username:     username,
+		LanguageCode: data.User.LanguageCode,
 	}
 }
 
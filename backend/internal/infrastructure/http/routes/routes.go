@@ -3,6 +3,8 @@ package routes
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
 	"math/rand"
 	"os"
@@ -11,23 +13,31 @@ import (
 
 	"github.com/gofiber/contrib/v3/websocket"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/static"
 
 	appQuiz "github.com/barsukov/quiz-sprint/backend/internal/application/quiz"
 	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
 	appMarathon "github.com/barsukov/quiz-sprint/backend/internal/application/marathon"
 	appDaily "github.com/barsukov/quiz-sprint/backend/internal/application/daily_challenge"
 	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
+	appParty "github.com/barsukov/quiz-sprint/backend/internal/application/party_mode"
+	appShop "github.com/barsukov/quiz-sprint/backend/internal/application/shop"
+	appAdReward "github.com/barsukov/quiz-sprint/backend/internal/application/ad_reward"
+	appSocial "github.com/barsukov/quiz-sprint/backend/internal/application/social"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	domainUser "github.com/barsukov/quiz-sprint/backend/internal/domain/user"
 	domainMarathon "github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
 	domainDaily "github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
 	domainDuel "github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	domainParty "github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/handlers"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/http/middleware"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/messaging"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/persistence/memory"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/persistence/postgres"
 	redisStore "github.com/barsukov/quiz-sprint/backend/internal/infrastructure/persistence/redis"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/realtime"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/scheduler"
 	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/telegram"
 
 	"github.com/gofiber/contrib/v3/swaggo"
@@ -104,73 +114,81 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	var (
 		duelGameRepo      domainDuel.DuelGameRepository
 		playerRatingRepo  domainDuel.PlayerRatingRepository
+		ratingHistoryRepo domainDuel.RatingHistoryRepository
 		challengeRepo     domainDuel.ChallengeRepository
 		referralRepo      domainDuel.ReferralRepository
 		seasonRepo        domainDuel.SeasonRepository
 		matchmakingQueue  domainDuel.MatchmakingQueue
-		duelRoundCache    appDuel.DuelRoundCache
 		duelOnlineTracker appDuel.OnlineTracker
 		lobbyHub          *handlers.DuelLobbyHub
+		duelCoordinator   realtime.Coordinator
 	)
 	if db != nil {
 		duelGameRepo = postgres.NewDuelGameRepository(db)
 		playerRatingRepo = postgres.NewPlayerRatingRepository(db)
+		ratingHistoryRepo = postgres.NewRatingHistoryRepository(db)
 		challengeRepo = postgres.NewChallengeRepository(db)
 		referralRepo = postgres.NewReferralRepository(db)
 		seasonRepo = postgres.NewSeasonRepository(db)
 
-		// Initialize Redis for matchmaking queue and round-answer cache
+		// Initialize Redis for matchmaking queue and online tracking
 		redisClient, err := redisStore.NewClient()
 		if err != nil {
 			log.Printf("⚠️ Redis not available, using in-memory fallbacks: %v", err)
-			duelRoundCache = appDuel.NewMemoryRoundCache()
 			lobbyHub = handlers.NewDuelLobbyHub(nil)
 		} else {
-			log.Println("✅ Connected to Redis for matchmaking queue and round-answer cache")
+			log.Println("✅ Connected to Redis for matchmaking queue and online tracking")
 			matchmakingQueue = redisStore.NewMatchmakingQueue(redisClient)
-			duelRoundCache = redisStore.NewDuelRoundCache(redisClient)
 			duelOnlineTracker = redisStore.NewOnlineTracker(redisClient)
 			lobbyHub = handlers.NewDuelLobbyHub(redisStore.NewLobbyEventBuffer(redisClient))
+			duelCoordinator = redisStore.NewDuelCoordinator(redisClient)
 		}
 	}
 
+	// Party Mode repositories: only available with PostgreSQL
+	var (
+		partyRoomRepo domainParty.RoomRepository
+		partyGameRepo domainParty.GameRepository
+	)
+	if db != nil {
+		partyRoomRepo = postgres.NewPartyRoomRepository(db)
+		partyGameRepo = postgres.NewPartyGameRepository(db)
+	}
+
+	// ========================================
+	// Infrastructure Layer: Background Jobs
+	// ========================================
+
+	// With PostgreSQL, every run takes an advisory lock so only one API replica runs a job
+	var jobScheduler *scheduler.Scheduler
+	if db != nil {
+		jobScheduler = scheduler.New(postgres.NewSchedulerLocker(db), postgres.NewJobRunRepository(db))
+	} else {
+		jobScheduler = scheduler.New(scheduler.NewLocalLocker(), scheduler.NewMemoryRunStore())
+	}
+
 	// ========================================
 	// Infrastructure Layer: Event Bus
 	// ========================================
-	eventBus := messaging.NewInMemoryEventBus()
-	loggingEventBus := messaging.NewLoggingEventBus(eventBus)
-
-	// Marathon event bus (separate from quiz events)
-	marathonEventBus := messaging.NewMarathonEventBus(true) // Enable logging
-
-	// Daily Challenge event bus
-	dailyChallengeEventBus := messaging.NewDailyChallengeEventBus(true) // Enable logging
-
-	// Subscribe to ChestEarnedEvent: credit marathon bonuses to player's wallet
-	if bonusWalletRepo != nil {
-		dailyChallengeEventBus.Subscribe("chest_earned", func(event domainDaily.Event) {
-			chestEvent, ok := event.(domainDaily.ChestEarnedEvent)
-			if !ok {
-				return
-			}
-
-			playerID := chestEvent.PlayerID()
-			wallet, err := bonusWalletRepo.FindByPlayer(playerID)
-			if err != nil || wallet == nil {
-				wallet = domainMarathon.NewBonusWallet(playerID)
-			}
-
-			for _, bonus := range chestEvent.MarathonBonuses() {
-				wallet.AddBonus(domainMarathon.BonusType(string(bonus)))
-			}
-
-			if err := bonusWalletRepo.Save(wallet); err != nil {
-				log.Printf("[DAILY→MARATHON] Failed to credit bonuses: %v", err)
-			} else {
-				log.Printf("[DAILY→MARATHON] Credited %d bonuses to player %s",
-					len(chestEvent.MarathonBonuses()), playerID.String())
-			}
-		})
+	// One bus shared by every bounded context; each context publishes through its own port.
+	// Reactions to events (including cross-context ones) are subscribed in the
+	// Event Subscriptions section below.
+	eventBus := messaging.NewBus()
+	quizEventBus := messaging.NewQuizEventBus(eventBus)
+	marathonEventBus := messaging.NewPublisher[domainMarathon.Event](eventBus)
+	dailyChallengeEventBus := messaging.NewPublisher[domainDaily.Event](eventBus)
+	duelEventBus := messaging.NewPublisher[domainDuel.Event](eventBus)
+	partyEventBus := messaging.NewPublisher[domainParty.Event](eventBus)
+
+	// Transactional outbox (only with PostgreSQL): events saved with their aggregate
+	// are delivered at-least-once to the durable subscribers registered on the relay
+	var (
+		outboxRepo  *postgres.OutboxRepository
+		outboxRelay *messaging.OutboxRelay
+	)
+	if db != nil {
+		outboxRepo = postgres.NewOutboxRepository(db)
+		outboxRelay = messaging.NewOutboxRelay(outboxRepo)
 	}
 
 	// ========================================
@@ -178,11 +196,26 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	// ========================================
 	wsHub := handlers.NewWebSocketHub(leaderboardRepo)
 
+	// Party hub exists only with the party repositories; its use cases are set below
+	var partyWsHub *handlers.PartyWebSocketHub
+	if partyRoomRepo != nil && partyGameRepo != nil && questionRepo != nil {
+		partyWsHub = handlers.NewPartyWebSocketHub()
+	}
+
 	// ========================================
-	// Infrastructure Layer: Event Handlers
+	// Infrastructure Layer: Event Subscriptions
 	// ========================================
-	// Register QuizCompletedEvent handler to broadcast leaderboard updates
-	loggingEventBus.Subscribe("quiz.completed", func(event quiz.Event) {
+	// Every reaction to a domain event is wired here
+
+	// Event logging
+	messaging.SubscribeQuizEventLogging(eventBus)
+	messaging.SubscribeMarathonEventLogging(eventBus)
+	messaging.SubscribeDailyChallengeEventLogging(eventBus)
+
+	// QuizCompletedEvent: broadcast leaderboard updates.
+	// With PostgreSQL the event comes through the outbox, so a crash after the save
+	// still refreshes the leaderboards.
+	broadcastLeaderboards := func(event quiz.Event) {
 		completedEvent, ok := event.(quiz.QuizCompletedEvent)
 		if !ok {
 			return
@@ -193,7 +226,84 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 
 		// Broadcast to global leaderboard WebSocket
 		wsHub.BroadcastGlobalLeaderboardUpdate()
-	})
+	}
+	if outboxRelay != nil {
+		outboxRelay.Subscribe("quiz.completed", "quiz.leaderboard_broadcast", messaging.QuizOutboxHandler(
+			func(_ *sql.Tx, event quiz.Event) error {
+				broadcastLeaderboards(event)
+				return nil
+			},
+		))
+	} else {
+		quizEventBus.Subscribe("quiz.completed", broadcastLeaderboards)
+	}
+
+	// Duel and party events: push to connected players
+	if lobbyHub != nil {
+		messaging.SubscribeLobbyNotifications(eventBus, lobbyHub)
+	}
+	if partyWsHub != nil {
+		messaging.SubscribePartyBroadcasts(eventBus, partyWsHub, partyGameRepo)
+	}
+
+	// Durable DuelGameFinishedEvent subscriber: advance referral milestones of invited players.
+	// The use case recomputes progress from stored games, so a redelivery changes nothing.
+	if outboxRelay != nil && referralRepo != nil && duelGameRepo != nil && playerRatingRepo != nil {
+		updateReferralProgressUC := appDuel.NewUpdateReferralProgressUseCase(referralRepo, duelGameRepo, playerRatingRepo, duelEventBus)
+		outboxRelay.Subscribe("duel_game_finished", "quick_duel.referral_progress", messaging.QuickDuelOutboxHandler(
+			func(_ *sql.Tx, event domainDuel.Event) error {
+				finishedEvent, ok := event.(domainDuel.DuelGameFinishedEvent)
+				if !ok {
+					return nil
+				}
+
+				var errs []error
+				for _, player := range []domainDuel.DuelPlayer{finishedEvent.Player1(), finishedEvent.Player2()} {
+					if err := updateReferralProgressUC.Execute(appDuel.UpdateReferralProgressInput{
+						PlayerID: player.UserID().String(),
+					}); err != nil {
+						errs = append(errs, err)
+					}
+				}
+				return errors.Join(errs...)
+			},
+		))
+	}
+
+	// Durable ChestEarnedEvent subscriber: credit marathon bonuses to player's wallet.
+	// The credit commits with the consumption record, so a redelivery never credits twice.
+	if outboxRelay != nil && bonusWalletRepo != nil {
+		walletRepo := postgres.NewBonusWalletRepository(db)
+		outboxRelay.Subscribe("chest_earned", "marathon.bonus_wallet", messaging.DailyChallengeOutboxHandler(
+			func(tx *sql.Tx, event domainDaily.Event) error {
+				chestEvent, ok := event.(domainDaily.ChestEarnedEvent)
+				if !ok {
+					return nil
+				}
+
+				playerID := chestEvent.PlayerID()
+				wallet, err := walletRepo.FindByPlayerForUpdate(tx, playerID)
+				if err != nil {
+					return err
+				}
+				if wallet == nil {
+					wallet = domainMarathon.NewBonusWallet(playerID)
+				}
+
+				for _, bonus := range chestEvent.MarathonBonuses() {
+					wallet.AddBonus(domainMarathon.BonusType(string(bonus)))
+				}
+
+				if err := walletRepo.SaveInTx(tx, wallet); err != nil {
+					log.Printf("[DAILY→MARATHON] Failed to credit bonuses: %v", err)
+					return err
+				}
+				log.Printf("[DAILY→MARATHON] Credited %d bonuses to player %s",
+					len(chestEvent.MarathonBonuses()), playerID.String())
+				return nil
+			},
+		))
+	}
 
 	// ========================================
 	// Application Layer: Use Cases
@@ -203,8 +313,11 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	listQuizzesUC := appQuiz.NewListQuizzesUseCase(quizRepo)
 	getQuizUC := appQuiz.NewGetQuizUseCase(quizRepo)
 	getQuizDetailsUC := appQuiz.NewGetQuizDetailsUseCase(quizRepo, leaderboardRepo)
-	startQuizUC := appQuiz.NewStartQuizUseCase(quizRepo, sessionRepo, loggingEventBus)
-	submitAnswerUC := appQuiz.NewSubmitAnswerUseCase(quizRepo, sessionRepo, loggingEventBus)
+	startQuizUC := appQuiz.NewStartQuizUseCase(quizRepo, sessionRepo, quizEventBus)
+	submitAnswerUC := appQuiz.NewSubmitAnswerUseCase(quizRepo, sessionRepo, quizEventBus)
+	if outboxRepo != nil {
+		submitAnswerUC.WithOutbox(postgres.NewTxManager(db), messaging.NewQuizOutbox(outboxRepo))
+	}
 	getLeaderboardUC := appQuiz.NewGetLeaderboardUseCase(leaderboardRepo)
 	getGlobalLeaderboardUC := appQuiz.NewGetGlobalLeaderboardUseCase(leaderboardRepo)
 	getActiveSessionUC := appQuiz.NewGetActiveSessionUseCase(quizRepo, sessionRepo)
@@ -233,13 +346,41 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		listUsersUC           *appUser.ListUsersUseCase
 		getUserByUsernameUC   *appUser.GetUserByTelegramUsernameUseCase
 		getUserProfileStatsUC *appUser.GetUserProfileStatsUseCase
+		createSessionUC       *appUser.CreateSessionUseCase
+		refreshSessionUC      *appUser.RefreshSessionUseCase
+	)
+
+	var (
+		inventoryService        appUser.InventoryService
+		getTransactionHistoryUC *appUser.GetTransactionHistoryUseCase
+		reconcileInventoryUC    *appUser.ReconcileInventoryUseCase
 	)
 
-	var inventoryService appUser.InventoryService
+	// Premium: paid with Telegram Stars (invoices and payment updates need the bot token)
+	var (
+		premiumService            appUser.PremiumService = &appUser.NoopPremiumService{}
+		getPremiumStatusUC        *appUser.GetPremiumStatusUseCase
+		createPremiumInvoiceUC    *appUser.CreatePremiumInvoiceUseCase
+		handlePreCheckoutUC       *appUser.HandlePreCheckoutUseCase
+		handleSuccessfulPaymentUC *appUser.HandleSuccessfulPaymentUseCase
+	)
 	if userRepo != nil {
 		inventoryRepo := postgres.NewInventoryRepository(db)
-		txRepo := postgres.NewTransactionRepository(db)
-		inventoryService = appUser.NewInventoryService(inventoryRepo, txRepo)
+		inventoryLedger := postgres.NewInventoryLedger(db)
+		inventoryService = appUser.NewInventoryService(inventoryRepo, inventoryLedger)
+		reconcileInventoryUC = appUser.NewReconcileInventoryUseCase(inventoryLedger)
+		getTransactionHistoryUC = appUser.NewGetTransactionHistoryUseCase(postgres.NewTransactionRepository(db))
+
+		premiumRepo := postgres.NewPremiumRepository(db)
+		premiumService = appUser.NewPremiumService(premiumRepo)
+		getPremiumStatusUC = appUser.NewGetPremiumStatusUseCase(premiumRepo)
+		if token := os.Getenv("TELEGRAM_BOT_TOKEN"); token != "" {
+			// TELEGRAM_BOT_API_URL points at a local Bot API server (or a fake one); empty for api.telegram.org
+			paymentsClient := telegram.NewPaymentsClient(token, os.Getenv("TELEGRAM_BOT_API_URL"))
+			createPremiumInvoiceUC = appUser.NewCreatePremiumInvoiceUseCase(paymentsClient)
+			handlePreCheckoutUC = appUser.NewHandlePreCheckoutUseCase(paymentsClient)
+			handleSuccessfulPaymentUC = appUser.NewHandleSuccessfulPaymentUseCase(premiumRepo, postgres.NewTxManager(db))
+		}
 
 		registerUserUC = appUser.NewRegisterUserUseCase(userRepo, inventoryService)
 		getUserUC = appUser.NewGetUserUseCase(userRepo)
@@ -249,6 +390,84 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		getUserByUsernameUC = appUser.NewGetUserByTelegramUsernameUseCase(userRepo)
 		profileStatsRepo := postgres.NewProfileStatsRepository(db)
 		getUserProfileStatsUC = appUser.NewGetUserProfileStatsUseCase(profileStatsRepo)
+
+		// Session tokens: exchanged for init data, accepted as "Bearer" by the auth middleware
+		if secret := os.Getenv("SESSION_TOKEN_SECRET"); secret != "" {
+			signer := appUser.NewSessionTokenSigner(secret)
+			createSessionUC = appUser.NewCreateSessionUseCase(userRepo, signer)
+			refreshSessionUC = appUser.NewRefreshSessionUseCase(userRepo, signer)
+			verifySessionUC := appUser.NewVerifySessionUseCase(userRepo, signer)
+			middleware.SetSessionVerifier(func(accessToken string) (*middleware.Principal, error) {
+				output, err := verifySessionUC.Execute(accessToken)
+				if err == domainUser.ErrUserBlocked {
+					return nil, fiber.NewError(fiber.StatusForbidden, "User is blocked")
+				}
+				if err != nil {
+					return nil, err
+				}
+				return &middleware.Principal{
+					PlayerID:     output.UserID,
+					Username:     output.Username,
+					LanguageCode: output.LanguageCode,
+				}, nil
+			})
+		} else {
+			log.Println("⚠️ SESSION_TOKEN_SECRET not set, session tokens disabled (Telegram init data only)")
+		}
+	}
+
+	// Shop use cases (only if database is available)
+	var (
+		getShopUC      *appShop.GetShopUseCase
+		purchaseItemUC *appShop.PurchaseItemUseCase
+	)
+	if inventoryService != nil {
+		shopCatalogRepo := postgres.NewShopCatalogRepository(db)
+		shopPurchaseRepo := postgres.NewShopPurchaseRepository(db)
+		getShopUC = appShop.NewGetShopUseCase(shopCatalogRepo, shopPurchaseRepo, inventoryService)
+		purchaseItemUC = appShop.NewPurchaseItemUseCase(shopCatalogRepo, shopPurchaseRepo, inventoryService, postgres.NewTxManager(db))
+	}
+
+	// Ad reward use cases (only if database is available)
+	var (
+		adRewardVerifier *appAdReward.Verifier
+		issueAdRewardUC  *appAdReward.IssueAdRewardUseCase
+		redeemAdRewardUC *appAdReward.RedeemAdRewardUseCase
+	)
+	if userRepo != nil {
+		adRewardRepo := postgres.NewAdRewardRepository(db)
+		adRewardVerifier = appAdReward.NewVerifier(adRewardRepo)
+		issueAdRewardUC = appAdReward.NewIssueAdRewardUseCase(adRewardRepo)
+		// Shared with the ad network, which signs its reward callbacks with it
+		if secret := os.Getenv("AD_CALLBACK_SECRET"); secret != "" {
+			redeemAdRewardUC = appAdReward.NewRedeemAdRewardUseCase(adRewardRepo, appAdReward.NewCallbackSigner(secret))
+		}
+	}
+
+	// Social (friends) use cases (only if database is available)
+	var (
+		friendService          *appSocial.FriendService
+		getFriendsUC           *appSocial.GetFriendsUseCase
+		sendFriendRequestUC    *appSocial.SendFriendRequestUseCase
+		respondFriendRequestUC *appSocial.RespondFriendRequestUseCase
+		removeFriendUC         *appSocial.RemoveFriendUseCase
+		blockPlayerUC          *appSocial.BlockPlayerUseCase
+		unblockPlayerUC        *appSocial.UnblockPlayerUseCase
+		getBlockedPlayersUC    *appSocial.GetBlockedPlayersUseCase
+		getFriendSuggestionsUC *appSocial.GetFriendSuggestionsUseCase
+	)
+	if userRepo != nil {
+		friendshipRepo := postgres.NewFriendshipRepository(db)
+		blockRepo := postgres.NewBlockRepository(db)
+		friendService = appSocial.NewFriendService(friendshipRepo)
+		getFriendsUC = appSocial.NewGetFriendsUseCase(friendshipRepo, userRepo)
+		sendFriendRequestUC = appSocial.NewSendFriendRequestUseCase(friendshipRepo, blockRepo, userRepo)
+		respondFriendRequestUC = appSocial.NewRespondFriendRequestUseCase(friendshipRepo, userRepo)
+		removeFriendUC = appSocial.NewRemoveFriendUseCase(friendshipRepo)
+		blockPlayerUC = appSocial.NewBlockPlayerUseCase(blockRepo, userRepo)
+		unblockPlayerUC = appSocial.NewUnblockPlayerUseCase(blockRepo)
+		getBlockedPlayersUC = appSocial.NewGetBlockedPlayersUseCase(blockRepo, userRepo)
+		getFriendSuggestionsUC = appSocial.NewGetFriendSuggestionsUseCase(duelGameRepo, friendshipRepo, blockRepo, userRepo)
 	}
 
 	// Marathon use cases (only if database is available)
@@ -266,6 +485,9 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	)
 
 	if marathonRepo != nil && personalBestRepo != nil && questionRepo != nil && categoryRepo != nil && userRepo != nil {
+		marathonTxManager := postgres.NewTxManager(db)
+		marathonOutbox := messaging.NewMarathonOutbox(outboxRepo)
+
 		startMarathonUC = appMarathon.NewStartMarathonUseCase(
 			marathonRepo,
 			personalBestRepo,
@@ -273,7 +495,7 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			categoryRepo,
 			marathonEventBus,
 			bonusWalletRepo,
-		)
+		).WithOutbox(marathonTxManager, marathonOutbox)
 		submitMarathonAnswerUC = appMarathon.NewSubmitMarathonAnswerUseCase(
 			marathonRepo,
 			personalBestRepo,
@@ -291,19 +513,19 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			questionRepo,
 			marathonEventBus,
 			inventoryService,
-		)
+		).WithAdVerification(adRewardVerifier)
 		abandonMarathonUC = appMarathon.NewAbandonMarathonUseCase(
 			marathonRepo,
 			personalBestRepo,
 			marathonEventBus,
-		)
+		).WithOutbox(marathonTxManager, marathonOutbox)
 		milestoneClaimsRepo := postgres.NewMilestoneClaimsRepository(db)
 		completeMarathonUC = appMarathon.NewCompleteMarathonUseCase(
 			marathonRepo,
 			personalBestRepo,
 			marathonEventBus,
 			inventoryService,
-		).WithMilestoneClaimsRepository(milestoneClaimsRepo)
+		).WithMilestoneClaimsRepository(milestoneClaimsRepo).WithOutbox(marathonTxManager, marathonOutbox)
 		getMarathonStatusUC = appMarathon.NewGetMarathonStatusUseCase(
 			marathonRepo,
 			bonusWalletRepo,
@@ -342,6 +564,10 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 		chestRewardCalc := domainDaily.NewChestRewardCalculator(rng)
 
+		// Games and their events are saved in one transaction through the outbox
+		dailyTxManager := postgres.NewTxManager(db)
+		dailyOutbox := messaging.NewDailyChallengeOutbox(outboxRepo)
+
 		getOrCreateDailyQuizUC = appDaily.NewGetOrCreateDailyQuizUseCase(
 			dailyQuizRepo,
 			dailyGameRepo,
@@ -355,7 +581,7 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			quizRepo,
 			dailyChallengeEventBus,
 			getOrCreateDailyQuizUC,
-		)
+		).WithOutbox(dailyTxManager, dailyOutbox)
 		getDailyLeaderboardUC = appDaily.NewGetDailyLeaderboardUseCase(
 			dailyGameRepo,
 			userRepo,
@@ -365,7 +591,7 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			dailyChallengeEventBus,
 			getDailyLeaderboardUC,
 			chestRewardCalc,
-		)
+		).WithOutbox(dailyTxManager, dailyOutbox)
 		getDailyGameStatusUC = appDaily.NewGetDailyGameStatusUseCase(
 			dailyQuizRepo,
 			dailyGameRepo,
@@ -377,15 +603,15 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		openChestUC = appDaily.NewOpenChestUseCase(
 			dailyGameRepo,
 			inventoryService,
-		).WithPremiumService(&appUser.NoopPremiumService{})
+		).WithPremiumService(premiumService)
 		retryUC = appDaily.NewRetryChallengeUseCase(
 			dailyGameRepo,
 			dailyQuizRepo,
 			questionRepo,
 			dailyChallengeEventBus,
 			inventoryService,
-			&appDaily.NoopAdVerificationService{},
-		).WithPremiumService(&appUser.NoopPremiumService{})
+			adRewardVerifier,
+		).WithPremiumService(premiumService).WithOutbox(dailyTxManager, dailyOutbox)
 	}
 
 	// Duel (PvP) use cases (only if database is available)
@@ -403,17 +629,21 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		requestRematchUC       *appDuel.RequestRematchUseCase
 		startGameUC            *appDuel.StartGameUseCase
 		submitDuelAnswerUC     *appDuel.SubmitDuelAnswerUseCase
+		botPlayerUC            *appDuel.BotPlayerUseCase
 		getGameResultUC        *appDuel.GetGameResultUseCase
+		getGameReplayUC        *appDuel.GetGameReplayUseCase
 		getRivalsUC            *appDuel.GetRivalsUseCase
 		prepareShareUC         *appDuel.PrepareShareUseCase
 		getReferralsUC         *appDuel.GetReferralsUseCase
 		claimReferralRewardUC  *appDuel.ClaimReferralRewardUseCase
 		surrenderGameUC        *appDuel.SurrenderGameUseCase
+		getOnlineFriendsUC     *appDuel.GetOnlineFriendsUseCase
+		getRatingHistoryUC     *appDuel.GetRatingHistoryUseCase
 	)
 
 	if duelGameRepo != nil && playerRatingRepo != nil && challengeRepo != nil && referralRepo != nil && seasonRepo != nil && userRepo != nil {
 		txManager := postgres.NewTxManager(db)
-		duelEventBus := messaging.NewLobbyEventBus(lobbyHub)
+		duelOutbox := messaging.NewQuickDuelOutbox(outboxRepo)
 
 		var telegramNotifier appDuel.TelegramNotifier = telegram.NewNoOpNotifier()
 		if token := os.Getenv("TELEGRAM_BOT_TOKEN"); token != "" {
@@ -431,6 +661,9 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			duelOnlineTracker, // marks player online on each status poll
 			inventoryService,
 		)
+		if friendService != nil {
+			getDuelStatusUC.WithFriends(friendService)
+		}
 		if matchmakingQueue != nil {
 			joinQueueUC = appDuel.NewJoinQueueUseCase(
 				matchmakingQueue,
@@ -504,9 +737,9 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 				duelQuestionRepo,
 				seasonRepo,
 				duelEventBus,
-				duelRoundCache,
 				inventoryService,
-			)
+			).WithRatingHistory(ratingHistoryRepo).WithOutbox(txManager, duelOutbox)
+			botPlayerUC = appDuel.NewBotPlayerUseCase(duelGameRepo, duelQuestionRepo, nil)
 			requestRematchUC = appDuel.NewRequestRematchUseCase(
 				duelGameRepo,
 				challengeRepo,
@@ -516,6 +749,11 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 				userRepo,
 				duelEventBus,
 			)
+			getGameReplayUC = appDuel.NewGetGameReplayUseCase(
+				duelGameRepo,
+				duelQuestionRepo,
+				userRepo,
+			)
 		}
 		createChallengeLinkUC = appDuel.NewCreateChallengeLinkUseCase(
 			challengeRepo,
@@ -525,6 +763,11 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		getGameHistoryUC = appDuel.NewGetGameHistoryUseCase(
 			duelGameRepo,
 			userRepo,
+		).WithRatingHistory(ratingHistoryRepo)
+		getRatingHistoryUC = appDuel.NewGetRatingHistoryUseCase(
+			ratingHistoryRepo,
+			playerRatingRepo,
+			seasonRepo,
 		)
 		getDuelLeaderboardUC = appDuel.NewGetLeaderboardUseCase(
 			playerRatingRepo,
@@ -532,12 +775,18 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			seasonRepo,
 			userRepo,
 		)
+		if friendService != nil {
+			getDuelLeaderboardUC.WithFriends(friendService)
+		}
+		if duelOnlineTracker != nil && friendService != nil {
+			getOnlineFriendsUC = appDuel.NewGetOnlineFriendsUseCase(duelOnlineTracker, userRepo, friendService)
+		}
 		getGameResultUC = appDuel.NewGetGameResultUseCase(
 			duelGameRepo,
 			playerRatingRepo,
 			seasonRepo,
 			userRepo,
-		)
+		).WithRatingHistory(ratingHistoryRepo)
 		getRivalsUC = appDuel.NewGetRivalsUseCase(
 			duelGameRepo,
 			playerRatingRepo,
@@ -560,9 +809,9 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			playerRatingRepo,
 			seasonRepo,
 			duelEventBus,
-		)
+		).WithRatingHistory(ratingHistoryRepo).WithOutbox(txManager, duelOutbox)
 
-		// Start bot fallback scheduler (pairs long-waiting queue players with a bot)
+		// Bot fallback job (pairs long-waiting queue players with a bot)
 		if matchmakingQueue != nil && startGameUC != nil {
 			duelQuestionRepoForBot := postgres.NewDuelQuestionRepositoryAdapter(questionRepo)
 			botFallbackUC := appDuel.NewBotFallbackUseCase(
@@ -571,117 +820,147 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 				playerRatingRepo,
 				duelQuestionRepoForBot,
 				seasonRepo,
-				messaging.NewLobbyEventBus(lobbyHub),
+				duelEventBus,
 			)
-			go func() {
-				ticker := time.NewTicker(5 * time.Second)
-				defer ticker.Stop()
-				for range ticker.C {
-					botFallbackUC.Execute()
-				}
-			}()
+			jobScheduler.Register(scheduler.Job{
+				Name:        "duel.bot_fallback",
+				Description: "Pair players waiting too long in the matchmaking queue with a bot",
+				Schedule:    scheduler.Every(5 * time.Second),
+				// Every 5s: record only the runs that paired someone
+				SkipIdleRuns: true,
+				Run: func(ctx context.Context) (string, error) {
+					if paired := botFallbackUC.Execute(); paired > 0 {
+						return fmt.Sprintf("paired %d players with a bot", paired), nil
+					}
+					return "", nil
+				},
+			})
 		}
 
-		// Start background challenge cleanup scheduler
-		go func() {
-			minuteTicker := time.NewTicker(1 * time.Minute)
-			hourTicker := time.NewTicker(1 * time.Hour)
-			defer minuteTicker.Stop()
-			defer hourTicker.Stop()
-
-			for {
-				select {
-				case <-minuteTicker.C:
-					now := time.Now().UTC()
-					nowUnix := now.Unix()
-
-					// Edit Telegram messages for expiring challenges
-					expiring, err := challengeRepo.FindPendingExpiredWithMessageID(nowUnix)
-					if err == nil {
-						for _, c := range expiring {
-							if c.TelegramMessageID() > 0 && c.ChallengedID() != nil {
-								challengedUser, err := userRepo.FindByID(*c.ChallengedID())
-								if err != nil {
-									continue
-								}
-								if tgID, err := strconv.ParseInt(challengedUser.ID().String(), 10, 64); err == nil && tgID > 0 {
-									_ = telegramNotifier.EditChallengeMessage(context.Background(), tgID, c.TelegramMessageID(), "⏰ Время истекло")
-								}
+		// Challenge expiry and stale game cleanup jobs
+		jobScheduler.Register(scheduler.Job{
+			Name:        "duel.expire_challenges",
+			Description: "Expire overdue challenges and abandon stale duel games",
+			Schedule:    scheduler.Every(time.Minute),
+			Run: func(ctx context.Context) (string, error) {
+				now := time.Now().UTC()
+				nowUnix := now.Unix()
+
+				// Edit Telegram messages for expiring challenges
+				expiring, err := challengeRepo.FindPendingExpiredWithMessageID(nowUnix)
+				if err == nil {
+					for _, c := range expiring {
+						if c.TelegramMessageID() > 0 && c.ChallengedID() != nil {
+							challengedUser, err := userRepo.FindByID(*c.ChallengedID())
+							if err != nil {
+								continue
+							}
+							if tgID, err := strconv.ParseInt(challengedUser.ID().String(), 10, 64); err == nil && tgID > 0 {
+								_ = telegramNotifier.EditChallengeMessage(ctx, tgID, c.TelegramMessageID(), challengedUser.LanguageCode().String(), appDuel.ChallengeMessageExpired)
 							}
 						}
 					}
+				}
 
-					// Expire pending challenges via domain (publishes events)
-					if expired, err := challengeRepo.FindPendingExpired(nowUnix); err == nil {
-						for _, c := range expired {
-							if c.Expire(nowUnix) == nil {
-								_ = challengeRepo.Save(c)
-								for _, event := range c.Events() {
-									duelEventBus.Publish(event)
-								}
+				// Expire pending challenges via domain (publishes events)
+				if expired, err := challengeRepo.FindPendingExpired(nowUnix); err == nil {
+					for _, c := range expired {
+						if c.Expire(nowUnix) == nil {
+							_ = challengeRepo.Save(c)
+							for _, event := range c.Events() {
+								duelEventBus.Publish(event)
 							}
 						}
 					}
+				}
 
-					// Expire waiting challenges and notify invitee
-					if waitingExpired, err := challengeRepo.FindWaitingExpired(nowUnix); err == nil {
-						for _, c := range waitingExpired {
-							if c.ExpireWaiting(nowUnix) == nil {
-								_ = challengeRepo.Save(c)
-								for _, event := range c.Events() {
-									duelEventBus.Publish(event)
-								}
+				// Expire waiting challenges and notify invitee
+				if waitingExpired, err := challengeRepo.FindWaitingExpired(nowUnix); err == nil {
+					for _, c := range waitingExpired {
+						if c.ExpireWaiting(nowUnix) == nil {
+							_ = challengeRepo.Save(c)
+							for _, event := range c.Events() {
+								duelEventBus.Publish(event)
 							}
 						}
 					}
+				}
 
-					// Safety net: bulk-expire any remaining stale challenges
-					_ = challengeRepo.DeleteExpired(nowUnix)
-
-					// Auto-abandon stale games (older than 10 minutes)
-					cutoff := nowUnix - 600
-					if count, err := duelGameRepo.AbandonStaleGames(cutoff); err == nil && count > 0 {
-						log.Printf("[Cleanup] Abandoned %d stale games", count)
-					}
+				// Safety net: bulk-expire any remaining stale challenges
+				_ = challengeRepo.DeleteExpired(nowUnix)
 
-				case <-hourTicker.C:
-					oneDayAgo := time.Now().UTC().Unix() - 86400
-					_ = challengeRepo.DeleteHardExpired(oneDayAgo)
+				// Auto-abandon stale games (older than 10 minutes)
+				cutoff := nowUnix - 600
+				count, err := duelGameRepo.AbandonStaleGames(cutoff)
+				if err != nil {
+					return "", err
+				}
+				if count > 0 {
+					log.Printf("[Cleanup] Abandoned %d stale games", count)
 				}
-			}
-		}()
+				return fmt.Sprintf("abandoned %d stale games", count), nil
+			},
+		})
+		jobScheduler.Register(scheduler.Job{
+			Name:        "duel.purge_expired_challenges",
+			Description: "Delete challenges expired for more than a day",
+			Schedule:    scheduler.MustParseCron("0 * * * *"),
+			Run: func(ctx context.Context) (string, error) {
+				oneDayAgo := time.Now().UTC().Unix() - 86400
+				return "", challengeRepo.DeleteHardExpired(oneDayAgo)
+			},
+		})
+	}
+
+	// Party Mode use cases (only if database is available)
+	var (
+		createPartyRoomUC   *appParty.CreateRoomUseCase
+		joinPartyRoomUC     *appParty.JoinRoomUseCase
+		getPartyRoomUC      *appParty.GetRoomUseCase
+		setPartyReadyUC     *appParty.SetReadyUseCase
+		startPartyGameUC    *appParty.StartPartyGameUseCase
+		leavePartyUC        *appParty.LeavePartyUseCase
+		submitPartyAnswerUC *appParty.SubmitPartyAnswerUseCase
+	)
+	if partyWsHub != nil {
+		createPartyRoomUC = appParty.NewCreateRoomUseCase(partyRoomRepo, partyEventBus)
+		partyTxManager := postgres.NewTxManager(db)
+		joinPartyRoomUC = appParty.NewJoinRoomUseCase(partyRoomRepo, partyTxManager, partyEventBus)
+		getPartyRoomUC = appParty.NewGetRoomUseCase(partyRoomRepo, partyGameRepo)
+		setPartyReadyUC = appParty.NewSetReadyUseCase(partyRoomRepo, partyTxManager, partyEventBus)
+		startPartyGameUC = appParty.NewStartPartyGameUseCase(partyRoomRepo, partyGameRepo, questionRepo, partyTxManager, partyEventBus)
+		leavePartyUC = appParty.NewLeavePartyUseCase(partyRoomRepo, partyGameRepo, partyTxManager, partyEventBus)
+		submitPartyAnswerUC = appParty.NewSubmitPartyAnswerUseCase(partyRoomRepo, partyGameRepo, questionRepo, partyTxManager, partyEventBus)
+
+		partyWsHub.SetUseCases(
+			getPartyRoomUC,
+			appParty.NewGetPartyQuestionUseCase(partyRoomRepo, partyGameRepo, questionRepo),
+			submitPartyAnswerUC,
+		)
 	}
 
 	// ========================================
 	// Background: Weekly Marathon Reward Distribution (Monday 00:01 UTC)
 	// ========================================
 	if distributeWeeklyMarathonRewardsUC != nil {
-		go func() {
-			for {
-				now := time.Now().UTC()
-				weekday := int(now.Weekday())
-				if weekday == 0 {
-					weekday = 7
-				}
-				daysUntilMonday := (8 - weekday) % 7
-				if daysUntilMonday == 0 {
-					daysUntilMonday = 7
-				}
-				nextMonday := time.Date(now.Year(), now.Month(), now.Day()+daysUntilMonday, 0, 1, 0, 0, time.UTC)
-				sleepDur := time.Until(nextMonday)
-				log.Printf("[Marathon Cron] Next weekly reward distribution in %s (at %s)", sleepDur.Round(time.Minute), nextMonday.Format(time.RFC3339))
-
-				<-time.After(sleepDur)
+		jobScheduler.Register(scheduler.Job{
+			Name:        "marathon.weekly_rewards",
+			Description: "Distribute weekly marathon leaderboard rewards",
+			Schedule:    scheduler.MustParseCron("1 0 * * 1"),
+			Run: func(ctx context.Context) (string, error) {
 				out, err := distributeWeeklyMarathonRewardsUC.Execute(appMarathon.DistributeWeeklyMarathonRewardsInput{})
 				if err != nil {
 					log.Printf("[Marathon Cron] Weekly reward distribution failed: %v", err)
-				} else if out.Skipped {
+					return "", err
+				}
+				if out.Skipped {
 					log.Printf("[Marathon Cron] Week %s already distributed — skipped", out.WeekID)
-				} else {
-					log.Printf("[Marathon Cron] Week %s: distributed to %d players", out.WeekID, out.Distributed)
+					return fmt.Sprintf("week %s already distributed", out.WeekID), nil
 				}
-			}
-		}()
+				log.Printf("[Marathon Cron] Week %s: distributed to %d players", out.WeekID, out.Distributed)
+				return fmt.Sprintf("week %s: distributed to %d players", out.WeekID, out.Distributed), nil
+			},
+		})
 	}
 
 	// ========================================
@@ -689,22 +968,24 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	// ========================================
 	if dailyGameRepo != nil {
 		cleanupAbandonedGamesUC := appDaily.NewCleanupAbandonedGamesUseCase(dailyGameRepo)
-		go func() {
-			for {
-				now := time.Now().UTC()
-				next := time.Date(now.Year(), now.Month(), now.Day()+1, 1, 0, 0, 0, time.UTC)
-				<-time.After(time.Until(next))
-				if count, err := cleanupAbandonedGamesUC.Execute(context.Background()); err != nil {
+		jobScheduler.Register(scheduler.Job{
+			Name:        "daily.cleanup_abandoned_games",
+			Description: "Mark unfinished daily challenge games of past days as abandoned",
+			Schedule:    scheduler.MustParseCron("0 1 * * *"),
+			Run: func(ctx context.Context) (string, error) {
+				count, err := cleanupAbandonedGamesUC.Execute(ctx)
+				if err != nil {
 					log.Printf("[Daily Cron] Cleanup abandoned games failed: %v", err)
-				} else {
-					log.Printf("[Daily Cron] Marked %d abandoned games", count)
+					return "", err
 				}
-			}
-		}()
+				log.Printf("[Daily Cron] Marked %d abandoned games", count)
+				return fmt.Sprintf("marked %d abandoned games", count), nil
+			},
+		})
 	}
 
 	// ========================================
-	// Background: End-of-Season Reward Distribution (last Sunday 23:59 UTC)
+	// Background: End-of-Season Reward Distribution (last day of month 23:59 UTC)
 	// ========================================
 	if playerRatingRepo != nil && seasonRepo != nil {
 		seasonalResetUC := appDuel.NewSeasonalResetUseCase(playerRatingRepo, seasonRepo)
@@ -714,32 +995,44 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			inventoryService,
 			seasonalResetUC,
 		)
-		go func() {
-			for {
-				now := time.Now().UTC()
-				// Last day of current month 23:59:00 UTC
-				firstOfNextMonth := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
-				lastDayEnd := firstOfNextMonth.Add(-time.Minute) // 23:59 of last day
-				sleepDur := time.Until(lastDayEnd)
-				if sleepDur < 0 {
-					// Already past — aim for next month's last day
-					firstOfNextMonth = time.Date(now.Year(), now.Month()+2, 1, 0, 0, 0, 0, time.UTC)
-					lastDayEnd = firstOfNextMonth.Add(-time.Minute)
-					sleepDur = time.Until(lastDayEnd)
-				}
-				log.Printf("[Season Cron] Next seasonal reward distribution in %s (at %s)", sleepDur.Round(time.Minute), lastDayEnd.Format(time.RFC3339))
-
-				<-time.After(sleepDur)
+		jobScheduler.Register(scheduler.Job{
+			Name:        "duel.seasonal_rewards",
+			Description: "Distribute end-of-season rewards and reset ratings",
+			Schedule:    scheduler.MustParseCron("59 23 L * *"),
+			Run: func(ctx context.Context) (string, error) {
 				out, err := distributeSeasonalRewardsUC.Execute(appDuel.DistributeSeasonalRewardsInput{})
 				if err != nil {
 					log.Printf("[Season Cron] Seasonal reward distribution failed: %v", err)
-				} else {
-					log.Printf("[Season Cron] Season %s: distributed to %d players", out.SeasonID, out.RewardsGranted)
+					return "", err
 				}
-			}
-		}()
+				log.Printf("[Season Cron] Season %s: distributed to %d players", out.SeasonID, out.RewardsGranted)
+				return fmt.Sprintf("season %s: distributed to %d players", out.SeasonID, out.RewardsGranted), nil
+			},
+		})
 	}
 
+	// ========================================
+	// Background: Outbox Relay and Cleanup
+	// ========================================
+	if outboxRelay != nil {
+		outboxRelay.Start(context.Background())
+
+		jobScheduler.Register(scheduler.Job{
+			Name:        "outbox.prune_delivered",
+			Description: "Delete delivered outbox events and their consumption records",
+			Schedule:    scheduler.MustParseCron("45 3 * * *"),
+			Run: func(ctx context.Context) (string, error) {
+				deleted, err := outboxRepo.DeleteDeliveredBefore(ctx, time.Now().UTC().Add(-7*24*time.Hour).Unix())
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deleted %d delivered events", deleted), nil
+			},
+		})
+	}
+
+	jobScheduler.Start(context.Background())
+
 	// ========================================
 	// Infrastructure Layer: HTTP Handlers
 	// ========================================
@@ -779,6 +1072,51 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		)
 	}
 
+	// Inventory handler (only if database is available)
+	var inventoryHandler *handlers.InventoryHandler
+	if reconcileInventoryUC != nil {
+		inventoryHandler = handlers.NewInventoryHandler(getTransactionHistoryUC, reconcileInventoryUC)
+	}
+
+	// Premium handler (only if database is available)
+	var premiumHandler *handlers.PremiumHandler
+	if getPremiumStatusUC != nil {
+		premiumHandler = handlers.NewPremiumHandler(
+			getPremiumStatusUC,
+			createPremiumInvoiceUC,
+			handlePreCheckoutUC,
+			handleSuccessfulPaymentUC,
+			os.Getenv("TELEGRAM_WEBHOOK_SECRET"),
+		)
+	}
+
+	// Ad reward handler (only if database is available)
+	var adRewardHandler *handlers.AdRewardHandler
+	if issueAdRewardUC != nil {
+		adRewardHandler = handlers.NewAdRewardHandler(issueAdRewardUC, redeemAdRewardUC)
+	}
+
+	// Shop handler (only if database is available)
+	var shopHandler *handlers.ShopHandler
+	if getShopUC != nil {
+		shopHandler = handlers.NewShopHandler(getShopUC, purchaseItemUC)
+	}
+
+	// Social handler (only if database is available)
+	var socialHandler *handlers.SocialHandler
+	if getFriendsUC != nil {
+		socialHandler = handlers.NewSocialHandler(
+			getFriendsUC,
+			sendFriendRequestUC,
+			respondFriendRequestUC,
+			removeFriendUC,
+			blockPlayerUC,
+			unblockPlayerUC,
+			getBlockedPlayersUC,
+			getFriendSuggestionsUC,
+		)
+	}
+
 	// Marathon handler (only if database is available)
 	var marathonHandler *handlers.MarathonHandler
 	if startMarathonUC != nil {
@@ -798,7 +1136,8 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	// Daily Challenge handler (only if database is available)
 	var dailyChallengeHandler *handlers.DailyChallengeHandler
 	if startDailyChallengeUC != nil {
-		recoverStreakUC := appDaily.NewRecoverStreakUseCase(dailyGameRepo, inventoryService)
+		recoverStreakUC := appDaily.NewRecoverStreakUseCase(dailyGameRepo, inventoryService).
+			WithAdVerification(adRewardVerifier)
 		dailyChallengeHandler = handlers.NewDailyChallengeHandler(
 			getOrCreateDailyQuizUC,
 			startDailyChallengeUC,
@@ -828,11 +1167,28 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 			getDuelLeaderboardUC,
 			requestRematchUC,
 			getGameResultUC,
+			getGameReplayUC,
 			getRivalsUC,
 			prepareShareUC,
 			getReferralsUC,
 			claimReferralRewardUC,
 			surrenderGameUC,
+			getOnlineFriendsUC,
+			getRatingHistoryUC,
+		)
+	}
+
+	// Party Mode handler (only if database is available)
+	var partyHandler *handlers.PartyHandler
+	if createPartyRoomUC != nil {
+		partyHandler = handlers.NewPartyHandler(
+			createPartyRoomUC,
+			joinPartyRoomUC,
+			getPartyRoomUC,
+			setPartyReadyUC,
+			startPartyGameUC,
+			leavePartyUC,
+			submitPartyAnswerUC,
 		)
 	}
 
@@ -846,12 +1202,13 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 
 	// Quiz routes
 	quiz := v1.Group("/quiz")
+	quizAuth := middleware.TelegramAuthMiddleware()
 	quiz.Get("/", quizHandler.GetAllQuizzes)
 	quiz.Get("/daily", middleware.TelegramAuthMiddleware(), quizHandler.GetDailyQuiz) // Daily quiz with auth (before /:id)
 	quiz.Get("/random", quizHandler.GetRandomQuiz)                                    // Random quiz (before /:id)
 	quiz.Get("/:id", quizHandler.GetQuizByID)
-	quiz.Post("/:id/start", quizHandler.StartQuiz)
-	quiz.Get("/:id/active-session", quizHandler.GetActiveSession)
+	quiz.Post("/:id/start", quizAuth, quizHandler.StartQuiz)
+	quiz.Get("/:id/active-session", quizAuth, quizHandler.GetActiveSession)
 	quiz.Get("/:id/leaderboard", quizHandler.GetLeaderboard)
 
 	// Global leaderboard route
@@ -860,9 +1217,9 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	// Session routes
 	session := v1.Group("/quiz/session")
 	// IMPORTANT: More specific routes MUST come before generic /:sessionId
-	session.Post("/:sessionId/answer", quizHandler.SubmitAnswer)
+	session.Post("/:sessionId/answer", quizAuth, quizHandler.SubmitAnswer)
 	session.Get("/:sessionId", quizHandler.GetSessionResults)
-	session.Delete("/:sessionId", quizHandler.AbandonSession)
+	session.Delete("/:sessionId", quizAuth, quizHandler.AbandonSession)
 
 	// Category routes
 	if categoryHandler != nil {
@@ -871,6 +1228,22 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		categories.Post("/", categoryHandler.CreateCategory) // Maybe add auth later
 	}
 
+	// Question media stored by asset key (appQuiz.MediaAssetURLPrefix); images are
+	// replaced under a new key rather than overwritten, so clients may cache them for a week.
+	// A file opened directly must not run as a page on the API origin.
+	mediaDir := os.Getenv("MEDIA_DIR")
+	if mediaDir == "" {
+		mediaDir = "data/media"
+	}
+	v1.Get("/media/*", func(c fiber.Ctx) error {
+		c.Set("Content-Security-Policy", "default-src 'none'; sandbox")
+		c.Set("X-Content-Type-Options", "nosniff")
+		return c.Next()
+	}, static.New(mediaDir, static.Config{
+		MaxAge:     7 * 24 * 60 * 60,
+		IndexNames: []string{"__none__"}, // never list or serve a directory
+	}))
+
 	// WebSocket routes
 	ws := app.Group("/ws")
 
@@ -885,19 +1258,39 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 	ws.Get("/leaderboard/:id", websocket.New(wsHub.HandleLeaderboardWebSocket))
 	ws.Get("/leaderboard/global", websocket.New(wsHub.HandleGlobalLeaderboardWebSocket))
 
+	// Game-mode WebSockets identify the player from validated init data or a session
+	// access token, passed as the "auth" query parameter (browsers cannot set WebSocket headers)
+	wsAuth := middleware.TelegramWebSocketAuthMiddleware()
+
 	// Duel WebSocket (if database available)
 	if duelGameRepo != nil {
 		// Lobby WebSocket — must be registered BEFORE /duel/:gameId to avoid route conflict
 		if lobbyHub != nil {
 			lobbyWsHandler := handlers.NewDuelLobbyWebSocketHandler(lobbyHub, duelOnlineTracker)
-			ws.Get("/duel/lobby", websocket.New(lobbyWsHandler.HandleLobbyWebSocket))
+			ws.Get("/duel/lobby", wsAuth, websocket.New(lobbyWsHandler.HandleLobbyWebSocket))
 		}
 
 		// startGameUC and submitDuelAnswerUC require a duel-specific QuestionRepository
 		// adapter (appDuel.QuestionRepository) that does not exist yet. They will be nil
 		// until that adapter is implemented; the hub handles nil use cases gracefully.
-		duelWsHub := handlers.NewDuelWebSocketHub(startGameUC, submitDuelAnswerUC, userRepo)
-		ws.Get("/duel/:gameId", websocket.New(duelWsHub.HandleDuelWebSocket))
+		// Without Redis (nil coordinator) duel games live in this process only
+		duelWsHub := handlers.NewDuelWebSocketHub(startGameUC, submitDuelAnswerUC, userRepo, duelCoordinator).
+			WithBotPlayer(botPlayerUC)
+		duelWsHub.Start(context.Background())
+		ws.Get("/duel/:gameId", wsAuth, websocket.New(duelWsHub.HandleDuelWebSocket))
+	}
+
+	// Party WebSocket (if database available)
+	if partyWsHub != nil {
+		ws.Get("/party/:roomId", wsAuth, websocket.New(partyWsHub.HandlePartyWebSocket))
+	}
+
+	// Auth session routes (only if session tokens are enabled)
+	if createSessionUC != nil {
+		authHandler := handlers.NewAuthHandler(createSessionUC, refreshSessionUC)
+		auth := v1.Group("/auth")
+		auth.Post("/session", middleware.TelegramAuthMiddleware(), authHandler.CreateSession)
+		auth.Post("/refresh", authHandler.RefreshSession)
 	}
 
 	// User routes (only if database is available)
@@ -914,36 +1307,92 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		user.Put("/:id", userHandler.UpdateUserProfile)
 		user.Get("/by-username/:username", userHandler.GetUserByTelegramUsername)
 
+		// Wallet: the authenticated player's own transaction history
+		if inventoryHandler != nil {
+			user.Get("/:id/transactions", middleware.TelegramAuthMiddleware(), inventoryHandler.GetTransactions)
+		}
+
 		// Admin routes
 		users := v1.Group("/users")
 		users.Get("/", userHandler.ListUsers)
 	}
 
+	// Premium routes (only if database is available)
+	if premiumHandler != nil {
+		premium := v1.Group("/premium", middleware.TelegramAuthMiddleware())
+		premium.Get("/", premiumHandler.GetPremiumStatus)
+
+		if createPremiumInvoiceUC != nil {
+			premium.Post("/invoice", premiumHandler.CreateInvoice)
+		}
+
+		// Bot API updates (pre-checkout and successful payments); set with setWebhook's secret_token
+		if handlePreCheckoutUC != nil && os.Getenv("TELEGRAM_WEBHOOK_SECRET") != "" {
+			v1.Post("/telegram/webhook", premiumHandler.TelegramWebhook)
+		} else {
+			log.Println("⚠️ TELEGRAM_BOT_TOKEN or TELEGRAM_WEBHOOK_SECRET not set, Telegram Stars payments disabled")
+		}
+	}
+
+	// Ad reward routes (only if database is available)
+	if adRewardHandler != nil {
+		// Without the callback no nonce is ever confirmed, so "ad" payments are refused
+		if redeemAdRewardUC != nil {
+			ads := v1.Group("/ads")
+			ads.Post("/reward-nonce", middleware.TelegramAuthMiddleware(), adRewardHandler.IssueRewardNonce)
+			ads.Get("/callback", adRewardHandler.RewardCallback)
+		} else {
+			log.Println("⚠️ AD_CALLBACK_SECRET not set, rewarded ads disabled")
+		}
+	}
+
+	// Shop routes (only if database is available)
+	if shopHandler != nil {
+		shop := v1.Group("/shop", middleware.TelegramAuthMiddleware())
+		shop.Get("/", shopHandler.GetShop)
+		shop.Post("/purchase", shopHandler.PurchaseItem)
+	}
+
+	// Social (friends) routes (only if database is available)
+	if socialHandler != nil {
+		friends := v1.Group("/friends", middleware.TelegramAuthMiddleware())
+		friends.Get("/", socialHandler.GetFriends)
+		friends.Get("/suggestions", socialHandler.GetFriendSuggestions)
+		friends.Post("/requests", socialHandler.SendFriendRequest)
+		friends.Post("/requests/:requestId/respond", socialHandler.RespondFriendRequest)
+		friends.Get("/blocked", socialHandler.GetBlockedPlayers)
+		friends.Post("/blocked/:playerId", socialHandler.BlockPlayer)
+		friends.Delete("/blocked/:playerId", socialHandler.UnblockPlayer)
+		friends.Delete("/:friendId", socialHandler.RemoveFriend)
+	}
+
 	// Marathon routes (only if database is available)
 	if marathonHandler != nil {
 		marathon := v1.Group("/marathon")
-		marathon.Post("/start", marathonHandler.StartMarathon)
-		marathon.Post("/:gameId/answer", marathonHandler.SubmitMarathonAnswer)
-		marathon.Post("/:gameId/bonus", marathonHandler.UseMarathonBonus)
-		marathon.Post("/:gameId/continue", marathonHandler.ContinueMarathon)
-		marathon.Post("/:gameId/complete", marathonHandler.CompleteMarathon)
-		marathon.Delete("/:gameId", marathonHandler.AbandonMarathon)
-		marathon.Get("/status", marathonHandler.GetMarathonStatus)
-		marathon.Get("/personal-bests", marathonHandler.GetPersonalBests)
-		marathon.Get("/leaderboard", marathonHandler.GetMarathonLeaderboard)
+		marathonAuth := middleware.TelegramAuthMiddleware()
+		marathon.Post("/start", marathonAuth, marathonHandler.StartMarathon)
+		marathon.Post("/:gameId/answer", marathonAuth, marathonHandler.SubmitMarathonAnswer)
+		marathon.Post("/:gameId/bonus", marathonAuth, marathonHandler.UseMarathonBonus)
+		marathon.Post("/:gameId/continue", marathonAuth, marathonHandler.ContinueMarathon)
+		marathon.Post("/:gameId/complete", marathonAuth, marathonHandler.CompleteMarathon)
+		marathon.Delete("/:gameId", marathonAuth, marathonHandler.AbandonMarathon)
+		marathon.Get("/status", marathonAuth, marathonHandler.GetMarathonStatus)
+		marathon.Get("/personal-bests", marathonAuth, marathonHandler.GetPersonalBests)
+		marathon.Get("/leaderboard", marathonHandler.GetMarathonLeaderboard) // Public
 	}
 
 	// Daily Challenge routes (only if database is available)
 	if dailyChallengeHandler != nil {
 		daily := v1.Group("/daily-challenge")
-		daily.Post("/start", dailyChallengeHandler.StartDailyChallenge)
-		daily.Post("/:gameId/answer", dailyChallengeHandler.SubmitDailyAnswer)
-		daily.Post("/:gameId/chest/open", dailyChallengeHandler.OpenChest)
-		daily.Post("/:gameId/retry", dailyChallengeHandler.RetryChallenge)
-		daily.Get("/status", dailyChallengeHandler.GetDailyStatus)
-		daily.Get("/leaderboard", dailyChallengeHandler.GetDailyLeaderboard)
-		daily.Get("/streak", dailyChallengeHandler.GetPlayerStreak)
-		daily.Post("/recover-streak", dailyChallengeHandler.RecoverStreak)
+		dailyAuth := middleware.TelegramAuthMiddleware()
+		daily.Post("/start", dailyAuth, dailyChallengeHandler.StartDailyChallenge)
+		daily.Post("/:gameId/answer", dailyAuth, dailyChallengeHandler.SubmitDailyAnswer)
+		daily.Post("/:gameId/chest/open", dailyAuth, dailyChallengeHandler.OpenChest)
+		daily.Post("/:gameId/retry", dailyAuth, dailyChallengeHandler.RetryChallenge)
+		daily.Get("/status", dailyAuth, dailyChallengeHandler.GetDailyStatus)
+		daily.Get("/leaderboard", dailyChallengeHandler.GetDailyLeaderboard) // Public
+		daily.Get("/streak", dailyAuth, dailyChallengeHandler.GetPlayerStreak)
+		daily.Post("/recover-streak", dailyAuth, dailyChallengeHandler.RecoverStreak)
 	}
 
 	// Duel (PvP) routes (only if database is available)
@@ -959,15 +1408,30 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		duel.Post("/challenge/:challengeId/start", duelHandler.StartChallenge)
 		duel.Post("/challenge/:challengeId/respond", duelHandler.RespondChallenge)
 		duel.Get("/history", duelHandler.GetGameHistory)
+		duel.Get("/rating-history", duelHandler.GetRatingHistory)
 		duel.Get("/leaderboard", duelHandler.GetDuelLeaderboard)
 		duel.Get("/game/:gameId", duelHandler.GetGameResult)
+		duel.Get("/game/:gameId/replay", duelHandler.GetGameReplay)
 		duel.Post("/game/:gameId/rematch", duelHandler.RequestRematch)
 		duel.Post("/game/:gameId/surrender", duelHandler.SurrenderGame)
 		duel.Get("/rivals", duelHandler.GetRivals)
+		duel.Get("/friends/online", duelHandler.GetOnlineFriends)
 		duel.Get("/referrals", duelHandler.GetReferrals)
 		duel.Post("/referrals/:friendId/claim", duelHandler.ClaimReferralReward)
 	}
 
+	// Party Mode routes (only if database is available)
+	if partyHandler != nil {
+		party := v1.Group("/party", middleware.TelegramAuthMiddleware())
+		party.Post("/rooms", partyHandler.CreateRoom)
+		party.Post("/rooms/join", partyHandler.JoinRoom)
+		party.Get("/rooms/:roomId", partyHandler.GetRoom)
+		party.Post("/rooms/:roomId/ready", partyHandler.SetReady)
+		party.Post("/rooms/:roomId/start", partyHandler.StartGame)
+		party.Post("/rooms/:roomId/leave", partyHandler.LeaveRoom)
+		party.Post("/game/:gameId/answer", partyHandler.SubmitAnswer)
+	}
+
 	// Admin routes (debug/testing, protected by API key)
 	if db != nil {
 		adminHandler := handlers.NewAdminHandler(db)
@@ -986,6 +1450,78 @@ func SetupRoutes(app *fiber.App, db *sql.DB) {
 		adminMarathon.Patch("/game", adminHandler.UpdateMarathonGame)
 		adminMarathon.Get("/games", adminHandler.ListMarathonGames)
 		adminMarathon.Delete("/games", adminHandler.DeleteMarathonGames)
+
+		// Duel admin (support)
+		if duelHandler != nil {
+			admin.Get("/duel/game/:gameId/replay", duelHandler.GetGameReplayForSupport)
+		}
+
+		// Background jobs
+		schedulerHandler := handlers.NewSchedulerHandler(jobScheduler)
+		admin.Get("/jobs", schedulerHandler.ListJobs)
+		admin.Get("/jobs/:name/runs", schedulerHandler.ListJobRuns)
+		admin.Post("/jobs/:name/trigger", schedulerHandler.TriggerJob)
+
+		// Event bus metrics
+		eventBusHandler := handlers.NewEventBusHandler(eventBus)
+		admin.Get("/events/handlers", eventBusHandler.ListEventHandlers)
+
+		// Inventory ledger
+		if inventoryHandler != nil {
+			admin.Post("/inventory/reconcile", inventoryHandler.ReconcileInventory)
+			admin.Get("/user/:id/transactions", inventoryHandler.GetPlayerTransactionsForSupport)
+		}
+
+		// Content management (soft delete + audit trail)
+		contentQuestionRepo := postgres.NewQuestionRepository(db)
+		contentTagRepo := postgres.NewTagRepository(db)
+		contentAuditRepo := postgres.NewContentAuditRepository(db)
+		contentTxManager := postgres.NewTxManager(db)
+		contentHandler := handlers.NewContentAdminHandler(
+			appQuiz.NewListContentAuditUseCase(contentAuditRepo),
+			appQuiz.NewAdminGetQuizUseCase(quizRepo),
+			appQuiz.NewAdminCreateQuizUseCase(quizRepo, categoryRepo, contentTagRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminUpdateQuizUseCase(quizRepo, categoryRepo, contentTagRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminDeleteQuizUseCase(quizRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRestoreQuizUseCase(quizRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminGetQuestionUseCase(contentQuestionRepo),
+			appQuiz.NewAdminCreateQuestionUseCase(quizRepo, contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminUpdateQuestionUseCase(contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminDeleteQuestionUseCase(contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRestoreQuestionUseCase(contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminDeleteAnswerUseCase(contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRestoreAnswerUseCase(contentQuestionRepo, contentQuestionRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminCreateCategoryUseCase(categoryRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminUpdateCategoryUseCase(categoryRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminDeleteCategoryUseCase(categoryRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRestoreCategoryUseCase(categoryRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminCreateTagUseCase(contentTagRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRenameTagUseCase(contentTagRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminDeleteTagUseCase(contentTagRepo, contentAuditRepo, contentTxManager),
+			appQuiz.NewAdminRestoreTagUseCase(contentTagRepo, contentAuditRepo, contentTxManager),
+		)
+		content := admin.Group("/content")
+		content.Get("/audit", contentHandler.ListAudit)
+		content.Post("/quizzes", contentHandler.CreateQuiz)
+		content.Get("/quizzes/:quizId", contentHandler.GetQuiz)
+		content.Put("/quizzes/:quizId", contentHandler.UpdateQuiz)
+		content.Delete("/quizzes/:quizId", contentHandler.DeleteQuiz)
+		content.Post("/quizzes/:quizId/restore", contentHandler.RestoreQuiz)
+		content.Post("/quizzes/:quizId/questions", contentHandler.CreateQuestion)
+		content.Get("/questions/:questionId", contentHandler.GetQuestion)
+		content.Put("/questions/:questionId", contentHandler.UpdateQuestion)
+		content.Delete("/questions/:questionId", contentHandler.DeleteQuestion)
+		content.Post("/questions/:questionId/restore", contentHandler.RestoreQuestion)
+		content.Delete("/questions/:questionId/answers/:answerId", contentHandler.DeleteAnswer)
+		content.Post("/questions/:questionId/answers/:answerId/restore", contentHandler.RestoreAnswer)
+		content.Post("/categories", contentHandler.CreateCategory)
+		content.Put("/categories/:categoryId", contentHandler.UpdateCategory)
+		content.Delete("/categories/:categoryId", contentHandler.DeleteCategory)
+		content.Post("/categories/:categoryId/restore", contentHandler.RestoreCategory)
+		content.Post("/tags", contentHandler.CreateTag)
+		content.Put("/tags/:tagId", contentHandler.RenameTag)
+		content.Delete("/tags/:tagId", contentHandler.DeleteTag)
+		content.Post("/tags/:tagId/restore", contentHandler.RestoreTag)
 	}
 
 	// Swagger documentation
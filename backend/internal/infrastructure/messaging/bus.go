@@ -0,0 +1,243 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HandlerMode tells how the bus runs a handler
+type HandlerMode string
+
+const (
+	// Sync handlers run in the publishing goroutine, in subscription order.
+	// Their errors are returned from Publish. Use it when order matters
+	// (WebSocket notifications) or the publisher must know about failures.
+	Sync HandlerMode = "sync"
+	// Async handlers run in their own goroutine; errors only reach the error reporter
+	Async HandlerMode = "async"
+)
+
+// HandlerError is a failure of one handler on one event
+type HandlerError struct {
+	Handler string
+	Event   string
+	Err     error
+}
+
+func (e *HandlerError) Error() string {
+	return fmt.Sprintf("%s on %s: %v", e.Handler, e.Event, e.Err)
+}
+
+func (e *HandlerError) Unwrap() error { return e.Err }
+
+// HandlerStats are the counters of one subscription
+type HandlerStats struct {
+	Handler       string      `json:"handler"`
+	Event         string      `json:"event"`
+	Mode          HandlerMode `json:"mode"`
+	Handled       int64       `json:"handled"`
+	Failed        int64       `json:"failed"`
+	Panics        int64       `json:"panics"`
+	TotalDuration int64       `json:"totalDurationMs"`
+	LastError     string      `json:"lastError,omitempty"`
+	LastErrorAt   int64       `json:"lastErrorAt,omitempty"`
+}
+
+type subscription struct {
+	name      string
+	eventType string
+	mode      HandlerMode
+	// call runs the handler if the event has the subscribed type
+	call func(ctx context.Context, event any) (matched bool, err error)
+
+	handled   atomic.Int64
+	failed    atomic.Int64
+	panics    atomic.Int64
+	totalNs   atomic.Int64
+	lastError atomic.Pointer[HandlerError]
+	lastErrAt atomic.Int64
+}
+
+// Bus is the in-process domain event bus shared by every bounded context.
+// Handlers subscribe to a Go type (a concrete event or an interface such as
+// quick_duel.Event) and receive every published event assignable to it.
+type Bus struct {
+	mu   sync.RWMutex
+	subs []*subscription
+
+	reporter func(err *HandlerError)
+	inflight sync.WaitGroup
+}
+
+// NewBus creates a bus that logs handler failures
+func NewBus() *Bus {
+	return &Bus{
+		reporter: func(err *HandlerError) {
+			log.Printf("[EVENT BUS] %v", err)
+		},
+	}
+}
+
+// OnError replaces the reporter called for every failed or panicking handler
+func (b *Bus) OnError(reporter func(err *HandlerError)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reporter = reporter
+}
+
+// Subscribe registers a handler for events of type E.
+// name identifies the handler in errors and stats; use "<context>.<reaction>".
+func Subscribe[E any](b *Bus, name string, mode HandlerMode, handler func(ctx context.Context, event E) error) {
+	sub := &subscription{
+		name:      name,
+		eventType: fmt.Sprintf("%T", (*E)(nil))[1:],
+		mode:      mode,
+		call: func(ctx context.Context, event any) (bool, error) {
+			e, ok := event.(E)
+			if !ok {
+				return false, nil
+			}
+			return true, handler(ctx, e)
+		},
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = append(b.subs, sub)
+}
+
+// Publish delivers events to their subscribers. Returns the joined errors of
+// the sync handlers; async handler failures are only reported.
+func (b *Bus) Publish(ctx context.Context, events ...any) error {
+	b.mu.RLock()
+	subs := b.subs
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, event := range events {
+		for _, sub := range subs {
+			if sub.mode == Async {
+				b.inflight.Add(1)
+				go func(sub *subscription, event any) {
+					defer b.inflight.Done()
+					b.run(context.WithoutCancel(ctx), sub, event)
+				}(sub, event)
+				continue
+			}
+			if err := b.run(ctx, sub, event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Wait blocks until the async handlers started so far have returned
+func (b *Bus) Wait() {
+	b.inflight.Wait()
+}
+
+// Stats returns the counters of every subscription, sorted by handler name
+func (b *Bus) Stats() []HandlerStats {
+	b.mu.RLock()
+	subs := b.subs
+	b.mu.RUnlock()
+
+	stats := make([]HandlerStats, 0, len(subs))
+	for _, sub := range subs {
+		s := HandlerStats{
+			Handler:       sub.name,
+			Event:         sub.eventType,
+			Mode:          sub.mode,
+			Handled:       sub.handled.Load(),
+			Failed:        sub.failed.Load(),
+			Panics:        sub.panics.Load(),
+			TotalDuration: time.Duration(sub.totalNs.Load()).Milliseconds(),
+		}
+		if last := sub.lastError.Load(); last != nil {
+			s.LastError = last.Error()
+			s.LastErrorAt = sub.lastErrAt.Load()
+		}
+		stats = append(stats, s)
+	}
+	sort.SliceStable(stats, func(i, j int) bool { return stats[i].Handler < stats[j].Handler })
+	return stats
+}
+
+// run calls one handler, recording its outcome. Returns nil if the event does not match.
+func (b *Bus) run(ctx context.Context, sub *subscription, event any) (herr *HandlerError) {
+	start := time.Now()
+	matched := true
+
+	defer func() {
+		if r := recover(); r != nil {
+			sub.panics.Add(1)
+			herr = &HandlerError{Handler: sub.name, Event: eventName(event), Err: fmt.Errorf("panic: %v", r)}
+		}
+		if !matched {
+			return
+		}
+		sub.handled.Add(1)
+		sub.totalNs.Add(int64(time.Since(start)))
+		if herr != nil {
+			sub.failed.Add(1)
+			sub.lastError.Store(herr)
+			sub.lastErrAt.Store(time.Now().Unix())
+			b.report(herr)
+		}
+	}()
+
+	ok, err := sub.call(ctx, event)
+	matched = ok
+	if err != nil {
+		return &HandlerError{Handler: sub.name, Event: eventName(event), Err: err}
+	}
+	return nil
+}
+
+func (b *Bus) report(err *HandlerError) {
+	b.mu.RLock()
+	reporter := b.reporter
+	b.mu.RUnlock()
+	if reporter != nil {
+		reporter(err)
+	}
+}
+
+// eventName returns the name events give themselves (EventType or EventName), or their Go type
+func eventName(event any) string {
+	switch e := event.(type) {
+	case interface{ EventType() string }:
+		return e.EventType()
+	case interface{ EventName() string }:
+		return e.EventName()
+	}
+	return fmt.Sprintf("%T", event)
+}
+
+// ========================================
+// Context ports
+// ========================================
+
+// Publisher adapts the bus to the single-event EventBus port of a bounded context
+// (appDaily.EventBus, appMarathon.EventBus, appDuel.EventBus, appParty.EventBus).
+// Failures were already reported by the bus, and the ports have no error to return.
+type Publisher[E any] struct {
+	bus *Bus
+}
+
+// NewPublisher creates a port publishing events of type E to the bus
+func NewPublisher[E any](bus *Bus) *Publisher[E] {
+	return &Publisher[E]{bus: bus}
+}
+
+// Publish implements the context's EventBus port
+func (p *Publisher[E]) Publish(event E) {
+	_ = p.bus.Publish(context.Background(), event)
+}
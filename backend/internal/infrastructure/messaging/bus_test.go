@@ -0,0 +1,140 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+type testEvent interface{ EventType() string }
+
+type pingEvent struct{ n int }
+
+func (pingEvent) EventType() string { return "ping" }
+
+type pongEvent struct{}
+
+func (pongEvent) EventType() string { return "pong" }
+
+func TestBus_DeliversByType(t *testing.T) {
+	bus := NewBus()
+
+	var pings []int
+	var all []string
+	Subscribe(bus, "test.pings", Sync, func(_ context.Context, e pingEvent) error {
+		pings = append(pings, e.n)
+		return nil
+	})
+	Subscribe(bus, "test.all", Sync, func(_ context.Context, e testEvent) error {
+		all = append(all, e.EventType())
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), pingEvent{n: 1}, pongEvent{}, pingEvent{n: 2}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	if len(pings) != 2 || pings[0] != 1 || pings[1] != 2 {
+		t.Errorf("pings = %v, want [1 2]", pings)
+	}
+	if len(all) != 3 || all[1] != "pong" {
+		t.Errorf("all = %v, want [ping pong ping]", all)
+	}
+}
+
+func TestBus_SyncErrorsAreReturnedAndCounted(t *testing.T) {
+	bus := NewBus()
+	var reported []*HandlerError
+	bus.OnError(func(err *HandlerError) { reported = append(reported, err) })
+
+	errBoom := errors.New("boom")
+	calledAfter := false
+	Subscribe(bus, "test.failing", Sync, func(_ context.Context, _ pingEvent) error { return errBoom })
+	Subscribe(bus, "test.panicking", Sync, func(_ context.Context, _ pingEvent) error { panic("oops") })
+	Subscribe(bus, "test.after", Sync, func(_ context.Context, _ pingEvent) error {
+		calledAfter = true
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), pingEvent{})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("Publish error = %v, want it to wrap %v", err, errBoom)
+	}
+	if !calledAfter {
+		t.Error("a failing handler must not stop the following ones")
+	}
+	if len(reported) != 2 {
+		t.Fatalf("reported %d errors, want 2", len(reported))
+	}
+
+	stats := map[string]HandlerStats{}
+	for _, s := range bus.Stats() {
+		stats[s.Handler] = s
+	}
+	if s := stats["test.failing"]; s.Handled != 1 || s.Failed != 1 || s.Panics != 0 || s.LastError == "" {
+		t.Errorf("test.failing stats = %+v", s)
+	}
+	if s := stats["test.panicking"]; s.Failed != 1 || s.Panics != 1 {
+		t.Errorf("test.panicking stats = %+v", s)
+	}
+	if s := stats["test.after"]; s.Handled != 1 || s.Failed != 0 || s.Event != "messaging.pingEvent" {
+		t.Errorf("test.after stats = %+v", s)
+	}
+}
+
+func TestBus_AsyncErrorsAreOnlyReported(t *testing.T) {
+	bus := NewBus()
+	var mu sync.Mutex
+	var reported []string
+	bus.OnError(func(err *HandlerError) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported = append(reported, err.Handler)
+	})
+
+	Subscribe(bus, "test.async", Async, func(_ context.Context, _ pingEvent) error {
+		return errors.New("boom")
+	})
+
+	if err := bus.Publish(context.Background(), pingEvent{}); err != nil {
+		t.Errorf("Publish error = %v, want nil for async handlers", err)
+	}
+	bus.Wait()
+
+	if len(reported) != 1 || reported[0] != "test.async" {
+		t.Errorf("reported = %v, want [test.async]", reported)
+	}
+}
+
+func TestBus_UnmatchedEventsAreNotCounted(t *testing.T) {
+	bus := NewBus()
+	Subscribe(bus, "test.pings", Sync, func(_ context.Context, _ pingEvent) error { return nil })
+
+	_ = bus.Publish(context.Background(), pongEvent{})
+
+	if s := bus.Stats()[0]; s.Handled != 0 {
+		t.Errorf("Handled = %d, want 0", s.Handled)
+	}
+}
+
+func TestPublisher_ImplementsContextPort(t *testing.T) {
+	bus := NewBus()
+
+	var got []string
+	Subscribe(bus, "test.daily", Sync, func(_ context.Context, e daily_challenge.Event) error {
+		got = append(got, e.EventType())
+		return nil
+	})
+
+	playerID, _ := shared.NewUserID("123")
+	var port interface{ Publish(daily_challenge.Event) } = NewPublisher[daily_challenge.Event](bus)
+	port.Publish(daily_challenge.NewStreakMilestoneReachedEvent(daily_challenge.NewGameID(), playerID, 7, 10, 1000))
+
+	if len(got) != 1 || got[0] != "streak_milestone_reached" {
+		t.Errorf("got = %v, want [streak_milestone_reached]", got)
+	}
+}
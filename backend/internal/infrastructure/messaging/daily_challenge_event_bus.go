@@ -1,53 +1,21 @@
 package messaging
 
 import (
+	"context"
 	"log"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
 )
 
-// DailyChallengeEventBus is an in-memory implementation of daily_challenge.EventBus
-type DailyChallengeEventBus struct {
-	enableLogging bool
-	handlers      map[string][]func(daily_challenge.Event)
+// SubscribeDailyChallengeEventLogging logs every daily challenge event
+func SubscribeDailyChallengeEventLogging(bus *Bus) {
+	Subscribe(bus, "daily_challenge.logging", Async, func(_ context.Context, event daily_challenge.Event) error {
+		logDailyChallengeEvent(event)
+		return nil
+	})
 }
 
-// NewDailyChallengeEventBus creates a new daily challenge event bus
-func NewDailyChallengeEventBus(enableLogging bool) *DailyChallengeEventBus {
-	return &DailyChallengeEventBus{
-		enableLogging: enableLogging,
-		handlers:      make(map[string][]func(daily_challenge.Event)),
-	}
-}
-
-// Subscribe registers a handler for a specific event type
-func (eb *DailyChallengeEventBus) Subscribe(eventType string, handler func(daily_challenge.Event)) {
-	eb.handlers[eventType] = append(eb.handlers[eventType], handler)
-}
-
-// Publish publishes a single daily challenge event
-func (eb *DailyChallengeEventBus) Publish(event daily_challenge.Event) {
-	go eb.dispatch(event)
-}
-
-func (eb *DailyChallengeEventBus) dispatch(event daily_challenge.Event) {
-	defer func() {
-		if r := recover(); r != nil {
-			log.Printf("[DAILY CHALLENGE EVENT BUS] Panic: %v", r)
-		}
-	}()
-
-	if eb.enableLogging {
-		eb.logEvent(event)
-	}
-
-	// Call registered handlers
-	for _, handler := range eb.handlers[event.EventType()] {
-		handler(event)
-	}
-}
-
-func (eb *DailyChallengeEventBus) logEvent(event daily_challenge.Event) {
+func logDailyChallengeEvent(event daily_challenge.Event) {
 	switch e := event.(type) {
 	case daily_challenge.DailyQuizCreatedEvent:
 		log.Printf("[DAILY CHALLENGE] Quiz Created: date=%s, questions=%d", e.Date().String(), len(e.QuestionIDs()))
@@ -0,0 +1,191 @@
+package messaging
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// DailyChallengeOutbox writes daily challenge events to the transactional outbox.
+// Implements appDaily.EventOutbox.
+// Only event types with a payload codec below are stored; other events
+// (game started, question answered) only reach the in-process bus.
+type DailyChallengeOutbox struct {
+	writer OutboxWriter
+}
+
+// NewDailyChallengeOutbox creates a daily challenge outbox on top of the given writer
+func NewDailyChallengeOutbox(writer OutboxWriter) *DailyChallengeOutbox {
+	return &DailyChallengeOutbox{writer: writer}
+}
+
+// AppendInTx implements appDaily.EventOutbox
+func (o *DailyChallengeOutbox) AppendInTx(tx *sql.Tx, events ...daily_challenge.Event) error {
+	return appendOutboxEvents(o.writer, tx, events, func(event daily_challenge.Event) (string, int64, interface{}, bool) {
+		payload, ok := encodeDailyChallengeEvent(event)
+		return event.EventType(), event.OccurredAt(), payload, ok
+	})
+}
+
+// DailyChallengeOutboxHandler adapts a handler of daily challenge events to the outbox relay
+func DailyChallengeOutboxHandler(handler func(tx *sql.Tx, event daily_challenge.Event) error) OutboxHandler {
+	return func(tx *sql.Tx, msg OutboxMessage) error {
+		event, err := DecodeDailyChallengeEvent(msg)
+		if err != nil {
+			return err
+		}
+		return handler(tx, event)
+	}
+}
+
+// ========================================
+// Payload codec
+// ========================================
+
+type chestEarnedPayload struct {
+	GameID          string   `json:"gameId"`
+	PlayerID        string   `json:"playerId"`
+	Date            string   `json:"date"`
+	ChestType       string   `json:"chestType"`
+	Coins           int      `json:"coins"`
+	PvpTickets      int      `json:"pvpTickets"`
+	MarathonBonuses []string `json:"marathonBonuses"`
+	StreakBonus     float64  `json:"streakBonus"`
+}
+
+type dailyGameCompletedPayload struct {
+	GameID         string  `json:"gameId"`
+	PlayerID       string  `json:"playerId"`
+	DailyQuizID    string  `json:"dailyQuizId"`
+	Date           string  `json:"date"`
+	FinalScore     int     `json:"finalScore"`
+	CorrectAnswers int     `json:"correctAnswers"`
+	TotalQuestions int     `json:"totalQuestions"`
+	NewStreak      int     `json:"newStreak"`
+	StreakBonus    float64 `json:"streakBonus"`
+	Rank           *int    `json:"rank,omitempty"`
+}
+
+type streakMilestonePayload struct {
+	GameID       string `json:"gameId"`
+	PlayerID     string `json:"playerId"`
+	StreakDays   int    `json:"streakDays"`
+	BonusPercent int    `json:"bonusPercent"`
+}
+
+func encodeDailyChallengeEvent(event daily_challenge.Event) (interface{}, bool) {
+	switch e := event.(type) {
+	case daily_challenge.ChestEarnedEvent:
+		bonuses := make([]string, len(e.MarathonBonuses()))
+		for i, b := range e.MarathonBonuses() {
+			bonuses[i] = b.String()
+		}
+		return chestEarnedPayload{
+			GameID:          e.GameID().String(),
+			PlayerID:        e.PlayerID().String(),
+			Date:            e.Date().String(),
+			ChestType:       e.ChestType().String(),
+			Coins:           e.Coins(),
+			PvpTickets:      e.PvpTickets(),
+			MarathonBonuses: bonuses,
+			StreakBonus:     e.StreakBonus(),
+		}, true
+
+	case daily_challenge.DailyGameCompletedEvent:
+		return dailyGameCompletedPayload{
+			GameID:         e.GameID().String(),
+			PlayerID:       e.PlayerID().String(),
+			DailyQuizID:    e.DailyQuizID().String(),
+			Date:           e.Date().String(),
+			FinalScore:     e.FinalScore(),
+			CorrectAnswers: e.CorrectAnswers(),
+			TotalQuestions: e.TotalQuestions(),
+			NewStreak:      e.NewStreak(),
+			StreakBonus:    e.StreakBonus(),
+			Rank:           e.Rank(),
+		}, true
+
+	case daily_challenge.StreakMilestoneReachedEvent:
+		return streakMilestonePayload{
+			GameID:       e.GameID().String(),
+			PlayerID:     e.PlayerID().String(),
+			StreakDays:   e.StreakDays(),
+			BonusPercent: e.BonusPercent(),
+		}, true
+	}
+
+	return nil, false
+}
+
+// DecodeDailyChallengeEvent rebuilds the domain event stored in an outbox message
+func DecodeDailyChallengeEvent(msg OutboxMessage) (daily_challenge.Event, error) {
+	switch msg.EventType {
+	case "chest_earned":
+		var p chestEarnedPayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		playerID, err := shared.NewUserID(p.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		bonuses := make([]daily_challenge.MarathonBonus, len(p.MarathonBonuses))
+		for i, b := range p.MarathonBonuses {
+			bonuses[i] = daily_challenge.MarathonBonus(b)
+		}
+		reward := daily_challenge.NewChestReward(daily_challenge.ChestType(p.ChestType), p.Coins, p.PvpTickets, bonuses)
+		return daily_challenge.NewChestEarnedEvent(
+			daily_challenge.NewGameIDFromString(p.GameID),
+			playerID,
+			daily_challenge.NewDateFromString(p.Date),
+			reward,
+			p.StreakBonus,
+			msg.OccurredAt,
+		), nil
+
+	case "daily_game_completed":
+		var p dailyGameCompletedPayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		playerID, err := shared.NewUserID(p.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		return daily_challenge.NewDailyGameCompletedEvent(
+			daily_challenge.NewGameIDFromString(p.GameID),
+			playerID,
+			daily_challenge.NewDailyQuizIDFromString(p.DailyQuizID),
+			daily_challenge.NewDateFromString(p.Date),
+			p.FinalScore,
+			p.CorrectAnswers,
+			p.TotalQuestions,
+			p.NewStreak,
+			p.StreakBonus,
+			p.Rank,
+			msg.OccurredAt,
+		), nil
+
+	case "streak_milestone_reached":
+		var p streakMilestonePayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		playerID, err := shared.NewUserID(p.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		return daily_challenge.NewStreakMilestoneReachedEvent(
+			daily_challenge.NewGameIDFromString(p.GameID),
+			playerID,
+			p.StreakDays,
+			p.BonusPercent,
+			msg.OccurredAt,
+		), nil
+	}
+
+	return nil, fmt.Errorf("no outbox codec for daily challenge event %q", msg.EventType)
+}
@@ -1,82 +1,43 @@
 package messaging
 
 import (
+	"context"
 	"log"
-	"sync"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
 
-// InMemoryEventBus is an in-memory implementation of quiz.EventBus
-type InMemoryEventBus struct {
-	handlers map[string][]quiz.EventHandler
-	mu       sync.RWMutex
+// QuizEventBus implements quiz.EventBus on top of the shared Bus
+type QuizEventBus struct {
+	bus *Bus
 }
 
-// NewInMemoryEventBus creates a new in-memory event bus
-func NewInMemoryEventBus() *InMemoryEventBus {
-	return &InMemoryEventBus{
-		handlers: make(map[string][]quiz.EventHandler),
-	}
+// NewQuizEventBus creates the quiz context's port to the shared bus
+func NewQuizEventBus(bus *Bus) *QuizEventBus {
+	return &QuizEventBus{bus: bus}
 }
 
-// Publish publishes events asynchronously
-func (eb *InMemoryEventBus) Publish(events ...quiz.Event) {
+// Publish publishes events to the shared bus
+func (eb *QuizEventBus) Publish(events ...quiz.Event) {
 	for _, event := range events {
-		go eb.dispatch(event)
-	}
-}
-
-func (eb *InMemoryEventBus) dispatch(event quiz.Event) {
-	eb.mu.RLock()
-	handlers, exists := eb.handlers[event.EventName()]
-	eb.mu.RUnlock()
-
-	if !exists {
-		return
-	}
-
-	for _, handler := range handlers {
-		// Run each handler in a separate goroutine for true async
-		go func(h quiz.EventHandler) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Printf("Event handler panicked: %v", r)
-				}
-			}()
-			h(event)
-		}(handler)
+		_ = eb.bus.Publish(context.Background(), event)
 	}
 }
 
-// Subscribe registers a handler for a specific event type
-func (eb *InMemoryEventBus) Subscribe(eventName string, handler quiz.EventHandler) {
-	eb.mu.Lock()
-	defer eb.mu.Unlock()
-
-	eb.handlers[eventName] = append(eb.handlers[eventName], handler)
-}
-
-// LoggingEventBus wraps an EventBus and logs all events
-type LoggingEventBus struct {
-	inner quiz.EventBus
-}
-
-// NewLoggingEventBus creates a new logging event bus
-func NewLoggingEventBus(inner quiz.EventBus) *LoggingEventBus {
-	return &LoggingEventBus{inner: inner}
+// Subscribe registers an async handler for a quiz event name (e.g. "quiz.completed")
+func (eb *QuizEventBus) Subscribe(eventName string, handler quiz.EventHandler) {
+	Subscribe(eb.bus, eventName, Async, func(_ context.Context, event quiz.Event) error {
+		if event.EventName() == eventName {
+			handler(event)
+		}
+		return nil
+	})
 }
 
-// Publish publishes events with logging
-func (eb *LoggingEventBus) Publish(events ...quiz.Event) {
-	for _, event := range events {
+// SubscribeQuizEventLogging logs every quiz event
+func SubscribeQuizEventLogging(bus *Bus) {
+	Subscribe(bus, "quiz.logging", Async, func(_ context.Context, event quiz.Event) error {
 		log.Printf("[EVENT] %s at %d", event.EventName(), event.OccurredAt())
-	}
-	eb.inner.Publish(events...)
-}
-
-// Subscribe registers a handler with logging
-func (eb *LoggingEventBus) Subscribe(eventName string, handler quiz.EventHandler) {
-	log.Printf("[EVENT] Subscribed to: %s", eventName)
-	eb.inner.Subscribe(eventName, handler)
+		return nil
+	})
 }
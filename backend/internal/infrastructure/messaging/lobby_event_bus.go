@@ -1,29 +1,29 @@
 package messaging
 
 import (
-	"log"
+	"context"
 
 	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
 	domainDuel "github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
 )
 
-// LobbyEventBus implements appDuel.EventBus by routing domain events
-// to the lobby WebSocket hub.
-type LobbyEventBus struct {
-	hub appDuel.LobbyHub
+// SubscribeLobbyNotifications routes duel domain events to the player(s)
+// they concern via the lobby WebSocket hub. Sync, so notifications keep
+// the order the use case published them in.
+func SubscribeLobbyNotifications(bus *Bus, hub appDuel.LobbyHub) {
+	Subscribe(bus, "quick_duel.lobby_notifications", Sync, func(_ context.Context, event domainDuel.Event) error {
+		notifyLobby(hub, event)
+		return nil
+	})
 }
 
-func NewLobbyEventBus(hub appDuel.LobbyHub) *LobbyEventBus {
-	return &LobbyEventBus{hub: hub}
-}
-
-// Publish routes a domain event to the appropriate player(s) via lobby WS.
-func (b *LobbyEventBus) Publish(event domainDuel.Event) {
+// notifyLobby sends the lobby message for an event; other events are ignored.
+func notifyLobby(hub appDuel.LobbyHub, event domainDuel.Event) {
 	switch e := event.(type) {
 	case domainDuel.ChallengeCreatedEvent:
 		// Direct challenge: notify invitee if connected
 		if e.ChallengedID() != nil {
-			b.hub.Notify(e.ChallengedID().String(), appDuel.LobbyEvent{
+			hub.Notify(e.ChallengedID().String(), appDuel.LobbyEvent{
 				Type: "challenge_received",
 				Data: map[string]interface{}{
 					"challengeId": e.ChallengeID().String(),
@@ -34,7 +34,7 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 
 	case domainDuel.ChallengeAcceptedEvent:
 		// Notify the challenger (inviter) that invitee accepted
-		b.hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
+		hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
 			Type: "challenge_accepted",
 			Data: map[string]interface{}{
 				"challengeId": e.ChallengeID().String(),
@@ -44,7 +44,7 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 
 	case domainDuel.ChallengeDeclinedEvent:
 		// Notify inviter that invitee declined
-		b.hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
+		hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
 			Type: "challenge_declined",
 			Data: map[string]interface{}{
 				"challengeId": e.ChallengeID().String(),
@@ -52,12 +52,12 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 		})
 
 	case domainDuel.ChallengeExpiredEvent:
-		b.hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
+		hub.Notify(e.ChallengerID().String(), appDuel.LobbyEvent{
 			Type: "challenge_expired",
 			Data: map[string]interface{}{"challengeId": e.ChallengeID().String()},
 		})
 		if e.ChallengedID() != nil {
-			b.hub.Notify(e.ChallengedID().String(), appDuel.LobbyEvent{
+			hub.Notify(e.ChallengedID().String(), appDuel.LobbyEvent{
 				Type: "challenge_expired",
 				Data: map[string]interface{}{"challengeId": e.ChallengeID().String()},
 			})
@@ -65,7 +65,7 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 
 	case domainDuel.GameReadyEvent:
 		if e.PlayerID() != nil {
-			b.hub.Notify(e.PlayerID().String(), appDuel.LobbyEvent{
+			hub.Notify(e.PlayerID().String(), appDuel.LobbyEvent{
 				Type: "game_ready",
 				Data: map[string]string{"gameId": e.GameID()},
 			})
@@ -73,7 +73,7 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 
 	case domainDuel.PlayerSurrenderedEvent:
 		// Notify the opponent that the other player surrendered (game is over)
-		b.hub.Notify(e.OpponentID().String(), appDuel.LobbyEvent{
+		hub.Notify(e.OpponentID().String(), appDuel.LobbyEvent{
 			Type: "game_surrendered",
 			Data: map[string]interface{}{
 				"gameId":      e.GameID().String(),
@@ -81,8 +81,5 @@ func (b *LobbyEventBus) Publish(event domainDuel.Event) {
 				"winnerId":    e.OpponentID().String(),
 			},
 		})
-
-	default:
-		log.Printf("[LobbyEventBus] unhandled event type: %T", event)
 	}
 }
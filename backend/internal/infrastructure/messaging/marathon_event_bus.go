@@ -1,41 +1,21 @@
 package messaging
 
 import (
+	"context"
 	"log"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
 )
 
-// MarathonEventBus is an in-memory implementation of marathon.EventBus
-type MarathonEventBus struct {
-	enableLogging bool
+// SubscribeMarathonEventLogging logs every marathon event
+func SubscribeMarathonEventLogging(bus *Bus) {
+	Subscribe(bus, "marathon.logging", Async, func(_ context.Context, event solo_marathon.Event) error {
+		logMarathonEvent(event)
+		return nil
+	})
 }
 
-// NewMarathonEventBus creates a new marathon event bus
-func NewMarathonEventBus(enableLogging bool) *MarathonEventBus {
-	return &MarathonEventBus{
-		enableLogging: enableLogging,
-	}
-}
-
-// Publish publishes a single marathon event
-func (eb *MarathonEventBus) Publish(event solo_marathon.Event) {
-	go eb.dispatch(event)
-}
-
-func (eb *MarathonEventBus) dispatch(event solo_marathon.Event) {
-	defer func() {
-		if r := recover(); r != nil {
-			log.Printf("[MARATHON EVENT BUS] Panic during event dispatch: %v", r)
-		}
-	}()
-
-	if eb.enableLogging {
-		eb.logEvent(event)
-	}
-}
-
-func (eb *MarathonEventBus) logEvent(event solo_marathon.Event) {
+func logMarathonEvent(event solo_marathon.Event) {
 	switch e := event.(type) {
 	case solo_marathon.MarathonGameStartedEvent:
 		log.Printf("[MARATHON EVENT] Game Started: gameId=%s, playerId=%s, category=%s",
@@ -77,16 +57,3 @@ func (eb *MarathonEventBus) logEvent(event solo_marathon.Event) {
 		log.Printf("[MARATHON EVENT] Unknown event type: %s at %d", event.EventType(), event.OccurredAt())
 	}
 }
-
-// NoOpMarathonEventBus is a no-op implementation for testing
-type NoOpMarathonEventBus struct{}
-
-// NewNoOpMarathonEventBus creates a no-op event bus
-func NewNoOpMarathonEventBus() *NoOpMarathonEventBus {
-	return &NoOpMarathonEventBus{}
-}
-
-// Publish does nothing
-func (eb *NoOpMarathonEventBus) Publish(event solo_marathon.Event) {
-	// No-op
-}
@@ -0,0 +1,99 @@
+package messaging
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/solo_marathon"
+)
+
+// MarathonOutbox writes marathon events to the transactional outbox.
+// Implements appMarathon.EventOutbox.
+// Only MarathonGameOverEvent is stored; per-question events only reach the in-process bus.
+type MarathonOutbox struct {
+	writer OutboxWriter
+}
+
+// NewMarathonOutbox creates a marathon outbox on top of the given writer
+func NewMarathonOutbox(writer OutboxWriter) *MarathonOutbox {
+	return &MarathonOutbox{writer: writer}
+}
+
+// AppendInTx implements appMarathon.EventOutbox
+func (o *MarathonOutbox) AppendInTx(tx *sql.Tx, events ...solo_marathon.Event) error {
+	return appendOutboxEvents(o.writer, tx, events, func(event solo_marathon.Event) (string, int64, interface{}, bool) {
+		payload, ok := encodeMarathonEvent(event)
+		return event.EventType(), event.OccurredAt(), payload, ok
+	})
+}
+
+// MarathonOutboxHandler adapts a handler of marathon events to the outbox relay
+func MarathonOutboxHandler(handler func(tx *sql.Tx, event solo_marathon.Event) error) OutboxHandler {
+	return func(tx *sql.Tx, msg OutboxMessage) error {
+		event, err := DecodeMarathonEvent(msg)
+		if err != nil {
+			return err
+		}
+		return handler(tx, event)
+	}
+}
+
+// ========================================
+// Payload codec
+// ========================================
+
+type marathonGameOverPayload struct {
+	GameID         string `json:"gameId"`
+	PlayerID       string `json:"playerId"`
+	FinalScore     int    `json:"finalScore"`
+	TotalQuestions int    `json:"totalQuestions"`
+	IsNewRecord    bool   `json:"isNewRecord"`
+	PreviousRecord *int   `json:"previousRecord,omitempty"`
+	ContinueCount  int    `json:"continueCount"`
+}
+
+func encodeMarathonEvent(event solo_marathon.Event) (interface{}, bool) {
+	switch e := event.(type) {
+	case solo_marathon.MarathonGameOverEvent:
+		return marathonGameOverPayload{
+			GameID:         e.GameID().String(),
+			PlayerID:       e.PlayerID().String(),
+			FinalScore:     e.FinalScore(),
+			TotalQuestions: e.TotalQuestions(),
+			IsNewRecord:    e.IsNewRecord(),
+			PreviousRecord: e.PreviousRecord(),
+			ContinueCount:  e.ContinueCount(),
+		}, true
+	}
+
+	return nil, false
+}
+
+// DecodeMarathonEvent rebuilds the domain event stored in an outbox message
+func DecodeMarathonEvent(msg OutboxMessage) (solo_marathon.Event, error) {
+	switch msg.EventType {
+	case "marathon_game_over":
+		var p marathonGameOverPayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		playerID, err := shared.NewUserID(p.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		return solo_marathon.NewMarathonGameOverEvent(
+			solo_marathon.NewGameIDFromString(p.GameID),
+			playerID,
+			p.FinalScore,
+			p.TotalQuestions,
+			p.IsNewRecord,
+			p.PreviousRecord,
+			p.ContinueCount,
+			msg.OccurredAt,
+		), nil
+	}
+
+	return nil, fmt.Errorf("no outbox codec for marathon event %q", msg.EventType)
+}
@@ -0,0 +1,211 @@
+package messaging
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is a domain event stored in the transactional outbox
+type OutboxMessage struct {
+	ID         int64
+	EventID    string // unique per event; subscribers deduplicate on it
+	EventType  string
+	Payload    []byte // JSON, see the context's outbox codec
+	OccurredAt int64
+	Attempts   int // delivery attempts so far, including the current one
+}
+
+// OutboxWriter appends messages within the transaction that saves their aggregate
+type OutboxWriter interface {
+	AppendInTx(tx *sql.Tx, messages ...OutboxMessage) error
+}
+
+// OutboxStore is the relay's view of the outbox
+type OutboxStore interface {
+	// ClaimBatch returns up to limit messages due for delivery and hides them from
+	// other relays for the lease duration. Each claim counts as an attempt.
+	ClaimBatch(ctx context.Context, limit int, lease time.Duration) ([]OutboxMessage, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	// MarkFailed schedules a retry at retryAt, or parks the message for good when dead is true
+	MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time, dead bool) error
+	// Consume runs fn in a transaction that also records that consumer handled eventID.
+	// Returns false without calling fn when consumer already handled the event.
+	Consume(ctx context.Context, consumer, eventID string, fn func(tx *sql.Tx) error) (bool, error)
+}
+
+// OutboxHandler handles one outbox message. Writes made through tx commit
+// together with the consumption record, so they happen exactly once.
+type OutboxHandler func(tx *sql.Tx, msg OutboxMessage) error
+
+// appendOutboxEvents encodes events with a context's payload codec and appends them
+// through writer. encode returns false for event types that are not stored.
+func appendOutboxEvents[E any](
+	writer OutboxWriter,
+	tx *sql.Tx,
+	events []E,
+	encode func(event E) (eventType string, occurredAt int64, payload interface{}, ok bool),
+) error {
+	messages := make([]OutboxMessage, 0, len(events))
+	for _, event := range events {
+		eventType, occurredAt, payload, ok := encode(event)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", eventType, err)
+		}
+		messages = append(messages, OutboxMessage{
+			EventID:    uuid.NewString(),
+			EventType:  eventType,
+			Payload:    data,
+			OccurredAt: occurredAt,
+		})
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+	return writer.AppendInTx(tx, messages...)
+}
+
+const (
+	outboxBatchSize    = 50
+	outboxPollInterval = time.Second
+	outboxLease        = time.Minute
+	// OutboxMaxAttempts is how many times a message is tried before it is parked as dead
+	OutboxMaxAttempts = 10
+	outboxMaxBackoff  = 10 * time.Minute
+)
+
+type outboxSubscriber struct {
+	consumer string
+	handler  OutboxHandler
+}
+
+// OutboxRelay delivers outbox messages to durable subscribers, at-least-once.
+// Several API replicas may run a relay: claimed messages are leased, and
+// subscribers are idempotent per event ID.
+type OutboxRelay struct {
+	store OutboxStore
+
+	mu          sync.RWMutex
+	subscribers map[string][]outboxSubscriber // event type -> subscribers
+}
+
+// NewOutboxRelay creates a relay reading from the given store
+func NewOutboxRelay(store OutboxStore) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		subscribers: make(map[string][]outboxSubscriber),
+	}
+}
+
+// Subscribe registers a durable handler for an event type.
+// consumer names the subscriber in the consumption records and must stay stable across releases.
+func (r *OutboxRelay) Subscribe(eventType, consumer string, handler OutboxHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers[eventType] = append(r.subscribers[eventType], outboxSubscriber{
+		consumer: consumer,
+		handler:  handler,
+	})
+}
+
+// Start polls the outbox until ctx is cancelled
+func (r *OutboxRelay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(outboxPollInterval)
+		defer ticker.Stop()
+
+		for {
+			// Drain full batches right away; wait for the next tick otherwise
+			for r.RelayBatch(ctx) == outboxBatchSize {
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RelayBatch claims and delivers one batch, returning how many messages it claimed
+func (r *OutboxRelay) RelayBatch(ctx context.Context) int {
+	messages, err := r.store.ClaimBatch(ctx, outboxBatchSize, outboxLease)
+	if err != nil {
+		log.Printf("[OUTBOX] Failed to claim messages: %v", err)
+		return 0
+	}
+
+	for _, msg := range messages {
+		r.deliver(ctx, msg)
+	}
+	return len(messages)
+}
+
+func (r *OutboxRelay) deliver(ctx context.Context, msg OutboxMessage) {
+	r.mu.RLock()
+	subscribers := r.subscribers[msg.EventType]
+	r.mu.RUnlock()
+
+	// Subscribers that already consumed the event skip it, so a retry
+	// after a partial failure only re-runs the ones that failed
+	var failed error
+	for _, sub := range subscribers {
+		if _, err := r.store.Consume(ctx, sub.consumer, msg.EventID, func(tx *sql.Tx) error {
+			return callOutboxHandler(sub.handler, tx, msg)
+		}); err != nil {
+			log.Printf("[OUTBOX] %s failed on %s %s (attempt %d): %v", sub.consumer, msg.EventType, msg.EventID, msg.Attempts, err)
+			if failed == nil {
+				failed = fmt.Errorf("%s: %w", sub.consumer, err)
+			}
+		}
+	}
+
+	if failed == nil {
+		if err := r.store.MarkDelivered(ctx, msg.ID); err != nil {
+			log.Printf("[OUTBOX] Failed to mark %s delivered: %v", msg.EventID, err)
+		}
+		return
+	}
+
+	dead := msg.Attempts >= OutboxMaxAttempts
+	if dead {
+		log.Printf("[OUTBOX] Giving up on %s %s after %d attempts", msg.EventType, msg.EventID, msg.Attempts)
+	}
+	if err := r.store.MarkFailed(ctx, msg.ID, failed.Error(), time.Now().Add(outboxBackoff(msg.Attempts)), dead); err != nil {
+		log.Printf("[OUTBOX] Failed to schedule retry of %s: %v", msg.EventID, err)
+	}
+}
+
+// outboxBackoff doubles the delay after each attempt: 2s, 4s, 8s... up to outboxMaxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	if attempts > 16 {
+		return outboxMaxBackoff
+	}
+	backoff := time.Second << uint(attempts)
+	if backoff > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return backoff
+}
+
+func callOutboxHandler(handler OutboxHandler, tx *sql.Tx, msg OutboxMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(tx, msg)
+}
@@ -0,0 +1,224 @@
+package messaging
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/daily_challenge"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// memoryOutboxStore is an in-memory OutboxStore; every pending message is due
+type memoryOutboxStore struct {
+	messages  []OutboxMessage
+	status    map[int64]string
+	consumed  map[string]bool // consumer + event ID
+	lastError map[int64]string
+}
+
+func newMemoryOutboxStore() *memoryOutboxStore {
+	return &memoryOutboxStore{
+		status:    make(map[int64]string),
+		consumed:  make(map[string]bool),
+		lastError: make(map[int64]string),
+	}
+}
+
+func (s *memoryOutboxStore) AppendInTx(_ *sql.Tx, messages ...OutboxMessage) error {
+	for _, msg := range messages {
+		msg.ID = int64(len(s.messages) + 1)
+		s.messages = append(s.messages, msg)
+		s.status[msg.ID] = "pending"
+	}
+	return nil
+}
+
+func (s *memoryOutboxStore) ClaimBatch(_ context.Context, limit int, _ time.Duration) ([]OutboxMessage, error) {
+	var claimed []OutboxMessage
+	for i := range s.messages {
+		if s.status[s.messages[i].ID] == "pending" && len(claimed) < limit {
+			s.messages[i].Attempts++
+			claimed = append(claimed, s.messages[i])
+		}
+	}
+	return claimed, nil
+}
+
+func (s *memoryOutboxStore) MarkDelivered(_ context.Context, id int64) error {
+	s.status[id] = "delivered"
+	return nil
+}
+
+func (s *memoryOutboxStore) MarkFailed(_ context.Context, id int64, lastError string, _ time.Time, dead bool) error {
+	s.lastError[id] = lastError
+	if dead {
+		s.status[id] = "dead"
+	}
+	return nil
+}
+
+func (s *memoryOutboxStore) Consume(_ context.Context, consumer, eventID string, fn func(tx *sql.Tx) error) (bool, error) {
+	key := consumer + "/" + eventID
+	if s.consumed[key] {
+		return false, nil
+	}
+	if err := fn(nil); err != nil {
+		return false, err
+	}
+	s.consumed[key] = true
+	return true, nil
+}
+
+func newChestEarnedEvent(t *testing.T) daily_challenge.ChestEarnedEvent {
+	t.Helper()
+	playerID, err := shared.NewUserID("player123")
+	if err != nil {
+		t.Fatalf("NewUserID: %v", err)
+	}
+	reward := daily_challenge.NewChestReward(daily_challenge.ChestType("golden"), 400, 4,
+		[]daily_challenge.MarathonBonus{daily_challenge.MarathonBonus("shield")})
+	return daily_challenge.NewChestEarnedEvent(
+		daily_challenge.NewGameIDFromString("game-1"),
+		playerID,
+		daily_challenge.NewDateFromString("2026-10-16"),
+		reward,
+		1.25,
+		1760000000,
+	)
+}
+
+func TestDailyChallengeOutbox_ChestEarnedRoundTrip(t *testing.T) {
+	store := newMemoryOutboxStore()
+	outbox := NewDailyChallengeOutbox(store)
+	event := newChestEarnedEvent(t)
+
+	// Events without a codec are not stored
+	if err := outbox.AppendInTx(nil, event, daily_challenge.DailyQuestionAnsweredEvent{}); err != nil {
+		t.Fatalf("AppendInTx: %v", err)
+	}
+	if len(store.messages) != 1 {
+		t.Fatalf("stored %d messages, want 1", len(store.messages))
+	}
+	msg := store.messages[0]
+	if msg.EventID == "" || msg.EventType != "chest_earned" || msg.OccurredAt != event.OccurredAt() {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+
+	decoded, err := DecodeDailyChallengeEvent(msg)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	got, ok := decoded.(daily_challenge.ChestEarnedEvent)
+	if !ok {
+		t.Fatalf("decoded %T, want ChestEarnedEvent", decoded)
+	}
+	if got.PlayerID().String() != "player123" || got.GameID().String() != "game-1" ||
+		got.Coins() != 400 || got.PvpTickets() != 4 || got.StreakBonus() != 1.25 ||
+		len(got.MarathonBonuses()) != 1 || got.MarathonBonuses()[0] != "shield" {
+		t.Errorf("round trip lost data: %+v", got)
+	}
+}
+
+func TestQuickDuelOutbox_GameFinishedRoundTrip(t *testing.T) {
+	store := newMemoryOutboxStore()
+	outbox := NewQuickDuelOutbox(store)
+	winnerID, _ := shared.NewUserID("player1")
+	loserID, _ := shared.NewUserID("player2")
+	event := quick_duel.NewDuelGameFinishedEvent(
+		quick_duel.NewGameIDFromString("duel-1"),
+		&winnerID,
+		quick_duel.WinReasonTime,
+		quick_duel.ReconstructDuelPlayer(winnerID, "alice", 1520, 700, true, 10, 41000),
+		quick_duel.ReconstructDuelPlayer(loserID, "bob", 1480, 700, false, 9, 52000),
+		1760000000,
+	)
+
+	if err := outbox.AppendInTx(nil, event, quick_duel.DuelGameCreatedEvent{}); err != nil {
+		t.Fatalf("AppendInTx: %v", err)
+	}
+	if len(store.messages) != 1 || store.messages[0].EventType != "duel_game_finished" {
+		t.Fatalf("stored %+v, want one duel_game_finished message", store.messages)
+	}
+
+	decoded, err := DecodeQuickDuelEvent(store.messages[0])
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	got, ok := decoded.(quick_duel.DuelGameFinishedEvent)
+	if !ok {
+		t.Fatalf("decoded %T, want DuelGameFinishedEvent", decoded)
+	}
+	if got.WinnerID() == nil || *got.WinnerID() != winnerID || got.WinReason() != quick_duel.WinReasonTime ||
+		got.Player1() != event.Player1() || got.Player2() != event.Player2() || got.OccurredAt() != event.OccurredAt() {
+		t.Errorf("round trip lost data: %+v", got)
+	}
+}
+
+func TestOutboxRelay_RetriesOnlyFailedSubscribers(t *testing.T) {
+	store := newMemoryOutboxStore()
+	_ = NewDailyChallengeOutbox(store).AppendInTx(nil, newChestEarnedEvent(t))
+
+	relay := NewOutboxRelay(store)
+	var walletCalls, statsCalls int
+	relay.Subscribe("chest_earned", "wallet", func(*sql.Tx, OutboxMessage) error {
+		walletCalls++
+		return nil
+	})
+	relay.Subscribe("chest_earned", "stats", func(*sql.Tx, OutboxMessage) error {
+		statsCalls++
+		if statsCalls == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	relay.RelayBatch(context.Background())
+	if store.status[1] != "pending" || store.lastError[1] == "" {
+		t.Fatalf("after failure: status %q, lastError %q", store.status[1], store.lastError[1])
+	}
+
+	relay.RelayBatch(context.Background())
+	if store.status[1] != "delivered" {
+		t.Fatalf("after retry: status %q, want delivered", store.status[1])
+	}
+	if walletCalls != 1 {
+		t.Errorf("wallet handled the event %d times, want exactly once", walletCalls)
+	}
+	if statsCalls != 2 {
+		t.Errorf("stats handled the event %d times, want 2", statsCalls)
+	}
+}
+
+func TestOutboxRelay_ParksMessageAfterMaxAttempts(t *testing.T) {
+	store := newMemoryOutboxStore()
+	_ = NewDailyChallengeOutbox(store).AppendInTx(nil, newChestEarnedEvent(t))
+
+	relay := NewOutboxRelay(store)
+	relay.Subscribe("chest_earned", "broken", func(*sql.Tx, OutboxMessage) error {
+		panic("handler bug")
+	})
+
+	for i := 0; i < OutboxMaxAttempts; i++ {
+		relay.RelayBatch(context.Background())
+	}
+
+	if store.status[1] != "dead" {
+		t.Fatalf("status %q after %d attempts, want dead", store.status[1], OutboxMaxAttempts)
+	}
+	if relay.RelayBatch(context.Background()) != 0 {
+		t.Error("dead messages must not be claimed again")
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	if got := outboxBackoff(1); got != 2*time.Second {
+		t.Errorf("backoff(1) = %s, want 2s", got)
+	}
+	if got := outboxBackoff(30); got != outboxMaxBackoff {
+		t.Errorf("backoff(30) = %s, want %s", got, outboxMaxBackoff)
+	}
+}
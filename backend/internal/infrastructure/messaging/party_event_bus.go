@@ -0,0 +1,144 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+
+	appParty "github.com/barsukov/quiz-sprint/backend/internal/application/party_mode"
+	domainParty "github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+)
+
+// partyRouter sends party domain events to every player in the room via the party WebSocket hub
+type partyRouter struct {
+	hub      appParty.PartyHub
+	gameRepo domainParty.GameRepository
+
+	// gameRooms caches gameID -> roomID: game events only carry the game ID
+	gameRooms sync.Map
+}
+
+// SubscribePartyBroadcasts routes party domain events to the room they belong to.
+// Sync, so players see events in the order the use case published them.
+func SubscribePartyBroadcasts(bus *Bus, hub appParty.PartyHub, gameRepo domainParty.GameRepository) {
+	router := &partyRouter{
+		hub:      hub,
+		gameRepo: gameRepo,
+	}
+	Subscribe(bus, "party_mode.room_broadcasts", Sync, func(_ context.Context, event domainParty.Event) error {
+		router.route(event)
+		return nil
+	})
+}
+
+// route broadcasts an event to its room; other events are ignored.
+func (b *partyRouter) route(event domainParty.Event) {
+	switch e := event.(type) {
+	case domainParty.RoomCreatedEvent:
+		log.Printf("[PARTY] Room %s created by %s (code %s)", e.RoomID(), e.HostID(), e.RoomCode())
+
+	case domainParty.PlayerJoinedEvent:
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "player_joined",
+			Data: map[string]interface{}{
+				"playerId": e.PlayerID().String(),
+				"username": e.Username(),
+			},
+		})
+
+	case domainParty.PlayerLeftEvent:
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "player_left",
+			Data: map[string]interface{}{"playerId": e.PlayerID().String()},
+		})
+
+	case domainParty.PlayerReadyEvent:
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "player_ready",
+			Data: map[string]interface{}{
+				"playerId": e.PlayerID().String(),
+				"isReady":  e.IsReady(),
+			},
+		})
+
+	case domainParty.HostChangedEvent:
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "host_changed",
+			Data: map[string]interface{}{"hostId": e.NewHostID().String()},
+		})
+
+	case domainParty.GameStartedEvent:
+		b.gameRooms.Store(e.GameID().String(), e.RoomID().String())
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "game_started",
+			Data: map[string]interface{}{
+				"gameId":         e.GameID().String(),
+				"totalQuestions": len(e.QuestionIDs()),
+				"startsIn":       appParty.QuestionStartDelaySec,
+			},
+		})
+
+	case domainParty.QuestionStartedEvent:
+		if roomID, ok := b.roomOf(e.GameID()); ok {
+			b.hub.QuestionStarted(roomID, e.GameID().String(), e.QuestionNumber())
+		}
+
+	case domainParty.PartyPlayerAnsweredEvent:
+		if roomID, ok := b.roomOf(e.GameID()); ok {
+			b.hub.Broadcast(roomID, appParty.PartyEvent{
+				Type: "player_answered",
+				Data: map[string]interface{}{
+					"playerId":   e.PlayerID().String(),
+					"questionId": e.QuestionID().String(),
+				},
+			})
+		}
+
+	case domainParty.QuestionCompletedEvent:
+		if roomID, ok := b.roomOf(e.GameID()); ok {
+			b.hub.Broadcast(roomID, appParty.PartyEvent{
+				Type: "question_completed",
+				Data: map[string]interface{}{
+					"questionId":     e.QuestionID().String(),
+					"questionNumber": e.QuestionNumber(),
+				},
+			})
+		}
+
+	case domainParty.GameFinishedEvent:
+		b.gameRooms.Delete(e.GameID().String())
+
+		leaderboard := appParty.ToPartyPlayerDTOs(e.Players())
+		sort.SliceStable(leaderboard, func(i, j int) bool {
+			return leaderboard[i].Score > leaderboard[j].Score
+		})
+
+		b.hub.Broadcast(e.RoomID().String(), appParty.PartyEvent{
+			Type: "game_finished",
+			Data: map[string]interface{}{
+				"gameId":      e.GameID().String(),
+				"winnerId":    e.WinnerID().String(),
+				"leaderboard": leaderboard,
+			},
+		})
+	}
+}
+
+// roomOf resolves the room a game is played in (cache first, then repository,
+// e.g. after a restart while the game was in progress).
+func (b *partyRouter) roomOf(gameID domainParty.GameID) (string, bool) {
+	if roomID, ok := b.gameRooms.Load(gameID.String()); ok {
+		return roomID.(string), true
+	}
+
+	game, err := b.gameRepo.FindByID(gameID)
+	if err != nil {
+		log.Printf("[PARTY] Cannot resolve room for game %s: %v", gameID, err)
+		return "", false
+	}
+
+	roomID := game.RoomID().String()
+	b.gameRooms.Store(gameID.String(), roomID)
+	return roomID, true
+}
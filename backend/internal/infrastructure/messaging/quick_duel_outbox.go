@@ -0,0 +1,141 @@
+package messaging
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// QuickDuelOutbox writes duel game events to the transactional outbox.
+// Implements appDuel.EventOutbox.
+// Only DuelGameFinishedEvent is stored; round events only reach the in-process bus,
+// which also pushes them to the lobby and game WebSockets.
+type QuickDuelOutbox struct {
+	writer OutboxWriter
+}
+
+// NewQuickDuelOutbox creates a duel outbox on top of the given writer
+func NewQuickDuelOutbox(writer OutboxWriter) *QuickDuelOutbox {
+	return &QuickDuelOutbox{writer: writer}
+}
+
+// AppendInTx implements appDuel.EventOutbox
+func (o *QuickDuelOutbox) AppendInTx(tx *sql.Tx, events ...quick_duel.Event) error {
+	return appendOutboxEvents(o.writer, tx, events, func(event quick_duel.Event) (string, int64, interface{}, bool) {
+		payload, ok := encodeQuickDuelEvent(event)
+		return event.EventType(), event.OccurredAt(), payload, ok
+	})
+}
+
+// QuickDuelOutboxHandler adapts a handler of duel events to the outbox relay
+func QuickDuelOutboxHandler(handler func(tx *sql.Tx, event quick_duel.Event) error) OutboxHandler {
+	return func(tx *sql.Tx, msg OutboxMessage) error {
+		event, err := DecodeQuickDuelEvent(msg)
+		if err != nil {
+			return err
+		}
+		return handler(tx, event)
+	}
+}
+
+// ========================================
+// Payload codec
+// ========================================
+
+type duelPlayerPayload struct {
+	UserID       string `json:"userId"`
+	Username     string `json:"username"`
+	MMR          int    `json:"mmr"`
+	Score        int    `json:"score"`
+	Connected    bool   `json:"connected"`
+	AnswersCount int    `json:"answersCount"`
+	TotalTimeMs  int64  `json:"totalTimeMs"`
+}
+
+type duelGameFinishedPayload struct {
+	GameID    string            `json:"gameId"`
+	WinnerID  *string           `json:"winnerId,omitempty"`
+	WinReason string            `json:"winReason"`
+	Player1   duelPlayerPayload `json:"player1"`
+	Player2   duelPlayerPayload `json:"player2"`
+}
+
+func encodeDuelPlayer(p quick_duel.DuelPlayer) duelPlayerPayload {
+	return duelPlayerPayload{
+		UserID:       p.UserID().String(),
+		Username:     p.Username(),
+		MMR:          p.MMR(),
+		Score:        p.Score(),
+		Connected:    p.Connected(),
+		AnswersCount: p.AnswersCount(),
+		TotalTimeMs:  p.TotalTimeMs(),
+	}
+}
+
+func decodeDuelPlayer(p duelPlayerPayload) (quick_duel.DuelPlayer, error) {
+	userID, err := shared.NewUserID(p.UserID)
+	if err != nil {
+		return quick_duel.DuelPlayer{}, err
+	}
+	return quick_duel.ReconstructDuelPlayer(userID, p.Username, p.MMR, p.Score, p.Connected, p.AnswersCount, p.TotalTimeMs), nil
+}
+
+func encodeQuickDuelEvent(event quick_duel.Event) (interface{}, bool) {
+	switch e := event.(type) {
+	case quick_duel.DuelGameFinishedEvent:
+		var winnerID *string
+		if e.WinnerID() != nil {
+			id := e.WinnerID().String()
+			winnerID = &id
+		}
+		return duelGameFinishedPayload{
+			GameID:    e.GameID().String(),
+			WinnerID:  winnerID,
+			WinReason: string(e.WinReason()),
+			Player1:   encodeDuelPlayer(e.Player1()),
+			Player2:   encodeDuelPlayer(e.Player2()),
+		}, true
+	}
+
+	return nil, false
+}
+
+// DecodeQuickDuelEvent rebuilds the domain event stored in an outbox message
+func DecodeQuickDuelEvent(msg OutboxMessage) (quick_duel.Event, error) {
+	switch msg.EventType {
+	case "duel_game_finished":
+		var p duelGameFinishedPayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		var winnerID *quick_duel.UserID
+		if p.WinnerID != nil {
+			id, err := shared.NewUserID(*p.WinnerID)
+			if err != nil {
+				return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+			}
+			winnerID = &id
+		}
+		player1, err := decodeDuelPlayer(p.Player1)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		player2, err := decodeDuelPlayer(p.Player2)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		return quick_duel.NewDuelGameFinishedEvent(
+			quick_duel.NewGameIDFromString(p.GameID),
+			winnerID,
+			quick_duel.WinReason(p.WinReason),
+			player1,
+			player2,
+			msg.OccurredAt,
+		), nil
+	}
+
+	return nil, fmt.Errorf("no outbox codec for duel event %q", msg.EventType)
+}
@@ -0,0 +1,96 @@
+package messaging
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// QuizOutbox writes quiz session events to the transactional outbox.
+// Implements appQuiz.EventOutbox.
+// Only QuizCompletedEvent is stored; other events only reach the in-process bus.
+type QuizOutbox struct {
+	writer OutboxWriter
+}
+
+// NewQuizOutbox creates a quiz outbox on top of the given writer
+func NewQuizOutbox(writer OutboxWriter) *QuizOutbox {
+	return &QuizOutbox{writer: writer}
+}
+
+// AppendInTx implements appQuiz.EventOutbox
+func (o *QuizOutbox) AppendInTx(tx *sql.Tx, events ...quiz.Event) error {
+	return appendOutboxEvents(o.writer, tx, events, func(event quiz.Event) (string, int64, interface{}, bool) {
+		payload, ok := encodeQuizEvent(event)
+		return event.EventName(), event.OccurredAt(), payload, ok
+	})
+}
+
+// QuizOutboxHandler adapts a handler of quiz events to the outbox relay
+func QuizOutboxHandler(handler func(tx *sql.Tx, event quiz.Event) error) OutboxHandler {
+	return func(tx *sql.Tx, msg OutboxMessage) error {
+		event, err := DecodeQuizEvent(msg)
+		if err != nil {
+			return err
+		}
+		return handler(tx, event)
+	}
+}
+
+// ========================================
+// Payload codec
+// ========================================
+
+type quizCompletedPayload struct {
+	QuizID     string `json:"quizId"`
+	SessionID  string `json:"sessionId"`
+	UserID     string `json:"userId"`
+	FinalScore int    `json:"finalScore"`
+}
+
+func encodeQuizEvent(event quiz.Event) (interface{}, bool) {
+	switch e := event.(type) {
+	case quiz.QuizCompletedEvent:
+		return quizCompletedPayload{
+			QuizID:     e.QuizID().String(),
+			SessionID:  e.SessionID().String(),
+			UserID:     e.UserID().String(),
+			FinalScore: e.FinalScore().Value(),
+		}, true
+	}
+
+	return nil, false
+}
+
+// DecodeQuizEvent rebuilds the domain event stored in an outbox message
+func DecodeQuizEvent(msg OutboxMessage) (quiz.Event, error) {
+	switch msg.EventType {
+	case "quiz.completed":
+		var p quizCompletedPayload
+		if err := json.Unmarshal(msg.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		quizID, err := quiz.NewQuizIDFromString(p.QuizID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		sessionID, err := quiz.NewSessionIDFromString(p.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		userID, err := shared.NewUserID(p.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		finalScore, err := quiz.NewPoints(p.FinalScore)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", msg.EventType, err)
+		}
+		return quiz.NewQuizCompletedEvent(quizID, sessionID, userID, finalScore, msg.OccurredAt), nil
+	}
+
+	return nil, fmt.Errorf("no outbox codec for quiz event %q", msg.EventType)
+}
@@ -1,6 +1,7 @@
 package memory
 
 import (
+	"database/sql"
 	"sort"
 	"sync"
 
@@ -83,6 +84,11 @@ func (r *QuizRepository) Save(q *quiz.Quiz) error {
 	return nil
 }
 
+// SaveInTx stores a quiz; there are no transactions in memory, so tx is ignored
+func (r *QuizRepository) SaveInTx(_ *sql.Tx, q *quiz.Quiz) error {
+	return r.Save(q)
+}
+
 // Delete removes a quiz
 func (r *QuizRepository) Delete(id quiz.QuizID) error {
 	r.mu.Lock()
@@ -181,6 +187,11 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 	return nil
 }
 
+// SaveInTx stores a session; there are no transactions in memory, so tx is ignored
+func (r *SessionRepository) SaveInTx(_ *sql.Tx, session *quiz.QuizSession) error {
+	return r.Save(session)
+}
+
 // Delete removes a session by ID
 func (r *SessionRepository) Delete(id quiz.SessionID) error {
 	r.mu.Lock()
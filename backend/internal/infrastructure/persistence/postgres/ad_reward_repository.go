@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/ad_reward"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// AdRewardRepository is a PostgreSQL implementation of ad_reward.Repository
+type AdRewardRepository struct {
+	db *sql.DB
+}
+
+// NewAdRewardRepository creates a new PostgreSQL ad reward nonce repository
+func NewAdRewardRepository(db *sql.DB) *AdRewardRepository {
+	return &AdRewardRepository{db: db}
+}
+
+// Save inserts a newly issued nonce
+func (r *AdRewardRepository) Save(nonce *ad_reward.RewardNonce) error {
+	_, err := r.db.Exec(`
+		INSERT INTO ad_reward_nonces (id, player_id, placement, status, transaction_id, issued_at, redeemed_at, consumed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		nonce.ID().String(),
+		nonce.PlayerID().String(),
+		nonce.Placement().String(),
+		string(nonce.Status()),
+		nonce.TransactionID(),
+		nonce.IssuedAt(),
+		nonce.RedeemedAt(),
+		nonce.ConsumedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save ad reward nonce: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a nonce
+func (r *AdRewardRepository) FindByID(id ad_reward.NonceID) (*ad_reward.RewardNonce, error) {
+	var (
+		playerIDStr   string
+		placement     string
+		status        string
+		transactionID string
+		issuedAt      int64
+		redeemedAt    int64
+		consumedAt    int64
+	)
+
+	err := r.db.QueryRow(`
+		SELECT player_id, placement, status, transaction_id, issued_at, redeemed_at, consumed_at
+		FROM ad_reward_nonces WHERE id = $1
+	`, id.String()).Scan(&playerIDStr, &placement, &status, &transactionID, &issuedAt, &redeemedAt, &consumedAt)
+	if err == sql.ErrNoRows {
+		return nil, ad_reward.ErrNonceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ad reward nonce: %w", err)
+	}
+
+	playerID, err := shared.NewUserID(playerIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player ID in ad reward nonce: %w", err)
+	}
+
+	return ad_reward.ReconstructRewardNonce(
+		id,
+		playerID,
+		ad_reward.Placement(placement),
+		ad_reward.NonceStatus(status),
+		transactionID,
+		issuedAt,
+		redeemedAt,
+		consumedAt,
+	), nil
+}
+
+// UpdateFrom persists the nonce's new state if its stored status is still loadedStatus
+func (r *AdRewardRepository) UpdateFrom(nonce *ad_reward.RewardNonce, loadedStatus ad_reward.NonceStatus) error {
+	result, err := r.db.Exec(`
+		UPDATE ad_reward_nonces
+		SET status = $1, transaction_id = $2, redeemed_at = $3, consumed_at = $4
+		WHERE id = $5 AND status = $6
+	`,
+		string(nonce.Status()),
+		nonce.TransactionID(),
+		nonce.RedeemedAt(),
+		nonce.ConsumedAt(),
+		nonce.ID().String(),
+		string(loadedStatus),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update ad reward nonce: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return ad_reward.ErrNonceStateChanged
+	}
+	return nil
+}
+
+// CountConsumedSince counts the player's rewards of a placement consumed since the given time
+func (r *AdRewardRepository) CountConsumedSince(playerID ad_reward.UserID, placement ad_reward.Placement, since int64) (int, error) {
+	var count int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM ad_reward_nonces
+		WHERE player_id = $1 AND placement = $2 AND status = 'consumed' AND consumed_at >= $3
+	`, playerID.String(), placement.String(), since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count consumed ad rewards: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// numericAnswerRecord is the JSONB shape of questions.numeric_answer
+type numericAnswerRecord struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+// marshalNumericAnswer encodes a numeric answer for a JSONB column; none is stored as NULL
+func marshalNumericAnswer(answer quiz.NumericAnswer) (interface{}, error) {
+	if answer.IsEmpty() {
+		return nil, nil
+	}
+	data, err := json.Marshal(numericAnswerRecord{Value: answer.Value(), Tolerance: answer.Tolerance()})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// applyQuestionType sets the stored type and numeric answer (NULL = none) on a question
+func applyQuestionType(question *quiz.Question, questionType string, numericAnswer []byte) error {
+	qType, err := quiz.NewQuestionType(questionType)
+	if err != nil {
+		return err
+	}
+	question.SetType(qType)
+
+	if len(numericAnswer) == 0 {
+		return nil
+	}
+	var record numericAnswerRecord
+	if err := json.Unmarshal(numericAnswer, &record); err != nil {
+		return err
+	}
+	answer, err := quiz.NewNumericAnswer(record.Value, record.Tolerance)
+	if err != nil {
+		return err
+	}
+	question.SetNumericAnswer(answer)
+	return nil
+}
+
+// submissionRecord is the JSON shape of a submission that is not a single option
+// (user_answers.submission, and the submission field of serialized game answers).
+// A single option is stored as the answer ID alone.
+type submissionRecord struct {
+	AnswerIDs []string `json:"answerIds,omitempty"`
+	Number    *float64 `json:"number,omitempty"`
+}
+
+// toSubmissionRecord returns the record of a submission; nil for a single option or none
+func toSubmissionRecord(submission quiz.AnswerSubmission) *submissionRecord {
+	if number, ok := submission.Number(); ok {
+		return &submissionRecord{Number: &number}
+	}
+	ids := submission.AnswerIDs()
+	if len(ids) < 2 {
+		return nil
+	}
+	record := &submissionRecord{AnswerIDs: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		record.AnswerIDs = append(record.AnswerIDs, id.String())
+	}
+	return record
+}
+
+// marshalSubmission encodes a submission for a JSONB column; a single option is stored as NULL
+func marshalSubmission(submission quiz.AnswerSubmission) (interface{}, error) {
+	record := toSubmissionRecord(submission)
+	if record == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// nullableAnswerID stores a zero answer ID (a numeric answer) as NULL
+func nullableAnswerID(id quiz.AnswerID) interface{} {
+	if id.IsZero() {
+		return nil
+	}
+	return id.String()
+}
+
+// submissionFromRecord rebuilds a submission from its stored answer ID and record
+// (nil = a single option; an empty answer ID too = no answer)
+func submissionFromRecord(answerID string, record *submissionRecord) (quiz.AnswerSubmission, error) {
+	if record != nil && record.Number != nil {
+		return quiz.NewNumericSubmission(*record.Number), nil
+	}
+
+	raw := []string{answerID}
+	if record != nil && len(record.AnswerIDs) > 0 {
+		raw = record.AnswerIDs
+	} else if answerID == "" {
+		return quiz.AnswerSubmission{}, nil
+	}
+
+	ids := make([]quiz.AnswerID, 0, len(raw))
+	for _, value := range raw {
+		id, err := quiz.NewAnswerIDFromString(value)
+		if err != nil {
+			return quiz.AnswerSubmission{}, err
+		}
+		ids = append(ids, id)
+	}
+	return quiz.NewChoiceSubmission(ids...), nil
+}
+
+// parseSubmission decodes a submission JSONB column together with its answer ID column
+func parseSubmission(answerID string, data []byte) (quiz.AnswerSubmission, error) {
+	if len(data) == 0 {
+		return submissionFromRecord(answerID, nil)
+	}
+	var record submissionRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return quiz.AnswerSubmission{}, err
+	}
+	return submissionFromRecord(answerID, &record)
+}
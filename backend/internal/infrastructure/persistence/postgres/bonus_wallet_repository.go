@@ -26,6 +26,22 @@ func (r *BonusWalletRepository) FindByPlayer(playerID solo_marathon.UserID) (*so
 		WHERE player_id = $1
 	`
 
+	return r.scanWallet(r.db.QueryRow(query, playerID.String()))
+}
+
+// FindByPlayerForUpdate retrieves the bonus wallet and locks it until tx ends
+func (r *BonusWalletRepository) FindByPlayerForUpdate(tx *sql.Tx, playerID solo_marathon.UserID) (*solo_marathon.BonusWallet, error) {
+	query := `
+		SELECT player_id, bonus_shield, bonus_fifty_fifty, bonus_skip, bonus_freeze
+		FROM player_bonus_wallet
+		WHERE player_id = $1
+		FOR UPDATE
+	`
+
+	return r.scanWallet(tx.QueryRow(query, playerID.String()))
+}
+
+func (r *BonusWalletRepository) scanWallet(row *sql.Row) (*solo_marathon.BonusWallet, error) {
 	var (
 		dbPlayerID string
 		shield     int
@@ -34,7 +50,7 @@ func (r *BonusWalletRepository) FindByPlayer(playerID solo_marathon.UserID) (*so
 		freeze     int
 	)
 
-	err := r.db.QueryRow(query, playerID.String()).Scan(
+	err := row.Scan(
 		&dbPlayerID, &shield, &fiftyFifty, &skip, &freeze,
 	)
 
@@ -55,6 +71,17 @@ func (r *BonusWalletRepository) FindByPlayer(playerID solo_marathon.UserID) (*so
 
 // Save persists a bonus wallet (upsert)
 func (r *BonusWalletRepository) Save(wallet *solo_marathon.BonusWallet) error {
+	return r.save(r.db, wallet)
+}
+
+// SaveInTx persists a bonus wallet within an existing transaction
+func (r *BonusWalletRepository) SaveInTx(tx *sql.Tx, wallet *solo_marathon.BonusWallet) error {
+	return r.save(tx, wallet)
+}
+
+func (r *BonusWalletRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, wallet *solo_marathon.BonusWallet) error {
 	query := `
 		INSERT INTO player_bonus_wallet (player_id, bonus_shield, bonus_fifty_fifty, bonus_skip, bonus_freeze, updated_at)
 		VALUES ($1, $2, $3, $4, $5, NOW())
@@ -66,7 +93,7 @@ func (r *BonusWalletRepository) Save(wallet *solo_marathon.BonusWallet) error {
 			updated_at = NOW()
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := db.Exec(query,
 		wallet.PlayerID().String(),
 		wallet.Shield(),
 		wallet.FiftyFifty(),
@@ -3,6 +3,7 @@ package postgres
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
@@ -17,14 +18,16 @@ func NewCategoryRepository(db *sql.DB) *CategoryRepository {
 	return &CategoryRepository{db: db}
 }
 
-// FindByID retrieves a category by its ID.
+// FindByID retrieves a category by its ID, including a soft-deleted one.
 func (r *CategoryRepository) FindByID(id quiz.CategoryID) (*quiz.Category, error) {
 	var (
-		idStr string
-		name  string
+		idStr        string
+		name         string
+		translations []byte
+		deletedAt    sql.NullInt64
 	)
-	query := `SELECT id, name FROM categories WHERE id = $1`
-	err := r.db.QueryRow(query, id.String()).Scan(&idStr, &name)
+	query := `SELECT id, name, translations, deleted_at FROM categories WHERE id = $1`
+	err := r.db.QueryRow(query, id.String()).Scan(&idStr, &name, &translations, &deletedAt)
 	if err == sql.ErrNoRows {
 		return nil, quiz.ErrCategoryNotFound // I need to add this error
 	}
@@ -42,12 +45,21 @@ func (r *CategoryRepository) FindByID(id quiz.CategoryID) (*quiz.Category, error
 		return nil, err
 	}
 
-	return quiz.ReconstructCategory(catID, catName), nil
+	category := quiz.ReconstructCategory(catID, catName)
+	if err := applyCategoryTranslations(category, translations); err != nil {
+		return nil, fmt.Errorf("invalid category translations: %w", err)
+	}
+	if deletedAt.Valid {
+		if err := category.Delete(deletedAt.Int64); err != nil {
+			return nil, err
+		}
+	}
+	return category, nil
 }
 
-// FindAll retrieves all categories from the database.
+// FindAll retrieves all live categories from the database.
 func (r *CategoryRepository) FindAll() ([]*quiz.Category, error) {
-	query := `SELECT id, name FROM categories ORDER BY name ASC`
+	query := `SELECT id, name, translations FROM categories WHERE deleted_at IS NULL ORDER BY name ASC`
 	rows, err := r.db.Query(query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query categories: %w", err)
@@ -57,10 +69,11 @@ func (r *CategoryRepository) FindAll() ([]*quiz.Category, error) {
 	var categories []*quiz.Category
 	for rows.Next() {
 		var (
-			idStr string
-			name  string
+			idStr        string
+			name         string
+			translations []byte
 		)
-		if err := rows.Scan(&idStr, &name); err != nil {
+		if err := rows.Scan(&idStr, &name, &translations); err != nil {
 			return nil, fmt.Errorf("failed to scan category row: %w", err)
 		}
 
@@ -73,7 +86,12 @@ func (r *CategoryRepository) FindAll() ([]*quiz.Category, error) {
 			return nil, err
 		}
 
-		categories = append(categories, quiz.ReconstructCategory(catID, catName))
+		category := quiz.ReconstructCategory(catID, catName)
+		if err := applyCategoryTranslations(category, translations); err != nil {
+			return nil, fmt.Errorf("invalid category translations: %w", err)
+		}
+
+		categories = append(categories, category)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -83,10 +101,10 @@ func (r *CategoryRepository) FindAll() ([]*quiz.Category, error) {
 	return categories, nil
 }
 
-// Delete removes a category from the database.
+// Delete soft-deletes a category (deleting it again keeps the first timestamp).
 func (r *CategoryRepository) Delete(id quiz.CategoryID) error {
-	query := `DELETE FROM categories WHERE id = $1`
-	result, err := r.db.Exec(query, id.String())
+	query := `UPDATE categories SET deleted_at = COALESCE(deleted_at, $2) WHERE id = $1`
+	result, err := r.db.Exec(query, id.String(), time.Now().Unix())
 	if err != nil {
 		return fmt.Errorf("failed to delete category: %w", err)
 	}
@@ -104,12 +122,28 @@ func (r *CategoryRepository) Delete(id quiz.CategoryID) error {
 
 // Save inserts or updates a category in the database.
 func (r *CategoryRepository) Save(category *quiz.Category) error {
+	return r.save(r.db, category)
+}
+
+// SaveInTx inserts or updates a category within an existing transaction.
+func (r *CategoryRepository) SaveInTx(tx *sql.Tx, category *quiz.Category) error {
+	return r.save(tx, category)
+}
+
+func (r *CategoryRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, category *quiz.Category) error {
+	translations, err := marshalCategoryTranslations(category.Translations())
+	if err != nil {
+		return fmt.Errorf("failed to encode category translations: %w", err)
+	}
+
 	query := `
-		INSERT INTO categories (id, name)
-		VALUES ($1, $2)
-		ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name
+		INSERT INTO categories (id, name, translations, deleted_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, translations = EXCLUDED.translations, deleted_at = EXCLUDED.deleted_at
 	`
-	_, err := r.db.Exec(query, category.ID().String(), category.Name().String())
+	_, err = db.Exec(query, category.ID().String(), category.Name().String(), translations, nullableTimestamp(category.DeletedAt()))
 	if err != nil {
 		return fmt.Errorf("failed to save category: %w", err)
 	}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// ContentAuditRepository is a PostgreSQL implementation of quiz.ContentAuditRepository
+type ContentAuditRepository struct {
+	db *sql.DB
+}
+
+// NewContentAuditRepository creates a new PostgreSQL content audit repository
+func NewContentAuditRepository(db *sql.DB) *ContentAuditRepository {
+	return &ContentAuditRepository{db: db}
+}
+
+// Append stores an audit entry
+func (r *ContentAuditRepository) Append(entry *quiz.ContentAuditEntry) error {
+	return r.append(r.db, entry)
+}
+
+// AppendInTx stores an audit entry within the transaction that saves the edit
+func (r *ContentAuditRepository) AppendInTx(tx *sql.Tx, entry *quiz.ContentAuditEntry) error {
+	return r.append(tx, entry)
+}
+
+func (r *ContentAuditRepository) append(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, entry *quiz.ContentAuditEntry) error {
+	changes, err := json.Marshal(entry.Changes())
+	if err != nil {
+		return fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+
+	query := `
+		INSERT INTO content_audit_log (id, entity_type, entity_id, action, actor, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = db.Exec(
+		query,
+		entry.ID().String(),
+		entry.EntityType().String(),
+		entry.EntityID(),
+		entry.Action().String(),
+		entry.Actor(),
+		changes,
+		entry.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// FindByEntity retrieves an entity's audit entries, newest first
+func (r *ContentAuditRepository) FindByEntity(entityType quiz.ContentEntityType, entityID string, limit int) ([]*quiz.ContentAuditEntry, error) {
+	query := `
+		SELECT id, entity_type, entity_id, action, actor, changes, created_at
+		FROM content_audit_log
+		WHERE entity_type = $1 AND entity_id = $2
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(query, entityType.String(), entityID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanEntries(rows)
+}
+
+// FindRecent retrieves the latest audit entries across all content, newest first
+func (r *ContentAuditRepository) FindRecent(limit int) ([]*quiz.ContentAuditEntry, error) {
+	query := `
+		SELECT id, entity_type, entity_id, action, actor, changes, created_at
+		FROM content_audit_log
+		ORDER BY created_at DESC
+		LIMIT $1
+	`
+
+	rows, err := r.db.Query(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanEntries(rows)
+}
+
+// scanEntries scans audit log rows into entries
+func (r *ContentAuditRepository) scanEntries(rows *sql.Rows) ([]*quiz.ContentAuditEntry, error) {
+	var entries []*quiz.ContentAuditEntry
+
+	for rows.Next() {
+		var (
+			idStr      string
+			entityType string
+			entityID   string
+			action     string
+			actor      string
+			changesRaw []byte
+			createdAt  int64
+		)
+
+		if err := rows.Scan(&idStr, &entityType, &entityID, &action, &actor, &changesRaw, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+
+		id, err := shared.NewIDFromString(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid audit entry ID: %w", err)
+		}
+
+		changes := make(map[string]quiz.FieldChange)
+		if len(changesRaw) > 0 {
+			if err := json.Unmarshal(changesRaw, &changes); err != nil {
+				return nil, fmt.Errorf("invalid audit changes: %w", err)
+			}
+		}
+
+		entries = append(entries, quiz.ReconstructContentAuditEntry(
+			id,
+			quiz.ContentEntityType(entityType),
+			entityID,
+			quiz.ContentAction(action),
+			actor,
+			changes,
+			createdAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit entry rows: %w", err)
+	}
+
+	return entries, nil
+}
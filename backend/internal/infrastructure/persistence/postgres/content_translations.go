@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// Translations columns (quizzes, questions, answers, categories) map a locale to the
+// translated text; NULL = default locale only
+
+// quizTranslationRecord is one locale of quizzes.translations
+type quizTranslationRecord struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// questionTranslationRecord is one locale of questions.translations
+type questionTranslationRecord struct {
+	Text        string `json:"text"`
+	Explanation string `json:"explanation,omitempty"`
+}
+
+// marshalTranslations encodes a translations JSONB column; records is a map by locale,
+// and none (count 0) is stored as NULL
+func marshalTranslations(records interface{}, count int) (interface{}, error) {
+	if count == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseTranslations decodes a translations JSONB column (NULL = none) into records
+func parseTranslations(data []byte, records interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, records)
+}
+
+func marshalQuizTranslations(translations map[string]quiz.QuizTranslation) (interface{}, error) {
+	records := make(map[string]quizTranslationRecord, len(translations))
+	for locale, t := range translations {
+		records[locale] = quizTranslationRecord{Title: t.Title().String(), Description: t.Description()}
+	}
+	return marshalTranslations(records, len(records))
+}
+
+// quizTranslator is a quiz or a quiz summary
+type quizTranslator interface {
+	SetTranslation(locale string, translation quiz.QuizTranslation) error
+}
+
+func applyQuizTranslations(q quizTranslator, data []byte) error {
+	var records map[string]quizTranslationRecord
+	if err := parseTranslations(data, &records); err != nil {
+		return err
+	}
+	for locale, record := range records {
+		translation, err := quiz.NewQuizTranslation(record.Title, record.Description)
+		if err != nil {
+			return err
+		}
+		if err := q.SetTranslation(locale, translation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalQuestionTranslations(translations map[string]quiz.QuestionTranslation) (interface{}, error) {
+	records := make(map[string]questionTranslationRecord, len(translations))
+	for locale, t := range translations {
+		records[locale] = questionTranslationRecord{Text: t.Text().String(), Explanation: t.Explanation()}
+	}
+	return marshalTranslations(records, len(records))
+}
+
+func applyQuestionTranslations(q *quiz.Question, data []byte) error {
+	var records map[string]questionTranslationRecord
+	if err := parseTranslations(data, &records); err != nil {
+		return err
+	}
+	for locale, record := range records {
+		translation, err := quiz.NewQuestionTranslation(record.Text, record.Explanation)
+		if err != nil {
+			return err
+		}
+		if err := q.SetTranslation(locale, translation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalAnswerTranslations(translations map[string]quiz.AnswerText) (interface{}, error) {
+	records := make(map[string]string, len(translations))
+	for locale, text := range translations {
+		records[locale] = text.String()
+	}
+	return marshalTranslations(records, len(records))
+}
+
+func applyAnswerTranslations(a *quiz.Answer, data []byte) error {
+	var records map[string]string
+	if err := parseTranslations(data, &records); err != nil {
+		return err
+	}
+	for locale, record := range records {
+		text, err := quiz.NewAnswerText(record)
+		if err != nil {
+			return err
+		}
+		if err := a.SetTranslation(locale, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalCategoryTranslations(translations map[string]quiz.CategoryName) (interface{}, error) {
+	records := make(map[string]string, len(translations))
+	for locale, name := range translations {
+		records[locale] = name.String()
+	}
+	return marshalTranslations(records, len(records))
+}
+
+func applyCategoryTranslations(c *quiz.Category, data []byte) error {
+	var records map[string]string
+	if err := parseTranslations(data, &records); err != nil {
+		return err
+	}
+	for locale, record := range records {
+		name, err := quiz.NewCategoryName(record)
+		if err != nil {
+			return err
+		}
+		if err := c.SetTranslation(locale, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
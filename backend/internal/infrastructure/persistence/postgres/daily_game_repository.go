@@ -37,6 +37,17 @@ func NewDailyGameRepository(
 
 // Save persists a daily game
 func (r *DailyGameRepository) Save(game *daily_challenge.DailyGame) error {
+	return r.save(r.db, game)
+}
+
+// SaveInTx persists a daily game within an existing transaction
+func (r *DailyGameRepository) SaveInTx(tx *sql.Tx, game *daily_challenge.DailyGame) error {
+	return r.save(tx, game)
+}
+
+func (r *DailyGameRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, game *daily_challenge.DailyGame) error {
 	session := game.Session()
 
 	// Serialize session state
@@ -102,7 +113,7 @@ func (r *DailyGameRepository) Save(game *daily_challenge.DailyGame) error {
 			question_started_at = EXCLUDED.question_started_at
 	`
 
-	_, err = r.db.Exec(query,
+	_, err = db.Exec(query,
 		game.ID().String(),
 		game.PlayerID().String(),
 		game.DailyQuizID().String(),
@@ -202,13 +213,15 @@ func (r *DailyGameRepository) FindTopByDate(date daily_challenge.Date, limit int
 	return games, rows.Err()
 }
 
-// FindTopByDateAndFriends retrieves top N results for a date filtered to player's friends (referrals)
+// FindTopByDateAndFriends retrieves top N results for a date among the player and their accepted friends
 func (r *DailyGameRepository) FindTopByDateAndFriends(date daily_challenge.Date, playerID daily_challenge.UserID, limit int) ([]*daily_challenge.DailyGame, error) {
 	query := `
 		WITH friend_ids AS (
-			SELECT invitee_id AS friend_id FROM referrals WHERE inviter_id = $2
+			SELECT $2::text AS friend_id
+			UNION
+			SELECT addressee_id FROM friendships WHERE requester_id = $2 AND status = 'accepted'
 			UNION
-			SELECT inviter_id AS friend_id FROM referrals WHERE invitee_id = $2
+			SELECT requester_id FROM friendships WHERE addressee_id = $2 AND status = 'accepted'
 		),
 		best_attempts AS (
 			SELECT DISTINCT ON (dg.player_id)
@@ -655,13 +668,13 @@ func (r *DailyGameRepository) deserializeDailyChallengeSession(
 			return nil, fmt.Errorf("invalid question_id: %w", err)
 		}
 
-		answerID, err := quiz.NewAnswerIDFromString(serializedAnswer.AnswerID)
+		submission, err := submissionFromRecord(serializedAnswer.AnswerID, serializedAnswer.Submission)
 		if err != nil {
 			return nil, fmt.Errorf("invalid answer_id: %w", err)
 		}
 
 		userAnswers[questionID] = kernel.NewAnswerData(
-			answerID,
+			submission,
 			serializedAnswer.IsCorrect,
 			serializedAnswer.TimeTaken,
 			serializedAnswer.AnsweredAt,
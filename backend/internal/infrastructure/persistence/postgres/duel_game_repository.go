@@ -4,12 +4,28 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"strconv"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 )
 
+// duelRoundAnswerJSON is the JSONB representation of quick_duel.RoundAnswer.
+// AnswerID is empty when the player timed out (or answered with a number, kept in
+// Submission with any other answer but a single option). Answers recorded before
+// server-side timing have no client_time_taken: their time_taken is the client's figure.
+type duelRoundAnswerJSON struct {
+	PlayerID        string            `json:"player_id"`
+	AnswerID        string            `json:"answer_id"`
+	Submission      *submissionRecord `json:"submission,omitempty"`
+	TimeTaken       int64             `json:"time_taken"`
+	ClientTimeTaken *int64            `json:"client_time_taken,omitempty"`
+	TimingFlagged   bool              `json:"timing_flagged,omitempty"`
+	IsCorrect       bool              `json:"is_correct"`
+	Points          int               `json:"points"`
+}
+
 type DuelGameRepository struct {
 	db *sql.DB
 }
@@ -18,13 +34,64 @@ func NewDuelGameRepository(db *sql.DB) *DuelGameRepository {
 	return &DuelGameRepository{db: db}
 }
 
+const duelGameColumns = `id, status, player1_id, player2_id,
+			player1_score, player2_score, player1_total_time, player2_total_time,
+			player1_mmr_before, player2_mmr_before,
+			winner_id, win_reason, is_friend_match,
+			current_round, question_ids, round_answers, question_sent_at,
+			started_at, finished_at`
+
 func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
+	return r.save(r.db, game)
+}
+
+// SaveInTx persists a duel game within an existing transaction
+func (r *DuelGameRepository) SaveInTx(tx *sql.Tx, game *quick_duel.DuelGame) error {
+	return r.save(tx, game)
+}
+
+func (r *DuelGameRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, game *quick_duel.DuelGame) error {
 	questionIDsJSON, err := json.Marshal(questionIDsToStrings(game.QuestionIDs()))
 	if err != nil {
 		return err
 	}
 
-	roundAnswersJSON, err := json.Marshal(map[string]interface{}{})
+	// JSON object keys must be strings: round number -> answers
+	roundAnswers := make(map[string][]duelRoundAnswerJSON, len(game.RoundAnswers()))
+	for round, answers := range game.RoundAnswers() {
+		records := make([]duelRoundAnswerJSON, 0, len(answers))
+		for _, a := range answers {
+			record := duelRoundAnswerJSON{
+				PlayerID:      a.PlayerID().String(),
+				TimeTaken:     a.TimeTaken(),
+				TimingFlagged: a.TimingFlagged(),
+				IsCorrect:     a.IsCorrect(),
+				Points:        a.Points(),
+			}
+			if !a.IsTimeout() {
+				if answerID := a.AnswerID(); !answerID.IsZero() {
+					record.AnswerID = answerID.String()
+				}
+				record.Submission = toSubmissionRecord(a.Submission())
+				clientTimeTaken := a.ClientTimeTaken()
+				record.ClientTimeTaken = &clientTimeTaken
+			}
+			records = append(records, record)
+		}
+		roundAnswers[strconv.Itoa(round)] = records
+	}
+	roundAnswersJSON, err := json.Marshal(roundAnswers)
+	if err != nil {
+		return err
+	}
+
+	questionSentAt := make(map[string]int64, len(game.QuestionSentTimes()))
+	for round, sentAt := range game.QuestionSentTimes() {
+		questionSentAt[strconv.Itoa(round)] = sentAt
+	}
+	questionSentAtJSON, err := json.Marshal(questionSentAt)
 	if err != nil {
 		return err
 	}
@@ -35,8 +102,8 @@ func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
 			player1_score, player2_score, player1_total_time, player2_total_time,
 			player1_mmr_before, player2_mmr_before, player1_mmr_after, player2_mmr_after,
 			win_reason, is_friend_match, current_round,
-			question_ids, round_answers, started_at, finished_at, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+			question_ids, round_answers, question_sent_at, started_at, finished_at, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 		ON CONFLICT (id) DO UPDATE SET
 			status = EXCLUDED.status,
 			winner_id = EXCLUDED.winner_id,
@@ -47,25 +114,30 @@ func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
 			player1_mmr_after = EXCLUDED.player1_mmr_after,
 			player2_mmr_after = EXCLUDED.player2_mmr_after,
 			win_reason = EXCLUDED.win_reason,
+			is_friend_match = EXCLUDED.is_friend_match,
 			current_round = EXCLUDED.current_round,
 			round_answers = EXCLUDED.round_answers,
+			question_sent_at = EXCLUDED.question_sent_at,
 			started_at = EXCLUDED.started_at,
 			finished_at = EXCLUDED.finished_at
 	`
 
 	var winnerID *string
-	if game.Player1().Score() > game.Player2().Score() {
-		id := game.Player1().UserID().String()
-		winnerID = &id
-	} else if game.Player2().Score() > game.Player1().Score() {
-		id := game.Player2().UserID().String()
+	if winner := game.WinnerID(); winner != nil {
+		id := winner.String()
 		winnerID = &id
 	}
 
+	var winReason *string
+	if game.WinReason() != "" {
+		reason := string(game.WinReason())
+		winReason = &reason
+	}
+
 	var player1MMRAfter, player2MMRAfter *int
 	if game.Status() == quick_duel.GameStatusFinished {
-		p1mmr := game.Player1().Elo().Rating()
-		p2mmr := game.Player2().Elo().Rating()
+		p1mmr := game.Player1().MMR()
+		p2mmr := game.Player2().MMR()
 		player1MMRAfter = &p1mmr
 		player2MMRAfter = &p2mmr
 	}
@@ -80,7 +152,7 @@ func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
 		finishedAt = &fa
 	}
 
-	_, err = r.db.Exec(query,
+	_, err = db.Exec(query,
 		game.ID().String(),
 		string(game.Status()),
 		game.Player1().UserID().String(),
@@ -88,17 +160,18 @@ func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
 		winnerID,
 		game.Player1().Score(),
 		game.Player2().Score(),
-		0, // player1_total_time (TODO)
-		0, // player2_total_time (TODO)
-		game.Player1().Elo().Rating(),
-		game.Player2().Elo().Rating(),
+		game.Player1().TotalTimeMs(),
+		game.Player2().TotalTimeMs(),
+		game.Player1().MMR(),
+		game.Player2().MMR(),
 		player1MMRAfter,
 		player2MMRAfter,
-		nil, // win_reason (TODO)
-		false, // is_friend_match (TODO)
+		winReason,
+		game.IsFriendMatch(),
 		game.CurrentRound(),
 		questionIDsJSON,
 		roundAnswersJSON,
+		questionSentAtJSON,
 		startedAt,
 		finishedAt,
 		game.StartedAt(),
@@ -109,11 +182,7 @@ func (r *DuelGameRepository) Save(game *quick_duel.DuelGame) error {
 
 func (r *DuelGameRepository) FindByID(id quick_duel.GameID) (*quick_duel.DuelGame, error) {
 	query := `
-		SELECT id, status, player1_id, player2_id,
-			player1_score, player2_score,
-			player1_mmr_before, player2_mmr_before,
-			current_round, question_ids, round_answers,
-			started_at, finished_at
+		SELECT ` + duelGameColumns + `
 		FROM duel_matches
 		WHERE id = $1
 	`
@@ -121,13 +190,20 @@ func (r *DuelGameRepository) FindByID(id quick_duel.GameID) (*quick_duel.DuelGam
 	return r.scanGame(r.db.QueryRow(query, id.String()))
 }
 
+func (r *DuelGameRepository) FindByIDForUpdate(tx *sql.Tx, id quick_duel.GameID) (*quick_duel.DuelGame, error) {
+	query := `
+		SELECT ` + duelGameColumns + `
+		FROM duel_matches
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	return r.scanGame(tx.QueryRow(query, id.String()))
+}
+
 func (r *DuelGameRepository) FindActiveByPlayer(playerID quick_duel.UserID) (*quick_duel.DuelGame, error) {
 	query := `
-		SELECT id, status, player1_id, player2_id,
-			player1_score, player2_score,
-			player1_mmr_before, player2_mmr_before,
-			current_round, question_ids, round_answers,
-			started_at, finished_at
+		SELECT ` + duelGameColumns + `
 		FROM duel_matches
 		WHERE (player1_id = $1 OR player2_id = $1)
 		AND status IN ('waiting_start', 'in_progress')
@@ -164,11 +240,7 @@ func (r *DuelGameRepository) FindByPlayerPaginated(playerID quick_duel.UserID, l
 
 	// Get paginated results
 	query := `
-		SELECT id, status, player1_id, player2_id,
-			player1_score, player2_score,
-			player1_mmr_before, player2_mmr_before,
-			current_round, question_ids, round_answers,
-			started_at, finished_at
+		SELECT ` + duelGameColumns + `
 	` + baseQuery + `
 		ORDER BY finished_at DESC
 		LIMIT $2 OFFSET $3
@@ -207,31 +279,39 @@ func (r *DuelGameRepository) Delete(id quick_duel.GameID) error {
 	return err
 }
 
-func (r *DuelGameRepository) scanGame(row *sql.Row) (*quick_duel.DuelGame, error) {
+func (r *DuelGameRepository) scanGame(row interface {
+	Scan(dest ...interface{}) error
+}) (*quick_duel.DuelGame, error) {
 	var (
-		id             string
-		status         string
-		player1ID      string
-		player2ID      string
-		player1Score   int
-		player2Score   int
-		player1MMR     int
-		player2MMR     int
-		currentRound   int
-		questionIDsJSON []byte
+		id               string
+		status           string
+		player1ID        string
+		player2ID        string
+		player1Score     int
+		player2Score     int
+		player1TotalTime int64
+		player2TotalTime int64
+		player1MMR       int
+		player2MMR       int
+		winnerIDStr      sql.NullString
+		winReason        sql.NullString
+		isFriendMatch    bool
+		currentRound     int
+		questionIDsJSON  []byte
 		roundAnswersJSON []byte
-		startedAt      sql.NullInt64
-		finishedAt     sql.NullInt64
+		sentAtJSON       []byte
+		startedAt        sql.NullInt64
+		finishedAt       sql.NullInt64
 	)
 
 	err := row.Scan(
 		&id, &status, &player1ID, &player2ID,
-		&player1Score, &player2Score,
+		&player1Score, &player2Score, &player1TotalTime, &player2TotalTime,
 		&player1MMR, &player2MMR,
-		&currentRound, &questionIDsJSON, &roundAnswersJSON,
+		&winnerIDStr, &winReason, &isFriendMatch,
+		&currentRound, &questionIDsJSON, &roundAnswersJSON, &sentAtJSON,
 		&startedAt, &finishedAt,
 	)
-
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, quick_duel.ErrGameNotFound
 	}
@@ -239,71 +319,6 @@ func (r *DuelGameRepository) scanGame(row *sql.Row) (*quick_duel.DuelGame, error
 		return nil, err
 	}
 
-	return r.reconstructGame(
-		id, status, player1ID, player2ID,
-		player1Score, player2Score,
-		player1MMR, player2MMR,
-		currentRound, questionIDsJSON, roundAnswersJSON,
-		startedAt, finishedAt,
-	)
-}
-
-func (r *DuelGameRepository) scanGames(rows *sql.Rows) ([]*quick_duel.DuelGame, error) {
-	var games []*quick_duel.DuelGame
-
-	for rows.Next() {
-		var (
-			id             string
-			status         string
-			player1ID      string
-			player2ID      string
-			player1Score   int
-			player2Score   int
-			player1MMR     int
-			player2MMR     int
-			currentRound   int
-			questionIDsJSON []byte
-			roundAnswersJSON []byte
-			startedAt      sql.NullInt64
-			finishedAt     sql.NullInt64
-		)
-
-		err := rows.Scan(
-			&id, &status, &player1ID, &player2ID,
-			&player1Score, &player2Score,
-			&player1MMR, &player2MMR,
-			&currentRound, &questionIDsJSON, &roundAnswersJSON,
-			&startedAt, &finishedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		game, err := r.reconstructGame(
-			id, status, player1ID, player2ID,
-			player1Score, player2Score,
-			player1MMR, player2MMR,
-			currentRound, questionIDsJSON, roundAnswersJSON,
-			startedAt, finishedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		games = append(games, game)
-	}
-
-	return games, nil
-}
-
-func (r *DuelGameRepository) reconstructGame(
-	id, status, player1ID, player2ID string,
-	player1Score, player2Score int,
-	player1MMR, player2MMR int,
-	currentRound int,
-	questionIDsJSON, roundAnswersJSON []byte,
-	startedAt, finishedAt sql.NullInt64,
-) (*quick_duel.DuelGame, error) {
 	// Parse question IDs
 	var questionIDStrs []string
 	if err := json.Unmarshal(questionIDsJSON, &questionIDStrs); err != nil {
@@ -316,27 +331,46 @@ func (r *DuelGameRepository) reconstructGame(
 		questionIDs = append(questionIDs, qid)
 	}
 
+	roundAnswers, err := parseDuelRoundAnswers(roundAnswersJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	questionSentAt, err := parseDuelQuestionSentAt(sentAtJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create players
 	p1id, _ := shared.NewUserID(player1ID)
 	p2id, _ := shared.NewUserID(player2ID)
 
-	player1 := quick_duel.NewDuelPlayer(
+	player1 := quick_duel.ReconstructDuelPlayer(
 		p1id,
 		"Player1", // TODO: get username
-		quick_duel.ReconstructEloRating(player1MMR, 0),
+		player1MMR,
+		player1Score,
+		true,
+		countAnsweredRounds(roundAnswers, p1id),
+		player1TotalTime,
 	)
-	// Simulate adding score (time unknown from DB, use 0)
-	for i := 0; i < player1Score/100; i++ {
-		player1 = player1.AddScore(100, 0)
-	}
-
-	player2 := quick_duel.NewDuelPlayer(
+	player2 := quick_duel.ReconstructDuelPlayer(
 		p2id,
 		"Player2",
-		quick_duel.ReconstructEloRating(player2MMR, 0),
+		player2MMR,
+		player2Score,
+		true,
+		countAnsweredRounds(roundAnswers, p2id),
+		player2TotalTime,
 	)
-	for i := 0; i < player2Score/100; i++ {
-		player2 = player2.AddScore(100, 0)
+
+	var winnerID *quick_duel.UserID
+	if winnerIDStr.Valid {
+		wid, err := shared.NewUserID(winnerIDStr.String)
+		if err != nil {
+			return nil, err
+		}
+		winnerID = &wid
 	}
 
 	var sa, fa int64
@@ -354,12 +388,103 @@ func (r *DuelGameRepository) reconstructGame(
 		questionIDs,
 		currentRound,
 		quick_duel.GameStatus(status),
-		make(map[int][]quick_duel.RoundAnswer), // TODO: parse round answers
+		roundAnswers,
+		questionSentAt,
 		sa,
 		fa,
+		winnerID,
+		quick_duel.WinReason(winReason.String),
+		isFriendMatch,
 	), nil
 }
 
+func (r *DuelGameRepository) scanGames(rows *sql.Rows) ([]*quick_duel.DuelGame, error) {
+	var games []*quick_duel.DuelGame
+
+	for rows.Next() {
+		game, err := r.scanGame(rows)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, game)
+	}
+
+	return games, rows.Err()
+}
+
+// parseDuelRoundAnswers decodes the round_answers JSONB column
+func parseDuelRoundAnswers(data []byte) (map[int][]quick_duel.RoundAnswer, error) {
+	var records map[string][]duelRoundAnswerJSON
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, err
+		}
+	}
+
+	roundAnswers := make(map[int][]quick_duel.RoundAnswer, len(records))
+	for key, roundRecords := range records {
+		round, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]quick_duel.RoundAnswer, 0, len(roundRecords))
+		for _, a := range roundRecords {
+			playerID, err := shared.NewUserID(a.PlayerID)
+			if err != nil {
+				return nil, err
+			}
+			submission, err := submissionFromRecord(a.AnswerID, a.Submission) // empty = timed out
+			if err != nil {
+				return nil, err
+			}
+			clientTimeTaken := a.TimeTaken
+			if a.ClientTimeTaken != nil {
+				clientTimeTaken = *a.ClientTimeTaken
+			}
+			answers = append(answers, quick_duel.ReconstructRoundAnswer(
+				playerID, submission, a.TimeTaken, clientTimeTaken, a.TimingFlagged, a.IsCorrect, a.Points,
+			))
+		}
+		roundAnswers[round] = answers
+	}
+
+	return roundAnswers, nil
+}
+
+// parseDuelQuestionSentAt decodes the question_sent_at JSONB column
+func parseDuelQuestionSentAt(data []byte) (map[int]int64, error) {
+	var records map[string]int64
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, err
+		}
+	}
+
+	sentAt := make(map[int]int64, len(records))
+	for key, at := range records {
+		round, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, err
+		}
+		sentAt[round] = at
+	}
+
+	return sentAt, nil
+}
+
+// countAnsweredRounds counts the rounds the player actually answered (timeouts excluded)
+func countAnsweredRounds(roundAnswers map[int][]quick_duel.RoundAnswer, playerID quick_duel.UserID) int {
+	count := 0
+	for _, answers := range roundAnswers {
+		for _, a := range answers {
+			if a.PlayerID().Equals(playerID) && !a.IsTimeout() {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 func (r *DuelGameRepository) FindRecentOpponents(playerID quick_duel.UserID, limit int) ([]quick_duel.RecentOpponentEntry, error) {
 	query := `
 		SELECT
@@ -20,6 +20,8 @@ func NewDuelQuestionRepositoryAdapter(repo duelQuestionSource) *DuelQuestionRepo
 	return &DuelQuestionRepositoryAdapter{repo: repo}
 }
 
+// FindRandomByDifficulty picks random questions of the given difficulty.
+// If the catalog has too few of them, the rest is topped up with questions of any difficulty.
 func (a *DuelQuestionRepositoryAdapter) FindRandomByDifficulty(count int, difficulty string) ([]appDuel.QuestionData, error) {
 	filter := quiz.NewQuestionFilter().WithDifficulty(difficulty)
 	questions, err := a.repo.FindRandomQuestions(filter, count)
@@ -27,6 +29,18 @@ func (a *DuelQuestionRepositoryAdapter) FindRandomByDifficulty(count int, diffic
 		return nil, err
 	}
 
+	if len(questions) < count {
+		picked := make([]quiz.QuestionID, 0, len(questions))
+		for _, q := range questions {
+			picked = append(picked, q.ID())
+		}
+		rest, err := a.repo.FindRandomQuestions(quiz.NewQuestionFilter().WithExcludeIDs(picked), count-len(questions))
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, rest...)
+	}
+
 	result := make([]appDuel.QuestionData, 0, len(questions))
 	for _, q := range questions {
 		answers := make([]appDuel.AnswerData, 0, len(q.Answers()))
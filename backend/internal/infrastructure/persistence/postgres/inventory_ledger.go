@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// InventoryLedger is a PostgreSQL implementation of user.InventoryLedger.
+// Each change runs in one transaction holding the inventory row lock, so concurrent
+// credits/debits of a player serialize and the balance never diverges from its log.
+type InventoryLedger struct {
+	db *sql.DB
+}
+
+// NewInventoryLedger creates a new PostgreSQL inventory ledger
+func NewInventoryLedger(db *sql.DB) *InventoryLedger {
+	return &InventoryLedger{db: db}
+}
+
+// Apply applies the transaction to the locked inventory and logs it, atomically
+func (l *InventoryLedger) Apply(txLog *user.TransactionLog) error {
+	tx, err := l.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := l.ApplyInTx(tx, txLog); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit inventory transaction: %w", err)
+	}
+	return nil
+}
+
+// ApplyInTx applies the transaction within the caller's transaction; the row lock is held until it ends
+func (l *InventoryLedger) ApplyInTx(tx *sql.Tx, txLog *user.TransactionLog) error {
+	inventory, err := l.lockInventory(tx, txLog.PlayerID())
+	if err != nil {
+		return err
+	}
+
+	// The row lock serializes retries of the same request: the second one sees the first's log entry
+	if key := txLog.IdempotencyKey(); key != "" {
+		var applied bool
+		err := tx.QueryRow(`
+			SELECT EXISTS (
+				SELECT 1 FROM user_transactions
+				WHERE player_id = $1 AND idempotency_key = $2
+			)
+		`, txLog.PlayerID().String(), key).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("failed to check idempotency key: %w", err)
+		}
+		if applied {
+			return user.ErrDuplicateTransaction
+		}
+	}
+
+	if err := inventory.Apply(txLog); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE user_inventory SET
+			coins = $2,
+			pvp_tickets = $3,
+			shield = $4,
+			fifty_fifty = $5,
+			"skip" = $6,
+			"freeze" = $7,
+			updated_at = $8
+		WHERE player_id = $1
+	`,
+		inventory.PlayerID().String(),
+		inventory.Coins(),
+		inventory.PvpTickets(),
+		inventory.Shield(),
+		inventory.FiftyFifty(),
+		inventory.Skip(),
+		inventory.Freeze(),
+		inventory.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update inventory: %w", err)
+	}
+
+	detailsJSON, err := json.Marshal(txLog.Details())
+	if err != nil {
+		return fmt.Errorf("failed to marshal transaction details: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO user_transactions (id, player_id, "type", source, details, idempotency_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
+	`,
+		txLog.ID(),
+		txLog.PlayerID().String(),
+		string(txLog.Type()),
+		txLog.Source(),
+		detailsJSON,
+		txLog.IdempotencyKey(),
+		txLog.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save transaction: %w", err)
+	}
+	return nil
+}
+
+// lockInventory loads the player's inventory FOR UPDATE, creating the default one first if needed
+func (l *InventoryLedger) lockInventory(tx *sql.Tx, playerID user.UserID) (*user.Inventory, error) {
+	_, err := tx.Exec(`
+		INSERT INTO user_inventory (player_id, coins, pvp_tickets, shield, fifty_fifty, "skip", "freeze", updated_at)
+		VALUES ($1, 0, 3, 0, 0, 0, 0, $2)
+		ON CONFLICT (player_id) DO NOTHING
+	`, playerID.String(), time.Now().Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure inventory exists: %w", err)
+	}
+
+	return scanInventory(tx.QueryRow(`
+		SELECT player_id, coins, pvp_tickets, shield, fifty_fifty, "skip", "freeze", updated_at
+		FROM user_inventory
+		WHERE player_id = $1
+		FOR UPDATE
+	`, playerID.String()))
+}
+
+// Snapshot reads the inventory and its whole transaction log in one repeatable-read transaction
+func (l *InventoryLedger) Snapshot(playerID user.UserID) (*user.Inventory, []user.TransactionLog, error) {
+	tx, err := l.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	inventory, err := scanInventory(tx.QueryRow(`
+		SELECT player_id, coins, pvp_tickets, shield, fifty_fifty, "skip", "freeze", updated_at
+		FROM user_inventory
+		WHERE player_id = $1
+	`, playerID.String()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.Query(`
+		SELECT id, player_id, "type", source, details, COALESCE(idempotency_key, ''), created_at
+		FROM user_transactions
+		WHERE player_id = $1
+		ORDER BY created_at, id
+	`, playerID.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	defer rows.Close()
+
+	history, err := scanTransactions(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inventory, history, nil
+}
+
+// Correct overwrites the balances, provided they are still the ones that were read
+func (l *InventoryLedger) Correct(read *user.Inventory, corrected *user.Inventory) error {
+	result, err := l.db.Exec(`
+		UPDATE user_inventory SET
+			coins = $2,
+			pvp_tickets = $3,
+			shield = $4,
+			fifty_fifty = $5,
+			"skip" = $6,
+			"freeze" = $7,
+			updated_at = $8
+		WHERE player_id = $1
+			AND coins = $9
+			AND pvp_tickets = $10
+			AND shield = $11
+			AND fifty_fifty = $12
+			AND "skip" = $13
+			AND "freeze" = $14
+	`,
+		read.PlayerID().String(),
+		corrected.Coins(),
+		corrected.PvpTickets(),
+		corrected.Shield(),
+		corrected.FiftyFifty(),
+		corrected.Skip(),
+		corrected.Freeze(),
+		time.Now().Unix(),
+		read.Coins(),
+		read.PvpTickets(),
+		read.Shield(),
+		read.FiftyFifty(),
+		read.Skip(),
+		read.Freeze(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to correct inventory: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return user.ErrInventoryChanged
+	}
+	return nil
+}
+
+// FindPlayerIDs lists players that have an inventory, ordered by ID, after afterID
+func (l *InventoryLedger) FindPlayerIDs(afterID string, limit int) ([]user.UserID, error) {
+	rows, err := l.db.Query(`
+		SELECT player_id
+		FROM user_inventory
+		WHERE player_id > $1
+		ORDER BY player_id
+		LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inventory players: %w", err)
+	}
+	defer rows.Close()
+
+	var playerIDs []user.UserID
+	for rows.Next() {
+		var dbPlayerID string
+		if err := rows.Scan(&dbPlayerID); err != nil {
+			return nil, fmt.Errorf("failed to scan inventory player: %w", err)
+		}
+		uid, err := shared.NewUserID(dbPlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid player_id in inventory: %w", err)
+		}
+		playerIDs = append(playerIDs, uid)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating inventory players: %w", err)
+	}
+
+	return playerIDs, nil
+}
+
+func scanInventory(row *sql.Row) (*user.Inventory, error) {
+	var (
+		dbPlayerID string
+		coins      int
+		pvpTickets int
+		shield     int
+		fiftyFifty int
+		skip       int
+		freeze     int
+		updatedAt  int64
+	)
+
+	err := row.Scan(&dbPlayerID, &coins, &pvpTickets, &shield, &fiftyFifty, &skip, &freeze, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inventory: %w", err)
+	}
+
+	uid, err := shared.NewUserID(dbPlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player_id in inventory: %w", err)
+	}
+
+	return user.ReconstructInventory(uid, coins, pvpTickets, shield, fiftyFifty, skip, freeze, updatedAt), nil
+}
@@ -26,6 +26,17 @@ func NewMarathonRepository(db *sql.DB, questionRepo quiz.QuestionRepository) *Ma
 
 // Save persists a marathon game
 func (r *MarathonRepository) Save(game *solo_marathon.MarathonGameV2) error {
+	return r.save(r.db, game)
+}
+
+// SaveInTx persists a marathon game within an existing transaction
+func (r *MarathonRepository) SaveInTx(tx *sql.Tx, game *solo_marathon.MarathonGameV2) error {
+	return r.save(tx, game)
+}
+
+func (r *MarathonRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, game *solo_marathon.MarathonGameV2) error {
 	// Marshal JSONB fields
 	answeredIDsJSON, err := r.marshalQuestionIDs(game.AnsweredQuestionIDs())
 	if err != nil {
@@ -94,7 +105,7 @@ func (r *MarathonRepository) Save(game *solo_marathon.MarathonGameV2) error {
 		categoryID = &cid
 	}
 
-	_, err = r.db.Exec(query,
+	_, err = db.Exec(query,
 		game.ID().String(),
 		game.PlayerID().String(),
 		categoryID,
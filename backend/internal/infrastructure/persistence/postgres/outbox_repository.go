@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/messaging"
+)
+
+// OutboxRepository is a PostgreSQL implementation of messaging.OutboxWriter
+// and messaging.OutboxStore
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// AppendInTx stores messages as pending, due immediately
+func (r *OutboxRepository) AppendInTx(tx *sql.Tx, messages ...messaging.OutboxMessage) error {
+	now := time.Now().UTC().Unix()
+	for _, msg := range messages {
+		_, err := tx.Exec(
+			`INSERT INTO event_outbox (event_id, event_type, payload, occurred_at, created_at, next_attempt_at)
+			 VALUES ($1, $2, $3, $4, $5, $5)`,
+			msg.EventID,
+			msg.EventType,
+			msg.Payload,
+			msg.OccurredAt,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimBatch leases due messages by pushing their next attempt past the lease.
+// SKIP LOCKED lets relays on other replicas claim different messages concurrently.
+func (r *OutboxRepository) ClaimBatch(ctx context.Context, limit int, lease time.Duration) ([]messaging.OutboxMessage, error) {
+	now := time.Now().UTC()
+	rows, err := r.db.QueryContext(ctx,
+		`UPDATE event_outbox
+		 SET attempts = attempts + 1, next_attempt_at = $2
+		 WHERE id IN (
+			SELECT id FROM event_outbox
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING id, event_id, event_type, payload, occurred_at, attempts`,
+		now.Unix(),
+		now.Add(lease).Unix(),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []messaging.OutboxMessage
+	for rows.Next() {
+		var msg messaging.OutboxMessage
+		if err := rows.Scan(&msg.ID, &msg.EventID, &msg.EventType, &msg.Payload, &msg.OccurredAt, &msg.Attempts); err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the subquery order
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE event_outbox SET status = 'delivered', delivered_at = $2, last_error = '' WHERE id = $1`,
+		id,
+		time.Now().UTC().Unix(),
+	)
+	return err
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time, dead bool) error {
+	status := "pending"
+	if dead {
+		status = "dead"
+	}
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE event_outbox SET status = $2, next_attempt_at = $3, last_error = $4 WHERE id = $1`,
+		id,
+		status,
+		retryAt.UTC().Unix(),
+		lastError,
+	)
+	return err
+}
+
+// Consume records the consumption and runs fn in one transaction.
+// A concurrent delivery of the same event blocks on the consumption row
+// until the first one commits, then sees the conflict and skips.
+func (r *OutboxRepository) Consume(ctx context.Context, consumer, eventID string, fn func(tx *sql.Tx) error) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO event_outbox_consumptions (consumer, event_id, consumed_at)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (consumer, event_id) DO NOTHING`,
+		consumer,
+		eventID,
+		time.Now().UTC().Unix(),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if inserted, err := result.RowsAffected(); err != nil || inserted == 0 {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteDeliveredBefore removes delivered messages older than the given Unix time,
+// with their consumption records, in one transaction. Consumptions of a message
+// still pending or retrying are kept: they stop its consumers from running twice.
+// Dead messages are kept for inspection.
+func (r *OutboxRepository) DeleteDeliveredBefore(ctx context.Context, before int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM event_outbox_consumptions
+		 WHERE event_id IN (
+			SELECT event_id FROM event_outbox WHERE status = 'delivered' AND delivered_at < $1
+		 )`,
+		before,
+	); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM event_outbox WHERE status = 'delivered' AND delivered_at < $1`,
+		before,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// partyPlayerJSON is the JSONB representation of party_mode.PartyPlayer
+type partyPlayerJSON struct {
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Score        int    `json:"score"`
+	Connected    bool   `json:"connected"`
+	AnswersCount int    `json:"answers_count"`
+}
+
+// partyAnswerJSON is the JSONB representation of party_mode.QuestionAnswer
+type partyAnswerJSON struct {
+	PlayerID  string `json:"player_id"`
+	AnswerID  string `json:"answer_id"`
+	TimeTaken int64  `json:"time_taken"`
+	IsCorrect bool   `json:"is_correct"`
+	Points    int    `json:"points"`
+	Position  int    `json:"position"`
+}
+
+type PartyGameRepository struct {
+	db *sql.DB
+}
+
+func NewPartyGameRepository(db *sql.DB) *PartyGameRepository {
+	return &PartyGameRepository{db: db}
+}
+
+const partyGameColumns = `id, room_id, question_ids, players, current_question, question_answers, status, started_at, finished_at`
+
+func (r *PartyGameRepository) Save(game *party_mode.PartyGame) error {
+	return r.save(r.db, game)
+}
+
+func (r *PartyGameRepository) SaveInTx(tx *sql.Tx, game *party_mode.PartyGame) error {
+	return r.save(tx, game)
+}
+
+func (r *PartyGameRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, game *party_mode.PartyGame) error {
+	questionIDsJSON, err := json.Marshal(questionIDsToStrings(game.QuestionIDs()))
+	if err != nil {
+		return err
+	}
+
+	players := make([]partyPlayerJSON, 0, len(game.Players()))
+	for _, p := range game.Players() {
+		players = append(players, partyPlayerJSON{
+			UserID:       p.UserID().String(),
+			Username:     p.Username(),
+			Score:        p.Score(),
+			Connected:    p.Connected(),
+			AnswersCount: p.AnswersCount(),
+		})
+	}
+	playersJSON, err := json.Marshal(players)
+	if err != nil {
+		return err
+	}
+
+	// JSON object keys must be strings: question index -> answers
+	answers := make(map[string][]partyAnswerJSON, len(game.QuestionAnswers()))
+	for index, questionAnswers := range game.QuestionAnswers() {
+		records := make([]partyAnswerJSON, 0, len(questionAnswers))
+		for _, a := range questionAnswers {
+			records = append(records, partyAnswerJSON{
+				PlayerID:  a.PlayerID().String(),
+				AnswerID:  a.AnswerID().String(),
+				TimeTaken: a.TimeTaken(),
+				IsCorrect: a.IsCorrect(),
+				Points:    a.Points(),
+				Position:  a.Position(),
+			})
+		}
+		answers[strconv.Itoa(index)] = records
+	}
+	answersJSON, err := json.Marshal(answers)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO party_games (` + partyGameColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			players = EXCLUDED.players,
+			current_question = EXCLUDED.current_question,
+			question_answers = EXCLUDED.question_answers,
+			status = EXCLUDED.status,
+			finished_at = EXCLUDED.finished_at
+	`
+
+	_, err = db.Exec(query,
+		game.ID().String(),
+		game.RoomID().String(),
+		questionIDsJSON,
+		playersJSON,
+		game.CurrentQuestion(),
+		answersJSON,
+		string(game.Status()),
+		game.StartedAt(),
+		game.FinishedAt(),
+	)
+
+	return err
+}
+
+func (r *PartyGameRepository) FindByID(id party_mode.GameID) (*party_mode.PartyGame, error) {
+	query := `SELECT ` + partyGameColumns + ` FROM party_games WHERE id = $1`
+	return r.scanGame(r.db.QueryRow(query, id.String()))
+}
+
+// FindByRoomID returns the latest game played in the room
+func (r *PartyGameRepository) FindByRoomID(roomID party_mode.RoomID) (*party_mode.PartyGame, error) {
+	query := `
+		SELECT ` + partyGameColumns + `
+		FROM party_games
+		WHERE room_id = $1
+		ORDER BY started_at DESC
+		LIMIT 1
+	`
+	return r.scanGame(r.db.QueryRow(query, roomID.String()))
+}
+
+func (r *PartyGameRepository) Delete(id party_mode.GameID) error {
+	_, err := r.db.Exec(`DELETE FROM party_games WHERE id = $1`, id.String())
+	return err
+}
+
+func (r *PartyGameRepository) scanGame(row *sql.Row) (*party_mode.PartyGame, error) {
+	var (
+		id              string
+		roomID          string
+		questionIDsJSON []byte
+		playersJSON     []byte
+		currentQuestion int
+		answersJSON     []byte
+		status          string
+		startedAt       int64
+		finishedAt      int64
+	)
+
+	err := row.Scan(&id, &roomID, &questionIDsJSON, &playersJSON, &currentQuestion, &answersJSON, &status, &startedAt, &finishedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, party_mode.ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var questionIDStrs []string
+	if err := json.Unmarshal(questionIDsJSON, &questionIDStrs); err != nil {
+		return nil, err
+	}
+	questionIDs := make([]party_mode.QuestionID, 0, len(questionIDStrs))
+	for _, idStr := range questionIDStrs {
+		qid, err := quiz.NewQuestionIDFromString(idStr)
+		if err != nil {
+			return nil, err
+		}
+		questionIDs = append(questionIDs, qid)
+	}
+
+	var playerRecords []partyPlayerJSON
+	if err := json.Unmarshal(playersJSON, &playerRecords); err != nil {
+		return nil, err
+	}
+	players := make([]party_mode.PartyPlayer, 0, len(playerRecords))
+	for _, p := range playerRecords {
+		userID, err := shared.NewUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, party_mode.ReconstructPartyPlayer(
+			userID, p.Username, p.Score, p.Connected, p.AnswersCount,
+		))
+	}
+
+	var answerRecords map[string][]partyAnswerJSON
+	if err := json.Unmarshal(answersJSON, &answerRecords); err != nil {
+		return nil, err
+	}
+	questionAnswers := make(map[int][]party_mode.QuestionAnswer, len(answerRecords))
+	for key, records := range answerRecords {
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]party_mode.QuestionAnswer, 0, len(records))
+		for _, a := range records {
+			playerID, err := shared.NewUserID(a.PlayerID)
+			if err != nil {
+				return nil, err
+			}
+			answerID, err := quiz.NewAnswerIDFromString(a.AnswerID)
+			if err != nil {
+				return nil, err
+			}
+			answers = append(answers, party_mode.ReconstructQuestionAnswer(
+				playerID, answerID, a.TimeTaken, a.IsCorrect, a.Points, a.Position,
+			))
+		}
+		questionAnswers[index] = answers
+	}
+
+	return party_mode.ReconstructPartyGame(
+		party_mode.NewGameIDFromString(id),
+		party_mode.NewRoomIDFromString(roomID),
+		questionIDs,
+		players,
+		currentQuestion,
+		questionAnswers,
+		party_mode.GameStatus(status),
+		startedAt,
+		finishedAt,
+	), nil
+}
@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/party_mode"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/lib/pq"
+)
+
+// partyRoomSettingsJSON is the JSONB representation of party_mode.RoomSettings
+type partyRoomSettingsJSON struct {
+	MaxPlayers        int      `json:"max_players"`
+	QuestionsCount    int      `json:"questions_count"`
+	TimePerQuestion   int      `json:"time_per_question"`
+	Categories        []string `json:"categories"`
+	Difficulty        string   `json:"difficulty"`
+	ShowCorrectAnswer bool     `json:"show_correct_answer"`
+	ShowPlayerAnswers bool     `json:"show_player_answers"`
+	ShowCurrentScore  bool     `json:"show_current_score"`
+}
+
+// partyRoomPlayerJSON is the JSONB representation of party_mode.RoomPlayer
+type partyRoomPlayerJSON struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	IsHost    bool   `json:"is_host"`
+	IsReady   bool   `json:"is_ready"`
+	Connected bool   `json:"connected"`
+	JoinedAt  int64  `json:"joined_at"`
+}
+
+type PartyRoomRepository struct {
+	db *sql.DB
+}
+
+func NewPartyRoomRepository(db *sql.DB) *PartyRoomRepository {
+	return &PartyRoomRepository{db: db}
+}
+
+const partyRoomColumns = `id, code, name, host_id, settings, players, status, created_at, expires_at`
+
+func (r *PartyRoomRepository) Save(room *party_mode.PartyRoom) error {
+	return r.save(r.db, room)
+}
+
+func (r *PartyRoomRepository) SaveInTx(tx *sql.Tx, room *party_mode.PartyRoom) error {
+	return r.save(tx, room)
+}
+
+func (r *PartyRoomRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, room *party_mode.PartyRoom) error {
+	settingsJSON, err := json.Marshal(partyRoomSettingsToJSON(room.Settings()))
+	if err != nil {
+		return err
+	}
+
+	players := make([]partyRoomPlayerJSON, 0, room.PlayerCount())
+	for _, p := range room.Players() {
+		players = append(players, partyRoomPlayerJSON{
+			UserID:    p.UserID().String(),
+			Username:  p.Username(),
+			IsHost:    p.IsHost(),
+			IsReady:   p.IsReady(),
+			Connected: p.Connected(),
+			JoinedAt:  p.JoinedAt(),
+		})
+	}
+	playersJSON, err := json.Marshal(players)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO party_rooms (` + partyRoomColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			host_id = EXCLUDED.host_id,
+			settings = EXCLUDED.settings,
+			players = EXCLUDED.players,
+			status = EXCLUDED.status,
+			expires_at = EXCLUDED.expires_at
+	`
+
+	_, err = db.Exec(query,
+		room.ID().String(),
+		room.Code().String(),
+		room.Name(),
+		room.HostID().String(),
+		settingsJSON,
+		playersJSON,
+		string(room.Status()),
+		room.CreatedAt(),
+		room.ExpiresAt(),
+	)
+
+	// Only one open room may hold a code (idx_party_rooms_open_code)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_party_rooms_open_code" {
+		return party_mode.ErrRoomCodeTaken
+	}
+	return err
+}
+
+func (r *PartyRoomRepository) FindByID(id party_mode.RoomID) (*party_mode.PartyRoom, error) {
+	query := `SELECT ` + partyRoomColumns + ` FROM party_rooms WHERE id = $1`
+	return r.scanRoom(r.db.QueryRow(query, id.String()))
+}
+
+func (r *PartyRoomRepository) FindByIDForUpdate(tx *sql.Tx, id party_mode.RoomID) (*party_mode.PartyRoom, error) {
+	query := `SELECT ` + partyRoomColumns + ` FROM party_rooms WHERE id = $1 FOR UPDATE`
+	return r.scanRoom(tx.QueryRow(query, id.String()))
+}
+
+// FindByCode returns the open room with the given code.
+// Codes are short and get reused once a room closes; idx_party_rooms_open_code
+// guarantees at most one open room per code.
+func (r *PartyRoomRepository) FindByCode(code party_mode.RoomCode) (*party_mode.PartyRoom, error) {
+	query := `
+		SELECT ` + partyRoomColumns + `
+		FROM party_rooms
+		WHERE code = $1 AND status <> 'closed'
+	`
+	return r.scanRoom(r.db.QueryRow(query, code.String()))
+}
+
+func (r *PartyRoomRepository) FindActiveRooms() ([]*party_mode.PartyRoom, error) {
+	query := `
+		SELECT ` + partyRoomColumns + `
+		FROM party_rooms
+		WHERE status = 'lobby'
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rooms []*party_mode.PartyRoom
+	for rows.Next() {
+		room, err := r.scanRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+
+	return rooms, rows.Err()
+}
+
+func (r *PartyRoomRepository) Delete(id party_mode.RoomID) error {
+	_, err := r.db.Exec(`DELETE FROM party_rooms WHERE id = $1`, id.String())
+	return err
+}
+
+func (r *PartyRoomRepository) scanRoom(row interface {
+	Scan(dest ...interface{}) error
+}) (*party_mode.PartyRoom, error) {
+	var (
+		id           string
+		code         string
+		name         string
+		hostID       string
+		settingsJSON []byte
+		playersJSON  []byte
+		status       string
+		createdAt    int64
+		expiresAt    int64
+	)
+
+	err := row.Scan(&id, &code, &name, &hostID, &settingsJSON, &playersJSON, &status, &createdAt, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, party_mode.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var settings partyRoomSettingsJSON
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, err
+	}
+
+	var playerRecords []partyRoomPlayerJSON
+	if err := json.Unmarshal(playersJSON, &playerRecords); err != nil {
+		return nil, err
+	}
+
+	players := make([]party_mode.RoomPlayer, 0, len(playerRecords))
+	for _, p := range playerRecords {
+		userID, err := shared.NewUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, party_mode.ReconstructRoomPlayer(
+			userID, p.Username, p.IsHost, p.IsReady, p.Connected, p.JoinedAt,
+		))
+	}
+
+	host, _ := shared.NewUserID(hostID)
+
+	return party_mode.ReconstructPartyRoom(
+		party_mode.NewRoomIDFromString(id),
+		party_mode.NewRoomCodeFromString(code),
+		name,
+		host,
+		partyRoomSettingsFromJSON(settings),
+		players,
+		party_mode.RoomStatus(status),
+		createdAt,
+		expiresAt,
+	), nil
+}
+
+func partyRoomSettingsToJSON(s party_mode.RoomSettings) partyRoomSettingsJSON {
+	categories := make([]string, 0, len(s.Categories()))
+	for _, c := range s.Categories() {
+		categories = append(categories, c.String())
+	}
+
+	return partyRoomSettingsJSON{
+		MaxPlayers:        s.MaxPlayers(),
+		QuestionsCount:    s.QuestionsCount(),
+		TimePerQuestion:   s.TimePerQuestion(),
+		Categories:        categories,
+		Difficulty:        s.Difficulty(),
+		ShowCorrectAnswer: s.ShowCorrectAnswer(),
+		ShowPlayerAnswers: s.ShowPlayerAnswers(),
+		ShowCurrentScore:  s.ShowCurrentScore(),
+	}
+}
+
+func partyRoomSettingsFromJSON(s partyRoomSettingsJSON) party_mode.RoomSettings {
+	categories := make([]party_mode.CategoryID, 0, len(s.Categories))
+	for _, idStr := range s.Categories {
+		categoryID, err := quiz.NewCategoryIDFromString(idStr)
+		if err != nil {
+			continue
+		}
+		categories = append(categories, categoryID)
+	}
+
+	return party_mode.ReconstructRoomSettings(
+		s.MaxPlayers,
+		s.QuestionsCount,
+		s.TimePerQuestion,
+		categories,
+		s.Difficulty,
+		s.ShowCorrectAnswer,
+		s.ShowPlayerAnswers,
+		s.ShowCurrentScore,
+	)
+}
@@ -3,7 +3,8 @@ package postgres
 import (
 	"database/sql"
 	"errors"
-	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
@@ -17,41 +18,62 @@ func NewPlayerRatingRepository(db *sql.DB) *PlayerRatingRepository {
 	return &PlayerRatingRepository{db: db}
 }
 
+const playerRatingColumns = `player_id, mmr, rating_deviation, volatility, league, division,
+	peak_mmr, peak_league, peak_division,
+	games_at_rank, games_played, season_id, season_wins, season_losses, season_draws,
+	last_game_at, updated_at`
+
 func (r *PlayerRatingRepository) Save(rating *quick_duel.PlayerRating) error {
+	return r.save(r.db, rating)
+}
+
+// SaveInTx persists a player rating within an existing transaction
+func (r *PlayerRatingRepository) SaveInTx(tx *sql.Tx, rating *quick_duel.PlayerRating) error {
+	return r.save(tx, rating)
+}
+
+func (r *PlayerRatingRepository) save(db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, rating *quick_duel.PlayerRating) error {
 	query := `
-		INSERT INTO player_ratings (
-			player_id, mmr, league, division,
-			peak_mmr, peak_league, peak_division,
-			games_at_rank, season_id, season_wins, season_losses, season_draws, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		INSERT INTO player_ratings (` + playerRatingColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (player_id) DO UPDATE SET
 			mmr = EXCLUDED.mmr,
+			rating_deviation = EXCLUDED.rating_deviation,
+			volatility = EXCLUDED.volatility,
 			league = EXCLUDED.league,
 			division = EXCLUDED.division,
 			peak_mmr = EXCLUDED.peak_mmr,
 			peak_league = EXCLUDED.peak_league,
 			peak_division = EXCLUDED.peak_division,
 			games_at_rank = EXCLUDED.games_at_rank,
+			games_played = EXCLUDED.games_played,
 			season_id = EXCLUDED.season_id,
 			season_wins = EXCLUDED.season_wins,
 			season_losses = EXCLUDED.season_losses,
 			season_draws = EXCLUDED.season_draws,
+			last_game_at = EXCLUDED.last_game_at,
 			updated_at = EXCLUDED.updated_at
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := db.Exec(query,
 		rating.PlayerID().String(),
 		rating.MMR(),
+		rating.RatingDeviation(),
+		rating.Volatility(),
 		rating.League().String(),
 		rating.Division().Value(),
 		rating.PeakMMR(),
 		rating.PeakLeague().String(),
 		rating.PeakDivision().Value(),
 		rating.GamesAtRank(),
+		rating.GamesPlayed(),
 		rating.SeasonID(),
 		rating.SeasonWins(),
 		rating.SeasonLosses(),
 		rating.SeasonDraws(),
+		rating.LastGameAt(),
 		rating.UpdatedAt(),
 	)
 
@@ -59,38 +81,9 @@ func (r *PlayerRatingRepository) Save(rating *quick_duel.PlayerRating) error {
 }
 
 func (r *PlayerRatingRepository) FindByPlayerID(playerID quick_duel.UserID) (*quick_duel.PlayerRating, error) {
-	query := `
-		SELECT player_id, mmr, league, division,
-			peak_mmr, peak_league, peak_division,
-			games_at_rank, season_id, season_wins, season_losses, season_draws, updated_at
-		FROM player_ratings
-		WHERE player_id = $1
-	`
-
-	row := r.db.QueryRow(query, playerID.String())
-
-	var (
-		playerIDStr   string
-		mmr           int
-		leagueStr     string
-		division      int
-		peakMMR       int
-		peakLeagueStr string
-		peakDivision  int
-		gamesAtRank   int
-		seasonID      string
-		seasonWins    int
-		seasonLosses  int
-		seasonDraws   int
-		updatedAt     int64
-	)
-
-	err := row.Scan(
-		&playerIDStr, &mmr, &leagueStr, &division,
-		&peakMMR, &peakLeagueStr, &peakDivision,
-		&gamesAtRank, &seasonID, &seasonWins, &seasonLosses, &seasonDraws, &updatedAt,
-	)
+	query := `SELECT ` + playerRatingColumns + ` FROM player_ratings WHERE player_id = $1`
 
+	rating, err := scanPlayerRating(r.db.QueryRow(query, playerID.String()))
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, quick_duel.ErrGameNotFound
 	}
@@ -98,25 +91,7 @@ func (r *PlayerRatingRepository) FindByPlayerID(playerID quick_duel.UserID) (*qu
 		return nil, err
 	}
 
-	pid, _ := shared.NewUserID(playerIDStr)
-	league := stringToLeague(leagueStr)
-	peakLeague := stringToLeague(peakLeagueStr)
-
-	return quick_duel.ReconstructPlayerRating(
-		pid,
-		mmr,
-		league,
-		quick_duel.Division(division),
-		peakMMR,
-		peakLeague,
-		quick_duel.Division(peakDivision),
-		gamesAtRank,
-		seasonID,
-		seasonWins,
-		seasonLosses,
-		seasonDraws,
-		updatedAt,
-	), nil
+	return rating, nil
 }
 
 func (r *PlayerRatingRepository) FindOrCreate(playerID quick_duel.UserID, seasonID string, createdAt int64) (*quick_duel.PlayerRating, error) {
@@ -140,9 +115,7 @@ func (r *PlayerRatingRepository) FindOrCreate(playerID quick_duel.UserID, season
 
 func (r *PlayerRatingRepository) GetLeaderboard(seasonID string, limit int, offset int) ([]*quick_duel.PlayerRating, error) {
 	query := `
-		SELECT player_id, mmr, league, division,
-			peak_mmr, peak_league, peak_division,
-			games_at_rank, season_id, season_wins, season_losses, season_draws, updated_at
+		SELECT ` + playerRatingColumns + `
 		FROM player_ratings
 		WHERE season_id = $1
 		ORDER BY mmr DESC
@@ -155,50 +128,32 @@ func (r *PlayerRatingRepository) GetLeaderboard(seasonID string, limit int, offs
 	}
 	defer rows.Close()
 
-	var ratings []*quick_duel.PlayerRating
-	for rows.Next() {
-		var (
-			playerIDStr   string
-			mmr           int
-			leagueStr     string
-			division      int
-			peakMMR       int
-			peakLeagueStr string
-			peakDivision  int
-			gamesAtRank   int
-			sid           string
-			seasonWins    int
-			seasonLosses  int
-			seasonDraws   int
-			updatedAt     int64
-		)
-
-		err := rows.Scan(
-			&playerIDStr, &mmr, &leagueStr, &division,
-			&peakMMR, &peakLeagueStr, &peakDivision,
-			&gamesAtRank, &sid, &seasonWins, &seasonLosses, &seasonDraws, &updatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		pid, _ := shared.NewUserID(playerIDStr)
-		league := stringToLeague(leagueStr)
-		peakLeague := stringToLeague(peakLeagueStr)
+	return scanPlayerRatings(rows)
+}
 
-		ratings = append(ratings, quick_duel.ReconstructPlayerRating(
-			pid, mmr, league, quick_duel.Division(division),
-			peakMMR, peakLeague, quick_duel.Division(peakDivision),
-			gamesAtRank, sid, seasonWins, seasonLosses, seasonDraws, updatedAt,
-		))
+func (r *PlayerRatingRepository) GetFriendsLeaderboard(playerID quick_duel.UserID, friendIDs []quick_duel.UserID, limit int) ([]*quick_duel.PlayerRating, error) {
+	// The player is ranked among their friends
+	ids := make([]string, 0, len(friendIDs)+1)
+	ids = append(ids, playerID.String())
+	for _, id := range friendIDs {
+		ids = append(ids, id.String())
 	}
 
-	return ratings, nil
-}
+	query := `
+		SELECT ` + playerRatingColumns + `
+		FROM player_ratings
+		WHERE player_id = ANY($1)
+		ORDER BY mmr DESC, player_id
+		LIMIT $2
+	`
 
-func (r *PlayerRatingRepository) GetFriendsLeaderboard(playerID quick_duel.UserID, friendIDs []quick_duel.UserID, limit int) ([]*quick_duel.PlayerRating, error) {
-	// TODO: Implement friends leaderboard
-	return nil, nil
+	rows, err := r.db.Query(query, pq.Array(ids), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanPlayerRatings(rows)
 }
 
 func (r *PlayerRatingRepository) GetPlayerRank(playerID quick_duel.UserID, seasonID string) (int, error) {
@@ -229,9 +184,7 @@ func (r *PlayerRatingRepository) GetTotalPlayers(seasonID string) (int, error) {
 
 func (r *PlayerRatingRepository) FindAllBySeasonID(seasonID string) ([]*quick_duel.PlayerRating, error) {
 	query := `
-		SELECT player_id, mmr, league, division,
-			peak_mmr, peak_league, peak_division,
-			games_at_rank, season_id, season_wins, season_losses, season_draws, updated_at
+		SELECT ` + playerRatingColumns + `
 		FROM player_ratings
 		WHERE season_id = $1
 		ORDER BY player_id
@@ -243,108 +196,82 @@ func (r *PlayerRatingRepository) FindAllBySeasonID(seasonID string) ([]*quick_du
 	}
 	defer rows.Close()
 
-	var ratings []*quick_duel.PlayerRating
-	for rows.Next() {
-		var (
-			playerIDStr   string
-			mmr           int
-			leagueStr     string
-			division      int
-			peakMMR       int
-			peakLeagueStr string
-			peakDivision  int
-			gamesAtRank   int
-			sid           string
-			seasonWins    int
-			seasonLosses  int
-			seasonDraws   int
-			updatedAt     int64
-		)
-
-		if err := rows.Scan(
-			&playerIDStr, &mmr, &leagueStr, &division,
-			&peakMMR, &peakLeagueStr, &peakDivision,
-			&gamesAtRank, &sid, &seasonWins, &seasonLosses, &seasonDraws, &updatedAt,
-		); err != nil {
-			return nil, err
-		}
-
-		pid, _ := shared.NewUserID(playerIDStr)
-		league := stringToLeague(leagueStr)
-		peakLeague := stringToLeague(peakLeagueStr)
-
-		ratings = append(ratings, quick_duel.ReconstructPlayerRating(
-			pid, mmr, league, quick_duel.Division(division),
-			peakMMR, peakLeague, quick_duel.Division(peakDivision),
-			gamesAtRank, sid, seasonWins, seasonLosses, seasonDraws, updatedAt,
-		))
-	}
-
-	return ratings, rows.Err()
+	return scanPlayerRatings(rows)
 }
 
-func (r *PlayerRatingRepository) ResetAllRatingsForSeason(newSeasonID string, resetFn func(currentMMR int) int) error {
-	selectQuery := `
-		SELECT player_id, mmr
-		FROM player_ratings
-		ORDER BY player_id
-	`
-
-	rows, err := r.db.Query(selectQuery)
+func (r *PlayerRatingRepository) ResetAllRatingsForSeason(reset func(rating *quick_duel.PlayerRating)) error {
+	rows, err := r.db.Query(`SELECT ` + playerRatingColumns + ` FROM player_ratings ORDER BY player_id`)
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
-
-	type playerRow struct {
-		playerID string
-		mmr      int
+	ratings, err := scanPlayerRatings(rows)
+	rows.Close()
+	if err != nil {
+		return err
 	}
-	var players []playerRow
-	for rows.Next() {
-		var p playerRow
-		if err := rows.Scan(&p.playerID, &p.mmr); err != nil {
+
+	for _, rating := range ratings {
+		reset(rating)
+		if err := r.Save(rating); err != nil {
 			return err
 		}
-		players = append(players, p)
-	}
-	if err := rows.Err(); err != nil {
-		return err
 	}
 
-	updateQuery := `
-		UPDATE player_ratings
-		SET mmr = $1,
-		    league = $2,
-		    division = $3,
-		    season_id = $4,
-		    season_wins = 0,
-		    season_losses = 0,
-		    season_draws = 0,
-		    games_at_rank = 0,
-		    updated_at = $5
-		WHERE player_id = $6
-	`
+	return nil
+}
 
-	now := time.Now().UTC().Unix()
+func scanPlayerRatings(rows *sql.Rows) ([]*quick_duel.PlayerRating, error) {
+	var ratings []*quick_duel.PlayerRating
+	for rows.Next() {
+		rating, err := scanPlayerRating(rows)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
 
-	for _, p := range players {
-		newMMR := resetFn(p.mmr)
-		leagueInfo := quick_duel.GetLeagueFromMMR(newMMR)
+	return ratings, rows.Err()
+}
 
-		if _, err := r.db.Exec(updateQuery,
-			newMMR,
-			leagueInfo.League().String(),
-			leagueInfo.Division().Value(),
-			newSeasonID,
-			now,
-			p.playerID,
-		); err != nil {
-			return err
-		}
+func scanPlayerRating(row interface{ Scan(dest ...any) error }) (*quick_duel.PlayerRating, error) {
+	var (
+		playerIDStr     string
+		mmr             int
+		ratingDeviation float64
+		volatility      float64
+		leagueStr       string
+		division        int
+		peakMMR         int
+		peakLeagueStr   string
+		peakDivision    int
+		gamesAtRank     int
+		gamesPlayed     int
+		seasonID        string
+		seasonWins      int
+		seasonLosses    int
+		seasonDraws     int
+		lastGameAt      int64
+		updatedAt       int64
+	)
+
+	if err := row.Scan(
+		&playerIDStr, &mmr, &ratingDeviation, &volatility, &leagueStr, &division,
+		&peakMMR, &peakLeagueStr, &peakDivision,
+		&gamesAtRank, &gamesPlayed, &seasonID, &seasonWins, &seasonLosses, &seasonDraws,
+		&lastGameAt, &updatedAt,
+	); err != nil {
+		return nil, err
 	}
 
-	return nil
+	pid, _ := shared.NewUserID(playerIDStr)
+
+	return quick_duel.ReconstructPlayerRating(
+		pid, mmr, ratingDeviation, volatility,
+		stringToLeague(leagueStr), quick_duel.Division(division),
+		peakMMR, stringToLeague(peakLeagueStr), quick_duel.Division(peakDivision),
+		gamesAtRank, gamesPlayed, seasonID, seasonWins, seasonLosses, seasonDraws,
+		lastGameAt, updatedAt,
+	), nil
 }
 
 // Helper function to convert string to League
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
+)
+
+// PremiumRepository is a PostgreSQL implementation of user.PremiumRepository
+type PremiumRepository struct {
+	db *sql.DB
+}
+
+// NewPremiumRepository creates a new PostgreSQL premium repository
+func NewPremiumRepository(db *sql.DB) *PremiumRepository {
+	return &PremiumRepository{db: db}
+}
+
+// FindStatus returns the player's premium status, NoPremium if there is none
+func (r *PremiumRepository) FindStatus(playerID user.UserID) (user.PremiumStatus, error) {
+	return scanPremiumStatus(r.db.QueryRow(`
+		SELECT is_active, expires_at FROM user_premium WHERE player_id = $1
+	`, playerID.String()))
+}
+
+// FindStatusForUpdate locks the player's premium row (creating it first) until tx ends
+func (r *PremiumRepository) FindStatusForUpdate(tx *sql.Tx, playerID user.UserID) (user.PremiumStatus, error) {
+	_, err := tx.Exec(`
+		INSERT INTO user_premium (player_id, is_active, expires_at, updated_at)
+		VALUES ($1, FALSE, 0, $2)
+		ON CONFLICT (player_id) DO NOTHING
+	`, playerID.String(), time.Now().Unix())
+	if err != nil {
+		return user.PremiumStatus{}, fmt.Errorf("failed to ensure premium row exists: %w", err)
+	}
+
+	return scanPremiumStatus(tx.QueryRow(`
+		SELECT is_active, expires_at FROM user_premium WHERE player_id = $1 FOR UPDATE
+	`, playerID.String()))
+}
+
+// SaveStatusInTx persists the player's premium status within tx
+func (r *PremiumRepository) SaveStatusInTx(tx *sql.Tx, playerID user.UserID, status user.PremiumStatus) error {
+	_, err := tx.Exec(`
+		INSERT INTO user_premium (player_id, is_active, expires_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (player_id) DO UPDATE SET
+			is_active = EXCLUDED.is_active,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = EXCLUDED.updated_at
+	`, playerID.String(), status.IsActive(), status.ExpiresAt(), time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf("failed to save premium status: %w", err)
+	}
+	return nil
+}
+
+// SavePaymentInTx records a payment within tx; a known charge ID is ErrDuplicatePremiumPayment
+func (r *PremiumRepository) SavePaymentInTx(tx *sql.Tx, payment *user.PremiumPayment) error {
+	result, err := tx.Exec(`
+		INSERT INTO premium_payments (charge_id, player_id, plan_id, amount_stars, is_recurring, premium_until, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (charge_id) DO NOTHING
+	`,
+		payment.ChargeID(),
+		payment.PlayerID().String(),
+		payment.PlanID(),
+		payment.AmountStars(),
+		payment.IsRecurring(),
+		payment.PremiumUntil(),
+		payment.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save premium payment: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return user.ErrDuplicatePremiumPayment
+	}
+	return nil
+}
+
+func scanPremiumStatus(row *sql.Row) (user.PremiumStatus, error) {
+	var (
+		active    bool
+		expiresAt int64
+	)
+	err := row.Scan(&active, &expiresAt)
+	if err == sql.ErrNoRows {
+		return user.NoPremium(), nil
+	}
+	if err != nil {
+		return user.PremiumStatus{}, fmt.Errorf("failed to query premium status: %w", err)
+	}
+	return user.NewPremiumStatus(active, expiresAt), nil
+}
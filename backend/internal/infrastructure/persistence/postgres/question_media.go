@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// mediaRecord is the JSONB shape of questions.media and answers.media
+type mediaRecord struct {
+	URL      string `json:"url,omitempty"`
+	AssetKey string `json:"assetKey,omitempty"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Alt      string `json:"alt"`
+}
+
+// marshalMedia encodes media for a JSONB column; no media is stored as NULL
+func marshalMedia(media quiz.Media) (interface{}, error) {
+	if media.IsEmpty() {
+		return nil, nil
+	}
+	data, err := json.Marshal(mediaRecord{
+		URL:      media.URL(),
+		AssetKey: media.AssetKey(),
+		Width:    media.Width(),
+		Height:   media.Height(),
+		Alt:      media.AltText(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseMedia decodes a media JSONB column (NULL = no media)
+func parseMedia(data []byte) (quiz.Media, error) {
+	if len(data) == 0 {
+		return quiz.Media{}, nil
+	}
+	var record mediaRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return quiz.Media{}, err
+	}
+	return quiz.NewMedia(record.URL, record.AssetKey, record.Width, record.Height, record.Alt)
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
 )
@@ -21,20 +22,32 @@ func NewQuestionRepository(db *sql.DB) *QuestionRepository {
 // FindByID retrieves a single question by ID
 func (r *QuestionRepository) FindByID(id quiz.QuestionID) (*quiz.Question, error) {
 	query := `
-		SELECT q.id, q.text, q.points, q.position
+		SELECT q.id, q.text, q.points, q.position, q.difficulty,
+		       q.explanation, q.explanation_source_url, q.media, q.translations,
+		       q.question_type, q.numeric_answer, q.quiz_id, q.deleted_at
 		FROM questions q
 		WHERE q.id = $1
 	`
 
 	var (
-		questionID  string
-		text        string
-		points      int
-		position    int
+		questionID   string
+		text         string
+		points       int
+		position     int
+		difficulty   string
+		explanation  string
+		sourceURL    string
+		media        []byte
+		translations []byte
+		questionType string
+		numeric      []byte
+		quizID       string
+		deletedAt    sql.NullInt64
 	)
 
 	err := r.db.QueryRow(query, id.String()).Scan(
-		&questionID, &text, &points, &position,
+		&questionID, &text, &points, &position, &difficulty, &explanation, &sourceURL, &media, &translations, &questionType, &numeric,
+		&quizID, &deletedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -51,7 +64,7 @@ func (r *QuestionRepository) FindByID(id quiz.QuestionID) (*quiz.Question, error
 	}
 
 	// Reconstruct question
-	return r.reconstructQuestion(questionID, text, points, position, answers)
+	return r.reconstructQuestion(questionID, text, points, position, difficulty, explanation, sourceURL, media, translations, questionType, numeric, quizID, deletedAt, answers)
 }
 
 // FindByIDs retrieves multiple questions by their IDs
@@ -75,7 +88,9 @@ func (r *QuestionRepository) FindByIDs(ids []quiz.QuestionID) ([]*quiz.Question,
 	}
 
 	query := fmt.Sprintf(`
-		SELECT q.id, q.text, q.points, q.position
+		SELECT q.id, q.text, q.points, q.position, q.difficulty,
+		       q.explanation, q.explanation_source_url, q.media, q.translations,
+		       q.question_type, q.numeric_answer, q.quiz_id, q.deleted_at
 		FROM questions q
 		WHERE q.id IN (%s)
 		ORDER BY q.position ASC
@@ -91,13 +106,23 @@ func (r *QuestionRepository) FindByIDs(ids []quiz.QuestionID) ([]*quiz.Question,
 
 	for rows.Next() {
 		var (
-			questionID  string
-			text        string
-			points      int
-			position    int
+			questionID   string
+			text         string
+			points       int
+			position     int
+			difficulty   string
+			explanation  string
+			sourceURL    string
+			media        []byte
+			translations []byte
+			questionType string
+			numeric      []byte
+			quizID       string
+			deletedAt    sql.NullInt64
 		)
 
-		err := rows.Scan(&questionID, &text, &points, &position)
+		err := rows.Scan(&questionID, &text, &points, &position, &difficulty, &explanation, &sourceURL, &media, &translations, &questionType, &numeric,
+			&quizID, &deletedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan question row: %w", err)
 		}
@@ -110,7 +135,7 @@ func (r *QuestionRepository) FindByIDs(ids []quiz.QuestionID) ([]*quiz.Question,
 		}
 
 		// Reconstruct question
-		question, err := r.reconstructQuestion(questionID, text, points, position, answers)
+		question, err := r.reconstructQuestion(questionID, text, points, position, difficulty, explanation, sourceURL, media, translations, questionType, numeric, quizID, deletedAt, answers)
 		if err != nil {
 			return nil, err
 		}
@@ -207,10 +232,11 @@ func (r *QuestionRepository) FindQuestionsByQuizSeed(questionsPerQuiz int, seed
 			JOIN (
 				SELECT quiz_id, COUNT(*) as cnt
 				FROM questions
+				WHERE deleted_at IS NULL
 				GROUP BY quiz_id
 				HAVING COUNT(*) = $1
 			) qc ON qc.quiz_id = q.id
-			WHERE q.category_id = $2
+			WHERE q.category_id = $2 AND q.deleted_at IS NULL
 			ORDER BY RANDOM()
 			LIMIT 1
 		`
@@ -222,9 +248,11 @@ func (r *QuestionRepository) FindQuestionsByQuizSeed(questionsPerQuiz int, seed
 			JOIN (
 				SELECT quiz_id, COUNT(*) as cnt
 				FROM questions
+				WHERE deleted_at IS NULL
 				GROUP BY quiz_id
 				HAVING COUNT(*) = $1
 			) qc ON qc.quiz_id = q.id
+			WHERE q.deleted_at IS NULL
 			ORDER BY RANDOM()
 			LIMIT 1
 		`
@@ -238,9 +266,11 @@ func (r *QuestionRepository) FindQuestionsByQuizSeed(questionsPerQuiz int, seed
 
 	// 3. Load all questions from that quiz, ordered by position
 	rows, err := r.db.Query(`
-		SELECT q.id, q.text, q.points, q.position
+		SELECT q.id, q.text, q.points, q.position, q.difficulty,
+		       q.explanation, q.explanation_source_url, q.media, q.translations,
+		       q.question_type, q.numeric_answer, q.quiz_id, q.deleted_at
 		FROM questions q
-		WHERE q.quiz_id = $1
+		WHERE q.quiz_id = $1 AND q.deleted_at IS NULL
 		ORDER BY q.position ASC
 	`, quizID)
 	if err != nil {
@@ -266,22 +296,89 @@ func (r *QuestionRepository) CountByFilter(filter quiz.QuestionFilter) (int, err
 	return count, nil
 }
 
-// Save persists a question (create or update)
+// Save persists a question with its answers (create or update)
+// Answers no longer on the question are soft-deleted
 func (r *QuestionRepository) Save(question *quiz.Question) error {
-	// TODO: Implement Save
-	return fmt.Errorf("not implemented")
+	return r.SaveAll([]*quiz.Question{question})
 }
 
-// SaveAll persists multiple questions at once
+// SaveAll persists multiple questions at once, in one transaction
 func (r *QuestionRepository) SaveAll(questions []*quiz.Question) error {
-	// TODO: Implement SaveAll (for import functionality)
-	return fmt.Errorf("not implemented")
+	for _, question := range questions {
+		if question.QuizID().IsZero() {
+			return quiz.ErrQuestionWithoutQuiz
+		}
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	for _, question := range questions {
+		if err := saveQuestion(tx, question.QuizID(), *question, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SaveInTx persists a question with its answers within an existing transaction
+func (r *QuestionRepository) SaveInTx(tx *sql.Tx, question *quiz.Question) error {
+	if question.QuizID().IsZero() {
+		return quiz.ErrQuestionWithoutQuiz
+	}
+	return saveQuestion(tx, question.QuizID(), *question, time.Now().Unix())
 }
 
-// Delete removes a question by ID
+// Delete soft-deletes a question by ID (deleting it again keeps the first timestamp)
 func (r *QuestionRepository) Delete(id quiz.QuestionID) error {
-	// TODO: Implement Delete
-	return fmt.Errorf("not implemented")
+	query := `UPDATE questions SET deleted_at = COALESCE(deleted_at, $2) WHERE id = $1`
+
+	result, err := r.db.Exec(query, id.String(), time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf("failed to delete question: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return quiz.ErrQuestionNotFound
+	}
+
+	return nil
+}
+
+// FindDeletedAnswers retrieves a question's soft-deleted answers (for restoring them)
+func (r *QuestionRepository) FindDeletedAnswers(questionID quiz.QuestionID) ([]quiz.Answer, error) {
+	query := `
+		SELECT id, text, is_correct, position, media, translations
+		FROM answers
+		WHERE question_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY position ASC
+	`
+
+	rows, err := r.queryAnswerRows(query, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make([]quiz.Answer, 0, len(rows))
+	for _, row := range rows {
+		answer, err := reconstructAnswer(row)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, *answer)
+	}
+
+	return answers, nil
 }
 
 // ========================================
@@ -305,9 +402,12 @@ func (r *QuestionRepository) buildFilterQuery(filter quiz.QuestionFilter, random
 // buildFilterQueryBase builds base query with WHERE clauses
 func (r *QuestionRepository) buildFilterQueryBase(filter quiz.QuestionFilter) (string, []interface{}) {
 	query := `
-		SELECT q.id, q.text, q.points, q.position
+		SELECT q.id, q.text, q.points, q.position, q.difficulty,
+		       q.explanation, q.explanation_source_url, q.media, q.translations,
+		       q.question_type, q.numeric_answer, q.quiz_id, q.deleted_at
 		FROM questions q
-		WHERE 1=1
+		JOIN quizzes qz ON qz.id = q.quiz_id
+		WHERE q.deleted_at IS NULL AND qz.deleted_at IS NULL
 	`
 	args := []interface{}{}
 	argCount := 0
@@ -317,9 +417,24 @@ func (r *QuestionRepository) buildFilterQueryBase(filter quiz.QuestionFilter) (s
 	// For now, ignore category filter
 	// TODO: Join with quizzes table if category filtering is needed
 
-	// Filter by difficulty - NOTE: questions table doesn't have difficulty column
-	// Difficulty would need to be on quiz level or added as migration
-	// For now, ignore difficulty filter
+	// Filter by difficulty
+	if filter.HasDifficultyFilter() {
+		argCount++
+		query += fmt.Sprintf(" AND q.difficulty = $%d", argCount)
+		args = append(args, *filter.Difficulty)
+	}
+
+	// Filter by question type
+	if filter.HasTypeFilter() {
+		placeholders := make([]string, len(filter.Types))
+		for i, questionType := range filter.Types {
+			argCount++
+			placeholders[i] = fmt.Sprintf("$%d", argCount)
+			args = append(args, questionType.String())
+		}
+
+		query += fmt.Sprintf(" AND q.question_type IN (%s)", strings.Join(placeholders, ","))
+	}
 
 	// Exclude specific IDs
 	if filter.HasExcludeFilter() {
@@ -345,13 +460,23 @@ func (r *QuestionRepository) scanQuestions(rows *sql.Rows) ([]*quiz.Question, er
 
 	for rows.Next() {
 		var (
-			questionID  string
-			text        string
-			points      int
-			position    int
+			questionID   string
+			text         string
+			points       int
+			position     int
+			difficulty   string
+			explanation  string
+			sourceURL    string
+			media        []byte
+			translations []byte
+			questionType string
+			numeric      []byte
+			quizID       string
+			deletedAt    sql.NullInt64
 		)
 
-		err := rows.Scan(&questionID, &text, &points, &position)
+		err := rows.Scan(&questionID, &text, &points, &position, &difficulty, &explanation, &sourceURL, &media, &translations, &questionType, &numeric,
+			&quizID, &deletedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan question row: %w", err)
 		}
@@ -364,7 +489,7 @@ func (r *QuestionRepository) scanQuestions(rows *sql.Rows) ([]*quiz.Question, er
 		}
 
 		// Reconstruct question
-		question, err := r.reconstructQuestion(questionID, text, points, position, answers)
+		question, err := r.reconstructQuestion(questionID, text, points, position, difficulty, explanation, sourceURL, media, translations, questionType, numeric, quizID, deletedAt, answers)
 		if err != nil {
 			return nil, err
 		}
@@ -379,15 +504,20 @@ func (r *QuestionRepository) scanQuestions(rows *sql.Rows) ([]*quiz.Question, er
 	return questions, nil
 }
 
-// loadAnswersForQuestion loads all answers for a question
+// loadAnswersForQuestion loads all live answers for a question
 func (r *QuestionRepository) loadAnswersForQuestion(questionID quiz.QuestionID) ([]answerRow, error) {
 	query := `
-		SELECT id, text, is_correct, position
+		SELECT id, text, is_correct, position, media, translations
 		FROM answers
-		WHERE question_id = $1
+		WHERE question_id = $1 AND deleted_at IS NULL
 		ORDER BY position ASC
 	`
 
+	return r.queryAnswerRows(query, questionID)
+}
+
+// queryAnswerRows runs an answers query for a question
+func (r *QuestionRepository) queryAnswerRows(query string, questionID quiz.QuestionID) ([]answerRow, error) {
 	rows, err := r.db.Query(query, questionID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query answers: %w", err)
@@ -398,7 +528,7 @@ func (r *QuestionRepository) loadAnswersForQuestion(questionID quiz.QuestionID)
 
 	for rows.Next() {
 		var answer answerRow
-		err := rows.Scan(&answer.ID, &answer.Text, &answer.IsCorrect, &answer.Position)
+		err := rows.Scan(&answer.ID, &answer.Text, &answer.IsCorrect, &answer.Position, &answer.Media, &answer.Translations)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan answer row: %w", err)
 		}
@@ -414,10 +544,12 @@ func (r *QuestionRepository) loadAnswersForQuestion(questionID quiz.QuestionID)
 
 // answerRow represents an answer row from database
 type answerRow struct {
-	ID        string
-	Text      string
-	IsCorrect bool
-	Position  int
+	ID           string
+	Text         string
+	IsCorrect    bool
+	Position     int
+	Media        []byte
+	Translations []byte
 }
 
 // reconstructQuestion reconstructs a Question aggregate from database data
@@ -426,6 +558,15 @@ func (r *QuestionRepository) reconstructQuestion(
 	text string,
 	points int,
 	position int,
+	difficulty string,
+	explanation string,
+	sourceURL string,
+	media []byte,
+	translations []byte,
+	questionType string,
+	numericAnswer []byte,
+	quizID string,
+	deletedAt sql.NullInt64,
 	answers []answerRow,
 ) (*quiz.Question, error) {
 	// Parse question ID
@@ -446,27 +587,52 @@ func (r *QuestionRepository) reconstructQuestion(
 		return nil, fmt.Errorf("invalid points: %w", err)
 	}
 
+	questionDifficulty, err := quiz.NewDifficulty(difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty: %w", err)
+	}
+
+	questionExplanation, err := quiz.NewExplanation(explanation, sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid explanation: %w", err)
+	}
+
+	questionMedia, err := parseMedia(media)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media: %w", err)
+	}
+
 	// Create question entity
 	question, err := quiz.NewQuestion(questionID, questionText, questionPoints, position)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create question: %w", err)
 	}
+	question.SetDifficulty(questionDifficulty)
+	question.SetExplanation(questionExplanation)
+	question.SetMedia(questionMedia)
+	if err := applyQuestionTranslations(question, translations); err != nil {
+		return nil, fmt.Errorf("invalid translations: %w", err)
+	}
+	if err := applyQuestionType(question, questionType, numericAnswer); err != nil {
+		return nil, fmt.Errorf("invalid question type: %w", err)
+	}
 
-	// Add answers to question
-	for _, ans := range answers {
-		answerID, err := quiz.NewAnswerIDFromString(ans.ID)
-		if err != nil {
-			return nil, fmt.Errorf("invalid answer_id: %w", err)
-		}
-
-		answerText, err := quiz.NewAnswerText(ans.Text)
-		if err != nil {
-			return nil, fmt.Errorf("invalid answer text: %w", err)
+	questionQuizID, err := quiz.NewQuizIDFromString(quizID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quiz_id: %w", err)
+	}
+	question.AssignToQuiz(questionQuizID)
+	if deletedAt.Valid {
+		if err := question.Delete(deletedAt.Int64); err != nil {
+			return nil, err
 		}
+	}
 
-		answer, err := quiz.NewAnswer(answerID, answerText, ans.IsCorrect, ans.Position)
+	// Add answers to question
+	for _, ans := range answers {
+		answer, err := reconstructAnswer(ans)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create answer: %w", err)
+			return nil, err
 		}
 
 		if err := question.AddAnswer(*answer); err != nil {
@@ -476,3 +642,32 @@ func (r *QuestionRepository) reconstructQuestion(
 
 	return question, nil
 }
+
+// reconstructAnswer reconstructs an Answer from an answer row
+func reconstructAnswer(ans answerRow) (*quiz.Answer, error) {
+	answerID, err := quiz.NewAnswerIDFromString(ans.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer_id: %w", err)
+	}
+
+	answerText, err := quiz.NewAnswerText(ans.Text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer text: %w", err)
+	}
+
+	answer, err := quiz.NewAnswer(answerID, answerText, ans.IsCorrect, ans.Position)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create answer: %w", err)
+	}
+
+	answerMedia, err := parseMedia(ans.Media)
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer media: %w", err)
+	}
+	answer.SetMedia(answerMedia)
+	if err := applyAnswerTranslations(answer, ans.Translations); err != nil {
+		return nil, fmt.Errorf("invalid answer translations: %w", err)
+	}
+
+	return answer, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+	"github.com/lib/pq"
 )
 
 // QuizRepository is a PostgreSQL implementation of quiz.QuizRepository
@@ -58,6 +59,12 @@ func (r *QuizRepository) FindByID(id quiz.QuizID) (*quiz.Quiz, error) {
 		quizData.streakThreshold,
 		quizData.streakBonus,
 	)
+	if err := applyQuizTranslations(q, quizData.translations); err != nil {
+		return nil, fmt.Errorf("invalid quiz translations: %w", err)
+	}
+	if err := applyQuizDeletedAt(q, quizData.deletedAt); err != nil {
+		return nil, err
+	}
 
 	// Add questions
 	for _, question := range questions {
@@ -69,12 +76,13 @@ func (r *QuizRepository) FindByID(id quiz.QuizID) (*quiz.Quiz, error) {
 	return q, nil
 }
 
-// FindAll retrieves all quizzes (without questions for performance)
+// FindAll retrieves all live quizzes (without questions for performance)
 func (r *QuizRepository) FindAll() ([]quiz.Quiz, error) {
 	query := `
 		SELECT id, title, description, category_id, time_limit, passing_score, created_at, updated_at, import_batch_id, generated_at,
-		       base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus
+		       base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus, translations, deleted_at
 		FROM quizzes
+		WHERE deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
@@ -111,6 +119,9 @@ func (r *QuizRepository) FindAll() ([]quiz.Quiz, error) {
 			quizData.streakThreshold,
 			quizData.streakBonus,
 		)
+		if err := applyQuizTranslations(q, quizData.translations); err != nil {
+			return nil, fmt.Errorf("invalid quiz translations: %w", err)
+		}
 
 		quizzes = append(quizzes, *q)
 	}
@@ -127,9 +138,10 @@ func (r *QuizRepository) FindAllSummaries() ([]*quiz.QuizSummary, error) {
 	query := `
 		SELECT
 			q.id, q.title, q.description, q.category_id, q.time_limit, q.passing_score, q.created_at,
-			COUNT(qu.id) as question_count
+			q.translations, COUNT(qu.id) as question_count
 		FROM quizzes q
-		LEFT JOIN questions qu ON q.id = qu.quiz_id
+		LEFT JOIN questions qu ON q.id = qu.quiz_id AND qu.deleted_at IS NULL
+		WHERE q.deleted_at IS NULL
 		GROUP BY q.id
 		ORDER BY q.created_at DESC
 	`
@@ -151,12 +163,13 @@ func (r *QuizRepository) FindAllSummaries() ([]*quiz.QuizSummary, error) {
 			timeLimit     int
 			passingScore  int
 			createdAt     int64
+			translations  []byte
 			questionCount int
 		)
 
 		err := rows.Scan(
 			&idStr, &title, &description, &categoryIDStr, &timeLimit,
-			&passingScore, &createdAt, &questionCount,
+			&passingScore, &createdAt, &translations, &questionCount,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan quiz summary: %w", err)
@@ -202,6 +215,9 @@ func (r *QuizRepository) FindAllSummaries() ([]*quiz.QuizSummary, error) {
 			createdAt,
 			questionCount,
 		)
+		if err := applyQuizTranslations(summary, translations); err != nil {
+			return nil, fmt.Errorf("invalid quiz translations: %w", err)
+		}
 
 		summaries = append(summaries, summary)
 	}
@@ -218,10 +234,10 @@ func (r *QuizRepository) FindSummariesByCategory(categoryID quiz.CategoryID) ([]
 	query := `
 		SELECT
 			q.id, q.title, q.description, q.category_id, q.time_limit, q.passing_score, q.created_at,
-			COUNT(qu.id) as question_count
+			q.translations, COUNT(qu.id) as question_count
 		FROM quizzes q
-		LEFT JOIN questions qu ON q.id = qu.quiz_id
-		WHERE q.category_id = $1
+		LEFT JOIN questions qu ON q.id = qu.quiz_id AND qu.deleted_at IS NULL
+		WHERE q.category_id = $1 AND q.deleted_at IS NULL
 		GROUP BY q.id
 		ORDER BY q.created_at DESC
 	`
@@ -243,12 +259,13 @@ func (r *QuizRepository) FindSummariesByCategory(categoryID quiz.CategoryID) ([]
 			timeLimit     int
 			passingScore  int
 			createdAt     int64
+			translations  []byte
 			questionCount int
 		)
 
 		err := rows.Scan(
 			&idStr, &title, &description, &categoryIDStr, &timeLimit,
-			&passingScore, &createdAt, &questionCount,
+			&passingScore, &createdAt, &translations, &questionCount,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan quiz summary by category: %w", err)
@@ -294,6 +311,9 @@ func (r *QuizRepository) FindSummariesByCategory(categoryID quiz.CategoryID) ([]
 			createdAt,
 			questionCount,
 		)
+		if err := applyQuizTranslations(summary, translations); err != nil {
+			return nil, fmt.Errorf("invalid quiz translations: %w", err)
+		}
 
 		summaries = append(summaries, summary)
 	}
@@ -305,7 +325,9 @@ func (r *QuizRepository) FindSummariesByCategory(categoryID quiz.CategoryID) ([]
 	return summaries, nil
 }
 
-// Save stores a quiz with all its questions and answers in a transaction
+// Save stores a quiz with all its questions and answers in a transaction.
+// Rows are upserted, and questions no longer on the quiz are soft-deleted, so
+// answers players gave keep their questions.
 func (r *QuizRepository) Save(q *quiz.Quiz) error {
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -313,8 +335,17 @@ func (r *QuizRepository) Save(q *quiz.Quiz) error {
 	}
 	defer tx.Rollback()
 
+	if err := r.SaveInTx(tx, q); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SaveInTx stores a quiz with all its questions and answers within an existing transaction
+func (r *QuizRepository) SaveInTx(tx *sql.Tx, q *quiz.Quiz) error {
 	// Save quiz
-	err = r.saveQuiz(tx, q)
+	err := r.saveQuiz(tx, q)
 	if err != nil {
 		return err
 	}
@@ -326,42 +357,51 @@ func (r *QuizRepository) Save(q *quiz.Quiz) error {
 		if err != nil {
 			return err
 		}
+	}
 
-		// Delete existing quiz-tag relationships
-		_, err = tx.Exec("DELETE FROM quiz_tags WHERE quiz_id = $1", q.ID().String())
-		if err != nil {
-			return fmt.Errorf("failed to delete existing quiz tags: %w", err)
-		}
-
-		// Create new quiz-tag relationships
-		err = r.assignTagsToQuiz(tx, q.ID(), tags)
-		if err != nil {
-			return err
-		}
+	// Replace quiz-tag relationships (an edit may have removed them all). Links to
+	// soft-deleted tags aren't loaded, so they are kept for when the tag is restored.
+	_, err = tx.Exec(`
+		DELETE FROM quiz_tags
+		WHERE quiz_id = $1 AND tag_id NOT IN (SELECT id FROM tags WHERE deleted_at IS NOT NULL)
+	`, q.ID().String())
+	if err != nil {
+		return fmt.Errorf("failed to delete existing quiz tags: %w", err)
 	}
 
-	// Delete existing questions and answers (will be re-inserted)
-	_, err = tx.Exec("DELETE FROM questions WHERE quiz_id = $1", q.ID().String())
+	err = r.assignTagsToQuiz(tx, q.ID(), tags)
 	if err != nil {
-		return fmt.Errorf("failed to delete existing questions: %w", err)
+		return err
 	}
 
 	// Save questions and answers
+	now := time.Now().Unix()
+	questionIDs := make([]string, 0, len(q.Questions()))
 	for _, question := range q.Questions() {
-		err = r.saveQuestion(tx, q.ID(), question)
+		err = saveQuestion(tx, q.ID(), question, now)
 		if err != nil {
 			return err
 		}
+		questionIDs = append(questionIDs, question.ID().String())
 	}
 
-	return tx.Commit()
+	// Soft-delete questions removed from the quiz
+	_, err = tx.Exec(`
+		UPDATE questions SET deleted_at = $3
+		WHERE quiz_id = $1 AND deleted_at IS NULL AND NOT (id::text = ANY($2))
+	`, q.ID().String(), pq.Array(questionIDs), now)
+	if err != nil {
+		return fmt.Errorf("failed to delete removed questions: %w", err)
+	}
+
+	return nil
 }
 
-// Delete removes a quiz (cascade will delete questions and answers)
+// Delete soft-deletes a quiz (deleting it again keeps the first timestamp)
 func (r *QuizRepository) Delete(id quiz.QuizID) error {
-	query := `DELETE FROM quizzes WHERE id = $1`
+	query := `UPDATE quizzes SET deleted_at = COALESCE(deleted_at, $2) WHERE id = $1`
 
-	result, err := r.db.Exec(query, id.String())
+	result, err := r.db.Exec(query, id.String(), time.Now().Unix())
 	if err != nil {
 		return fmt.Errorf("failed to delete quiz: %w", err)
 	}
@@ -399,13 +439,15 @@ type quizData struct {
 	maxTimeBonus         quiz.Points
 	streakThreshold      int
 	streakBonus          quiz.Points
+	translations         []byte // JSONB, applied once the aggregate is reconstructed
+	deletedAt            sql.NullInt64
 }
 
 // loadQuiz loads a quiz from database (without questions)
 func (r *QuizRepository) loadQuiz(id quiz.QuizID) (*quizData, error) {
 	query := `
 		SELECT id, title, description, category_id, time_limit, passing_score, created_at, updated_at, import_batch_id, generated_at,
-		       base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus
+		       base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus, translations, deleted_at
 		FROM quizzes
 		WHERE id = $1
 	`
@@ -434,10 +476,12 @@ func (r *QuizRepository) scanQuizRow(scanner interface {
 		maxTimeBonus         int
 		streakThreshold      int
 		streakBonus          int
+		translations         []byte
+		deletedAt            sql.NullInt64
 	)
 
 	err := scanner.Scan(&idStr, &title, &description, &categoryIDStr, &timeLimit, &passingScore, &createdAt, &updatedAt, &importBatchID, &generatedAtSQL,
-		&basePoints, &timeLimitPerQuestion, &maxTimeBonus, &streakThreshold, &streakBonus)
+		&basePoints, &timeLimitPerQuestion, &maxTimeBonus, &streakThreshold, &streakBonus, &translations, &deletedAt)
 	if err == sql.ErrNoRows {
 		return nil, quiz.ErrQuizNotFound
 	}
@@ -524,15 +568,35 @@ func (r *QuizRepository) scanQuizRow(scanner interface {
 		maxTimeBonus:         quizMaxTimeBonus,
 		streakThreshold:      streakThreshold,
 		streakBonus:          quizStreakBonus,
+		translations:         translations,
+		deletedAt:            deletedAt,
 	}, nil
 }
 
-// loadQuestions loads all questions with their answers for a quiz
+// applyQuizDeletedAt marks a reconstructed quiz as soft-deleted if it is
+func applyQuizDeletedAt(q *quiz.Quiz, deletedAt sql.NullInt64) error {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return q.Delete(deletedAt.Int64)
+}
+
+// nullableTimestamp stores a zero Unix timestamp as NULL
+func nullableTimestamp(ts int64) interface{} {
+	if ts == 0 {
+		return nil
+	}
+	return ts
+}
+
+// loadQuestions loads all live questions with their answers for a quiz
 func (r *QuizRepository) loadQuestions(quizID quiz.QuizID) ([]quiz.Question, error) {
 	query := `
-		SELECT id, text, points, position
+		SELECT id, text, points, position, difficulty,
+		       explanation, explanation_source_url, media, translations,
+		       question_type, numeric_answer
 		FROM questions
-		WHERE quiz_id = $1
+		WHERE quiz_id = $1 AND deleted_at IS NULL
 		ORDER BY position ASC
 	`
 
@@ -546,13 +610,20 @@ func (r *QuizRepository) loadQuestions(quizID quiz.QuizID) ([]quiz.Question, err
 
 	for rows.Next() {
 		var (
-			idStr    string
-			text     string
-			points   int
-			position int
+			idStr        string
+			text         string
+			points       int
+			position     int
+			difficulty   string
+			explanation  string
+			sourceURL    string
+			media        []byte
+			translations []byte
+			questionType string
+			numeric      []byte
 		)
 
-		err := rows.Scan(&idStr, &text, &points, &position)
+		err := rows.Scan(&idStr, &text, &points, &position, &difficulty, &explanation, &sourceURL, &media, &translations, &questionType, &numeric)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan question: %w", err)
 		}
@@ -573,11 +644,35 @@ func (r *QuizRepository) loadQuestions(quizID quiz.QuizID) ([]quiz.Question, err
 			return nil, fmt.Errorf("invalid points: %w", err)
 		}
 
+		questionDifficulty, err := quiz.NewDifficulty(difficulty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid difficulty: %w", err)
+		}
+
+		questionExplanation, err := quiz.NewExplanation(explanation, sourceURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid explanation: %w", err)
+		}
+
+		questionMedia, err := parseMedia(media)
+		if err != nil {
+			return nil, fmt.Errorf("invalid media: %w", err)
+		}
+
 		// Create question
 		question, err := quiz.NewQuestion(questionID, questionText, questionPoints, position)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create question: %w", err)
 		}
+		question.SetDifficulty(questionDifficulty)
+		question.SetExplanation(questionExplanation)
+		question.SetMedia(questionMedia)
+		if err := applyQuestionTranslations(question, translations); err != nil {
+			return nil, fmt.Errorf("invalid question translations: %w", err)
+		}
+		if err := applyQuestionType(question, questionType, numeric); err != nil {
+			return nil, fmt.Errorf("invalid question type: %w", err)
+		}
 
 		// Load answers for this question
 		answers, err := r.loadAnswers(questionID)
@@ -601,12 +696,12 @@ func (r *QuizRepository) loadQuestions(quizID quiz.QuizID) ([]quiz.Question, err
 	return questions, nil
 }
 
-// loadAnswers loads all answers for a question
+// loadAnswers loads all live answers for a question
 func (r *QuizRepository) loadAnswers(questionID quiz.QuestionID) ([]quiz.Answer, error) {
 	query := `
-		SELECT id, text, is_correct, position
+		SELECT id, text, is_correct, position, media, translations
 		FROM answers
-		WHERE question_id = $1
+		WHERE question_id = $1 AND deleted_at IS NULL
 		ORDER BY position ASC
 	`
 
@@ -620,13 +715,15 @@ func (r *QuizRepository) loadAnswers(questionID quiz.QuestionID) ([]quiz.Answer,
 
 	for rows.Next() {
 		var (
-			idStr     string
-			text      string
-			isCorrect bool
-			position  int
+			idStr        string
+			text         string
+			isCorrect    bool
+			position     int
+			media        []byte
+			translations []byte
 		)
 
-		err := rows.Scan(&idStr, &text, &isCorrect, &position)
+		err := rows.Scan(&idStr, &text, &isCorrect, &position, &media, &translations)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan answer: %w", err)
 		}
@@ -648,6 +745,15 @@ func (r *QuizRepository) loadAnswers(questionID quiz.QuestionID) ([]quiz.Answer,
 			return nil, fmt.Errorf("failed to create answer: %w", err)
 		}
 
+		answerMedia, err := parseMedia(media)
+		if err != nil {
+			return nil, fmt.Errorf("invalid answer media: %w", err)
+		}
+		answer.SetMedia(answerMedia)
+		if err := applyAnswerTranslations(answer, translations); err != nil {
+			return nil, fmt.Errorf("invalid answer translations: %w", err)
+		}
+
 		answers = append(answers, *answer)
 	}
 
@@ -662,8 +768,8 @@ func (r *QuizRepository) loadAnswers(questionID quiz.QuestionID) ([]quiz.Answer,
 func (r *QuizRepository) saveQuiz(tx *sql.Tx, q *quiz.Quiz) error {
 	query := `
 		INSERT INTO quizzes (id, title, description, category_id, time_limit, passing_score, created_at, updated_at, tags, import_batch_id, generated_at,
-		                     base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		                     base_points, time_limit_per_question, max_time_bonus, streak_threshold, streak_bonus, translations, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT (id) DO UPDATE SET
 			title = EXCLUDED.title,
 			description = EXCLUDED.description,
@@ -678,7 +784,9 @@ func (r *QuizRepository) saveQuiz(tx *sql.Tx, q *quiz.Quiz) error {
 			time_limit_per_question = EXCLUDED.time_limit_per_question,
 			max_time_bonus = EXCLUDED.max_time_bonus,
 			streak_threshold = EXCLUDED.streak_threshold,
-			streak_bonus = EXCLUDED.streak_bonus
+			streak_bonus = EXCLUDED.streak_bonus,
+			translations = EXCLUDED.translations,
+			deleted_at = EXCLUDED.deleted_at
 	`
 
 	var categoryIDStr interface{}
@@ -699,7 +807,12 @@ func (r *QuizRepository) saveQuiz(tx *sql.Tx, q *quiz.Quiz) error {
 		generatedAtSQL = time.Unix(*q.GeneratedAt(), 0)
 	}
 
-	_, err := tx.Exec(
+	translations, err := marshalQuizTranslations(q.Translations())
+	if err != nil {
+		return fmt.Errorf("failed to encode quiz translations: %w", err)
+	}
+
+	_, err = tx.Exec(
 		query,
 		q.ID().String(),
 		q.Title().String(),
@@ -717,6 +830,8 @@ func (r *QuizRepository) saveQuiz(tx *sql.Tx, q *quiz.Quiz) error {
 		q.MaxTimeBonus().Value(),
 		q.StreakThreshold(),
 		q.StreakBonus().Value(),
+		translations,
+		nullableTimestamp(q.DeletedAt()),
 	)
 
 	if err != nil {
@@ -726,21 +841,56 @@ func (r *QuizRepository) saveQuiz(tx *sql.Tx, q *quiz.Quiz) error {
 	return nil
 }
 
-// saveQuestion saves a question with its answers
-func (r *QuizRepository) saveQuestion(tx *sql.Tx, quizID quiz.QuizID, q quiz.Question) error {
+// saveQuestion upserts a question with its answers; answers no longer on the
+// question are soft-deleted at now. Shared by QuizRepository and QuestionRepository.
+func saveQuestion(tx *sql.Tx, quizID quiz.QuizID, q quiz.Question, now int64) error {
+	media, err := marshalMedia(q.Media())
+	if err != nil {
+		return fmt.Errorf("failed to encode question media: %w", err)
+	}
+	translations, err := marshalQuestionTranslations(q.Translations())
+	if err != nil {
+		return fmt.Errorf("failed to encode question translations: %w", err)
+	}
+	numericAnswer, err := marshalNumericAnswer(q.NumericAnswer())
+	if err != nil {
+		return fmt.Errorf("failed to encode numeric answer: %w", err)
+	}
+
 	// Save question
 	query := `
-		INSERT INTO questions (id, quiz_id, text, points, position)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO questions (id, quiz_id, text, points, position, difficulty, explanation, explanation_source_url, media, translations, question_type, numeric_answer, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			quiz_id = EXCLUDED.quiz_id,
+			text = EXCLUDED.text,
+			points = EXCLUDED.points,
+			position = EXCLUDED.position,
+			difficulty = EXCLUDED.difficulty,
+			explanation = EXCLUDED.explanation,
+			explanation_source_url = EXCLUDED.explanation_source_url,
+			media = EXCLUDED.media,
+			translations = EXCLUDED.translations,
+			question_type = EXCLUDED.question_type,
+			numeric_answer = EXCLUDED.numeric_answer,
+			deleted_at = EXCLUDED.deleted_at
 	`
 
-	_, err := tx.Exec(
+	_, err = tx.Exec(
 		query,
 		q.ID().String(),
 		quizID.String(),
 		q.Text().String(),
 		q.Points().Value(),
 		q.Position(),
+		q.Difficulty().String(),
+		q.Explanation().Text(),
+		q.Explanation().SourceURL(),
+		media,
+		translations,
+		q.Type().String(),
+		numericAnswer,
+		nullableTimestamp(q.DeletedAt()),
 	)
 
 	if err != nil {
@@ -748,30 +898,60 @@ func (r *QuizRepository) saveQuestion(tx *sql.Tx, quizID quiz.QuizID, q quiz.Que
 	}
 
 	// Save answers
+	answerIDs := make([]string, 0, len(q.Answers()))
 	for _, answer := range q.Answers() {
-		err = r.saveAnswer(tx, q.ID(), answer)
+		err = saveAnswer(tx, q.ID(), answer)
 		if err != nil {
 			return err
 		}
+		answerIDs = append(answerIDs, answer.ID().String())
+	}
+
+	// Soft-delete answers removed from the question
+	_, err = tx.Exec(`
+		UPDATE answers SET deleted_at = $3
+		WHERE question_id = $1 AND deleted_at IS NULL AND NOT (id::text = ANY($2))
+	`, q.ID().String(), pq.Array(answerIDs), now)
+	if err != nil {
+		return fmt.Errorf("failed to delete removed answers: %w", err)
 	}
 
 	return nil
 }
 
-// saveAnswer saves an answer
-func (r *QuizRepository) saveAnswer(tx *sql.Tx, questionID quiz.QuestionID, a quiz.Answer) error {
+// saveAnswer upserts an answer (saving it makes it live again)
+func saveAnswer(tx *sql.Tx, questionID quiz.QuestionID, a quiz.Answer) error {
+	media, err := marshalMedia(a.Media())
+	if err != nil {
+		return fmt.Errorf("failed to encode answer media: %w", err)
+	}
+	translations, err := marshalAnswerTranslations(a.Translations())
+	if err != nil {
+		return fmt.Errorf("failed to encode answer translations: %w", err)
+	}
+
 	query := `
-		INSERT INTO answers (id, question_id, text, is_correct, position)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO answers (id, question_id, text, is_correct, position, media, translations)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+			question_id = EXCLUDED.question_id,
+			text = EXCLUDED.text,
+			is_correct = EXCLUDED.is_correct,
+			position = EXCLUDED.position,
+			media = EXCLUDED.media,
+			translations = EXCLUDED.translations,
+			deleted_at = NULL
 	`
 
-	_, err := tx.Exec(
+	_, err = tx.Exec(
 		query,
 		a.ID().String(),
 		questionID.String(),
 		a.Text().String(),
 		a.IsCorrect(),
 		a.Position(),
+		media,
+		translations,
 	)
 
 	if err != nil {
@@ -816,22 +996,19 @@ func (r *QuizRepository) assignTagsToQuiz(tx *sql.Tx, quizID quiz.QuizID, tags [
 		return nil
 	}
 
-	// Build batch insert
-	valueStrings := make([]string, 0, len(tags))
-	valueArgs := make([]interface{}, 0, len(tags)*2)
-
+	// Tag IDs are resolved by name: a renamed tag keeps the ID of its old name
+	names := make([]string, len(tags))
 	for i, tag := range tags {
-		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, CURRENT_TIMESTAMP)", i*2+1, i*2+2))
-		valueArgs = append(valueArgs, quizID.String(), tag.ID().String())
+		names[i] = tag.Name().String()
 	}
 
-	query := fmt.Sprintf(`
+	query := `
 		INSERT INTO quiz_tags (quiz_id, tag_id, created_at)
-		VALUES %s
+		SELECT $1, id, CURRENT_TIMESTAMP FROM tags WHERE name = ANY($2)
 		ON CONFLICT (quiz_id, tag_id) DO NOTHING
-	`, strings.Join(valueStrings, ","))
+	`
 
-	_, err := tx.Exec(query, valueArgs...)
+	_, err := tx.Exec(query, quizID.String(), pq.Array(names))
 	if err != nil {
 		return fmt.Errorf("failed to assign tags to quiz: %w", err)
 	}
@@ -845,7 +1022,7 @@ func (r *QuizRepository) loadTags(quizID quiz.QuizID) ([]quiz.Tag, error) {
 		SELECT t.id, t.name
 		FROM tags t
 		INNER JOIN quiz_tags qt ON t.id = qt.tag_id
-		WHERE qt.quiz_id = $1
+		WHERE qt.quiz_id = $1 AND t.deleted_at IS NULL
 		ORDER BY t.name
 	`
 
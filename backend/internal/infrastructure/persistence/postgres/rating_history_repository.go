@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+)
+
+// RatingHistoryRepository is a PostgreSQL implementation of quick_duel.RatingHistoryRepository
+type RatingHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewRatingHistoryRepository creates a new PostgreSQL rating history repository
+func NewRatingHistoryRepository(db *sql.DB) *RatingHistoryRepository {
+	return &RatingHistoryRepository{db: db}
+}
+
+const ratingHistoryColumns = `player_id, game_id, season_id,
+	mmr_before, mmr_after, league_before, division_before, league_after, division_after,
+	rank_event, played_at`
+
+// Save inserts a history entry; a second entry for the same player and game is ignored
+func (r *RatingHistoryRepository) Save(entry *quick_duel.RatingHistoryEntry) error {
+	change := entry.Change()
+	_, err := r.db.Exec(`
+		INSERT INTO duel_rating_history (`+ratingHistoryColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (player_id, game_id) DO NOTHING
+	`,
+		entry.PlayerID().String(),
+		entry.GameID().String(),
+		entry.SeasonID(),
+		change.MMRBefore,
+		change.MMRAfter,
+		change.LeagueBefore.String(),
+		change.DivisionBefore.Value(),
+		change.LeagueAfter.String(),
+		change.DivisionAfter.Value(),
+		string(change.RankEvent),
+		entry.PlayedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rating history: %w", err)
+	}
+	return nil
+}
+
+// FindByGame retrieves the entries of both players of a game
+func (r *RatingHistoryRepository) FindByGame(gameID quick_duel.GameID) ([]*quick_duel.RatingHistoryEntry, error) {
+	rows, err := r.db.Query(`
+		SELECT `+ratingHistoryColumns+`
+		FROM duel_rating_history
+		WHERE game_id = $1
+	`, gameID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRatingHistory(rows)
+}
+
+// FindByGames retrieves a player's entries for the given games
+func (r *RatingHistoryRepository) FindByGames(playerID quick_duel.UserID, gameIDs []quick_duel.GameID) ([]*quick_duel.RatingHistoryEntry, error) {
+	ids := make([]string, 0, len(gameIDs))
+	for _, id := range gameIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.db.Query(`
+		SELECT `+ratingHistoryColumns+`
+		FROM duel_rating_history
+		WHERE player_id = $1 AND game_id = ANY($2)
+	`, playerID.String(), pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRatingHistory(rows)
+}
+
+// FindBySeason retrieves a player's entries in a season, oldest first
+func (r *RatingHistoryRepository) FindBySeason(playerID quick_duel.UserID, seasonID string) ([]*quick_duel.RatingHistoryEntry, error) {
+	rows, err := r.db.Query(`
+		SELECT `+ratingHistoryColumns+`
+		FROM duel_rating_history
+		WHERE player_id = $1 AND season_id = $2
+		ORDER BY played_at, game_id
+	`, playerID.String(), seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRatingHistory(rows)
+}
+
+func scanRatingHistory(rows *sql.Rows) ([]*quick_duel.RatingHistoryEntry, error) {
+	var entries []*quick_duel.RatingHistoryEntry
+	for rows.Next() {
+		var (
+			playerIDStr     string
+			gameID          string
+			seasonID        string
+			mmrBefore       int
+			mmrAfter        int
+			leagueBeforeStr string
+			divisionBefore  int
+			leagueAfterStr  string
+			divisionAfter   int
+			rankEvent       string
+			playedAt        int64
+		)
+		if err := rows.Scan(
+			&playerIDStr, &gameID, &seasonID,
+			&mmrBefore, &mmrAfter, &leagueBeforeStr, &divisionBefore, &leagueAfterStr, &divisionAfter,
+			&rankEvent, &playedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan rating history: %w", err)
+		}
+
+		pid, _ := shared.NewUserID(playerIDStr)
+		entries = append(entries, quick_duel.ReconstructRatingHistoryEntry(
+			pid,
+			quick_duel.NewGameIDFromString(gameID),
+			seasonID,
+			quick_duel.RatingChange{
+				MMRBefore:      mmrBefore,
+				MMRAfter:       mmrAfter,
+				LeagueBefore:   stringToLeague(leagueBeforeStr),
+				DivisionBefore: quick_duel.Division(divisionBefore),
+				LeagueAfter:    stringToLeague(leagueAfterStr),
+				DivisionAfter:  quick_duel.Division(divisionAfter),
+				RankEvent:      quick_duel.RankEvent(rankEvent),
+			},
+			playedAt,
+		))
+	}
+
+	return entries, rows.Err()
+}
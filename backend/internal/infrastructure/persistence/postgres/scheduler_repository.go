@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"hash/fnv"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/scheduler"
+)
+
+// ========================================
+// Advisory lock leader election
+// ========================================
+
+// SchedulerLocker is a PostgreSQL implementation of scheduler.Locker.
+// It holds a session-level advisory lock on a dedicated connection for the
+// duration of a run, so among all API replicas only one runs a job at a time.
+// If the replica dies, the connection closes and Postgres releases the lock.
+type SchedulerLocker struct {
+	db *sql.DB
+}
+
+func NewSchedulerLocker(db *sql.DB) *SchedulerLocker {
+	return &SchedulerLocker{db: db}
+}
+
+func (l *SchedulerLocker) TryLock(ctx context.Context, jobName string) (func(), bool, error) {
+	key := schedulerLockKey(jobName)
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+			// Never return a connection still holding the lock to the pool:
+			// discarding it ends the session, which releases the lock
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+	return unlock, true, nil
+}
+
+// schedulerLockKey maps a job name to an advisory lock key
+func schedulerLockKey(jobName string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("scheduler:" + jobName))
+	return int64(h.Sum64())
+}
+
+// ========================================
+// Run history
+// ========================================
+
+// JobRunRepository is a PostgreSQL implementation of scheduler.RunStore
+type JobRunRepository struct {
+	db *sql.DB
+}
+
+func NewJobRunRepository(db *sql.DB) *JobRunRepository {
+	return &JobRunRepository{db: db}
+}
+
+// Claim inserts a running row. The partial unique index on (job_name, scheduled_at)
+// for scheduled runs makes a slot already run by another replica a no-op.
+func (r *JobRunRepository) Claim(ctx context.Context, run *scheduler.Run) (bool, error) {
+	err := r.db.QueryRowContext(ctx,
+		`INSERT INTO scheduler_job_runs (job_name, triggered_by, scheduled_at, started_at, status, instance)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (job_name, scheduled_at) WHERE triggered_by = 'schedule' DO NOTHING
+		 RETURNING id`,
+		run.JobName,
+		string(run.Trigger),
+		run.ScheduledAt,
+		run.StartedAt,
+		string(run.Status),
+		run.Instance,
+	).Scan(&run.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *JobRunRepository) Finish(ctx context.Context, run *scheduler.Run) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE scheduler_job_runs
+		 SET finished_at = $2, status = $3, summary = $4, error = $5
+		 WHERE id = $1`,
+		run.ID,
+		run.FinishedAt,
+		string(run.Status),
+		run.Summary,
+		run.Error,
+	)
+	return err
+}
+
+func (r *JobRunRepository) ListRecent(ctx context.Context, jobName string, limit int) ([]scheduler.Run, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, job_name, triggered_by, scheduled_at, started_at, COALESCE(finished_at, 0),
+		        status, summary, error, instance
+		 FROM scheduler_job_runs
+		 WHERE job_name = $1
+		 ORDER BY started_at DESC, id DESC
+		 LIMIT $2`,
+		jobName,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]scheduler.Run, 0, limit)
+	for rows.Next() {
+		var (
+			run     scheduler.Run
+			trigger string
+			status  string
+		)
+		if err := rows.Scan(
+			&run.ID, &run.JobName, &trigger, &run.ScheduledAt, &run.StartedAt, &run.FinishedAt,
+			&status, &run.Summary, &run.Error, &run.Instance,
+		); err != nil {
+			return nil, err
+		}
+		run.Trigger = scheduler.Trigger(trigger)
+		run.Status = scheduler.RunStatus(status)
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+func (r *JobRunRepository) DeleteFinishedBefore(ctx context.Context, before int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM scheduler_job_runs WHERE status <> 'running' AND started_at < $1`,
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
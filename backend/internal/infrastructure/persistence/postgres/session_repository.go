@@ -127,7 +127,7 @@ func (r *SessionRepository) FindByID(id quiz.SessionID) (*quiz.QuizSession, erro
 // loadUserAnswers retrieves all user answers for a session
 func (r *SessionRepository) loadUserAnswers(sessionID quiz.SessionID) ([]quiz.UserAnswer, error) {
 	query := `
-		SELECT question_id, answer_id, is_correct, base_points, time_bonus, streak_bonus, time_spent, answered_at
+		SELECT question_id, answer_id, is_correct, base_points, time_bonus, streak_bonus, time_spent, answered_at, submission
 		FROM user_answers
 		WHERE session_id = $1
 		ORDER BY answered_at ASC
@@ -144,13 +144,14 @@ func (r *SessionRepository) loadUserAnswers(sessionID quiz.SessionID) ([]quiz.Us
 	for rows.Next() {
 		var (
 			questionID  string
-			answerID    string
+			answerID    sql.NullString // NULL for a numeric answer
 			isCorrect   bool
 			basePoints  int
 			timeBonus   int
 			streakBonus int
 			timeSpent   int64
 			answeredAt  int64
+			submission  []byte
 		)
 
 		err := rows.Scan(
@@ -162,6 +163,7 @@ func (r *SessionRepository) loadUserAnswers(sessionID quiz.SessionID) ([]quiz.Us
 			&streakBonus,
 			&timeSpent,
 			&answeredAt,
+			&submission,
 		)
 
 		if err != nil {
@@ -174,9 +176,9 @@ func (r *SessionRepository) loadUserAnswers(sessionID quiz.SessionID) ([]quiz.Us
 			return nil, fmt.Errorf("failed to parse question ID: %w", err)
 		}
 
-		answerIDVO, err := quiz.NewAnswerIDFromString(answerID)
+		submissionVO, err := parseSubmission(answerID.String, submission)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse answer ID: %w", err)
+			return nil, fmt.Errorf("failed to parse answer: %w", err)
 		}
 
 		// Parse points
@@ -187,7 +189,7 @@ func (r *SessionRepository) loadUserAnswers(sessionID quiz.SessionID) ([]quiz.Us
 		// Create UserAnswer with breakdown
 		answer := quiz.NewUserAnswerWithBreakdown(
 			questionIDVO,
-			answerIDVO,
+			submissionVO,
 			isCorrect,
 			basePointsVO,
 			timeBonusVO,
@@ -325,6 +327,20 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 	}
 	defer tx.Rollback() // Rollback if not committed
 
+	if err := r.SaveInTx(tx, session); err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SaveInTx persists a quiz session with all user answers within an existing transaction
+func (r *SessionRepository) SaveInTx(tx *sql.Tx, session *quiz.QuizSession) error {
 	// UPSERT quiz_sessions
 	sessionQuery := `
 		INSERT INTO quiz_sessions (id, quiz_id, user_id, current_question, score, status, started_at, completed_at, correct_answer_streak)
@@ -344,7 +360,7 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 		completedAtNullable = sql.NullInt64{Valid: false}
 	}
 
-	_, err = tx.Exec(
+	_, err := tx.Exec(
 		sessionQuery,
 		session.ID().String(),
 		session.QuizID().String(),
@@ -374,9 +390,14 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 	for i := existingCount; i < len(answers); i++ {
 		answer := answers[i]
 
+		submission, err := marshalSubmission(answer.Submission())
+		if err != nil {
+			return fmt.Errorf("failed to encode user answer: %w", err)
+		}
+
 		answerQuery := `
-			INSERT INTO user_answers (session_id, question_id, answer_id, is_correct, base_points, time_bonus, streak_bonus, time_spent, answered_at, points)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			INSERT INTO user_answers (session_id, question_id, answer_id, is_correct, base_points, time_bonus, streak_bonus, time_spent, answered_at, points, submission)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 			ON CONFLICT (session_id, question_id) DO NOTHING
 		`
 
@@ -384,7 +405,7 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 			answerQuery,
 			session.ID().String(),
 			answer.QuestionID().String(),
-			answer.AnswerID().String(),
+			nullableAnswerID(answer.AnswerID()),
 			answer.IsCorrect(),
 			answer.BasePoints().Value(),
 			answer.TimeBonus().Value(),
@@ -392,6 +413,7 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 			answer.TimeSpent(),
 			answer.AnsweredAt(),
 			answer.TotalPoints().Value(), // Legacy points column
+			submission,
 		)
 
 		if err != nil {
@@ -399,11 +421,6 @@ func (r *SessionRepository) Save(session *quiz.QuizSession) error {
 		}
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
 	return nil
 }
 
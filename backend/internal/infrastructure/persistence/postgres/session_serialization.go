@@ -27,10 +27,12 @@ type SerializedSession struct {
 
 // SerializedAnswer represents AnswerData in JSON format
 type SerializedAnswer struct {
-	AnswerID   string `json:"answer_id"`
+	AnswerID   string `json:"answer_id"` // first picked option; empty for a number
 	IsCorrect  bool   `json:"is_correct"`
 	TimeTaken  int64  `json:"time_taken"`
 	AnsweredAt int64  `json:"answered_at"`
+
+	Submission *submissionRecord `json:"submission,omitempty"` // anything but a single option
 }
 
 // serializeSession converts kernel.QuizGameplaySession to JSON bytes
@@ -42,12 +44,16 @@ func serializeSession(session *kernel.QuizGameplaySession) ([]byte, error) {
 	// Convert userAnswers map
 	userAnswers := make(map[string]SerializedAnswer)
 	for questionID, answerData := range session.GetAllAnswers() {
-		userAnswers[questionID.String()] = SerializedAnswer{
-			AnswerID:   answerData.AnswerID().String(),
+		serializedAnswer := SerializedAnswer{
 			IsCorrect:  answerData.IsCorrect(),
 			TimeTaken:  answerData.TimeTaken(),
 			AnsweredAt: answerData.AnsweredAt(),
+			Submission: toSubmissionRecord(answerData.Submission()),
+		}
+		if answerID := answerData.AnswerID(); !answerID.IsZero() {
+			serializedAnswer.AnswerID = answerID.String()
 		}
+		userAnswers[questionID.String()] = serializedAnswer
 	}
 
 	serialized := SerializedSession{
@@ -93,13 +99,13 @@ func deserializeSession(
 			return nil, fmt.Errorf("invalid question_id: %w", err)
 		}
 
-		answerID, err := quiz.NewAnswerIDFromString(serializedAnswer.AnswerID)
+		submission, err := submissionFromRecord(serializedAnswer.AnswerID, serializedAnswer.Submission)
 		if err != nil {
 			return nil, fmt.Errorf("invalid answer_id: %w", err)
 		}
 
 		userAnswers[questionID] = kernel.NewAnswerData(
-			answerID,
+			submission,
 			serializedAnswer.IsCorrect,
 			serializedAnswer.TimeTaken,
 			serializedAnswer.AnsweredAt,
@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shop"
+)
+
+// ShopCatalogRepository is a PostgreSQL implementation of shop.CatalogRepository
+type ShopCatalogRepository struct {
+	db *sql.DB
+}
+
+// NewShopCatalogRepository creates a new PostgreSQL shop catalog repository
+func NewShopCatalogRepository(db *sql.DB) *ShopCatalogRepository {
+	return &ShopCatalogRepository{db: db}
+}
+
+const shopItemColumns = `
+	id, title, description, contents, price_coins, purchase_limit, limit_window,
+	available_from, available_until, is_active, sort_order
+`
+
+// FindAll retrieves every catalog item ordered for display
+func (r *ShopCatalogRepository) FindAll() ([]*shop.Item, error) {
+	rows, err := r.db.Query(`SELECT ` + shopItemColumns + ` FROM shop_items ORDER BY sort_order, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shop items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*shop.Item
+	for rows.Next() {
+		item, err := scanShopItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shop items: %w", err)
+	}
+
+	return items, nil
+}
+
+// FindByID retrieves a catalog item
+func (r *ShopCatalogRepository) FindByID(id shop.ItemID) (*shop.Item, error) {
+	item, err := scanShopItem(r.db.QueryRow(`SELECT `+shopItemColumns+` FROM shop_items WHERE id = $1`, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, shop.ErrItemNotFound
+	}
+	return item, err
+}
+
+func scanShopItem(row interface{ Scan(dest ...any) error }) (*shop.Item, error) {
+	var (
+		id             string
+		title          string
+		description    string
+		contentsJSON   []byte
+		priceCoins     int
+		purchaseLimit  int
+		limitWindow    string
+		availableFrom  int64
+		availableUntil int64
+		active         bool
+		sortOrder      int
+	)
+
+	err := row.Scan(&id, &title, &description, &contentsJSON, &priceCoins, &purchaseLimit, &limitWindow,
+		&availableFrom, &availableUntil, &active, &sortOrder)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan shop item: %w", err)
+	}
+
+	itemID, err := shop.NewItemID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shop item id %q: %w", id, err)
+	}
+
+	var contents map[string]int
+	if err := json.Unmarshal(contentsJSON, &contents); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal shop item contents: %w", err)
+	}
+
+	return shop.ReconstructItem(itemID, title, description, contents, priceCoins, purchaseLimit,
+		shop.LimitWindow(limitWindow), availableFrom, availableUntil, active, sortOrder), nil
+}
+
+// ShopPurchaseRepository is a PostgreSQL implementation of shop.PurchaseRepository
+type ShopPurchaseRepository struct {
+	db *sql.DB
+}
+
+// NewShopPurchaseRepository creates a new PostgreSQL shop purchase repository
+func NewShopPurchaseRepository(db *sql.DB) *ShopPurchaseRepository {
+	return &ShopPurchaseRepository{db: db}
+}
+
+// SaveInTx persists a purchase within an existing transaction
+func (r *ShopPurchaseRepository) SaveInTx(tx *sql.Tx, purchase *shop.Purchase) error {
+	contentsJSON, err := json.Marshal(purchase.Contents())
+	if err != nil {
+		return fmt.Errorf("failed to marshal purchase contents: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO shop_purchases (id, player_id, item_id, price_coins, contents, idempotency_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
+	`,
+		purchase.ID(),
+		purchase.PlayerID().String(),
+		purchase.ItemID().String(),
+		purchase.PriceCoins(),
+		contentsJSON,
+		purchase.IdempotencyKey(),
+		purchase.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save purchase: %w", err)
+	}
+	return nil
+}
+
+// CountByPlayerSinceInTx counts the player's purchases of an item since the given time
+func (r *ShopPurchaseRepository) CountByPlayerSinceInTx(tx *sql.Tx, playerID shop.UserID, itemID shop.ItemID, since int64) (int, error) {
+	var count int
+	err := tx.QueryRow(`
+		SELECT COUNT(*)
+		FROM shop_purchases
+		WHERE player_id = $1 AND item_id = $2 AND created_at >= $3
+	`, playerID.String(), itemID.String(), since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purchases: %w", err)
+	}
+	return count, nil
+}
+
+// CountByPlayerSince counts the player's purchases of each item since that item's window start
+func (r *ShopPurchaseRepository) CountByPlayerSince(playerID shop.UserID, windowStarts map[string]int64) (map[string]int, error) {
+	windowsJSON, err := json.Marshal(windowStarts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal limit windows: %w", err)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT p.item_id, COUNT(*)
+		FROM shop_purchases p
+		JOIN jsonb_each_text($2::JSONB) w ON w.key = p.item_id
+		WHERE p.player_id = $1 AND p.created_at >= w.value::BIGINT
+		GROUP BY p.item_id
+	`, playerID.String(), windowsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count purchases: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			itemID string
+			count  int
+		)
+		if err := rows.Scan(&itemID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase count: %w", err)
+		}
+		counts[itemID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating purchase counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// FindByIdempotencyKey retrieves the player's purchase made with the key, or nil
+func (r *ShopPurchaseRepository) FindByIdempotencyKey(playerID shop.UserID, key string) (*shop.Purchase, error) {
+	var (
+		id           string
+		dbPlayerID   string
+		itemID       string
+		priceCoins   int
+		contentsJSON []byte
+		createdAt    int64
+	)
+
+	err := r.db.QueryRow(`
+		SELECT id, player_id, item_id, price_coins, contents, created_at
+		FROM shop_purchases
+		WHERE player_id = $1 AND idempotency_key = $2
+	`, playerID.String(), key).Scan(&id, &dbPlayerID, &itemID, &priceCoins, &contentsJSON, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purchase: %w", err)
+	}
+
+	uid, err := shared.NewUserID(dbPlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player_id in purchase: %w", err)
+	}
+	purchasedItemID, err := shop.NewItemID(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item_id in purchase: %w", err)
+	}
+
+	var contents map[string]int
+	if err := json.Unmarshal(contentsJSON, &contents); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal purchase contents: %w", err)
+	}
+
+	return shop.ReconstructPurchase(id, uid, purchasedItemID, priceCoins, contents, key, createdAt), nil
+}
@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/social"
+)
+
+// ========================================
+// FriendshipRepository
+// ========================================
+
+// FriendshipRepository is a PostgreSQL implementation of social.FriendshipRepository
+type FriendshipRepository struct {
+	db *sql.DB
+}
+
+// NewFriendshipRepository creates a new PostgreSQL friendship repository
+func NewFriendshipRepository(db *sql.DB) *FriendshipRepository {
+	return &FriendshipRepository{db: db}
+}
+
+const friendshipColumns = `id, requester_id, addressee_id, status, created_at, accepted_at`
+
+// Create inserts a new friend request; the pair index rejects a second one in either direction
+func (r *FriendshipRepository) Create(friendship *social.Friendship) error {
+	result, err := r.db.Exec(`
+		INSERT INTO friendships (`+friendshipColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+	`,
+		friendship.ID().String(),
+		friendship.RequesterID().String(),
+		friendship.AddresseeID().String(),
+		friendship.Status().String(),
+		friendship.CreatedAt(),
+		friendship.AcceptedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create friend request: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to create friend request: %w", err)
+	}
+	if rows == 0 {
+		return social.ErrFriendshipExists
+	}
+	return nil
+}
+
+// UpdateFrom saves the friendship's status if nobody changed it since it was loaded
+func (r *FriendshipRepository) UpdateFrom(friendship *social.Friendship, loadedStatus social.FriendshipStatus) error {
+	result, err := r.db.Exec(`
+		UPDATE friendships SET status = $2, accepted_at = $3
+		WHERE id = $1 AND status = $4
+	`,
+		friendship.ID().String(),
+		friendship.Status().String(),
+		friendship.AcceptedAt(),
+		loadedStatus.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update friendship: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update friendship: %w", err)
+	}
+	if rows == 0 {
+		return social.ErrFriendshipChanged
+	}
+	return nil
+}
+
+// Delete removes a friend request or friendship
+func (r *FriendshipRepository) Delete(id social.FriendshipID) error {
+	result, err := r.db.Exec(`DELETE FROM friendships WHERE id = $1`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete friendship: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete friendship: %w", err)
+	}
+	if rows == 0 {
+		return social.ErrFriendshipNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves a friend request or friendship
+func (r *FriendshipRepository) FindByID(id social.FriendshipID) (*social.Friendship, error) {
+	row := r.db.QueryRow(`SELECT `+friendshipColumns+` FROM friendships WHERE id = $1`, id.String())
+	return scanFriendship(row)
+}
+
+// FindBetween retrieves the request or friendship between two players, whoever sent it
+func (r *FriendshipRepository) FindBetween(playerID social.UserID, otherID social.UserID) (*social.Friendship, error) {
+	row := r.db.QueryRow(`
+		SELECT `+friendshipColumns+` FROM friendships
+		WHERE (requester_id = $1 AND addressee_id = $2)
+		   OR (requester_id = $2 AND addressee_id = $1)
+	`, playerID.String(), otherID.String())
+	return scanFriendship(row)
+}
+
+// FindByPlayer retrieves the player's friendships and pending requests, newest first
+func (r *FriendshipRepository) FindByPlayer(playerID social.UserID) ([]*social.Friendship, error) {
+	rows, err := r.db.Query(`
+		SELECT `+friendshipColumns+` FROM friendships
+		WHERE requester_id = $1 OR addressee_id = $1
+		ORDER BY GREATEST(created_at, accepted_at) DESC
+	`, playerID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find friendships: %w", err)
+	}
+	defer rows.Close()
+
+	var friendships []*social.Friendship
+	for rows.Next() {
+		friendship, err := scanFriendship(rows)
+		if err != nil {
+			return nil, err
+		}
+		friendships = append(friendships, friendship)
+	}
+	return friendships, rows.Err()
+}
+
+// FindFriendIDs retrieves the IDs of the player's accepted friends
+func (r *FriendshipRepository) FindFriendIDs(playerID social.UserID) ([]social.UserID, error) {
+	rows, err := r.db.Query(`
+		SELECT CASE WHEN requester_id = $1 THEN addressee_id ELSE requester_id END
+		FROM friendships
+		WHERE (requester_id = $1 OR addressee_id = $1) AND status = 'accepted'
+	`, playerID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find friend IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var friendIDs []social.UserID
+	for rows.Next() {
+		var idStr string
+		if err := rows.Scan(&idStr); err != nil {
+			return nil, err
+		}
+		friendID, err := shared.NewUserID(idStr)
+		if err != nil {
+			continue
+		}
+		friendIDs = append(friendIDs, friendID)
+	}
+	return friendIDs, rows.Err()
+}
+
+// CountFriends returns how many accepted friends the player has
+func (r *FriendshipRepository) CountFriends(playerID social.UserID) (int, error) {
+	var count int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM friendships
+		WHERE (requester_id = $1 OR addressee_id = $1) AND status = 'accepted'
+	`, playerID.String()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count friends: %w", err)
+	}
+	return count, nil
+}
+
+// scanFriendship reads a friendship from a row of friendshipColumns
+func scanFriendship(row interface{ Scan(dest ...any) error }) (*social.Friendship, error) {
+	var (
+		idStr          string
+		requesterIDStr string
+		addresseeIDStr string
+		status         string
+		createdAt      int64
+		acceptedAt     int64
+	)
+	err := row.Scan(&idStr, &requesterIDStr, &addresseeIDStr, &status, &createdAt, &acceptedAt)
+	if err == sql.ErrNoRows {
+		return nil, social.ErrFriendshipNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan friendship: %w", err)
+	}
+
+	id, err := social.NewFriendshipIDFromString(idStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid friendship ID: %w", err)
+	}
+	requesterID, err := shared.NewUserID(requesterIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid requester ID in friendship: %w", err)
+	}
+	addresseeID, err := shared.NewUserID(addresseeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid addressee ID in friendship: %w", err)
+	}
+
+	return social.ReconstructFriendship(
+		id,
+		requesterID,
+		addresseeID,
+		social.FriendshipStatus(status),
+		createdAt,
+		acceptedAt,
+	), nil
+}
+
+// ========================================
+// BlockRepository
+// ========================================
+
+// BlockRepository is a PostgreSQL implementation of social.BlockRepository
+type BlockRepository struct {
+	db *sql.DB
+}
+
+// NewBlockRepository creates a new PostgreSQL player block repository
+func NewBlockRepository(db *sql.DB) *BlockRepository {
+	return &BlockRepository{db: db}
+}
+
+// Save records the block and ends any friendship or request between the two players
+func (r *BlockRepository) Save(block *social.Block) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO player_blocks (blocker_id, blocked_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`, block.BlockerID().String(), block.BlockedID().String(), block.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to save player block: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM friendships
+		WHERE (requester_id = $1 AND addressee_id = $2)
+		   OR (requester_id = $2 AND addressee_id = $1)
+	`, block.BlockerID().String(), block.BlockedID().String())
+	if err != nil {
+		return fmt.Errorf("failed to end friendship of blocked player: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Delete lifts a block
+func (r *BlockRepository) Delete(blockerID social.UserID, blockedID social.UserID) error {
+	result, err := r.db.Exec(`
+		DELETE FROM player_blocks WHERE blocker_id = $1 AND blocked_id = $2
+	`, blockerID.String(), blockedID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete player block: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete player block: %w", err)
+	}
+	if rows == 0 {
+		return social.ErrBlockNotFound
+	}
+	return nil
+}
+
+// IsBlocked reports whether either player blocked the other
+func (r *BlockRepository) IsBlocked(playerID social.UserID, otherID social.UserID) (bool, error) {
+	var blocked bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM player_blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2)
+			   OR (blocker_id = $2 AND blocked_id = $1)
+		)
+	`, playerID.String(), otherID.String()).Scan(&blocked)
+	if err != nil {
+		return false, fmt.Errorf("failed to check player block: %w", err)
+	}
+	return blocked, nil
+}
+
+// FindByBlocker retrieves the players the player blocked, newest first
+func (r *BlockRepository) FindByBlocker(blockerID social.UserID) ([]*social.Block, error) {
+	rows, err := r.db.Query(`
+		SELECT blocked_id, created_at FROM player_blocks
+		WHERE blocker_id = $1
+		ORDER BY created_at DESC
+	`, blockerID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find player blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var blocks []*social.Block
+	for rows.Next() {
+		var (
+			blockedIDStr string
+			createdAt    int64
+		)
+		if err := rows.Scan(&blockedIDStr, &createdAt); err != nil {
+			return nil, err
+		}
+		blockedID, err := shared.NewUserID(blockedIDStr)
+		if err != nil {
+			continue
+		}
+		blocks = append(blocks, social.ReconstructBlock(blockerID, blockedID, createdAt))
+	}
+	return blocks, rows.Err()
+}
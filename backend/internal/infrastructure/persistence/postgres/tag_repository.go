@@ -22,6 +22,17 @@ func NewTagRepository(db *sql.DB) *TagRepository {
 
 // Save persists a tag (create or update)
 func (r *TagRepository) Save(tag *quiz.Tag) error {
+	return r.save(r.db, tag)
+}
+
+// SaveInTx persists a tag within an existing transaction
+func (r *TagRepository) SaveInTx(tx *sql.Tx, tag *quiz.Tag) error {
+	return r.save(tx, tag)
+}
+
+func (r *TagRepository) save(db interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}, tag *quiz.Tag) error {
 	ctx := context.Background()
 
 	query := `
@@ -30,7 +41,7 @@ func (r *TagRepository) Save(tag *quiz.Tag) error {
 		ON CONFLICT (name) DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := db.ExecContext(
 		ctx,
 		query,
 		tag.ID().String(),
@@ -75,30 +86,96 @@ func (r *TagRepository) SaveAll(tags []*quiz.Tag) error {
 	return nil
 }
 
-// FindByName retrieves a tag by its name
+// FindByName retrieves a tag by its name, including a soft-deleted one
 func (r *TagRepository) FindByName(name string) (*quiz.Tag, error) {
-	ctx := context.Background()
+	return r.findOne(`SELECT id, name, deleted_at FROM tags WHERE name = $1`, name)
+}
 
-	query := `
-		SELECT id, name
-		FROM tags
-		WHERE name = $1
-	`
+// FindByID retrieves a tag by its ID, including a soft-deleted one
+func (r *TagRepository) FindByID(id quiz.TagID) (*quiz.Tag, error) {
+	return r.findOne(`SELECT id, name, deleted_at FROM tags WHERE id = $1`, id.String())
+}
+
+// findOne scans a single tag row (id, name, deleted_at)
+func (r *TagRepository) findOne(query string, arg string) (*quiz.Tag, error) {
+	ctx := context.Background()
 
 	var (
-		id      string
-		tagName string
+		id        string
+		tagName   string
+		deletedAt sql.NullInt64
 	)
 
-	err := r.db.QueryRowContext(ctx, query, name).Scan(&id, &tagName)
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&id, &tagName, &deletedAt)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("tag not found: %s", name)
+		return nil, quiz.ErrTagNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find tag: %w", err)
 	}
 
-	return quiz.ReconstructTag(id, tagName), nil
+	tag := quiz.ReconstructTag(id, tagName)
+	if deletedAt.Valid {
+		if err := tag.Delete(deletedAt.Int64); err != nil {
+			return nil, err
+		}
+	}
+
+	return tag, nil
+}
+
+// Update persists a tag's name and soft delete, and keeps the quizzes.tags
+// names of its quizzes in sync (deleted tags are left out of them)
+func (r *TagRepository) Update(tag *quiz.Tag) error {
+	ctx := context.Background()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.UpdateInTx(tx, tag); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateInTx persists a renamed, deleted or restored tag within an existing transaction
+func (r *TagRepository) UpdateInTx(tx *sql.Tx, tag *quiz.Tag) error {
+	ctx := context.Background()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE tags SET name = $2, deleted_at = $3 WHERE id = $1`,
+		tag.ID().String(), tag.Name().String(), nullableTimestamp(tag.DeletedAt()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return quiz.ErrTagNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE quizzes q SET tags = (
+			SELECT ARRAY_AGG(t.name ORDER BY t.name)
+			FROM quiz_tags qt
+			INNER JOIN tags t ON t.id = qt.tag_id
+			WHERE qt.quiz_id = q.id AND t.deleted_at IS NULL
+		)
+		WHERE q.id IN (SELECT quiz_id FROM quiz_tags WHERE tag_id = $1)
+	`, tag.ID().String())
+	if err != nil {
+		return fmt.Errorf("failed to sync quiz tags: %w", err)
+	}
+
+	return nil
 }
 
 // FindByNames retrieves multiple tags by their names
@@ -112,7 +189,7 @@ func (r *TagRepository) FindByNames(names []string) ([]*quiz.Tag, error) {
 	query := `
 		SELECT id, name
 		FROM tags
-		WHERE name = ANY($1)
+		WHERE name = ANY($1) AND deleted_at IS NULL
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, pq.Array(names))
@@ -150,6 +227,7 @@ func (r *TagRepository) FindAll() ([]*quiz.Tag, error) {
 	query := `
 		SELECT id, name
 		FROM tags
+		WHERE deleted_at IS NULL
 		ORDER BY name
 	`
 
@@ -189,7 +267,7 @@ func (r *TagRepository) FindByQuizID(quizID quiz.QuizID) ([]*quiz.Tag, error) {
 		SELECT t.id, t.name
 		FROM tags t
 		INNER JOIN quiz_tags qt ON t.id = qt.tag_id
-		WHERE qt.quiz_id = $1
+		WHERE qt.quiz_id = $1 AND t.deleted_at IS NULL
 		ORDER BY t.name
 	`
 
@@ -277,8 +355,8 @@ func (r *TagRepository) FindQuizzesByTag(tagName string, limit, offset int) ([]*
 		FROM quizzes q
 		INNER JOIN quiz_tags qt ON q.id = qt.quiz_id
 		INNER JOIN tags t ON qt.tag_id = t.id
-		LEFT JOIN questions qu ON q.id = qu.quiz_id
-		WHERE t.name = $1
+		LEFT JOIN questions qu ON q.id = qu.quiz_id AND qu.deleted_at IS NULL
+		WHERE t.name = $1 AND t.deleted_at IS NULL AND q.deleted_at IS NULL
 		GROUP BY q.id
 		ORDER BY q.created_at DESC
 		LIMIT $2 OFFSET $3
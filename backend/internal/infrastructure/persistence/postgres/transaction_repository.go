@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/shared"
 	"github.com/barsukov/quiz-sprint/backend/internal/domain/user"
@@ -27,8 +28,8 @@ func (r *TransactionRepository) Save(tx *user.TransactionLog) error {
 	}
 
 	query := `
-		INSERT INTO user_transactions (id, player_id, "type", source, details, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO user_transactions (id, player_id, "type", source, details, idempotency_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
 	`
 
 	_, err = r.db.Exec(query,
@@ -37,6 +38,7 @@ func (r *TransactionRepository) Save(tx *user.TransactionLog) error {
 		string(tx.Type()),
 		tx.Source(),
 		detailsJSON,
+		tx.IdempotencyKey(),
 		tx.CreatedAt(),
 	)
 
@@ -50,7 +52,7 @@ func (r *TransactionRepository) Save(tx *user.TransactionLog) error {
 // FindByPlayer retrieves transactions for a player ordered by created_at DESC
 func (r *TransactionRepository) FindByPlayer(playerID user.UserID, limit int) ([]user.TransactionLog, error) {
 	query := `
-		SELECT id, player_id, "type", source, details, created_at
+		SELECT id, player_id, "type", source, details, COALESCE(idempotency_key, ''), created_at
 		FROM user_transactions
 		WHERE player_id = $1
 		ORDER BY created_at DESC
@@ -63,19 +65,100 @@ func (r *TransactionRepository) FindByPlayer(playerID user.UserID, limit int) ([
 	}
 	defer rows.Close()
 
+	return scanTransactions(rows)
+}
+
+// FindPage retrieves a page of a player's transactions, newest first (keyset pagination on created_at, id)
+func (r *TransactionRepository) FindPage(filter user.TransactionFilter, after *user.TransactionCursor, limit int) ([]user.TransactionLog, error) {
+	query := `
+		SELECT id, player_id, "type", source, details, COALESCE(idempotency_key, ''), created_at
+		FROM user_transactions
+		WHERE player_id = $1
+			AND ($2 = '' OR details ? $2)
+			AND ($3 = '' OR source = $3)
+			AND (created_at, id) < ($4, $5::UUID)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $6
+	`
+
+	// No cursor: start past the newest possible transaction
+	afterCreatedAt, afterID := int64(math.MaxInt64), "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	if after != nil {
+		afterCreatedAt, afterID = after.CreatedAt, after.ID
+	}
+
+	rows, err := r.db.Query(query,
+		filter.PlayerID.String(),
+		filter.Resource,
+		filter.Source,
+		afterCreatedAt,
+		afterID,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTransactions(rows)
+}
+
+// SummarizeByDay totals credited and debited amounts per UTC day and resource
+func (r *TransactionRepository) SummarizeByDay(filter user.TransactionFilter, since int64) ([]user.DailyResourceTotal, error) {
+	query := `
+		SELECT
+			to_char(to_timestamp(t.created_at) AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS day,
+			d.key AS resource,
+			COALESCE(SUM(d.value::INT) FILTER (WHERE t."type" = 'credit'), 0) AS credited,
+			COALESCE(SUM(d.value::INT) FILTER (WHERE t."type" = 'debit'), 0) AS debited
+		FROM user_transactions t
+		CROSS JOIN LATERAL jsonb_each_text(t.details) d
+		WHERE t.player_id = $1
+			AND t.created_at >= $2
+			AND ($3 = '' OR d.key = $3)
+			AND ($4 = '' OR t.source = $4)
+		GROUP BY day, resource
+		ORDER BY day DESC, resource
+	`
+
+	rows, err := r.db.Query(query, filter.PlayerID.String(), since, filter.Resource, filter.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []user.DailyResourceTotal
+	for rows.Next() {
+		var total user.DailyResourceTotal
+		if err := rows.Scan(&total.Day, &total.Resource, &total.Credited, &total.Debited); err != nil {
+			return nil, fmt.Errorf("failed to scan transaction summary row: %w", err)
+		}
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transaction summary rows: %w", err)
+	}
+
+	return totals, nil
+}
+
+// scanTransactions reads rows of (id, player_id, type, source, details, idempotency_key, created_at)
+func scanTransactions(rows *sql.Rows) ([]user.TransactionLog, error) {
 	var transactions []user.TransactionLog
 
 	for rows.Next() {
 		var (
-			id          string
-			dbPlayerID  string
-			txType      string
-			source      string
-			detailsJSON []byte
-			createdAt   int64
+			id             string
+			dbPlayerID     string
+			txType         string
+			source         string
+			detailsJSON    []byte
+			idempotencyKey string
+			createdAt      int64
 		)
 
-		err := rows.Scan(&id, &dbPlayerID, &txType, &source, &detailsJSON, &createdAt)
+		err := rows.Scan(&id, &dbPlayerID, &txType, &source, &detailsJSON, &idempotencyKey, &createdAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan transaction row: %w", err)
 		}
@@ -90,7 +173,7 @@ func (r *TransactionRepository) FindByPlayer(playerID user.UserID, limit int) ([
 			return nil, fmt.Errorf("invalid player_id in transaction: %w", err)
 		}
 
-		tx := user.ReconstructTransactionLog(id, uid, user.TransactionType(txType), source, details, createdAt)
+		tx := user.ReconstructTransactionLog(id, uid, user.TransactionType(txType), source, details, idempotencyKey, createdAt)
 		transactions = append(transactions, *tx)
 	}
 
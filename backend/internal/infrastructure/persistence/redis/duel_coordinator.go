@@ -0,0 +1,381 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/realtime"
+)
+
+const (
+	duelGameKeyPrefix  = "duel:live:"           // HASH duel:live:{gameID} -> shared game state; channel duel:live:{gameID}:messages
+	duelStepsKey       = "duel:live:steps"      // ZSET: step JSON -> due time (unix ms)
+	duelStepsWake      = "duel:live:steps:wake" // channel: a step is due now
+	duelGameTTL        = 2 * time.Hour          // state of a game still in progress
+	duelOwnerLease     = 10 * time.Second       // an owner that stops renewing loses its games after this
+	duelOwnerIdle      = time.Minute            // stop renewing games without steps for this long
+	duelStepsPoll      = 250 * time.Millisecond // step polling interval (wake-ups poll earlier)
+	duelStepLease      = 30 * time.Second       // a claimed step not acknowledged by then is due again
+	duelStepsBatchSize = 100
+)
+
+// joinScript assigns the player a slot. Returns 1 on rejoin, 0 on a new slot, error FULL otherwise.
+var joinScript = redis.NewScript(`
+local p1 = redis.call('HGET', KEYS[1], 'p1')
+local p2 = redis.call('HGET', KEYS[1], 'p2')
+local rejoined = 1
+if p1 == ARGV[1] then
+	redis.call('HSET', KEYS[1], 'p1conn', ARGV[2])
+elseif p2 == ARGV[1] then
+	redis.call('HSET', KEYS[1], 'p2conn', ARGV[2])
+elseif not p1 then
+	redis.call('HSET', KEYS[1], 'p1', ARGV[1], 'p1conn', ARGV[2])
+	rejoined = 0
+elseif not p2 then
+	redis.call('HSET', KEYS[1], 'p2', ARGV[1], 'p2conn', ARGV[2])
+	rejoined = 0
+else
+	return redis.error_reply('FULL')
+end
+if redis.call('HGET', KEYS[1], 'finished') ~= '1' then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+end
+return rejoined
+`)
+
+// leaveScript clears the player's connection if it is still connID; forgets an unfinished game nobody is connected to.
+// Returns 1 if the connection was cleared.
+var leaveScript = redis.NewScript(`
+local slot
+if redis.call('HGET', KEYS[1], 'p1') == ARGV[1] then
+	slot = 'p1conn'
+elseif redis.call('HGET', KEYS[1], 'p2') == ARGV[1] then
+	slot = 'p2conn'
+else
+	return 0
+end
+if redis.call('HGET', KEYS[1], slot) ~= ARGV[2] then
+	return 0
+end
+redis.call('HDEL', KEYS[1], slot)
+if redis.call('HEXISTS', KEYS[1], 'p1conn') == 0 and redis.call('HEXISTS', KEYS[1], 'p2conn') == 0
+	and redis.call('HGET', KEYS[1], 'finished') ~= '1' then
+	redis.call('DEL', KEYS[1])
+end
+return 1
+`)
+
+// claimStepsScript leases due steps of the games this instance owns, taking over games without a live owner.
+// A claimed step stays in the set, due again after the lease (ARGV[6]), until the owner removes it once run.
+// The owner key of a game is built from its ID inside the script (single Redis node only).
+var claimStepsScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[4]))
+local claimed = {}
+for _, member in ipairs(due) do
+	local step = cjson.decode(member)
+	local ownerKey = ARGV[5] .. step.gameId .. ':owner'
+	local owner = redis.call('GET', ownerKey)
+	if not owner or owner == ARGV[2] then
+		redis.call('SET', ownerKey, ARGV[2], 'PX', ARGV[3])
+		redis.call('ZADD', KEYS[1], 'XX', ARGV[6], member)
+		table.insert(claimed, member)
+	end
+end
+return claimed
+`)
+
+// renewOwnerScript extends the owner lease if this instance still holds it
+var renewOwnerScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// DuelCoordinator shares live duel games between API instances through Redis.
+// Implements realtime.Coordinator.
+//
+// Messages go through a pub/sub channel per game. Steps wait in a sorted set
+// by due time; the first instance to claim a due step of a game becomes its
+// owner and keeps the game while it renews its lease. A claimed step is only
+// removed after it has run, so a new owner picks up the steps of one that died.
+type DuelCoordinator struct {
+	rdb      *redis.Client
+	pubsub   *redis.PubSub
+	instance string
+
+	mu      sync.Mutex
+	owned   map[string]time.Time // gameID -> last step claimed
+	running map[string]bool      // claimed step members not yet removed
+}
+
+// NewDuelCoordinator creates a coordinator on the given Redis client
+func NewDuelCoordinator(client *Client) *DuelCoordinator {
+	return &DuelCoordinator{
+		rdb:      client.Redis(),
+		pubsub:   client.Redis().Subscribe(context.Background(), duelStepsWake),
+		instance: uuid.NewString(),
+		owned:    make(map[string]time.Time),
+		running:  make(map[string]bool),
+	}
+}
+
+func duelGameKey(gameID string) string     { return duelGameKeyPrefix + gameID }
+func duelOwnerKey(gameID string) string    { return duelGameKeyPrefix + gameID + ":owner" }
+func duelMessagesKey(gameID string) string { return duelGameKeyPrefix + gameID + ":messages" }
+
+func (c *DuelCoordinator) Join(ctx context.Context, gameID, playerID, connID string) (realtime.GameState, bool, error) {
+	rejoined, err := joinScript.Run(ctx, c.rdb, []string{duelGameKey(gameID)},
+		playerID, connID, duelGameTTL.Milliseconds()).Int()
+	if err != nil {
+		if strings.Contains(err.Error(), "FULL") {
+			return realtime.GameState{}, false, realtime.ErrGameFull
+		}
+		return realtime.GameState{}, false, fmt.Errorf("join duel %s: %w", gameID, err)
+	}
+
+	state, err := c.State(ctx, gameID)
+	return state, rejoined == 1, err
+}
+
+func (c *DuelCoordinator) Leave(ctx context.Context, gameID, playerID, connID string) (realtime.GameState, bool, error) {
+	// Read first: the script may delete the game
+	state, err := c.State(ctx, gameID)
+	if err != nil {
+		return realtime.GameState{}, false, err
+	}
+
+	left, err := leaveScript.Run(ctx, c.rdb, []string{duelGameKey(gameID)}, playerID, connID).Int()
+	if err != nil {
+		return realtime.GameState{}, false, fmt.Errorf("leave duel %s: %w", gameID, err)
+	}
+	if left == 0 {
+		return state, false, nil
+	}
+
+	switch playerID {
+	case state.Player1ID:
+		state.Player1Connected = false
+	case state.Player2ID:
+		state.Player2Connected = false
+	}
+	return state, true, nil
+}
+
+func (c *DuelCoordinator) State(ctx context.Context, gameID string) (realtime.GameState, error) {
+	fields, err := c.rdb.HGetAll(ctx, duelGameKey(gameID)).Result()
+	if err != nil {
+		return realtime.GameState{}, fmt.Errorf("load duel %s: %w", gameID, err)
+	}
+
+	round, _ := strconv.Atoi(fields["round"])
+	state := realtime.GameState{
+		Player1ID:        fields["p1"],
+		Player2ID:        fields["p2"],
+		Player1Connected: fields["p1conn"] != "",
+		Player2Connected: fields["p2conn"] != "",
+		CurrentRound:     round,
+		Finished:         fields["finished"] == "1",
+	}
+	if complete := fields["complete"]; complete != "" {
+		state.GameComplete = json.RawMessage(complete)
+	}
+	return state, nil
+}
+
+func (c *DuelCoordinator) SetRound(ctx context.Context, gameID string, round int) error {
+	key := duelGameKey(gameID)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "round", round)
+	pipe.Expire(ctx, key, duelGameTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *DuelCoordinator) Finish(ctx context.Context, gameID string, gameComplete json.RawMessage) error {
+	key := duelGameKey(gameID)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "finished", "1", "complete", string(gameComplete))
+	pipe.Expire(ctx, key, realtime.FinishedGameTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *DuelCoordinator) Watch(ctx context.Context, gameID string) error {
+	return c.pubsub.Subscribe(ctx, duelMessagesKey(gameID))
+}
+
+func (c *DuelCoordinator) Unwatch(ctx context.Context, gameID string) error {
+	return c.pubsub.Unsubscribe(ctx, duelMessagesKey(gameID))
+}
+
+func (c *DuelCoordinator) Publish(ctx context.Context, msg realtime.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Publish(ctx, duelMessagesKey(msg.GameID), data).Err()
+}
+
+func (c *DuelCoordinator) Schedule(ctx context.Context, step realtime.Step, delay time.Duration) error {
+	if step.ID == "" {
+		step.ID = uuid.NewString()
+	}
+	data, err := json.Marshal(step)
+	if err != nil {
+		return err
+	}
+
+	due := time.Now().Add(delay)
+	if err := c.rdb.ZAdd(ctx, duelStepsKey, redis.Z{Score: float64(due.UnixMilli()), Member: data}).Err(); err != nil {
+		return fmt.Errorf("schedule %s step of duel %s: %w", step.Kind, step.GameID, err)
+	}
+	if delay <= 0 {
+		// Wake every instance up now instead of at the next poll
+		_ = c.rdb.Publish(ctx, duelStepsWake, "").Err()
+	}
+	return nil
+}
+
+func (c *DuelCoordinator) Start(ctx context.Context, deliver func(realtime.Message), run func(realtime.Step)) {
+	wake := make(chan struct{}, 1)
+
+	// Messages of watched games, and step wake-ups
+	go func() {
+		ch := c.pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = c.pubsub.Close()
+				return
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+				if m.Channel == duelStepsWake {
+					select {
+					case wake <- struct{}{}:
+					default:
+					}
+					continue
+				}
+				var msg realtime.Message
+				if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+					log.Printf("[DuelCoordinator] Invalid message on %s: %v", m.Channel, err)
+					continue
+				}
+				deliver(msg)
+			}
+		}
+	}()
+
+	// Due steps of owned games
+	go func() {
+		ticker := time.NewTicker(duelStepsPoll)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.renewOwnership(ctx)
+			case <-wake:
+			}
+			c.runDueSteps(ctx, run)
+		}
+	}()
+}
+
+// runDueSteps claims due steps and runs them, in order within each game
+func (c *DuelCoordinator) runDueSteps(ctx context.Context, run func(realtime.Step)) {
+	now := time.Now()
+	members, err := claimStepsScript.Run(ctx, c.rdb, []string{duelStepsKey},
+		now.UnixMilli(), c.instance, duelOwnerLease.Milliseconds(), duelStepsBatchSize, duelGameKeyPrefix,
+		now.Add(duelStepLease).UnixMilli()).StringSlice()
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Printf("[DuelCoordinator] Failed to claim steps: %v", err)
+		}
+		return
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	type claimedStep struct {
+		member string
+		step   realtime.Step
+	}
+	byGame := make(map[string][]claimedStep)
+	var order []string
+
+	c.mu.Lock()
+	for _, member := range members {
+		if c.running[member] {
+			continue // Lease ran out while the step is still queued or running here
+		}
+		var step realtime.Step
+		if err := json.Unmarshal([]byte(member), &step); err != nil {
+			log.Printf("[DuelCoordinator] Invalid step %s: %v", member, err)
+			c.rdb.ZRem(ctx, duelStepsKey, member)
+			continue
+		}
+		if _, seen := byGame[step.GameID]; !seen {
+			order = append(order, step.GameID)
+		}
+		byGame[step.GameID] = append(byGame[step.GameID], claimedStep{member: member, step: step})
+		c.owned[step.GameID] = now
+		c.running[member] = true
+	}
+	c.mu.Unlock()
+
+	for _, gameID := range order {
+		go func(steps []claimedStep) {
+			for _, claimed := range steps {
+				run(claimed.step)
+				if err := c.rdb.ZRem(ctx, duelStepsKey, claimed.member).Err(); err != nil {
+					log.Printf("[DuelCoordinator] Failed to remove step %s: %v", claimed.member, err)
+				}
+				c.mu.Lock()
+				delete(c.running, claimed.member)
+				c.mu.Unlock()
+			}
+		}(byGame[gameID])
+	}
+}
+
+// renewOwnership extends the leases of the games this instance recently ran steps for
+func (c *DuelCoordinator) renewOwnership(ctx context.Context) {
+	c.mu.Lock()
+	games := make([]string, 0, len(c.owned))
+	for gameID, lastStep := range c.owned {
+		if time.Since(lastStep) > duelOwnerIdle {
+			delete(c.owned, gameID)
+			continue
+		}
+		games = append(games, gameID)
+	}
+	c.mu.Unlock()
+
+	for _, gameID := range games {
+		renewed, err := renewOwnerScript.Run(ctx, c.rdb, []string{duelOwnerKey(gameID)},
+			c.instance, duelOwnerLease.Milliseconds()).Int()
+		if err == nil && renewed == 0 {
+			// Lease lost (e.g. after a long pause): another instance took the game over
+			c.mu.Lock()
+			delete(c.owned, gameID)
+			c.mu.Unlock()
+		}
+	}
+}
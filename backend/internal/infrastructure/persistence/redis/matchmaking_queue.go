@@ -15,9 +15,6 @@ import (
 const (
 	queueKey            = "duel:matchmaking:queue" // ZSET: playerID -> MMR score
 	queueInfoKey        = "duel:matchmaking:info"  // HASH: playerID -> joinedAt
-	initialMMRRange     = 100                      // Initial MMR search range
-	maxMMRRange         = 500                      // Maximum MMR range after expansion
-	rangeExpansion      = 50                       // Expand range by this much per second
 	recentOpponentTTL   = 5 * time.Minute         // How long to remember a recent opponent
 	sameOpponentBypass  = 30                       // After this many seconds in queue, allow rematch
 )
@@ -66,19 +63,14 @@ func (q *MatchmakingQueue) RemoveFromQueue(playerID quick_duel.UserID) error {
 }
 
 // FindMatch finds a suitable opponent for a player
-// Expands search range based on how long the player has been waiting
-func (q *MatchmakingQueue) FindMatch(playerID quick_duel.UserID, mmr int, searchSeconds int) (*quick_duel.UserID, *int, error) {
+// The search range widens with waiting time and with the player's rating deviation
+func (q *MatchmakingQueue) FindMatch(playerID quick_duel.UserID, rating quick_duel.Glicko2Rating, searchSeconds int) (*quick_duel.UserID, *int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Calculate MMR range based on search duration
-	mmrRange := initialMMRRange + (searchSeconds * rangeExpansion)
-	if mmrRange > maxMMRRange {
-		mmrRange = maxMMRRange
-	}
-
-	minMMR := mmr - mmrRange
-	maxMMR := mmr + mmrRange
+	mmr := rating.MMR()
+	minMMR, maxMMR := rating.MatchmakingRange(searchSeconds)
+	mmrRange := maxMMR - mmr
 
 	// Find players in MMR range using ZRANGEBYSCORE
 	results, err := q.rdb.ZRangeByScoreWithScores(ctx, queueKey, &redis.ZRangeBy{
@@ -0,0 +1,115 @@
+// Package realtime coordinates live duel games between API instances.
+//
+// Players of one duel may hold their WebSockets on different instances. Each
+// game therefore has shared state (player slots, connections, current round),
+// a message channel every instance with a local player listens to, and a
+// single owner instance that runs the game's steps (answers, round timers,
+// forfeits), so the duel use cases never race with themselves on two instances.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrGameFull is returned when a third player tries to join a game
+	ErrGameFull = errors.New("game already has two players")
+)
+
+// FinishedGameTTL is how long a finished game stays available to reconnecting players
+const FinishedGameTTL = 30 * time.Second
+
+// StepKind names a unit of game progress
+type StepKind string
+
+const (
+	StepReady           StepKind = "ready"            // both players joined: announce the game
+	StepStartRound      StepKind = "start_round"      // send the question of Round
+	StepRoundTimeout    StepKind = "round_timeout"    // Round's time is up
+	StepAnswer          StepKind = "answer"           // PlayerID answered; Payload holds the answer
+	StepDisconnectGrace StepKind = "disconnect_grace" // PlayerID's reconnect grace period is over
+)
+
+// Step is a unit of game progress, run on the game's owner instance
+type Step struct {
+	ID       string          `json:"id"` // set by Schedule; keeps two identical steps apart
+	GameID   string          `json:"gameId"`
+	Kind     StepKind        `json:"kind"`
+	Round    int             `json:"round,omitempty"`
+	PlayerID string          `json:"playerId,omitempty"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+// Message is a WebSocket message for the players of a game
+type Message struct {
+	GameID string          `json:"gameId"`
+	To     string          `json:"to,omitempty"` // single recipient; empty for both players
+	Body   json.RawMessage `json:"body"`
+}
+
+// GameState is the part of a game shared by every instance
+type GameState struct {
+	Player1ID        string
+	Player2ID        string
+	Player1Connected bool
+	Player2Connected bool
+	CurrentRound     int
+	Finished         bool
+	GameComplete     json.RawMessage // final message, replayed to reconnecting players
+}
+
+// IsConnected reports whether the player has a live connection on any instance
+func (s GameState) IsConnected(playerID string) bool {
+	switch playerID {
+	case "":
+		return false
+	case s.Player1ID:
+		return s.Player1Connected
+	case s.Player2ID:
+		return s.Player2Connected
+	}
+	return false
+}
+
+// Opponent returns the other player's ID, or "" if they have not joined yet
+func (s GameState) Opponent(playerID string) string {
+	switch playerID {
+	case s.Player1ID:
+		return s.Player2ID
+	case s.Player2ID:
+		return s.Player1ID
+	}
+	return ""
+}
+
+// Coordinator shares live duel games between API instances
+type Coordinator interface {
+	// Join gives the player a slot (the one they had, when reconnecting) and records
+	// connID as their live connection. rejoined is true if they already had a slot.
+	// Returns ErrGameFull when both slots belong to other players.
+	Join(ctx context.Context, gameID, playerID, connID string) (state GameState, rejoined bool, err error)
+	// Leave clears the player's connection unless a newer one (another connID) replaced it;
+	// left is false in that case. An unfinished game nobody is connected to is forgotten.
+	Leave(ctx context.Context, gameID, playerID, connID string) (state GameState, left bool, err error)
+	State(ctx context.Context, gameID string) (GameState, error)
+	SetRound(ctx context.Context, gameID string, round int) error
+	// Finish marks the game finished; its state is dropped after FinishedGameTTL
+	Finish(ctx context.Context, gameID string, gameComplete json.RawMessage) error
+
+	// Watch makes this instance receive the game's messages; call it before Join
+	Watch(ctx context.Context, gameID string) error
+	// Unwatch stops receiving the game's messages once no local player is left
+	Unwatch(ctx context.Context, gameID string) error
+	// Publish sends a message to every instance watching the game
+	Publish(ctx context.Context, msg Message) error
+
+	// Schedule runs the step on the game's owner after delay
+	Schedule(ctx context.Context, step Step, delay time.Duration) error
+
+	// Start passes messages of watched games to deliver, and due steps of the
+	// games this instance owns to run, until ctx is cancelled
+	Start(ctx context.Context, deliver func(Message), run func(Step))
+}
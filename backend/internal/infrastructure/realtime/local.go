@@ -0,0 +1,185 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LocalCoordinator keeps games in process: this instance owns every game
+// and sees every player (used when Redis is not available)
+type LocalCoordinator struct {
+	mu    sync.Mutex
+	games map[string]*localGame
+
+	deliver func(Message)
+	run     func(Step)
+	ctx     context.Context
+}
+
+type localGame struct {
+	state  GameState
+	conn1  string
+	conn2  string
+	expiry *time.Timer
+}
+
+func NewLocalCoordinator() *LocalCoordinator {
+	return &LocalCoordinator{games: make(map[string]*localGame)}
+}
+
+func (c *LocalCoordinator) Join(_ context.Context, gameID, playerID, connID string) (GameState, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, ok := c.games[gameID]
+	if !ok {
+		game = &localGame{}
+		c.games[gameID] = game
+	}
+
+	rejoined := true
+	switch playerID {
+	case game.state.Player1ID:
+		game.conn1 = connID
+	case game.state.Player2ID:
+		game.conn2 = connID
+	default:
+		rejoined = false
+		switch {
+		case game.state.Player1ID == "":
+			game.state.Player1ID, game.conn1 = playerID, connID
+		case game.state.Player2ID == "":
+			game.state.Player2ID, game.conn2 = playerID, connID
+		default:
+			return GameState{}, false, ErrGameFull
+		}
+	}
+
+	return game.snapshot(), rejoined, nil
+}
+
+func (c *LocalCoordinator) Leave(_ context.Context, gameID, playerID, connID string) (GameState, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, ok := c.games[gameID]
+	if !ok {
+		return GameState{}, false, nil
+	}
+
+	left := false
+	switch {
+	case playerID == game.state.Player1ID && game.conn1 == connID:
+		game.conn1, left = "", true
+	case playerID == game.state.Player2ID && game.conn2 == connID:
+		game.conn2, left = "", true
+	}
+
+	state := game.snapshot()
+	// Nobody left to play or reconnect to an unfinished game: forget it, as the in-process hub always did
+	if game.conn1 == "" && game.conn2 == "" && !game.state.Finished {
+		delete(c.games, gameID)
+	}
+	return state, left, nil
+}
+
+func (c *LocalCoordinator) State(_ context.Context, gameID string) (GameState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if game, ok := c.games[gameID]; ok {
+		return game.snapshot(), nil
+	}
+	return GameState{}, nil
+}
+
+func (c *LocalCoordinator) SetRound(_ context.Context, gameID string, round int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if game, ok := c.games[gameID]; ok {
+		game.state.CurrentRound = round
+	}
+	return nil
+}
+
+func (c *LocalCoordinator) Finish(_ context.Context, gameID string, gameComplete json.RawMessage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	game, ok := c.games[gameID]
+	if !ok {
+		return nil
+	}
+	game.state.Finished = true
+	game.state.GameComplete = gameComplete
+
+	if game.expiry == nil {
+		game.expiry = time.AfterFunc(FinishedGameTTL, func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if c.games[gameID] == game {
+				delete(c.games, gameID)
+			}
+		})
+	}
+	return nil
+}
+
+// Watch is a no-op: every message is delivered in process
+func (c *LocalCoordinator) Watch(context.Context, string) error { return nil }
+
+// Unwatch is a no-op: every message is delivered in process
+func (c *LocalCoordinator) Unwatch(context.Context, string) error { return nil }
+
+// Publish delivers the message synchronously
+func (c *LocalCoordinator) Publish(_ context.Context, msg Message) error {
+	c.mu.Lock()
+	deliver := c.deliver
+	c.mu.Unlock()
+
+	if deliver != nil {
+		deliver(msg)
+	}
+	return nil
+}
+
+func (c *LocalCoordinator) Schedule(_ context.Context, step Step, delay time.Duration) error {
+	if step.ID == "" {
+		step.ID = uuid.NewString()
+	}
+
+	time.AfterFunc(delay, func() {
+		c.mu.Lock()
+		run, ctx := c.run, c.ctx
+		c.mu.Unlock()
+
+		if run == nil || ctx.Err() != nil {
+			log.Printf("[DuelCoordinator] Dropping %s step of game %s: not started", step.Kind, step.GameID)
+			return
+		}
+		run(step)
+	})
+	return nil
+}
+
+func (c *LocalCoordinator) Start(ctx context.Context, deliver func(Message), run func(Step)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ctx = ctx
+	c.deliver = deliver
+	c.run = run
+}
+
+func (g *localGame) snapshot() GameState {
+	state := g.state
+	state.Player1Connected = g.conn1 != ""
+	state.Player2Connected = g.conn2 != ""
+	return state
+}
@@ -0,0 +1,106 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocalCoordinator_JoinAssignsSlots(t *testing.T) {
+	c := NewLocalCoordinator()
+	ctx := context.Background()
+
+	state, rejoined, err := c.Join(ctx, "g1", "alice", "c1")
+	if err != nil || rejoined {
+		t.Fatalf("Join(alice) = rejoined %v, err %v", rejoined, err)
+	}
+	if state.Player1ID != "alice" || !state.Player1Connected {
+		t.Errorf("state after alice = %+v", state)
+	}
+
+	state, _, _ = c.Join(ctx, "g1", "bob", "c2")
+	if state.Player2ID != "bob" || state.Opponent("bob") != "alice" {
+		t.Errorf("state after bob = %+v", state)
+	}
+
+	if _, _, err := c.Join(ctx, "g1", "carol", "c3"); !errors.Is(err, ErrGameFull) {
+		t.Errorf("Join(carol) err = %v, want ErrGameFull", err)
+	}
+}
+
+func TestLocalCoordinator_ReconnectReplacesConnection(t *testing.T) {
+	c := NewLocalCoordinator()
+	ctx := context.Background()
+	c.Join(ctx, "g1", "alice", "c1")
+	c.Join(ctx, "g1", "bob", "c2")
+
+	// New connection arrives before the old one is closed
+	_, rejoined, _ := c.Join(ctx, "g1", "alice", "c3")
+	if !rejoined {
+		t.Fatal("second Join of alice must be a rejoin")
+	}
+
+	state, left, _ := c.Leave(ctx, "g1", "alice", "c1")
+	if left || !state.IsConnected("alice") {
+		t.Errorf("closing the replaced connection must keep alice connected: left %v, state %+v", left, state)
+	}
+
+	state, left, _ = c.Leave(ctx, "g1", "alice", "c3")
+	if !left || state.IsConnected("alice") || !state.IsConnected("bob") {
+		t.Errorf("Leave(c3) = left %v, state %+v", left, state)
+	}
+}
+
+func TestLocalCoordinator_ForgetsAbandonedGame(t *testing.T) {
+	c := NewLocalCoordinator()
+	ctx := context.Background()
+	c.Join(ctx, "g1", "alice", "c1")
+	c.Leave(ctx, "g1", "alice", "c1")
+
+	if state, _ := c.State(ctx, "g1"); state.Player1ID != "" {
+		t.Errorf("abandoned game still known: %+v", state)
+	}
+}
+
+func TestLocalCoordinator_FinishedGameOutlivesPlayers(t *testing.T) {
+	c := NewLocalCoordinator()
+	ctx := context.Background()
+	c.Join(ctx, "g1", "alice", "c1")
+	c.Join(ctx, "g1", "bob", "c2")
+	c.Finish(ctx, "g1", []byte(`{"type":"game_complete"}`))
+	c.Leave(ctx, "g1", "alice", "c1")
+	c.Leave(ctx, "g1", "bob", "c2")
+
+	state, rejoined, _ := c.Join(ctx, "g1", "alice", "c3")
+	if !rejoined || !state.Finished || string(state.GameComplete) != `{"type":"game_complete"}` {
+		t.Errorf("Join after finish = rejoined %v, state %+v", rejoined, state)
+	}
+}
+
+func TestLocalCoordinator_RunsScheduledStepsAndDeliversMessages(t *testing.T) {
+	c := NewLocalCoordinator()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	steps := make(chan Step, 1)
+	var delivered []Message
+	c.Start(ctx, func(m Message) { delivered = append(delivered, m) }, func(s Step) { steps <- s })
+
+	if err := c.Schedule(ctx, Step{GameID: "g1", Kind: StepStartRound, Round: 2}, time.Millisecond); err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	select {
+	case s := <-steps:
+		if s.Kind != StepStartRound || s.Round != 2 || s.ID == "" {
+			t.Errorf("step = %+v", s)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("scheduled step did not run")
+	}
+
+	c.Publish(ctx, Message{GameID: "g1", To: "bob", Body: []byte(`{}`)})
+	if len(delivered) != 1 || delivered[0].To != "bob" {
+		t.Errorf("delivered = %+v", delivered)
+	}
+}
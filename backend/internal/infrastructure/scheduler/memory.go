@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// LocalLocker is an in-process Locker for a single API instance
+// (used when PostgreSQL is not available)
+type LocalLocker struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{locks: make(map[string]*sync.Mutex)}
+}
+
+func (l *LocalLocker) TryLock(_ context.Context, jobName string) (func(), bool, error) {
+	l.mu.Lock()
+	lock, ok := l.locks[jobName]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[jobName] = lock
+	}
+	l.mu.Unlock()
+
+	if !lock.TryLock() {
+		return nil, false, nil
+	}
+	return lock.Unlock, true, nil
+}
+
+// MemoryRunStore keeps the run history in memory
+type MemoryRunStore struct {
+	mu     sync.Mutex
+	nextID int64
+	runs   []Run
+}
+
+func NewMemoryRunStore() *MemoryRunStore {
+	return &MemoryRunStore{}
+}
+
+func (s *MemoryRunStore) Claim(_ context.Context, run *Run) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if run.Trigger == TriggerSchedule {
+		for _, r := range s.runs {
+			if r.JobName == run.JobName && r.Trigger == TriggerSchedule && r.ScheduledAt == run.ScheduledAt {
+				return false, nil
+			}
+		}
+	}
+
+	s.nextID++
+	run.ID = s.nextID
+	s.runs = append(s.runs, *run)
+	return true, nil
+}
+
+func (s *MemoryRunStore) Finish(_ context.Context, run *Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.runs {
+		if s.runs[i].ID == run.ID {
+			s.runs[i] = *run
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *MemoryRunStore) ListRecent(_ context.Context, jobName string, limit int) ([]Run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var runs []Run
+	for _, r := range s.runs {
+		if r.JobName == jobName {
+			runs = append(runs, r)
+		}
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].ID > runs[j].ID })
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+func (s *MemoryRunStore) DeleteFinishedBefore(_ context.Context, before int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.runs[:0]
+	var deleted int64
+	for _, r := range s.runs {
+		if r.Status != RunStatusRunning && r.StartedAt < before {
+			deleted++
+			continue
+		}
+		kept = append(kept, r)
+	}
+	s.runs = kept
+	return deleted, nil
+}
@@ -0,0 +1,218 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule computes when a job runs next.
+// All schedules work in UTC so every replica agrees on the same run slots.
+type Schedule interface {
+	// Next returns the first run time strictly after the given time,
+	// or the zero time if the schedule never fires again.
+	Next(after time.Time) time.Time
+	String() string
+}
+
+// ========================================
+// Interval schedule
+// ========================================
+
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every fires at a fixed interval, aligned to the Unix epoch
+// (Every(time.Minute) fires at :00 of every minute on every replica).
+func Every(interval time.Duration) Schedule {
+	if interval < time.Second {
+		panic(fmt.Sprintf("scheduler: interval %s is shorter than a second", interval))
+	}
+	return intervalSchedule{interval: interval}
+}
+
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	return after.UTC().Truncate(s.interval).Add(s.interval)
+}
+
+func (s intervalSchedule) String() string {
+	return "every " + s.interval.String()
+}
+
+// ========================================
+// Cron schedule
+// ========================================
+
+// cronSchedule is a standard 5-field cron expression:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Fields accept *, lists (1,15), ranges (1-5) and steps (*/10, 0-30/5).
+// Day-of-week is 0-6 (Sunday = 0, 7 is accepted as Sunday).
+// Day-of-month also accepts L for the last day of the month.
+// As in classic cron, when both day fields are restricted a day matching either one fires.
+type cronSchedule struct {
+	expr    string
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	lastDom bool
+	domAny  bool
+	dowAny  bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day-of-week", min: 0, max: 7},
+}
+
+// ParseCron parses a 5-field cron expression evaluated in UTC.
+func ParseCron(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("scheduler: cron expression %q: expected %d fields, got %d", expr, len(cronFields), len(parts))
+	}
+
+	s := &cronSchedule{expr: expr}
+	masks := [5]*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, part := range parts {
+		field := cronFields[i]
+		for _, item := range strings.Split(part, ",") {
+			if i == 2 && item == "L" {
+				s.lastDom = true
+				continue
+			}
+			bits, err := parseCronItem(item, field)
+			if err != nil {
+				return nil, fmt.Errorf("scheduler: cron expression %q: %w", expr, err)
+			}
+			*masks[i] |= bits
+		}
+	}
+
+	// Sunday may be written as 0 or 7
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domAny = parts[2] == "*"
+	s.dowAny = parts[4] == "*"
+
+	return s, nil
+}
+
+// MustParseCron is like ParseCron but panics on an invalid expression.
+// Meant for expressions hard-coded at job registration.
+func MustParseCron(expr string) Schedule {
+	s, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func parseCronItem(item string, field cronField) (uint64, error) {
+	rangePart, step := item, 1
+	if i := strings.IndexByte(item, '/'); i >= 0 {
+		n, err := strconv.Atoi(item[i+1:])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("%s: invalid step in %q", field.name, item)
+		}
+		rangePart, step = item[:i], n
+	}
+
+	lo, hi := field.min, field.max
+	switch {
+	case rangePart == "*":
+	case strings.Contains(rangePart, "-"):
+		bounds := strings.SplitN(rangePart, "-", 2)
+		var err1, err2 error
+		lo, err1 = strconv.Atoi(bounds[0])
+		hi, err2 = strconv.Atoi(bounds[1])
+		if err1 != nil || err2 != nil {
+			return 0, fmt.Errorf("%s: invalid range %q", field.name, rangePart)
+		}
+	default:
+		n, err := strconv.Atoi(rangePart)
+		if err != nil {
+			return 0, fmt.Errorf("%s: invalid value %q", field.name, rangePart)
+		}
+		lo = n
+		if step == 1 {
+			hi = n
+		}
+	}
+
+	if lo < field.min || hi > field.max || lo > hi {
+		return 0, fmt.Errorf("%s: %q out of range %d-%d", field.name, item, field.min, field.max)
+	}
+
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+// maxCronSearch bounds the search for expressions that never match (e.g. "0 0 31 2 *")
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	if s.lastDom && t.AddDate(0, 0, 1).Day() == 1 {
+		domMatch = true
+	}
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dowMatch
+	case s.dowAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+func (s *cronSchedule) String() string {
+	return s.expr
+}
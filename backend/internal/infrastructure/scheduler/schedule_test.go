@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func utc(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParseCron_Next(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  string
+		after string
+		want  string
+	}{
+		{"weekly monday 00:01", "1 0 * * 1", "2026-10-14 12:00", "2026-10-19 00:01"},
+		{"weekly fires next week when exactly on the slot", "1 0 * * 1", "2026-10-19 00:01", "2026-10-26 00:01"},
+		{"daily 01:00 same day", "0 1 * * *", "2026-10-16 00:30", "2026-10-16 01:00"},
+		{"daily 01:00 next day", "0 1 * * *", "2026-10-16 01:00", "2026-10-17 01:00"},
+		{"hourly", "0 * * * *", "2026-10-16 10:15", "2026-10-16 11:00"},
+		{"step minutes", "*/15 * * * *", "2026-10-16 10:16", "2026-10-16 10:30"},
+		{"last day of month", "59 23 L * *", "2026-02-03 00:00", "2026-02-28 23:59"},
+		{"last day of leap february", "59 23 L * *", "2028-02-03 00:00", "2028-02-29 23:59"},
+		{"last day rolls to next month", "59 23 L * *", "2026-10-31 23:59", "2026-11-30 23:59"},
+		{"sunday as 7", "0 12 * * 7", "2026-10-16 00:00", "2026-10-18 12:00"},
+		{"range and list", "0 9 * * 1-5", "2026-10-17 10:00", "2026-10-19 09:00"},
+		{"day-of-month or day-of-week", "0 0 1 * 0", "2026-10-16 00:00", "2026-10-18 00:00"},
+		{"month restriction", "0 0 1 1 *", "2026-10-16 00:00", "2027-01-01 00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron(%q): %v", tt.expr, err)
+			}
+			if got := s.Next(utc(tt.after)); !got.Equal(utc(tt.want)) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got.Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"L * * * *",
+		"a * * * *",
+	} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) should fail", expr)
+		}
+	}
+}
+
+func TestParseCron_NeverMatches(t *testing.T) {
+	s := MustParseCron("0 0 31 2 *")
+	if next := s.Next(utc("2026-01-01 00:00")); !next.IsZero() {
+		t.Errorf("Next = %s, want zero time", next)
+	}
+}
+
+func TestEvery_AlignedToEpoch(t *testing.T) {
+	s := Every(5 * time.Second)
+	after := time.Date(2026, 10, 16, 10, 0, 3, 500, time.UTC)
+	want := time.Date(2026, 10, 16, 10, 0, 5, 0, time.UTC)
+	if got := s.Next(after); !got.Equal(want) {
+		t.Errorf("Next = %s, want %s", got, want)
+	}
+	// Strictly after: a run exactly on a slot schedules the following one
+	if got := s.Next(want); !got.Equal(want.Add(5 * time.Second)) {
+		t.Errorf("Next(slot) = %s, want %s", got, want.Add(5*time.Second))
+	}
+}
@@ -0,0 +1,338 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrJobNotFound is returned when triggering a job that was never registered
+	ErrJobNotFound = errors.New("job not found")
+)
+
+// HistoryRetention is how long finished runs are kept in the run history
+const HistoryRetention = 7 * 24 * time.Hour
+
+// pruneHistoryJobName is the built-in job that trims the run history
+const pruneHistoryJobName = "scheduler.prune_history"
+
+// Job is a named unit of background work
+type Job struct {
+	Name        string
+	Description string
+	Schedule    Schedule
+	// Run does the work. The returned summary is stored in the run history.
+	Run func(ctx context.Context) (summary string, err error)
+	// SkipIdleRuns keeps a frequent polling job from flooding the run history:
+	// only runs that return a summary or fail are recorded. Its slots are not
+	// deduplicated across replicas (the lock still is), so a repeat must be harmless.
+	SkipIdleRuns bool
+}
+
+// Trigger tells why a run happened
+type Trigger string
+
+const (
+	TriggerSchedule Trigger = "schedule"
+	TriggerManual   Trigger = "manual"
+)
+
+// RunStatus is the state of a single run
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "running"
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+// Run is one execution of a job, as recorded in the run history.
+// Times are Unix seconds; FinishedAt is 0 while the run is in progress.
+type Run struct {
+	ID          int64     `json:"id"`
+	JobName     string    `json:"jobName"`
+	Trigger     Trigger   `json:"trigger"`
+	ScheduledAt int64     `json:"scheduledAt"`
+	StartedAt   int64     `json:"startedAt"`
+	FinishedAt  int64     `json:"finishedAt,omitempty"`
+	Status      RunStatus `json:"status"`
+	Summary     string    `json:"summary,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	Instance    string    `json:"instance"`
+}
+
+// Locker elects the single replica allowed to run a job right now.
+type Locker interface {
+	// TryLock acquires the job's lock without waiting.
+	// ok is false when another replica holds it; unlock must be called once the run is over.
+	TryLock(ctx context.Context, jobName string) (unlock func(), ok bool, err error)
+}
+
+// RunStore persists the run history
+type RunStore interface {
+	// Claim records the start of a run and sets run.ID.
+	// Returns false when a scheduled run of the same job and slot already exists:
+	// another replica ran it, possibly before this one's timer fired.
+	Claim(ctx context.Context, run *Run) (bool, error)
+	// Finish records the outcome of a claimed run
+	Finish(ctx context.Context, run *Run) error
+	// ListRecent returns the latest runs of a job, newest first
+	ListRecent(ctx context.Context, jobName string, limit int) ([]Run, error)
+	// DeleteFinishedBefore removes finished runs that started before the given time
+	DeleteFinishedBefore(ctx context.Context, before int64) (int64, error)
+}
+
+// JobInfo describes a registered job for the admin API
+type JobInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schedule    string `json:"schedule"`
+	NextRunAt   int64  `json:"nextRunAt"`
+	LastRun     *Run   `json:"lastRun,omitempty"`
+}
+
+// Scheduler runs registered jobs on their schedules.
+// Each run takes the job's lock first, so with several API replicas only one
+// of them executes a given run; the others skip it.
+type Scheduler struct {
+	mu      sync.RWMutex
+	jobs    map[string]Job
+	started bool
+	ctx     context.Context
+
+	locker   Locker
+	runs     RunStore
+	instance string
+	now      func() time.Time
+}
+
+// New creates a scheduler with the built-in run history pruning job registered
+func New(locker Locker, runs RunStore) *Scheduler {
+	s := &Scheduler{
+		jobs:     make(map[string]Job),
+		locker:   locker,
+		runs:     runs,
+		instance: instanceName(),
+		now:      time.Now,
+	}
+
+	s.Register(Job{
+		Name:        pruneHistoryJobName,
+		Description: "Delete job runs older than the retention period",
+		Schedule:    MustParseCron("30 3 * * *"),
+		Run: func(ctx context.Context) (string, error) {
+			deleted, err := s.runs.DeleteFinishedBefore(ctx, s.now().Add(-HistoryRetention).Unix())
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("deleted %d runs", deleted), nil
+		},
+	})
+
+	return s
+}
+
+// Register adds a job. Jobs registered after Start are scheduled immediately.
+// Panics on a duplicate name or a job without a schedule: both are wiring bugs.
+func (s *Scheduler) Register(job Job) {
+	if job.Name == "" || job.Schedule == nil || job.Run == nil {
+		panic(fmt.Sprintf("scheduler: job %q needs a name, a schedule and a run function", job.Name))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[job.Name]; exists {
+		panic(fmt.Sprintf("scheduler: job %q registered twice", job.Name))
+	}
+	s.jobs[job.Name] = job
+
+	if s.started {
+		go s.loop(s.ctx, job)
+	}
+}
+
+// Start runs every registered job on its schedule until ctx is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+	s.ctx = ctx
+
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+	log.Printf("[Scheduler] Started %d jobs on %s", len(s.jobs), s.instance)
+}
+
+// Trigger runs a job now, outside its schedule. The run happens in the background;
+// like scheduled runs, it is skipped if the job is already running on any replica.
+func (s *Scheduler) Trigger(name string) error {
+	s.mu.RLock()
+	job, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+
+	go s.execute(context.Background(), job, TriggerManual, s.now())
+	return nil
+}
+
+// Jobs lists the registered jobs with their next run and latest recorded run
+func (s *Scheduler) Jobs(ctx context.Context) ([]JobInfo, error) {
+	s.mu.RLock()
+	jobs := make([]Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, job)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+
+	now := s.now()
+	infos := make([]JobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		info := JobInfo{
+			Name:        job.Name,
+			Description: job.Description,
+			Schedule:    job.Schedule.String(),
+		}
+		if next := job.Schedule.Next(now); !next.IsZero() {
+			info.NextRunAt = next.Unix()
+		}
+
+		recent, err := s.runs.ListRecent(ctx, job.Name, 1)
+		if err != nil {
+			return nil, fmt.Errorf("list runs of %s: %w", job.Name, err)
+		}
+		if len(recent) > 0 {
+			info.LastRun = &recent[0]
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// Runs returns the latest runs of a job, newest first
+func (s *Scheduler) Runs(ctx context.Context, name string, limit int) ([]Run, error) {
+	s.mu.RLock()
+	_, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+
+	return s.runs.ListRecent(ctx, name, limit)
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	for {
+		next := job.Schedule.Next(s.now())
+		if next.IsZero() {
+			log.Printf("[Scheduler] Job %s has no further runs", job.Name)
+			return
+		}
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.execute(ctx, job, TriggerSchedule, next)
+	}
+}
+
+// execute runs a job once if this replica wins its lock and the slot is not taken yet
+func (s *Scheduler) execute(ctx context.Context, job Job, trigger Trigger, scheduledAt time.Time) {
+	unlock, ok, err := s.locker.TryLock(ctx, job.Name)
+	if err != nil {
+		log.Printf("[Scheduler] Job %s: lock failed: %v", job.Name, err)
+		return
+	}
+	if !ok {
+		// Running on another replica (or still running here)
+		return
+	}
+	defer unlock()
+
+	run := &Run{
+		JobName:     job.Name,
+		Trigger:     trigger,
+		ScheduledAt: scheduledAt.Unix(),
+		StartedAt:   s.now().Unix(),
+		Status:      RunStatusRunning,
+		Instance:    s.instance,
+	}
+	if !job.SkipIdleRuns && !s.claim(ctx, run) {
+		return
+	}
+
+	summary, runErr := runJob(ctx, job)
+
+	if job.SkipIdleRuns {
+		if summary == "" && runErr == nil {
+			return // nothing done, nothing to record
+		}
+		if !s.claim(context.Background(), run) {
+			return
+		}
+	}
+
+	run.FinishedAt = s.now().Unix()
+	run.Summary = summary
+	run.Status = RunStatusSucceeded
+	if runErr != nil {
+		run.Status = RunStatusFailed
+		run.Error = runErr.Error()
+		log.Printf("[Scheduler] Job %s failed: %v", job.Name, runErr)
+	}
+
+	// The run is over even if the context was cancelled meanwhile
+	if err := s.runs.Finish(context.Background(), run); err != nil {
+		log.Printf("[Scheduler] Job %s: cannot record run result: %v", job.Name, err)
+	}
+}
+
+// claim records the start of a run; false means it must not be recorded (or run)
+func (s *Scheduler) claim(ctx context.Context, run *Run) bool {
+	claimed, err := s.runs.Claim(ctx, run)
+	if err != nil {
+		log.Printf("[Scheduler] Job %s: cannot record run: %v", run.JobName, err)
+		return false
+	}
+	return claimed
+}
+
+// runJob calls the job, turning a panic into an error so one bad job cannot take the API down
+func runJob(ctx context.Context, job Job) (summary string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func instanceName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s/%d", host, os.Getpid())
+}
@@ -0,0 +1,207 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestScheduler(locker Locker, runs RunStore) *Scheduler {
+	s := New(locker, runs)
+	s.now = func() time.Time { return time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func countingJob(name string, calls *int, err error) Job {
+	return Job{
+		Name:     name,
+		Schedule: Every(time.Minute),
+		Run: func(context.Context) (string, error) {
+			*calls++
+			return "done", err
+		},
+	}
+}
+
+func TestScheduler_ExecuteRecordsRun(t *testing.T) {
+	runs := NewMemoryRunStore()
+	s := newTestScheduler(NewLocalLocker(), runs)
+	calls := 0
+	job := countingJob("test.job", &calls, nil)
+	s.Register(job)
+
+	slot := s.now()
+	s.execute(context.Background(), job, TriggerSchedule, slot)
+
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+	recent, _ := runs.ListRecent(context.Background(), "test.job", 10)
+	if len(recent) != 1 {
+		t.Fatalf("recorded %d runs, want 1", len(recent))
+	}
+	run := recent[0]
+	if run.Status != RunStatusSucceeded || run.Summary != "done" || run.ScheduledAt != slot.Unix() || run.FinishedAt == 0 {
+		t.Errorf("unexpected run record: %+v", run)
+	}
+}
+
+func TestScheduler_SlotRunsOnceAcrossReplicas(t *testing.T) {
+	// Two replicas sharing the same history: the second one finds the slot taken
+	runs := NewMemoryRunStore()
+	replica1 := newTestScheduler(NewLocalLocker(), runs)
+	replica2 := newTestScheduler(NewLocalLocker(), runs)
+	calls := 0
+	job := countingJob("test.job", &calls, nil)
+
+	slot := replica1.now()
+	replica1.execute(context.Background(), job, TriggerSchedule, slot)
+	replica2.execute(context.Background(), job, TriggerSchedule, slot)
+
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+
+	// A manual trigger is never deduplicated
+	replica2.execute(context.Background(), job, TriggerManual, slot)
+	if calls != 2 {
+		t.Fatalf("manual run: calls = %d, want 2", calls)
+	}
+}
+
+func TestScheduler_SkipIdleRunsRecordsOnlyWork(t *testing.T) {
+	runs := NewMemoryRunStore()
+	s := newTestScheduler(NewLocalLocker(), runs)
+	summary := ""
+	calls := 0
+	job := Job{
+		Name:         "test.poll",
+		Schedule:     Every(5 * time.Second),
+		SkipIdleRuns: true,
+		Run: func(context.Context) (string, error) {
+			calls++
+			return summary, nil
+		},
+	}
+
+	s.execute(context.Background(), job, TriggerSchedule, s.now())
+	s.execute(context.Background(), job, TriggerSchedule, s.now().Add(5*time.Second))
+	summary = "paired 1"
+	s.execute(context.Background(), job, TriggerSchedule, s.now().Add(10*time.Second))
+
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3", calls)
+	}
+	recent, _ := runs.ListRecent(context.Background(), "test.poll", 10)
+	if len(recent) != 1 || recent[0].Summary != "paired 1" || recent[0].Status != RunStatusSucceeded {
+		t.Errorf("recorded runs = %+v, want only the run that did work", recent)
+	}
+}
+
+func TestScheduler_SkipsWhenLockHeld(t *testing.T) {
+	locker := NewLocalLocker()
+	runs := NewMemoryRunStore()
+	s := newTestScheduler(locker, runs)
+	calls := 0
+	job := countingJob("test.job", &calls, nil)
+
+	unlock, ok, _ := locker.TryLock(context.Background(), "test.job")
+	if !ok {
+		t.Fatal("expected to take the lock")
+	}
+	s.execute(context.Background(), job, TriggerSchedule, s.now())
+	unlock()
+
+	if calls != 0 {
+		t.Fatalf("job ran while another holder had its lock")
+	}
+	if recent, _ := runs.ListRecent(context.Background(), "test.job", 10); len(recent) != 0 {
+		t.Errorf("skipped run should not be recorded, got %d", len(recent))
+	}
+}
+
+func TestScheduler_RecordsFailureAndPanic(t *testing.T) {
+	runs := NewMemoryRunStore()
+	s := newTestScheduler(NewLocalLocker(), runs)
+	calls := 0
+	failing := countingJob("test.failing", &calls, errors.New("boom"))
+	panicking := Job{
+		Name:     "test.panicking",
+		Schedule: Every(time.Minute),
+		Run:      func(context.Context) (string, error) { panic("oops") },
+	}
+
+	s.execute(context.Background(), failing, TriggerSchedule, s.now())
+	s.execute(context.Background(), panicking, TriggerSchedule, s.now())
+
+	for name, wantErr := range map[string]string{"test.failing": "boom", "test.panicking": "panic: oops"} {
+		recent, _ := runs.ListRecent(context.Background(), name, 1)
+		if len(recent) != 1 || recent[0].Status != RunStatusFailed || recent[0].Error != wantErr {
+			t.Errorf("%s: unexpected runs %+v", name, recent)
+		}
+	}
+}
+
+func TestScheduler_TriggerAndList(t *testing.T) {
+	s := newTestScheduler(NewLocalLocker(), NewMemoryRunStore())
+	done := make(chan struct{})
+	s.Register(Job{
+		Name:     "test.job",
+		Schedule: MustParseCron("0 1 * * *"),
+		Run: func(context.Context) (string, error) {
+			close(done)
+			return "", nil
+		},
+	})
+
+	if err := s.Trigger("missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("Trigger(missing) = %v, want ErrJobNotFound", err)
+	}
+	if err := s.Trigger("test.job"); err != nil {
+		t.Fatalf("Trigger: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("triggered job did not run")
+	}
+
+	var jobs []JobInfo
+	deadline := time.Now().Add(time.Second)
+	for {
+		jobs, _ = s.Jobs(context.Background())
+		if len(jobs) == 2 && jobs[1].LastRun != nil && jobs[1].LastRun.Status == RunStatusSucceeded {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("run never recorded as succeeded: %+v", jobs)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Sorted by name: the built-in prune job comes first
+	if jobs[0].Name != pruneHistoryJobName || jobs[1].Name != "test.job" {
+		t.Fatalf("unexpected jobs order: %s, %s", jobs[0].Name, jobs[1].Name)
+	}
+	if jobs[1].LastRun.Trigger != TriggerManual {
+		t.Errorf("LastRun.Trigger = %s, want manual", jobs[1].LastRun.Trigger)
+	}
+	wantNext := time.Date(2026, 10, 17, 1, 0, 0, 0, time.UTC).Unix()
+	if jobs[1].NextRunAt != wantNext {
+		t.Errorf("NextRunAt = %d, want %d", jobs[1].NextRunAt, wantNext)
+	}
+}
+
+func TestScheduler_RegisterDuplicatePanics(t *testing.T) {
+	s := newTestScheduler(NewLocalLocker(), NewMemoryRunStore())
+	calls := 0
+	s.Register(countingJob("test.job", &calls, nil))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a job twice should panic")
+		}
+	}()
+	s.Register(countingJob("test.job", &calls, nil))
+}
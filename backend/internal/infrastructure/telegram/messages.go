@@ -0,0 +1,61 @@
+package telegram
+
+import (
+	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+// messageKey names a bot message in the messages table
+type messageKey string
+
+const (
+	msgChallengeAccepted messageKey = "challenge_accepted" // args: invitee name, lobby URL
+	msgInviterWaiting    messageKey = "inviter_waiting"    // args: inviter name, lobby URL
+	msgChallengeReceived messageKey = "challenge_received" // args: inviter name
+	msgAcceptButton      messageKey = "accept_button"
+	msgShareTitle        messageKey = "share_title"
+	msgShareText         messageKey = "share_text"
+)
+
+// challengeStatusKeys are the texts a challenge message is edited to
+var challengeStatusKeys = map[appDuel.ChallengeMessageStatus]messageKey{
+	appDuel.ChallengeMessageExpired:  "challenge_status_expired",
+	appDuel.ChallengeMessageDeclined: "challenge_status_declined",
+	appDuel.ChallengeMessageAccepted: "challenge_status_accepted",
+}
+
+// messages holds the bot's texts (Telegram HTML) per locale. quiz.DefaultLocale
+// must have every key; other locales may leave keys out.
+var messages = map[string]map[messageKey]string{
+	quiz.DefaultLocale: {
+		msgChallengeAccepted:        "⚔️ <b>%s</b> accepted your challenge and is ready to duel!\n\n<a href=\"%s\">Go to the lobby →</a>",
+		msgInviterWaiting:           "⚔️ <b>%s</b> is waiting for you in the lobby!\n\n<a href=\"%s\">Join →</a>",
+		msgChallengeReceived:        "⚔️ <b>Duel challenge!</b>\n\n<b>%s</b> challenges you in Quiz Sprint.\nYou have 1 hour to accept.",
+		msgAcceptButton:             "⚔️ Accept challenge",
+		msgShareTitle:               "⚔️ Duel challenge — Quiz Sprint",
+		msgShareText:                "⚔️ I challenge you to a duel in Quiz Sprint!",
+		"challenge_status_expired":  "⏰ Time is up",
+		"challenge_status_declined": "❌ Challenge declined",
+		"challenge_status_accepted": "✅ Challenge accepted — good luck!",
+	},
+	"ru": {
+		msgChallengeAccepted:        "⚔️ <b>%s</b> принял твой вызов и готов к дуэли!\n\n<a href=\"%s\">Зайти в лобби →</a>",
+		msgInviterWaiting:           "⚔️ <b>%s</b> ждёт тебя в лобби!\n\n<a href=\"%s\">Зайти →</a>",
+		msgChallengeReceived:        "⚔️ <b>Вызов на дуэль!</b>\n\n<b>%s</b> бросает тебе вызов в Quiz Sprint.\nУ тебя есть 1 час чтобы принять.",
+		msgAcceptButton:             "⚔️ Принять вызов",
+		msgShareTitle:               "⚔️ Вызов на дуэль — Quiz Sprint",
+		msgShareText:                "⚔️ Вызываю тебя на дуэль в Quiz Sprint!",
+		"challenge_status_expired":  "⏰ Время истекло",
+		"challenge_status_declined": "❌ Вызов отклонён",
+		"challenge_status_accepted": "✅ Вызов принят — удачи!",
+	},
+}
+
+// message returns the text of key in a user's language (a Telegram language
+// code such as "pt-br"), falling back to quiz.DefaultLocale
+func message(languageCode string, key messageKey) string {
+	if text, ok := messages[quiz.NormalizeLocale(languageCode)][key]; ok {
+		return text
+	}
+	return messages[quiz.DefaultLocale][key]
+}
@@ -0,0 +1,46 @@
+package telegram
+
+import (
+	"testing"
+
+	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
+	"github.com/barsukov/quiz-sprint/backend/internal/domain/quiz"
+)
+
+func TestMessage_PicksRecipientLanguage(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "ru", want: "⚔️ Принять вызов"},
+		{language: "ru-RU", want: "⚔️ Принять вызов"},
+		{language: "en", want: "⚔️ Accept challenge"},
+		{language: "de", want: "⚔️ Accept challenge"},
+		{language: "", want: "⚔️ Accept challenge"},
+	}
+	for _, tt := range tests {
+		if got := message(tt.language, msgAcceptButton); got != tt.want {
+			t.Errorf("message(%q) = %q, want %q", tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestMessages_DefaultLocaleHasEveryText(t *testing.T) {
+	defaults := messages[quiz.DefaultLocale]
+	for locale, texts := range messages {
+		for key := range texts {
+			if defaults[key] == "" {
+				t.Errorf("%s text %q has no %s fallback", locale, key, quiz.DefaultLocale)
+			}
+		}
+	}
+	for _, status := range []appDuel.ChallengeMessageStatus{
+		appDuel.ChallengeMessageAccepted,
+		appDuel.ChallengeMessageDeclined,
+		appDuel.ChallengeMessageExpired,
+	} {
+		if defaults[challengeStatusKeys[status]] == "" {
+			t.Errorf("no text for challenge status %q", status)
+		}
+	}
+}
@@ -6,15 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	appDuel "github.com/barsukov/quiz-sprint/backend/internal/application/quick_duel"
 )
 
-// TelegramNotifier sends notifications via Telegram Bot API.
+// TelegramNotifier sends notifications via Telegram Bot API, in the
+// recipient's language (see messages).
 type TelegramNotifier interface {
-	NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, inviteeName string, lobbyURL string) error
-	NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, inviterName string, lobbyURL string) error
-	NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, inviterName string, deepLink string) (int64, error)
-	EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, text string) error
-	SavePreparedInlineMessage(ctx context.Context, userID int64, challengeLink string, challengeText string) (string, int64, error)
+	NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, language string, inviteeName string, lobbyURL string) error
+	NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, lobbyURL string) error
+	NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, deepLink string) (int64, error)
+	EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, language string, status appDuel.ChallengeMessageStatus) error
+	SavePreparedInlineMessage(ctx context.Context, userID int64, language string, challengeLink string) (string, int64, error)
 }
 
 // NoOpNotifier does nothing (used in tests / when bot token is absent).
@@ -22,16 +25,16 @@ type NoOpNotifier struct{}
 
 func NewNoOpNotifier() TelegramNotifier { return &NoOpNotifier{} }
 
-func (n *NoOpNotifier) NotifyChallengeAccepted(_ context.Context, _ int64, _ string, _ string) error {
+func (n *NoOpNotifier) NotifyChallengeAccepted(_ context.Context, _ int64, _ string, _ string, _ string) error {
 	return nil
 }
-func (n *NoOpNotifier) NotifyInviterWaiting(_ context.Context, _ int64, _ string, _ string) error {
+func (n *NoOpNotifier) NotifyInviterWaiting(_ context.Context, _ int64, _ string, _ string, _ string) error {
 	return nil
 }
-func (n *NoOpNotifier) NotifyChallengeReceived(_ context.Context, _ int64, _ string, _ string) (int64, error) {
+func (n *NoOpNotifier) NotifyChallengeReceived(_ context.Context, _ int64, _ string, _ string, _ string) (int64, error) {
 	return 0, nil
 }
-func (n *NoOpNotifier) EditChallengeMessage(_ context.Context, _ int64, _ int64, _ string) error {
+func (n *NoOpNotifier) EditChallengeMessage(_ context.Context, _ int64, _ int64, _ string, _ appDuel.ChallengeMessageStatus) error {
 	return nil
 }
 func (n *NoOpNotifier) SavePreparedInlineMessage(_ context.Context, _ int64, _ string, _ string) (string, int64, error) {
@@ -174,36 +177,34 @@ func (n *HTTPNotifier) sendMessageWithButton(ctx context.Context, chatID int64,
 	return result.Result.MessageID, nil
 }
 
-func (n *HTTPNotifier) NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, inviteeName string, lobbyURL string) error {
-	text := fmt.Sprintf("⚔️ <b>%s</b> принял твой вызов и готов к дуэли!\n\n<a href=\"%s\">Зайти в лобби →</a>", inviteeName, lobbyURL)
+func (n *HTTPNotifier) NotifyChallengeAccepted(ctx context.Context, inviterTelegramID int64, language string, inviteeName string, lobbyURL string) error {
+	text := fmt.Sprintf(message(language, msgChallengeAccepted), inviteeName, lobbyURL)
 	return n.sendMessage(ctx, inviterTelegramID, text)
 }
 
-func (n *HTTPNotifier) NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, inviterName string, lobbyURL string) error {
-	text := fmt.Sprintf("⚔️ <b>%s</b> ждёт тебя в лобби!\n\n<a href=\"%s\">Зайти →</a>", inviterName, lobbyURL)
+func (n *HTTPNotifier) NotifyInviterWaiting(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, lobbyURL string) error {
+	text := fmt.Sprintf(message(language, msgInviterWaiting), inviterName, lobbyURL)
 	return n.sendMessage(ctx, inviteeTelegramID, text)
 }
 
-func (n *HTTPNotifier) NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, inviterName string, deepLink string) (int64, error) {
-	text := fmt.Sprintf(
-		"⚔️ <b>Вызов на дуэль!</b>\n\n<b>%s</b> бросает тебе вызов в Quiz Sprint.\nУ тебя есть 1 час чтобы принять.",
-		inviterName,
-	)
-	return n.sendMessageWithButton(ctx, inviteeTelegramID, text, "⚔️ Принять вызов", deepLink)
+func (n *HTTPNotifier) NotifyChallengeReceived(ctx context.Context, inviteeTelegramID int64, language string, inviterName string, deepLink string) (int64, error) {
+	text := fmt.Sprintf(message(language, msgChallengeReceived), inviterName)
+	return n.sendMessageWithButton(ctx, inviteeTelegramID, text, message(language, msgAcceptButton), deepLink)
 }
 
-func (n *HTTPNotifier) SavePreparedInlineMessage(ctx context.Context, userID int64, challengeLink string, challengeText string) (string, int64, error) {
+func (n *HTTPNotifier) SavePreparedInlineMessage(ctx context.Context, userID int64, language string, challengeLink string) (string, int64, error) {
+	challengeText := message(language, msgShareText)
 	result := inlineQueryResultArticle{
 		Type:        "article",
 		ID:          "challenge_share",
-		Title:       "⚔️ Вызов на дуэль — Quiz Sprint",
+		Title:       message(language, msgShareTitle),
 		Description: challengeText,
 		InputMessageContent: inputMsgContent{
 			MessageText: challengeText,
 		},
 		ReplyMarkup: inlineKeyboard{
 			InlineKeyboard: [][]inlineButton{
-				{{Text: "⚔️ Принять вызов", URL: challengeLink}},
+				{{Text: message(language, msgAcceptButton), URL: challengeLink}},
 			},
 		},
 	}
@@ -236,11 +237,11 @@ func (n *HTTPNotifier) SavePreparedInlineMessage(ctx context.Context, userID int
 	return result2.Result.ID, result2.Result.ExpirationDate, nil
 }
 
-func (n *HTTPNotifier) EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, text string) error {
+func (n *HTTPNotifier) EditChallengeMessage(ctx context.Context, inviteeTelegramID int64, messageID int64, language string, status appDuel.ChallengeMessageStatus) error {
 	body, _ := json.Marshal(editMessageRequest{
 		ChatID:    inviteeTelegramID,
 		MessageID: messageID,
-		Text:      text,
+		Text:      message(language, challengeStatusKeys[status]),
 		ParseMode: "HTML",
 	})
 	url := fmt.Sprintf("https://api.telegram.org/bot%s/editMessageText", n.botToken)
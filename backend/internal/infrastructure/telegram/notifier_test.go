@@ -9,7 +9,7 @@ import (
 
 func TestNoOpNotifier_DoesNotError(t *testing.T) {
 	n := telegram.NewNoOpNotifier()
-	err := n.NotifyChallengeAccepted(context.Background(), 123456, "@friend", "https://t.me/bot")
+	err := n.NotifyChallengeAccepted(context.Background(), 123456, "en", "@friend", "https://t.me/bot")
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
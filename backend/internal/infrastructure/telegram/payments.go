@@ -0,0 +1,123 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+)
+
+// defaultBotAPIURL is the public Telegram Bot API server
+const defaultBotAPIURL = "https://api.telegram.org"
+
+// PaymentsClient implements appUser.StarsPaymentGateway over the Telegram Bot API.
+type PaymentsClient struct {
+	botToken string
+	baseURL  string
+	client   *http.Client
+}
+
+// NewPaymentsClient creates a payments client for the public Bot API.
+// baseURL overrides it (a local Bot API server, or a fake one in tests); "" keeps the default.
+func NewPaymentsClient(botToken string, baseURL string) *PaymentsClient {
+	if baseURL == "" {
+		baseURL = defaultBotAPIURL
+	}
+	return &PaymentsClient{
+		botToken: botToken,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type labeledPrice struct {
+	Label  string `json:"label"`
+	Amount int    `json:"amount"`
+}
+
+type createInvoiceLinkRequest struct {
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Payload            string         `json:"payload"`
+	Currency           string         `json:"currency"`
+	Prices             []labeledPrice `json:"prices"`
+	SubscriptionPeriod int64          `json:"subscription_period,omitempty"`
+}
+
+type answerPreCheckoutQueryRequest struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	OK                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+type botAPIResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result"`
+	Description string          `json:"description"`
+}
+
+// CreateInvoiceLink creates a Telegram Stars invoice link (provider token is empty for Stars)
+func (c *PaymentsClient) CreateInvoiceLink(ctx context.Context, invoice appUser.StarsInvoice) (string, error) {
+	var link string
+	err := c.call(ctx, "createInvoiceLink", createInvoiceLinkRequest{
+		Title:              invoice.Title,
+		Description:        invoice.Description,
+		Payload:            invoice.Payload,
+		Currency:           "XTR",
+		Prices:             []labeledPrice{{Label: invoice.Title, Amount: invoice.PriceStars}},
+		SubscriptionPeriod: invoice.SubscriptionPeriod,
+	}, &link)
+	if err != nil {
+		return "", err
+	}
+	return link, nil
+}
+
+// AnswerPreCheckoutQuery approves or declines a pending payment
+func (c *PaymentsClient) AnswerPreCheckoutQuery(ctx context.Context, queryID string, ok bool, errorMessage string) error {
+	return c.call(ctx, "answerPreCheckoutQuery", answerPreCheckoutQueryRequest{
+		PreCheckoutQueryID: queryID,
+		OK:                 ok,
+		ErrorMessage:       errorMessage,
+	}, nil)
+}
+
+// call invokes a Bot API method and decodes its result into result (if not nil)
+func (c *PaymentsClient) call(ctx context.Context, method string, request interface{}, result interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("telegram %s: failed to encode request: %w", method, err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/%s", c.baseURL, c.botToken, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("telegram %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var apiResp botAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return fmt.Errorf("telegram %s: failed to decode response: %w", method, err)
+	}
+	if !apiResp.OK {
+		return fmt.Errorf("telegram %s: %s", method, apiResp.Description)
+	}
+	if result != nil {
+		if err := json.Unmarshal(apiResp.Result, result); err != nil {
+			return fmt.Errorf("telegram %s: unexpected result: %w", method, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	appUser "github.com/barsukov/quiz-sprint/backend/internal/application/user"
+	"github.com/barsukov/quiz-sprint/backend/internal/infrastructure/telegram"
+)
+
+// fakeBotAPI is a local stand-in for the Telegram Bot API that records every call
+type fakeBotAPI struct {
+	calls map[string]map[string]interface{} // method -> request body
+}
+
+func newFakeBotAPI(t *testing.T) (*fakeBotAPI, *httptest.Server) {
+	t.Helper()
+	fake := &fakeBotAPI{calls: make(map[string]map[string]interface{})}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/botTEST_TOKEN/") {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"ok":false,"description":"Unauthorized"}`))
+			return
+		}
+		method := strings.TrimPrefix(r.URL.Path, "/botTEST_TOKEN/")
+
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		fake.calls[method] = body
+
+		switch method {
+		case "createInvoiceLink":
+			_, _ = w.Write([]byte(`{"ok":true,"result":"https://t.me/$fake_invoice"}`))
+		case "answerPreCheckoutQuery":
+			_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+		default:
+			_, _ = w.Write([]byte(`{"ok":false,"description":"Not Found: method not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func TestPaymentsClient_CreateInvoiceLink(t *testing.T) {
+	fake, server := newFakeBotAPI(t)
+	client := telegram.NewPaymentsClient("TEST_TOKEN", server.URL)
+
+	link, err := client.CreateInvoiceLink(context.Background(), appUser.StarsInvoice{
+		Title:              "Premium",
+		Description:        "30 days",
+		Payload:            "premium:premium_month:12345",
+		PriceStars:         250,
+		SubscriptionPeriod: 2592000,
+	})
+	if err != nil {
+		t.Fatalf("CreateInvoiceLink: %v", err)
+	}
+	if link != "https://t.me/$fake_invoice" {
+		t.Errorf("link = %q", link)
+	}
+
+	req := fake.calls["createInvoiceLink"]
+	if req["currency"] != "XTR" || req["payload"] != "premium:premium_month:12345" || req["subscription_period"] != float64(2592000) {
+		t.Errorf("request = %v", req)
+	}
+	prices, _ := req["prices"].([]interface{})
+	if len(prices) != 1 || prices[0].(map[string]interface{})["amount"] != float64(250) {
+		t.Errorf("prices = %v, want one price of 250 stars", req["prices"])
+	}
+}
+
+func TestPaymentsClient_AnswerPreCheckoutQuery(t *testing.T) {
+	fake, server := newFakeBotAPI(t)
+	client := telegram.NewPaymentsClient("TEST_TOKEN", server.URL)
+
+	if err := client.AnswerPreCheckoutQuery(context.Background(), "query-1", false, "Sold out"); err != nil {
+		t.Fatalf("AnswerPreCheckoutQuery: %v", err)
+	}
+
+	req := fake.calls["answerPreCheckoutQuery"]
+	if req["pre_checkout_query_id"] != "query-1" || req["ok"] != false || req["error_message"] != "Sold out" {
+		t.Errorf("request = %v", req)
+	}
+}
+
+func TestPaymentsClient_ReportsAPIErrors(t *testing.T) {
+	_, server := newFakeBotAPI(t)
+	client := telegram.NewPaymentsClient("WRONG_TOKEN", server.URL)
+
+	_, err := client.CreateInvoiceLink(context.Background(), appUser.StarsInvoice{Title: "Premium", PriceStars: 1})
+	if err == nil || !strings.Contains(err.Error(), "Unauthorized") {
+		t.Errorf("err = %v, want the API's Unauthorized description", err)
+	}
+}
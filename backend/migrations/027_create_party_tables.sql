@@ -0,0 +1,38 @@
+-- Migration: 027_create_party_tables.sql
+-- Party Mode tables (private multiplayer rooms, 2-8 players)
+
+-- ========================================
+-- Party Rooms Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS party_rooms (
+    id VARCHAR(50) PRIMARY KEY,
+    code VARCHAR(10) NOT NULL,           -- Join code shown to players (e.g. "ABC-123")
+    name VARCHAR(100) NOT NULL,
+    host_id VARCHAR(50) NOT NULL,
+    settings JSONB NOT NULL,             -- max_players, questions_count, time_per_question, categories, difficulty, show_* flags
+    players JSONB NOT NULL DEFAULT '[]', -- [{user_id, username, is_host, is_ready, connected, joined_at}]
+    status VARCHAR(20) NOT NULL DEFAULT 'lobby',  -- lobby, playing, closed
+    created_at BIGINT NOT NULL,
+    expires_at BIGINT NOT NULL
+);
+
+-- Codes are reused once a room closes, but at most one open room holds a code at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_party_rooms_open_code ON party_rooms(code) WHERE status <> 'closed';
+CREATE INDEX IF NOT EXISTS idx_party_rooms_status ON party_rooms(status);
+
+-- ========================================
+-- Party Games Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS party_games (
+    id VARCHAR(50) PRIMARY KEY,
+    room_id VARCHAR(50) NOT NULL REFERENCES party_rooms(id) ON DELETE CASCADE,
+    question_ids JSONB NOT NULL,
+    players JSONB NOT NULL,              -- [{user_id, username, score, connected, answers_count}]
+    current_question INTEGER NOT NULL DEFAULT 0,  -- 0-based index
+    question_answers JSONB NOT NULL DEFAULT '{}', -- {"0": [{player_id, answer_id, time_taken, is_correct, points, position}]}
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress',  -- in_progress, finished
+    started_at BIGINT NOT NULL,
+    finished_at BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_party_games_room ON party_games(room_id, started_at DESC);
@@ -0,0 +1,16 @@
+-- Migration: 028_add_question_difficulty.sql
+-- Per-question difficulty used by adaptive selection (Marathon) and duels
+
+ALTER TABLE questions
+ADD COLUMN IF NOT EXISTS difficulty VARCHAR(10) NOT NULL DEFAULT 'medium'
+    CHECK (difficulty IN ('easy', 'medium', 'hard'));
+
+-- Backfill: questions of quizzes tagged difficulty:easy / difficulty:hard
+UPDATE questions qu
+SET difficulty = SUBSTRING(t.name FROM 'difficulty:(.*)$')
+FROM quiz_tags qt
+JOIN tags t ON t.id = qt.tag_id
+WHERE qt.quiz_id = qu.quiz_id
+  AND t.name IN ('difficulty:easy', 'difficulty:hard');
+
+CREATE INDEX IF NOT EXISTS idx_questions_difficulty ON questions(difficulty);
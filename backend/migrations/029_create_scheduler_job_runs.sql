@@ -0,0 +1,23 @@
+-- Migration: 029_create_scheduler_job_runs.sql
+-- Run history of background jobs (scheduler package)
+
+CREATE TABLE IF NOT EXISTS scheduler_job_runs (
+    id BIGSERIAL PRIMARY KEY,
+    job_name VARCHAR(100) NOT NULL,
+    triggered_by VARCHAR(20) NOT NULL CHECK (triggered_by IN ('schedule', 'manual')),
+    scheduled_at BIGINT NOT NULL,        -- Unix timestamp of the slot (manual: trigger time)
+    started_at BIGINT NOT NULL,
+    finished_at BIGINT,                  -- NULL while running
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'succeeded', 'failed')),
+    summary TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    instance VARCHAR(255) NOT NULL DEFAULT ''  -- host/pid of the replica that ran it
+);
+
+-- One scheduled run per job and slot across all replicas
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scheduler_job_runs_slot
+    ON scheduler_job_runs(job_name, scheduled_at)
+    WHERE triggered_by = 'schedule';
+
+CREATE INDEX IF NOT EXISTS idx_scheduler_job_runs_job_started
+    ON scheduler_job_runs(job_name, started_at DESC);
@@ -0,0 +1,38 @@
+-- Migration: 030_create_event_outbox.sql
+-- Transactional outbox: domain events written with their aggregate, delivered by a relay
+
+-- ========================================
+-- Outbox Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at BIGINT NOT NULL,
+    created_at BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at BIGINT NOT NULL,     -- also the lease of a message being delivered
+    delivered_at BIGINT,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+-- Relay polling: only pending messages are scanned
+CREATE INDEX IF NOT EXISTS idx_event_outbox_pending
+    ON event_outbox(next_attempt_at, id)
+    WHERE status = 'pending';
+
+-- ========================================
+-- Consumptions Table (idempotent subscribers)
+-- ========================================
+CREATE TABLE IF NOT EXISTS event_outbox_consumptions (
+    consumer VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL,
+    consumed_at BIGINT NOT NULL,
+    PRIMARY KEY (consumer, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_consumptions_consumed_at
+    ON event_outbox_consumptions(consumed_at);
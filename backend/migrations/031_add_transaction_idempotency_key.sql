@@ -0,0 +1,10 @@
+-- Migration: 031_add_transaction_idempotency_key.sql
+-- Inventory ledger: a caller-supplied key applies a credit/debit at most once per player
+
+ALTER TABLE user_transactions
+    ADD COLUMN IF NOT EXISTS idempotency_key TEXT;
+
+-- One transaction per player and key; transactions without a key may repeat
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_transactions_idempotency_key
+    ON user_transactions(player_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
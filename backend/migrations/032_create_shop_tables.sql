@@ -0,0 +1,51 @@
+-- Migration: 032_create_shop_tables.sql
+-- Coin shop: data-driven catalog and per-player purchase records
+
+-- ========================================
+-- Shop Items Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS shop_items (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    contents JSONB NOT NULL,                      -- resource -> amount, e.g. {"shield": 3}
+    price_coins INT NOT NULL CHECK (price_coins > 0),
+    purchase_limit INT NOT NULL DEFAULT 0 CHECK (purchase_limit >= 0), -- 0 = unlimited
+    limit_window TEXT NOT NULL DEFAULT 'lifetime' CHECK (limit_window IN ('lifetime', 'daily', 'weekly')),
+    available_from BIGINT NOT NULL DEFAULT 0,     -- 0 = always
+    available_until BIGINT NOT NULL DEFAULT 0,    -- 0 = never ends
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    sort_order INT NOT NULL DEFAULT 0
+);
+
+-- ========================================
+-- Shop Purchases Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS shop_purchases (
+    id UUID PRIMARY KEY,
+    player_id TEXT NOT NULL REFERENCES users(id),
+    item_id TEXT NOT NULL REFERENCES shop_items(id),
+    price_coins INT NOT NULL,
+    contents JSONB NOT NULL,
+    idempotency_key TEXT,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_shop_purchases_player_item_created
+    ON shop_purchases(player_id, item_id, created_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shop_purchases_idempotency_key
+    ON shop_purchases(player_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+
+-- ========================================
+-- Seed: default catalog
+-- ========================================
+INSERT INTO shop_items (id, title, description, contents, price_coins, purchase_limit, limit_window, sort_order) VALUES
+    ('shield_pack_3', 'Shield x3', 'Three shields to protect your marathon lives', '{"shield": 3}', 150, 0, 'lifetime', 10),
+    ('fifty_fifty_pack_3', '50/50 x3', 'Remove two wrong answers, three times', '{"fifty_fifty": 3}', 120, 0, 'lifetime', 20),
+    ('skip_pack_3', 'Skip x3', 'Skip three hard questions', '{"skip": 3}', 120, 0, 'lifetime', 30),
+    ('freeze_pack_3', 'Freeze x3', 'Stop the timer three times', '{"freeze": 3}', 120, 0, 'lifetime', 40),
+    ('pvp_tickets_5', 'PvP tickets x5', 'Five tickets for ranked duels', '{"pvp_tickets": 5}', 200, 3, 'daily', 50),
+    ('starter_bundle', 'Starter bundle', 'One of each bonus and two PvP tickets at a discount', '{"shield": 1, "fifty_fifty": 1, "skip": 1, "freeze": 1, "pvp_tickets": 2}', 150, 1, 'lifetime', 0)
+ON CONFLICT (id) DO NOTHING;
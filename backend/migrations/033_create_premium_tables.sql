@@ -0,0 +1,28 @@
+-- Migration: 033_create_premium_tables.sql
+-- Premium subscriptions paid with Telegram Stars
+
+-- ========================================
+-- User Premium Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS user_premium (
+    player_id TEXT PRIMARY KEY REFERENCES users(id),
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at BIGINT NOT NULL DEFAULT 0,   -- Unix seconds; premium counts while expires_at > now
+    updated_at BIGINT NOT NULL DEFAULT 0
+);
+
+-- ========================================
+-- Premium Payments Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS premium_payments (
+    charge_id TEXT PRIMARY KEY,              -- telegram_payment_charge_id (needed for refunds)
+    player_id TEXT NOT NULL REFERENCES users(id),
+    plan_id TEXT NOT NULL,
+    amount_stars INT NOT NULL,
+    is_recurring BOOLEAN NOT NULL DEFAULT FALSE,
+    premium_until BIGINT NOT NULL,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_premium_payments_player_created
+    ON premium_payments(player_id, created_at DESC);
@@ -0,0 +1,21 @@
+-- Migration: 034_create_ad_reward_nonces.sql
+-- One-time rewarded ad nonces, confirmed by the ad network's server-to-server callback
+
+-- ========================================
+-- Ad Reward Nonces Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS ad_reward_nonces (
+    id TEXT PRIMARY KEY,                           -- random hex nonce handed to the client
+    player_id TEXT NOT NULL REFERENCES users(id),
+    placement TEXT NOT NULL,                       -- daily_retry, streak_recovery, marathon_continue
+    status TEXT NOT NULL DEFAULT 'issued',         -- issued -> redeemed -> consumed
+    transaction_id TEXT NOT NULL DEFAULT '',       -- ad network's ID of the rewarded view
+    issued_at BIGINT NOT NULL,
+    redeemed_at BIGINT NOT NULL DEFAULT 0,
+    consumed_at BIGINT NOT NULL DEFAULT 0
+);
+
+-- Daily caps count consumed rewards per player and placement
+CREATE INDEX IF NOT EXISTS idx_ad_reward_nonces_player_consumed
+    ON ad_reward_nonces(player_id, placement, consumed_at)
+    WHERE status = 'consumed';
@@ -0,0 +1,46 @@
+-- Migration: 035_create_friendships.sql
+-- Friend graph: friend requests, friendships and player blocks (replaces referral-as-friendship)
+
+-- ========================================
+-- Friendships Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS friendships (
+    id UUID PRIMARY KEY,
+    requester_id TEXT NOT NULL REFERENCES users(id),
+    addressee_id TEXT NOT NULL REFERENCES users(id),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted')),
+    created_at BIGINT NOT NULL,
+    accepted_at BIGINT NOT NULL DEFAULT 0,         -- 0 while pending
+    CHECK (requester_id <> addressee_id)
+);
+
+-- One request or friendship per pair of players, whoever sent it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_friendships_pair
+    ON friendships(LEAST(requester_id, addressee_id), GREATEST(requester_id, addressee_id));
+
+CREATE INDEX IF NOT EXISTS idx_friendships_requester ON friendships(requester_id, status);
+CREATE INDEX IF NOT EXISTS idx_friendships_addressee ON friendships(addressee_id, status);
+
+-- ========================================
+-- Player Blocks Table
+-- ========================================
+CREATE TABLE IF NOT EXISTS player_blocks (
+    blocker_id TEXT NOT NULL REFERENCES users(id),
+    blocked_id TEXT NOT NULL REFERENCES users(id),
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_blocks_blocked ON player_blocks(blocked_id);
+
+-- ========================================
+-- Backfill: referrals were treated as friendships until now
+-- ========================================
+INSERT INTO friendships (id, requester_id, addressee_id, status, created_at, accepted_at)
+SELECT gen_random_uuid(), r.inviter_id, r.invitee_id, 'accepted', r.created_at, r.created_at
+FROM referrals r
+WHERE r.inviter_id <> r.invitee_id
+  AND EXISTS (SELECT 1 FROM users WHERE id = r.inviter_id)
+  AND EXISTS (SELECT 1 FROM users WHERE id = r.invitee_id)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,28 @@
+-- Migration: 036_add_glicko2_ratings.sql
+-- Glicko-2 ratings: rating deviation and volatility next to MMR, placement matches, RD decay
+
+ALTER TABLE player_ratings
+    ADD COLUMN IF NOT EXISTS rating_deviation DOUBLE PRECISION NOT NULL DEFAULT 350,
+    ADD COLUMN IF NOT EXISTS volatility DOUBLE PRECISION NOT NULL DEFAULT 0.06,
+    ADD COLUMN IF NOT EXISTS games_played INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_game_at BIGINT NOT NULL DEFAULT 0;  -- 0 if the player never played
+
+-- Backfill from finished duels so existing players skip placement
+UPDATE player_ratings pr
+SET games_played = played.games,
+    last_game_at = played.last_game_at
+FROM (
+    SELECT player_id, COUNT(*) AS games, COALESCE(MAX(finished_at), 0) AS last_game_at
+    FROM (
+        SELECT player1_id AS player_id, finished_at FROM duel_matches WHERE status = 'finished'
+        UNION ALL
+        SELECT player2_id AS player_id, finished_at FROM duel_matches WHERE status = 'finished'
+    ) games
+    GROUP BY player_id
+) played
+WHERE pr.player_id = played.player_id;
+
+-- An Elo rating built over many games is more certain than a new player's
+UPDATE player_ratings
+SET rating_deviation = GREATEST(50, 350 - games_played * 10)
+WHERE games_played > 0;
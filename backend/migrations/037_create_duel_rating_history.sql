@@ -0,0 +1,25 @@
+-- Migration: 037_create_duel_rating_history.sql
+-- Per-game rating history: each player's MMR, league and division before and after a finished duel
+
+CREATE TABLE IF NOT EXISTS duel_rating_history (
+    player_id VARCHAR(50) NOT NULL,
+    game_id UUID NOT NULL,
+    season_id VARCHAR(20) NOT NULL,
+    mmr_before INTEGER NOT NULL,
+    mmr_after INTEGER NOT NULL,
+    league_before VARCHAR(20) NOT NULL,
+    division_before INTEGER NOT NULL,
+    league_after VARCHAR(20) NOT NULL,
+    division_after INTEGER NOT NULL,
+    rank_event VARCHAR(30) NOT NULL DEFAULT '',  -- promoted, demoted, demotion_protected, placed; '' if the rank held
+    played_at BIGINT NOT NULL,
+
+    PRIMARY KEY (player_id, game_id)
+);
+
+-- Rating graph: a player's games in a season, oldest first
+CREATE INDEX IF NOT EXISTS idx_duel_rating_history_season
+    ON duel_rating_history(player_id, season_id, played_at);
+
+-- Game result: both players' entries of a game
+CREATE INDEX IF NOT EXISTS idx_duel_rating_history_game ON duel_rating_history(game_id);
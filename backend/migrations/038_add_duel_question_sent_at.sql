@@ -0,0 +1,5 @@
+-- Migration: 038_add_duel_question_sent_at.sql
+-- Server-side answer timing: when each round's question was sent to the players
+
+-- Round number -> Unix ms; answers are timed from here instead of trusting the client
+ALTER TABLE duel_matches ADD COLUMN IF NOT EXISTS question_sent_at JSONB NOT NULL DEFAULT '{}';
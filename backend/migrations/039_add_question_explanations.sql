@@ -0,0 +1,5 @@
+-- Migration: 039_add_question_explanations.sql
+-- Optional explanation shown after a question is answered (Marathon, Daily Challenge, duels)
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS explanation TEXT NOT NULL DEFAULT '';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS explanation_source_url TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- Migration: 040_add_question_media.sql
+-- Optional image on questions and answers ("identify this flag / landmark / logo")
+
+-- {"url" | "assetKey", "width", "height", "alt"}; NULL = text only
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS media JSONB;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS media JSONB;
@@ -0,0 +1,11 @@
+-- Migration: 041_add_content_translations.sql
+-- Per-locale translations of catalog content. The columns' own text is the
+-- default locale ("en"); a locale missing here falls back to it.
+
+-- {"ru": {"title": "...", "description": "..."}}
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS translations JSONB;
+-- {"ru": {"text": "...", "explanation": "..."}}
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS translations JSONB;
+-- {"ru": "..."}
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS translations JSONB;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS translations JSONB;
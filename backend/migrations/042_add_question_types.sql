@@ -0,0 +1,12 @@
+-- Migration: 042_add_question_types.sql
+-- Question types beyond single choice: true/false, multi-select, ordering and
+-- numeric. Existing questions keep the default type.
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS question_type VARCHAR(20) NOT NULL DEFAULT 'single_choice';
+-- Numeric questions only: {"value": 1889, "tolerance": 10}
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS numeric_answer JSONB;
+
+-- Answers other than a single option: {"answerIds": [...]} or {"number": 1900}.
+-- answer_id keeps the first picked option, and is NULL for a number.
+ALTER TABLE user_answers ADD COLUMN IF NOT EXISTS submission JSONB;
+ALTER TABLE user_answers ALTER COLUMN answer_id DROP NOT NULL;
@@ -0,0 +1,28 @@
+-- Migration: 043_add_content_admin.sql
+-- Admin content API: soft delete of catalog content and an audit trail of edits.
+-- Deleted rows stay in place (deleted_at = Unix timestamp) so answers, sessions
+-- and games that reference them keep their history.
+
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+
+-- Categories are saved by id and name only
+ALTER TABLE categories ALTER COLUMN slug DROP NOT NULL;
+
+CREATE TABLE IF NOT EXISTS content_audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('quiz', 'question', 'answer', 'category', 'tag')),
+    entity_id VARCHAR(100) NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore')),
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    changes JSONB NOT NULL DEFAULT '{}',  -- {"field": {"before": ..., "after": ...}}
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_audit_log_entity
+    ON content_audit_log(entity_type, entity_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_content_audit_log_created
+    ON content_audit_log(created_at DESC);
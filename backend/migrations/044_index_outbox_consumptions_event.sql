@@ -0,0 +1,5 @@
+-- Migration: 044_index_outbox_consumptions_event.sql
+-- Outbox retention deletes consumption records by the delivered message they belong to
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_consumptions_event_id
+    ON event_outbox_consumptions(event_id);
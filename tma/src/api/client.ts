@@ -37,20 +37,29 @@ export default async function fetch<TData, _TError = unknown, TVariables = unkno
 export type RequestConfig<TVariables = unknown> = AxiosRequestConfig<TVariables>
 export type ResponseErrorConfig<TError = unknown> = TError
 
+// Значение Authorization для текущего пользователя ("tma <base64 init data>") или null
+// WebSocket не умеет передавать заголовки, поэтому WS-клиенты шлют его в query-параметре auth
+export const getAuthorizationValue = (): string | null => {
+	// RAW init data хранится в composable
+	const initDataRaw = localStorage.getItem('tma_init_data_raw')
+	if (!initDataRaw) return null
+
+	// Формат по документации: "Authorization: tma <init-data-raw>"
+	// ВАЖНО: initDataRaw может содержать не-ASCII символы, что вызовет ошибку в setRequestHeader
+	// Кодируем в Base64 для безопасной передачи
+	return `tma ${btoa(unescape(encodeURIComponent(initDataRaw)))}`
+}
+
 // Request interceptor - добавляем Telegram init data в Authorization header
 apiClient.interceptors.request.use(
 	(config) => {
 		// Получаем raw init data из localStorage или глобального состояния
 		// Используем динамический импорт чтобы избежать circular dependency
 		try {
-			// RAW init data хранится в composable
-			const initDataRaw = localStorage.getItem('tma_init_data_raw')
+			const authorization = getAuthorizationValue()
 
-			if (initDataRaw) {
-				// Формат по документации: "Authorization: tma <init-data-raw>"
-				// ВАЖНО: initDataRaw может содержать не-ASCII символы, что вызовет ошибку в setRequestHeader
-				// Кодируем в Base64 для безопасной передачи
-				config.headers.Authorization = `tma ${btoa(unescape(encodeURIComponent(initDataRaw)))}`
+			if (authorization) {
+				config.headers.Authorization = authorization
 				console.log('Added TMA Authorization header (Base64-encoded)')
 			}
 		} catch (error) {
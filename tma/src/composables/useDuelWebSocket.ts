@@ -1,4 +1,5 @@
 import { ref, onUnmounted, computed } from 'vue'
+import { getAuthorizationValue } from '@/api/client'
 
 export interface DuelQuestion {
 	id: string
@@ -216,7 +217,7 @@ export function useDuelWebSocket(gameId: string, playerId: string) {
 			ws.value.close()
 		}
 
-		const wsUrl = `${getWsBaseUrl()}/ws/duel/${gameId}?playerId=${playerId}`
+		const wsUrl = `${getWsBaseUrl()}/ws/duel/${gameId}?auth=${encodeURIComponent(getAuthorizationValue() ?? '')}`
 
 		console.log('[DuelWS] Connecting to:', wsUrl)
 
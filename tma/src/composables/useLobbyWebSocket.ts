@@ -1,4 +1,5 @@
 import { ref, onUnmounted } from 'vue'
+import { getAuthorizationValue } from '@/api/client'
 
 export type LobbyEventType =
 	| 'connected'
@@ -52,7 +53,7 @@ export function useLobbyWebSocket(playerId: string) {
 			return
 		if (!playerId) return
 
-		const url = `${getWsBase()}/ws/duel/lobby?playerId=${playerId}`
+		const url = `${getWsBase()}/ws/duel/lobby?auth=${encodeURIComponent(getAuthorizationValue() ?? '')}`
 		ws = new WebSocket(url)
 
 		ws.onopen = () => {